@@ -0,0 +1,156 @@
+package api_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+func TestGetApprovalRequests(t *testing.T) {
+	errPrefix := `get approval requests: `
+	uri := "/v1/workspaces/" + exampleID + "/approval-requests"
+
+	tts := []testCase{
+		&simpleTestCase{
+			name:  "requires workspace",
+			param: api.GetApprovalRequestsParam{},
+			err:   errPrefix + "workspace is required",
+		},
+		(&multiRequestTestCase{
+			name: "pending",
+			param: api.GetApprovalRequestsParam{
+				Workspace:       exampleID,
+				Status:          dto.ApprovalStatePending,
+				PaginationParam: api.AllPages(),
+			},
+			result: []dto.ApprovalRequest{
+				{
+					ID:     "a1",
+					Owner:  dto.ApprovalOwner{UserID: "u1"},
+					Status: dto.ApprovalStatus{State: "PENDING"},
+				},
+			},
+		}).
+			addHttpCall(&httpRequest{
+				method: "get",
+				url:    uri + "?page=1&page-size=50&status=PENDING",
+				status: 200,
+				response: `[{"approvalRequest":{
+					"id":"a1","owner":{"userId":"u1"},
+					"status":{"state":"PENDING"}
+				}}]`,
+			}).
+			addHttpCall(&httpRequest{
+				method:   "get",
+				url:      uri + "?page=2&page-size=50&status=PENDING",
+				status:   200,
+				response: `[]`,
+			}),
+	}
+
+	for _, tt := range tts {
+		runClient(t, tt,
+			func(c api.Client, p interface{}) (interface{}, error) {
+				return c.GetApprovalRequests(context.Background(),
+					p.(api.GetApprovalRequestsParam))
+			})
+	}
+}
+
+func TestSubmitApprovalRequest(t *testing.T) {
+	errPrefix := `submit approval request: `
+
+	tts := []testCase{
+		&simpleTestCase{
+			name:  "requires period",
+			param: api.SubmitApprovalRequestParam{Workspace: exampleID},
+			err:   errPrefix + "period is required",
+		},
+		&simpleTestCase{
+			name: "weekly",
+			param: api.SubmitApprovalRequestParam{
+				Workspace:   exampleID,
+				Period:      dto.ApprovalPeriodWeekly,
+				PeriodStart: time.Date(2023, 7, 24, 0, 0, 0, 0, time.UTC),
+			},
+
+			result: dto.ApprovalRequest{ID: "a1"},
+
+			requestMethod: "post",
+			requestUrl:    "/v1/workspaces/" + exampleID + "/approval-requests",
+			requestBody: `{
+				"period":"WEEKLY",
+				"periodStart":"2023-07-24T00:00:00Z"
+			}`,
+
+			responseStatus: 201,
+			responseBody:   `{"id":"a1"}`,
+		},
+	}
+
+	for _, tt := range tts {
+		runClient(t, tt,
+			func(c api.Client, p interface{}) (interface{}, error) {
+				return c.SubmitApprovalRequest(context.Background(),
+					p.(api.SubmitApprovalRequestParam))
+			})
+	}
+}
+
+func TestUpdateApprovalRequest(t *testing.T) {
+	errPrefix := `update approval request: `
+	uri := "/v1/workspaces/" + exampleID + "/approval-requests/" + exampleID
+
+	tts := []testCase{
+		&simpleTestCase{
+			name: "requires state",
+			param: api.UpdateApprovalRequestParam{
+				Workspace:         exampleID,
+				ApprovalRequestID: exampleID,
+			},
+			err: errPrefix + "state is required",
+		},
+		&simpleTestCase{
+			name: "valid id",
+			param: api.UpdateApprovalRequestParam{
+				Workspace:         exampleID,
+				ApprovalRequestID: "a",
+				State:             dto.ApprovalStateApproved,
+			},
+			err: errPrefix + "approval request id .* is not valid ID",
+		},
+		&simpleTestCase{
+			name: "reject",
+			param: api.UpdateApprovalRequestParam{
+				Workspace:         exampleID,
+				ApprovalRequestID: exampleID,
+				State:             dto.ApprovalStateRejected,
+				Note:              "missing friday",
+			},
+
+			result: dto.ApprovalRequest{
+				ID:     exampleID,
+				Status: dto.ApprovalStatus{State: "REJECTED"},
+			},
+
+			requestMethod: "patch",
+			requestUrl:    uri,
+			requestBody:   `{"state":"REJECTED","note":"missing friday"}`,
+
+			responseStatus: 200,
+			responseBody: `{"id":"` + exampleID +
+				`","status":{"state":"REJECTED"}}`,
+		},
+	}
+
+	for _, tt := range tts {
+		runClient(t, tt,
+			func(c api.Client, p interface{}) (interface{}, error) {
+				return c.UpdateApprovalRequest(context.Background(),
+					p.(api.UpdateApprovalRequestParam))
+			})
+	}
+}
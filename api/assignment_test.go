@@ -0,0 +1,122 @@
+package api_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+func TestGetAssignments(t *testing.T) {
+	start := time.Date(2023, 8, 7, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 7)
+
+	tts := []testCase{
+		&simpleTestCase{
+			name: "end after start",
+			param: api.GetAssignmentsParam{
+				Workspace: exampleID,
+				Start:     end,
+				End:       start,
+			},
+			err: "get assignments: end must be after start",
+		},
+		&simpleTestCase{
+			name: "a week",
+			param: api.GetAssignmentsParam{
+				Workspace: exampleID,
+				Start:     start,
+				End:       end,
+			},
+
+			result: []dto.Assignment{
+				{ID: "a1", UserID: "u1", ProjectID: "p1", HoursPerDay: 4},
+			},
+
+			requestMethod: "get",
+			requestUrl: "/v1/workspaces/" + exampleID +
+				"/scheduling/assignments/all" +
+				"?end=2023-08-14T00%3A00%3A00Z&page-size=50" +
+				"&start=2023-08-07T00%3A00%3A00Z",
+
+			responseStatus: 200,
+			responseBody: `[{"id":"a1","userId":"u1","projectId":"p1",
+				"hoursPerDay":4}]`,
+		},
+	}
+
+	for _, tt := range tts {
+		runClient(t, tt,
+			func(c api.Client, p interface{}) (interface{}, error) {
+				return c.GetAssignments(context.Background(),
+					p.(api.GetAssignmentsParam))
+			})
+	}
+}
+
+func TestAddAssignment(t *testing.T) {
+	errPrefix := `add assignment: `
+	start := time.Date(2023, 8, 7, 0, 0, 0, 0, time.UTC)
+
+	tts := []testCase{
+		&simpleTestCase{
+			name: "requires project",
+			param: api.AddAssignmentParam{
+				Workspace: exampleID,
+				UserID:    exampleID,
+			},
+			err: errPrefix + "project id is required",
+		},
+		&simpleTestCase{
+			name: "requires hours",
+			param: api.AddAssignmentParam{
+				Workspace: exampleID,
+				UserID:    exampleID,
+				ProjectID: exampleID,
+				Start:     start,
+				End:       start,
+			},
+			err: errPrefix + "hours per day must be greater than zero",
+		},
+		&simpleTestCase{
+			name: "assign",
+			param: api.AddAssignmentParam{
+				Workspace:   exampleID,
+				UserID:      exampleID,
+				ProjectID:   exampleID,
+				Start:       start,
+				End:         start.AddDate(0, 0, 4),
+				HoursPerDay: 6,
+				Billable:    true,
+			},
+
+			result: []dto.Assignment{{ID: "a1", HoursPerDay: 6}},
+
+			requestMethod: "post",
+			requestUrl: "/v1/workspaces/" + exampleID +
+				"/scheduling/assignments/recurring",
+			requestBody: `{
+				"userId":"` + exampleID + `",
+				"projectId":"` + exampleID + `",
+				"start":"2023-08-07T00:00:00Z",
+				"end":"2023-08-11T00:00:00Z",
+				"hoursPerDay":6,
+				"billable":true,
+				"includeNonWorkingDays":false
+			}`,
+
+			responseStatus: 201,
+			responseBody:   `[{"id":"a1","hoursPerDay":6}]`,
+		},
+	}
+
+	for _, tt := range tts {
+		runClient(t, tt,
+			func(c api.Client, p interface{}) (interface{}, error) {
+				return c.AddAssignment(context.Background(),
+					p.(api.AddAssignmentParam))
+			})
+	}
+}
@@ -0,0 +1,142 @@
+package api
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// cacheEntry is a response kept to be reused while its ETag is still valid
+type cacheEntry struct {
+	ETag   string      `json:"etag"`
+	Header http.Header `json:"header"`
+	Body   []byte      `json:"body"`
+}
+
+// cacheTransport keeps the responses of GET requests that have an ETag and
+// sends it with If-None-Match on the next requests, reusing the response
+// kept when the API answers "304 Not Modified". The responses are kept in
+// memory and, when dir is set, on disk to be reused by other executions
+type cacheTransport struct {
+	next http.RoundTripper
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+	dir     string
+}
+
+func (t *cacheTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if r.Method != http.MethodGet {
+		return t.next.RoundTrip(r)
+	}
+
+	key := cacheKey(r)
+	e, ok := t.get(key)
+
+	req := r
+	if ok {
+		req = r.Clone(r.Context())
+		req.Header.Set("If-None-Match", e.ETag)
+	}
+
+	res, err := t.next.RoundTrip(req)
+	if err != nil {
+		return res, err
+	}
+
+	if ok && res.StatusCode == http.StatusNotModified {
+		_, _ = io.Copy(io.Discard, res.Body)
+		res.Body.Close()
+
+		return &http.Response{
+			Status:        "200 OK",
+			StatusCode:    http.StatusOK,
+			Proto:         res.Proto,
+			ProtoMajor:    res.ProtoMajor,
+			ProtoMinor:    res.ProtoMinor,
+			Header:        e.Header.Clone(),
+			Body:          io.NopCloser(bytes.NewReader(e.Body)),
+			ContentLength: int64(len(e.Body)),
+			Request:       r,
+		}, nil
+	}
+
+	etag := res.Header.Get("ETag")
+	if res.StatusCode != http.StatusOK || etag == "" {
+		return res, nil
+	}
+
+	b, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	res.Body = io.NopCloser(bytes.NewReader(b))
+	t.set(key, cacheEntry{ETag: etag, Header: res.Header.Clone(), Body: b})
+
+	return res, nil
+}
+
+// cacheKey identifies a request by its url and the api key used, as the
+// same url returns different data to each user
+func cacheKey(r *http.Request) string {
+	h := sha256.Sum256(
+		[]byte(r.Header.Get("X-Api-Key") + " " + r.URL.String()))
+	return hex.EncodeToString(h[:])
+}
+
+func (t *cacheTransport) get(key string) (cacheEntry, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if e, ok := t.entries[key]; ok {
+		return e, true
+	}
+
+	if t.dir == "" {
+		return cacheEntry{}, false
+	}
+
+	b, err := os.ReadFile(filepath.Join(t.dir, key+".json"))
+	if err != nil {
+		return cacheEntry{}, false
+	}
+
+	var e cacheEntry
+	if err := json.Unmarshal(b, &e); err != nil || e.ETag == "" {
+		return cacheEntry{}, false
+	}
+
+	t.entries[key] = e
+	return e, true
+}
+
+func (t *cacheTransport) set(key string, e cacheEntry) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.entries[key] = e
+	if t.dir == "" {
+		return
+	}
+
+	// failing to write the cache should not fail the request, it will only
+	// be downloaded again on the next execution
+	b, err := json.Marshal(e)
+	if err != nil {
+		return
+	}
+
+	if err := os.MkdirAll(t.dir, 0700); err != nil {
+		return
+	}
+
+	_ = os.WriteFile(filepath.Join(t.dir, key+".json"), b, 0600)
+}
@@ -0,0 +1,63 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheWithETag(t *testing.T) {
+	calls := 0
+	notModified := 0
+	s := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if r.Header.Get("If-None-Match") == `"v1"` {
+				notModified++
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+
+			w.Header().Set("ETag", `"v1"`)
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"id":"u1","name":"John"}`))
+		}))
+	defer s.Close()
+
+	dir := t.TempDir()
+	expected := dto.User{ID: "u1", Name: "John"}
+
+	c, _ := api.NewClientFromUrlAndKey("a-key", s.URL)
+	for i := 0; i < 2; i++ {
+		u, err := c.GetMe(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, expected, u)
+	}
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, 1, notModified, "second request should be conditional")
+
+	c, _ = api.NewClientFromUrlAndKey("a-key", s.URL)
+	c.SetCacheDir(dir)
+	u, err := c.GetMe(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, expected, u)
+	assert.Equal(t, 1, notModified, "nothing was on the disk yet")
+
+	c, _ = api.NewClientFromUrlAndKey("a-key", s.URL)
+	c.SetCacheDir(dir)
+	u, err = c.GetMe(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, expected, u)
+	assert.Equal(t, 2, notModified, "should use the etag on disk")
+
+	c, _ = api.NewClientFromUrlAndKey("other-key", s.URL)
+	c.SetCacheDir(dir)
+	_, err = c.GetMe(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, notModified, "should not share between keys")
+}
@@ -1,13 +1,19 @@
 package api
 
 import (
+	"context"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"reflect"
 	"regexp"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/lucassabreu/clockify-cli/api/dto"
@@ -15,7 +21,8 @@ import (
 	"github.com/pkg/errors"
 )
 
-// Client will help to access Clockify API
+// Client will help to access Clockify API, when the context of a request
+// is cancelled the request in flight and its next pages are stopped
 type Client interface {
 	// SetDebugLogger when set will output the responses of requests to the
 	// logger
@@ -23,69 +30,175 @@ type Client interface {
 	// SetInfoLogger when set will output which requests and params are used to
 	// the logger
 	SetInfoLogger(logger Logger) Client
-
-	GetWorkspace(GetWorkspace) (dto.Workspace, error)
-	GetWorkspaces(GetWorkspaces) ([]dto.Workspace, error)
-
-	GetMe() (dto.User, error)
-	GetUser(GetUser) (dto.User, error)
-	WorkspaceUsers(WorkspaceUsersParam) ([]dto.User, error)
-
-	AddClient(AddClientParam) (dto.Client, error)
-	GetClients(GetClientsParam) ([]dto.Client, error)
+	// SetMaxRetries sets how many times a request will be retried when the
+	// API is rate limiting the client (0 disables the retries)
+	SetMaxRetries(int) Client
+	// SetRetryWait sets how long the first retry waits when the API does not
+	// inform it, each next retry waits twice as long
+	SetRetryWait(time.Duration) Client
+	// SetTimeout sets how long each attempt of a request can take before
+	// being cancelled and retried (0 disables the timeout)
+	SetTimeout(time.Duration) Client
+	// SetCacheDir sets a directory to keep the responses of GET requests
+	// between executions, they are always kept in memory and revalidated
+	// using their ETags
+	SetCacheDir(dir string) Client
+	// SetHTTPTrace when set will write every request and response, with the
+	// token redacted, to out
+	SetHTTPTrace(out io.Writer) Client
+	// SetTransport sets how the requests are sent, like which proxy and
+	// certificates are used (see NewTransport)
+	SetTransport(http.RoundTripper) Client
+
+	GetWorkspace(context.Context, GetWorkspace) (dto.Workspace, error)
+	UpdateWorkspaceSettings(context.Context, UpdateWorkspaceSettingsParam) (
+		dto.WorkspaceSettings, error)
+	// UpdateWorkspaceBillableRate changes the default hourly rate of the
+	// workspace
+	UpdateWorkspaceBillableRate(context.Context, UpdateWorkspaceRateParam) (
+		dto.Workspace, error)
+	// UpdateWorkspaceCostRate changes the default cost rate of the workspace
+	UpdateWorkspaceCostRate(context.Context, UpdateWorkspaceRateParam) (
+		dto.Workspace, error)
+	// UpdateUserBillableRate changes the hourly rate of a user on all
+	// projects of the workspace
+	UpdateUserBillableRate(context.Context, UpdateUserRateParam) (
+		dto.Workspace, error)
+	// UpdateUserCostRate changes the cost rate of a user on all projects of
+	// the workspace
+	UpdateUserCostRate(context.Context, UpdateUserRateParam) (
+		dto.Workspace, error)
+	GetWorkspaces(context.Context, GetWorkspaces) ([]dto.Workspace, error)
+
+	GetMe(context.Context) (dto.User, error)
+	GetUser(context.Context, GetUser) (dto.User, error)
+	WorkspaceUsers(context.Context, WorkspaceUsersParam) ([]dto.User, error)
+
+	AddClient(context.Context, AddClientParam) (dto.Client, error)
+	GetClients(context.Context, GetClientsParam) ([]dto.Client, error)
 
 	// GetProjects get all project of a workspace
-	GetProjects(GetProjectsParam) ([]dto.Project, error)
+	GetProjects(context.Context, GetProjectsParam) ([]dto.Project, error)
 	// GetProject get a single Project, if exists
-	GetProject(GetProjectParam) (*dto.Project, error)
+	GetProject(context.Context, GetProjectParam) (*dto.Project, error)
 	// AddProject creates a new project
-	AddProject(AddProjectParam) (dto.Project, error)
+	AddProject(context.Context, AddProjectParam) (dto.Project, error)
 	// UpdateProject changes basic information about the project
-	UpdateProject(UpdateProjectParam) (dto.Project, error)
+	UpdateProject(context.Context, UpdateProjectParam) (dto.Project, error)
+	// UpdateProjectBillableRate changes the hourly rate of a project
+	UpdateProjectBillableRate(context.Context, UpdateProjectRateParam) (
+		dto.Project, error)
+	// UpdateProjectCostRate changes the cost rate of a project
+	UpdateProjectCostRate(context.Context, UpdateProjectRateParam) (
+		dto.Project, error)
 	// UpdateProjectUserCostRate will update the hourly rate of a user on a
 	// project
-	UpdateProjectUserBillableRate(UpdateProjectUserRateParam) (
+	UpdateProjectUserBillableRate(context.Context, UpdateProjectUserRateParam) (
 		dto.Project, error)
 	// UpdateProjectUserCostRate will update the cost of a user on a project
-	UpdateProjectUserCostRate(UpdateProjectUserRateParam) (
+	UpdateProjectUserCostRate(context.Context, UpdateProjectUserRateParam) (
 		dto.Project, error)
 	// UpdateProjectEstimate change how the estime of a project is measured
-	UpdateProjectEstimate(UpdateProjectEstimateParam) (dto.Project, error)
+	UpdateProjectEstimate(context.Context, UpdateProjectEstimateParam) (
+		dto.Project, error)
 	// UpdateProjectMemberships changes who has access to add time entries to
 	// the project
-	UpdateProjectMemberships(UpdateProjectMembershipsParam) (dto.Project, error)
+	UpdateProjectMemberships(context.Context, UpdateProjectMembershipsParam) (
+		dto.Project, error)
 	// UpdateProjectTemplate changes if a project is a template or not
-	UpdateProjectTemplate(UpdateProjectTemplateParam) (dto.Project, error)
+	UpdateProjectTemplate(context.Context, UpdateProjectTemplateParam) (
+		dto.Project, error)
 	// DeleteProject removes a project forever
-	DeleteProject(DeleteProjectParam) (dto.Project, error)
-
-	AddTask(AddTaskParam) (dto.Task, error)
-	DeleteTask(DeleteTaskParam) (dto.Task, error)
-	GetTask(GetTaskParam) (dto.Task, error)
-	GetTasks(GetTasksParam) ([]dto.Task, error)
-	UpdateTask(UpdateTaskParam) (dto.Task, error)
-
-	GetTag(GetTagParam) (*dto.Tag, error)
-	GetTags(GetTagsParam) ([]dto.Tag, error)
-
-	ChangeInvoiced(ChangeInvoicedParam) error
-	CreateTimeEntry(CreateTimeEntryParam) (dto.TimeEntryImpl, error)
-	DeleteTimeEntry(DeleteTimeEntryParam) error
-	GetHydratedTimeEntry(GetTimeEntryParam) (*dto.TimeEntry, error)
-	GetHydratedTimeEntryInProgress(GetTimeEntryInProgressParam) (*dto.TimeEntry, error)
-	GetTimeEntry(GetTimeEntryParam) (*dto.TimeEntryImpl, error)
-	GetTimeEntryInProgress(GetTimeEntryInProgressParam) (*dto.TimeEntryImpl, error)
-	GetUserTimeEntries(GetUserTimeEntriesParam) ([]dto.TimeEntryImpl, error)
-	GetUsersHydratedTimeEntries(GetUserTimeEntriesParam) ([]dto.TimeEntry, error)
-	Log(LogParam) ([]dto.TimeEntry, error)
-	LogRange(LogRangeParam) ([]dto.TimeEntry, error)
-	UpdateTimeEntry(UpdateTimeEntryParam) (dto.TimeEntryImpl, error)
-	Out(OutParam) error
+	DeleteProject(context.Context, DeleteProjectParam) (dto.Project, error)
+
+	AddTask(context.Context, AddTaskParam) (dto.Task, error)
+	DeleteTask(context.Context, DeleteTaskParam) (dto.Task, error)
+	GetTask(context.Context, GetTaskParam) (dto.Task, error)
+	GetTasks(context.Context, GetTasksParam) ([]dto.Task, error)
+	UpdateTask(context.Context, UpdateTaskParam) (dto.Task, error)
+
+	GetTag(context.Context, GetTagParam) (*dto.Tag, error)
+	GetTags(context.Context, GetTagsParam) ([]dto.Tag, error)
+	AddTag(context.Context, AddTagParam) (dto.Tag, error)
+
+	GetCustomFields(context.Context, GetCustomFieldsParam) (
+		[]dto.WorkspaceCustomField, error)
+
+	GetExpenses(context.Context, GetExpensesParam) ([]dto.Expense, error)
+	GetExpenseCategories(context.Context, GetExpenseCategoriesParam) (
+		[]dto.ExpenseCategory, error)
+	AddExpense(context.Context, AddExpenseParam) (dto.Expense, error)
+	DeleteExpense(context.Context, DeleteExpenseParam) error
+
+	GetApprovalRequests(context.Context, GetApprovalRequestsParam) (
+		[]dto.ApprovalRequest, error)
+	SubmitApprovalRequest(context.Context, SubmitApprovalRequestParam) (
+		dto.ApprovalRequest, error)
+	UpdateApprovalRequest(context.Context, UpdateApprovalRequestParam) (
+		dto.ApprovalRequest, error)
+
+	GetTimeOffPolicies(context.Context, GetTimeOffPoliciesParam) (
+		[]dto.TimeOffPolicy, error)
+	AddTimeOffRequest(context.Context, AddTimeOffRequestParam) (
+		dto.TimeOffRequest, error)
+	GetTimeOffRequests(context.Context, GetTimeOffRequestsParam) (
+		[]dto.TimeOffRequest, error)
+	GetTimeOffBalances(context.Context, GetTimeOffBalancesParam) (
+		[]dto.TimeOffBalance, error)
+
+	GetAssignments(context.Context, GetAssignmentsParam) (
+		[]dto.Assignment, error)
+	AddAssignment(context.Context, AddAssignmentParam) ([]dto.Assignment, error)
+
+	GetUserGroups(context.Context, GetUserGroupsParam) ([]dto.UserGroup, error)
+	AddUserGroup(context.Context, AddUserGroupParam) (dto.UserGroup, error)
+	AddUserToGroup(context.Context, UserGroupMemberParam) (dto.UserGroup, error)
+	RemoveUserFromGroup(context.Context, UserGroupMemberParam) (
+		dto.UserGroup, error)
+
+	GetWebhooks(context.Context, GetWebhooksParam) ([]dto.Webhook, error)
+	GetWebhook(context.Context, GetWebhookParam) (dto.Webhook, error)
+	AddWebhook(context.Context, AddWebhookParam) (dto.Webhook, error)
+	DeleteWebhook(context.Context, DeleteWebhookParam) (dto.Webhook, error)
+
+	ChangeInvoiced(context.Context, ChangeInvoicedParam) error
+	CreateTimeEntry(context.Context, CreateTimeEntryParam) (
+		dto.TimeEntryImpl, error)
+	DeleteTimeEntry(context.Context, DeleteTimeEntryParam) error
+	DeleteTimeEntries(context.Context, DeleteTimeEntriesParam) (
+		[]dto.TimeEntryImpl, error)
+	GetHydratedTimeEntry(context.Context, GetTimeEntryParam) (
+		*dto.TimeEntry, error)
+	GetHydratedTimeEntryInProgress(context.Context, GetTimeEntryInProgressParam) (
+		*dto.TimeEntry, error)
+	GetTimeEntry(context.Context, GetTimeEntryParam) (*dto.TimeEntryImpl, error)
+	GetTimeEntryInProgress(context.Context, GetTimeEntryInProgressParam) (
+		*dto.TimeEntryImpl, error)
+	GetUserTimeEntries(context.Context, GetUserTimeEntriesParam) (
+		[]dto.TimeEntryImpl, error)
+	GetUsersHydratedTimeEntries(context.Context, GetUserTimeEntriesParam) (
+		[]dto.TimeEntry, error)
+	TimeEntriesIter(context.Context, GetUserTimeEntriesParam) *TimeEntryIterator
+	Log(context.Context, LogParam) ([]dto.TimeEntry, error)
+	LogRange(context.Context, LogRangeParam) ([]dto.TimeEntry, error)
+	UpdateTimeEntry(context.Context, UpdateTimeEntryParam) (
+		dto.TimeEntryImpl, error)
+	UpdateTimeEntries(context.Context, UpdateTimeEntriesParam) (
+		[]dto.TimeEntryImpl, error)
+	Out(context.Context, OutParam) error
+
+	// Raw sends a request as is to the API, for endpoints not wrapped by the
+	// client
+	Raw(context.Context, RawParam) (json.RawMessage, error)
 }
 
 type client struct {
 	baseURL *url.URL
 	http.Client
+	cache       *cacheTransport
+	retry       *retryTransport
+	transport   http.RoundTripper
+	trace       io.Writer
 	debugLogger Logger
 	infoLogger  Logger
 }
@@ -115,16 +228,82 @@ func NewClientFromUrlAndKey(
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
+	u.Path = strings.TrimSuffix(u.Path, "/")
 
-	return &client{
-		baseURL: u,
-		Client: http.Client{
-			Transport: transport{
-				apiKey: apiKey,
-				next:   http.DefaultTransport,
-			},
+	c := &client{baseURL: u}
+	c.retry = &retryTransport{
+		next:       DefaultTransport,
+		maxRetries: DefaultMaxRetries,
+		baseDelay:  DefaultRetryWait,
+		logf:       c.infof,
+	}
+	c.cache = &cacheTransport{
+		next:    c.retry,
+		entries: map[string]cacheEntry{},
+	}
+	c.Client = http.Client{
+		Transport: transport{
+			apiKey: apiKey,
+			next:   c.cache,
 		},
-	}, nil
+	}
+
+	return c, nil
+}
+
+// SetMaxRetries sets how many times a rate limited request will be retried
+func (c *client) SetMaxRetries(n int) Client {
+	if n < 0 {
+		n = 0
+	}
+
+	c.retry.maxRetries = n
+	return c
+}
+
+// SetHTTPTrace writes the requests and responses to out, or stops writing
+// them if out is nil
+func (c *client) SetHTTPTrace(out io.Writer) Client {
+	c.trace = out
+	c.retry.next = wrapTransport(c.transport, c.trace)
+	return c
+}
+
+// SetTransport sets the http.RoundTripper used to send the requests, see
+// NewTransport
+func (c *client) SetTransport(t http.RoundTripper) Client {
+	c.transport = t
+	c.retry.next = wrapTransport(c.transport, c.trace)
+	return c
+}
+
+// SetRetryWait sets how long the first retry waits
+func (c *client) SetRetryWait(d time.Duration) Client {
+	if d <= 0 {
+		d = DefaultRetryWait
+	}
+
+	c.retry.baseDelay = d
+	return c
+}
+
+// SetTimeout sets how long each attempt of a request can take
+func (c *client) SetTimeout(d time.Duration) Client {
+	if d < 0 {
+		d = 0
+	}
+
+	c.retry.timeout = d
+	return c
+}
+
+// SetCacheDir sets where the responses of GET requests will be kept
+func (c *client) SetCacheDir(dir string) Client {
+	c.cache.mu.Lock()
+	defer c.cache.mu.Unlock()
+
+	c.cache.dir = dir
+	return c
 }
 
 // NewClient create a new Client, based on: https://clockify.github.io/clockify_api_docs/
@@ -141,10 +320,11 @@ type GetWorkspaces struct {
 }
 
 // Workspaces list all the user's workspaces
-func (c *client) GetWorkspaces(f GetWorkspaces) ([]dto.Workspace, error) {
+func (c *client) GetWorkspaces(
+	ctx context.Context, f GetWorkspaces) ([]dto.Workspace, error) {
 	var w []dto.Workspace
 
-	r, err := c.NewRequest("GET", "v1/workspaces", nil)
+	r, err := c.NewRequest(ctx, "GET", "v1/workspaces", nil)
 	if err != nil {
 		return w, err
 	}
@@ -184,6 +364,18 @@ const (
 	estimateMethodField = field("estimate method")
 	estimateTypeField   = field("estimate type")
 	resetOptionField    = field("reset option")
+	webhookIDField      = field("webhook id")
+	urlField            = field("url")
+	webhookEventField   = field("webhook event")
+	expenseIDField      = field("expense id")
+	categoryField       = field("category id")
+	approvalIDField     = field("approval request id")
+	approvalStateField  = field("state")
+	periodField         = field("period")
+	policyIDField       = field("policy id")
+	userGroupIDField    = field("user group id")
+	methodField         = field("method")
+	pathField           = field("path")
 )
 
 // RequiredFieldError indicates that a field should be filled, but was not
@@ -265,7 +457,8 @@ type GetWorkspace struct {
 	ID string
 }
 
-func (c *client) GetWorkspace(p GetWorkspace) (dto.Workspace, error) {
+func (c *client) GetWorkspace(
+	ctx context.Context, p GetWorkspace) (dto.Workspace, error) {
 	var err error
 	defer wrapError(&err, "get workspace %s", p.ID)
 
@@ -273,7 +466,7 @@ func (c *client) GetWorkspace(p GetWorkspace) (dto.Workspace, error) {
 		return dto.Workspace{}, errors.WithStack(err)
 	}
 
-	ws, err := c.GetWorkspaces(GetWorkspaces{})
+	ws, err := c.GetWorkspaces(ctx, GetWorkspaces{})
 	if err != nil {
 		return dto.Workspace{}, err
 	}
@@ -292,6 +485,162 @@ func (c *client) GetWorkspace(p GetWorkspace) (dto.Workspace, error) {
 	return dto.Workspace{}, err
 }
 
+// UpdateWorkspaceSettingsParam params to replace the settings of a
+// workspace
+type UpdateWorkspaceSettingsParam struct {
+	Workspace string
+	Settings  dto.WorkspaceSettings
+}
+
+// UpdateWorkspaceSettings replaces the settings of the workspace, the
+// settings not changed should be the same as returned by GetWorkspace
+func (c *client) UpdateWorkspaceSettings(
+	ctx context.Context, p UpdateWorkspaceSettingsParam,
+) (s dto.WorkspaceSettings, err error) {
+	defer wrapError(&err, "update workspace settings")
+
+	if err = checkWorkspace(p.Workspace); err != nil {
+		return s, err
+	}
+
+	r, err := c.NewRequest(
+		ctx,
+		"PUT",
+		"v1/workspaces/"+p.Workspace+"/settings",
+		p.Settings,
+	)
+	if err != nil {
+		return s, err
+	}
+
+	_, err = c.Do(r, &s, "UpdateWorkspaceSettings")
+	return s, err
+}
+
+// UpdateWorkspaceRateParam sets the parameters to update the default
+// billable/cost rate of a workspace, if Since is not nil, then all time
+// entries after that time will be updated to new rate
+type UpdateWorkspaceRateParam struct {
+	Workspace string
+	Amount    uint
+	Currency  string
+	Since     *time.Time
+}
+
+func (c *client) UpdateWorkspaceBillableRate(
+	ctx context.Context, p UpdateWorkspaceRateParam,
+) (w dto.Workspace, err error) {
+	defer wrapError(&err, "update workspace billable rate")
+
+	if err = checkWorkspace(p.Workspace); err != nil {
+		return w, err
+	}
+
+	err = c.updateRate(
+		ctx,
+		"v1/workspaces/"+p.Workspace+"/hourly-rate",
+		p.Amount, p.Currency, p.Since, &w, "UpdateWorkspaceBillableRate")
+	return w, err
+}
+
+func (c *client) UpdateWorkspaceCostRate(
+	ctx context.Context, p UpdateWorkspaceRateParam,
+) (w dto.Workspace, err error) {
+	defer wrapError(&err, "update workspace cost rate")
+
+	if err = checkWorkspace(p.Workspace); err != nil {
+		return w, err
+	}
+
+	err = c.updateRate(
+		ctx,
+		"v1/workspaces/"+p.Workspace+"/cost-rate",
+		p.Amount, p.Currency, p.Since, &w, "UpdateWorkspaceCostRate")
+	return w, err
+}
+
+// UpdateUserRateParam sets the parameters to update the billable/cost rate
+// of a user on the workspace, if Since is not nil, then all time entries
+// after that time will be updated to new rate
+type UpdateUserRateParam struct {
+	Workspace string
+	UserID    string
+	Amount    uint
+	Since     *time.Time
+}
+
+func (p UpdateUserRateParam) check() error {
+	if err := required(map[field]string{
+		workspaceField: p.Workspace,
+		userIDField:    p.UserID,
+	}); err != nil {
+		return err
+	}
+
+	return checkIDs(map[field]string{
+		workspaceField: p.Workspace,
+		userIDField:    p.UserID,
+	})
+}
+
+func (c *client) UpdateUserBillableRate(
+	ctx context.Context, p UpdateUserRateParam) (w dto.Workspace, err error) {
+	defer wrapError(&err, "update user billable rate")
+
+	if err = p.check(); err != nil {
+		return w, err
+	}
+
+	err = c.updateRate(
+		ctx,
+		"v1/workspaces/"+p.Workspace+"/users/"+p.UserID+"/hourly-rate",
+		p.Amount, "", p.Since, &w, "UpdateUserBillableRate")
+	return w, err
+}
+
+func (c *client) UpdateUserCostRate(
+	ctx context.Context, p UpdateUserRateParam) (w dto.Workspace, err error) {
+	defer wrapError(&err, "update user cost rate")
+
+	if err = p.check(); err != nil {
+		return w, err
+	}
+
+	err = c.updateRate(
+		ctx,
+		"v1/workspaces/"+p.Workspace+"/users/"+p.UserID+"/cost-rate",
+		p.Amount, "", p.Since, &w, "UpdateUserCostRate")
+	return w, err
+}
+
+// updateRate sends the new rate to the uri, decoding the response into v
+func (c *client) updateRate(
+	ctx context.Context,
+	uri string,
+	amount uint,
+	currency string,
+	since *time.Time,
+	v interface{},
+	name string,
+) error {
+	var s *dto.DateTime
+	if since != nil {
+		s = &dto.DateTime{Time: *since}
+	}
+
+	r, err := c.NewRequest(ctx, "PUT", uri, dto.UpdateRateRequest{
+		Amount:   amount,
+		Currency: currency,
+		Since:    s,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Do(r, v, name)
+	return err
+}
+
 // WorkspaceUsersParam params to query workspace users
 type WorkspaceUsersParam struct {
 	Workspace string
@@ -301,7 +650,8 @@ type WorkspaceUsersParam struct {
 }
 
 // WorkspaceUsers all users in a Workspace
-func (c *client) WorkspaceUsers(p WorkspaceUsersParam) (users []dto.User, err error) {
+func (c *client) WorkspaceUsers(
+	ctx context.Context, p WorkspaceUsersParam) (users []dto.User, err error) {
 	defer wrapError(&err, "get users")
 
 	if err := checkWorkspace(p.Workspace); err != nil {
@@ -309,6 +659,7 @@ func (c *client) WorkspaceUsers(p WorkspaceUsersParam) (users []dto.User, err er
 	}
 
 	err = c.paginate(
+		ctx,
 		"GET",
 		fmt.Sprintf("v1/workspaces/%s/users", p.Workspace),
 		p.PaginationParam,
@@ -336,6 +687,9 @@ type PaginationParam struct {
 	AllPages bool
 	Page     int
 	PageSize int
+	// Limit is the maximum of items returned, no more pages are fetched
+	// after it is reached (0 is unlimited)
+	Limit int
 }
 
 // AllPages sets the query to retrieve all pages
@@ -352,13 +706,13 @@ type LogParam struct {
 }
 
 // Log list time entries from a date
-func (c *client) Log(p LogParam) ([]dto.TimeEntry, error) {
+func (c *client) Log(ctx context.Context, p LogParam) ([]dto.TimeEntry, error) {
 	c.infof("Log - Date Param: %s", p.Date)
 
 	d := p.Date.Round(time.Hour)
 	d = d.Add(time.Hour * time.Duration(d.Hour()) * -1)
 
-	return c.LogRange(LogRangeParam{
+	return c.LogRange(ctx, LogRangeParam{
 		Workspace:       p.Workspace,
 		UserID:          p.UserID,
 		FirstDate:       d,
@@ -380,10 +734,11 @@ type LogRangeParam struct {
 }
 
 // LogRange list time entries by date range
-func (c *client) LogRange(p LogRangeParam) ([]dto.TimeEntry, error) {
+func (c *client) LogRange(
+	ctx context.Context, p LogRangeParam) ([]dto.TimeEntry, error) {
 	c.infof("LogRange - First Date Param: %s | Last Date Param: %s", p.FirstDate, p.LastDate)
 
-	return c.GetUsersHydratedTimeEntries(GetUserTimeEntriesParam{
+	return c.GetUsersHydratedTimeEntries(ctx, GetUserTimeEntriesParam{
 		Workspace:       p.Workspace,
 		UserID:          p.UserID,
 		Start:           &p.FirstDate,
@@ -409,43 +764,48 @@ type GetUserTimeEntriesParam struct {
 }
 
 // GetUserTimeEntries will list the time entries of a user on a workspace, can be paginated
-func (c *client) GetUserTimeEntries(p GetUserTimeEntriesParam) ([]dto.TimeEntryImpl, error) {
+func (c *client) GetUserTimeEntries(
+	ctx context.Context, p GetUserTimeEntriesParam,
+) ([]dto.TimeEntryImpl, error) {
 	var timeEntries []dto.TimeEntryImpl
 	var tes []dto.TimeEntryImpl
 
-	err := c.getUserTimeEntriesImpl(p, false, &tes, func(res interface{}) (int, error) {
-		if res == nil {
-			return 0, nil
-		}
+	err := c.getUserTimeEntriesImpl(ctx, p, false, &tes,
+		func(res interface{}) (int, error) {
+			if res == nil {
+				return 0, nil
+			}
 
-		tes := res.(*[]dto.TimeEntryImpl)
-		timeEntries = append(timeEntries, *tes...)
-		return len(*tes), nil
-	})
+			tes := res.(*[]dto.TimeEntryImpl)
+			timeEntries = append(timeEntries, *tes...)
+			return len(*tes), nil
+		})
 
 	return timeEntries, err
 }
 
 // GetUsersHydratedTimeEntries will list hydrated time entries of a user on a workspace, can be paginated
-func (c *client) GetUsersHydratedTimeEntries(p GetUserTimeEntriesParam) ([]dto.TimeEntry, error) {
+func (c *client) GetUsersHydratedTimeEntries(
+	ctx context.Context, p GetUserTimeEntriesParam) ([]dto.TimeEntry, error) {
 	var timeEntries []dto.TimeEntry
 	var tes []dto.TimeEntry
 
-	err := c.getUserTimeEntriesImpl(p, true, &tes, func(res interface{}) (int, error) {
-		if res == nil {
-			return 0, nil
-		}
+	err := c.getUserTimeEntriesImpl(ctx, p, true, &tes,
+		func(res interface{}) (int, error) {
+			if res == nil {
+				return 0, nil
+			}
 
-		tes := res.(*[]dto.TimeEntry)
-		timeEntries = append(timeEntries, *tes...)
-		return len(*tes), nil
-	})
+			tes := res.(*[]dto.TimeEntry)
+			timeEntries = append(timeEntries, *tes...)
+			return len(*tes), nil
+		})
 
 	if err != nil {
 		return timeEntries, err
 	}
 
-	user, err := c.GetUser(GetUser{p.Workspace, p.UserID})
+	user, err := c.GetUser(ctx, GetUser{p.Workspace, p.UserID})
 	if err != nil {
 		return timeEntries, err
 	}
@@ -457,7 +817,53 @@ func (c *client) GetUsersHydratedTimeEntries(p GetUserTimeEntriesParam) ([]dto.T
 	return timeEntries, err
 }
 
+// TimeEntriesIter iterates over the hydrated time entries of a user one page
+// at a time, AllPages and Page are ignored
+func (c *client) TimeEntriesIter(
+	ctx context.Context,
+	p GetUserTimeEntriesParam) *TimeEntryIterator {
+	size := p.PageSize
+	if size <= 0 {
+		size = 50
+	}
+
+	var user *dto.User
+	return NewTimeEntryIterator(size, func(page int) ([]dto.TimeEntry, error) {
+		var tes []dto.TimeEntry
+		var tmpl []dto.TimeEntry
+
+		p.PaginationParam = PaginationParam{Page: page, PageSize: size}
+		err := c.getUserTimeEntriesImpl(ctx, p, true, &tmpl,
+			func(res interface{}) (int, error) {
+				if res == nil {
+					return 0, nil
+				}
+
+				tes = *res.(*[]dto.TimeEntry)
+				return len(tes), nil
+			})
+		if err != nil || len(tes) == 0 {
+			return tes, err
+		}
+
+		if user == nil {
+			u, err := c.GetUser(ctx, GetUser{p.Workspace, p.UserID})
+			if err != nil {
+				return tes, err
+			}
+			user = &u
+		}
+
+		for i := range tes {
+			tes[i].User = user
+		}
+
+		return tes, nil
+	})
+}
+
 func (c *client) getUserTimeEntriesImpl(
+	ctx context.Context,
 	p GetUserTimeEntriesParam,
 	hydrated bool,
 	tmpl interface{},
@@ -514,6 +920,7 @@ func (c *client) getUserTimeEntriesImpl(
 	}
 
 	err = c.paginate(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"v1/workspaces/%s/user/%s/time-entries",
@@ -530,7 +937,17 @@ func (c *client) getUserTimeEntriesImpl(
 	return err
 }
 
+// paginationWorkers is how many pages are fetched at the same time when the
+// total of items is known
+const paginationWorkers = 4
+
+// paginate fetches the pages of the request calling the reducer with each
+// one of them in order. When all pages are requested and the first response
+// informs the total of items (X-Total-Count), the other pages are fetched
+// concurrently, otherwise they are fetched one after the other until a page
+// with less items than the page size is found
 func (c *client) paginate(
+	ctx context.Context,
 	method, uri string,
 	p PaginationParam,
 	request dto.PaginatedRequest,
@@ -538,6 +955,14 @@ func (c *client) paginate(
 	reducer func(interface{}) (int, error),
 	name string,
 ) error {
+	if p.PageSize < 0 {
+		return errors.New("page size can't be negative")
+	}
+
+	if p.Limit < 0 {
+		return errors.New("limit can't be negative")
+	}
+
 	page := p.Page
 	if p.AllPages {
 		page = 1
@@ -545,36 +970,159 @@ func (c *client) paginate(
 
 	if p.PageSize == 0 {
 		p.PageSize = 50
+		if p.Limit > 0 && p.Limit < p.PageSize {
+			p.PageSize = p.Limit
+		}
 	}
 
-	stop := false
-	for !stop {
+	fetch := func(page int) (interface{}, int, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, 0, errors.WithStack(err)
+		}
+
 		r, err := c.NewRequest(
+			ctx,
 			method,
 			uri,
 			request.WithPagination(page, p.PageSize),
 		)
 		if err != nil {
-			return err
+			return nil, 0, err
 		}
 
 		response := reflect.New(reflect.TypeOf(bodyTempl).Elem()).Interface()
-		_, err = c.Do(r, &response, name)
+		res, err := c.Do(r, &response, name)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		total, _ := strconv.Atoi(res.Header.Get("X-Total-Count"))
+		return response, total, nil
+	}
+
+	fetched := 0
+	stop := false
+	for !stop {
+		response, total, err := fetch(page)
 		if err != nil {
 			return err
 		}
 
+		if p.Limit > 0 {
+			err = limitPage(reflect.ValueOf(response), p.Limit-fetched)
+			if err != nil {
+				return err
+			}
+		}
+
 		count, err := reducer(response)
 		if err != nil {
 			return err
 		}
+		fetched += count
+
+		stop = count < p.PageSize || !p.AllPages ||
+			(p.Limit > 0 && fetched >= p.Limit)
+		if !stop && total > 0 && p.Limit == 0 {
+			lastPage := (total + p.PageSize - 1) / p.PageSize
+			return fetchPagesConcurrently(page+1, lastPage, fetch, reducer)
+		}
 
-		stop = count < p.PageSize || !p.AllPages
 		page++
 	}
 	return nil
 }
 
+// limitPage removes the items of the page after max, the response must be
+// a list or a struct with only one list on it
+func limitPage(v reflect.Value, max int) error {
+	ls := pageLists(v, nil)
+	if len(ls) != 1 {
+		return errors.Errorf(
+			"can't limit a page with %d lists on it", len(ls))
+	}
+
+	if ls[0].Len() > max {
+		ls[0].Set(ls[0].Slice(0, max))
+	}
+	return nil
+}
+
+// pageLists returns the lists of the response that can be changed, looking
+// into its structs, but not into the lists
+func pageLists(v reflect.Value, ls []reflect.Value) []reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			return pageLists(v.Elem(), ls)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			ls = pageLists(v.Field(i), ls)
+		}
+	case reflect.Slice:
+		if v.CanSet() {
+			ls = append(ls, v)
+		}
+	}
+	return ls
+}
+
+// fetchPagesConcurrently fetches the pages from first to last using up to
+// paginationWorkers requests at the same time, the reducer is called in the
+// order of the pages; once a page fails no other page is requested
+func fetchPagesConcurrently(
+	first, last int,
+	fetch func(int) (interface{}, int, error),
+	reducer func(interface{}) (int, error),
+) error {
+	if first > last {
+		return nil
+	}
+
+	responses := make([]interface{}, last-first+1)
+	errs := make([]error, len(responses))
+
+	var wg sync.WaitGroup
+	var failed int32
+	scheduled := 0
+	sem := make(chan struct{}, paginationWorkers)
+	for i := range responses {
+		sem <- struct{}{}
+		if atomic.LoadInt32(&failed) != 0 {
+			<-sem
+			break
+		}
+
+		wg.Add(1)
+		scheduled++
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			responses[i], _, errs[i] = fetch(first + i)
+			if errs[i] != nil {
+				atomic.StoreInt32(&failed, 1)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	for i := range responses[:scheduled] {
+		if errs[i] != nil {
+			return errs[i]
+		}
+
+		if _, err := reducer(responses[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // GetTimeEntryInProgressParam params to query entries
 type GetTimeEntryInProgressParam struct {
 	Workspace string
@@ -582,9 +1130,11 @@ type GetTimeEntryInProgressParam struct {
 }
 
 // GetTimeEntryInProgress show time entry in progress (if any)
-func (c *client) GetTimeEntryInProgress(p GetTimeEntryInProgressParam) (timeEntryImpl *dto.TimeEntryImpl, err error) {
+func (c *client) GetTimeEntryInProgress(
+	ctx context.Context, p GetTimeEntryInProgressParam,
+) (timeEntryImpl *dto.TimeEntryImpl, err error) {
 	b := true
-	ts, err := c.GetUserTimeEntries(GetUserTimeEntriesParam{
+	ts, err := c.GetUserTimeEntries(ctx, GetUserTimeEntriesParam{
 		Workspace:       p.Workspace,
 		UserID:          p.UserID,
 		OnlyInProgress:  &b,
@@ -602,9 +1152,11 @@ func (c *client) GetTimeEntryInProgress(p GetTimeEntryInProgressParam) (timeEntr
 }
 
 // GetHydratedTimeEntryInProgress show hydrated time entry in progress (if any)
-func (c *client) GetHydratedTimeEntryInProgress(p GetTimeEntryInProgressParam) (timeEntry *dto.TimeEntry, err error) {
+func (c *client) GetHydratedTimeEntryInProgress(
+	ctx context.Context, p GetTimeEntryInProgressParam,
+) (timeEntry *dto.TimeEntry, err error) {
 	b := true
-	ts, err := c.GetUsersHydratedTimeEntries(GetUserTimeEntriesParam{
+	ts, err := c.GetUsersHydratedTimeEntries(ctx, GetUserTimeEntriesParam{
 		Workspace:      p.Workspace,
 		UserID:         p.UserID,
 		OnlyInProgress: &b,
@@ -623,7 +1175,9 @@ type GetTimeEntryParam struct {
 }
 
 // GetTimeEntry will retrieve a Time Entry using its Workspace and ID
-func (c *client) GetTimeEntry(p GetTimeEntryParam) (timeEntry *dto.TimeEntryImpl, err error) {
+func (c *client) GetTimeEntry(
+	ctx context.Context, p GetTimeEntryParam,
+) (timeEntry *dto.TimeEntryImpl, err error) {
 	defer wrapError(&err, "get time entry \"%s\"", p.TimeEntryID)
 
 	ids := map[field]string{
@@ -640,6 +1194,7 @@ func (c *client) GetTimeEntry(p GetTimeEntryParam) (timeEntry *dto.TimeEntryImpl
 	}
 
 	r, err := c.NewRequest(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"v1/workspaces/%s/time-entries/%s",
@@ -659,7 +1214,9 @@ func (c *client) GetTimeEntry(p GetTimeEntryParam) (timeEntry *dto.TimeEntryImpl
 	return timeEntry, err
 }
 
-func (c *client) GetHydratedTimeEntry(p GetTimeEntryParam) (timeEntry *dto.TimeEntry, err error) {
+func (c *client) GetHydratedTimeEntry(
+	ctx context.Context, p GetTimeEntryParam,
+) (timeEntry *dto.TimeEntry, err error) {
 	defer wrapError(&err, "get hydrated time entry \"%s\"", p.TimeEntryID)
 
 	ids := map[field]string{
@@ -677,6 +1234,7 @@ func (c *client) GetHydratedTimeEntry(p GetTimeEntryParam) (timeEntry *dto.TimeE
 
 	b := true
 	r, err := c.NewRequest(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"v1/workspaces/%s/time-entries/%s",
@@ -704,8 +1262,8 @@ type GetTagParam struct {
 }
 
 // GetTag get a single tag, if it exists
-func (c *client) GetTag(p GetTagParam) (*dto.Tag, error) {
-	tags, err := c.GetTags(GetTagsParam{
+func (c *client) GetTag(ctx context.Context, p GetTagParam) (*dto.Tag, error) {
+	tags, err := c.GetTags(ctx, GetTagsParam{
 		Workspace: p.Workspace,
 	})
 
@@ -731,7 +1289,8 @@ type GetProjectParam struct {
 }
 
 // GetProject get a single Project, if exists
-func (c *client) GetProject(p GetProjectParam) (pr *dto.Project, err error) {
+func (c *client) GetProject(
+	ctx context.Context, p GetProjectParam) (pr *dto.Project, err error) {
 	defer wrapError(&err, "get project \"%s\"", p.ProjectID)
 
 	ids := map[field]string{
@@ -748,6 +1307,7 @@ func (c *client) GetProject(p GetProjectParam) (pr *dto.Project, err error) {
 	}
 
 	r, err := c.NewRequest(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"v1/workspaces/%s/projects/%s",
@@ -776,7 +1336,7 @@ type GetUser struct {
 }
 
 // GetUser filters the wanted user from the workspace users
-func (c *client) GetUser(p GetUser) (dto.User, error) {
+func (c *client) GetUser(ctx context.Context, p GetUser) (dto.User, error) {
 	var err error
 	defer wrapError(&err, "get user \"%s\"", p.UserID)
 
@@ -793,7 +1353,7 @@ func (c *client) GetUser(p GetUser) (dto.User, error) {
 		return dto.User{}, err
 	}
 
-	us, err := c.WorkspaceUsers(WorkspaceUsersParam{
+	us, err := c.WorkspaceUsers(ctx, WorkspaceUsersParam{
 		Workspace:       p.Workspace,
 		PaginationParam: AllPages(),
 	})
@@ -815,8 +1375,8 @@ func (c *client) GetUser(p GetUser) (dto.User, error) {
 }
 
 // GetMe get details about the user who created the token
-func (c *client) GetMe() (dto.User, error) {
-	r, err := c.NewRequest("GET", "v1/user", nil)
+func (c *client) GetMe(ctx context.Context) (dto.User, error) {
+	r, err := c.NewRequest(ctx, "GET", "v1/user", nil)
 
 	if err != nil {
 		return dto.User{}, err
@@ -838,7 +1398,8 @@ type GetTasksParam struct {
 }
 
 // GetTasks get tasks of a project
-func (c *client) GetTasks(p GetTasksParam) (ps []dto.Task, err error) {
+func (c *client) GetTasks(
+	ctx context.Context, p GetTasksParam) (ps []dto.Task, err error) {
 	var tmpl []dto.Task
 
 	defer wrapError(&err, "get tasks from project \"%s\"", p.ProjectID)
@@ -857,6 +1418,7 @@ func (c *client) GetTasks(p GetTasksParam) (ps []dto.Task, err error) {
 	}
 
 	err = c.paginate(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"v1/workspaces/%s/projects/%s/tasks",
@@ -891,7 +1453,8 @@ type GetTaskParam struct {
 }
 
 // GetTasks get tasks of a project
-func (c *client) GetTask(p GetTaskParam) (t dto.Task, err error) {
+func (c *client) GetTask(
+	ctx context.Context, p GetTaskParam) (t dto.Task, err error) {
 	defer wrapError(&err, "get task \"%s\"", p.TaskID)
 
 	ids := map[field]string{
@@ -909,6 +1472,7 @@ func (c *client) GetTask(p GetTaskParam) (t dto.Task, err error) {
 	}
 
 	r, err := c.NewRequest(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"v1/workspaces/%s/projects/%s/tasks/%s",
@@ -942,11 +1506,13 @@ type AddTaskParam struct {
 	Name        string
 	AssigneeIDs *[]string
 	Estimate    *time.Duration
+	Budget      *uint64
 	Status      TaskStatus
 	Billable    *bool
 }
 
-func (c *client) AddTask(p AddTaskParam) (task dto.Task, err error) {
+func (c *client) AddTask(
+	ctx context.Context, p AddTaskParam) (task dto.Task, err error) {
 	defer wrapError(&err, "add task to project \"%s\"", p.ProjectID)
 
 	if err = required(map[field]string{
@@ -968,6 +1534,7 @@ func (c *client) AddTask(p AddTaskParam) (task dto.Task, err error) {
 		Name:        p.Name,
 		AssigneeIDs: p.AssigneeIDs,
 		Billable:    p.Billable,
+		Budget:      p.Budget,
 	}
 
 	if p.Status != TaskStatus("") {
@@ -981,6 +1548,7 @@ func (c *client) AddTask(p AddTaskParam) (task dto.Task, err error) {
 	}
 
 	req, err := c.NewRequest(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"v1/workspaces/%s/projects/%s/tasks",
@@ -1006,11 +1574,13 @@ type UpdateTaskParam struct {
 	Name        string
 	AssigneeIDs *[]string
 	Estimate    *time.Duration
+	Budget      *uint64
 	Status      TaskStatus
 	Billable    *bool
 }
 
-func (c *client) UpdateTask(p UpdateTaskParam) (task dto.Task, err error) {
+func (c *client) UpdateTask(
+	ctx context.Context, p UpdateTaskParam) (task dto.Task, err error) {
 	defer wrapError(&err, "update task \"%s\"", p.TaskID)
 
 	if err = required(map[field]string{
@@ -1034,6 +1604,7 @@ func (c *client) UpdateTask(p UpdateTaskParam) (task dto.Task, err error) {
 		Name:        p.Name,
 		AssigneeIDs: p.AssigneeIDs,
 		Billable:    p.Billable,
+		Budget:      p.Budget,
 	}
 
 	if p.Status != TaskStatus("") {
@@ -1047,6 +1618,7 @@ func (c *client) UpdateTask(p UpdateTaskParam) (task dto.Task, err error) {
 	}
 
 	req, err := c.NewRequest(
+		ctx,
 		"PUT",
 		fmt.Sprintf(
 			"v1/workspaces/%s/projects/%s/tasks/%s",
@@ -1072,7 +1644,8 @@ type DeleteTaskParam struct {
 	TaskID    string
 }
 
-func (c *client) DeleteTask(p DeleteTaskParam) (task dto.Task, err error) {
+func (c *client) DeleteTask(
+	ctx context.Context, p DeleteTaskParam) (task dto.Task, err error) {
 	defer wrapError(&err, "delete task \"%s\"", p.TaskID)
 
 	ids := map[field]string{
@@ -1090,6 +1663,7 @@ func (c *client) DeleteTask(p DeleteTaskParam) (task dto.Task, err error) {
 	}
 
 	req, err := c.NewRequest(
+		ctx,
 		"DELETE",
 		fmt.Sprintf(
 			"v1/workspaces/%s/projects/%s/tasks/%s",
@@ -1110,18 +1684,19 @@ func (c *client) DeleteTask(p DeleteTaskParam) (task dto.Task, err error) {
 
 // CreateTimeEntryParam params to create a new time entry
 type CreateTimeEntryParam struct {
-	Workspace   string
-	Start       time.Time
-	End         *time.Time
-	Billable    *bool
-	Description string
-	ProjectID   string
-	TaskID      string
-	TagIDs      []string
+	Workspace    string
+	Start        time.Time
+	End          *time.Time
+	Billable     *bool
+	Description  string
+	ProjectID    string
+	TaskID       string
+	TagIDs       []string
+	CustomFields []dto.CustomFieldValue
 }
 
 // CreateTimeEntry create a new time entry
-func (c *client) CreateTimeEntry(p CreateTimeEntryParam) (
+func (c *client) CreateTimeEntry(ctx context.Context, p CreateTimeEntryParam) (
 	t dto.TimeEntryImpl, err error) {
 	defer wrapError(&err, "create time entry")
 
@@ -1135,6 +1710,7 @@ func (c *client) CreateTimeEntry(p CreateTimeEntryParam) (
 	}
 
 	r, err := c.NewRequest(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"v1/workspaces/%s/time-entries",
@@ -1148,6 +1724,8 @@ func (c *client) CreateTimeEntry(p CreateTimeEntryParam) (
 			ProjectID:   p.ProjectID,
 			TaskID:      p.TaskID,
 			TagIDs:      p.TagIDs,
+
+			CustomFields: p.CustomFields,
 		},
 	)
 
@@ -1169,7 +1747,8 @@ type GetTagsParam struct {
 }
 
 // GetTags get all tags of a workspace
-func (c *client) GetTags(p GetTagsParam) (ps []dto.Tag, err error) {
+func (c *client) GetTags(
+	ctx context.Context, p GetTagsParam) (ps []dto.Tag, err error) {
 	defer wrapError(&err, "get tags")
 	var tmpl []dto.Tag
 	if err = checkWorkspace(p.Workspace); err != nil {
@@ -1177,6 +1756,7 @@ func (c *client) GetTags(p GetTagsParam) (ps []dto.Tag, err error) {
 	}
 
 	err = c.paginate(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"v1/workspaces/%s/tags",
@@ -1202,6 +1782,49 @@ func (c *client) GetTags(p GetTagsParam) (ps []dto.Tag, err error) {
 	return ps, err
 }
 
+type AddTagParam struct {
+	Workspace string
+	Name      string
+}
+
+// AddTag adds a new tag to a workspace
+func (c *client) AddTag(
+	ctx context.Context, p AddTagParam) (tag dto.Tag, err error) {
+	defer wrapError(&err, "add tag")
+
+	if err = required(map[field]string{
+		nameField:      p.Name,
+		workspaceField: p.Workspace,
+	}); err != nil {
+		return tag, err
+	}
+
+	if err = checkIDs(map[field]string{
+		workspaceField: p.Workspace,
+	}); err != nil {
+		return tag, err
+	}
+
+	req, err := c.NewRequest(
+		ctx,
+		"POST",
+		fmt.Sprintf(
+			"v1/workspaces/%s/tags",
+			p.Workspace,
+		),
+		dto.AddTagRequest{
+			Name: p.Name,
+		},
+	)
+
+	if err != nil {
+		return tag, err
+	}
+
+	_, err = c.Do(req, &tag, "AddTag")
+	return tag, err
+}
+
 // GetClientsParam params to get all clients of a workspace
 type GetClientsParam struct {
 	Workspace string
@@ -1212,7 +1835,7 @@ type GetClientsParam struct {
 }
 
 // GetClients gets all clients of a workspace
-func (c *client) GetClients(p GetClientsParam) (
+func (c *client) GetClients(ctx context.Context, p GetClientsParam) (
 	clients []dto.Client, err error) {
 	defer wrapError(&err, "get clients")
 
@@ -1222,6 +1845,7 @@ func (c *client) GetClients(p GetClientsParam) (
 	}
 
 	err = c.paginate(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"v1/workspaces/%s/clients",
@@ -1253,7 +1877,8 @@ type AddClientParam struct {
 }
 
 // AddClient adds a new client to a workspace
-func (c *client) AddClient(p AddClientParam) (client dto.Client, err error) {
+func (c *client) AddClient(
+	ctx context.Context, p AddClientParam) (client dto.Client, err error) {
 	defer wrapError(&err, "add client")
 
 	if err = required(map[field]string{
@@ -1270,6 +1895,7 @@ func (c *client) AddClient(p AddClientParam) (client dto.Client, err error) {
 	}
 
 	req, err := c.NewRequest(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"v1/workspaces/%s/clients",
@@ -1300,7 +1926,8 @@ type GetProjectsParam struct {
 }
 
 // GetProjects get all project of a workspace
-func (c *client) GetProjects(p GetProjectsParam) (ps []dto.Project, err error) {
+func (c *client) GetProjects(
+	ctx context.Context, p GetProjectsParam) (ps []dto.Project, err error) {
 	defer wrapError(&err, "get projects")
 
 	var tmpl []dto.Project
@@ -1309,6 +1936,7 @@ func (c *client) GetProjects(p GetProjectsParam) (ps []dto.Project, err error) {
 	}
 
 	err = c.paginate(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"v1/workspaces/%s/projects",
@@ -1374,7 +2002,7 @@ func parseColor(c string) (string, error) {
 }
 
 // AddProject adds a new project to a workspace
-func (c *client) AddProject(p AddProjectParam) (
+func (c *client) AddProject(ctx context.Context, p AddProjectParam) (
 	project dto.Project, err error) {
 	defer wrapError(&err, "add project")
 
@@ -1399,6 +2027,7 @@ func (c *client) AddProject(p AddProjectParam) (
 	}
 
 	req, err := c.NewRequest(
+		ctx,
 		"POST",
 		fmt.Sprintf(
 			"v1/workspaces/%s/projects",
@@ -1439,7 +2068,7 @@ type UpdateProjectParam struct {
 
 // UpdateProject will change properties of a Project, leave the property as nil
 // or "empty" to not change it
-func (c *client) UpdateProject(p UpdateProjectParam) (
+func (c *client) UpdateProject(ctx context.Context, p UpdateProjectParam) (
 	project dto.Project, err error) {
 	defer wrapError(&err, "update project")
 
@@ -1473,6 +2102,7 @@ func (c *client) UpdateProject(p UpdateProjectParam) (
 	}
 
 	req, err := c.NewRequest(
+		ctx,
 		"PUT",
 		"v1/workspaces/"+p.Workspace+"/projects/"+p.ProjectID,
 		dto.UpdateProjectRequest{
@@ -1511,8 +2141,9 @@ type UpdateProjectMembershipsParam struct {
 
 // UpdateProjectMemberships changes who has access to add time entries to
 // the project
-func (c *client) UpdateProjectMemberships(p UpdateProjectMembershipsParam) (
-	pr dto.Project, err error) {
+func (c *client) UpdateProjectMemberships(
+	ctx context.Context, p UpdateProjectMembershipsParam,
+) (pr dto.Project, err error) {
 	defer wrapError(&err, "update project memberships")
 
 	if err = required(map[field]string{
@@ -1546,6 +2177,7 @@ func (c *client) UpdateProjectMemberships(p UpdateProjectMembershipsParam) (
 	}
 
 	req, err := c.NewRequest(
+		ctx,
 		"PATCH",
 		"v1/workspaces/"+p.Workspace+"/projects/"+p.ProjectID+"/memberships",
 		dto.UpdateProjectMembershipsRequest{
@@ -1570,8 +2202,9 @@ type UpdateProjectTemplateParam struct {
 }
 
 // UpdateProjectTemplate changes if a project is a template or not
-func (c *client) UpdateProjectTemplate(p UpdateProjectTemplateParam) (
-	pr dto.Project, err error) {
+func (c *client) UpdateProjectTemplate(
+	ctx context.Context, p UpdateProjectTemplateParam,
+) (pr dto.Project, err error) {
 	defer wrapError(&err, "update project template")
 
 	if err = required(map[field]string{
@@ -1589,6 +2222,7 @@ func (c *client) UpdateProjectTemplate(p UpdateProjectTemplateParam) (
 	}
 
 	req, err := c.NewRequest(
+		ctx,
 		"PATCH",
 		"v1/workspaces/"+p.Workspace+"/projects/"+p.ProjectID+"/template",
 		dto.UpdateProjectTemplateRequest{
@@ -1604,43 +2238,101 @@ func (c *client) UpdateProjectTemplate(p UpdateProjectTemplateParam) (
 	return pr, err
 }
 
-// UpdateProjectUserRateParam sets the parameters to update the billable/cost
-// rate, if Since is not nil, then all time entries after that time will be
-// updated to new rate
-type UpdateProjectUserRateParam struct {
+// UpdateProjectRateParam sets the parameters to update the billable/cost
+// rate of a project, if Since is not nil, then all time entries after that
+// time will be updated to new rate
+type UpdateProjectRateParam struct {
 	Workspace string
 	ProjectID string
-	UserID    string
 	Amount    uint
 	Since     *time.Time
 }
 
-func (c *client) UpdateProjectUserBillableRate(
-	p UpdateProjectUserRateParam) (project dto.Project, err error) {
-	defer wrapError(&err, "update project user billable rate")
-
-	if err = required(map[field]string{
-		projectField:   p.ProjectID,
+func (p UpdateProjectRateParam) check() error {
+	if err := required(map[field]string{
 		workspaceField: p.Workspace,
-		userIDField:    p.UserID,
+		projectField:   p.ProjectID,
 	}); err != nil {
-		return
+		return err
 	}
 
-	if err = checkIDs(map[field]string{
-		projectField:   p.ProjectID,
+	return checkIDs(map[field]string{
 		workspaceField: p.Workspace,
-		userIDField:    p.UserID,
-	}); err != nil {
-		return
-	}
+		projectField:   p.ProjectID,
+	})
+}
 
-	var since *dto.DateTime
-	if p.Since != nil {
-		since = &dto.DateTime{Time: *p.Since}
+func (c *client) UpdateProjectBillableRate(
+	ctx context.Context, p UpdateProjectRateParam,
+) (project dto.Project, err error) {
+	defer wrapError(&err, "update project billable rate")
+
+	if err = p.check(); err != nil {
+		return project, err
 	}
 
-	req, err := c.NewRequest(
+	err = c.updateRate(
+		ctx,
+		"v1/workspaces/"+p.Workspace+"/projects/"+p.ProjectID+"/hourly-rate",
+		p.Amount, "", p.Since, &project, "UpdateProjectBillableRate")
+	return project, err
+}
+
+func (c *client) UpdateProjectCostRate(
+	ctx context.Context, p UpdateProjectRateParam,
+) (project dto.Project, err error) {
+	defer wrapError(&err, "update project cost rate")
+
+	if err = p.check(); err != nil {
+		return project, err
+	}
+
+	err = c.updateRate(
+		ctx,
+		"v1/workspaces/"+p.Workspace+"/projects/"+p.ProjectID+"/cost-rate",
+		p.Amount, "", p.Since, &project, "UpdateProjectCostRate")
+	return project, err
+}
+
+// UpdateProjectUserRateParam sets the parameters to update the billable/cost
+// rate, if Since is not nil, then all time entries after that time will be
+// updated to new rate
+type UpdateProjectUserRateParam struct {
+	Workspace string
+	ProjectID string
+	UserID    string
+	Amount    uint
+	Since     *time.Time
+}
+
+func (c *client) UpdateProjectUserBillableRate(
+	ctx context.Context,
+	p UpdateProjectUserRateParam) (project dto.Project, err error) {
+	defer wrapError(&err, "update project user billable rate")
+
+	if err = required(map[field]string{
+		projectField:   p.ProjectID,
+		workspaceField: p.Workspace,
+		userIDField:    p.UserID,
+	}); err != nil {
+		return
+	}
+
+	if err = checkIDs(map[field]string{
+		projectField:   p.ProjectID,
+		workspaceField: p.Workspace,
+		userIDField:    p.UserID,
+	}); err != nil {
+		return
+	}
+
+	var since *dto.DateTime
+	if p.Since != nil {
+		since = &dto.DateTime{Time: *p.Since}
+	}
+
+	req, err := c.NewRequest(
+		ctx,
 		"PUT",
 		"v1/workspaces/"+p.Workspace+"/projects/"+p.ProjectID+
 			"/users/"+p.UserID+"/hourly-rate",
@@ -1659,6 +2351,7 @@ func (c *client) UpdateProjectUserBillableRate(
 }
 
 func (c *client) UpdateProjectUserCostRate(
+	ctx context.Context,
 	p UpdateProjectUserRateParam) (project dto.Project, err error) {
 	defer wrapError(&err, "update project user cost rate")
 
@@ -1684,6 +2377,7 @@ func (c *client) UpdateProjectUserCostRate(
 	}
 
 	req, err := c.NewRequest(
+		ctx,
 		"PUT",
 		"v1/workspaces/"+p.Workspace+"/projects/"+p.ProjectID+
 			"/users/"+p.UserID+"/cost-rate",
@@ -1763,8 +2457,9 @@ type UpdateProjectEstimateParam struct {
 }
 
 // UpdateProjectEstimate change how the estime of a project is measured
-func (c *client) UpdateProjectEstimate(p UpdateProjectEstimateParam) (
-	r dto.Project, err error) {
+func (c *client) UpdateProjectEstimate(
+	ctx context.Context, p UpdateProjectEstimateParam,
+) (r dto.Project, err error) {
 	defer wrapError(&err, "update project estimate")
 
 	if err = required(map[field]string{
@@ -1840,6 +2535,7 @@ func (c *client) UpdateProjectEstimate(p UpdateProjectEstimateParam) (
 	}
 
 	req, err := c.NewRequest(
+		ctx,
 		"PATCH",
 		"v1/workspaces/"+p.Workspace+"/projects/"+p.ProjectID+"/estimate",
 		b,
@@ -1861,7 +2557,7 @@ type DeleteProjectParam struct {
 }
 
 // DeleteProject removes a project forever
-func (c *client) DeleteProject(p DeleteProjectParam) (
+func (c *client) DeleteProject(ctx context.Context, p DeleteProjectParam) (
 	pr dto.Project, err error) {
 	defer wrapError(&err, "delete project")
 
@@ -1879,6 +2575,7 @@ func (c *client) DeleteProject(p DeleteProjectParam) (
 	}
 
 	r, err := c.NewRequest(
+		ctx,
 		"DELETE",
 		"v1/workspaces/"+p.Workspace+"/projects/"+p.ProjectID,
 		nil,
@@ -1923,7 +2620,7 @@ type OutParam struct {
 }
 
 // Out create a new time entry
-func (c *client) Out(p OutParam) (err error) {
+func (c *client) Out(ctx context.Context, p OutParam) (err error) {
 	defer wrapError(&err, "end running time entry")
 
 	ids := map[field]string{
@@ -1940,6 +2637,7 @@ func (c *client) Out(p OutParam) (err error) {
 	}
 
 	r, err := c.NewRequest(
+		ctx,
 		"PATCH",
 		fmt.Sprintf(
 			"v1/workspaces/%s/user/%s/time-entries",
@@ -1961,19 +2659,20 @@ func (c *client) Out(p OutParam) (err error) {
 
 // UpdateTimeEntryParam params to update a new time entry
 type UpdateTimeEntryParam struct {
-	Workspace   string
-	TimeEntryID string
-	Start       time.Time
-	End         *time.Time
-	Billable    bool
-	Description string
-	ProjectID   string
-	TaskID      string
-	TagIDs      []string
+	Workspace    string
+	TimeEntryID  string
+	Start        time.Time
+	End          *time.Time
+	Billable     bool
+	Description  string
+	ProjectID    string
+	TaskID       string
+	TagIDs       []string
+	CustomFields []dto.CustomFieldValue
 }
 
 // UpdateTimeEntry update a time entry
-func (c *client) UpdateTimeEntry(p UpdateTimeEntryParam) (
+func (c *client) UpdateTimeEntry(ctx context.Context, p UpdateTimeEntryParam) (
 	t dto.TimeEntryImpl, err error) {
 	defer wrapError(&err, "update time entry \"%s\"", p.TimeEntryID)
 
@@ -1996,6 +2695,7 @@ func (c *client) UpdateTimeEntry(p UpdateTimeEntryParam) (
 	}
 
 	r, err := c.NewRequest(
+		ctx,
 		"PUT",
 		fmt.Sprintf(
 			"v1/workspaces/%s/time-entries/%s",
@@ -2010,6 +2710,8 @@ func (c *client) UpdateTimeEntry(p UpdateTimeEntryParam) (
 			ProjectID:   p.ProjectID,
 			TaskID:      p.TaskID,
 			TagIDs:      p.TagIDs,
+
+			CustomFields: p.CustomFields,
 		},
 	)
 
@@ -2028,7 +2730,8 @@ type DeleteTimeEntryParam struct {
 }
 
 // DeleteTimeEntry deletes a time entry
-func (c *client) DeleteTimeEntry(p DeleteTimeEntryParam) (err error) {
+func (c *client) DeleteTimeEntry(
+	ctx context.Context, p DeleteTimeEntryParam) (err error) {
 	defer wrapError(&err, "delete time entry \"%s\"", p.TimeEntryID)
 
 	ids := map[field]string{
@@ -2045,6 +2748,7 @@ func (c *client) DeleteTimeEntry(p DeleteTimeEntryParam) (err error) {
 	}
 
 	r, err := c.NewRequest(
+		ctx,
 		"DELETE",
 		fmt.Sprintf(
 			"v1/workspaces/%s/time-entries/%s",
@@ -2062,6 +2766,178 @@ func (c *client) DeleteTimeEntry(p DeleteTimeEntryParam) (err error) {
 	return err
 }
 
+// timeEntriesBatchSize is how many time entries are sent on each request to
+// the bulk endpoints, so the requests are not too large
+const timeEntriesBatchSize = 50
+
+// UpdateTimeEntriesParam params to update multiple time entries of a user
+// at once
+type UpdateTimeEntriesParam struct {
+	Workspace   string
+	UserID      string
+	TimeEntries []UpdateTimeEntryParam
+}
+
+// UpdateTimeEntries updates multiple time entries of a user, using one
+// request for each batch of them, instead of one for each time entry
+func (c *client) UpdateTimeEntries(
+	ctx context.Context, p UpdateTimeEntriesParam,
+) (tes []dto.TimeEntryImpl, err error) {
+	defer wrapError(&err, "update time entries")
+
+	ids := map[field]string{
+		workspaceField: p.Workspace,
+		userIDField:    p.UserID,
+	}
+
+	if err = required(ids); err != nil {
+		return tes, err
+	}
+
+	if err = checkIDs(ids); err != nil {
+		return tes, err
+	}
+
+	items := make([]dto.UpdateTimeEntriesItemRequest, len(p.TimeEntries))
+	for i, t := range p.TimeEntries {
+		ids := map[field]string{timeEntryIDField: t.TimeEntryID}
+		if err = required(ids); err != nil {
+			return tes, err
+		}
+
+		if err = checkIDs(ids); err != nil {
+			return tes, err
+		}
+
+		var end *dto.DateTime
+		if t.End != nil {
+			end = &dto.DateTime{Time: *t.End}
+		}
+
+		items[i] = dto.UpdateTimeEntriesItemRequest{
+			ID: t.TimeEntryID,
+			UpdateTimeEntryRequest: dto.UpdateTimeEntryRequest{
+				Start:       dto.DateTime{Time: t.Start},
+				End:         end,
+				Billable:    t.Billable,
+				Description: t.Description,
+				ProjectID:   t.ProjectID,
+				TaskID:      t.TaskID,
+				TagIDs:      t.TagIDs,
+
+				CustomFields: t.CustomFields,
+			},
+		}
+	}
+
+	tes = make([]dto.TimeEntryImpl, 0, len(items))
+	for len(items) > 0 {
+		n := timeEntriesBatchSize
+		if n > len(items) {
+			n = len(items)
+		}
+
+		r, err := c.NewRequest(
+			ctx,
+			"PUT",
+			fmt.Sprintf(
+				"v1/workspaces/%s/user/%s/time-entries",
+				p.Workspace,
+				p.UserID,
+			),
+			items[:n],
+		)
+		if err != nil {
+			return tes, err
+		}
+
+		var batch []dto.TimeEntryImpl
+		if _, err = c.Do(r, &batch, "UpdateTimeEntries"); err != nil {
+			return tes, err
+		}
+
+		tes = append(tes, batch...)
+		items = items[n:]
+	}
+
+	return tes, nil
+}
+
+// DeleteTimeEntriesParam params to delete multiple time entries of a user
+// at once
+type DeleteTimeEntriesParam struct {
+	Workspace    string
+	UserID       string
+	TimeEntryIDs []string
+}
+
+// DeleteTimeEntries deletes multiple time entries of a user, using one
+// request for each batch of them, instead of one for each time entry; it
+// returns the time entries deleted, as informed by the API
+func (c *client) DeleteTimeEntries(
+	ctx context.Context, p DeleteTimeEntriesParam,
+) (tes []dto.TimeEntryImpl, err error) {
+	defer wrapError(&err, "delete time entries")
+
+	ids := map[field]string{
+		workspaceField: p.Workspace,
+		userIDField:    p.UserID,
+	}
+
+	if err = required(ids); err != nil {
+		return tes, err
+	}
+
+	if err = checkIDs(ids); err != nil {
+		return tes, err
+	}
+
+	for _, id := range p.TimeEntryIDs {
+		ids := map[field]string{timeEntryIDField: id}
+		if err = required(ids); err != nil {
+			return tes, err
+		}
+
+		if err = checkIDs(ids); err != nil {
+			return tes, err
+		}
+	}
+
+	tes = make([]dto.TimeEntryImpl, 0, len(p.TimeEntryIDs))
+	teIDs := p.TimeEntryIDs
+	for len(teIDs) > 0 {
+		n := timeEntriesBatchSize
+		if n > len(teIDs) {
+			n = len(teIDs)
+		}
+
+		r, err := c.NewRequest(
+			ctx,
+			"DELETE",
+			fmt.Sprintf(
+				"v1/workspaces/%s/user/%s/time-entries?%s",
+				p.Workspace,
+				p.UserID,
+				url.Values{"time-entry-ids": teIDs[:n]}.Encode(),
+			),
+			nil,
+		)
+		if err != nil {
+			return tes, err
+		}
+
+		var batch []dto.TimeEntryImpl
+		if _, err = c.Do(r, &batch, "DeleteTimeEntries"); err != nil {
+			return tes, err
+		}
+
+		tes = append(tes, batch...)
+		teIDs = teIDs[n:]
+	}
+
+	return tes, nil
+}
+
 type ChangeInvoicedParam struct {
 	Workspace    string
 	TimeEntryIDs []string
@@ -2069,8 +2945,10 @@ type ChangeInvoicedParam struct {
 }
 
 // ChangeInvoiced changes time entries to invoiced or not
-func (c *client) ChangeInvoiced(p ChangeInvoicedParam) error {
+func (c *client) ChangeInvoiced(
+	ctx context.Context, p ChangeInvoicedParam) error {
 	r, err := c.NewRequest(
+		ctx,
 		"PATCH",
 		fmt.Sprintf(
 			"v1/workspaces/%s/time-entries/invoiced",
@@ -2089,3 +2967,1065 @@ func (c *client) ChangeInvoiced(p ChangeInvoicedParam) error {
 	_, err = c.Do(r, nil, "ChangeInvoiced")
 	return err
 }
+
+// GetWebhooksParam params to list the webhooks of a workspace
+type GetWebhooksParam struct {
+	Workspace string
+}
+
+// GetWebhooks lists the webhooks registered on the workspace
+func (c *client) GetWebhooks(ctx context.Context, p GetWebhooksParam) (
+	ws []dto.Webhook, err error) {
+	defer wrapError(&err, "get webhooks")
+
+	ids := map[field]string{workspaceField: p.Workspace}
+	if err = required(ids); err != nil {
+		return ws, err
+	}
+
+	if err = checkIDs(ids); err != nil {
+		return ws, err
+	}
+
+	r, err := c.NewRequest(
+		ctx,
+		"GET",
+		"v1/workspaces/"+p.Workspace+"/webhooks",
+		nil,
+	)
+	if err != nil {
+		return ws, err
+	}
+
+	var res struct {
+		Webhooks []dto.Webhook `json:"webhooks"`
+	}
+	if _, err = c.Do(r, &res, "GetWebhooks"); err != nil {
+		return ws, err
+	}
+
+	ws = res.Webhooks
+	if ws == nil {
+		ws = []dto.Webhook{}
+	}
+
+	return ws, nil
+}
+
+// GetWebhookParam identifies a webhook of a workspace
+type GetWebhookParam struct {
+	Workspace string
+	WebhookID string
+}
+
+// GetWebhook returns a webhook of the workspace
+func (c *client) GetWebhook(
+	ctx context.Context, p GetWebhookParam) (w dto.Webhook, err error) {
+	defer wrapError(&err, "get webhook")
+
+	ids := map[field]string{
+		workspaceField: p.Workspace,
+		webhookIDField: p.WebhookID,
+	}
+
+	if err = required(ids); err != nil {
+		return w, err
+	}
+
+	if err = checkIDs(ids); err != nil {
+		return w, err
+	}
+
+	r, err := c.NewRequest(
+		ctx,
+		"GET",
+		"v1/workspaces/"+p.Workspace+"/webhooks/"+p.WebhookID,
+		nil,
+	)
+	if err != nil {
+		return w, err
+	}
+
+	_, err = c.Do(r, &w, "GetWebhook")
+	return w, err
+}
+
+// AddWebhookParam params to register a webhook, when TriggerSourceType is
+// not set all changes on the workspace will trigger it
+type AddWebhookParam struct {
+	Workspace         string
+	Name              string
+	URL               string
+	Event             dto.WebhookEvent
+	TriggerSourceType dto.WebhookTriggerSourceType
+	TriggerSource     []string
+}
+
+// AddWebhook registers a webhook on the workspace
+func (c *client) AddWebhook(
+	ctx context.Context, p AddWebhookParam) (w dto.Webhook, err error) {
+	defer wrapError(&err, "add webhook")
+
+	if err = required(map[field]string{
+		workspaceField:    p.Workspace,
+		nameField:         p.Name,
+		urlField:          p.URL,
+		webhookEventField: string(p.Event),
+	}); err != nil {
+		return w, err
+	}
+
+	if err = checkIDs(map[field]string{
+		workspaceField: p.Workspace,
+	}); err != nil {
+		return w, err
+	}
+
+	if p.TriggerSourceType == "" {
+		p.TriggerSourceType = dto.WebhookTriggerSourceWorkspace
+		p.TriggerSource = []string{p.Workspace}
+	}
+
+	r, err := c.NewRequest(
+		ctx,
+		"POST",
+		"v1/workspaces/"+p.Workspace+"/webhooks",
+		dto.AddWebhookRequest{
+			Name:              p.Name,
+			URL:               p.URL,
+			WebhookEvent:      p.Event,
+			TriggerSourceType: p.TriggerSourceType,
+			TriggerSource:     p.TriggerSource,
+		},
+	)
+	if err != nil {
+		return w, err
+	}
+
+	_, err = c.Do(r, &w, "AddWebhook")
+	return w, err
+}
+
+// DeleteWebhookParam identifies which webhook to delete
+type DeleteWebhookParam struct {
+	Workspace string
+	WebhookID string
+}
+
+// DeleteWebhook removes a webhook from the workspace
+func (c *client) DeleteWebhook(ctx context.Context, p DeleteWebhookParam) (
+	w dto.Webhook, err error) {
+	defer wrapError(&err, "delete webhook")
+
+	ids := map[field]string{
+		workspaceField: p.Workspace,
+		webhookIDField: p.WebhookID,
+	}
+
+	if err = required(ids); err != nil {
+		return w, err
+	}
+
+	if err = checkIDs(ids); err != nil {
+		return w, err
+	}
+
+	r, err := c.NewRequest(
+		ctx,
+		"DELETE",
+		"v1/workspaces/"+p.Workspace+"/webhooks/"+p.WebhookID,
+		nil,
+	)
+	if err != nil {
+		return w, err
+	}
+
+	_, err = c.Do(r, &w, "DeleteWebhook")
+	return w, err
+}
+
+// GetCustomFieldsParam params to list the custom fields of a workspace
+type GetCustomFieldsParam struct {
+	Workspace string
+	Name      string
+}
+
+// GetCustomFields lists the custom fields of the workspace
+func (c *client) GetCustomFields(ctx context.Context, p GetCustomFieldsParam) (
+	cfs []dto.WorkspaceCustomField, err error) {
+	defer wrapError(&err, "get custom fields")
+
+	if err = checkWorkspace(p.Workspace); err != nil {
+		return cfs, err
+	}
+
+	r, err := c.NewRequest(
+		ctx,
+		"GET",
+		"v1/workspaces/"+p.Workspace+"/custom-fields",
+		dto.GetCustomFieldsRequest{Name: p.Name},
+	)
+	if err != nil {
+		return cfs, err
+	}
+
+	_, err = c.Do(r, &cfs, "GetCustomFields")
+	return cfs, err
+}
+
+// GetExpensesParam params to list the expenses of a workspace, when UserID
+// is set only the expenses of the user are listed
+type GetExpensesParam struct {
+	Workspace string
+	UserID    string
+
+	PaginationParam
+}
+
+// GetExpenses lists the expenses of the workspace
+func (c *client) GetExpenses(ctx context.Context, p GetExpensesParam) (
+	es []dto.Expense, err error) {
+	defer wrapError(&err, "get expenses")
+
+	if err = checkWorkspace(p.Workspace); err != nil {
+		return es, err
+	}
+
+	if p.UserID != "" {
+		if err = checkIDs(map[field]string{userIDField: p.UserID}); err != nil {
+			return es, err
+		}
+	}
+
+	var tmpl dto.GetExpensesResponse
+	err = c.paginate(
+		ctx,
+		"GET",
+		"v1/workspaces/"+p.Workspace+"/expenses",
+		p.PaginationParam,
+		dto.GetExpensesRequest{UserID: p.UserID},
+		&tmpl,
+		func(res interface{}) (int, error) {
+			if res == nil {
+				return 0, nil
+			}
+			ls := res.(*dto.GetExpensesResponse).Expenses.Expenses
+
+			es = append(es, ls...)
+			return len(ls), nil
+		},
+		"GetExpenses",
+	)
+	return es, err
+}
+
+// GetExpenseCategoriesParam params to list the expense categories
+type GetExpenseCategoriesParam struct {
+	Workspace string
+	Name      string
+	Archived  *bool
+
+	PaginationParam
+}
+
+// GetExpenseCategories lists the expense categories of the workspace
+func (c *client) GetExpenseCategories(
+	ctx context.Context, p GetExpenseCategoriesParam,
+) (cs []dto.ExpenseCategory, err error) {
+	defer wrapError(&err, "get expense categories")
+
+	if err = checkWorkspace(p.Workspace); err != nil {
+		return cs, err
+	}
+
+	var tmpl dto.GetExpenseCategoriesResponse
+	err = c.paginate(
+		ctx,
+		"GET",
+		"v1/workspaces/"+p.Workspace+"/expenses/categories",
+		p.PaginationParam,
+		dto.GetExpenseCategoriesRequest{
+			Name:     p.Name,
+			Archived: p.Archived,
+		},
+		&tmpl,
+		func(res interface{}) (int, error) {
+			if res == nil {
+				return 0, nil
+			}
+			ls := res.(*dto.GetExpenseCategoriesResponse).Categories
+
+			cs = append(cs, ls...)
+			return len(ls), nil
+		},
+		"GetExpenseCategories",
+	)
+	return cs, err
+}
+
+// AddExpenseParam params to record an expense, Amount is the total spent and
+// only the day of Date is used
+type AddExpenseParam struct {
+	Workspace  string
+	UserID     string
+	ProjectID  string
+	TaskID     string
+	CategoryID string
+	Date       time.Time
+	Amount     float64
+	Notes      string
+	Billable   bool
+}
+
+// AddExpense records an expense on the workspace
+func (c *client) AddExpense(
+	ctx context.Context, p AddExpenseParam) (e dto.Expense, err error) {
+	defer wrapError(&err, "add expense")
+
+	ids := map[field]string{
+		workspaceField: p.Workspace,
+		userIDField:    p.UserID,
+		projectField:   p.ProjectID,
+		categoryField:  p.CategoryID,
+	}
+
+	if err = required(ids); err != nil {
+		return e, err
+	}
+
+	if p.TaskID != "" {
+		ids[taskIDField] = p.TaskID
+	}
+
+	if err = checkIDs(ids); err != nil {
+		return e, err
+	}
+
+	fields := url.Values{
+		"userId":     {p.UserID},
+		"projectId":  {p.ProjectID},
+		"categoryId": {p.CategoryID},
+		"date":       {p.Date.Format("2006-01-02") + "T00:00:00Z"},
+		"amount":     {strconv.FormatFloat(p.Amount, 'f', -1, 64)},
+		"billable":   {strconv.FormatBool(p.Billable)},
+	}
+
+	if p.TaskID != "" {
+		fields.Set("taskId", p.TaskID)
+	}
+
+	if p.Notes != "" {
+		fields.Set("notes", p.Notes)
+	}
+
+	r, err := c.newMultipartRequest(
+		ctx,
+		"POST",
+		"v1/workspaces/"+p.Workspace+"/expenses",
+		fields,
+	)
+	if err != nil {
+		return e, err
+	}
+
+	_, err = c.Do(r, &e, "AddExpense")
+	return e, err
+}
+
+// DeleteExpenseParam identifies which expense to delete
+type DeleteExpenseParam struct {
+	Workspace string
+	ExpenseID string
+}
+
+// DeleteExpense removes an expense from the workspace
+func (c *client) DeleteExpense(
+	ctx context.Context, p DeleteExpenseParam) (err error) {
+	defer wrapError(&err, "delete expense")
+
+	ids := map[field]string{
+		workspaceField: p.Workspace,
+		expenseIDField: p.ExpenseID,
+	}
+
+	if err = required(ids); err != nil {
+		return err
+	}
+
+	if err = checkIDs(ids); err != nil {
+		return err
+	}
+
+	r, err := c.NewRequest(
+		ctx,
+		"DELETE",
+		"v1/workspaces/"+p.Workspace+"/expenses/"+p.ExpenseID,
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Do(r, nil, "DeleteExpense")
+	return err
+}
+
+// GetApprovalRequestsParam params to list the approval requests of a
+// workspace, filtering by status when it is set
+type GetApprovalRequestsParam struct {
+	Workspace string
+	Status    dto.ApprovalState
+
+	PaginationParam
+}
+
+// GetApprovalRequests lists the approval requests of the workspace
+func (c *client) GetApprovalRequests(
+	ctx context.Context, p GetApprovalRequestsParam,
+) (as []dto.ApprovalRequest, err error) {
+	defer wrapError(&err, "get approval requests")
+
+	if err = checkWorkspace(p.Workspace); err != nil {
+		return as, err
+	}
+
+	var tmpl dto.GetApprovalRequestsResponse
+	err = c.paginate(
+		ctx,
+		"GET",
+		"v1/workspaces/"+p.Workspace+"/approval-requests",
+		p.PaginationParam,
+		dto.GetApprovalRequestsRequest{Status: p.Status},
+		&tmpl,
+		func(res interface{}) (int, error) {
+			if res == nil {
+				return 0, nil
+			}
+			ls := *res.(*dto.GetApprovalRequestsResponse)
+
+			for i := range ls {
+				as = append(as, ls[i].ApprovalRequest)
+			}
+			return len(ls), nil
+		},
+		"GetApprovalRequests",
+	)
+	return as, err
+}
+
+// SubmitApprovalRequestParam params to submit the timesheet of the user for
+// the period starting at PeriodStart
+type SubmitApprovalRequestParam struct {
+	Workspace   string
+	Period      dto.ApprovalPeriod
+	PeriodStart time.Time
+}
+
+// SubmitApprovalRequest submits the timesheet of the user for approval
+func (c *client) SubmitApprovalRequest(
+	ctx context.Context, p SubmitApprovalRequestParam,
+) (a dto.ApprovalRequest, err error) {
+	defer wrapError(&err, "submit approval request")
+
+	if err = required(map[field]string{
+		workspaceField: p.Workspace,
+		periodField:    string(p.Period),
+	}); err != nil {
+		return a, err
+	}
+
+	if err = checkWorkspace(p.Workspace); err != nil {
+		return a, err
+	}
+
+	r, err := c.NewRequest(
+		ctx,
+		"POST",
+		"v1/workspaces/"+p.Workspace+"/approval-requests",
+		dto.SubmitApprovalRequest{
+			Period:      p.Period,
+			PeriodStart: dto.DateTime{Time: p.PeriodStart},
+		},
+	)
+	if err != nil {
+		return a, err
+	}
+
+	_, err = c.Do(r, &a, "SubmitApprovalRequest")
+	return a, err
+}
+
+// UpdateApprovalRequestParam params to approve, reject or withdraw a
+// approval request
+type UpdateApprovalRequestParam struct {
+	Workspace         string
+	ApprovalRequestID string
+	State             dto.ApprovalState
+	Note              string
+}
+
+// UpdateApprovalRequest changes the state of an approval request
+func (c *client) UpdateApprovalRequest(
+	ctx context.Context, p UpdateApprovalRequestParam,
+) (a dto.ApprovalRequest, err error) {
+	defer wrapError(&err, "update approval request")
+
+	ids := map[field]string{
+		workspaceField:  p.Workspace,
+		approvalIDField: p.ApprovalRequestID,
+	}
+
+	if err = required(ids); err != nil {
+		return a, err
+	}
+
+	if err = required(map[field]string{
+		approvalStateField: string(p.State),
+	}); err != nil {
+		return a, err
+	}
+
+	if err = checkIDs(ids); err != nil {
+		return a, err
+	}
+
+	r, err := c.NewRequest(
+		ctx,
+		"PATCH",
+		"v1/workspaces/"+p.Workspace+"/approval-requests/"+
+			p.ApprovalRequestID,
+		dto.UpdateApprovalRequest{
+			State: p.State,
+			Note:  p.Note,
+		},
+	)
+	if err != nil {
+		return a, err
+	}
+
+	_, err = c.Do(r, &a, "UpdateApprovalRequest")
+	return a, err
+}
+
+// GetTimeOffPoliciesParam params to list the time off policies of a
+// workspace
+type GetTimeOffPoliciesParam struct {
+	Workspace string
+	Name      string
+	Archived  *bool
+
+	PaginationParam
+}
+
+// GetTimeOffPolicies lists the time off policies of the workspace
+func (c *client) GetTimeOffPolicies(
+	ctx context.Context, p GetTimeOffPoliciesParam,
+) (ps []dto.TimeOffPolicy, err error) {
+	defer wrapError(&err, "get time off policies")
+
+	if err = checkWorkspace(p.Workspace); err != nil {
+		return ps, err
+	}
+
+	var tmpl []dto.TimeOffPolicy
+	err = c.paginate(
+		ctx,
+		"GET",
+		"v1/workspaces/"+p.Workspace+"/time-off/policies",
+		p.PaginationParam,
+		dto.GetTimeOffPoliciesRequest{
+			Name:     p.Name,
+			Archived: p.Archived,
+		},
+		&tmpl,
+		func(res interface{}) (int, error) {
+			if res == nil {
+				return 0, nil
+			}
+			ls := *res.(*[]dto.TimeOffPolicy)
+
+			ps = append(ps, ls...)
+			return len(ls), nil
+		},
+		"GetTimeOffPolicies",
+	)
+	return ps, err
+}
+
+// AddTimeOffRequestParam params to request time off on a policy, from the
+// Start until the End
+type AddTimeOffRequestParam struct {
+	Workspace string
+	PolicyID  string
+	Start     time.Time
+	End       time.Time
+	HalfDay   bool
+	Note      string
+}
+
+// AddTimeOffRequest requests time off for the user
+func (c *client) AddTimeOffRequest(
+	ctx context.Context, p AddTimeOffRequestParam,
+) (r dto.TimeOffRequest, err error) {
+	defer wrapError(&err, "add time off request")
+
+	ids := map[field]string{
+		workspaceField: p.Workspace,
+		policyIDField:  p.PolicyID,
+	}
+
+	if err = required(ids); err != nil {
+		return r, err
+	}
+
+	if err = checkIDs(ids); err != nil {
+		return r, err
+	}
+
+	if p.End.Before(p.Start) {
+		return r, errors.New("end must be after start")
+	}
+
+	req, err := c.NewRequest(
+		ctx,
+		"POST",
+		"v1/workspaces/"+p.Workspace+"/policies/"+p.PolicyID+"/requests",
+		dto.AddTimeOffRequest{
+			TimeOffPeriod: dto.AddTimeOffPeriod{
+				Period: dto.AddTimeOffRange{
+					Start: dto.DateTime{Time: p.Start},
+					End:   dto.DateTime{Time: p.End},
+				},
+				IsHalfDay: p.HalfDay,
+			},
+			Note: p.Note,
+		},
+	)
+	if err != nil {
+		return r, err
+	}
+
+	_, err = c.Do(req, &r, "AddTimeOffRequest")
+	return r, err
+}
+
+// GetTimeOffRequestsParam params to list the time off requests of a
+// workspace, the filters are only applied when set
+type GetTimeOffRequestsParam struct {
+	Workspace string
+	Start     *time.Time
+	End       *time.Time
+	Statuses  []dto.TimeOffStatus
+	Users     []string
+
+	PaginationParam
+}
+
+// GetTimeOffRequests lists the time off requests of the workspace
+func (c *client) GetTimeOffRequests(
+	ctx context.Context, p GetTimeOffRequestsParam,
+) (rs []dto.TimeOffRequest, err error) {
+	defer wrapError(&err, "get time off requests")
+
+	if err = checkWorkspace(p.Workspace); err != nil {
+		return rs, err
+	}
+
+	r := dto.GetTimeOffRequestsRequest{
+		Statuses: p.Statuses,
+		Users:    p.Users,
+	}
+
+	if p.Start != nil {
+		r.Start = &dto.DateTime{Time: *p.Start}
+	}
+
+	if p.End != nil {
+		r.End = &dto.DateTime{Time: *p.End}
+	}
+
+	var tmpl dto.GetTimeOffRequestsResponse
+	err = c.paginate(
+		ctx,
+		"POST",
+		"v1/workspaces/"+p.Workspace+"/requests",
+		p.PaginationParam,
+		r,
+		&tmpl,
+		func(res interface{}) (int, error) {
+			if res == nil {
+				return 0, nil
+			}
+			ls := res.(*dto.GetTimeOffRequestsResponse).Requests
+
+			rs = append(rs, ls...)
+			return len(ls), nil
+		},
+		"GetTimeOffRequests",
+	)
+	return rs, err
+}
+
+// GetTimeOffBalancesParam params to list the balances of a user on each
+// time off policy
+type GetTimeOffBalancesParam struct {
+	Workspace string
+	UserID    string
+
+	PaginationParam
+}
+
+// GetTimeOffBalances lists the balances of the user
+func (c *client) GetTimeOffBalances(
+	ctx context.Context, p GetTimeOffBalancesParam,
+) (bs []dto.TimeOffBalance, err error) {
+	defer wrapError(&err, "get time off balances")
+
+	ids := map[field]string{
+		workspaceField: p.Workspace,
+		userIDField:    p.UserID,
+	}
+
+	if err = required(ids); err != nil {
+		return bs, err
+	}
+
+	if err = checkIDs(ids); err != nil {
+		return bs, err
+	}
+
+	var tmpl dto.GetTimeOffBalancesResponse
+	err = c.paginate(
+		ctx,
+		"GET",
+		"v1/workspaces/"+p.Workspace+"/balance/user/"+p.UserID,
+		p.PaginationParam,
+		dto.GetTimeOffBalancesRequest{},
+		&tmpl,
+		func(res interface{}) (int, error) {
+			if res == nil {
+				return 0, nil
+			}
+			ls := res.(*dto.GetTimeOffBalancesResponse).Balances
+
+			bs = append(bs, ls...)
+			return len(ls), nil
+		},
+		"GetTimeOffBalances",
+	)
+	return bs, err
+}
+
+// GetAssignmentsParam params to list the assignments scheduled between
+// Start and End
+type GetAssignmentsParam struct {
+	Workspace string
+	Start     time.Time
+	End       time.Time
+
+	PaginationParam
+}
+
+// GetAssignments lists the assignments of all users of the workspace
+func (c *client) GetAssignments(ctx context.Context, p GetAssignmentsParam) (
+	as []dto.Assignment, err error) {
+	defer wrapError(&err, "get assignments")
+
+	if err = checkWorkspace(p.Workspace); err != nil {
+		return as, err
+	}
+
+	if p.End.Before(p.Start) {
+		return as, errors.New("end must be after start")
+	}
+
+	var tmpl []dto.Assignment
+	err = c.paginate(
+		ctx,
+		"GET",
+		"v1/workspaces/"+p.Workspace+"/scheduling/assignments/all",
+		p.PaginationParam,
+		dto.GetAssignmentsRequest{
+			Start: dto.DateTime{Time: p.Start},
+			End:   dto.DateTime{Time: p.End},
+		},
+		&tmpl,
+		func(res interface{}) (int, error) {
+			if res == nil {
+				return 0, nil
+			}
+			ls := *res.(*[]dto.Assignment)
+
+			as = append(as, ls...)
+			return len(ls), nil
+		},
+		"GetAssignments",
+	)
+	return as, err
+}
+
+// AddAssignmentParam params to schedule a user to work HoursPerDay on a
+// project, from Start until End
+type AddAssignmentParam struct {
+	Workspace             string
+	UserID                string
+	ProjectID             string
+	TaskID                string
+	Start                 time.Time
+	End                   time.Time
+	HoursPerDay           float64
+	Billable              bool
+	IncludeNonWorkingDays bool
+	Note                  string
+}
+
+// AddAssignment schedules a user on a project
+func (c *client) AddAssignment(ctx context.Context, p AddAssignmentParam) (
+	as []dto.Assignment, err error) {
+	defer wrapError(&err, "add assignment")
+
+	ids := map[field]string{
+		workspaceField: p.Workspace,
+		userIDField:    p.UserID,
+		projectField:   p.ProjectID,
+	}
+
+	if err = required(ids); err != nil {
+		return as, err
+	}
+
+	if p.TaskID != "" {
+		ids[taskIDField] = p.TaskID
+	}
+
+	if err = checkIDs(ids); err != nil {
+		return as, err
+	}
+
+	if p.End.Before(p.Start) {
+		return as, errors.New("end must be after start")
+	}
+
+	if p.HoursPerDay <= 0 {
+		return as, errors.New("hours per day must be greater than zero")
+	}
+
+	r, err := c.NewRequest(
+		ctx,
+		"POST",
+		"v1/workspaces/"+p.Workspace+"/scheduling/assignments/recurring",
+		dto.AddAssignmentRequest{
+			UserID:                p.UserID,
+			ProjectID:             p.ProjectID,
+			TaskID:                p.TaskID,
+			Start:                 dto.DateTime{Time: p.Start},
+			End:                   dto.DateTime{Time: p.End},
+			HoursPerDay:           p.HoursPerDay,
+			Billable:              p.Billable,
+			IncludeNonWorkingDays: p.IncludeNonWorkingDays,
+			Note:                  p.Note,
+		},
+	)
+	if err != nil {
+		return as, err
+	}
+
+	_, err = c.Do(r, &as, "AddAssignment")
+	return as, err
+}
+
+// GetUserGroupsParam params to list the user groups of a workspace
+type GetUserGroupsParam struct {
+	Workspace string
+	Name      string
+
+	PaginationParam
+}
+
+// GetUserGroups lists the user groups of the workspace
+func (c *client) GetUserGroups(ctx context.Context, p GetUserGroupsParam) (
+	gs []dto.UserGroup, err error) {
+	defer wrapError(&err, "get user groups")
+
+	if err = checkWorkspace(p.Workspace); err != nil {
+		return gs, err
+	}
+
+	var tmpl []dto.UserGroup
+	err = c.paginate(
+		ctx,
+		"GET",
+		"v1/workspaces/"+p.Workspace+"/user-groups",
+		p.PaginationParam,
+		dto.GetUserGroupsRequest{Name: p.Name},
+		&tmpl,
+		func(res interface{}) (int, error) {
+			if res == nil {
+				return 0, nil
+			}
+			ls := *res.(*[]dto.UserGroup)
+
+			gs = append(gs, ls...)
+			return len(ls), nil
+		},
+		"GetUserGroups",
+	)
+	return gs, err
+}
+
+// AddUserGroupParam params to create a user group
+type AddUserGroupParam struct {
+	Workspace string
+	Name      string
+}
+
+// AddUserGroup creates a user group on the workspace
+func (c *client) AddUserGroup(ctx context.Context, p AddUserGroupParam) (
+	g dto.UserGroup, err error) {
+	defer wrapError(&err, "add user group")
+
+	if err = required(map[field]string{
+		workspaceField: p.Workspace,
+		nameField:      p.Name,
+	}); err != nil {
+		return g, err
+	}
+
+	if err = checkWorkspace(p.Workspace); err != nil {
+		return g, err
+	}
+
+	r, err := c.NewRequest(
+		ctx,
+		"POST",
+		"v1/workspaces/"+p.Workspace+"/user-groups",
+		dto.AddUserGroupRequest{Name: p.Name},
+	)
+	if err != nil {
+		return g, err
+	}
+
+	_, err = c.Do(r, &g, "AddUserGroup")
+	return g, err
+}
+
+// UserGroupMemberParam identifies a user and the group to add or remove it
+// from
+type UserGroupMemberParam struct {
+	Workspace   string
+	UserGroupID string
+	UserID      string
+}
+
+func (p UserGroupMemberParam) check() error {
+	ids := map[field]string{
+		workspaceField:   p.Workspace,
+		userGroupIDField: p.UserGroupID,
+		userIDField:      p.UserID,
+	}
+
+	if err := required(ids); err != nil {
+		return err
+	}
+
+	return checkIDs(ids)
+}
+
+// AddUserToGroup adds a user to a user group
+func (c *client) AddUserToGroup(ctx context.Context, p UserGroupMemberParam) (
+	g dto.UserGroup, err error) {
+	defer wrapError(&err, "add user to group")
+
+	if err = p.check(); err != nil {
+		return g, err
+	}
+
+	r, err := c.NewRequest(
+		ctx,
+		"POST",
+		"v1/workspaces/"+p.Workspace+"/user-groups/"+p.UserGroupID+"/users",
+		dto.AddUserToGroupRequest{UserID: p.UserID},
+	)
+	if err != nil {
+		return g, err
+	}
+
+	_, err = c.Do(r, &g, "AddUserToGroup")
+	return g, err
+}
+
+// RemoveUserFromGroup removes a user from a user group
+func (c *client) RemoveUserFromGroup(
+	ctx context.Context, p UserGroupMemberParam) (g dto.UserGroup, err error) {
+	defer wrapError(&err, "remove user from group")
+
+	if err = p.check(); err != nil {
+		return g, err
+	}
+
+	r, err := c.NewRequest(
+		ctx,
+		"DELETE",
+		"v1/workspaces/"+p.Workspace+"/user-groups/"+p.UserGroupID+
+			"/users/"+p.UserID,
+		nil,
+	)
+	if err != nil {
+		return g, err
+	}
+
+	_, err = c.Do(r, &g, "RemoveUserFromGroup")
+	return g, err
+}
+
+// RawParam sets the request to be sent to the API
+type RawParam struct {
+	Method string
+	// Path is relative to the API url (like: /workspaces), and may have a
+	// query
+	Path string
+	// Body is sent as JSON, if not empty
+	Body json.RawMessage
+}
+
+// Raw sends a request as is to the API and returns its response
+func (c *client) Raw(
+	ctx context.Context, p RawParam) (r json.RawMessage, err error) {
+	defer wrapError(&err, "raw request")
+
+	p.Method = strings.ToUpper(p.Method)
+	if err = required(map[field]string{
+		methodField: p.Method,
+		pathField:   p.Path,
+	}); err != nil {
+		return
+	}
+
+	if err = shouldBeOneOf(methodField, p.Method, []string{
+		http.MethodGet,
+		http.MethodPost,
+		http.MethodPut,
+		http.MethodPatch,
+		http.MethodDelete,
+	}); err != nil {
+		return
+	}
+
+	var body interface{}
+	if len(p.Body) > 0 {
+		body = p.Body
+	}
+
+	path := strings.TrimPrefix(p.Path, "/")
+	if !strings.HasPrefix(path, "v1/") {
+		path = "v1/" + path
+	}
+
+	req, err := c.NewRequest(ctx, p.Method, path, body)
+	if err != nil {
+		return
+	}
+
+	_, err = c.Do(req, &r, "Raw")
+	return
+}
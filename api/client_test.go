@@ -1,6 +1,7 @@
 package api_test
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -272,3 +273,19 @@ func (h *httpRequest) getResponseStatus() int {
 func (h *httpRequest) getResponseBody() string {
 	return h.response
 }
+
+func TestNewClientFromUrlWithTrailingSlash(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/api/v1/user", r.URL.Path)
+			_, _ = w.Write([]byte(`{"id":"u1"}`))
+		}))
+	defer s.Close()
+
+	c, err := api.NewClientFromUrlAndKey("a-key", s.URL+"/api/")
+	assert.NoError(t, err)
+
+	u, err := c.GetMe(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "u1", u.ID)
+}
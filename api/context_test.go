@@ -0,0 +1,48 @@
+package api_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCancelStopsPagination(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	calls := 0
+	s := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls == 2 {
+				cancel()
+			}
+
+			tags := make([]string, 2)
+			for i := range tags {
+				tags[i] = fmt.Sprintf(`{"id":"t%d-%d"}`, calls, i)
+			}
+
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte("[" + strings.Join(tags, ",") + "]"))
+		}))
+	defer s.Close()
+
+	c, _ := api.NewClientFromUrlAndKey("a-key", s.URL)
+	_, err := c.GetTags(ctx, api.GetTagsParam{
+		Workspace: exampleID,
+		PaginationParam: api.PaginationParam{
+			AllPages: true,
+			PageSize: 2,
+		},
+	})
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 2, calls, "should stop after the context is cancelled")
+}
@@ -0,0 +1,64 @@
+package api_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+func TestGetCustomFields(t *testing.T) {
+	errPrefix := `get custom fields: `
+	uri := "/v1/workspaces/" + exampleID + "/custom-fields"
+
+	tts := []testCase{
+		&simpleTestCase{
+			name:  "requires workspace",
+			param: api.GetCustomFieldsParam{},
+			err:   errPrefix + "workspace is required",
+		},
+		&simpleTestCase{
+			name:  "all",
+			param: api.GetCustomFieldsParam{Workspace: exampleID},
+
+			result: []dto.WorkspaceCustomField{{
+				ID:            "cf1",
+				Name:          "Kind",
+				Type:          dto.CustomFieldTypeDropdownSingle,
+				Required:      true,
+				AllowedValues: []string{"Bug", "Feature"},
+			}},
+
+			requestMethod: "get",
+			requestUrl:    uri,
+
+			responseStatus: 200,
+			responseBody: `[{"id":"cf1","name":"Kind","required":true,
+				"type":"DROPDOWN_SINGLE","allowedValues":["Bug","Feature"]}]`,
+		},
+		&simpleTestCase{
+			name: "by name",
+			param: api.GetCustomFieldsParam{
+				Workspace: exampleID,
+				Name:      "Ticket",
+			},
+
+			result: []dto.WorkspaceCustomField{{ID: "cf2", Name: "Ticket"}},
+
+			requestMethod: "get",
+			requestUrl:    uri + "?name=Ticket",
+
+			responseStatus: 200,
+			responseBody:   `[{"id":"cf2","name":"Ticket"}]`,
+		},
+	}
+
+	for _, tt := range tts {
+		runClient(t, tt,
+			func(c api.Client, p interface{}) (interface{}, error) {
+				return c.GetCustomFields(context.Background(),
+					p.(api.GetCustomFieldsParam))
+			})
+	}
+}
@@ -9,6 +9,11 @@ import (
 type Error struct {
 	Message string `json:"message"`
 	Code    int    `json:"code"`
+	// Field is the field of the request that was not valid, when the API
+	// informs it
+	Field string `json:"field,omitempty"`
+	// Status is the HTTP status code of the response
+	Status int `json:"status,omitempty"`
 }
 
 func (e Error) Error() string {
@@ -116,6 +121,33 @@ type TimeEntry struct {
 	TotalBillable int64        `json:"totalBillable"`
 	User          *User        `json:"user"`
 	WorkspaceID   string       `json:"workspaceId"`
+
+	CustomFields []TimeEntryCustomField `json:"customFieldValues,omitempty"`
+
+	// ApprovalRequestID is set when the time entry was sent to approval
+	ApprovalRequestID *string `json:"approvalRequestId,omitempty"`
+}
+
+const (
+	ApprovalStatusApproved = "approved"
+	ApprovalStatusPending  = "pending"
+	ApprovalStatusLocked   = "locked"
+	ApprovalStatusOpen     = "open"
+)
+
+// ApprovalStatus informs if the time entry was approved, is pending
+// approval, is locked (without being approved) or is open to be edited
+func (t TimeEntry) ApprovalStatus() string {
+	switch {
+	case t.ApprovalRequestID != nil && t.IsLocked:
+		return ApprovalStatusApproved
+	case t.ApprovalRequestID != nil:
+		return ApprovalStatusPending
+	case t.IsLocked:
+		return ApprovalStatusLocked
+	default:
+		return ApprovalStatusOpen
+	}
 }
 
 // NewTimeInterval will create a TimeInterval from start and end times
@@ -152,6 +184,7 @@ type Tag struct {
 	ID          string `json:"id"`
 	Name        string `json:"name"`
 	WorkspaceID string `json:"workspaceId"`
+	Archived    bool   `json:"archived,omitempty"`
 }
 
 func (e Tag) GetID() string   { return e.ID }
@@ -169,18 +202,19 @@ const TaskStatusDone = TaskStatus("DONE")
 
 // Task DTO
 type Task struct {
-	AssigneeIDs  []string   `json:"assigneeIds"`
-	UserGroupIDs []string   `json:"userGroupIds"`
-	Estimate     *Duration  `json:"estimate"`
-	ID           string     `json:"id"`
-	Name         string     `json:"name"`
-	ProjectID    string     `json:"projectId"`
-	Billable     bool       `json:"billable"`
-	HourlyRate   *Rate      `json:"hourlyRate"`
-	CostRate     *Rate      `json:"costRate"`
-	Status       TaskStatus `json:"status"`
-	Duration     *Duration  `json:"duration"`
-	Favorite     bool       `json:"favorite"`
+	AssigneeIDs    []string   `json:"assigneeIds"`
+	UserGroupIDs   []string   `json:"userGroupIds"`
+	Estimate       *Duration  `json:"estimate"`
+	BudgetEstimate *uint64    `json:"budgetEstimate"`
+	ID             string     `json:"id"`
+	Name           string     `json:"name"`
+	ProjectID      string     `json:"projectId"`
+	Billable       bool       `json:"billable"`
+	HourlyRate     *Rate      `json:"hourlyRate"`
+	CostRate       *Rate      `json:"costRate"`
+	Status         TaskStatus `json:"status"`
+	Duration       *Duration  `json:"duration"`
+	Favorite       bool       `json:"favorite"`
 }
 
 func (e Task) GetID() string   { return e.ID }
@@ -206,6 +240,42 @@ type CustomField struct {
 	Value         string `json:"value"`
 }
 
+// TimeEntryCustomField DTO is the value of a custom field on a time entry,
+// the type of the value changes with the type of the custom field
+type TimeEntryCustomField struct {
+	CustomFieldID string      `json:"customFieldId"`
+	TimeEntryID   string      `json:"timeEntryId,omitempty"`
+	Name          string      `json:"name"`
+	Type          string      `json:"type"`
+	Value         interface{} `json:"value"`
+}
+
+// CustomFieldType is the kind of value a custom field accepts
+type CustomFieldType string
+
+const (
+	CustomFieldTypeText             = CustomFieldType("TXT")
+	CustomFieldTypeNumber           = CustomFieldType("NUMBER")
+	CustomFieldTypeLink             = CustomFieldType("LINK")
+	CustomFieldTypeCheckbox         = CustomFieldType("CHECKBOX")
+	CustomFieldTypeDropdownSingle   = CustomFieldType("DROPDOWN_SINGLE")
+	CustomFieldTypeDropdownMultiple = CustomFieldType("DROPDOWN_MULTIPLE")
+)
+
+// WorkspaceCustomField DTO is a custom field defined on the workspace
+type WorkspaceCustomField struct {
+	ID            string          `json:"id"`
+	Name          string          `json:"name"`
+	Type          CustomFieldType `json:"type"`
+	Status        string          `json:"status"`
+	Required      bool            `json:"required"`
+	AllowedValues []string        `json:"allowedValues"`
+	WorkspaceID   string          `json:"workspaceId"`
+}
+
+func (e WorkspaceCustomField) GetID() string   { return e.ID }
+func (e WorkspaceCustomField) GetName() string { return e.Name }
+
 // Project DTO
 type Project struct {
 	WorkspaceID string `json:"workspaceId"`
@@ -222,9 +292,9 @@ type Project struct {
 	CostRate   *Rate `json:"costRate"`
 	Billable   bool  `json:"billable"`
 
-	TimeEstimate   TimeEstimate `json:"timeEstimate"`
-	BudgetEstimate BaseEstimate `json:"budgetEstimate"`
-	Duration       *Duration    `json:"duration"`
+	TimeEstimate   TimeEstimate   `json:"timeEstimate"`
+	BudgetEstimate BudgetEstimate `json:"budgetEstimate"`
+	Duration       *Duration      `json:"duration"`
 
 	Archived bool `json:"archived"`
 	Template bool `json:"template"`
@@ -397,4 +467,272 @@ type TimeEntryImpl struct {
 	TimeInterval TimeInterval `json:"timeInterval"`
 	UserID       string       `json:"userId"`
 	WorkspaceID  string       `json:"workspaceId"`
+
+	CustomFields []TimeEntryCustomField `json:"customFieldValues,omitempty"`
+}
+
+// WebhookEvent is the event that triggers a webhook
+type WebhookEvent string
+
+const (
+	WebhookEventNewProject        = WebhookEvent("NEW_PROJECT")
+	WebhookEventNewTask           = WebhookEvent("NEW_TASK")
+	WebhookEventNewClient         = WebhookEvent("NEW_CLIENT")
+	WebhookEventNewTag            = WebhookEvent("NEW_TAG")
+	WebhookEventNewTimerStarted   = WebhookEvent("NEW_TIMER_STARTED")
+	WebhookEventTimerStopped      = WebhookEvent("TIMER_STOPPED")
+	WebhookEventNewTimeEntry      = WebhookEvent("NEW_TIME_ENTRY")
+	WebhookEventTimeEntryUpdated  = WebhookEvent("TIME_ENTRY_UPDATED")
+	WebhookEventTimeEntryDeleted  = WebhookEvent("TIME_ENTRY_DELETED")
+	WebhookEventUserJoined        = WebhookEvent("USER_JOINED_WORKSPACE")
+	WebhookEventUserDeleted       = WebhookEvent("USER_DELETED_FROM_WORKSPACE")
+	WebhookEventNewInvoice        = WebhookEvent("NEW_INVOICE")
+	WebhookEventInvoiceUpdated    = WebhookEvent("INVOICE_UPDATED")
+	WebhookEventNewApprovalReq    = WebhookEvent("NEW_APPROVAL_REQUEST")
+	WebhookEventApprovalReqStatus = WebhookEvent("APPROVAL_REQUEST_STATUS_UPDATED")
+)
+
+// WebhookEvents are the events known that can trigger a webhook
+var WebhookEvents = []WebhookEvent{
+	WebhookEventNewProject,
+	WebhookEventNewTask,
+	WebhookEventNewClient,
+	WebhookEventNewTag,
+	WebhookEventNewTimerStarted,
+	WebhookEventTimerStopped,
+	WebhookEventNewTimeEntry,
+	WebhookEventTimeEntryUpdated,
+	WebhookEventTimeEntryDeleted,
+	WebhookEventUserJoined,
+	WebhookEventUserDeleted,
+	WebhookEventNewInvoice,
+	WebhookEventInvoiceUpdated,
+	WebhookEventNewApprovalReq,
+	WebhookEventApprovalReqStatus,
+}
+
+// WebhookTriggerSourceType is the kind of entity that limits which changes
+// trigger a webhook
+type WebhookTriggerSourceType string
+
+const (
+	WebhookTriggerSourceWorkspace = WebhookTriggerSourceType("WORKSPACE_ID")
+	WebhookTriggerSourceProject   = WebhookTriggerSourceType("PROJECT_ID")
+	WebhookTriggerSourceUser      = WebhookTriggerSourceType("USER_ID")
+	WebhookTriggerSourceTag       = WebhookTriggerSourceType("TAG_ID")
+	WebhookTriggerSourceTask      = WebhookTriggerSourceType("TASK_ID")
+)
+
+// Webhook DTO
+type Webhook struct {
+	ID                string                   `json:"id"`
+	Name              string                   `json:"name"`
+	URL               string                   `json:"url"`
+	AuthToken         string                   `json:"authToken"`
+	Enabled           bool                     `json:"enabled"`
+	WebhookEvent      WebhookEvent             `json:"webhookEvent"`
+	TriggerSourceType WebhookTriggerSourceType `json:"triggerSourceType"`
+	TriggerSource     []string                 `json:"triggerSource"`
+	UserID            string                   `json:"userId"`
+	WorkspaceID       string                   `json:"workspaceId"`
+}
+
+func (e Webhook) GetID() string   { return e.ID }
+func (e Webhook) GetName() string { return e.Name }
+
+// ExpenseCategory DTO
+type ExpenseCategory struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	HasUnitPrice bool   `json:"hasUnitPrice"`
+	PriceInCents int64  `json:"priceInCents"`
+	Unit         string `json:"unit"`
+	Archived     bool   `json:"archived"`
+	WorkspaceID  string `json:"workspaceId"`
+}
+
+func (e ExpenseCategory) GetID() string   { return e.ID }
+func (e ExpenseCategory) GetName() string { return e.Name }
+
+// Expense DTO, Total is in cents
+type Expense struct {
+	ID          string           `json:"id"`
+	WorkspaceID string           `json:"workspaceId"`
+	UserID      string           `json:"userId"`
+	Date        time.Time        `json:"date"`
+	Notes       string           `json:"notes"`
+	Billable    bool             `json:"billable"`
+	Locked      bool             `json:"locked"`
+	Quantity    float64          `json:"quantity"`
+	Total       int64            `json:"total"`
+	ProjectID   string           `json:"projectId,omitempty"`
+	TaskID      string           `json:"taskId,omitempty"`
+	CategoryID  string           `json:"categoryId,omitempty"`
+	Project     *Project         `json:"project,omitempty"`
+	Task        *Task            `json:"task,omitempty"`
+	Category    *ExpenseCategory `json:"category,omitempty"`
+}
+
+// ApprovalState is the status of an approval request
+type ApprovalState string
+
+const (
+	ApprovalStatePending             = ApprovalState("PENDING")
+	ApprovalStateApproved            = ApprovalState("APPROVED")
+	ApprovalStateRejected            = ApprovalState("REJECTED")
+	ApprovalStateWithdrawnSubmission = ApprovalState("WITHDRAWN_SUBMISSION")
+	ApprovalStateWithdrawnApproval   = ApprovalState("WITHDRAWN_APPROVAL")
+)
+
+// ApprovalPeriod is the length of the timesheet submitted for approval
+type ApprovalPeriod string
+
+const (
+	ApprovalPeriodWeekly      = ApprovalPeriod("WEEKLY")
+	ApprovalPeriodSemiMonthly = ApprovalPeriod("SEMI_MONTHLY")
+	ApprovalPeriodMonthly     = ApprovalPeriod("MONTHLY")
+)
+
+// ApprovalDateRange is the period of an approval request
+type ApprovalDateRange struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// ApprovalOwner is the user who submitted the approval request
+type ApprovalOwner struct {
+	UserID   string `json:"userId"`
+	UserName string `json:"userName"`
+	TimeZone string `json:"timeZone"`
+}
+
+// ApprovalStatus is the current state of the approval request and who
+// changed it last
+type ApprovalStatus struct {
+	State             ApprovalState `json:"state"`
+	Note              string        `json:"note"`
+	UpdatedBy         string        `json:"updatedBy"`
+	UpdatedByUserName string        `json:"updatedByUserName"`
+	UpdatedAt         *time.Time    `json:"updatedAt"`
+}
+
+// ApprovalRequest DTO
+type ApprovalRequest struct {
+	ID          string            `json:"id"`
+	WorkspaceID string            `json:"workspaceId"`
+	DateRange   ApprovalDateRange `json:"dateRange"`
+	Owner       ApprovalOwner     `json:"owner"`
+	Status      ApprovalStatus    `json:"status"`
 }
+
+// TimeOffUnit is how a time off policy is measured
+type TimeOffUnit string
+
+const (
+	TimeOffUnitDays  = TimeOffUnit("DAYS")
+	TimeOffUnitHours = TimeOffUnit("HOURS")
+)
+
+// TimeOffStatus is the state of a time off request
+type TimeOffStatus string
+
+const (
+	TimeOffStatusPending  = TimeOffStatus("PENDING")
+	TimeOffStatusApproved = TimeOffStatus("APPROVED")
+	TimeOffStatusRejected = TimeOffStatus("REJECTED")
+)
+
+// TimeOffPolicy DTO
+type TimeOffPolicy struct {
+	ID                   string      `json:"id"`
+	Name                 string      `json:"name"`
+	TimeUnit             TimeOffUnit `json:"timeUnit"`
+	AllowHalfDay         bool        `json:"allowHalfDay"`
+	AllowNegativeBalance bool        `json:"allowNegativeBalance"`
+	Archived             bool        `json:"archived"`
+	WorkspaceID          string      `json:"workspaceId"`
+}
+
+func (e TimeOffPolicy) GetID() string   { return e.ID }
+func (e TimeOffPolicy) GetName() string { return e.Name }
+
+// TimeOffRange is the first and last moment of a time off
+type TimeOffRange struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// TimeOffPeriod is when the user will be off
+type TimeOffPeriod struct {
+	Period        TimeOffRange `json:"period"`
+	IsHalfDay     bool         `json:"isHalfDay"`
+	HalfDayPeriod string       `json:"halfDayPeriod,omitempty"`
+}
+
+// TimeOffRequestStatus is the state of the request and who changed it last
+type TimeOffRequestStatus struct {
+	StatusType      TimeOffStatus `json:"statusType"`
+	ChangedAt       *time.Time    `json:"changedAt"`
+	ChangedByUserID string        `json:"changedByUserId"`
+	Note            string        `json:"note"`
+}
+
+// TimeOffRequest DTO
+type TimeOffRequest struct {
+	ID            string               `json:"id"`
+	WorkspaceID   string               `json:"workspaceId"`
+	PolicyID      string               `json:"policyId"`
+	PolicyName    string               `json:"policyName"`
+	UserID        string               `json:"userId"`
+	UserName      string               `json:"userName"`
+	TimeOffPeriod TimeOffPeriod        `json:"timeOffPeriod"`
+	Status        TimeOffRequestStatus `json:"status"`
+	Note          string               `json:"note"`
+	BalanceDiff   float64              `json:"balanceDiff"`
+	TimeUnit      TimeOffUnit          `json:"timeUnit"`
+	CreatedAt     *time.Time           `json:"createdAt"`
+}
+
+// TimeOffBalance is how much time off a user has left on a policy
+type TimeOffBalance struct {
+	ID         string  `json:"id"`
+	PolicyID   string  `json:"policyId"`
+	PolicyName string  `json:"policyName"`
+	UserID     string  `json:"userId"`
+	UserName   string  `json:"userName"`
+	Balance    float64 `json:"balance"`
+	Used       float64 `json:"used"`
+	Total      float64 `json:"total"`
+}
+
+// AssignmentPeriod is the first and last day of an assignment
+type AssignmentPeriod struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// Assignment DTO, a user scheduled to work some hours per day on a project
+type Assignment struct {
+	ID                    string           `json:"id"`
+	WorkspaceID           string           `json:"workspaceId"`
+	UserID                string           `json:"userId"`
+	ProjectID             string           `json:"projectId"`
+	TaskID                string           `json:"taskId,omitempty"`
+	Period                AssignmentPeriod `json:"period"`
+	HoursPerDay           float64          `json:"hoursPerDay"`
+	Billable              bool             `json:"billable"`
+	IncludeNonWorkingDays bool             `json:"includeNonWorkingDays"`
+	Published             bool             `json:"published"`
+	Note                  string           `json:"note"`
+}
+
+// UserGroup DTO
+type UserGroup struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	WorkspaceID string   `json:"workspaceId"`
+	UserIDs     []string `json:"userIds"`
+}
+
+func (e UserGroup) GetID() string   { return e.ID }
+func (e UserGroup) GetName() string { return e.Name }
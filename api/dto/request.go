@@ -225,13 +225,11 @@ type CreateTimeEntryRequest struct {
 	CustomFields []CustomFieldValue `json:"customFields,omitempty"`
 }
 
-// CustomFieldValue DTO
+// CustomFieldValue DTO is the value to set on a custom field of a time
+// entry, the type of the value changes with the type of the custom field
 type CustomFieldValue struct {
-	CustomFieldID string `json:"customFieldId"`
-	Status        string `json:"status"`
-	Name          string `json:"name"`
-	Type          string `json:"type"`
-	Value         string `json:"value"`
+	CustomFieldID string      `json:"customFieldId"`
+	Value         interface{} `json:"value"`
 }
 
 // UpdateTimeEntryRequest to update a time entry
@@ -246,6 +244,13 @@ type UpdateTimeEntryRequest struct {
 	CustomFields []CustomFieldValue `json:"customFields,omitempty"`
 }
 
+// UpdateTimeEntriesItemRequest is one of the time entries changed by the
+// bulk edit endpoint
+type UpdateTimeEntriesItemRequest struct {
+	ID string `json:"id"`
+	UpdateTimeEntryRequest
+}
+
 type GetClientsRequest struct {
 	Name     string
 	Archived *bool
@@ -282,6 +287,10 @@ type AddClientRequest struct {
 	Name string `json:"name"`
 }
 
+type AddTagRequest struct {
+	Name string `json:"name"`
+}
+
 type GetProjectsRequest struct {
 	Name     string
 	Archived *bool
@@ -453,6 +462,7 @@ type AddTaskRequest struct {
 	AssigneeIDs *[]string `json:"assigneeIds,omitempty"`
 	Billable    *bool     `json:"billable,omitempty"`
 	Estimate    *Duration `json:"estimate,omitempty"`
+	Budget      *uint64   `json:"budgetEstimate,omitempty"`
 	Status      *string   `json:"status,omitempty"`
 }
 
@@ -461,6 +471,7 @@ type UpdateTaskRequest struct {
 	AssigneeIDs *[]string `json:"assigneeIds,omitempty"`
 	Billable    *bool     `json:"billable,omitempty"`
 	Estimate    *Duration `json:"estimate,omitempty"`
+	Budget      *uint64   `json:"budgetEstimate,omitempty"`
 	Status      *string   `json:"status,omitempty"`
 }
 
@@ -502,6 +513,14 @@ type UpdateProjectUserRateRequest struct {
 	Since  *DateTime `json:"since,omitempty"`
 }
 
+// UpdateRateRequest represents a request to change the billable or cost
+// rate of a workspace, user or project
+type UpdateRateRequest struct {
+	Amount   uint      `json:"amount"`
+	Currency string    `json:"currency,omitempty"`
+	Since    *DateTime `json:"since,omitempty"`
+}
+
 // BaseEstimateRequest is basic information to estime a project
 type BaseEstimateRequest struct {
 	Type         *EstimateType        `json:"type,omitempty"`
@@ -527,3 +546,325 @@ type UpdateProjectEstimateRequest struct {
 	TimeEstimate   TimeEstimateRequest   `json:"timeEstimate"`
 	BudgetEstimate BudgetEstimateRequest `json:"budgetEstimate"`
 }
+
+// AddWebhookRequest represents a request to register a webhook
+type AddWebhookRequest struct {
+	Name              string                   `json:"name"`
+	URL               string                   `json:"url"`
+	WebhookEvent      WebhookEvent             `json:"webhookEvent"`
+	TriggerSourceType WebhookTriggerSourceType `json:"triggerSourceType"`
+	TriggerSource     []string                 `json:"triggerSource"`
+}
+
+// GetCustomFieldsRequest query to list the custom fields of a workspace
+type GetCustomFieldsRequest struct {
+	Name string
+}
+
+// AppendToQuery decorates the URL with the query string needed for this Request
+func (r GetCustomFieldsRequest) AppendToQuery(u *url.URL) *url.URL {
+	if r.Name == "" {
+		return u
+	}
+
+	v := u.Query()
+	v.Add("name", r.Name)
+	u.RawQuery = v.Encode()
+
+	return u
+}
+
+// GetExpensesRequest query to list the expenses of a workspace
+type GetExpensesRequest struct {
+	UserID string
+
+	pagination
+}
+
+// WithPagination add pagination to the GetExpensesRequest
+func (r GetExpensesRequest) WithPagination(page, size int) PaginatedRequest {
+	r.pagination = newPagination(page, size)
+	return r
+}
+
+// AppendToQuery decorates the URL with the query string needed for this Request
+func (r GetExpensesRequest) AppendToQuery(u *url.URL) *url.URL {
+	u = r.pagination.AppendToQuery(u)
+
+	if r.UserID == "" {
+		return u
+	}
+
+	v := u.Query()
+	v.Add("user-id", r.UserID)
+	u.RawQuery = v.Encode()
+
+	return u
+}
+
+// GetExpensesResponse is a page of expenses
+type GetExpensesResponse struct {
+	Expenses struct {
+		Count    int       `json:"count"`
+		Expenses []Expense `json:"expenses"`
+	} `json:"expenses"`
+}
+
+// GetExpenseCategoriesRequest query to list the expense categories
+type GetExpenseCategoriesRequest struct {
+	Name     string
+	Archived *bool
+
+	pagination
+}
+
+// WithPagination add pagination to the GetExpenseCategoriesRequest
+func (r GetExpenseCategoriesRequest) WithPagination(
+	page, size int) PaginatedRequest {
+	r.pagination = newPagination(page, size)
+	return r
+}
+
+// AppendToQuery decorates the URL with the query string needed for this Request
+func (r GetExpenseCategoriesRequest) AppendToQuery(u *url.URL) *url.URL {
+	u = r.pagination.AppendToQuery(u)
+
+	v := u.Query()
+	if r.Name != "" {
+		v.Add("name", r.Name)
+	}
+	if r.Archived != nil {
+		v.Add("archived", boolString[*r.Archived])
+	}
+	u.RawQuery = v.Encode()
+
+	return u
+}
+
+// GetExpenseCategoriesResponse is a page of expense categories
+type GetExpenseCategoriesResponse struct {
+	Count      int               `json:"count"`
+	Categories []ExpenseCategory `json:"categories"`
+}
+
+// GetApprovalRequestsRequest query to list the approval requests
+type GetApprovalRequestsRequest struct {
+	Status ApprovalState
+
+	pagination
+}
+
+// WithPagination add pagination to the GetApprovalRequestsRequest
+func (r GetApprovalRequestsRequest) WithPagination(
+	page, size int) PaginatedRequest {
+	r.pagination = newPagination(page, size)
+	return r
+}
+
+// AppendToQuery decorates the URL with the query string needed for this Request
+func (r GetApprovalRequestsRequest) AppendToQuery(u *url.URL) *url.URL {
+	u = r.pagination.AppendToQuery(u)
+
+	if r.Status == "" {
+		return u
+	}
+
+	v := u.Query()
+	v.Add("status", string(r.Status))
+	u.RawQuery = v.Encode()
+
+	return u
+}
+
+// GetApprovalRequestsResponse is a page of approval requests
+type GetApprovalRequestsResponse []struct {
+	ApprovalRequest ApprovalRequest `json:"approvalRequest"`
+}
+
+// SubmitApprovalRequest represents a request to submit a timesheet
+type SubmitApprovalRequest struct {
+	Period      ApprovalPeriod `json:"period"`
+	PeriodStart DateTime       `json:"periodStart"`
+}
+
+// UpdateApprovalRequest represents a request to change the state of a
+// approval request
+type UpdateApprovalRequest struct {
+	State ApprovalState `json:"state"`
+	Note  string        `json:"note,omitempty"`
+}
+
+// GetTimeOffPoliciesRequest query to list the time off policies
+type GetTimeOffPoliciesRequest struct {
+	Name     string
+	Archived *bool
+
+	pagination
+}
+
+// WithPagination add pagination to the GetTimeOffPoliciesRequest
+func (r GetTimeOffPoliciesRequest) WithPagination(
+	page, size int) PaginatedRequest {
+	r.pagination = newPagination(page, size)
+	return r
+}
+
+// AppendToQuery decorates the URL with the query string needed for this Request
+func (r GetTimeOffPoliciesRequest) AppendToQuery(u *url.URL) *url.URL {
+	u = r.pagination.AppendToQuery(u)
+
+	v := u.Query()
+	if r.Name != "" {
+		v.Add("name", r.Name)
+	}
+	if r.Archived != nil {
+		status := "ACTIVE"
+		if *r.Archived {
+			status = "ARCHIVED"
+		}
+		v.Add("status", status)
+	}
+	u.RawQuery = v.Encode()
+
+	return u
+}
+
+// AddTimeOffRequest represents a request to take time off
+type AddTimeOffRequest struct {
+	TimeOffPeriod AddTimeOffPeriod `json:"timeOffPeriod"`
+	Note          string           `json:"note,omitempty"`
+}
+
+// AddTimeOffPeriod is when the user wants to be off
+type AddTimeOffPeriod struct {
+	Period    AddTimeOffRange `json:"period"`
+	IsHalfDay bool            `json:"isHalfDay"`
+}
+
+// AddTimeOffRange is the first and last moment of the time off
+type AddTimeOffRange struct {
+	Start DateTime `json:"start"`
+	End   DateTime `json:"end"`
+}
+
+// GetTimeOffRequestsRequest filters the time off requests, it is sent as
+// the body of the request
+type GetTimeOffRequestsRequest struct {
+	Start    *DateTime       `json:"start,omitempty"`
+	End      *DateTime       `json:"end,omitempty"`
+	Statuses []TimeOffStatus `json:"statuses,omitempty"`
+	Users    []string        `json:"users,omitempty"`
+	Page     int             `json:"page"`
+	PageSize int             `json:"pageSize"`
+}
+
+// WithPagination add pagination to the GetTimeOffRequestsRequest
+func (r GetTimeOffRequestsRequest) WithPagination(
+	page, size int) PaginatedRequest {
+	r.Page = page
+	r.PageSize = size
+	return r
+}
+
+// GetTimeOffRequestsResponse is a page of time off requests
+type GetTimeOffRequestsResponse struct {
+	Count    int              `json:"count"`
+	Requests []TimeOffRequest `json:"requests"`
+}
+
+// GetTimeOffBalancesRequest query to list the balances of a user
+type GetTimeOffBalancesRequest struct {
+	pagination
+}
+
+// WithPagination add pagination to the GetTimeOffBalancesRequest
+func (r GetTimeOffBalancesRequest) WithPagination(
+	page, size int) PaginatedRequest {
+	r.pagination = newPagination(page, size)
+	return r
+}
+
+// GetTimeOffBalancesResponse is a page of time off balances
+type GetTimeOffBalancesResponse struct {
+	Count    int              `json:"count"`
+	Balances []TimeOffBalance `json:"balances"`
+}
+
+// GetAssignmentsRequest query to list the assignments of a period
+type GetAssignmentsRequest struct {
+	Start DateTime
+	End   DateTime
+
+	pagination
+}
+
+// WithPagination add pagination to the GetAssignmentsRequest
+func (r GetAssignmentsRequest) WithPagination(
+	page, size int) PaginatedRequest {
+	r.pagination = newPagination(page, size)
+	return r
+}
+
+// AppendToQuery decorates the URL with the query string needed for this Request
+func (r GetAssignmentsRequest) AppendToQuery(u *url.URL) *url.URL {
+	u = r.pagination.AppendToQuery(u)
+
+	v := u.Query()
+	v.Add("start", r.Start.String())
+	v.Add("end", r.End.String())
+	u.RawQuery = v.Encode()
+
+	return u
+}
+
+// AddAssignmentRequest represents a request to schedule a user on a project
+type AddAssignmentRequest struct {
+	UserID                string   `json:"userId"`
+	ProjectID             string   `json:"projectId"`
+	TaskID                string   `json:"taskId,omitempty"`
+	Start                 DateTime `json:"start"`
+	End                   DateTime `json:"end"`
+	HoursPerDay           float64  `json:"hoursPerDay"`
+	Billable              bool     `json:"billable"`
+	IncludeNonWorkingDays bool     `json:"includeNonWorkingDays"`
+	Note                  string   `json:"note,omitempty"`
+}
+
+// GetUserGroupsRequest query to list the user groups of a workspace
+type GetUserGroupsRequest struct {
+	Name string
+
+	pagination
+}
+
+// WithPagination add pagination to the GetUserGroupsRequest
+func (r GetUserGroupsRequest) WithPagination(
+	page, size int) PaginatedRequest {
+	r.pagination = newPagination(page, size)
+	return r
+}
+
+// AppendToQuery decorates the URL with the query string needed for this Request
+func (r GetUserGroupsRequest) AppendToQuery(u *url.URL) *url.URL {
+	u = r.pagination.AppendToQuery(u)
+
+	if r.Name == "" {
+		return u
+	}
+
+	v := u.Query()
+	v.Add("name", r.Name)
+	u.RawQuery = v.Encode()
+
+	return u
+}
+
+// AddUserGroupRequest represents a request to create a user group
+type AddUserGroupRequest struct {
+	Name string `json:"name"`
+}
+
+// AddUserToGroupRequest represents a request to add a user into a group
+type AddUserToGroupRequest struct {
+	UserID string `json:"userId"`
+}
@@ -0,0 +1,75 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// ErrorKind classifies the errors returned by the API, so they can be
+// handled without looking at their messages
+type ErrorKind string
+
+const (
+	// ErrorKindUnknown is used for errors not returned by the API
+	ErrorKindUnknown      ErrorKind = ""
+	ErrorKindUnauthorized ErrorKind = "unauthorized"
+	ErrorKindForbidden    ErrorKind = "forbidden"
+	ErrorKindNotFound     ErrorKind = "not-found"
+	ErrorKindInvalid      ErrorKind = "invalid"
+	ErrorKindArchived     ErrorKind = "archived"
+	ErrorKindRateLimited  ErrorKind = "rate-limited"
+	ErrorKindServer       ErrorKind = "server"
+)
+
+// ErrorKindOf returns the kind of the API error wrapped by err, or
+// ErrorKindUnknown if it is not one
+func ErrorKindOf(err error) ErrorKind {
+	var e dto.Error
+	if !errors.As(err, &e) {
+		return ErrorKindUnknown
+	}
+
+	// Clockify uses its own codes for business errors, so the message is the
+	// only way to know the entity is archived
+	if strings.Contains(strings.ToLower(e.Message), "archived") {
+		return ErrorKindArchived
+	}
+
+	s := e.Status
+	if s == 0 {
+		s = e.Code
+	}
+
+	switch {
+	case s == 401:
+		return ErrorKindUnauthorized
+	case s == 403:
+		return ErrorKindForbidden
+	case s == 404:
+		return ErrorKindNotFound
+	case s == 429:
+		return ErrorKindRateLimited
+	case e.Status >= 500:
+		return ErrorKindServer
+	default:
+		return ErrorKindInvalid
+	}
+}
+
+// IsUnreachable returns true when err is because the API could not be
+// reached (no connection, name resolution or timeouts), instead of an error
+// returned by it
+func IsUnreachable(err error) bool {
+	var ue *url.Error
+	if !errors.As(err, &ue) || errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	var ne net.Error
+	return errors.As(ue.Err, &ne)
+}
@@ -0,0 +1,114 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorKindOf(t *testing.T) {
+	tts := []struct {
+		name   string
+		status int
+		body   string
+		kind   api.ErrorKind
+		err    dto.Error
+	}{
+		{
+			name:   "unauthorized",
+			status: http.StatusUnauthorized,
+			body:   `{"message":"Full authentication is required","code":1000}`,
+			kind:   api.ErrorKindUnauthorized,
+			err: dto.Error{
+				Message: "Full authentication is required",
+				Code:    1000,
+				Status:  401,
+			},
+		},
+		{
+			name:   "not found without body",
+			status: http.StatusNotFound,
+			kind:   api.ErrorKindNotFound,
+			err:    dto.Error{Message: "Nothing was found", Code: 404, Status: 404},
+		},
+		{
+			name:   "archived project",
+			status: http.StatusBadRequest,
+			body:   `{"message":"Project is archived","code":501}`,
+			kind:   api.ErrorKindArchived,
+			err: dto.Error{
+				Message: "Project is archived", Code: 501, Status: 400},
+		},
+		{
+			name:   "invalid field",
+			status: http.StatusBadRequest,
+			body:   `{"message":"must not be blank","code":501,"field":"name"}`,
+			kind:   api.ErrorKindInvalid,
+			err: dto.Error{
+				Message: "must not be blank",
+				Code:    501,
+				Field:   "name",
+				Status:  400,
+			},
+		},
+		{
+			name:   "server",
+			status: http.StatusInternalServerError,
+			body:   `{"message":"oops","code":500}`,
+			kind:   api.ErrorKindServer,
+			err:    dto.Error{Message: "oops", Code: 500, Status: 500},
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			s := httptest.NewServer(http.HandlerFunc(
+				func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(tt.status)
+					_, _ = w.Write([]byte(tt.body))
+				}))
+			defer s.Close()
+
+			c, _ := api.NewClientFromUrlAndKey("a-key", s.URL)
+			c.SetMaxRetries(0)
+
+			_, err := c.GetMe(context.Background())
+			assert.Equal(t, tt.kind, api.ErrorKindOf(err))
+
+			var e dto.Error
+			if assert.True(t, errors.As(err, &e)) {
+				assert.Equal(t, tt.err, e)
+			}
+		})
+	}
+
+	assert.Equal(t, api.ErrorKindUnknown,
+		api.ErrorKindOf(errors.New("not from the api")))
+	assert.Equal(t, api.ErrorKindNotFound, api.ErrorKindOf(
+		api.EntityNotFound{EntityName: "project", ID: "p1"}))
+}
+
+func TestIsUnreachable(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+	c, _ := api.NewClientFromUrlAndKey("a-key", s.URL)
+	c.SetMaxRetries(0)
+
+	_, err := c.GetMe(context.Background())
+	assert.False(t, api.IsUnreachable(err), "the API answered")
+
+	s.Close()
+	_, err = c.GetMe(context.Background())
+	assert.True(t, api.IsUnreachable(err), "the API is down")
+
+	assert.False(t, api.IsUnreachable(errors.New("not from the api")))
+}
@@ -0,0 +1,121 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetExpenses(t *testing.T) {
+	errPrefix := `get expenses: `
+	uri := "/v1/workspaces/" + exampleID + "/expenses"
+
+	tts := []testCase{
+		&simpleTestCase{
+			name:  "requires workspace",
+			param: api.GetExpensesParam{},
+			err:   errPrefix + "workspace is required",
+		},
+		&simpleTestCase{
+			name: "valid user",
+			param: api.GetExpensesParam{
+				Workspace: exampleID,
+				UserID:    "u",
+			},
+			err: errPrefix + "user id .* is not valid ID",
+		},
+		(&multiRequestTestCase{
+			name: "all pages",
+			param: api.GetExpensesParam{
+				Workspace:       exampleID,
+				UserID:          exampleID,
+				PaginationParam: api.AllPages(),
+			},
+			result: []dto.Expense{
+				{ID: "e1", Total: 1050},
+				{ID: "e2", Total: 200},
+			},
+		}).
+			addHttpCall(&httpRequest{
+				method: "get",
+				url: uri + "?page=1&page-size=50&user-id=" +
+					exampleID,
+				status: 200,
+				response: `{"expenses":{"count":2,"expenses":[
+					{"id":"e1","total":1050},
+					{"id":"e2","total":200}
+				]}}`,
+			}).
+			addHttpCall(&httpRequest{
+				method: "get",
+				url: uri + "?page=2&page-size=50&user-id=" +
+					exampleID,
+				status:   200,
+				response: `{"expenses":{"count":0,"expenses":[]}}`,
+			}),
+	}
+
+	for _, tt := range tts {
+		runClient(t, tt,
+			func(c api.Client, p interface{}) (interface{}, error) {
+				return c.GetExpenses(context.Background(),
+					p.(api.GetExpensesParam))
+			})
+	}
+}
+
+func TestAddExpense(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "POST", r.Method)
+			assert.Equal(t,
+				"/v1/workspaces/"+exampleID+"/expenses", r.URL.Path)
+
+			if !assert.NoError(t, r.ParseMultipartForm(1024)) {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			assert.Equal(t, map[string][]string{
+				"userId":     {exampleID},
+				"projectId":  {exampleID},
+				"categoryId": {exampleID},
+				"date":       {"2022-03-01T00:00:00Z"},
+				"amount":     {"10.5"},
+				"billable":   {"true"},
+				"notes":      {"lunch"},
+			}, r.MultipartForm.Value)
+
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"e1","total":1050}`))
+		}))
+	defer s.Close()
+
+	c, _ := api.NewClientFromUrlAndKey("a-key", s.URL)
+
+	_, err := c.AddExpense(context.Background(), api.AddExpenseParam{
+		Workspace: exampleID,
+		UserID:    exampleID,
+		ProjectID: exampleID,
+	})
+	assert.EqualError(t, err, "add expense: category id is required")
+
+	e, err := c.AddExpense(context.Background(), api.AddExpenseParam{
+		Workspace:  exampleID,
+		UserID:     exampleID,
+		ProjectID:  exampleID,
+		CategoryID: exampleID,
+		Date:       time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC),
+		Amount:     10.5,
+		Notes:      "lunch",
+		Billable:   true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, dto.Expense{ID: "e1", Total: 1050}, e)
+}
@@ -2,10 +2,13 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"net/url"
+	"sort"
 
 	"github.com/lucassabreu/clockify-cli/api/dto"
 	"github.com/pkg/errors"
@@ -34,7 +37,9 @@ func (t transport) RoundTrip(r *http.Request) (*http.Response, error) {
 }
 
 // NewRequest to be used in Client
-func (c *client) NewRequest(method, uri string, body interface{}) (*http.Request, error) {
+func (c *client) NewRequest(
+	ctx context.Context, method, uri string, body interface{},
+) (*http.Request, error) {
 	u, err := c.baseURL.Parse(c.baseURL.Path + "/" + uri)
 	if err != nil {
 		return nil, err
@@ -58,7 +63,7 @@ func (c *client) NewRequest(method, uri string, body interface{}) (*http.Request
 		c.infof("request body: %s", buf.(*bytes.Buffer))
 	}
 
-	req, err := http.NewRequest(method, u.String(), buf)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), buf)
 	if err != nil {
 		return nil, err
 	}
@@ -71,6 +76,47 @@ func (c *client) NewRequest(method, uri string, body interface{}) (*http.Request
 	return req, nil
 }
 
+// newMultipartRequest creates a request to be used in Client with the fields
+// sent as multipart/form-data, which some endpoints require
+func (c *client) newMultipartRequest(
+	ctx context.Context, method, uri string, fields url.Values,
+) (*http.Request, error) {
+	u, err := c.baseURL.Parse(c.baseURL.Path + "/" + uri)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	buf := new(bytes.Buffer)
+	mw := multipart.NewWriter(buf)
+	for _, k := range keys {
+		for _, v := range fields[k] {
+			if err := mw.WriteField(k, v); err != nil {
+				return nil, errors.WithStack(err)
+			}
+		}
+	}
+
+	if err := mw.Close(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	c.infof("request body: %s", fields.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), buf)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	req.Header.Set("Accept", "application/json")
+	return req, nil
+}
+
 // Do executes a http.Request inside the Clockify's Client
 func (c *client) Do(
 	req *http.Request, v interface{}, name string) (*http.Response, error) {
@@ -115,6 +161,7 @@ func (c *client) Do(
 		if apiErr.Message == "" {
 			apiErr.Message = "No response"
 		}
+		apiErr.Status = r.StatusCode
 
 		return r, errors.WithStack(apiErr)
 	}
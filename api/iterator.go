@@ -0,0 +1,49 @@
+package api
+
+import "github.com/lucassabreu/clockify-cli/api/dto"
+
+// TimeEntryIterator fetches time entries one page at a time, so they can be
+// processed without keeping all of them in memory
+type TimeEntryIterator struct {
+	fetch    func(page int) ([]dto.TimeEntry, error)
+	pageSize int
+
+	page    int
+	entries []dto.TimeEntry
+	err     error
+	done    bool
+}
+
+// NewTimeEntryIterator creates a TimeEntryIterator that calls fetch for each
+// page, until a page has less than pageSize time entries or it fails
+func NewTimeEntryIterator(
+	pageSize int, fetch func(page int) ([]dto.TimeEntry, error),
+) *TimeEntryIterator {
+	return &TimeEntryIterator{fetch: fetch, pageSize: pageSize}
+}
+
+// Next fetches the next page, returning false when there are no more time
+// entries or when it failed (see Err)
+func (it *TimeEntryIterator) Next() bool {
+	if it.done {
+		return false
+	}
+
+	it.page++
+	it.entries, it.err = it.fetch(it.page)
+	if it.err != nil || len(it.entries) < it.pageSize {
+		it.done = true
+	}
+
+	return it.err == nil && len(it.entries) > 0
+}
+
+// Entries returns the time entries of the current page
+func (it *TimeEntryIterator) Entries() []dto.TimeEntry {
+	return it.entries
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *TimeEntryIterator) Err() error {
+	return it.err
+}
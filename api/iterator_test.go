@@ -0,0 +1,56 @@
+package api_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeEntryIterator(t *testing.T) {
+	pages := [][]dto.TimeEntry{
+		{{ID: "te-1"}, {ID: "te-2"}},
+		{{ID: "te-3"}, {ID: "te-4"}},
+		{{ID: "te-5"}},
+	}
+
+	calls := 0
+	it := api.NewTimeEntryIterator(2,
+		func(page int) ([]dto.TimeEntry, error) {
+			calls++
+			return pages[page-1], nil
+		})
+
+	ids := []string{}
+	for it.Next() {
+		for _, te := range it.Entries() {
+			ids = append(ids, te.ID)
+		}
+	}
+
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []string{"te-1", "te-2", "te-3", "te-4", "te-5"}, ids)
+	assert.Equal(t, 3, calls)
+	assert.False(t, it.Next(), "should not fetch after the last page")
+	assert.Equal(t, 3, calls)
+}
+
+func TestTimeEntryIteratorStopsOnError(t *testing.T) {
+	calls := 0
+	it := api.NewTimeEntryIterator(1,
+		func(page int) ([]dto.TimeEntry, error) {
+			calls++
+			if page == 2 {
+				return nil, errors.New("failed")
+			}
+			return []dto.TimeEntry{{ID: "te-1"}}, nil
+		})
+
+	assert.True(t, it.Next())
+	assert.False(t, it.Next())
+	assert.EqualError(t, it.Err(), "failed")
+	assert.False(t, it.Next())
+	assert.Equal(t, 2, calls)
+}
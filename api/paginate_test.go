@@ -0,0 +1,169 @@
+package api_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaginateWithTotalCount(t *testing.T) {
+	const total = 9
+	var calls int32
+	s := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+
+			tags := []string{}
+			for i := (page - 1) * 2; i < page*2 && i < total; i++ {
+				tags = append(tags, fmt.Sprintf(`{"id":"t%d"}`, i))
+			}
+
+			w.Header().Set("X-Total-Count", strconv.Itoa(total))
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte("[" + strings.Join(tags, ",") + "]"))
+		}))
+	defer s.Close()
+
+	c, _ := api.NewClientFromUrlAndKey("a-key", s.URL)
+	tags, err := c.GetTags(context.Background(), api.GetTagsParam{
+		Workspace: exampleID,
+		PaginationParam: api.PaginationParam{
+			AllPages: true,
+			PageSize: 2,
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(5), atomic.LoadInt32(&calls))
+
+	expected := make([]dto.Tag, total)
+	for i := range expected {
+		expected[i] = dto.Tag{ID: fmt.Sprintf("t%d", i)}
+	}
+	assert.Equal(t, expected, tags, "pages should be kept in order")
+}
+
+func TestPaginateStopsAfterAFailedPage(t *testing.T) {
+	const total = 20
+	var lastPage int32
+	failed := make(chan struct{})
+	s := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+			for {
+				l := atomic.LoadInt32(&lastPage)
+				if int32(page) <= l ||
+					atomic.CompareAndSwapInt32(&lastPage, l, int32(page)) {
+					break
+				}
+			}
+
+			w.Header().Set("X-Total-Count", strconv.Itoa(total))
+			switch {
+			case page == 2:
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"code":400,"message":"failed"}`))
+				close(failed)
+				return
+			case page > 2:
+				// the pages already requested only finish after the
+				// failure, so the client could only ask for more pages
+				// after knowing that one failed
+				<-failed
+				time.Sleep(50 * time.Millisecond)
+			}
+
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`[{"id":"a"},{"id":"b"}]`))
+		}))
+	defer s.Close()
+
+	c, _ := api.NewClientFromUrlAndKey("a-key", s.URL)
+	_, err := c.GetTags(context.Background(), api.GetTagsParam{
+		Workspace: exampleID,
+		PaginationParam: api.PaginationParam{
+			AllPages: true,
+			PageSize: 2,
+		},
+	})
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed")
+	assert.LessOrEqual(t, atomic.LoadInt32(&lastPage), int32(5),
+		"no page should be requested after page 2 failed")
+}
+
+func TestPaginateWithLimit(t *testing.T) {
+	const total = 9
+	var calls int32
+	var pageSizes []string
+	s := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+			size, _ := strconv.Atoi(r.URL.Query().Get("page-size"))
+			pageSizes = append(pageSizes, r.URL.Query().Get("page-size"))
+
+			ids := []string{}
+			for i := (page - 1) * size; i < page*size && i < total; i++ {
+				ids = append(ids, fmt.Sprintf(`{"id":"i%d"}`, i))
+			}
+
+			w.Header().Set("X-Total-Count", strconv.Itoa(total))
+			w.WriteHeader(http.StatusOK)
+			l := "[" + strings.Join(ids, ",") + "]"
+			if strings.HasSuffix(r.URL.Path, "/expenses") {
+				l = `{"expenses":{"count":9,"expenses":` + l + `}}`
+			}
+			_, _ = w.Write([]byte(l))
+		}))
+	defer s.Close()
+
+	c, _ := api.NewClientFromUrlAndKey("a-key", s.URL)
+
+	tags, err := c.GetTags(context.Background(), api.GetTagsParam{
+		Workspace: exampleID,
+		PaginationParam: api.PaginationParam{
+			AllPages: true,
+			PageSize: 2,
+			Limit:    5,
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	assert.Equal(t, []dto.Tag{
+		{ID: "i0"}, {ID: "i1"}, {ID: "i2"}, {ID: "i3"}, {ID: "i4"},
+	}, tags)
+
+	atomic.StoreInt32(&calls, 0)
+	pageSizes = nil
+	es, err := c.GetExpenses(context.Background(), api.GetExpensesParam{
+		Workspace: exampleID,
+		PaginationParam: api.PaginationParam{
+			AllPages: true,
+			Limit:    3,
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	assert.Equal(t, []string{"3"}, pageSizes,
+		"page size should be the limit when smaller than the default")
+	assert.Equal(t, []dto.Expense{{ID: "i0"}, {ID: "i1"}, {ID: "i2"}}, es)
+
+	_, err = c.GetTags(context.Background(), api.GetTagsParam{
+		Workspace:       exampleID,
+		PaginationParam: api.PaginationParam{AllPages: true, Limit: -1},
+	})
+	assert.EqualError(t, err, "get tags: limit can't be negative")
+}
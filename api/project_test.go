@@ -1,6 +1,7 @@
 package api_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -157,7 +158,7 @@ func TestUpdateProjectMemberships(t *testing.T) {
 	for _, tt := range tts {
 		runClient(t, tt,
 			func(c api.Client, p interface{}) (interface{}, error) {
-				return c.UpdateProjectMemberships(
+				return c.UpdateProjectMemberships(context.Background(),
 					p.(api.UpdateProjectMembershipsParam))
 			})
 	}
@@ -229,7 +230,8 @@ func TestDeleteProject(t *testing.T) {
 	for _, tt := range tts {
 		runClient(t, tt,
 			func(c api.Client, p interface{}) (interface{}, error) {
-				return c.DeleteProject(p.(api.DeleteProjectParam))
+				return c.DeleteProject(context.Background(),
+					p.(api.DeleteProjectParam))
 			})
 	}
 }
@@ -331,7 +333,8 @@ func TestGetProject(t *testing.T) {
 	for _, tt := range tts {
 		runClient(t, tt,
 			func(c api.Client, p interface{}) (interface{}, error) {
-				return c.GetProject(p.(api.GetProjectParam))
+				return c.GetProject(context.Background(),
+					p.(api.GetProjectParam))
 			})
 	}
 }
@@ -521,7 +524,8 @@ func TestGetProjects(t *testing.T) {
 	for _, tt := range tts {
 		runClient(t, tt,
 			func(c api.Client, p interface{}) (interface{}, error) {
-				return c.GetProjects(p.(api.GetProjectsParam))
+				return c.GetProjects(context.Background(),
+					p.(api.GetProjectsParam))
 			})
 	}
 }
@@ -622,7 +626,7 @@ func TestUpdateProjectTemplate(t *testing.T) {
 	for i := range tts {
 		runClient(t, &tts[i],
 			func(c api.Client, p interface{}) (interface{}, error) {
-				return c.UpdateProjectTemplate(
+				return c.UpdateProjectTemplate(context.Background(),
 					p.(api.UpdateProjectTemplateParam))
 			})
 	}
@@ -912,7 +916,7 @@ func TestUpdateProjectEstimate(t *testing.T) {
 	for i := range tts {
 		runClient(t, &tts[i],
 			func(c api.Client, p interface{}) (interface{}, error) {
-				return c.UpdateProjectEstimate(
+				return c.UpdateProjectEstimate(context.Background(),
 					p.(api.UpdateProjectEstimateParam))
 			})
 	}
@@ -923,7 +927,7 @@ func TestUpdateProjectUserCostRate(t *testing.T) {
 		"update project user cost rate: ",
 		"cost-rate",
 		func(c api.Client, p interface{}) (interface{}, error) {
-			return c.UpdateProjectUserCostRate(
+			return c.UpdateProjectUserCostRate(context.Background(),
 				p.(api.UpdateProjectUserRateParam))
 		})
 }
@@ -933,7 +937,7 @@ func TestUpdateProjectUserBillableRate(t *testing.T) {
 		"update project user billable rate: ",
 		"hourly-rate",
 		func(c api.Client, p interface{}) (interface{}, error) {
-			return c.UpdateProjectUserBillableRate(
+			return c.UpdateProjectUserBillableRate(context.Background(),
 				p.(api.UpdateProjectUserRateParam))
 		})
 }
@@ -1246,7 +1250,8 @@ func TestUpdateProject(t *testing.T) {
 	for i := range tts {
 		runClient(t, &tts[i], func(
 			c api.Client, p interface{}) (interface{}, error) {
-			return c.UpdateProject(p.(api.UpdateProjectParam))
+			return c.UpdateProject(context.Background(),
+				p.(api.UpdateProjectParam))
 		})
 	}
 }
@@ -0,0 +1,204 @@
+package api_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+func TestUpdateWorkspaceRate(t *testing.T) {
+	since, _ := time.Parse("2006-01-02", "2022-02-02")
+	for _, r := range []struct {
+		errPrefix string
+		uriSufix  string
+		fn        func(api.Client, interface{}) (interface{}, error)
+	}{
+		{
+			errPrefix: "update workspace billable rate: ",
+			uriSufix:  "hourly-rate",
+			fn: func(c api.Client, p interface{}) (interface{}, error) {
+				return c.UpdateWorkspaceBillableRate(context.Background(),
+					p.(api.UpdateWorkspaceRateParam))
+			},
+		},
+		{
+			errPrefix: "update workspace cost rate: ",
+			uriSufix:  "cost-rate",
+			fn: func(c api.Client, p interface{}) (interface{}, error) {
+				return c.UpdateWorkspaceCostRate(context.Background(),
+					p.(api.UpdateWorkspaceRateParam))
+			},
+		},
+	} {
+		tts := []simpleTestCase{
+			{
+				name:  "workspace is required",
+				param: api.UpdateWorkspaceRateParam{Amount: 10},
+				err:   r.errPrefix + "workspace is required",
+			},
+			{
+				name: "amount and currency",
+				param: api.UpdateWorkspaceRateParam{
+					Workspace: exampleID,
+					Amount:    8000,
+					Currency:  "USD",
+				},
+
+				requestMethod: "put",
+				requestUrl: "/v1/workspaces/" + exampleID +
+					"/" + r.uriSufix,
+				requestBody: `{"amount":8000,"currency":"USD"}`,
+
+				result: dto.Workspace{
+					ID:         exampleID,
+					HourlyRate: dto.Rate{Amount: 8000, Currency: "USD"},
+				},
+				responseStatus: 200,
+				responseBody: `{"id":"` + exampleID + `",` +
+					`"hourlyRate":{"amount":8000,"currency":"USD"}}`,
+			},
+			{
+				name: "fail",
+				param: api.UpdateWorkspaceRateParam{
+					Workspace: exampleID,
+					Amount:    10,
+					Since:     &since,
+				},
+
+				requestMethod: "put",
+				requestUrl: "/v1/workspaces/" + exampleID +
+					"/" + r.uriSufix,
+				requestBody: `{"amount":10,"since":"2022-02-02T00:00:00Z"}`,
+
+				err:            r.errPrefix + "custom error.*code: 42",
+				responseStatus: 400,
+				responseBody:   `{"message":"custom error","code":42}`,
+			},
+		}
+
+		for i := range tts {
+			runClient(t, &tts[i], r.fn)
+		}
+	}
+}
+
+func TestUpdateUserRate(t *testing.T) {
+	since, _ := time.Parse("2006-01-02", "2022-02-02")
+	for _, r := range []struct {
+		errPrefix string
+		uriSufix  string
+		fn        func(api.Client, interface{}) (interface{}, error)
+	}{
+		{
+			errPrefix: "update user billable rate: ",
+			uriSufix:  "hourly-rate",
+			fn: func(c api.Client, p interface{}) (interface{}, error) {
+				return c.UpdateUserBillableRate(context.Background(),
+					p.(api.UpdateUserRateParam))
+			},
+		},
+		{
+			errPrefix: "update user cost rate: ",
+			uriSufix:  "cost-rate",
+			fn: func(c api.Client, p interface{}) (interface{}, error) {
+				return c.UpdateUserCostRate(context.Background(),
+					p.(api.UpdateUserRateParam))
+			},
+		},
+	} {
+		tts := []simpleTestCase{
+			{
+				name:  "user is required",
+				param: api.UpdateUserRateParam{Workspace: exampleID},
+				err:   r.errPrefix + "user id is required",
+			},
+			{
+				name: "user should be a ID",
+				param: api.UpdateUserRateParam{
+					Workspace: exampleID,
+					UserID:    "u-1",
+				},
+				err: r.errPrefix + "user id (.*) is not valid",
+			},
+			{
+				name: "amount and since",
+				param: api.UpdateUserRateParam{
+					Workspace: exampleID,
+					UserID:    exampleID,
+					Amount:    10,
+					Since:     &since,
+				},
+
+				requestMethod: "put",
+				requestUrl: "/v1/workspaces/" + exampleID +
+					"/users/" + exampleID + "/" + r.uriSufix,
+				requestBody: `{"amount":10,"since":"2022-02-02T00:00:00Z"}`,
+
+				result:         dto.Workspace{ID: exampleID},
+				responseStatus: 200,
+				responseBody:   `{"id":"` + exampleID + `"}`,
+			},
+		}
+
+		for i := range tts {
+			runClient(t, &tts[i], r.fn)
+		}
+	}
+}
+
+func TestUpdateProjectRate(t *testing.T) {
+	for _, r := range []struct {
+		errPrefix string
+		uriSufix  string
+		fn        func(api.Client, interface{}) (interface{}, error)
+	}{
+		{
+			errPrefix: "update project billable rate: ",
+			uriSufix:  "hourly-rate",
+			fn: func(c api.Client, p interface{}) (interface{}, error) {
+				return c.UpdateProjectBillableRate(context.Background(),
+					p.(api.UpdateProjectRateParam))
+			},
+		},
+		{
+			errPrefix: "update project cost rate: ",
+			uriSufix:  "cost-rate",
+			fn: func(c api.Client, p interface{}) (interface{}, error) {
+				return c.UpdateProjectCostRate(context.Background(),
+					p.(api.UpdateProjectRateParam))
+			},
+		},
+	} {
+		tts := []simpleTestCase{
+			{
+				name:  "project is required",
+				param: api.UpdateProjectRateParam{Workspace: exampleID},
+				err:   r.errPrefix + "project id is required",
+			},
+			{
+				name: "only amount",
+				param: api.UpdateProjectRateParam{
+					Workspace: exampleID,
+					ProjectID: exampleID,
+					Amount:    10,
+				},
+
+				requestMethod: "put",
+				requestUrl: "/v1/workspaces/" + exampleID +
+					"/projects/" + exampleID + "/" + r.uriSufix,
+				requestBody: `{"amount":10}`,
+
+				result:         dto.Project{ID: exampleID},
+				responseStatus: 200,
+				responseBody:   `{"id":"` + exampleID + `"}`,
+			},
+		}
+
+		for i := range tts {
+			runClient(t, &tts[i], r.fn)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+)
+
+func TestRaw(t *testing.T) {
+	tts := []testCase{
+		&simpleTestCase{
+			name:  "method is required",
+			param: api.RawParam{Path: "/workspaces"},
+			err:   "raw request: method is required",
+		},
+		&simpleTestCase{
+			name:  "path is required",
+			param: api.RawParam{Method: "GET"},
+			err:   "raw request: path is required",
+		},
+		&simpleTestCase{
+			name:  "invalid method",
+			param: api.RawParam{Method: "HEAD", Path: "/workspaces"},
+			err: "raw request: valid options for method are " +
+				"GET, POST, PUT, PATCH and DELETE",
+		},
+		&simpleTestCase{
+			name: "get with query",
+			param: api.RawParam{
+				Method: "get",
+				Path:   "/workspaces/" + exampleID + "/projects?page=2",
+			},
+
+			requestMethod: "get",
+			requestUrl: "/v1/workspaces/" + exampleID +
+				"/projects?page=2",
+
+			responseStatus: 200,
+			responseBody:   `[{"id":"p1"}]`,
+
+			result: json.RawMessage(`[{"id":"p1"}]`),
+		},
+		&simpleTestCase{
+			name: "post with body",
+			param: api.RawParam{
+				Method: "POST",
+				Path:   "v1/workspaces/" + exampleID + "/tags",
+				Body:   json.RawMessage(`{"name":"t"}`),
+			},
+
+			requestMethod: "post",
+			requestUrl:    "/v1/workspaces/" + exampleID + "/tags",
+			requestBody:   `{"name":"t"}`,
+
+			responseStatus: 201,
+			responseBody:   `{"id":"t1","name":"t"}`,
+
+			result: json.RawMessage(`{"id":"t1","name":"t"}`),
+		},
+		&simpleTestCase{
+			name: "api error",
+			param: api.RawParam{
+				Method: "DELETE",
+				Path:   "/workspaces/" + exampleID + "/tags/t1",
+			},
+
+			requestMethod: "delete",
+			requestUrl:    "/v1/workspaces/" + exampleID + "/tags/t1",
+
+			responseStatus: 400,
+			responseBody:   `{"message":"tag is in use","code":501}`,
+
+			err: `raw request: tag is in use \(code: 501\)`,
+		},
+	}
+
+	for i := range tts {
+		runClient(t, tts[i],
+			func(c api.Client, p interface{}) (interface{}, error) {
+				return c.Raw(context.Background(), p.(api.RawParam))
+			})
+	}
+}
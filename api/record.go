@@ -0,0 +1,229 @@
+package api
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// interaction is a request sent to the API and the response it got, as
+// kept on the golden files
+type interaction struct {
+	Method   string      `json:"method"`
+	URL      string      `json:"url"`
+	Body     string      `json:"body,omitempty"`
+	Status   int         `json:"status"`
+	Header   http.Header `json:"header,omitempty"`
+	Response string      `json:"response"`
+}
+
+// NewRecordTransport creates a http.RoundTripper that sends the requests
+// through next and writes each request and its response into dir, to be
+// replayed later by NewReplayTransport. The token is not written
+func NewRecordTransport(next http.RoundTripper, dir string) http.RoundTripper {
+	if next == nil {
+		next = DefaultTransport
+	}
+
+	return &recordTransport{next: next, dir: dir, seen: map[string]bool{}}
+}
+
+type recordTransport struct {
+	next http.RoundTripper
+	dir  string
+
+	mu   sync.Mutex
+	seen map[string]bool
+}
+
+func (t *recordTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	body, r, err := readRequestBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := t.next.RoundTrip(r)
+	if err != nil {
+		return res, err
+	}
+
+	b, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(b))
+
+	i := interaction{
+		Method:   r.Method,
+		URL:      r.URL.RequestURI(),
+		Body:     string(body),
+		Status:   res.StatusCode,
+		Header:   res.Header.Clone(),
+		Response: string(b),
+	}
+
+	if err := t.save(i); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// save appends the interaction to its golden file, replacing the ones
+// recorded by previous executions
+func (t *recordTransport) save(i interaction) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	p := goldenFile(t.dir, i.Method, i.URL, i.Body)
+
+	var is []interaction
+	if t.seen[p] {
+		if b, err := os.ReadFile(p); err == nil {
+			_ = json.Unmarshal(b, &is)
+		}
+	}
+	t.seen[p] = true
+
+	b, err := json.MarshalIndent(append(is, i), "", "  ")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := os.MkdirAll(t.dir, 0700); err != nil {
+		return errors.Wrap(err, "failed to record the request")
+	}
+
+	return errors.Wrap(os.WriteFile(p, b, 0600),
+		"failed to record the request")
+}
+
+// NewReplayTransport creates a http.RoundTripper that answers the requests
+// with the responses recorded into dir by NewRecordTransport, without
+// calling the API. Requests not recorded fail
+func NewReplayTransport(dir string) http.RoundTripper {
+	return &replayTransport{dir: dir, calls: map[string]int{}}
+}
+
+type replayTransport struct {
+	dir string
+
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+func (t *replayTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	body, r, err := readRequestBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	i, ok := t.next(goldenFile(t.dir, r.Method, r.URL.RequestURI(),
+		string(body)))
+	if !ok {
+		return nil, errors.Errorf("no response recorded on %s for %s %s",
+			t.dir, r.Method, r.URL.RequestURI())
+	}
+
+	return &http.Response{
+		Status: strconv.Itoa(i.Status) + " " +
+			http.StatusText(i.Status),
+		StatusCode:    i.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        i.Header,
+		Body:          io.NopCloser(strings.NewReader(i.Response)),
+		ContentLength: int64(len(i.Response)),
+		Request:       r,
+	}, nil
+}
+
+// next returns the responses in the order they were recorded, repeating
+// the last one when the request is sent more times than it was recorded
+func (t *replayTransport) next(p string) (interaction, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	b, err := os.ReadFile(p)
+	if err != nil {
+		return interaction{}, false
+	}
+
+	var is []interaction
+	if err := json.Unmarshal(b, &is); err != nil || len(is) == 0 {
+		return interaction{}, false
+	}
+
+	n := t.calls[p]
+	t.calls[p] = n + 1
+	if n >= len(is) {
+		n = len(is) - 1
+	}
+
+	return is[n], true
+}
+
+// readRequestBody reads the body of the request and returns the request to
+// be sent by the next transport, as a http.RoundTripper must not change the
+// request it gets; it is cloned when the body can't be read again
+func readRequestBody(r *http.Request) ([]byte, *http.Request, error) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return nil, r, nil
+	}
+
+	if r.GetBody != nil {
+		rc, err := r.GetBody()
+		if err != nil {
+			return nil, nil, err
+		}
+		defer rc.Close()
+
+		b, err := io.ReadAll(rc)
+		return b, r, err
+	}
+
+	b, err := io.ReadAll(r.Body)
+	r.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := r.Clone(r.Context())
+	c.Body = io.NopCloser(bytes.NewReader(b))
+	return b, c, nil
+}
+
+var notSlug = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+
+// goldenFile names the file of a request by its method and path, plus a
+// hash of the path, query and body, so different requests to the same
+// endpoint do not share it
+func goldenFile(dir, method, uri, body string) string {
+	h := sha256.Sum256([]byte(method + " " + uri + "\n" + body))
+
+	path := uri
+	if i := strings.Index(path, "?"); i >= 0 {
+		path = path[:i]
+	}
+
+	slug := strings.Trim(notSlug.ReplaceAllString(path, "-"), "-")
+	if len(slug) > 100 {
+		slug = slug[len(slug)-100:]
+	}
+
+	return filepath.Join(dir, strings.ToLower(method)+"-"+slug+"-"+
+		hex.EncodeToString(h[:4])+".json")
+}
@@ -0,0 +1,119 @@
+package api_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	calls := 0
+	s := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if r.Method == http.MethodPost {
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(`{"id":"c1","name":"c"}`))
+				return
+			}
+
+			if calls > 2 {
+				_, _ = w.Write([]byte(`{"id":"u1","name":"Jane"}`))
+				return
+			}
+
+			_, _ = w.Write([]byte(`{"id":"u1","name":"John"}`))
+		}))
+	dir := t.TempDir()
+
+	c, _ := api.NewClientFromUrlAndKey("a-secret-api-key", s.URL)
+	c.SetTransport(api.NewRecordTransport(nil, dir))
+
+	u, err := c.GetMe(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, dto.User{ID: "u1", Name: "John"}, u)
+
+	cl, err := c.AddClient(context.Background(), api.AddClientParam{
+		Workspace: exampleID,
+		Name:      "c",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, dto.Client{ID: "c1", Name: "c"}, cl)
+
+	u, err = c.GetMe(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, dto.User{ID: "u1", Name: "Jane"}, u)
+
+	s.Close()
+	assert.Equal(t, 3, calls)
+
+	fs, _ := os.ReadDir(dir)
+	assert.Len(t, fs, 2)
+	for _, f := range fs {
+		b, _ := os.ReadFile(dir + "/" + f.Name())
+		assert.NotContains(t, string(b), "a-secret-api-key")
+	}
+
+	c, _ = api.NewClientFromUrlAndKey("a-secret-api-key", s.URL)
+	c.SetTransport(api.NewReplayTransport(dir))
+
+	for _, name := range []string{"John", "Jane", "Jane"} {
+		u, err = c.GetMe(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, dto.User{ID: "u1", Name: name}, u)
+	}
+
+	cl, err = c.AddClient(context.Background(), api.AddClientParam{
+		Workspace: exampleID,
+		Name:      "c",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, dto.Client{ID: "c1", Name: "c"}, cl)
+
+	_, err = c.AddClient(context.Background(), api.AddClientParam{
+		Workspace: exampleID,
+		Name:      "other",
+	})
+	if assert.Error(t, err) {
+		assert.True(t, strings.Contains(err.Error(),
+			"no response recorded on "+dir+" for POST /v1/workspaces/"+
+				exampleID+"/clients"), err.Error())
+	}
+}
+
+func TestRecordDoesNotChangeTheRequest(t *testing.T) {
+	var got []string
+	s := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			b, _ := io.ReadAll(r.Body)
+			got = append(got, string(b))
+			_, _ = w.Write([]byte(`{}`))
+		}))
+	defer s.Close()
+
+	tr := api.NewRecordTransport(nil, t.TempDir())
+	for _, body := range []io.Reader{
+		strings.NewReader(`{"name":"a"}`),
+		io.NopCloser(strings.NewReader(`{"name":"b"}`)),
+	} {
+		req, _ := http.NewRequest(http.MethodPost, s.URL, body)
+		original := req.Body
+
+		res, err := tr.RoundTrip(req)
+		if assert.NoError(t, err) {
+			res.Body.Close()
+		}
+		assert.True(t, original == req.Body,
+			"the body of the request should not be replaced")
+	}
+
+	assert.Equal(t, []string{`{"name":"a"}`, `{"name":"b"}`}, got)
+}
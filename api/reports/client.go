@@ -0,0 +1,340 @@
+package reports
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/pkg/errors"
+)
+
+// Client will help to access Clockify Reports API, which aggregates the
+// time entries on the server, when the context of a request is cancelled the
+// request in flight and its next pages are stopped
+type Client interface {
+	// SetDebugLogger when set will output the responses of requests to the
+	// logger
+	SetDebugLogger(logger api.Logger) Client
+	// SetInfoLogger when set will output which requests and params are used to
+	// the logger
+	SetInfoLogger(logger api.Logger) Client
+	// SetHTTPTrace when set will write every request and response, with the
+	// token redacted, to out
+	SetHTTPTrace(out io.Writer) Client
+	// SetTransport sets how the requests are sent, like which proxy and
+	// certificates are used (see api.NewTransport)
+	SetTransport(http.RoundTripper) Client
+	// SetTimeout sets how long a request can take before being cancelled
+	// (0 disables the timeout)
+	SetTimeout(time.Duration) Client
+
+	// Summary returns the durations and amounts of the time entries grouped
+	// by up to three levels (like project, task and time entry)
+	Summary(context.Context, SummaryParam) (SummaryReport, error)
+	// Detailed returns the time entries with their amounts
+	Detailed(context.Context, DetailedParam) (DetailedReport, error)
+	// Weekly returns the durations of each group on each day of the range
+	Weekly(context.Context, WeeklyParam) (WeeklyReport, error)
+}
+
+type client struct {
+	baseURL *url.URL
+	http.Client
+	transport   http.RoundTripper
+	trace       io.Writer
+	debugLogger api.Logger
+	infoLogger  api.Logger
+}
+
+// baseURL is the Clockify Reports API base URL
+const baseURL = "https://reports.api.clockify.me/v1"
+
+// NewClientFromUrlAndKey creates a new Client using a custom url (useful for
+// self-hosted or regional instances)
+func NewClientFromUrlAndKey(
+	apiKey,
+	urlString string,
+) (Client, error) {
+	if apiKey == "" {
+		return nil, errors.WithStack(api.ErrorMissingAPIKey)
+	}
+
+	if urlString == "" {
+		return nil, errors.WithStack(api.ErrorMissingAPIURL)
+	}
+
+	u, err := url.Parse(urlString)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/")
+
+	return &client{
+		baseURL: u,
+		Client: http.Client{
+			Transport: transport{
+				apiKey: apiKey,
+				next:   api.DefaultTransport,
+			},
+		},
+	}, nil
+}
+
+// NewClient create a new Client, based on: https://docs.clockify.me/#tag/Time-Entry-Report
+func NewClient(apiKey string) (Client, error) {
+	return NewClientFromUrlAndKey(
+		apiKey,
+		baseURL,
+	)
+}
+
+// SetDebugLogger debug logger
+func (c *client) SetDebugLogger(logger api.Logger) Client {
+	c.debugLogger = logger
+	return c
+}
+
+func (c *client) debugf(format string, v ...interface{}) {
+	if c.debugLogger == nil {
+		return
+	}
+
+	c.debugLogger.Printf(format, v...)
+}
+
+// SetInfoLogger info logger
+func (c *client) SetInfoLogger(logger api.Logger) Client {
+	c.infoLogger = logger
+	return c
+}
+
+func (c *client) infof(format string, v ...interface{}) {
+	if c.infoLogger == nil {
+		return
+	}
+
+	c.infoLogger.Printf(format, v...)
+}
+
+// SetHTTPTrace writes the requests and responses to out, or stops writing
+// them if out is nil
+func (c *client) SetHTTPTrace(out io.Writer) Client {
+	c.trace = out
+	c.setNext()
+	return c
+}
+
+// SetTransport sets the http.RoundTripper used to send the requests
+func (c *client) SetTransport(t http.RoundTripper) Client {
+	c.transport = t
+	c.setNext()
+	return c
+}
+
+func (c *client) setNext() {
+	next := c.transport
+	if next == nil {
+		next = api.DefaultTransport
+	}
+
+	if c.trace != nil {
+		next = api.NewTraceTransport(next, c.trace)
+	}
+
+	t := c.Client.Transport.(transport)
+	t.next = next
+	c.Client.Transport = t
+}
+
+// SetTimeout sets how long a request can take before being cancelled
+func (c *client) SetTimeout(d time.Duration) Client {
+	if d < 0 {
+		d = 0
+	}
+
+	c.Client.Timeout = d
+	return c
+}
+
+func checkWorkspace(workspace string) error {
+	if workspace == "" {
+		return api.RequiredFieldError{Field: "workspace"}
+	}
+
+	if !api.IsValidID(workspace) {
+		return api.InvalidIDError{Field: "workspace", ID: workspace}
+	}
+
+	return nil
+}
+
+func wrapError(err *error, message string, args ...interface{}) {
+	if err == nil || *err == nil {
+		return
+	}
+	*err = errors.Wrapf(*err, message, args...)
+}
+
+// Summary returns the durations and amounts of the time entries grouped
+func (c *client) Summary(
+	ctx context.Context, p SummaryParam) (r SummaryReport, err error) {
+	defer wrapError(&err, "get summary report")
+
+	if err = checkWorkspace(p.Workspace); err != nil {
+		return r, err
+	}
+
+	groups := p.Groups
+	if len(groups) == 0 {
+		groups = []Group{GroupProject, GroupTimeEntry}
+	}
+
+	b := p.Filter.body()
+	b.SummaryFilter = &summaryFilter{Groups: groups, SortColumn: "GROUP"}
+
+	req, err := c.NewRequest(ctx,
+		"POST", "workspaces/"+p.Workspace+"/reports/summary", b)
+	if err != nil {
+		return r, err
+	}
+
+	_, err = c.Do(req, &r, "Summary")
+	return r, err
+}
+
+// paginationWorkers is how many pages of the detailed report are fetched at
+// the same time
+const paginationWorkers = 4
+
+// Detailed returns the time entries of the range with their amounts, when
+// all pages are requested the ones after the first are fetched concurrently
+// using the count of entries informed on the totals
+func (c *client) Detailed(
+	ctx context.Context, p DetailedParam) (r DetailedReport, err error) {
+	defer wrapError(&err, "get detailed report")
+
+	if err = checkWorkspace(p.Workspace); err != nil {
+		return r, err
+	}
+
+	page := p.Page
+	if p.AllPages || page == 0 {
+		page = 1
+	}
+
+	if p.PageSize == 0 {
+		p.PageSize = 200
+	}
+
+	if r, err = c.detailedPage(ctx, p, page); err != nil {
+		return r, err
+	}
+
+	if !p.AllPages || len(r.TimeEntries) < p.PageSize {
+		return r, nil
+	}
+
+	if len(r.Totals) == 0 || r.Totals[0].EntriesCount == 0 {
+		// without the count the pages are fetched until one is not full
+		for pr := r; len(pr.TimeEntries) == p.PageSize; {
+			page++
+			if pr, err = c.detailedPage(ctx, p, page); err != nil {
+				return r, err
+			}
+
+			r.TimeEntries = append(r.TimeEntries, pr.TimeEntries...)
+		}
+
+		return r, nil
+	}
+
+	lastPage := (r.Totals[0].EntriesCount + p.PageSize - 1) / p.PageSize
+	if lastPage <= page {
+		return r, nil
+	}
+
+	pages := make([]DetailedReport, lastPage-page)
+	errs := make([]error, len(pages))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, paginationWorkers)
+	for i := range pages {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			pages[i], errs[i] = c.detailedPage(ctx, p, page+1+i)
+		}(i)
+	}
+	wg.Wait()
+
+	for i := range pages {
+		if errs[i] != nil {
+			return r, errs[i]
+		}
+
+		r.TimeEntries = append(r.TimeEntries, pages[i].TimeEntries...)
+	}
+
+	return r, nil
+}
+
+func (c *client) detailedPage(
+	ctx context.Context, p DetailedParam, page int) (
+	r DetailedReport, err error) {
+	if err = ctx.Err(); err != nil {
+		return r, errors.WithStack(err)
+	}
+
+	b := p.Filter.body()
+	b.DetailedFilter = &detailedFilter{
+		Page:       page,
+		PageSize:   p.PageSize,
+		SortColumn: "DATE",
+	}
+
+	req, err := c.NewRequest(ctx,
+		"POST", "workspaces/"+p.Workspace+"/reports/detailed", b)
+	if err != nil {
+		return r, err
+	}
+
+	_, err = c.Do(req, &r, "Detailed")
+	return r, err
+}
+
+// Weekly returns the durations of each group on each day of the range
+func (c *client) Weekly(
+	ctx context.Context, p WeeklyParam) (r WeeklyReport, err error) {
+	defer wrapError(&err, "get weekly report")
+
+	if err = checkWorkspace(p.Workspace); err != nil {
+		return r, err
+	}
+
+	group := p.Group
+	if group == "" {
+		group = GroupProject
+	}
+
+	b := p.Filter.body()
+	b.WeeklyFilter = &weeklyFilter{Group: group, Subgroup: "TIME"}
+
+	req, err := c.NewRequest(ctx,
+		"POST", "workspaces/"+p.Workspace+"/reports/weekly", b)
+	if err != nil {
+		return r, err
+	}
+
+	_, err = c.Do(req, &r, "Weekly")
+	return r, err
+}
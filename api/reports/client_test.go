@@ -0,0 +1,257 @@
+package reports_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/reports"
+	"github.com/stretchr/testify/assert"
+)
+
+var exampleID = "62f2af744a912b05acc7c79e"
+
+type httpCall struct {
+	url          string
+	requestBody  string
+	status       int
+	responseBody string
+}
+
+func runClient(t *testing.T, calls []httpCall,
+	fn func(reports.Client) (interface{}, error),
+) (interface{}, error) {
+	i := 0
+	s := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !assert.Less(t, i, len(calls), "should not call api") {
+				w.WriteHeader(500)
+				return
+			}
+
+			hc := calls[i]
+			i++
+
+			assert.Equal(t, "POST", r.Method)
+			assert.Equal(t, "a-key", r.Header.Get("X-Api-Key"))
+			assert.Equal(t, hc.url, r.URL.String())
+
+			b, _ := io.ReadAll(r.Body)
+			var eMap, aMap map[string]interface{}
+			assert.NoError(t, json.Unmarshal(b, &aMap))
+			assert.NoError(t, json.Unmarshal([]byte(hc.requestBody), &eMap))
+			assert.Equal(t, eMap, aMap)
+
+			w.WriteHeader(hc.status)
+			_, err := w.Write([]byte(hc.responseBody))
+			assert.NoError(t, err)
+		}))
+	defer s.Close()
+
+	c, err := reports.NewClientFromUrlAndKey("a-key", s.URL+"/v1")
+	if !assert.NoError(t, err) {
+		return nil, err
+	}
+
+	r, err := fn(c)
+	assert.Equal(t, len(calls), i, "should call api")
+	return r, err
+}
+
+func filter() reports.Filter {
+	return reports.Filter{
+		Workspace: exampleID,
+		Start:     time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
+		End:       time.Date(2023, 1, 8, 23, 59, 59, 999000000, time.UTC),
+	}
+}
+
+func TestRequiresWorkspace(t *testing.T) {
+	c, _ := reports.NewClient("a-key")
+
+	_, err := c.Summary(context.Background(), reports.SummaryParam{})
+	assert.EqualError(t, err, "get summary report: workspace is required")
+
+	_, err = c.Detailed(context.Background(), reports.DetailedParam{
+		Filter: reports.Filter{Workspace: "w"}})
+	assert.EqualError(t, err,
+		`get detailed report: workspace ("w") is not valid ID`)
+
+	_, err = c.Weekly(context.Background(), reports.WeeklyParam{})
+	assert.EqualError(t, err, "get weekly report: workspace is required")
+}
+
+func TestRequiresAPIKey(t *testing.T) {
+	_, err := reports.NewClient("")
+	assert.ErrorIs(t, err, api.ErrorMissingAPIKey)
+}
+
+func TestSummary(t *testing.T) {
+	f := filter()
+	f.ProjectIDs = []string{exampleID}
+	f.Rounding = true
+	f.AmountShown = reports.AmountEarned
+
+	r, err := runClient(t, []httpCall{{
+		url: "/v1/workspaces/" + exampleID + "/reports/summary",
+		requestBody: `{
+			"dateRangeStart": "2023-01-02T00:00:00.000Z",
+			"dateRangeEnd": "2023-01-08T23:59:59.999Z",
+			"sortOrder": "ASCENDING",
+			"rounding": true,
+			"amountShown": "EARNED",
+			"projects": {
+				"ids": ["` + exampleID + `"],
+				"contains": "CONTAINS",
+				"status": "ALL"
+			},
+			"summaryFilter": {
+				"groups": ["PROJECT", "TIMEENTRY"],
+				"sortColumn": "GROUP"
+			}
+		}`,
+		status: 200,
+		responseBody: `{
+			"totals": [{"totalTime": 5400, "entriesCount": 2,
+				"totalAmount": 15000}],
+			"groupOne": [{"_id": "p1", "name": "Cli", "duration": 5400,
+				"amount": 15000, "children": [
+					{"_id": "d1", "name": "Work", "duration": 5400,
+						"amount": 15000}
+				]}]
+		}`,
+	}}, func(c reports.Client) (interface{}, error) {
+		return c.Summary(context.Background(), reports.SummaryParam{Filter: f})
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, reports.SummaryReport{
+		Totals: []reports.Totals{{
+			TotalTime:    5400,
+			EntriesCount: 2,
+			TotalAmount:  15000,
+		}},
+		GroupOne: []reports.SummaryGroup{{
+			ID: "p1", Name: "Cli", Duration: 5400, Amount: 15000,
+			Children: []reports.SummaryGroup{{
+				ID: "d1", Name: "Work", Duration: 5400, Amount: 15000,
+			}},
+		}},
+	}, r)
+}
+
+func TestDetailedAllPages(t *testing.T) {
+	body := func(page int) string {
+		return `{
+			"dateRangeStart": "2023-01-02T00:00:00.000Z",
+			"dateRangeEnd": "2023-01-08T23:59:59.999Z",
+			"sortOrder": "ASCENDING",
+			"rounding": false,
+			"detailedFilter": {
+				"page": ` + string(rune('0'+page)) + `,
+				"pageSize": 1,
+				"sortColumn": "DATE"
+			}
+		}`
+	}
+
+	uri := "/v1/workspaces/" + exampleID + "/reports/detailed"
+	r, err := runClient(t, []httpCall{
+		{
+			url:         uri,
+			requestBody: body(1),
+			status:      200,
+			responseBody: `{"totals":[{"totalTime":3600}],"timeentries":[
+				{"_id":"t1","timeInterval":{"duration":3600}}]}`,
+		},
+		{
+			url:          uri,
+			requestBody:  body(2),
+			status:       200,
+			responseBody: `{"totals":[{"totalTime":3600}],"timeentries":[]}`,
+		},
+	}, func(c reports.Client) (interface{}, error) {
+		return c.Detailed(context.Background(), reports.DetailedParam{
+			Filter: filter(),
+			PaginationParam: api.PaginationParam{
+				AllPages: true,
+				PageSize: 1,
+			},
+		})
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, reports.DetailedReport{
+		Totals: []reports.Totals{{TotalTime: 3600}},
+		TimeEntries: []reports.DetailedTimeEntry{{
+			ID:           "t1",
+			TimeInterval: reports.DetailedTimeInterval{Duration: 3600},
+		}},
+	}, r)
+}
+
+func TestDetailedPagesConcurrently(t *testing.T) {
+	var calls int32
+	s := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+
+			var b struct {
+				DetailedFilter struct {
+					Page int `json:"page"`
+				} `json:"detailedFilter"`
+			}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&b))
+
+			w.WriteHeader(200)
+			_, _ = fmt.Fprintf(w, `{
+				"totals":[{"entriesCount":3}],
+				"timeentries":[{"_id":"t%d"}]
+			}`, b.DetailedFilter.Page)
+		}))
+	defer s.Close()
+
+	c, _ := reports.NewClientFromUrlAndKey("a-key", s.URL+"/v1")
+	r, err := c.Detailed(context.Background(), reports.DetailedParam{
+		Filter: filter(),
+		PaginationParam: api.PaginationParam{
+			AllPages: true,
+			PageSize: 1,
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	assert.Equal(t, []reports.DetailedTimeEntry{
+		{ID: "t1"}, {ID: "t2"}, {ID: "t3"},
+	}, r.TimeEntries, "pages should be kept in order")
+}
+
+func TestWeeklyError(t *testing.T) {
+	_, err := runClient(t, []httpCall{{
+		url: "/v1/workspaces/" + exampleID + "/reports/weekly",
+		requestBody: `{
+			"dateRangeStart": "2023-01-02T00:00:00.000Z",
+			"dateRangeEnd": "2023-01-08T23:59:59.999Z",
+			"sortOrder": "ASCENDING",
+			"rounding": false,
+			"weeklyFilter": {"group": "USER", "subgroup": "TIME"}
+		}`,
+		status:       403,
+		responseBody: ``,
+	}}, func(c reports.Client) (interface{}, error) {
+		return c.Weekly(context.Background(), reports.WeeklyParam{
+			Filter: filter(),
+			Group:  reports.GroupUser,
+		})
+	})
+
+	assert.EqualError(t, err, "get weekly report: Forbidden (code: 403)")
+}
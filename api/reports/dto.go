@@ -0,0 +1,243 @@
+package reports
+
+import (
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+)
+
+// Group is how the time entries will be aggregated by the reports
+type Group string
+
+const (
+	GroupProject   = Group("PROJECT")
+	GroupClient    = Group("CLIENT")
+	GroupTask      = Group("TASK")
+	GroupTag       = Group("TAG")
+	GroupUser      = Group("USER")
+	GroupDate      = Group("DATE")
+	GroupMonth     = Group("MONTH")
+	GroupTimeEntry = Group("TIMEENTRY")
+)
+
+// AmountShown sets which amount the reports will calculate
+type AmountShown string
+
+const (
+	AmountEarned = AmountShown("EARNED")
+	AmountCost   = AmountShown("COST")
+	AmountProfit = AmountShown("PROFIT")
+	AmountHide   = AmountShown("HIDE_AMOUNT")
+)
+
+// Filter sets which time entries will be used on the reports
+type Filter struct {
+	Workspace string
+	Start     time.Time
+	End       time.Time
+
+	Description string
+	Billable    *bool
+	// Rounding applies the rounding settings of the workspace to the
+	// durations
+	Rounding    bool
+	AmountShown AmountShown
+
+	UserIDs    []string
+	ClientIDs  []string
+	ProjectIDs []string
+	TagIDs     []string
+}
+
+// SummaryParam params to get the summary report
+type SummaryParam struct {
+	Filter
+	// Groups are the levels of aggregation (up to 3), by default are
+	// project and time entry (description)
+	Groups []Group
+}
+
+// DetailedParam params to get the detailed report
+type DetailedParam struct {
+	Filter
+	api.PaginationParam
+}
+
+// WeeklyParam params to get the weekly report
+type WeeklyParam struct {
+	Filter
+	// Group is how the lines of the report are aggregated, by default is
+	// project
+	Group Group
+}
+
+const dateFormat = "2006-01-02T15:04:05.000Z"
+
+type entityFilter struct {
+	IDs      []string `json:"ids"`
+	Contains string   `json:"contains"`
+	Status   string   `json:"status"`
+}
+
+func newEntityFilter(ids []string) *entityFilter {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	return &entityFilter{IDs: ids, Contains: "CONTAINS", Status: "ALL"}
+}
+
+type summaryFilter struct {
+	Groups     []Group `json:"groups"`
+	SortColumn string  `json:"sortColumn"`
+}
+
+type detailedFilter struct {
+	Page       int    `json:"page"`
+	PageSize   int    `json:"pageSize"`
+	SortColumn string `json:"sortColumn"`
+}
+
+type weeklyFilter struct {
+	Group    Group  `json:"group"`
+	Subgroup string `json:"subgroup"`
+}
+
+type filterBody struct {
+	DateRangeStart string        `json:"dateRangeStart"`
+	DateRangeEnd   string        `json:"dateRangeEnd"`
+	SortOrder      string        `json:"sortOrder"`
+	Description    string        `json:"description,omitempty"`
+	Rounding       bool          `json:"rounding"`
+	AmountShown    AmountShown   `json:"amountShown,omitempty"`
+	Billable       *bool         `json:"billable,omitempty"`
+	Users          *entityFilter `json:"users,omitempty"`
+	Clients        *entityFilter `json:"clients,omitempty"`
+	Projects       *entityFilter `json:"projects,omitempty"`
+	Tags           *entityFilter `json:"tags,omitempty"`
+
+	SummaryFilter  *summaryFilter  `json:"summaryFilter,omitempty"`
+	DetailedFilter *detailedFilter `json:"detailedFilter,omitempty"`
+	WeeklyFilter   *weeklyFilter   `json:"weeklyFilter,omitempty"`
+}
+
+func (f Filter) body() filterBody {
+	return filterBody{
+		DateRangeStart: f.Start.UTC().Format(dateFormat),
+		DateRangeEnd:   f.End.UTC().Format(dateFormat),
+		SortOrder:      "ASCENDING",
+		Description:    f.Description,
+		Rounding:       f.Rounding,
+		AmountShown:    f.AmountShown,
+		Billable:       f.Billable,
+		Users:          newEntityFilter(f.UserIDs),
+		Clients:        newEntityFilter(f.ClientIDs),
+		Projects:       newEntityFilter(f.ProjectIDs),
+		Tags:           newEntityFilter(f.TagIDs),
+	}
+}
+
+// Seconds is how the reports inform durations
+type Seconds int64
+
+// Duration converts the seconds into a time.Duration
+func (s Seconds) Duration() time.Duration {
+	return time.Duration(s) * time.Second
+}
+
+// Amount is a value (in cents) of a currency
+type Amount struct {
+	Type     AmountShown `json:"type"`
+	Value    float64     `json:"value"`
+	Currency string      `json:"currency,omitempty"`
+}
+
+// Totals of the time entries on a report
+type Totals struct {
+	TotalTime         Seconds  `json:"totalTime"`
+	TotalBillableTime Seconds  `json:"totalBillableTime"`
+	EntriesCount      int      `json:"entriesCount"`
+	TotalAmount       float64  `json:"totalAmount"`
+	Amounts           []Amount `json:"amounts,omitempty"`
+}
+
+// SummaryGroup is a aggregation of time entries, with the sub groups as
+// children
+type SummaryGroup struct {
+	ID         string         `json:"_id"`
+	Name       string         `json:"name"`
+	Duration   Seconds        `json:"duration"`
+	Amount     float64        `json:"amount"`
+	Amounts    []Amount       `json:"amounts,omitempty"`
+	ClientName string         `json:"clientName,omitempty"`
+	Children   []SummaryGroup `json:"children,omitempty"`
+}
+
+// SummaryReport is the response of the summary report
+type SummaryReport struct {
+	Totals   []Totals       `json:"totals"`
+	GroupOne []SummaryGroup `json:"groupOne"`
+}
+
+// DetailedTimeInterval is when the time entry happened
+type DetailedTimeInterval struct {
+	Start    time.Time  `json:"start"`
+	End      *time.Time `json:"end"`
+	Duration Seconds    `json:"duration"`
+}
+
+// DetailedTag is a tag of a time entry on the detailed report
+type DetailedTag struct {
+	ID   string `json:"_id"`
+	Name string `json:"name"`
+}
+
+// DetailedTimeEntry is a time entry with the names of its relations and
+// amount
+type DetailedTimeEntry struct {
+	ID           string               `json:"_id"`
+	Description  string               `json:"description"`
+	Billable     bool                 `json:"billable"`
+	IsLocked     bool                 `json:"isLocked"`
+	TimeInterval DetailedTimeInterval `json:"timeInterval"`
+	Rate         float64              `json:"rate"`
+	Amount       float64              `json:"amount"`
+	UserID       string               `json:"userId"`
+	UserName     string               `json:"userName"`
+	ProjectID    string               `json:"projectId,omitempty"`
+	ProjectName  string               `json:"projectName,omitempty"`
+	ClientID     string               `json:"clientId,omitempty"`
+	ClientName   string               `json:"clientName,omitempty"`
+	TaskID       string               `json:"taskId,omitempty"`
+	TaskName     string               `json:"taskName,omitempty"`
+	Tags         []DetailedTag        `json:"tags,omitempty"`
+}
+
+// DetailedReport is the response of the detailed report
+type DetailedReport struct {
+	Totals      []Totals            `json:"totals"`
+	TimeEntries []DetailedTimeEntry `json:"timeentries"`
+}
+
+// DayTotal is the duration and amount of a day on the weekly report
+type DayTotal struct {
+	Date     time.Time `json:"date"`
+	Duration Seconds   `json:"duration"`
+	Amount   float64   `json:"amount"`
+}
+
+// WeeklyGroup is a line of the weekly report
+type WeeklyGroup struct {
+	ID          string     `json:"_id"`
+	Name        string     `json:"name"`
+	Duration    Seconds    `json:"duration"`
+	Amount      float64    `json:"amount"`
+	TotalsByDay []DayTotal `json:"totalsByDay"`
+}
+
+// WeeklyReport is the response of the weekly report
+type WeeklyReport struct {
+	Totals      []Totals      `json:"totals"`
+	TotalsByDay []DayTotal    `json:"totalsByDay"`
+	GroupOne    []WeeklyGroup `json:"groupOne"`
+}
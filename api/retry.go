@@ -0,0 +1,238 @@
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultMaxRetries is how many times a request will be retried when the
+	// API is rate limiting the client
+	DefaultMaxRetries = 3
+	// DefaultRetryWait is how long the first retry waits when the API does
+	// not inform it, each next retry waits twice as long
+	DefaultRetryWait = time.Second
+
+	retryMaxDelay = 30 * time.Second
+)
+
+// retryTransport retries the requests answered with "429 Too Many
+// Requests", waiting the time informed by the API (Retry-After or
+// X-RateLimit-Reset) or backing off exponentially. When the API informs that
+// there are no requests left (X-RateLimit-Remaining: 0) the next request
+// will wait the reset before being sent. When timeout is set, each attempt
+// that takes longer than it is cancelled, and retried only when the method
+// is idempotent, as the server may have done what was asked already
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+	baseDelay  time.Duration
+	timeout    time.Duration
+	logf       func(format string, v ...interface{})
+
+	mu       sync.Mutex
+	resumeAt time.Time
+}
+
+func (t *retryTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if err := wait(r, t.untilResume()); err != nil {
+			return nil, err
+		}
+
+		req := r
+		if attempt > 0 && r.Body != nil {
+			if r.GetBody == nil {
+				return nil, errRetryWithoutBody
+			}
+
+			body, err := r.GetBody()
+			if err != nil {
+				return nil, err
+			}
+
+			req = r.Clone(r.Context())
+			req.Body = body
+		}
+
+		cancel := context.CancelFunc(func() {})
+		if t.timeout > 0 {
+			var ctx context.Context
+			ctx, cancel = context.WithTimeout(req.Context(), t.timeout)
+			req = req.WithContext(ctx)
+		}
+
+		res, err := t.next.RoundTrip(req)
+		if err != nil {
+			cancel()
+			if r.Context().Err() != nil ||
+				!errors.Is(err, context.DeadlineExceeded) ||
+				!idempotent(r.Method) ||
+				attempt >= t.maxRetries {
+				return res, err
+			}
+
+			d := t.retryDelay(nil, attempt)
+			t.logf("timed out on %s %s after %s, retrying in %s (%d/%d)",
+				r.Method, r.URL.String(), t.timeout, d,
+				attempt+1, t.maxRetries)
+			if err := wait(r, d); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if res.StatusCode != http.StatusTooManyRequests ||
+			attempt >= t.maxRetries {
+			t.rememberReset(res)
+			res.Body = cancelOnClose{ReadCloser: res.Body, cancel: cancel}
+			return res, nil
+		}
+
+		d := t.retryDelay(res, attempt)
+		_, _ = io.Copy(io.Discard, res.Body)
+		res.Body.Close()
+		cancel()
+
+		t.logf("rate limited on %s %s, retrying in %s (%d/%d)",
+			r.Method, r.URL.String(), d, attempt+1, t.maxRetries)
+		if err := wait(r, d); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// idempotent informs if sending a request with the method again has the
+// same effect of sending it once
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// cancelOnClose releases the context of an attempt only after its response
+// is read, as cancelling it before would interrupt the reading
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c cancelOnClose) Close() error {
+	defer c.cancel()
+	return c.ReadCloser.Close()
+}
+
+// errRetryWithoutBody is returned when a request has to be retried but its
+// body was already consumed
+var errRetryWithoutBody = errors.New(
+	"can't retry a request whose body can't be read again")
+
+func wait(r *http.Request, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-r.Context().Done():
+		return r.Context().Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (t *retryTransport) untilResume() time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return time.Until(t.resumeAt)
+}
+
+func (t *retryTransport) rememberReset(res *http.Response) {
+	if res.Header.Get("X-RateLimit-Remaining") != "0" {
+		return
+	}
+
+	d, ok := rateLimitReset(res)
+	if !ok {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.resumeAt = time.Now().Add(d)
+}
+
+// retryDelay returns how long to wait before retrying, using the headers
+// of the response when available
+func (t *retryTransport) retryDelay(
+	res *http.Response, attempt int) time.Duration {
+	if res != nil {
+		if d, ok := rateLimitReset(res); ok {
+			return d
+		}
+	}
+
+	d := t.baseDelay
+	if d <= 0 {
+		d = DefaultRetryWait
+	}
+
+	d = d << attempt
+	if d > retryMaxDelay || d <= 0 {
+		return retryMaxDelay
+	}
+
+	return d
+}
+
+// rateLimitReset reads how long until the API accepts requests again,
+// Retry-After can be seconds or a HTTP date and X-RateLimit-Reset can be
+// seconds or a unix timestamp
+func rateLimitReset(res *http.Response) (time.Duration, bool) {
+	if v := res.Header.Get("Retry-After"); v != "" {
+		if s, err := strconv.Atoi(v); err == nil {
+			return capDelay(time.Duration(s) * time.Second), true
+		}
+
+		if t, err := http.ParseTime(v); err == nil {
+			return capDelay(time.Until(t)), true
+		}
+	}
+
+	v := res.Header.Get("X-RateLimit-Reset")
+	s, err := strconv.ParseInt(v, 10, 64)
+	if v == "" || err != nil {
+		return 0, false
+	}
+
+	// values bigger than a year of seconds are unix timestamps
+	if s > 365*24*60*60 {
+		return capDelay(time.Until(time.Unix(s, 0))), true
+	}
+
+	return capDelay(time.Duration(s) * time.Second), true
+}
+
+func capDelay(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+
+	if d > retryMaxDelay {
+		return retryMaxDelay
+	}
+
+	return d
+}
@@ -0,0 +1,148 @@
+package api_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryOnRateLimit(t *testing.T) {
+	tts := []struct {
+		name       string
+		maxRetries int
+		limited    int
+		calls      int
+		err        string
+	}{
+		{
+			name:       "retries until success",
+			maxRetries: 3,
+			limited:    2,
+			calls:      3,
+		},
+		{
+			name:       "gives up after max retries",
+			maxRetries: 1,
+			limited:    5,
+			calls:      2,
+			err:        "add client: rate limited (code: 429)",
+		},
+		{
+			name:       "without retries",
+			maxRetries: 0,
+			limited:    1,
+			calls:      1,
+			err:        "add client: rate limited (code: 429)",
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			s := httptest.NewServer(http.HandlerFunc(
+				func(w http.ResponseWriter, r *http.Request) {
+					calls++
+
+					b, _ := io.ReadAll(r.Body)
+					assert.JSONEq(t, `{"name":"c"}`, string(b))
+
+					if calls <= tt.limited {
+						w.Header().Set("Retry-After", "0")
+						w.WriteHeader(http.StatusTooManyRequests)
+						_, _ = w.Write(
+							[]byte(`{"message":"rate limited","code":429}`))
+						return
+					}
+
+					w.WriteHeader(http.StatusCreated)
+					_, _ = w.Write([]byte(`{"id":"c1","name":"c"}`))
+				}))
+			defer s.Close()
+
+			c, _ := api.NewClientFromUrlAndKey("a-key", s.URL)
+			c.SetMaxRetries(tt.maxRetries)
+
+			r, err := c.AddClient(context.Background(), api.AddClientParam{
+				Workspace: exampleID,
+				Name:      "c",
+			})
+
+			assert.Equal(t, tt.calls, calls)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, dto.Client{ID: "c1", Name: "c"}, r)
+		})
+	}
+}
+
+func TestRetryOnTimeout(t *testing.T) {
+	calls := 0
+	s := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls == 1 {
+				select {
+				case <-r.Context().Done():
+				case <-time.After(300 * time.Millisecond):
+				}
+				return
+			}
+
+			_, _ = w.Write([]byte(`[{"id":"w1","name":"w"}]`))
+		}))
+	defer s.Close()
+
+	c, _ := api.NewClientFromUrlAndKey("a-key", s.URL)
+	c.SetTimeout(50 * time.Millisecond)
+	c.SetRetryWait(time.Millisecond)
+
+	r, err := c.GetWorkspaces(context.Background(), api.GetWorkspaces{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, []dto.Workspace{{ID: "w1", Name: "w"}}, r)
+
+	calls = 0
+	c.SetMaxRetries(0)
+	_, err = c.GetWorkspaces(context.Background(), api.GetWorkspaces{})
+
+	assert.Error(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestTimedOutPostIsNotRetried(t *testing.T) {
+	calls := 0
+	s := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			select {
+			case <-r.Context().Done():
+			case <-time.After(300 * time.Millisecond):
+			}
+		}))
+	defer s.Close()
+
+	c, _ := api.NewClientFromUrlAndKey("a-key", s.URL)
+	c.SetTimeout(50 * time.Millisecond)
+	c.SetRetryWait(time.Millisecond)
+
+	_, err := c.AddClient(context.Background(), api.AddClientParam{
+		Workspace: exampleID,
+		Name:      "c",
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, 1, calls)
+}
@@ -0,0 +1,47 @@
+package api_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+func TestAddTag(t *testing.T) {
+	tts := []testCase{
+		&simpleTestCase{
+			name:  "requires name",
+			param: api.AddTagParam{Workspace: exampleID},
+			err:   "add tag: name is required",
+		},
+		&simpleTestCase{
+			name:  "requires workspace",
+			param: api.AddTagParam{Name: "Development"},
+			err:   "add tag: workspace is required",
+		},
+		&simpleTestCase{
+			name: "add",
+			param: api.AddTagParam{
+				Workspace: exampleID,
+				Name:      "Development",
+			},
+
+			result: dto.Tag{ID: "t1", Name: "Development"},
+
+			requestMethod: "post",
+			requestUrl:    "/v1/workspaces/" + exampleID + "/tags",
+			requestBody:   `{"name":"Development"}`,
+
+			responseStatus: 201,
+			responseBody:   `{"id":"t1","name":"Development"}`,
+		},
+	}
+
+	for _, tt := range tts {
+		runClient(t, tt,
+			func(c api.Client, p interface{}) (interface{}, error) {
+				return c.AddTag(context.Background(), p.(api.AddTagParam))
+			})
+	}
+}
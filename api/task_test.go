@@ -1,6 +1,7 @@
 package api_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -197,7 +198,7 @@ func TestGetTasks(t *testing.T) {
 	for _, tt := range tts {
 		runClient(t, tt,
 			func(c api.Client, p interface{}) (interface{}, error) {
-				return c.GetTasks(
+				return c.GetTasks(context.Background(),
 					p.(api.GetTasksParam))
 			})
 	}
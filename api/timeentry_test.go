@@ -1,12 +1,18 @@
 package api_test
 
 import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/lucassabreu/clockify-cli/api"
 	"github.com/lucassabreu/clockify-cli/api/dto"
 	. "github.com/lucassabreu/clockify-cli/internal/testhlp"
 	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestCreateTimeEntry(t *testing.T) {
@@ -123,8 +129,112 @@ func TestCreateTimeEntry(t *testing.T) {
 	for _, tt := range tts {
 		runClient(t, tt,
 			func(c api.Client, p interface{}) (interface{}, error) {
-				return c.CreateTimeEntry(
+				return c.CreateTimeEntry(context.Background(),
 					p.(api.CreateTimeEntryParam))
 			})
 	}
 }
+
+func TestDeleteTimeEntries(t *testing.T) {
+	ids := make([]string, 60)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("%024x", i)
+	}
+
+	var batches [][]string
+	s := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "DELETE", r.Method)
+			assert.Equal(t, "/v1/workspaces/"+exampleID+"/user/"+exampleID+
+				"/time-entries", r.URL.Path)
+
+			ids := r.URL.Query()["time-entry-ids"]
+			batches = append(batches, ids)
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`[{"id":"` + ids[0] + `"}]`))
+		}))
+	defer s.Close()
+
+	c, _ := api.NewClientFromUrlAndKey("a-key", s.URL)
+	tes, err := c.DeleteTimeEntries(context.Background(),
+		api.DeleteTimeEntriesParam{
+			Workspace:    exampleID,
+			UserID:       exampleID,
+			TimeEntryIDs: ids,
+		})
+
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{ids[:50], ids[50:]}, batches)
+	assert.Equal(t, []dto.TimeEntryImpl{{ID: ids[0]}, {ID: ids[50]}}, tes)
+
+	_, err = c.DeleteTimeEntries(context.Background(),
+		api.DeleteTimeEntriesParam{
+			Workspace:    exampleID,
+			UserID:       exampleID,
+			TimeEntryIDs: []string{exampleID, "te"},
+		})
+	assert.EqualError(t, err,
+		`delete time entries: time entry id ("te") is not valid ID`)
+	assert.Len(t, batches, 2, "should not call the api")
+}
+
+func TestUpdateTimeEntries(t *testing.T) {
+	start := MustParseTime(timehlp.SimplerTimeFormat, "2022-11-07 10:00")
+	end := MustParseTime(timehlp.SimplerTimeFormat, "2022-11-07 11:00")
+
+	s := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "PUT", r.Method)
+			assert.Equal(t, "/v1/workspaces/"+exampleID+"/user/"+exampleID+
+				"/time-entries", r.URL.Path)
+
+			b, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `[
+				{
+					"id": "`+exampleID+`",
+					"start": "2022-11-07T10:00:00Z",
+					"end": "2022-11-07T11:00:00Z",
+					"billable": true,
+					"description": "first",
+					"tagIds": ["tag1"]
+				},
+				{
+					"id": "62f2af744a912b05acc7c79f",
+					"start": "2022-11-07T11:00:00Z",
+					"projectId": "p"
+				}
+			]`, string(b))
+
+			_, _ = w.Write([]byte(`[{"id":"` + exampleID + `"},` +
+				`{"id":"62f2af744a912b05acc7c79f"}]`))
+		}))
+	defer s.Close()
+
+	c, _ := api.NewClientFromUrlAndKey("a-key", s.URL)
+	tes, err := c.UpdateTimeEntries(context.Background(),
+		api.UpdateTimeEntriesParam{
+			Workspace: exampleID,
+			UserID:    exampleID,
+			TimeEntries: []api.UpdateTimeEntryParam{
+				{
+					TimeEntryID: exampleID,
+					Start:       start,
+					End:         &end,
+					Billable:    true,
+					Description: "first",
+					TagIDs:      []string{"tag1"},
+				},
+				{
+					TimeEntryID: "62f2af744a912b05acc7c79f",
+					Start:       end,
+					ProjectID:   "p",
+				},
+			},
+		})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []dto.TimeEntryImpl{
+		{ID: exampleID},
+		{ID: "62f2af744a912b05acc7c79f"},
+	}, tes)
+}
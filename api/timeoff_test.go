@@ -0,0 +1,178 @@
+package api_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+func TestGetTimeOffPolicies(t *testing.T) {
+	uri := "/v1/workspaces/" + exampleID + "/time-off/policies"
+	b := false
+
+	tts := []testCase{
+		&simpleTestCase{
+			name:  "requires workspace",
+			param: api.GetTimeOffPoliciesParam{},
+			err:   "get time off policies: workspace is required",
+		},
+		&simpleTestCase{
+			name: "active",
+			param: api.GetTimeOffPoliciesParam{
+				Workspace: exampleID,
+				Archived:  &b,
+			},
+
+			result: []dto.TimeOffPolicy{
+				{ID: "p1", Name: "Vacation", TimeUnit: "DAYS"},
+			},
+
+			requestMethod: "get",
+			requestUrl:    uri + "?page-size=50&status=ACTIVE",
+
+			responseStatus: 200,
+			responseBody:   `[{"id":"p1","name":"Vacation","timeUnit":"DAYS"}]`,
+		},
+	}
+
+	for _, tt := range tts {
+		runClient(t, tt,
+			func(c api.Client, p interface{}) (interface{}, error) {
+				return c.GetTimeOffPolicies(context.Background(),
+					p.(api.GetTimeOffPoliciesParam))
+			})
+	}
+}
+
+func TestAddTimeOffRequest(t *testing.T) {
+	errPrefix := `add time off request: `
+	start := time.Date(2023, 8, 7, 0, 0, 0, 0, time.UTC)
+
+	tts := []testCase{
+		&simpleTestCase{
+			name:  "requires policy",
+			param: api.AddTimeOffRequestParam{Workspace: exampleID},
+			err:   errPrefix + "policy id is required",
+		},
+		&simpleTestCase{
+			name: "end after start",
+			param: api.AddTimeOffRequestParam{
+				Workspace: exampleID,
+				PolicyID:  exampleID,
+				Start:     start,
+				End:       start.Add(-time.Hour),
+			},
+			err: errPrefix + "end must be after start",
+		},
+		&simpleTestCase{
+			name: "a week",
+			param: api.AddTimeOffRequestParam{
+				Workspace: exampleID,
+				PolicyID:  exampleID,
+				Start:     start,
+				End:       start.AddDate(0, 0, 5).Add(-time.Second),
+				Note:      "trip",
+			},
+
+			result: dto.TimeOffRequest{ID: "r1"},
+
+			requestMethod: "post",
+			requestUrl: "/v1/workspaces/" + exampleID + "/policies/" +
+				exampleID + "/requests",
+			requestBody: `{
+				"timeOffPeriod":{
+					"period":{
+						"start":"2023-08-07T00:00:00Z",
+						"end":"2023-08-11T23:59:59Z"
+					},
+					"isHalfDay":false
+				},
+				"note":"trip"
+			}`,
+
+			responseStatus: 201,
+			responseBody:   `{"id":"r1"}`,
+		},
+	}
+
+	for _, tt := range tts {
+		runClient(t, tt,
+			func(c api.Client, p interface{}) (interface{}, error) {
+				return c.AddTimeOffRequest(context.Background(),
+					p.(api.AddTimeOffRequestParam))
+			})
+	}
+}
+
+func TestGetTimeOffRequests(t *testing.T) {
+	uri := "/v1/workspaces/" + exampleID + "/requests"
+
+	tts := []testCase{
+		(&multiRequestTestCase{
+			name: "pending of a user",
+			param: api.GetTimeOffRequestsParam{
+				Workspace:       exampleID,
+				Statuses:        []dto.TimeOffStatus{"PENDING"},
+				Users:           []string{"u1"},
+				PaginationParam: api.AllPages(),
+			},
+			result: []dto.TimeOffRequest{{ID: "r1"}},
+		}).
+			addHttpCall(&httpRequest{
+				method: "post",
+				url:    uri,
+				body: `{"statuses":["PENDING"],"users":["u1"],
+					"page":1,"pageSize":50}`,
+				status:   200,
+				response: `{"count":1,"requests":[{"id":"r1"}]}`,
+			}),
+	}
+
+	for _, tt := range tts {
+		runClient(t, tt,
+			func(c api.Client, p interface{}) (interface{}, error) {
+				return c.GetTimeOffRequests(context.Background(),
+					p.(api.GetTimeOffRequestsParam))
+			})
+	}
+}
+
+func TestGetTimeOffBalances(t *testing.T) {
+	tts := []testCase{
+		&simpleTestCase{
+			name:  "requires user",
+			param: api.GetTimeOffBalancesParam{Workspace: exampleID},
+			err:   "get time off balances: user id is required",
+		},
+		&simpleTestCase{
+			name: "balances",
+			param: api.GetTimeOffBalancesParam{
+				Workspace: exampleID,
+				UserID:    exampleID,
+			},
+
+			result: []dto.TimeOffBalance{
+				{PolicyName: "Vacation", Balance: 12.5, Used: 7.5, Total: 20},
+			},
+
+			requestMethod: "get",
+			requestUrl: "/v1/workspaces/" + exampleID + "/balance/user/" +
+				exampleID + "?page-size=50",
+
+			responseStatus: 200,
+			responseBody: `{"count":1,"balances":[{"policyName":"Vacation",
+				"balance":12.5,"used":7.5,"total":20}]}`,
+		},
+	}
+
+	for _, tt := range tts {
+		runClient(t, tt,
+			func(c api.Client, p interface{}) (interface{}, error) {
+				return c.GetTimeOffBalances(context.Background(),
+					p.(api.GetTimeOffBalancesParam))
+			})
+	}
+}
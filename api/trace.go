@@ -0,0 +1,102 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxTraceBody is how many bytes of a body are shown when tracing
+const maxTraceBody = 1024
+
+// traceTransport writes each request sent and the response received to out,
+// with the token redacted and the bodies truncated, so the output can be
+// shared when reporting issues
+type traceTransport struct {
+	next http.RoundTripper
+	out  io.Writer
+	now  func() time.Time
+
+	mu sync.Mutex
+}
+
+// NewTraceTransport decorates the http.RoundTripper to write a summary of
+// every request and response to out
+func NewTraceTransport(
+	next http.RoundTripper, out io.Writer) http.RoundTripper {
+	return &traceTransport{next: next, out: out, now: time.Now}
+}
+
+func (t *traceTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	b := new(strings.Builder)
+	fmt.Fprintf(b, "> %s %s\n", r.Method, r.URL.String())
+	if k := r.Header.Get("X-Api-Key"); k != "" {
+		fmt.Fprintf(b, "> X-Api-Key: %s\n", redact(k))
+	}
+
+	if r.Body != nil && r.GetBody != nil {
+		if body, err := r.GetBody(); err == nil {
+			data, _ := io.ReadAll(body)
+			body.Close()
+			fmt.Fprintf(b, "> %s\n", truncateBody(data))
+		}
+	}
+
+	start := t.now()
+	res, err := t.next.RoundTrip(r)
+	latency := t.now().Sub(start).Round(time.Millisecond)
+	if err != nil {
+		fmt.Fprintf(b, "< %s %s failed after %s: %s\n",
+			r.Method, r.URL.Path, latency, err)
+		t.write(b.String())
+		return res, err
+	}
+
+	fmt.Fprintf(b, "< %s %s %s (%s)\n",
+		res.Status, r.Method, r.URL.Path, latency)
+
+	data, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		t.write(b.String())
+		return nil, err
+	}
+
+	res.Body = io.NopCloser(bytes.NewReader(data))
+	if len(data) > 0 {
+		fmt.Fprintf(b, "< %s\n", truncateBody(data))
+	}
+
+	t.write(b.String())
+	return res, nil
+}
+
+func (t *traceTransport) write(s string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	_, _ = io.WriteString(t.out, s)
+}
+
+// redact hides the token, keeping only its last characters to tell which
+// one was used
+func redact(token string) string {
+	if len(token) <= 8 {
+		return "[redacted]"
+	}
+
+	return "[redacted]..." + token[len(token)-4:]
+}
+
+func truncateBody(b []byte) string {
+	b = bytes.TrimSpace(b)
+	if len(b) <= maxTraceBody {
+		return string(b)
+	}
+
+	return fmt.Sprintf("%s... (%d bytes)", b[:maxTraceBody], len(b))
+}
@@ -0,0 +1,62 @@
+package api_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPTrace(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			b, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{"name":"c"}`, string(b))
+
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"c1","name":"` +
+				strings.Repeat("c", 2000) + `"}`))
+		}))
+	defer s.Close()
+
+	out := new(bytes.Buffer)
+	c, _ := api.NewClientFromUrlAndKey("a-secret-api-key", s.URL)
+	c.SetHTTPTrace(out)
+
+	r, err := c.AddClient(context.Background(), api.AddClientParam{
+		Workspace: exampleID,
+		Name:      "c",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, dto.Client{ID: "c1", Name: strings.Repeat("c", 2000)}, r)
+
+	l := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if !assert.Len(t, l, 5) {
+		return
+	}
+
+	assert.Equal(t, "> POST "+s.URL+"/v1/workspaces/"+exampleID+"/clients",
+		l[0])
+	assert.Equal(t, "> X-Api-Key: [redacted]...-key", l[1])
+	assert.Equal(t, `> {"name":"c"}`, l[2])
+	assert.Regexp(t, `^< 201 Created POST /v1/workspaces/`+exampleID+
+		`/clients \(\d+m?s\)$`, l[3])
+	assert.True(t, strings.HasSuffix(l[4], "... (2021 bytes)"), l[4])
+	assert.NotContains(t, out.String(), "a-secret-api-key")
+
+	out.Reset()
+	c.SetHTTPTrace(nil)
+	_, err = c.AddClient(context.Background(), api.AddClientParam{
+		Workspace: exampleID,
+		Name:      "c",
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, out.String())
+}
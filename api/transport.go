@@ -0,0 +1,99 @@
+package api
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+// TransportParam sets how the clients connect to the API, useful behind
+// corporate proxies that inspect the traffic
+type TransportParam struct {
+	// Proxy is the url of the proxy to be used, when empty the proxy is
+	// read from $HTTPS_PROXY, $HTTP_PROXY and $NO_PROXY
+	Proxy string
+	// CABundle is a PEM file with certificates to be trusted besides the
+	// ones of the system
+	CABundle string
+	// InsecureSkipVerify disables the verification of the certificates
+	InsecureSkipVerify bool
+}
+
+// maxIdleConnsPerHost is how many connections are kept open to the API to
+// be reused, more than the pages fetched at the same time
+const maxIdleConnsPerHost = 16
+
+// DefaultTransport is the http.RoundTripper used by the clients when none
+// is set. It keeps more connections open than http.DefaultTransport, so
+// the pages fetched concurrently reuse them
+var DefaultTransport http.RoundTripper = newHTTPTransport()
+
+func newHTTPTransport() *http.Transport {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.MaxIdleConnsPerHost = maxIdleConnsPerHost
+	return t
+}
+
+// NewTransport creates a http.RoundTripper to connect to the API using the
+// proxy and certificates set
+func NewTransport(p TransportParam) (http.RoundTripper, error) {
+	t := newHTTPTransport()
+
+	if p.Proxy != "" {
+		u, err := url.Parse(p.Proxy)
+		if err != nil || u.Host == "" {
+			return nil, errors.Errorf("proxy %s is not a valid url", p.Proxy)
+		}
+		t.Proxy = http.ProxyURL(u)
+	}
+
+	if p.CABundle == "" && !p.InsecureSkipVerify {
+		return t, nil
+	}
+
+	t.TLSClientConfig = &tls.Config{
+		// #nosec G402 -- only when asked by the user
+		InsecureSkipVerify: p.InsecureSkipVerify,
+	}
+
+	if p.CABundle == "" {
+		return t, nil
+	}
+
+	b, err := os.ReadFile(p.CABundle)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read the CA bundle")
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+
+	if !pool.AppendCertsFromPEM(b) {
+		return nil, errors.Errorf(
+			"no certificates were found on %s", p.CABundle)
+	}
+	t.TLSClientConfig.RootCAs = pool
+
+	return t, nil
+}
+
+// wrapTransport returns the transport to send the requests through, tracing
+// them when out is set
+func wrapTransport(t http.RoundTripper, out io.Writer) http.RoundTripper {
+	if t == nil {
+		t = DefaultTransport
+	}
+
+	if out == nil {
+		return t
+	}
+
+	return NewTraceTransport(t, out)
+}
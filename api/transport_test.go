@@ -0,0 +1,170 @@
+package api_test
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTransportErrors(t *testing.T) {
+	dir := t.TempDir()
+	empty := filepath.Join(dir, "empty.pem")
+	_ = os.WriteFile(empty, []byte("nothing here"), 0600)
+
+	tts := []struct {
+		name  string
+		param api.TransportParam
+		err   string
+	}{
+		{
+			name:  "invalid proxy",
+			param: api.TransportParam{Proxy: "not a url"},
+			err:   "proxy not a url is not a valid url",
+		},
+		{
+			name:  "missing bundle",
+			param: api.TransportParam{CABundle: filepath.Join(dir, "no.pem")},
+			err:   "failed to read the CA bundle.*",
+		},
+		{
+			name:  "bundle without certificates",
+			param: api.TransportParam{CABundle: empty},
+			err:   "no certificates were found on .*empty.pem",
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := api.NewTransport(tt.param)
+			if assert.Error(t, err) {
+				assert.Regexp(t, tt.err, err.Error())
+			}
+		})
+	}
+}
+
+func TestNewTransportTLS(t *testing.T) {
+	s := httptest.NewUnstartedServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"id":"u1"}`))
+		}))
+	s.Config.ErrorLog = log.New(io.Discard, "", 0)
+	s.StartTLS()
+	defer s.Close()
+
+	bundle := filepath.Join(t.TempDir(), "ca.pem")
+	_ = os.WriteFile(bundle, pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: s.Certificate().Raw,
+	}), 0600)
+
+	tts := []struct {
+		name  string
+		param *api.TransportParam
+		err   bool
+	}{
+		{name: "untrusted", err: true},
+		{name: "ca bundle", param: &api.TransportParam{CABundle: bundle}},
+		{
+			name:  "insecure",
+			param: &api.TransportParam{InsecureSkipVerify: true},
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := api.NewClientFromUrlAndKey("a-key", s.URL)
+			c.SetMaxRetries(0)
+			if tt.param != nil {
+				tr, err := api.NewTransport(*tt.param)
+				if !assert.NoError(t, err) {
+					return
+				}
+				c.SetTransport(tr)
+			}
+
+			u, err := c.GetMe(context.Background())
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, dto.User{ID: "u1"}, u)
+		})
+	}
+}
+
+func TestNewTransportProxy(t *testing.T) {
+	proxied := ""
+	p := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			proxied = r.URL.String()
+			_, _ = w.Write([]byte(`{"id":"u1"}`))
+		}))
+	defer p.Close()
+
+	tr, err := api.NewTransport(api.TransportParam{Proxy: p.URL})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	c, _ := api.NewClientFromUrlAndKey("a-key", "http://clockify.example")
+	c.SetTransport(tr)
+
+	_, err = c.GetMe(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "http://clockify.example/v1/user", proxied)
+}
+
+func TestDefaultTransportReusesConnections(t *testing.T) {
+	const total = 40
+	var conns int32
+	s := httptest.NewUnstartedServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+
+			w.Header().Set("X-Total-Count", strconv.Itoa(total))
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(fmt.Sprintf(`[{"id":"t%d"}]`, page)))
+		}))
+	s.Config.ConnState = func(_ net.Conn, cs http.ConnState) {
+		if cs == http.StateNew {
+			atomic.AddInt32(&conns, 1)
+		}
+	}
+	s.Start()
+	defer s.Close()
+
+	c, _ := api.NewClientFromUrlAndKey("a-key", s.URL)
+	for i := 0; i < 2; i++ {
+		tags, err := c.GetTags(context.Background(), api.GetTagsParam{
+			Workspace: exampleID,
+			PaginationParam: api.PaginationParam{
+				AllPages: true,
+				PageSize: 1,
+			},
+		})
+		assert.NoError(t, err)
+		assert.Len(t, tags, total)
+	}
+
+	assert.LessOrEqual(t, atomic.LoadInt32(&conns), int32(5),
+		"connections should be reused between the pages")
+}
@@ -0,0 +1,157 @@
+package api_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+func TestGetUserGroups(t *testing.T) {
+	tts := []testCase{
+		&simpleTestCase{
+			name:  "requires workspace",
+			param: api.GetUserGroupsParam{},
+			err:   "get user groups: workspace is required",
+		},
+		&simpleTestCase{
+			name: "by name",
+			param: api.GetUserGroupsParam{
+				Workspace: exampleID,
+				Name:      "dev",
+			},
+
+			result: []dto.UserGroup{
+				{ID: "g1", Name: "Developers", UserIDs: []string{"u1"}},
+			},
+
+			requestMethod: "get",
+			requestUrl: "/v1/workspaces/" + exampleID +
+				"/user-groups?name=dev&page-size=50",
+
+			responseStatus: 200,
+			responseBody: `[{"id":"g1","name":"Developers",
+				"userIds":["u1"]}]`,
+		},
+	}
+
+	for _, tt := range tts {
+		runClient(t, tt,
+			func(c api.Client, p interface{}) (interface{}, error) {
+				return c.GetUserGroups(context.Background(),
+					p.(api.GetUserGroupsParam))
+			})
+	}
+}
+
+func TestAddUserGroup(t *testing.T) {
+	tts := []testCase{
+		&simpleTestCase{
+			name:  "requires name",
+			param: api.AddUserGroupParam{Workspace: exampleID},
+			err:   "add user group: name is required",
+		},
+		&simpleTestCase{
+			name: "add",
+			param: api.AddUserGroupParam{
+				Workspace: exampleID,
+				Name:      "Developers",
+			},
+
+			result: dto.UserGroup{ID: "g1", Name: "Developers"},
+
+			requestMethod: "post",
+			requestUrl:    "/v1/workspaces/" + exampleID + "/user-groups",
+			requestBody:   `{"name":"Developers"}`,
+
+			responseStatus: 201,
+			responseBody:   `{"id":"g1","name":"Developers"}`,
+		},
+	}
+
+	for _, tt := range tts {
+		runClient(t, tt,
+			func(c api.Client, p interface{}) (interface{}, error) {
+				return c.AddUserGroup(context.Background(),
+					p.(api.AddUserGroupParam))
+			})
+	}
+}
+
+func TestUserGroupMembers(t *testing.T) {
+	uri := "/v1/workspaces/" + exampleID + "/user-groups/" + exampleID +
+		"/users"
+
+	add := []testCase{
+		&simpleTestCase{
+			name: "requires user",
+			param: api.UserGroupMemberParam{
+				Workspace:   exampleID,
+				UserGroupID: exampleID,
+			},
+			err: "add user to group: user id is required",
+		},
+		&simpleTestCase{
+			name: "valid group",
+			param: api.UserGroupMemberParam{
+				Workspace:   exampleID,
+				UserGroupID: "g",
+				UserID:      exampleID,
+			},
+			err: "add user to group: user group id .* is not valid ID",
+		},
+		&simpleTestCase{
+			name: "add",
+			param: api.UserGroupMemberParam{
+				Workspace:   exampleID,
+				UserGroupID: exampleID,
+				UserID:      exampleID,
+			},
+
+			result: dto.UserGroup{ID: "g1", UserIDs: []string{exampleID}},
+
+			requestMethod: "post",
+			requestUrl:    uri,
+			requestBody:   `{"userId":"` + exampleID + `"}`,
+
+			responseStatus: 200,
+			responseBody:   `{"id":"g1","userIds":["` + exampleID + `"]}`,
+		},
+	}
+
+	for _, tt := range add {
+		runClient(t, tt,
+			func(c api.Client, p interface{}) (interface{}, error) {
+				return c.AddUserToGroup(context.Background(),
+					p.(api.UserGroupMemberParam))
+			})
+	}
+
+	remove := []testCase{
+		&simpleTestCase{
+			name: "remove",
+			param: api.UserGroupMemberParam{
+				Workspace:   exampleID,
+				UserGroupID: exampleID,
+				UserID:      exampleID,
+			},
+
+			result: dto.UserGroup{ID: "g1", UserIDs: []string{}},
+
+			requestMethod: "delete",
+			requestUrl:    uri + "/" + exampleID,
+
+			responseStatus: 200,
+			responseBody:   `{"id":"g1","userIds":[]}`,
+		},
+	}
+
+	for _, tt := range remove {
+		runClient(t, tt,
+			func(c api.Client, p interface{}) (interface{}, error) {
+				return c.RemoveUserFromGroup(context.Background(),
+					p.(api.UserGroupMemberParam))
+			})
+	}
+}
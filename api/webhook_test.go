@@ -0,0 +1,187 @@
+package api_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+func TestGetWebhooks(t *testing.T) {
+	errPrefix := `get webhooks: `
+	uri := "/v1/workspaces/" + exampleID + "/webhooks"
+
+	tts := []testCase{
+		&simpleTestCase{
+			name:  "requires workspace",
+			param: api.GetWebhooksParam{},
+			err:   errPrefix + "workspace is required",
+		},
+		&simpleTestCase{
+			name:  "valid workspace",
+			param: api.GetWebhooksParam{Workspace: "w"},
+			err:   errPrefix + "workspace .* is not valid ID",
+		},
+		&simpleTestCase{
+			name:  "list",
+			param: api.GetWebhooksParam{Workspace: exampleID},
+
+			result: []dto.Webhook{{
+				ID:           "h1",
+				Name:         "hook",
+				URL:          "https://example.com",
+				WebhookEvent: dto.WebhookEventNewTimeEntry,
+			}},
+
+			requestMethod: "get",
+			requestUrl:    uri,
+
+			responseStatus: 200,
+			responseBody: `{"workspaceWebhookCount":1,"webhooks":[{
+				"id":"h1","name":"hook","url":"https://example.com",
+				"webhookEvent":"NEW_TIME_ENTRY"
+			}]}`,
+		},
+		&simpleTestCase{
+			name:  "empty",
+			param: api.GetWebhooksParam{Workspace: exampleID},
+
+			result: []dto.Webhook{},
+
+			requestMethod: "get",
+			requestUrl:    uri,
+
+			responseStatus: 200,
+			responseBody:   `{"workspaceWebhookCount":0}`,
+		},
+	}
+
+	for _, tt := range tts {
+		runClient(t, tt,
+			func(c api.Client, p interface{}) (interface{}, error) {
+				return c.GetWebhooks(context.Background(),
+					p.(api.GetWebhooksParam))
+			})
+	}
+}
+
+func TestAddWebhook(t *testing.T) {
+	errPrefix := `add webhook: `
+	uri := "/v1/workspaces/" + exampleID + "/webhooks"
+
+	tts := []testCase{
+		&simpleTestCase{
+			name: "requires url",
+			param: api.AddWebhookParam{
+				Workspace: exampleID,
+				Name:      "hook",
+				Event:     dto.WebhookEventNewTimeEntry,
+			},
+			err: errPrefix + "url is required",
+		},
+		&simpleTestCase{
+			name: "requires event",
+			param: api.AddWebhookParam{
+				Workspace: exampleID,
+				Name:      "hook",
+				URL:       "https://example.com",
+			},
+			err: errPrefix + "webhook event is required",
+		},
+		&simpleTestCase{
+			name: "whole workspace by default",
+			param: api.AddWebhookParam{
+				Workspace: exampleID,
+				Name:      "hook",
+				URL:       "https://example.com",
+				Event:     dto.WebhookEventNewTimeEntry,
+			},
+
+			result: dto.Webhook{ID: "h1", Name: "hook"},
+
+			requestMethod: "post",
+			requestUrl:    uri,
+			requestBody: `{
+				"name":"hook",
+				"url":"https://example.com",
+				"webhookEvent":"NEW_TIME_ENTRY",
+				"triggerSourceType":"WORKSPACE_ID",
+				"triggerSource":["` + exampleID + `"]
+			}`,
+
+			responseStatus: 201,
+			responseBody:   `{"id":"h1","name":"hook"}`,
+		},
+		&simpleTestCase{
+			name: "only some projects",
+			param: api.AddWebhookParam{
+				Workspace:         exampleID,
+				Name:              "hook",
+				URL:               "https://example.com",
+				Event:             dto.WebhookEventTimerStopped,
+				TriggerSourceType: dto.WebhookTriggerSourceProject,
+				TriggerSource:     []string{"p1", "p2"},
+			},
+
+			result: dto.Webhook{ID: "h1", Name: "hook"},
+
+			requestMethod: "post",
+			requestUrl:    uri,
+			requestBody: `{
+				"name":"hook",
+				"url":"https://example.com",
+				"webhookEvent":"TIMER_STOPPED",
+				"triggerSourceType":"PROJECT_ID",
+				"triggerSource":["p1","p2"]
+			}`,
+
+			responseStatus: 201,
+			responseBody:   `{"id":"h1","name":"hook"}`,
+		},
+	}
+
+	for _, tt := range tts {
+		runClient(t, tt,
+			func(c api.Client, p interface{}) (interface{}, error) {
+				return c.AddWebhook(context.Background(),
+					p.(api.AddWebhookParam))
+			})
+	}
+}
+
+func TestDeleteWebhook(t *testing.T) {
+	errPrefix := `delete webhook: `
+	uri := "/v1/workspaces/" + exampleID + "/webhooks/" + exampleID
+
+	tts := []testCase{
+		&simpleTestCase{
+			name:  "requires webhook",
+			param: api.DeleteWebhookParam{Workspace: exampleID},
+			err:   errPrefix + "webhook id is required",
+		},
+		&simpleTestCase{
+			name: "delete",
+			param: api.DeleteWebhookParam{
+				Workspace: exampleID,
+				WebhookID: exampleID,
+			},
+
+			result: dto.Webhook{ID: exampleID, Name: "hook"},
+
+			requestMethod: "delete",
+			requestUrl:    uri,
+
+			responseStatus: 200,
+			responseBody:   `{"id":"` + exampleID + `","name":"hook"}`,
+		},
+	}
+
+	for _, tt := range tts {
+		runClient(t, tt,
+			func(c api.Client, p interface{}) (interface{}, error) {
+				return c.DeleteWebhook(context.Background(),
+					p.(api.DeleteWebhookParam))
+			})
+	}
+}
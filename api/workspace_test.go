@@ -0,0 +1,78 @@
+package api_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+func TestUpdateWorkspaceSettings(t *testing.T) {
+	tts := []testCase{
+		&simpleTestCase{
+			name:  "requires workspace",
+			param: api.UpdateWorkspaceSettingsParam{},
+			err:   "update workspace settings: workspace is required",
+		},
+		&simpleTestCase{
+			name: "update",
+			param: api.UpdateWorkspaceSettingsParam{
+				Workspace: exampleID,
+				Settings: dto.WorkspaceSettings{
+					ForceProjects: true,
+					Round:         dto.Round{Minutes: "15", Round: "Round up to"},
+				},
+			},
+
+			result: dto.WorkspaceSettings{
+				ForceProjects: true,
+				Round:         dto.Round{Minutes: "15", Round: "Round up to"},
+			},
+
+			requestMethod: "put",
+			requestUrl:    "/v1/workspaces/" + exampleID + "/settings",
+			requestBody: `{
+				"adminOnlyPages":null,
+				"automaticLock":{"changeDay":"","dayOfMonth":0,"firstDay":"",
+					"olderThanPeriod":"","olderThanValue":0,"type":""},
+				"canSeeTimeSheet":false,
+				"defaultBillableProjects":false,
+				"forceDescription":false,
+				"forceProjects":true,
+				"forceTags":false,
+				"forceTasks":false,
+				"lockTimeEntries":"0001-01-01T00:00:00Z",
+				"onlyAdminsCreateProject":false,
+				"onlyAdminsCreateTag":false,
+				"onlyAdminsCreateTask":false,
+				"onlyAdminsSeeAllTimeEntries":false,
+				"onlyAdminsSeeBillableRates":false,
+				"onlyAdminsSeeDashboard":false,
+				"onlyAdminsSeePublicProjectsEntries":false,
+				"projectFavorites":false,
+				"projectGroupingLabel":"",
+				"projectPickerSpecialFilter":false,
+				"round":{"minutes":"15","round":"Round up to"},
+				"timeRoundingInReports":false,
+				"trackTimeDownToSecond":false,
+				"isProjectPublicByDefault":false,
+				"canSeeTracker":false,
+				"featureSubscriptionType":""
+			}`,
+
+			responseStatus: 200,
+			responseBody: `{"forceProjects":true,
+				"round":{"minutes":"15","round":"Round up to"},
+				"lockTimeEntries":"0001-01-01T00:00:00Z"}`,
+		},
+	}
+
+	for _, tt := range tts {
+		runClient(t, tt,
+			func(c api.Client, p interface{}) (interface{}, error) {
+				return c.UpdateWorkspaceSettings(context.Background(),
+					p.(api.UpdateWorkspaceSettingsParam))
+			})
+	}
+}
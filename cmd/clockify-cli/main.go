@@ -1,14 +1,18 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
+	"os/signal"
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2/terminal"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
 	"github.com/mitchellh/go-homedir"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -21,19 +25,21 @@ var (
 	date    = "unknown"
 )
 
-const (
-	exitOK     = 0
-	exitError  = 1
-	exitCancel = 2
-)
-
 func main() {
 	exitCode := execute()
 	os.Exit(exitCode)
 }
 
 func execute() int {
-	f := cmdutil.NewFactory(cmdutil.Version{
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	go func() {
+		// a second interrupt should kill the process as usual
+		<-ctx.Done()
+		stop()
+	}()
+
+	f := cmdutil.NewFactory(ctx, cmdutil.Version{
 		Tag:    version,
 		Commit: commit,
 		Date:   date,
@@ -45,27 +51,34 @@ func execute() int {
 	})
 
 	cmd := rootCmd
-	err := bindViper(rootCmd)
+	err := bindViper(rootCmd, f.Config())
 
 	if err == nil {
-		cmd, err = rootCmd.ExecuteC()
+		cmd, err = rootCmd.ExecuteContextC(ctx)
 	}
 
 	if err == nil {
-		return exitOK
+		return cmdutil.ExitOK
 	}
 
 	stderr := cmd.ErrOrStderr()
-	if errors.Is(err, terminal.InterruptErr) {
+	if f.Config().GetBool(cmdutil.CONF_JSON_ERRORS) {
+		je := cmdutil.NewJSONError(err)
+		_ = json.NewEncoder(stderr).Encode(je)
+		return je.ExitCode
+	}
+
+	if errors.Is(err, terminal.InterruptErr) ||
+		errors.Is(err, context.Canceled) {
 		fmt.Fprintln(stderr)
-		return exitCancel
+		return cmdutil.ExitCancel
 	}
 
 	var flagError *cmdutil.FlagError
 	if errors.As(err, &flagError) {
 		fmt.Fprintln(stderr, flagError.Error())
 		fmt.Fprintln(stderr, cmd.UsageString())
-		return exitError
+		return cmdutil.ExitError
 	}
 
 	if f.Config().IsDebuging() {
@@ -74,10 +87,10 @@ func execute() int {
 		fmt.Fprintln(stderr, err.Error())
 	}
 
-	return exitError
+	return cmdutil.ExitError
 }
 
-func bindViper(rootCmd *cobra.Command) error {
+func bindViper(rootCmd *cobra.Command, config cmdutil.Config) error {
 	envPrefix := "CLOCKIFY"
 	bind := func(flag *pflag.Flag, conf, sufix string) error {
 		if flag == nil {
@@ -110,6 +123,13 @@ func bindViper(rootCmd *cobra.Command) error {
 		return err
 	}
 
+	if err = bind(l("no-color"), cmdutil.CONF_NO_COLOR,
+		"NO_COLOR"); err != nil {
+		return err
+	}
+	viper.SetDefault(cmdutil.CONF_COLOR_PROJECT, true)
+	viper.SetDefault(cmdutil.CONF_JOURNAL_SIZE, 10)
+
 	viper.RegisterAlias(cmdutil.CONF_ALLOW_NAME_FOR_ID, "allow-project-name")
 	if err = bind(l("allow-name-for-id"), cmdutil.CONF_ALLOW_NAME_FOR_ID,
 		"ALLOW_NAME_FOR_ID"); err != nil {
@@ -121,6 +141,72 @@ func bindViper(rootCmd *cobra.Command) error {
 		return err
 	}
 
+	if err = bind(l("max-retries"), cmdutil.CONF_MAX_RETRIES,
+		"MAX_RETRIES"); err != nil {
+		return err
+	}
+
+	if err = bind(l("retry-wait"), cmdutil.CONF_RETRY_WAIT,
+		"RETRY_WAIT"); err != nil {
+		return err
+	}
+
+	if err = bind(l("http-timeout"), cmdutil.CONF_HTTP_TIMEOUT,
+		"HTTP_TIMEOUT"); err != nil {
+		return err
+	}
+
+	if err = bind(l("proxy"), cmdutil.CONF_PROXY, "PROXY"); err != nil {
+		return err
+	}
+
+	if err = bind(l("http-cache"), cmdutil.CONF_HTTP_CACHE,
+		"HTTP_CACHE"); err != nil {
+		return err
+	}
+
+	if err = bind(l("cache-ttl"), cmdutil.CONF_CACHE_TTL,
+		"CACHE_TTL"); err != nil {
+		return err
+	}
+
+	if err = bind(l("api-url"), cmdutil.CONF_API_URL,
+		"API_URL"); err != nil {
+		return err
+	}
+
+	if err = bind(l("reports-api-url"), cmdutil.CONF_REPORTS_API_URL,
+		"REPORTS_API_URL"); err != nil {
+		return err
+	}
+
+	if err = bind(l("debug-http"), cmdutil.CONF_DEBUG_HTTP,
+		"DEBUG"); err != nil {
+		return err
+	}
+	if err = viper.BindEnv(cmdutil.CONF_DEBUG_HTTP,
+		envPrefix+"_DEBUG", envPrefix+"_DEBUG_HTTP"); err != nil {
+		return err
+	}
+
+	if err = bind(l("profile"), cmdutil.CONF_PROFILE,
+		"PROFILE"); err != nil {
+		return err
+	}
+
+	if err = bind(l("json-errors"), cmdutil.CONF_JSON_ERRORS,
+		"JSON_ERRORS"); err != nil {
+		return err
+	}
+
+	if err = bind(l("record"), cmdutil.CONF_RECORD, "RECORD"); err != nil {
+		return err
+	}
+
+	if err = bind(l("replay"), cmdutil.CONF_REPLAY, "REPLAY"); err != nil {
+		return err
+	}
+
 	if err = bind(l("interactive-page-size"),
 		cmdutil.CONF_INTERACTIVE_PAGE_SIZE,
 		"INTERACTIVE_PAGE_SIZE"); err != nil {
@@ -150,6 +236,33 @@ func bindViper(rootCmd *cobra.Command) error {
 			}
 		}
 
+		for name, conf := range map[string]string{
+			"duration-format": cmdutil.CONF_DURATION_FORMAT,
+			"time-zone":       cmdutil.CONF_TIME_ZONE,
+			"locale":          cmdutil.CONF_LOCALE,
+			"split-midnight":  cmdutil.CONF_SPLIT_MIDNIGHT,
+		} {
+			flag := cmd.Flags().Lookup(name)
+			if flag == nil || flag.Changed {
+				continue
+			}
+
+			if d := viper.GetString(conf); d != "" {
+				if err := flag.Value.Set(d); err != nil {
+					return err
+				}
+			}
+		}
+
+		if err := applyColorTheme(config); err != nil {
+			return err
+		}
+
+		if err := cmdutil.ApplyTemplateSource(
+			cmd.Flags(), config, cmd.InOrStdin()); err != nil {
+			return err
+		}
+
 		if flag := cmd.Flags().Lookup("allow-incomplete"); flag != nil {
 			if err := bind(flag, cmdutil.CONF_ALLOW_INCOMPLETE,
 				"ALLOW_INCOMPLETE"); err != nil {
@@ -179,8 +292,15 @@ func bindViper(rootCmd *cobra.Command) error {
 		viper.AutomaticEnv()
 
 		err := viper.ReadInConfig()
+		// registered after reading, so the value on the file is moved to
+		// the key it is an alias of
+		viper.RegisterAlias(cmdutil.CONF_RETRY_COUNT, cmdutil.CONF_MAX_RETRIES)
 		if errors.As(err, &viper.ConfigFileNotFoundError{}) {
-			return
+			err = nil
+		}
+
+		if err == nil {
+			err = cmdutil.UseProfile()
 		}
 
 		viperErr = err
@@ -188,3 +308,26 @@ func bindViper(rootCmd *cobra.Command) error {
 
 	return nil
 }
+
+// applyColorTheme sets the colors used on the outputs from the config
+func applyColorTheme(config cmdutil.Config) error {
+	util.SetNoColor(config.GetBool(cmdutil.CONF_NO_COLOR))
+
+	header, err := util.ParseColor(config.GetString(cmdutil.CONF_COLOR_HEADER))
+	if err != nil {
+		return err
+	}
+
+	total, err := util.ParseColor(config.GetString(cmdutil.CONF_COLOR_TOTAL))
+	if err != nil {
+		return err
+	}
+
+	util.SetTheme(util.Theme{
+		Header:        header,
+		Total:         total,
+		ProjectColors: config.GetBool(cmdutil.CONF_COLOR_PROJECT),
+	})
+
+	return nil
+}
@@ -2,6 +2,7 @@ package mocks
 
 import (
 	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/reports"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 )
 
@@ -19,3 +20,8 @@ type Config interface {
 type Client interface {
 	api.Client
 }
+
+//go:generate mockery --name=ReportsClient --inpackage --with-expecter
+type ReportsClient interface {
+	reports.Client
+}
@@ -3,6 +3,12 @@
 package mocks
 
 import (
+	context "context"
+	json "encoding/json"
+	io "io"
+	http "net/http"
+	time "time"
+
 	api "github.com/lucassabreu/clockify-cli/api"
 	dto "github.com/lucassabreu/clockify-cli/api/dto"
 
@@ -22,20 +28,22 @@ func (_m *MockClient) EXPECT() *MockClient_Expecter {
 	return &MockClient_Expecter{mock: &_m.Mock}
 }
 
-// AddClient provides a mock function with given fields: _a0
-func (_m *MockClient) AddClient(_a0 api.AddClientParam) (dto.Client, error) {
-	ret := _m.Called(_a0)
+// AddAssignment provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) AddAssignment(_a0 context.Context, _a1 api.AddAssignmentParam) ([]dto.Assignment, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 dto.Client
-	if rf, ok := ret.Get(0).(func(api.AddClientParam) dto.Client); ok {
-		r0 = rf(_a0)
+	var r0 []dto.Assignment
+	if rf, ok := ret.Get(0).(func(context.Context, api.AddAssignmentParam) []dto.Assignment); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		r0 = ret.Get(0).(dto.Client)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.Assignment)
+		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(api.AddClientParam) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, api.AddAssignmentParam) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -43,43 +51,44 @@ func (_m *MockClient) AddClient(_a0 api.AddClientParam) (dto.Client, error) {
 	return r0, r1
 }
 
-// MockClient_AddClient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddClient'
-type MockClient_AddClient_Call struct {
+// MockClient_AddAssignment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddAssignment'
+type MockClient_AddAssignment_Call struct {
 	*mock.Call
 }
 
-// AddClient is a helper method to define mock.On call
-//   - _a0 api.AddClientParam
-func (_e *MockClient_Expecter) AddClient(_a0 interface{}) *MockClient_AddClient_Call {
-	return &MockClient_AddClient_Call{Call: _e.mock.On("AddClient", _a0)}
+// AddAssignment is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.AddAssignmentParam
+func (_e *MockClient_Expecter) AddAssignment(_a0 interface{}, _a1 interface{}) *MockClient_AddAssignment_Call {
+	return &MockClient_AddAssignment_Call{Call: _e.mock.On("AddAssignment", _a0, _a1)}
 }
 
-func (_c *MockClient_AddClient_Call) Run(run func(_a0 api.AddClientParam)) *MockClient_AddClient_Call {
+func (_c *MockClient_AddAssignment_Call) Run(run func(_a0 context.Context, _a1 api.AddAssignmentParam)) *MockClient_AddAssignment_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.AddClientParam))
+		run(args[0].(context.Context), args[1].(api.AddAssignmentParam))
 	})
 	return _c
 }
 
-func (_c *MockClient_AddClient_Call) Return(_a0 dto.Client, _a1 error) *MockClient_AddClient_Call {
+func (_c *MockClient_AddAssignment_Call) Return(_a0 []dto.Assignment, _a1 error) *MockClient_AddAssignment_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// AddProject provides a mock function with given fields: _a0
-func (_m *MockClient) AddProject(_a0 api.AddProjectParam) (dto.Project, error) {
-	ret := _m.Called(_a0)
+// AddClient provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) AddClient(_a0 context.Context, _a1 api.AddClientParam) (dto.Client, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 dto.Project
-	if rf, ok := ret.Get(0).(func(api.AddProjectParam) dto.Project); ok {
-		r0 = rf(_a0)
+	var r0 dto.Client
+	if rf, ok := ret.Get(0).(func(context.Context, api.AddClientParam) dto.Client); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		r0 = ret.Get(0).(dto.Project)
+		r0 = ret.Get(0).(dto.Client)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(api.AddProjectParam) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, api.AddClientParam) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -87,43 +96,44 @@ func (_m *MockClient) AddProject(_a0 api.AddProjectParam) (dto.Project, error) {
 	return r0, r1
 }
 
-// MockClient_AddProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddProject'
-type MockClient_AddProject_Call struct {
+// MockClient_AddClient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddClient'
+type MockClient_AddClient_Call struct {
 	*mock.Call
 }
 
-// AddProject is a helper method to define mock.On call
-//   - _a0 api.AddProjectParam
-func (_e *MockClient_Expecter) AddProject(_a0 interface{}) *MockClient_AddProject_Call {
-	return &MockClient_AddProject_Call{Call: _e.mock.On("AddProject", _a0)}
+// AddClient is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.AddClientParam
+func (_e *MockClient_Expecter) AddClient(_a0 interface{}, _a1 interface{}) *MockClient_AddClient_Call {
+	return &MockClient_AddClient_Call{Call: _e.mock.On("AddClient", _a0, _a1)}
 }
 
-func (_c *MockClient_AddProject_Call) Run(run func(_a0 api.AddProjectParam)) *MockClient_AddProject_Call {
+func (_c *MockClient_AddClient_Call) Run(run func(_a0 context.Context, _a1 api.AddClientParam)) *MockClient_AddClient_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.AddProjectParam))
+		run(args[0].(context.Context), args[1].(api.AddClientParam))
 	})
 	return _c
 }
 
-func (_c *MockClient_AddProject_Call) Return(_a0 dto.Project, _a1 error) *MockClient_AddProject_Call {
+func (_c *MockClient_AddClient_Call) Return(_a0 dto.Client, _a1 error) *MockClient_AddClient_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// AddTask provides a mock function with given fields: _a0
-func (_m *MockClient) AddTask(_a0 api.AddTaskParam) (dto.Task, error) {
-	ret := _m.Called(_a0)
+// AddExpense provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) AddExpense(_a0 context.Context, _a1 api.AddExpenseParam) (dto.Expense, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 dto.Task
-	if rf, ok := ret.Get(0).(func(api.AddTaskParam) dto.Task); ok {
-		r0 = rf(_a0)
+	var r0 dto.Expense
+	if rf, ok := ret.Get(0).(func(context.Context, api.AddExpenseParam) dto.Expense); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		r0 = ret.Get(0).(dto.Task)
+		r0 = ret.Get(0).(dto.Expense)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(api.AddTaskParam) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, api.AddExpenseParam) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -131,80 +141,89 @@ func (_m *MockClient) AddTask(_a0 api.AddTaskParam) (dto.Task, error) {
 	return r0, r1
 }
 
-// MockClient_AddTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTask'
-type MockClient_AddTask_Call struct {
+// MockClient_AddExpense_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddExpense'
+type MockClient_AddExpense_Call struct {
 	*mock.Call
 }
 
-// AddTask is a helper method to define mock.On call
-//   - _a0 api.AddTaskParam
-func (_e *MockClient_Expecter) AddTask(_a0 interface{}) *MockClient_AddTask_Call {
-	return &MockClient_AddTask_Call{Call: _e.mock.On("AddTask", _a0)}
+// AddExpense is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.AddExpenseParam
+func (_e *MockClient_Expecter) AddExpense(_a0 interface{}, _a1 interface{}) *MockClient_AddExpense_Call {
+	return &MockClient_AddExpense_Call{Call: _e.mock.On("AddExpense", _a0, _a1)}
 }
 
-func (_c *MockClient_AddTask_Call) Run(run func(_a0 api.AddTaskParam)) *MockClient_AddTask_Call {
+func (_c *MockClient_AddExpense_Call) Run(run func(_a0 context.Context, _a1 api.AddExpenseParam)) *MockClient_AddExpense_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.AddTaskParam))
+		run(args[0].(context.Context), args[1].(api.AddExpenseParam))
 	})
 	return _c
 }
 
-func (_c *MockClient_AddTask_Call) Return(_a0 dto.Task, _a1 error) *MockClient_AddTask_Call {
+func (_c *MockClient_AddExpense_Call) Return(_a0 dto.Expense, _a1 error) *MockClient_AddExpense_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// ChangeInvoiced provides a mock function with given fields: _a0
-func (_m *MockClient) ChangeInvoiced(_a0 api.ChangeInvoicedParam) error {
-	ret := _m.Called(_a0)
+// AddProject provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) AddProject(_a0 context.Context, _a1 api.AddProjectParam) (dto.Project, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(api.ChangeInvoicedParam) error); ok {
-		r0 = rf(_a0)
+	var r0 dto.Project
+	if rf, ok := ret.Get(0).(func(context.Context, api.AddProjectParam) dto.Project); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(dto.Project)
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.AddProjectParam) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockClient_ChangeInvoiced_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeInvoiced'
-type MockClient_ChangeInvoiced_Call struct {
+// MockClient_AddProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddProject'
+type MockClient_AddProject_Call struct {
 	*mock.Call
 }
 
-// ChangeInvoiced is a helper method to define mock.On call
-//   - _a0 api.ChangeInvoicedParam
-func (_e *MockClient_Expecter) ChangeInvoiced(_a0 interface{}) *MockClient_ChangeInvoiced_Call {
-	return &MockClient_ChangeInvoiced_Call{Call: _e.mock.On("ChangeInvoiced", _a0)}
+// AddProject is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.AddProjectParam
+func (_e *MockClient_Expecter) AddProject(_a0 interface{}, _a1 interface{}) *MockClient_AddProject_Call {
+	return &MockClient_AddProject_Call{Call: _e.mock.On("AddProject", _a0, _a1)}
 }
 
-func (_c *MockClient_ChangeInvoiced_Call) Run(run func(_a0 api.ChangeInvoicedParam)) *MockClient_ChangeInvoiced_Call {
+func (_c *MockClient_AddProject_Call) Run(run func(_a0 context.Context, _a1 api.AddProjectParam)) *MockClient_AddProject_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.ChangeInvoicedParam))
+		run(args[0].(context.Context), args[1].(api.AddProjectParam))
 	})
 	return _c
 }
 
-func (_c *MockClient_ChangeInvoiced_Call) Return(_a0 error) *MockClient_ChangeInvoiced_Call {
-	_c.Call.Return(_a0)
+func (_c *MockClient_AddProject_Call) Return(_a0 dto.Project, _a1 error) *MockClient_AddProject_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// CreateTimeEntry provides a mock function with given fields: _a0
-func (_m *MockClient) CreateTimeEntry(_a0 api.CreateTimeEntryParam) (dto.TimeEntryImpl, error) {
-	ret := _m.Called(_a0)
+// AddTag provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) AddTag(_a0 context.Context, _a1 api.AddTagParam) (dto.Tag, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 dto.TimeEntryImpl
-	if rf, ok := ret.Get(0).(func(api.CreateTimeEntryParam) dto.TimeEntryImpl); ok {
-		r0 = rf(_a0)
+	var r0 dto.Tag
+	if rf, ok := ret.Get(0).(func(context.Context, api.AddTagParam) dto.Tag); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		r0 = ret.Get(0).(dto.TimeEntryImpl)
+		r0 = ret.Get(0).(dto.Tag)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(api.CreateTimeEntryParam) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, api.AddTagParam) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -212,43 +231,44 @@ func (_m *MockClient) CreateTimeEntry(_a0 api.CreateTimeEntryParam) (dto.TimeEnt
 	return r0, r1
 }
 
-// MockClient_CreateTimeEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTimeEntry'
-type MockClient_CreateTimeEntry_Call struct {
+// MockClient_AddTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTag'
+type MockClient_AddTag_Call struct {
 	*mock.Call
 }
 
-// CreateTimeEntry is a helper method to define mock.On call
-//   - _a0 api.CreateTimeEntryParam
-func (_e *MockClient_Expecter) CreateTimeEntry(_a0 interface{}) *MockClient_CreateTimeEntry_Call {
-	return &MockClient_CreateTimeEntry_Call{Call: _e.mock.On("CreateTimeEntry", _a0)}
+// AddTag is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.AddTagParam
+func (_e *MockClient_Expecter) AddTag(_a0 interface{}, _a1 interface{}) *MockClient_AddTag_Call {
+	return &MockClient_AddTag_Call{Call: _e.mock.On("AddTag", _a0, _a1)}
 }
 
-func (_c *MockClient_CreateTimeEntry_Call) Run(run func(_a0 api.CreateTimeEntryParam)) *MockClient_CreateTimeEntry_Call {
+func (_c *MockClient_AddTag_Call) Run(run func(_a0 context.Context, _a1 api.AddTagParam)) *MockClient_AddTag_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.CreateTimeEntryParam))
+		run(args[0].(context.Context), args[1].(api.AddTagParam))
 	})
 	return _c
 }
 
-func (_c *MockClient_CreateTimeEntry_Call) Return(_a0 dto.TimeEntryImpl, _a1 error) *MockClient_CreateTimeEntry_Call {
+func (_c *MockClient_AddTag_Call) Return(_a0 dto.Tag, _a1 error) *MockClient_AddTag_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// DeleteProject provides a mock function with given fields: _a0
-func (_m *MockClient) DeleteProject(_a0 api.DeleteProjectParam) (dto.Project, error) {
-	ret := _m.Called(_a0)
+// AddTask provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) AddTask(_a0 context.Context, _a1 api.AddTaskParam) (dto.Task, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 dto.Project
-	if rf, ok := ret.Get(0).(func(api.DeleteProjectParam) dto.Project); ok {
-		r0 = rf(_a0)
+	var r0 dto.Task
+	if rf, ok := ret.Get(0).(func(context.Context, api.AddTaskParam) dto.Task); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		r0 = ret.Get(0).(dto.Project)
+		r0 = ret.Get(0).(dto.Task)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(api.DeleteProjectParam) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, api.AddTaskParam) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -256,43 +276,44 @@ func (_m *MockClient) DeleteProject(_a0 api.DeleteProjectParam) (dto.Project, er
 	return r0, r1
 }
 
-// MockClient_DeleteProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProject'
-type MockClient_DeleteProject_Call struct {
+// MockClient_AddTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTask'
+type MockClient_AddTask_Call struct {
 	*mock.Call
 }
 
-// DeleteProject is a helper method to define mock.On call
-//   - _a0 api.DeleteProjectParam
-func (_e *MockClient_Expecter) DeleteProject(_a0 interface{}) *MockClient_DeleteProject_Call {
-	return &MockClient_DeleteProject_Call{Call: _e.mock.On("DeleteProject", _a0)}
+// AddTask is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.AddTaskParam
+func (_e *MockClient_Expecter) AddTask(_a0 interface{}, _a1 interface{}) *MockClient_AddTask_Call {
+	return &MockClient_AddTask_Call{Call: _e.mock.On("AddTask", _a0, _a1)}
 }
 
-func (_c *MockClient_DeleteProject_Call) Run(run func(_a0 api.DeleteProjectParam)) *MockClient_DeleteProject_Call {
+func (_c *MockClient_AddTask_Call) Run(run func(_a0 context.Context, _a1 api.AddTaskParam)) *MockClient_AddTask_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.DeleteProjectParam))
+		run(args[0].(context.Context), args[1].(api.AddTaskParam))
 	})
 	return _c
 }
 
-func (_c *MockClient_DeleteProject_Call) Return(_a0 dto.Project, _a1 error) *MockClient_DeleteProject_Call {
+func (_c *MockClient_AddTask_Call) Return(_a0 dto.Task, _a1 error) *MockClient_AddTask_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// DeleteTask provides a mock function with given fields: _a0
-func (_m *MockClient) DeleteTask(_a0 api.DeleteTaskParam) (dto.Task, error) {
-	ret := _m.Called(_a0)
+// AddTimeOffRequest provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) AddTimeOffRequest(_a0 context.Context, _a1 api.AddTimeOffRequestParam) (dto.TimeOffRequest, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 dto.Task
-	if rf, ok := ret.Get(0).(func(api.DeleteTaskParam) dto.Task); ok {
-		r0 = rf(_a0)
+	var r0 dto.TimeOffRequest
+	if rf, ok := ret.Get(0).(func(context.Context, api.AddTimeOffRequestParam) dto.TimeOffRequest); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		r0 = ret.Get(0).(dto.Task)
+		r0 = ret.Get(0).(dto.TimeOffRequest)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(api.DeleteTaskParam) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, api.AddTimeOffRequestParam) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -300,82 +321,89 @@ func (_m *MockClient) DeleteTask(_a0 api.DeleteTaskParam) (dto.Task, error) {
 	return r0, r1
 }
 
-// MockClient_DeleteTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTask'
-type MockClient_DeleteTask_Call struct {
+// MockClient_AddTimeOffRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTimeOffRequest'
+type MockClient_AddTimeOffRequest_Call struct {
 	*mock.Call
 }
 
-// DeleteTask is a helper method to define mock.On call
-//   - _a0 api.DeleteTaskParam
-func (_e *MockClient_Expecter) DeleteTask(_a0 interface{}) *MockClient_DeleteTask_Call {
-	return &MockClient_DeleteTask_Call{Call: _e.mock.On("DeleteTask", _a0)}
+// AddTimeOffRequest is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.AddTimeOffRequestParam
+func (_e *MockClient_Expecter) AddTimeOffRequest(_a0 interface{}, _a1 interface{}) *MockClient_AddTimeOffRequest_Call {
+	return &MockClient_AddTimeOffRequest_Call{Call: _e.mock.On("AddTimeOffRequest", _a0, _a1)}
 }
 
-func (_c *MockClient_DeleteTask_Call) Run(run func(_a0 api.DeleteTaskParam)) *MockClient_DeleteTask_Call {
+func (_c *MockClient_AddTimeOffRequest_Call) Run(run func(_a0 context.Context, _a1 api.AddTimeOffRequestParam)) *MockClient_AddTimeOffRequest_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.DeleteTaskParam))
+		run(args[0].(context.Context), args[1].(api.AddTimeOffRequestParam))
 	})
 	return _c
 }
 
-func (_c *MockClient_DeleteTask_Call) Return(_a0 dto.Task, _a1 error) *MockClient_DeleteTask_Call {
+func (_c *MockClient_AddTimeOffRequest_Call) Return(_a0 dto.TimeOffRequest, _a1 error) *MockClient_AddTimeOffRequest_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// DeleteTimeEntry provides a mock function with given fields: _a0
-func (_m *MockClient) DeleteTimeEntry(_a0 api.DeleteTimeEntryParam) error {
-	ret := _m.Called(_a0)
+// AddUserGroup provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) AddUserGroup(_a0 context.Context, _a1 api.AddUserGroupParam) (dto.UserGroup, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(api.DeleteTimeEntryParam) error); ok {
-		r0 = rf(_a0)
+	var r0 dto.UserGroup
+	if rf, ok := ret.Get(0).(func(context.Context, api.AddUserGroupParam) dto.UserGroup); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(dto.UserGroup)
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.AddUserGroupParam) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockClient_DeleteTimeEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTimeEntry'
-type MockClient_DeleteTimeEntry_Call struct {
+// MockClient_AddUserGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddUserGroup'
+type MockClient_AddUserGroup_Call struct {
 	*mock.Call
 }
 
-// DeleteTimeEntry is a helper method to define mock.On call
-//   - _a0 api.DeleteTimeEntryParam
-func (_e *MockClient_Expecter) DeleteTimeEntry(_a0 interface{}) *MockClient_DeleteTimeEntry_Call {
-	return &MockClient_DeleteTimeEntry_Call{Call: _e.mock.On("DeleteTimeEntry", _a0)}
+// AddUserGroup is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.AddUserGroupParam
+func (_e *MockClient_Expecter) AddUserGroup(_a0 interface{}, _a1 interface{}) *MockClient_AddUserGroup_Call {
+	return &MockClient_AddUserGroup_Call{Call: _e.mock.On("AddUserGroup", _a0, _a1)}
 }
 
-func (_c *MockClient_DeleteTimeEntry_Call) Run(run func(_a0 api.DeleteTimeEntryParam)) *MockClient_DeleteTimeEntry_Call {
+func (_c *MockClient_AddUserGroup_Call) Run(run func(_a0 context.Context, _a1 api.AddUserGroupParam)) *MockClient_AddUserGroup_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.DeleteTimeEntryParam))
+		run(args[0].(context.Context), args[1].(api.AddUserGroupParam))
 	})
 	return _c
 }
 
-func (_c *MockClient_DeleteTimeEntry_Call) Return(_a0 error) *MockClient_DeleteTimeEntry_Call {
-	_c.Call.Return(_a0)
+func (_c *MockClient_AddUserGroup_Call) Return(_a0 dto.UserGroup, _a1 error) *MockClient_AddUserGroup_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// GetClients provides a mock function with given fields: _a0
-func (_m *MockClient) GetClients(_a0 api.GetClientsParam) ([]dto.Client, error) {
-	ret := _m.Called(_a0)
+// AddUserToGroup provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) AddUserToGroup(_a0 context.Context, _a1 api.UserGroupMemberParam) (dto.UserGroup, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 []dto.Client
-	if rf, ok := ret.Get(0).(func(api.GetClientsParam) []dto.Client); ok {
-		r0 = rf(_a0)
+	var r0 dto.UserGroup
+	if rf, ok := ret.Get(0).(func(context.Context, api.UserGroupMemberParam) dto.UserGroup); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]dto.Client)
-		}
+		r0 = ret.Get(0).(dto.UserGroup)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(api.GetClientsParam) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, api.UserGroupMemberParam) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -383,45 +411,44 @@ func (_m *MockClient) GetClients(_a0 api.GetClientsParam) ([]dto.Client, error)
 	return r0, r1
 }
 
-// MockClient_GetClients_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetClients'
-type MockClient_GetClients_Call struct {
+// MockClient_AddUserToGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddUserToGroup'
+type MockClient_AddUserToGroup_Call struct {
 	*mock.Call
 }
 
-// GetClients is a helper method to define mock.On call
-//   - _a0 api.GetClientsParam
-func (_e *MockClient_Expecter) GetClients(_a0 interface{}) *MockClient_GetClients_Call {
-	return &MockClient_GetClients_Call{Call: _e.mock.On("GetClients", _a0)}
+// AddUserToGroup is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.UserGroupMemberParam
+func (_e *MockClient_Expecter) AddUserToGroup(_a0 interface{}, _a1 interface{}) *MockClient_AddUserToGroup_Call {
+	return &MockClient_AddUserToGroup_Call{Call: _e.mock.On("AddUserToGroup", _a0, _a1)}
 }
 
-func (_c *MockClient_GetClients_Call) Run(run func(_a0 api.GetClientsParam)) *MockClient_GetClients_Call {
+func (_c *MockClient_AddUserToGroup_Call) Run(run func(_a0 context.Context, _a1 api.UserGroupMemberParam)) *MockClient_AddUserToGroup_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.GetClientsParam))
+		run(args[0].(context.Context), args[1].(api.UserGroupMemberParam))
 	})
 	return _c
 }
 
-func (_c *MockClient_GetClients_Call) Return(_a0 []dto.Client, _a1 error) *MockClient_GetClients_Call {
+func (_c *MockClient_AddUserToGroup_Call) Return(_a0 dto.UserGroup, _a1 error) *MockClient_AddUserToGroup_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// GetHydratedTimeEntry provides a mock function with given fields: _a0
-func (_m *MockClient) GetHydratedTimeEntry(_a0 api.GetTimeEntryParam) (*dto.TimeEntry, error) {
-	ret := _m.Called(_a0)
+// AddWebhook provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) AddWebhook(_a0 context.Context, _a1 api.AddWebhookParam) (dto.Webhook, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 *dto.TimeEntry
-	if rf, ok := ret.Get(0).(func(api.GetTimeEntryParam) *dto.TimeEntry); ok {
-		r0 = rf(_a0)
+	var r0 dto.Webhook
+	if rf, ok := ret.Get(0).(func(context.Context, api.AddWebhookParam) dto.Webhook); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dto.TimeEntry)
-		}
+		r0 = ret.Get(0).(dto.Webhook)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(api.GetTimeEntryParam) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, api.AddWebhookParam) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -429,89 +456,82 @@ func (_m *MockClient) GetHydratedTimeEntry(_a0 api.GetTimeEntryParam) (*dto.Time
 	return r0, r1
 }
 
-// MockClient_GetHydratedTimeEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHydratedTimeEntry'
-type MockClient_GetHydratedTimeEntry_Call struct {
+// MockClient_AddWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddWebhook'
+type MockClient_AddWebhook_Call struct {
 	*mock.Call
 }
 
-// GetHydratedTimeEntry is a helper method to define mock.On call
-//   - _a0 api.GetTimeEntryParam
-func (_e *MockClient_Expecter) GetHydratedTimeEntry(_a0 interface{}) *MockClient_GetHydratedTimeEntry_Call {
-	return &MockClient_GetHydratedTimeEntry_Call{Call: _e.mock.On("GetHydratedTimeEntry", _a0)}
+// AddWebhook is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.AddWebhookParam
+func (_e *MockClient_Expecter) AddWebhook(_a0 interface{}, _a1 interface{}) *MockClient_AddWebhook_Call {
+	return &MockClient_AddWebhook_Call{Call: _e.mock.On("AddWebhook", _a0, _a1)}
 }
 
-func (_c *MockClient_GetHydratedTimeEntry_Call) Run(run func(_a0 api.GetTimeEntryParam)) *MockClient_GetHydratedTimeEntry_Call {
+func (_c *MockClient_AddWebhook_Call) Run(run func(_a0 context.Context, _a1 api.AddWebhookParam)) *MockClient_AddWebhook_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.GetTimeEntryParam))
+		run(args[0].(context.Context), args[1].(api.AddWebhookParam))
 	})
 	return _c
 }
 
-func (_c *MockClient_GetHydratedTimeEntry_Call) Return(_a0 *dto.TimeEntry, _a1 error) *MockClient_GetHydratedTimeEntry_Call {
+func (_c *MockClient_AddWebhook_Call) Return(_a0 dto.Webhook, _a1 error) *MockClient_AddWebhook_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// GetHydratedTimeEntryInProgress provides a mock function with given fields: _a0
-func (_m *MockClient) GetHydratedTimeEntryInProgress(_a0 api.GetTimeEntryInProgressParam) (*dto.TimeEntry, error) {
-	ret := _m.Called(_a0)
-
-	var r0 *dto.TimeEntry
-	if rf, ok := ret.Get(0).(func(api.GetTimeEntryInProgressParam) *dto.TimeEntry); ok {
-		r0 = rf(_a0)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dto.TimeEntry)
-		}
-	}
+// ChangeInvoiced provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) ChangeInvoiced(_a0 context.Context, _a1 api.ChangeInvoicedParam) error {
+	ret := _m.Called(_a0, _a1)
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(api.GetTimeEntryInProgressParam) error); ok {
-		r1 = rf(_a0)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, api.ChangeInvoicedParam) error); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockClient_GetHydratedTimeEntryInProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHydratedTimeEntryInProgress'
-type MockClient_GetHydratedTimeEntryInProgress_Call struct {
+// MockClient_ChangeInvoiced_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeInvoiced'
+type MockClient_ChangeInvoiced_Call struct {
 	*mock.Call
 }
 
-// GetHydratedTimeEntryInProgress is a helper method to define mock.On call
-//   - _a0 api.GetTimeEntryInProgressParam
-func (_e *MockClient_Expecter) GetHydratedTimeEntryInProgress(_a0 interface{}) *MockClient_GetHydratedTimeEntryInProgress_Call {
-	return &MockClient_GetHydratedTimeEntryInProgress_Call{Call: _e.mock.On("GetHydratedTimeEntryInProgress", _a0)}
+// ChangeInvoiced is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.ChangeInvoicedParam
+func (_e *MockClient_Expecter) ChangeInvoiced(_a0 interface{}, _a1 interface{}) *MockClient_ChangeInvoiced_Call {
+	return &MockClient_ChangeInvoiced_Call{Call: _e.mock.On("ChangeInvoiced", _a0, _a1)}
 }
 
-func (_c *MockClient_GetHydratedTimeEntryInProgress_Call) Run(run func(_a0 api.GetTimeEntryInProgressParam)) *MockClient_GetHydratedTimeEntryInProgress_Call {
+func (_c *MockClient_ChangeInvoiced_Call) Run(run func(_a0 context.Context, _a1 api.ChangeInvoicedParam)) *MockClient_ChangeInvoiced_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.GetTimeEntryInProgressParam))
+		run(args[0].(context.Context), args[1].(api.ChangeInvoicedParam))
 	})
 	return _c
 }
 
-func (_c *MockClient_GetHydratedTimeEntryInProgress_Call) Return(_a0 *dto.TimeEntry, _a1 error) *MockClient_GetHydratedTimeEntryInProgress_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockClient_ChangeInvoiced_Call) Return(_a0 error) *MockClient_ChangeInvoiced_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-// GetMe provides a mock function with given fields:
-func (_m *MockClient) GetMe() (dto.User, error) {
-	ret := _m.Called()
+// CreateTimeEntry provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) CreateTimeEntry(_a0 context.Context, _a1 api.CreateTimeEntryParam) (dto.TimeEntryImpl, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 dto.User
-	if rf, ok := ret.Get(0).(func() dto.User); ok {
-		r0 = rf()
+	var r0 dto.TimeEntryImpl
+	if rf, ok := ret.Get(0).(func(context.Context, api.CreateTimeEntryParam) dto.TimeEntryImpl); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		r0 = ret.Get(0).(dto.User)
+		r0 = ret.Get(0).(dto.TimeEntryImpl)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context, api.CreateTimeEntryParam) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -519,90 +539,82 @@ func (_m *MockClient) GetMe() (dto.User, error) {
 	return r0, r1
 }
 
-// MockClient_GetMe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMe'
-type MockClient_GetMe_Call struct {
+// MockClient_CreateTimeEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTimeEntry'
+type MockClient_CreateTimeEntry_Call struct {
 	*mock.Call
 }
 
-// GetMe is a helper method to define mock.On call
-func (_e *MockClient_Expecter) GetMe() *MockClient_GetMe_Call {
-	return &MockClient_GetMe_Call{Call: _e.mock.On("GetMe")}
+// CreateTimeEntry is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.CreateTimeEntryParam
+func (_e *MockClient_Expecter) CreateTimeEntry(_a0 interface{}, _a1 interface{}) *MockClient_CreateTimeEntry_Call {
+	return &MockClient_CreateTimeEntry_Call{Call: _e.mock.On("CreateTimeEntry", _a0, _a1)}
 }
 
-func (_c *MockClient_GetMe_Call) Run(run func()) *MockClient_GetMe_Call {
+func (_c *MockClient_CreateTimeEntry_Call) Run(run func(_a0 context.Context, _a1 api.CreateTimeEntryParam)) *MockClient_CreateTimeEntry_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(context.Context), args[1].(api.CreateTimeEntryParam))
 	})
 	return _c
 }
 
-func (_c *MockClient_GetMe_Call) Return(_a0 dto.User, _a1 error) *MockClient_GetMe_Call {
+func (_c *MockClient_CreateTimeEntry_Call) Return(_a0 dto.TimeEntryImpl, _a1 error) *MockClient_CreateTimeEntry_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// GetProject provides a mock function with given fields: _a0
-func (_m *MockClient) GetProject(_a0 api.GetProjectParam) (*dto.Project, error) {
-	ret := _m.Called(_a0)
-
-	var r0 *dto.Project
-	if rf, ok := ret.Get(0).(func(api.GetProjectParam) *dto.Project); ok {
-		r0 = rf(_a0)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dto.Project)
-		}
-	}
+// DeleteExpense provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) DeleteExpense(_a0 context.Context, _a1 api.DeleteExpenseParam) error {
+	ret := _m.Called(_a0, _a1)
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(api.GetProjectParam) error); ok {
-		r1 = rf(_a0)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, api.DeleteExpenseParam) error); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockClient_GetProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProject'
-type MockClient_GetProject_Call struct {
+// MockClient_DeleteExpense_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpense'
+type MockClient_DeleteExpense_Call struct {
 	*mock.Call
 }
 
-// GetProject is a helper method to define mock.On call
-//   - _a0 api.GetProjectParam
-func (_e *MockClient_Expecter) GetProject(_a0 interface{}) *MockClient_GetProject_Call {
-	return &MockClient_GetProject_Call{Call: _e.mock.On("GetProject", _a0)}
+// DeleteExpense is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.DeleteExpenseParam
+func (_e *MockClient_Expecter) DeleteExpense(_a0 interface{}, _a1 interface{}) *MockClient_DeleteExpense_Call {
+	return &MockClient_DeleteExpense_Call{Call: _e.mock.On("DeleteExpense", _a0, _a1)}
 }
 
-func (_c *MockClient_GetProject_Call) Run(run func(_a0 api.GetProjectParam)) *MockClient_GetProject_Call {
+func (_c *MockClient_DeleteExpense_Call) Run(run func(_a0 context.Context, _a1 api.DeleteExpenseParam)) *MockClient_DeleteExpense_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.GetProjectParam))
+		run(args[0].(context.Context), args[1].(api.DeleteExpenseParam))
 	})
 	return _c
 }
 
-func (_c *MockClient_GetProject_Call) Return(_a0 *dto.Project, _a1 error) *MockClient_GetProject_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockClient_DeleteExpense_Call) Return(_a0 error) *MockClient_DeleteExpense_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-// GetProjects provides a mock function with given fields: _a0
-func (_m *MockClient) GetProjects(_a0 api.GetProjectsParam) ([]dto.Project, error) {
-	ret := _m.Called(_a0)
+// DeleteProject provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) DeleteProject(_a0 context.Context, _a1 api.DeleteProjectParam) (dto.Project, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 []dto.Project
-	if rf, ok := ret.Get(0).(func(api.GetProjectsParam) []dto.Project); ok {
-		r0 = rf(_a0)
+	var r0 dto.Project
+	if rf, ok := ret.Get(0).(func(context.Context, api.DeleteProjectParam) dto.Project); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]dto.Project)
-		}
+		r0 = ret.Get(0).(dto.Project)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(api.GetProjectsParam) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, api.DeleteProjectParam) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -610,45 +622,44 @@ func (_m *MockClient) GetProjects(_a0 api.GetProjectsParam) ([]dto.Project, erro
 	return r0, r1
 }
 
-// MockClient_GetProjects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProjects'
-type MockClient_GetProjects_Call struct {
+// MockClient_DeleteProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProject'
+type MockClient_DeleteProject_Call struct {
 	*mock.Call
 }
 
-// GetProjects is a helper method to define mock.On call
-//   - _a0 api.GetProjectsParam
-func (_e *MockClient_Expecter) GetProjects(_a0 interface{}) *MockClient_GetProjects_Call {
-	return &MockClient_GetProjects_Call{Call: _e.mock.On("GetProjects", _a0)}
+// DeleteProject is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.DeleteProjectParam
+func (_e *MockClient_Expecter) DeleteProject(_a0 interface{}, _a1 interface{}) *MockClient_DeleteProject_Call {
+	return &MockClient_DeleteProject_Call{Call: _e.mock.On("DeleteProject", _a0, _a1)}
 }
 
-func (_c *MockClient_GetProjects_Call) Run(run func(_a0 api.GetProjectsParam)) *MockClient_GetProjects_Call {
+func (_c *MockClient_DeleteProject_Call) Run(run func(_a0 context.Context, _a1 api.DeleteProjectParam)) *MockClient_DeleteProject_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.GetProjectsParam))
+		run(args[0].(context.Context), args[1].(api.DeleteProjectParam))
 	})
 	return _c
 }
 
-func (_c *MockClient_GetProjects_Call) Return(_a0 []dto.Project, _a1 error) *MockClient_GetProjects_Call {
+func (_c *MockClient_DeleteProject_Call) Return(_a0 dto.Project, _a1 error) *MockClient_DeleteProject_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// GetTag provides a mock function with given fields: _a0
-func (_m *MockClient) GetTag(_a0 api.GetTagParam) (*dto.Tag, error) {
-	ret := _m.Called(_a0)
+// DeleteTask provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) DeleteTask(_a0 context.Context, _a1 api.DeleteTaskParam) (dto.Task, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 *dto.Tag
-	if rf, ok := ret.Get(0).(func(api.GetTagParam) *dto.Tag); ok {
-		r0 = rf(_a0)
+	var r0 dto.Task
+	if rf, ok := ret.Get(0).(func(context.Context, api.DeleteTaskParam) dto.Task); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dto.Tag)
-		}
+		r0 = ret.Get(0).(dto.Task)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(api.GetTagParam) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, api.DeleteTaskParam) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -656,89 +667,84 @@ func (_m *MockClient) GetTag(_a0 api.GetTagParam) (*dto.Tag, error) {
 	return r0, r1
 }
 
-// MockClient_GetTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTag'
-type MockClient_GetTag_Call struct {
+// MockClient_DeleteTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTask'
+type MockClient_DeleteTask_Call struct {
 	*mock.Call
 }
 
-// GetTag is a helper method to define mock.On call
-//   - _a0 api.GetTagParam
-func (_e *MockClient_Expecter) GetTag(_a0 interface{}) *MockClient_GetTag_Call {
-	return &MockClient_GetTag_Call{Call: _e.mock.On("GetTag", _a0)}
+// DeleteTask is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.DeleteTaskParam
+func (_e *MockClient_Expecter) DeleteTask(_a0 interface{}, _a1 interface{}) *MockClient_DeleteTask_Call {
+	return &MockClient_DeleteTask_Call{Call: _e.mock.On("DeleteTask", _a0, _a1)}
 }
 
-func (_c *MockClient_GetTag_Call) Run(run func(_a0 api.GetTagParam)) *MockClient_GetTag_Call {
+func (_c *MockClient_DeleteTask_Call) Run(run func(_a0 context.Context, _a1 api.DeleteTaskParam)) *MockClient_DeleteTask_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.GetTagParam))
+		run(args[0].(context.Context), args[1].(api.DeleteTaskParam))
 	})
 	return _c
 }
 
-func (_c *MockClient_GetTag_Call) Return(_a0 *dto.Tag, _a1 error) *MockClient_GetTag_Call {
+func (_c *MockClient_DeleteTask_Call) Return(_a0 dto.Task, _a1 error) *MockClient_DeleteTask_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// GetTags provides a mock function with given fields: _a0
-func (_m *MockClient) GetTags(_a0 api.GetTagsParam) ([]dto.Tag, error) {
-	ret := _m.Called(_a0)
-
-	var r0 []dto.Tag
-	if rf, ok := ret.Get(0).(func(api.GetTagsParam) []dto.Tag); ok {
-		r0 = rf(_a0)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]dto.Tag)
-		}
-	}
+// DeleteTimeEntry provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) DeleteTimeEntry(_a0 context.Context, _a1 api.DeleteTimeEntryParam) error {
+	ret := _m.Called(_a0, _a1)
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(api.GetTagsParam) error); ok {
-		r1 = rf(_a0)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, api.DeleteTimeEntryParam) error); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockClient_GetTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTags'
-type MockClient_GetTags_Call struct {
+// MockClient_DeleteTimeEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTimeEntry'
+type MockClient_DeleteTimeEntry_Call struct {
 	*mock.Call
 }
 
-// GetTags is a helper method to define mock.On call
-//   - _a0 api.GetTagsParam
-func (_e *MockClient_Expecter) GetTags(_a0 interface{}) *MockClient_GetTags_Call {
-	return &MockClient_GetTags_Call{Call: _e.mock.On("GetTags", _a0)}
+// DeleteTimeEntry is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.DeleteTimeEntryParam
+func (_e *MockClient_Expecter) DeleteTimeEntry(_a0 interface{}, _a1 interface{}) *MockClient_DeleteTimeEntry_Call {
+	return &MockClient_DeleteTimeEntry_Call{Call: _e.mock.On("DeleteTimeEntry", _a0, _a1)}
 }
 
-func (_c *MockClient_GetTags_Call) Run(run func(_a0 api.GetTagsParam)) *MockClient_GetTags_Call {
+func (_c *MockClient_DeleteTimeEntry_Call) Run(run func(_a0 context.Context, _a1 api.DeleteTimeEntryParam)) *MockClient_DeleteTimeEntry_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.GetTagsParam))
+		run(args[0].(context.Context), args[1].(api.DeleteTimeEntryParam))
 	})
 	return _c
 }
 
-func (_c *MockClient_GetTags_Call) Return(_a0 []dto.Tag, _a1 error) *MockClient_GetTags_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockClient_DeleteTimeEntry_Call) Return(_a0 error) *MockClient_DeleteTimeEntry_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-// GetTask provides a mock function with given fields: _a0
-func (_m *MockClient) GetTask(_a0 api.GetTaskParam) (dto.Task, error) {
-	ret := _m.Called(_a0)
+// DeleteTimeEntries provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) DeleteTimeEntries(_a0 context.Context, _a1 api.DeleteTimeEntriesParam) ([]dto.TimeEntryImpl, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 dto.Task
-	if rf, ok := ret.Get(0).(func(api.GetTaskParam) dto.Task); ok {
-		r0 = rf(_a0)
+	var r0 []dto.TimeEntryImpl
+	if rf, ok := ret.Get(0).(func(context.Context, api.DeleteTimeEntriesParam) []dto.TimeEntryImpl); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		r0 = ret.Get(0).(dto.Task)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.TimeEntryImpl)
+		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(api.GetTaskParam) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, api.DeleteTimeEntriesParam) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -746,45 +752,91 @@ func (_m *MockClient) GetTask(_a0 api.GetTaskParam) (dto.Task, error) {
 	return r0, r1
 }
 
-// MockClient_GetTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTask'
-type MockClient_GetTask_Call struct {
+// MockClient_DeleteTimeEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTimeEntries'
+type MockClient_DeleteTimeEntries_Call struct {
 	*mock.Call
 }
 
-// GetTask is a helper method to define mock.On call
-//   - _a0 api.GetTaskParam
-func (_e *MockClient_Expecter) GetTask(_a0 interface{}) *MockClient_GetTask_Call {
-	return &MockClient_GetTask_Call{Call: _e.mock.On("GetTask", _a0)}
+// DeleteTimeEntries is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.DeleteTimeEntriesParam
+func (_e *MockClient_Expecter) DeleteTimeEntries(_a0 interface{}, _a1 interface{}) *MockClient_DeleteTimeEntries_Call {
+	return &MockClient_DeleteTimeEntries_Call{Call: _e.mock.On("DeleteTimeEntries", _a0, _a1)}
 }
 
-func (_c *MockClient_GetTask_Call) Run(run func(_a0 api.GetTaskParam)) *MockClient_GetTask_Call {
+func (_c *MockClient_DeleteTimeEntries_Call) Run(run func(_a0 context.Context, _a1 api.DeleteTimeEntriesParam)) *MockClient_DeleteTimeEntries_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.GetTaskParam))
+		run(args[0].(context.Context), args[1].(api.DeleteTimeEntriesParam))
 	})
 	return _c
 }
 
-func (_c *MockClient_GetTask_Call) Return(_a0 dto.Task, _a1 error) *MockClient_GetTask_Call {
+func (_c *MockClient_DeleteTimeEntries_Call) Return(_a0 []dto.TimeEntryImpl, _a1 error) *MockClient_DeleteTimeEntries_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// GetTasks provides a mock function with given fields: _a0
-func (_m *MockClient) GetTasks(_a0 api.GetTasksParam) ([]dto.Task, error) {
-	ret := _m.Called(_a0)
+// DeleteWebhook provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) DeleteWebhook(_a0 context.Context, _a1 api.DeleteWebhookParam) (dto.Webhook, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 []dto.Task
-	if rf, ok := ret.Get(0).(func(api.GetTasksParam) []dto.Task); ok {
-		r0 = rf(_a0)
+	var r0 dto.Webhook
+	if rf, ok := ret.Get(0).(func(context.Context, api.DeleteWebhookParam) dto.Webhook); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(dto.Webhook)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.DeleteWebhookParam) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_DeleteWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebhook'
+type MockClient_DeleteWebhook_Call struct {
+	*mock.Call
+}
+
+// DeleteWebhook is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.DeleteWebhookParam
+func (_e *MockClient_Expecter) DeleteWebhook(_a0 interface{}, _a1 interface{}) *MockClient_DeleteWebhook_Call {
+	return &MockClient_DeleteWebhook_Call{Call: _e.mock.On("DeleteWebhook", _a0, _a1)}
+}
+
+func (_c *MockClient_DeleteWebhook_Call) Run(run func(_a0 context.Context, _a1 api.DeleteWebhookParam)) *MockClient_DeleteWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(api.DeleteWebhookParam))
+	})
+	return _c
+}
+
+func (_c *MockClient_DeleteWebhook_Call) Return(_a0 dto.Webhook, _a1 error) *MockClient_DeleteWebhook_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GetApprovalRequests provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) GetApprovalRequests(_a0 context.Context, _a1 api.GetApprovalRequestsParam) ([]dto.ApprovalRequest, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []dto.ApprovalRequest
+	if rf, ok := ret.Get(0).(func(context.Context, api.GetApprovalRequestsParam) []dto.ApprovalRequest); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]dto.Task)
+			r0 = ret.Get(0).([]dto.ApprovalRequest)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(api.GetTasksParam) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, api.GetApprovalRequestsParam) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -792,45 +844,46 @@ func (_m *MockClient) GetTasks(_a0 api.GetTasksParam) ([]dto.Task, error) {
 	return r0, r1
 }
 
-// MockClient_GetTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTasks'
-type MockClient_GetTasks_Call struct {
+// MockClient_GetApprovalRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetApprovalRequests'
+type MockClient_GetApprovalRequests_Call struct {
 	*mock.Call
 }
 
-// GetTasks is a helper method to define mock.On call
-//   - _a0 api.GetTasksParam
-func (_e *MockClient_Expecter) GetTasks(_a0 interface{}) *MockClient_GetTasks_Call {
-	return &MockClient_GetTasks_Call{Call: _e.mock.On("GetTasks", _a0)}
+// GetApprovalRequests is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.GetApprovalRequestsParam
+func (_e *MockClient_Expecter) GetApprovalRequests(_a0 interface{}, _a1 interface{}) *MockClient_GetApprovalRequests_Call {
+	return &MockClient_GetApprovalRequests_Call{Call: _e.mock.On("GetApprovalRequests", _a0, _a1)}
 }
 
-func (_c *MockClient_GetTasks_Call) Run(run func(_a0 api.GetTasksParam)) *MockClient_GetTasks_Call {
+func (_c *MockClient_GetApprovalRequests_Call) Run(run func(_a0 context.Context, _a1 api.GetApprovalRequestsParam)) *MockClient_GetApprovalRequests_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.GetTasksParam))
+		run(args[0].(context.Context), args[1].(api.GetApprovalRequestsParam))
 	})
 	return _c
 }
 
-func (_c *MockClient_GetTasks_Call) Return(_a0 []dto.Task, _a1 error) *MockClient_GetTasks_Call {
+func (_c *MockClient_GetApprovalRequests_Call) Return(_a0 []dto.ApprovalRequest, _a1 error) *MockClient_GetApprovalRequests_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// GetTimeEntry provides a mock function with given fields: _a0
-func (_m *MockClient) GetTimeEntry(_a0 api.GetTimeEntryParam) (*dto.TimeEntryImpl, error) {
-	ret := _m.Called(_a0)
+// GetAssignments provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) GetAssignments(_a0 context.Context, _a1 api.GetAssignmentsParam) ([]dto.Assignment, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 *dto.TimeEntryImpl
-	if rf, ok := ret.Get(0).(func(api.GetTimeEntryParam) *dto.TimeEntryImpl); ok {
-		r0 = rf(_a0)
+	var r0 []dto.Assignment
+	if rf, ok := ret.Get(0).(func(context.Context, api.GetAssignmentsParam) []dto.Assignment); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dto.TimeEntryImpl)
+			r0 = ret.Get(0).([]dto.Assignment)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(api.GetTimeEntryParam) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, api.GetAssignmentsParam) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -838,45 +891,46 @@ func (_m *MockClient) GetTimeEntry(_a0 api.GetTimeEntryParam) (*dto.TimeEntryImp
 	return r0, r1
 }
 
-// MockClient_GetTimeEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTimeEntry'
-type MockClient_GetTimeEntry_Call struct {
+// MockClient_GetAssignments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAssignments'
+type MockClient_GetAssignments_Call struct {
 	*mock.Call
 }
 
-// GetTimeEntry is a helper method to define mock.On call
-//   - _a0 api.GetTimeEntryParam
-func (_e *MockClient_Expecter) GetTimeEntry(_a0 interface{}) *MockClient_GetTimeEntry_Call {
-	return &MockClient_GetTimeEntry_Call{Call: _e.mock.On("GetTimeEntry", _a0)}
+// GetAssignments is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.GetAssignmentsParam
+func (_e *MockClient_Expecter) GetAssignments(_a0 interface{}, _a1 interface{}) *MockClient_GetAssignments_Call {
+	return &MockClient_GetAssignments_Call{Call: _e.mock.On("GetAssignments", _a0, _a1)}
 }
 
-func (_c *MockClient_GetTimeEntry_Call) Run(run func(_a0 api.GetTimeEntryParam)) *MockClient_GetTimeEntry_Call {
+func (_c *MockClient_GetAssignments_Call) Run(run func(_a0 context.Context, _a1 api.GetAssignmentsParam)) *MockClient_GetAssignments_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.GetTimeEntryParam))
+		run(args[0].(context.Context), args[1].(api.GetAssignmentsParam))
 	})
 	return _c
 }
 
-func (_c *MockClient_GetTimeEntry_Call) Return(_a0 *dto.TimeEntryImpl, _a1 error) *MockClient_GetTimeEntry_Call {
+func (_c *MockClient_GetAssignments_Call) Return(_a0 []dto.Assignment, _a1 error) *MockClient_GetAssignments_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// GetTimeEntryInProgress provides a mock function with given fields: _a0
-func (_m *MockClient) GetTimeEntryInProgress(_a0 api.GetTimeEntryInProgressParam) (*dto.TimeEntryImpl, error) {
-	ret := _m.Called(_a0)
+// GetClients provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) GetClients(_a0 context.Context, _a1 api.GetClientsParam) ([]dto.Client, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 *dto.TimeEntryImpl
-	if rf, ok := ret.Get(0).(func(api.GetTimeEntryInProgressParam) *dto.TimeEntryImpl); ok {
-		r0 = rf(_a0)
+	var r0 []dto.Client
+	if rf, ok := ret.Get(0).(func(context.Context, api.GetClientsParam) []dto.Client); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dto.TimeEntryImpl)
+			r0 = ret.Get(0).([]dto.Client)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(api.GetTimeEntryInProgressParam) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, api.GetClientsParam) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -884,42 +938,278 @@ func (_m *MockClient) GetTimeEntryInProgress(_a0 api.GetTimeEntryInProgressParam
 	return r0, r1
 }
 
-// MockClient_GetTimeEntryInProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTimeEntryInProgress'
-type MockClient_GetTimeEntryInProgress_Call struct {
+// MockClient_GetClients_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetClients'
+type MockClient_GetClients_Call struct {
 	*mock.Call
 }
 
-// GetTimeEntryInProgress is a helper method to define mock.On call
-//   - _a0 api.GetTimeEntryInProgressParam
-func (_e *MockClient_Expecter) GetTimeEntryInProgress(_a0 interface{}) *MockClient_GetTimeEntryInProgress_Call {
-	return &MockClient_GetTimeEntryInProgress_Call{Call: _e.mock.On("GetTimeEntryInProgress", _a0)}
+// GetClients is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.GetClientsParam
+func (_e *MockClient_Expecter) GetClients(_a0 interface{}, _a1 interface{}) *MockClient_GetClients_Call {
+	return &MockClient_GetClients_Call{Call: _e.mock.On("GetClients", _a0, _a1)}
 }
 
-func (_c *MockClient_GetTimeEntryInProgress_Call) Run(run func(_a0 api.GetTimeEntryInProgressParam)) *MockClient_GetTimeEntryInProgress_Call {
+func (_c *MockClient_GetClients_Call) Run(run func(_a0 context.Context, _a1 api.GetClientsParam)) *MockClient_GetClients_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.GetTimeEntryInProgressParam))
+		run(args[0].(context.Context), args[1].(api.GetClientsParam))
 	})
 	return _c
 }
 
-func (_c *MockClient_GetTimeEntryInProgress_Call) Return(_a0 *dto.TimeEntryImpl, _a1 error) *MockClient_GetTimeEntryInProgress_Call {
+func (_c *MockClient_GetClients_Call) Return(_a0 []dto.Client, _a1 error) *MockClient_GetClients_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GetCustomFields provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) GetCustomFields(_a0 context.Context, _a1 api.GetCustomFieldsParam) ([]dto.WorkspaceCustomField, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []dto.WorkspaceCustomField
+	if rf, ok := ret.Get(0).(func(context.Context, api.GetCustomFieldsParam) []dto.WorkspaceCustomField); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.WorkspaceCustomField)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.GetCustomFieldsParam) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_GetCustomFields_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCustomFields'
+type MockClient_GetCustomFields_Call struct {
+	*mock.Call
+}
+
+// GetCustomFields is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.GetCustomFieldsParam
+func (_e *MockClient_Expecter) GetCustomFields(_a0 interface{}, _a1 interface{}) *MockClient_GetCustomFields_Call {
+	return &MockClient_GetCustomFields_Call{Call: _e.mock.On("GetCustomFields", _a0, _a1)}
+}
+
+func (_c *MockClient_GetCustomFields_Call) Run(run func(_a0 context.Context, _a1 api.GetCustomFieldsParam)) *MockClient_GetCustomFields_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(api.GetCustomFieldsParam))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetCustomFields_Call) Return(_a0 []dto.WorkspaceCustomField, _a1 error) *MockClient_GetCustomFields_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GetExpenseCategories provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) GetExpenseCategories(_a0 context.Context, _a1 api.GetExpenseCategoriesParam) ([]dto.ExpenseCategory, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []dto.ExpenseCategory
+	if rf, ok := ret.Get(0).(func(context.Context, api.GetExpenseCategoriesParam) []dto.ExpenseCategory); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.ExpenseCategory)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.GetExpenseCategoriesParam) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_GetExpenseCategories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExpenseCategories'
+type MockClient_GetExpenseCategories_Call struct {
+	*mock.Call
+}
+
+// GetExpenseCategories is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.GetExpenseCategoriesParam
+func (_e *MockClient_Expecter) GetExpenseCategories(_a0 interface{}, _a1 interface{}) *MockClient_GetExpenseCategories_Call {
+	return &MockClient_GetExpenseCategories_Call{Call: _e.mock.On("GetExpenseCategories", _a0, _a1)}
+}
+
+func (_c *MockClient_GetExpenseCategories_Call) Run(run func(_a0 context.Context, _a1 api.GetExpenseCategoriesParam)) *MockClient_GetExpenseCategories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(api.GetExpenseCategoriesParam))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetExpenseCategories_Call) Return(_a0 []dto.ExpenseCategory, _a1 error) *MockClient_GetExpenseCategories_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GetExpenses provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) GetExpenses(_a0 context.Context, _a1 api.GetExpensesParam) ([]dto.Expense, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []dto.Expense
+	if rf, ok := ret.Get(0).(func(context.Context, api.GetExpensesParam) []dto.Expense); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.Expense)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.GetExpensesParam) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_GetExpenses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExpenses'
+type MockClient_GetExpenses_Call struct {
+	*mock.Call
+}
+
+// GetExpenses is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.GetExpensesParam
+func (_e *MockClient_Expecter) GetExpenses(_a0 interface{}, _a1 interface{}) *MockClient_GetExpenses_Call {
+	return &MockClient_GetExpenses_Call{Call: _e.mock.On("GetExpenses", _a0, _a1)}
+}
+
+func (_c *MockClient_GetExpenses_Call) Run(run func(_a0 context.Context, _a1 api.GetExpensesParam)) *MockClient_GetExpenses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(api.GetExpensesParam))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetExpenses_Call) Return(_a0 []dto.Expense, _a1 error) *MockClient_GetExpenses_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GetHydratedTimeEntry provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) GetHydratedTimeEntry(_a0 context.Context, _a1 api.GetTimeEntryParam) (*dto.TimeEntry, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *dto.TimeEntry
+	if rf, ok := ret.Get(0).(func(context.Context, api.GetTimeEntryParam) *dto.TimeEntry); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TimeEntry)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.GetTimeEntryParam) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_GetHydratedTimeEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHydratedTimeEntry'
+type MockClient_GetHydratedTimeEntry_Call struct {
+	*mock.Call
+}
+
+// GetHydratedTimeEntry is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.GetTimeEntryParam
+func (_e *MockClient_Expecter) GetHydratedTimeEntry(_a0 interface{}, _a1 interface{}) *MockClient_GetHydratedTimeEntry_Call {
+	return &MockClient_GetHydratedTimeEntry_Call{Call: _e.mock.On("GetHydratedTimeEntry", _a0, _a1)}
+}
+
+func (_c *MockClient_GetHydratedTimeEntry_Call) Run(run func(_a0 context.Context, _a1 api.GetTimeEntryParam)) *MockClient_GetHydratedTimeEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(api.GetTimeEntryParam))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetHydratedTimeEntry_Call) Return(_a0 *dto.TimeEntry, _a1 error) *MockClient_GetHydratedTimeEntry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GetHydratedTimeEntryInProgress provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) GetHydratedTimeEntryInProgress(_a0 context.Context, _a1 api.GetTimeEntryInProgressParam) (*dto.TimeEntry, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *dto.TimeEntry
+	if rf, ok := ret.Get(0).(func(context.Context, api.GetTimeEntryInProgressParam) *dto.TimeEntry); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TimeEntry)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.GetTimeEntryInProgressParam) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_GetHydratedTimeEntryInProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHydratedTimeEntryInProgress'
+type MockClient_GetHydratedTimeEntryInProgress_Call struct {
+	*mock.Call
+}
+
+// GetHydratedTimeEntryInProgress is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.GetTimeEntryInProgressParam
+func (_e *MockClient_Expecter) GetHydratedTimeEntryInProgress(_a0 interface{}, _a1 interface{}) *MockClient_GetHydratedTimeEntryInProgress_Call {
+	return &MockClient_GetHydratedTimeEntryInProgress_Call{Call: _e.mock.On("GetHydratedTimeEntryInProgress", _a0, _a1)}
+}
+
+func (_c *MockClient_GetHydratedTimeEntryInProgress_Call) Run(run func(_a0 context.Context, _a1 api.GetTimeEntryInProgressParam)) *MockClient_GetHydratedTimeEntryInProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(api.GetTimeEntryInProgressParam))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetHydratedTimeEntryInProgress_Call) Return(_a0 *dto.TimeEntry, _a1 error) *MockClient_GetHydratedTimeEntryInProgress_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// GetUser provides a mock function with given fields: _a0
-func (_m *MockClient) GetUser(_a0 api.GetUser) (dto.User, error) {
+// GetMe provides a mock function with given fields: _a0
+func (_m *MockClient) GetMe(_a0 context.Context) (dto.User, error) {
 	ret := _m.Called(_a0)
 
 	var r0 dto.User
-	if rf, ok := ret.Get(0).(func(api.GetUser) dto.User); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) dto.User); ok {
 		r0 = rf(_a0)
 	} else {
 		r0 = ret.Get(0).(dto.User)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(api.GetUser) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -928,45 +1218,45 @@ func (_m *MockClient) GetUser(_a0 api.GetUser) (dto.User, error) {
 	return r0, r1
 }
 
-// MockClient_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
-type MockClient_GetUser_Call struct {
+// MockClient_GetMe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMe'
+type MockClient_GetMe_Call struct {
 	*mock.Call
 }
 
-// GetUser is a helper method to define mock.On call
-//   - _a0 api.GetUser
-func (_e *MockClient_Expecter) GetUser(_a0 interface{}) *MockClient_GetUser_Call {
-	return &MockClient_GetUser_Call{Call: _e.mock.On("GetUser", _a0)}
+// GetMe is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *MockClient_Expecter) GetMe(_a0 interface{}) *MockClient_GetMe_Call {
+	return &MockClient_GetMe_Call{Call: _e.mock.On("GetMe", _a0)}
 }
 
-func (_c *MockClient_GetUser_Call) Run(run func(_a0 api.GetUser)) *MockClient_GetUser_Call {
+func (_c *MockClient_GetMe_Call) Run(run func(_a0 context.Context)) *MockClient_GetMe_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.GetUser))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *MockClient_GetUser_Call) Return(_a0 dto.User, _a1 error) *MockClient_GetUser_Call {
+func (_c *MockClient_GetMe_Call) Return(_a0 dto.User, _a1 error) *MockClient_GetMe_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// GetUserTimeEntries provides a mock function with given fields: _a0
-func (_m *MockClient) GetUserTimeEntries(_a0 api.GetUserTimeEntriesParam) ([]dto.TimeEntryImpl, error) {
-	ret := _m.Called(_a0)
+// GetProject provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) GetProject(_a0 context.Context, _a1 api.GetProjectParam) (*dto.Project, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 []dto.TimeEntryImpl
-	if rf, ok := ret.Get(0).(func(api.GetUserTimeEntriesParam) []dto.TimeEntryImpl); ok {
-		r0 = rf(_a0)
+	var r0 *dto.Project
+	if rf, ok := ret.Get(0).(func(context.Context, api.GetProjectParam) *dto.Project); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]dto.TimeEntryImpl)
+			r0 = ret.Get(0).(*dto.Project)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(api.GetUserTimeEntriesParam) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, api.GetProjectParam) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -974,45 +1264,1548 @@ func (_m *MockClient) GetUserTimeEntries(_a0 api.GetUserTimeEntriesParam) ([]dto
 	return r0, r1
 }
 
-// MockClient_GetUserTimeEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserTimeEntries'
-type MockClient_GetUserTimeEntries_Call struct {
+// MockClient_GetProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProject'
+type MockClient_GetProject_Call struct {
 	*mock.Call
 }
 
-// GetUserTimeEntries is a helper method to define mock.On call
-//   - _a0 api.GetUserTimeEntriesParam
-func (_e *MockClient_Expecter) GetUserTimeEntries(_a0 interface{}) *MockClient_GetUserTimeEntries_Call {
-	return &MockClient_GetUserTimeEntries_Call{Call: _e.mock.On("GetUserTimeEntries", _a0)}
+// GetProject is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.GetProjectParam
+func (_e *MockClient_Expecter) GetProject(_a0 interface{}, _a1 interface{}) *MockClient_GetProject_Call {
+	return &MockClient_GetProject_Call{Call: _e.mock.On("GetProject", _a0, _a1)}
 }
 
-func (_c *MockClient_GetUserTimeEntries_Call) Run(run func(_a0 api.GetUserTimeEntriesParam)) *MockClient_GetUserTimeEntries_Call {
+func (_c *MockClient_GetProject_Call) Run(run func(_a0 context.Context, _a1 api.GetProjectParam)) *MockClient_GetProject_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.GetUserTimeEntriesParam))
+		run(args[0].(context.Context), args[1].(api.GetProjectParam))
 	})
 	return _c
 }
 
-func (_c *MockClient_GetUserTimeEntries_Call) Return(_a0 []dto.TimeEntryImpl, _a1 error) *MockClient_GetUserTimeEntries_Call {
+func (_c *MockClient_GetProject_Call) Return(_a0 *dto.Project, _a1 error) *MockClient_GetProject_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// GetUsersHydratedTimeEntries provides a mock function with given fields: _a0
-func (_m *MockClient) GetUsersHydratedTimeEntries(_a0 api.GetUserTimeEntriesParam) ([]dto.TimeEntry, error) {
-	ret := _m.Called(_a0)
+// GetProjects provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) GetProjects(_a0 context.Context, _a1 api.GetProjectsParam) ([]dto.Project, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 []dto.TimeEntry
-	if rf, ok := ret.Get(0).(func(api.GetUserTimeEntriesParam) []dto.TimeEntry); ok {
-		r0 = rf(_a0)
+	var r0 []dto.Project
+	if rf, ok := ret.Get(0).(func(context.Context, api.GetProjectsParam) []dto.Project); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]dto.TimeEntry)
+			r0 = ret.Get(0).([]dto.Project)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(api.GetUserTimeEntriesParam) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, api.GetProjectsParam) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_GetProjects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProjects'
+type MockClient_GetProjects_Call struct {
+	*mock.Call
+}
+
+// GetProjects is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.GetProjectsParam
+func (_e *MockClient_Expecter) GetProjects(_a0 interface{}, _a1 interface{}) *MockClient_GetProjects_Call {
+	return &MockClient_GetProjects_Call{Call: _e.mock.On("GetProjects", _a0, _a1)}
+}
+
+func (_c *MockClient_GetProjects_Call) Run(run func(_a0 context.Context, _a1 api.GetProjectsParam)) *MockClient_GetProjects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(api.GetProjectsParam))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetProjects_Call) Return(_a0 []dto.Project, _a1 error) *MockClient_GetProjects_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GetTag provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) GetTag(_a0 context.Context, _a1 api.GetTagParam) (*dto.Tag, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *dto.Tag
+	if rf, ok := ret.Get(0).(func(context.Context, api.GetTagParam) *dto.Tag); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.Tag)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.GetTagParam) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_GetTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTag'
+type MockClient_GetTag_Call struct {
+	*mock.Call
+}
+
+// GetTag is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.GetTagParam
+func (_e *MockClient_Expecter) GetTag(_a0 interface{}, _a1 interface{}) *MockClient_GetTag_Call {
+	return &MockClient_GetTag_Call{Call: _e.mock.On("GetTag", _a0, _a1)}
+}
+
+func (_c *MockClient_GetTag_Call) Run(run func(_a0 context.Context, _a1 api.GetTagParam)) *MockClient_GetTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(api.GetTagParam))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetTag_Call) Return(_a0 *dto.Tag, _a1 error) *MockClient_GetTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GetTags provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) GetTags(_a0 context.Context, _a1 api.GetTagsParam) ([]dto.Tag, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []dto.Tag
+	if rf, ok := ret.Get(0).(func(context.Context, api.GetTagsParam) []dto.Tag); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.Tag)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.GetTagsParam) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_GetTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTags'
+type MockClient_GetTags_Call struct {
+	*mock.Call
+}
+
+// GetTags is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.GetTagsParam
+func (_e *MockClient_Expecter) GetTags(_a0 interface{}, _a1 interface{}) *MockClient_GetTags_Call {
+	return &MockClient_GetTags_Call{Call: _e.mock.On("GetTags", _a0, _a1)}
+}
+
+func (_c *MockClient_GetTags_Call) Run(run func(_a0 context.Context, _a1 api.GetTagsParam)) *MockClient_GetTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(api.GetTagsParam))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetTags_Call) Return(_a0 []dto.Tag, _a1 error) *MockClient_GetTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GetTask provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) GetTask(_a0 context.Context, _a1 api.GetTaskParam) (dto.Task, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 dto.Task
+	if rf, ok := ret.Get(0).(func(context.Context, api.GetTaskParam) dto.Task); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(dto.Task)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.GetTaskParam) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_GetTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTask'
+type MockClient_GetTask_Call struct {
+	*mock.Call
+}
+
+// GetTask is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.GetTaskParam
+func (_e *MockClient_Expecter) GetTask(_a0 interface{}, _a1 interface{}) *MockClient_GetTask_Call {
+	return &MockClient_GetTask_Call{Call: _e.mock.On("GetTask", _a0, _a1)}
+}
+
+func (_c *MockClient_GetTask_Call) Run(run func(_a0 context.Context, _a1 api.GetTaskParam)) *MockClient_GetTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(api.GetTaskParam))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetTask_Call) Return(_a0 dto.Task, _a1 error) *MockClient_GetTask_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GetTasks provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) GetTasks(_a0 context.Context, _a1 api.GetTasksParam) ([]dto.Task, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []dto.Task
+	if rf, ok := ret.Get(0).(func(context.Context, api.GetTasksParam) []dto.Task); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.Task)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.GetTasksParam) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_GetTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTasks'
+type MockClient_GetTasks_Call struct {
+	*mock.Call
+}
+
+// GetTasks is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.GetTasksParam
+func (_e *MockClient_Expecter) GetTasks(_a0 interface{}, _a1 interface{}) *MockClient_GetTasks_Call {
+	return &MockClient_GetTasks_Call{Call: _e.mock.On("GetTasks", _a0, _a1)}
+}
+
+func (_c *MockClient_GetTasks_Call) Run(run func(_a0 context.Context, _a1 api.GetTasksParam)) *MockClient_GetTasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(api.GetTasksParam))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetTasks_Call) Return(_a0 []dto.Task, _a1 error) *MockClient_GetTasks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GetTimeEntry provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) GetTimeEntry(_a0 context.Context, _a1 api.GetTimeEntryParam) (*dto.TimeEntryImpl, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *dto.TimeEntryImpl
+	if rf, ok := ret.Get(0).(func(context.Context, api.GetTimeEntryParam) *dto.TimeEntryImpl); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TimeEntryImpl)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.GetTimeEntryParam) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_GetTimeEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTimeEntry'
+type MockClient_GetTimeEntry_Call struct {
+	*mock.Call
+}
+
+// GetTimeEntry is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.GetTimeEntryParam
+func (_e *MockClient_Expecter) GetTimeEntry(_a0 interface{}, _a1 interface{}) *MockClient_GetTimeEntry_Call {
+	return &MockClient_GetTimeEntry_Call{Call: _e.mock.On("GetTimeEntry", _a0, _a1)}
+}
+
+func (_c *MockClient_GetTimeEntry_Call) Run(run func(_a0 context.Context, _a1 api.GetTimeEntryParam)) *MockClient_GetTimeEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(api.GetTimeEntryParam))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetTimeEntry_Call) Return(_a0 *dto.TimeEntryImpl, _a1 error) *MockClient_GetTimeEntry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GetTimeEntryInProgress provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) GetTimeEntryInProgress(_a0 context.Context, _a1 api.GetTimeEntryInProgressParam) (*dto.TimeEntryImpl, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *dto.TimeEntryImpl
+	if rf, ok := ret.Get(0).(func(context.Context, api.GetTimeEntryInProgressParam) *dto.TimeEntryImpl); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.TimeEntryImpl)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.GetTimeEntryInProgressParam) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_GetTimeEntryInProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTimeEntryInProgress'
+type MockClient_GetTimeEntryInProgress_Call struct {
+	*mock.Call
+}
+
+// GetTimeEntryInProgress is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.GetTimeEntryInProgressParam
+func (_e *MockClient_Expecter) GetTimeEntryInProgress(_a0 interface{}, _a1 interface{}) *MockClient_GetTimeEntryInProgress_Call {
+	return &MockClient_GetTimeEntryInProgress_Call{Call: _e.mock.On("GetTimeEntryInProgress", _a0, _a1)}
+}
+
+func (_c *MockClient_GetTimeEntryInProgress_Call) Run(run func(_a0 context.Context, _a1 api.GetTimeEntryInProgressParam)) *MockClient_GetTimeEntryInProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(api.GetTimeEntryInProgressParam))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetTimeEntryInProgress_Call) Return(_a0 *dto.TimeEntryImpl, _a1 error) *MockClient_GetTimeEntryInProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GetTimeOffBalances provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) GetTimeOffBalances(_a0 context.Context, _a1 api.GetTimeOffBalancesParam) ([]dto.TimeOffBalance, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []dto.TimeOffBalance
+	if rf, ok := ret.Get(0).(func(context.Context, api.GetTimeOffBalancesParam) []dto.TimeOffBalance); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.TimeOffBalance)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.GetTimeOffBalancesParam) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_GetTimeOffBalances_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTimeOffBalances'
+type MockClient_GetTimeOffBalances_Call struct {
+	*mock.Call
+}
+
+// GetTimeOffBalances is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.GetTimeOffBalancesParam
+func (_e *MockClient_Expecter) GetTimeOffBalances(_a0 interface{}, _a1 interface{}) *MockClient_GetTimeOffBalances_Call {
+	return &MockClient_GetTimeOffBalances_Call{Call: _e.mock.On("GetTimeOffBalances", _a0, _a1)}
+}
+
+func (_c *MockClient_GetTimeOffBalances_Call) Run(run func(_a0 context.Context, _a1 api.GetTimeOffBalancesParam)) *MockClient_GetTimeOffBalances_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(api.GetTimeOffBalancesParam))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetTimeOffBalances_Call) Return(_a0 []dto.TimeOffBalance, _a1 error) *MockClient_GetTimeOffBalances_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GetTimeOffPolicies provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) GetTimeOffPolicies(_a0 context.Context, _a1 api.GetTimeOffPoliciesParam) ([]dto.TimeOffPolicy, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []dto.TimeOffPolicy
+	if rf, ok := ret.Get(0).(func(context.Context, api.GetTimeOffPoliciesParam) []dto.TimeOffPolicy); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.TimeOffPolicy)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.GetTimeOffPoliciesParam) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_GetTimeOffPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTimeOffPolicies'
+type MockClient_GetTimeOffPolicies_Call struct {
+	*mock.Call
+}
+
+// GetTimeOffPolicies is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.GetTimeOffPoliciesParam
+func (_e *MockClient_Expecter) GetTimeOffPolicies(_a0 interface{}, _a1 interface{}) *MockClient_GetTimeOffPolicies_Call {
+	return &MockClient_GetTimeOffPolicies_Call{Call: _e.mock.On("GetTimeOffPolicies", _a0, _a1)}
+}
+
+func (_c *MockClient_GetTimeOffPolicies_Call) Run(run func(_a0 context.Context, _a1 api.GetTimeOffPoliciesParam)) *MockClient_GetTimeOffPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(api.GetTimeOffPoliciesParam))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetTimeOffPolicies_Call) Return(_a0 []dto.TimeOffPolicy, _a1 error) *MockClient_GetTimeOffPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GetTimeOffRequests provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) GetTimeOffRequests(_a0 context.Context, _a1 api.GetTimeOffRequestsParam) ([]dto.TimeOffRequest, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []dto.TimeOffRequest
+	if rf, ok := ret.Get(0).(func(context.Context, api.GetTimeOffRequestsParam) []dto.TimeOffRequest); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.TimeOffRequest)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.GetTimeOffRequestsParam) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_GetTimeOffRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTimeOffRequests'
+type MockClient_GetTimeOffRequests_Call struct {
+	*mock.Call
+}
+
+// GetTimeOffRequests is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.GetTimeOffRequestsParam
+func (_e *MockClient_Expecter) GetTimeOffRequests(_a0 interface{}, _a1 interface{}) *MockClient_GetTimeOffRequests_Call {
+	return &MockClient_GetTimeOffRequests_Call{Call: _e.mock.On("GetTimeOffRequests", _a0, _a1)}
+}
+
+func (_c *MockClient_GetTimeOffRequests_Call) Run(run func(_a0 context.Context, _a1 api.GetTimeOffRequestsParam)) *MockClient_GetTimeOffRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(api.GetTimeOffRequestsParam))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetTimeOffRequests_Call) Return(_a0 []dto.TimeOffRequest, _a1 error) *MockClient_GetTimeOffRequests_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GetUser provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) GetUser(_a0 context.Context, _a1 api.GetUser) (dto.User, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 dto.User
+	if rf, ok := ret.Get(0).(func(context.Context, api.GetUser) dto.User); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(dto.User)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.GetUser) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
+type MockClient_GetUser_Call struct {
+	*mock.Call
+}
+
+// GetUser is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.GetUser
+func (_e *MockClient_Expecter) GetUser(_a0 interface{}, _a1 interface{}) *MockClient_GetUser_Call {
+	return &MockClient_GetUser_Call{Call: _e.mock.On("GetUser", _a0, _a1)}
+}
+
+func (_c *MockClient_GetUser_Call) Run(run func(_a0 context.Context, _a1 api.GetUser)) *MockClient_GetUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(api.GetUser))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetUser_Call) Return(_a0 dto.User, _a1 error) *MockClient_GetUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GetUserGroups provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) GetUserGroups(_a0 context.Context, _a1 api.GetUserGroupsParam) ([]dto.UserGroup, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []dto.UserGroup
+	if rf, ok := ret.Get(0).(func(context.Context, api.GetUserGroupsParam) []dto.UserGroup); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.UserGroup)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.GetUserGroupsParam) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_GetUserGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserGroups'
+type MockClient_GetUserGroups_Call struct {
+	*mock.Call
+}
+
+// GetUserGroups is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.GetUserGroupsParam
+func (_e *MockClient_Expecter) GetUserGroups(_a0 interface{}, _a1 interface{}) *MockClient_GetUserGroups_Call {
+	return &MockClient_GetUserGroups_Call{Call: _e.mock.On("GetUserGroups", _a0, _a1)}
+}
+
+func (_c *MockClient_GetUserGroups_Call) Run(run func(_a0 context.Context, _a1 api.GetUserGroupsParam)) *MockClient_GetUserGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(api.GetUserGroupsParam))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetUserGroups_Call) Return(_a0 []dto.UserGroup, _a1 error) *MockClient_GetUserGroups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GetUserTimeEntries provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) GetUserTimeEntries(_a0 context.Context, _a1 api.GetUserTimeEntriesParam) ([]dto.TimeEntryImpl, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []dto.TimeEntryImpl
+	if rf, ok := ret.Get(0).(func(context.Context, api.GetUserTimeEntriesParam) []dto.TimeEntryImpl); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.TimeEntryImpl)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.GetUserTimeEntriesParam) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_GetUserTimeEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserTimeEntries'
+type MockClient_GetUserTimeEntries_Call struct {
+	*mock.Call
+}
+
+// GetUserTimeEntries is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.GetUserTimeEntriesParam
+func (_e *MockClient_Expecter) GetUserTimeEntries(_a0 interface{}, _a1 interface{}) *MockClient_GetUserTimeEntries_Call {
+	return &MockClient_GetUserTimeEntries_Call{Call: _e.mock.On("GetUserTimeEntries", _a0, _a1)}
+}
+
+func (_c *MockClient_GetUserTimeEntries_Call) Run(run func(_a0 context.Context, _a1 api.GetUserTimeEntriesParam)) *MockClient_GetUserTimeEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(api.GetUserTimeEntriesParam))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetUserTimeEntries_Call) Return(_a0 []dto.TimeEntryImpl, _a1 error) *MockClient_GetUserTimeEntries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GetUsersHydratedTimeEntries provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) GetUsersHydratedTimeEntries(_a0 context.Context, _a1 api.GetUserTimeEntriesParam) ([]dto.TimeEntry, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []dto.TimeEntry
+	if rf, ok := ret.Get(0).(func(context.Context, api.GetUserTimeEntriesParam) []dto.TimeEntry); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.TimeEntry)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.GetUserTimeEntriesParam) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_GetUsersHydratedTimeEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsersHydratedTimeEntries'
+type MockClient_GetUsersHydratedTimeEntries_Call struct {
+	*mock.Call
+}
+
+// GetUsersHydratedTimeEntries is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.GetUserTimeEntriesParam
+func (_e *MockClient_Expecter) GetUsersHydratedTimeEntries(_a0 interface{}, _a1 interface{}) *MockClient_GetUsersHydratedTimeEntries_Call {
+	return &MockClient_GetUsersHydratedTimeEntries_Call{Call: _e.mock.On("GetUsersHydratedTimeEntries", _a0, _a1)}
+}
+
+func (_c *MockClient_GetUsersHydratedTimeEntries_Call) Run(run func(_a0 context.Context, _a1 api.GetUserTimeEntriesParam)) *MockClient_GetUsersHydratedTimeEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(api.GetUserTimeEntriesParam))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetUsersHydratedTimeEntries_Call) Return(_a0 []dto.TimeEntry, _a1 error) *MockClient_GetUsersHydratedTimeEntries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GetWebhook provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) GetWebhook(_a0 context.Context, _a1 api.GetWebhookParam) (dto.Webhook, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 dto.Webhook
+	if rf, ok := ret.Get(0).(func(context.Context, api.GetWebhookParam) dto.Webhook); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(dto.Webhook)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.GetWebhookParam) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_GetWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhook'
+type MockClient_GetWebhook_Call struct {
+	*mock.Call
+}
+
+// GetWebhook is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.GetWebhookParam
+func (_e *MockClient_Expecter) GetWebhook(_a0 interface{}, _a1 interface{}) *MockClient_GetWebhook_Call {
+	return &MockClient_GetWebhook_Call{Call: _e.mock.On("GetWebhook", _a0, _a1)}
+}
+
+func (_c *MockClient_GetWebhook_Call) Run(run func(_a0 context.Context, _a1 api.GetWebhookParam)) *MockClient_GetWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(api.GetWebhookParam))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetWebhook_Call) Return(_a0 dto.Webhook, _a1 error) *MockClient_GetWebhook_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GetWebhooks provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) GetWebhooks(_a0 context.Context, _a1 api.GetWebhooksParam) ([]dto.Webhook, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []dto.Webhook
+	if rf, ok := ret.Get(0).(func(context.Context, api.GetWebhooksParam) []dto.Webhook); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.Webhook)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.GetWebhooksParam) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_GetWebhooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhooks'
+type MockClient_GetWebhooks_Call struct {
+	*mock.Call
+}
+
+// GetWebhooks is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.GetWebhooksParam
+func (_e *MockClient_Expecter) GetWebhooks(_a0 interface{}, _a1 interface{}) *MockClient_GetWebhooks_Call {
+	return &MockClient_GetWebhooks_Call{Call: _e.mock.On("GetWebhooks", _a0, _a1)}
+}
+
+func (_c *MockClient_GetWebhooks_Call) Run(run func(_a0 context.Context, _a1 api.GetWebhooksParam)) *MockClient_GetWebhooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(api.GetWebhooksParam))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetWebhooks_Call) Return(_a0 []dto.Webhook, _a1 error) *MockClient_GetWebhooks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GetWorkspace provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) GetWorkspace(_a0 context.Context, _a1 api.GetWorkspace) (dto.Workspace, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 dto.Workspace
+	if rf, ok := ret.Get(0).(func(context.Context, api.GetWorkspace) dto.Workspace); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(dto.Workspace)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.GetWorkspace) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_GetWorkspace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspace'
+type MockClient_GetWorkspace_Call struct {
+	*mock.Call
+}
+
+// GetWorkspace is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.GetWorkspace
+func (_e *MockClient_Expecter) GetWorkspace(_a0 interface{}, _a1 interface{}) *MockClient_GetWorkspace_Call {
+	return &MockClient_GetWorkspace_Call{Call: _e.mock.On("GetWorkspace", _a0, _a1)}
+}
+
+func (_c *MockClient_GetWorkspace_Call) Run(run func(_a0 context.Context, _a1 api.GetWorkspace)) *MockClient_GetWorkspace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(api.GetWorkspace))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetWorkspace_Call) Return(_a0 dto.Workspace, _a1 error) *MockClient_GetWorkspace_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GetWorkspaces provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) GetWorkspaces(_a0 context.Context, _a1 api.GetWorkspaces) ([]dto.Workspace, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []dto.Workspace
+	if rf, ok := ret.Get(0).(func(context.Context, api.GetWorkspaces) []dto.Workspace); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.Workspace)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.GetWorkspaces) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_GetWorkspaces_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaces'
+type MockClient_GetWorkspaces_Call struct {
+	*mock.Call
+}
+
+// GetWorkspaces is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.GetWorkspaces
+func (_e *MockClient_Expecter) GetWorkspaces(_a0 interface{}, _a1 interface{}) *MockClient_GetWorkspaces_Call {
+	return &MockClient_GetWorkspaces_Call{Call: _e.mock.On("GetWorkspaces", _a0, _a1)}
+}
+
+func (_c *MockClient_GetWorkspaces_Call) Run(run func(_a0 context.Context, _a1 api.GetWorkspaces)) *MockClient_GetWorkspaces_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(api.GetWorkspaces))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetWorkspaces_Call) Return(_a0 []dto.Workspace, _a1 error) *MockClient_GetWorkspaces_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// Log provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) Log(_a0 context.Context, _a1 api.LogParam) ([]dto.TimeEntry, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []dto.TimeEntry
+	if rf, ok := ret.Get(0).(func(context.Context, api.LogParam) []dto.TimeEntry); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.TimeEntry)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.LogParam) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_Log_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Log'
+type MockClient_Log_Call struct {
+	*mock.Call
+}
+
+// Log is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.LogParam
+func (_e *MockClient_Expecter) Log(_a0 interface{}, _a1 interface{}) *MockClient_Log_Call {
+	return &MockClient_Log_Call{Call: _e.mock.On("Log", _a0, _a1)}
+}
+
+func (_c *MockClient_Log_Call) Run(run func(_a0 context.Context, _a1 api.LogParam)) *MockClient_Log_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(api.LogParam))
+	})
+	return _c
+}
+
+func (_c *MockClient_Log_Call) Return(_a0 []dto.TimeEntry, _a1 error) *MockClient_Log_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// LogRange provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) LogRange(_a0 context.Context, _a1 api.LogRangeParam) ([]dto.TimeEntry, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []dto.TimeEntry
+	if rf, ok := ret.Get(0).(func(context.Context, api.LogRangeParam) []dto.TimeEntry); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.TimeEntry)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.LogRangeParam) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_LogRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogRange'
+type MockClient_LogRange_Call struct {
+	*mock.Call
+}
+
+// LogRange is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.LogRangeParam
+func (_e *MockClient_Expecter) LogRange(_a0 interface{}, _a1 interface{}) *MockClient_LogRange_Call {
+	return &MockClient_LogRange_Call{Call: _e.mock.On("LogRange", _a0, _a1)}
+}
+
+func (_c *MockClient_LogRange_Call) Run(run func(_a0 context.Context, _a1 api.LogRangeParam)) *MockClient_LogRange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(api.LogRangeParam))
+	})
+	return _c
+}
+
+func (_c *MockClient_LogRange_Call) Return(_a0 []dto.TimeEntry, _a1 error) *MockClient_LogRange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// Out provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) Out(_a0 context.Context, _a1 api.OutParam) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, api.OutParam) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockClient_Out_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Out'
+type MockClient_Out_Call struct {
+	*mock.Call
+}
+
+// Out is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.OutParam
+func (_e *MockClient_Expecter) Out(_a0 interface{}, _a1 interface{}) *MockClient_Out_Call {
+	return &MockClient_Out_Call{Call: _e.mock.On("Out", _a0, _a1)}
+}
+
+func (_c *MockClient_Out_Call) Run(run func(_a0 context.Context, _a1 api.OutParam)) *MockClient_Out_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(api.OutParam))
+	})
+	return _c
+}
+
+func (_c *MockClient_Out_Call) Return(_a0 error) *MockClient_Out_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// Raw provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) Raw(_a0 context.Context, _a1 api.RawParam) (json.RawMessage, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 json.RawMessage
+	if rf, ok := ret.Get(0).(func(context.Context, api.RawParam) json.RawMessage); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(json.RawMessage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.RawParam) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_Raw_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Raw'
+type MockClient_Raw_Call struct {
+	*mock.Call
+}
+
+// Raw is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.RawParam
+func (_e *MockClient_Expecter) Raw(_a0 interface{}, _a1 interface{}) *MockClient_Raw_Call {
+	return &MockClient_Raw_Call{Call: _e.mock.On("Raw", _a0, _a1)}
+}
+
+func (_c *MockClient_Raw_Call) Run(run func(_a0 context.Context, _a1 api.RawParam)) *MockClient_Raw_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(api.RawParam))
+	})
+	return _c
+}
+
+func (_c *MockClient_Raw_Call) Return(_a0 json.RawMessage, _a1 error) *MockClient_Raw_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// RemoveUserFromGroup provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) RemoveUserFromGroup(_a0 context.Context, _a1 api.UserGroupMemberParam) (dto.UserGroup, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 dto.UserGroup
+	if rf, ok := ret.Get(0).(func(context.Context, api.UserGroupMemberParam) dto.UserGroup); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(dto.UserGroup)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.UserGroupMemberParam) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_RemoveUserFromGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveUserFromGroup'
+type MockClient_RemoveUserFromGroup_Call struct {
+	*mock.Call
+}
+
+// RemoveUserFromGroup is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.UserGroupMemberParam
+func (_e *MockClient_Expecter) RemoveUserFromGroup(_a0 interface{}, _a1 interface{}) *MockClient_RemoveUserFromGroup_Call {
+	return &MockClient_RemoveUserFromGroup_Call{Call: _e.mock.On("RemoveUserFromGroup", _a0, _a1)}
+}
+
+func (_c *MockClient_RemoveUserFromGroup_Call) Run(run func(_a0 context.Context, _a1 api.UserGroupMemberParam)) *MockClient_RemoveUserFromGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(api.UserGroupMemberParam))
+	})
+	return _c
+}
+
+func (_c *MockClient_RemoveUserFromGroup_Call) Return(_a0 dto.UserGroup, _a1 error) *MockClient_RemoveUserFromGroup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// SetCacheDir provides a mock function with given fields: dir
+func (_m *MockClient) SetCacheDir(dir string) api.Client {
+	ret := _m.Called(dir)
+
+	var r0 api.Client
+	if rf, ok := ret.Get(0).(func(string) api.Client); ok {
+		r0 = rf(dir)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(api.Client)
+		}
+	}
+
+	return r0
+}
+
+// MockClient_SetCacheDir_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCacheDir'
+type MockClient_SetCacheDir_Call struct {
+	*mock.Call
+}
+
+// SetCacheDir is a helper method to define mock.On call
+//   - dir string
+func (_e *MockClient_Expecter) SetCacheDir(dir interface{}) *MockClient_SetCacheDir_Call {
+	return &MockClient_SetCacheDir_Call{Call: _e.mock.On("SetCacheDir", dir)}
+}
+
+func (_c *MockClient_SetCacheDir_Call) Run(run func(dir string)) *MockClient_SetCacheDir_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockClient_SetCacheDir_Call) Return(_a0 api.Client) *MockClient_SetCacheDir_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// SetDebugLogger provides a mock function with given fields: logger
+func (_m *MockClient) SetDebugLogger(logger api.Logger) api.Client {
+	ret := _m.Called(logger)
+
+	var r0 api.Client
+	if rf, ok := ret.Get(0).(func(api.Logger) api.Client); ok {
+		r0 = rf(logger)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(api.Client)
+		}
+	}
+
+	return r0
+}
+
+// MockClient_SetDebugLogger_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetDebugLogger'
+type MockClient_SetDebugLogger_Call struct {
+	*mock.Call
+}
+
+// SetDebugLogger is a helper method to define mock.On call
+//   - logger api.Logger
+func (_e *MockClient_Expecter) SetDebugLogger(logger interface{}) *MockClient_SetDebugLogger_Call {
+	return &MockClient_SetDebugLogger_Call{Call: _e.mock.On("SetDebugLogger", logger)}
+}
+
+func (_c *MockClient_SetDebugLogger_Call) Run(run func(logger api.Logger)) *MockClient_SetDebugLogger_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.Logger))
+	})
+	return _c
+}
+
+func (_c *MockClient_SetDebugLogger_Call) Return(_a0 api.Client) *MockClient_SetDebugLogger_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// SetInfoLogger provides a mock function with given fields: logger
+func (_m *MockClient) SetInfoLogger(logger api.Logger) api.Client {
+	ret := _m.Called(logger)
+
+	var r0 api.Client
+	if rf, ok := ret.Get(0).(func(api.Logger) api.Client); ok {
+		r0 = rf(logger)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(api.Client)
+		}
+	}
+
+	return r0
+}
+
+// MockClient_SetInfoLogger_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetInfoLogger'
+type MockClient_SetInfoLogger_Call struct {
+	*mock.Call
+}
+
+// SetInfoLogger is a helper method to define mock.On call
+//   - logger api.Logger
+func (_e *MockClient_Expecter) SetInfoLogger(logger interface{}) *MockClient_SetInfoLogger_Call {
+	return &MockClient_SetInfoLogger_Call{Call: _e.mock.On("SetInfoLogger", logger)}
+}
+
+func (_c *MockClient_SetInfoLogger_Call) Run(run func(logger api.Logger)) *MockClient_SetInfoLogger_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.Logger))
+	})
+	return _c
+}
+
+func (_c *MockClient_SetInfoLogger_Call) Return(_a0 api.Client) *MockClient_SetInfoLogger_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// SetHTTPTrace provides a mock function with given fields: out
+func (_m *MockClient) SetHTTPTrace(out io.Writer) api.Client {
+	ret := _m.Called(out)
+
+	var r0 api.Client
+	if rf, ok := ret.Get(0).(func(io.Writer) api.Client); ok {
+		r0 = rf(out)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(api.Client)
+		}
+	}
+
+	return r0
+}
+
+// MockClient_SetHTTPTrace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetHTTPTrace'
+type MockClient_SetHTTPTrace_Call struct {
+	*mock.Call
+}
+
+// SetHTTPTrace is a helper method to define mock.On call
+//   - out io.Writer
+func (_e *MockClient_Expecter) SetHTTPTrace(out interface{}) *MockClient_SetHTTPTrace_Call {
+	return &MockClient_SetHTTPTrace_Call{Call: _e.mock.On("SetHTTPTrace", out)}
+}
+
+func (_c *MockClient_SetHTTPTrace_Call) Run(run func(out io.Writer)) *MockClient_SetHTTPTrace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *MockClient_SetHTTPTrace_Call) Return(_a0 api.Client) *MockClient_SetHTTPTrace_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// SetMaxRetries provides a mock function with given fields: _a0
+func (_m *MockClient) SetMaxRetries(_a0 int) api.Client {
+	ret := _m.Called(_a0)
+
+	var r0 api.Client
+	if rf, ok := ret.Get(0).(func(int) api.Client); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(api.Client)
+		}
+	}
+
+	return r0
+}
+
+// MockClient_SetMaxRetries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMaxRetries'
+type MockClient_SetMaxRetries_Call struct {
+	*mock.Call
+}
+
+// SetMaxRetries is a helper method to define mock.On call
+//   - _a0 int
+func (_e *MockClient_Expecter) SetMaxRetries(_a0 interface{}) *MockClient_SetMaxRetries_Call {
+	return &MockClient_SetMaxRetries_Call{Call: _e.mock.On("SetMaxRetries", _a0)}
+}
+
+func (_c *MockClient_SetMaxRetries_Call) Run(run func(_a0 int)) *MockClient_SetMaxRetries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockClient_SetMaxRetries_Call) Return(_a0 api.Client) *MockClient_SetMaxRetries_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// SetRetryWait provides a mock function with given fields: _a0
+func (_m *MockClient) SetRetryWait(_a0 time.Duration) api.Client {
+	ret := _m.Called(_a0)
+
+	var r0 api.Client
+	if rf, ok := ret.Get(0).(func(time.Duration) api.Client); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(api.Client)
+		}
+	}
+
+	return r0
+}
+
+// MockClient_SetRetryWait_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRetryWait'
+type MockClient_SetRetryWait_Call struct {
+	*mock.Call
+}
+
+// SetRetryWait is a helper method to define mock.On call
+//   - _a0 time.Duration
+func (_e *MockClient_Expecter) SetRetryWait(_a0 interface{}) *MockClient_SetRetryWait_Call {
+	return &MockClient_SetRetryWait_Call{Call: _e.mock.On("SetRetryWait", _a0)}
+}
+
+func (_c *MockClient_SetRetryWait_Call) Run(run func(_a0 time.Duration)) *MockClient_SetRetryWait_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockClient_SetRetryWait_Call) Return(_a0 api.Client) *MockClient_SetRetryWait_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// SetTimeout provides a mock function with given fields: _a0
+func (_m *MockClient) SetTimeout(_a0 time.Duration) api.Client {
+	ret := _m.Called(_a0)
+
+	var r0 api.Client
+	if rf, ok := ret.Get(0).(func(time.Duration) api.Client); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(api.Client)
+		}
+	}
+
+	return r0
+}
+
+// MockClient_SetTimeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTimeout'
+type MockClient_SetTimeout_Call struct {
+	*mock.Call
+}
+
+// SetTimeout is a helper method to define mock.On call
+//   - _a0 time.Duration
+func (_e *MockClient_Expecter) SetTimeout(_a0 interface{}) *MockClient_SetTimeout_Call {
+	return &MockClient_SetTimeout_Call{Call: _e.mock.On("SetTimeout", _a0)}
+}
+
+func (_c *MockClient_SetTimeout_Call) Run(run func(_a0 time.Duration)) *MockClient_SetTimeout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockClient_SetTimeout_Call) Return(_a0 api.Client) *MockClient_SetTimeout_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// SetTransport provides a mock function with given fields: t
+func (_m *MockClient) SetTransport(t http.RoundTripper) api.Client {
+	ret := _m.Called(t)
+
+	var r0 api.Client
+	if rf, ok := ret.Get(0).(func(http.RoundTripper) api.Client); ok {
+		r0 = rf(t)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(api.Client)
+		}
+	}
+
+	return r0
+}
+
+// MockClient_SetTransport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTransport'
+type MockClient_SetTransport_Call struct {
+	*mock.Call
+}
+
+// SetTransport is a helper method to define mock.On call
+//   - t http.RoundTripper
+func (_e *MockClient_Expecter) SetTransport(t interface{}) *MockClient_SetTransport_Call {
+	return &MockClient_SetTransport_Call{Call: _e.mock.On("SetTransport", t)}
+}
+
+func (_c *MockClient_SetTransport_Call) Run(run func(t http.RoundTripper)) *MockClient_SetTransport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.RoundTripper))
+	})
+	return _c
+}
+
+func (_c *MockClient_SetTransport_Call) Return(_a0 api.Client) *MockClient_SetTransport_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// SubmitApprovalRequest provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) SubmitApprovalRequest(_a0 context.Context, _a1 api.SubmitApprovalRequestParam) (dto.ApprovalRequest, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 dto.ApprovalRequest
+	if rf, ok := ret.Get(0).(func(context.Context, api.SubmitApprovalRequestParam) dto.ApprovalRequest); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(dto.ApprovalRequest)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.SubmitApprovalRequestParam) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_SubmitApprovalRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubmitApprovalRequest'
+type MockClient_SubmitApprovalRequest_Call struct {
+	*mock.Call
+}
+
+// SubmitApprovalRequest is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.SubmitApprovalRequestParam
+func (_e *MockClient_Expecter) SubmitApprovalRequest(_a0 interface{}, _a1 interface{}) *MockClient_SubmitApprovalRequest_Call {
+	return &MockClient_SubmitApprovalRequest_Call{Call: _e.mock.On("SubmitApprovalRequest", _a0, _a1)}
+}
+
+func (_c *MockClient_SubmitApprovalRequest_Call) Run(run func(_a0 context.Context, _a1 api.SubmitApprovalRequestParam)) *MockClient_SubmitApprovalRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(api.SubmitApprovalRequestParam))
+	})
+	return _c
+}
+
+func (_c *MockClient_SubmitApprovalRequest_Call) Return(_a0 dto.ApprovalRequest, _a1 error) *MockClient_SubmitApprovalRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// TimeEntriesIter provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) TimeEntriesIter(_a0 context.Context, _a1 api.GetUserTimeEntriesParam) *api.TimeEntryIterator {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *api.TimeEntryIterator
+	if rf, ok := ret.Get(0).(func(context.Context, api.GetUserTimeEntriesParam) *api.TimeEntryIterator); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.TimeEntryIterator)
+		}
+	}
+
+	return r0
+}
+
+// MockClient_TimeEntriesIter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TimeEntriesIter'
+type MockClient_TimeEntriesIter_Call struct {
+	*mock.Call
+}
+
+// TimeEntriesIter is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.GetUserTimeEntriesParam
+func (_e *MockClient_Expecter) TimeEntriesIter(_a0 interface{}, _a1 interface{}) *MockClient_TimeEntriesIter_Call {
+	return &MockClient_TimeEntriesIter_Call{Call: _e.mock.On("TimeEntriesIter", _a0, _a1)}
+}
+
+func (_c *MockClient_TimeEntriesIter_Call) Run(run func(_a0 context.Context, _a1 api.GetUserTimeEntriesParam)) *MockClient_TimeEntriesIter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(api.GetUserTimeEntriesParam))
+	})
+	return _c
+}
+
+func (_c *MockClient_TimeEntriesIter_Call) Return(_a0 *api.TimeEntryIterator) *MockClient_TimeEntriesIter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// UpdateApprovalRequest provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) UpdateApprovalRequest(_a0 context.Context, _a1 api.UpdateApprovalRequestParam) (dto.ApprovalRequest, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 dto.ApprovalRequest
+	if rf, ok := ret.Get(0).(func(context.Context, api.UpdateApprovalRequestParam) dto.ApprovalRequest); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(dto.ApprovalRequest)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.UpdateApprovalRequestParam) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_UpdateApprovalRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateApprovalRequest'
+type MockClient_UpdateApprovalRequest_Call struct {
+	*mock.Call
+}
+
+// UpdateApprovalRequest is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.UpdateApprovalRequestParam
+func (_e *MockClient_Expecter) UpdateApprovalRequest(_a0 interface{}, _a1 interface{}) *MockClient_UpdateApprovalRequest_Call {
+	return &MockClient_UpdateApprovalRequest_Call{Call: _e.mock.On("UpdateApprovalRequest", _a0, _a1)}
+}
+
+func (_c *MockClient_UpdateApprovalRequest_Call) Run(run func(_a0 context.Context, _a1 api.UpdateApprovalRequestParam)) *MockClient_UpdateApprovalRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(api.UpdateApprovalRequestParam))
+	})
+	return _c
+}
+
+func (_c *MockClient_UpdateApprovalRequest_Call) Return(_a0 dto.ApprovalRequest, _a1 error) *MockClient_UpdateApprovalRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// UpdateProject provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) UpdateProject(_a0 context.Context, _a1 api.UpdateProjectParam) (dto.Project, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 dto.Project
+	if rf, ok := ret.Get(0).(func(context.Context, api.UpdateProjectParam) dto.Project); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(dto.Project)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.UpdateProjectParam) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1020,43 +2813,44 @@ func (_m *MockClient) GetUsersHydratedTimeEntries(_a0 api.GetUserTimeEntriesPara
 	return r0, r1
 }
 
-// MockClient_GetUsersHydratedTimeEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsersHydratedTimeEntries'
-type MockClient_GetUsersHydratedTimeEntries_Call struct {
+// MockClient_UpdateProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProject'
+type MockClient_UpdateProject_Call struct {
 	*mock.Call
 }
 
-// GetUsersHydratedTimeEntries is a helper method to define mock.On call
-//   - _a0 api.GetUserTimeEntriesParam
-func (_e *MockClient_Expecter) GetUsersHydratedTimeEntries(_a0 interface{}) *MockClient_GetUsersHydratedTimeEntries_Call {
-	return &MockClient_GetUsersHydratedTimeEntries_Call{Call: _e.mock.On("GetUsersHydratedTimeEntries", _a0)}
+// UpdateProject is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.UpdateProjectParam
+func (_e *MockClient_Expecter) UpdateProject(_a0 interface{}, _a1 interface{}) *MockClient_UpdateProject_Call {
+	return &MockClient_UpdateProject_Call{Call: _e.mock.On("UpdateProject", _a0, _a1)}
 }
 
-func (_c *MockClient_GetUsersHydratedTimeEntries_Call) Run(run func(_a0 api.GetUserTimeEntriesParam)) *MockClient_GetUsersHydratedTimeEntries_Call {
+func (_c *MockClient_UpdateProject_Call) Run(run func(_a0 context.Context, _a1 api.UpdateProjectParam)) *MockClient_UpdateProject_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.GetUserTimeEntriesParam))
+		run(args[0].(context.Context), args[1].(api.UpdateProjectParam))
 	})
 	return _c
 }
 
-func (_c *MockClient_GetUsersHydratedTimeEntries_Call) Return(_a0 []dto.TimeEntry, _a1 error) *MockClient_GetUsersHydratedTimeEntries_Call {
+func (_c *MockClient_UpdateProject_Call) Return(_a0 dto.Project, _a1 error) *MockClient_UpdateProject_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// GetWorkspace provides a mock function with given fields: _a0
-func (_m *MockClient) GetWorkspace(_a0 api.GetWorkspace) (dto.Workspace, error) {
-	ret := _m.Called(_a0)
+// UpdateProjectBillableRate provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) UpdateProjectBillableRate(_a0 context.Context, _a1 api.UpdateProjectRateParam) (dto.Project, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 dto.Workspace
-	if rf, ok := ret.Get(0).(func(api.GetWorkspace) dto.Workspace); ok {
-		r0 = rf(_a0)
+	var r0 dto.Project
+	if rf, ok := ret.Get(0).(func(context.Context, api.UpdateProjectRateParam) dto.Project); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		r0 = ret.Get(0).(dto.Workspace)
+		r0 = ret.Get(0).(dto.Project)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(api.GetWorkspace) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, api.UpdateProjectRateParam) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1064,45 +2858,44 @@ func (_m *MockClient) GetWorkspace(_a0 api.GetWorkspace) (dto.Workspace, error)
 	return r0, r1
 }
 
-// MockClient_GetWorkspace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspace'
-type MockClient_GetWorkspace_Call struct {
+// MockClient_UpdateProjectBillableRate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProjectBillableRate'
+type MockClient_UpdateProjectBillableRate_Call struct {
 	*mock.Call
 }
 
-// GetWorkspace is a helper method to define mock.On call
-//   - _a0 api.GetWorkspace
-func (_e *MockClient_Expecter) GetWorkspace(_a0 interface{}) *MockClient_GetWorkspace_Call {
-	return &MockClient_GetWorkspace_Call{Call: _e.mock.On("GetWorkspace", _a0)}
+// UpdateProjectBillableRate is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.UpdateProjectRateParam
+func (_e *MockClient_Expecter) UpdateProjectBillableRate(_a0 interface{}, _a1 interface{}) *MockClient_UpdateProjectBillableRate_Call {
+	return &MockClient_UpdateProjectBillableRate_Call{Call: _e.mock.On("UpdateProjectBillableRate", _a0, _a1)}
 }
 
-func (_c *MockClient_GetWorkspace_Call) Run(run func(_a0 api.GetWorkspace)) *MockClient_GetWorkspace_Call {
+func (_c *MockClient_UpdateProjectBillableRate_Call) Run(run func(_a0 context.Context, _a1 api.UpdateProjectRateParam)) *MockClient_UpdateProjectBillableRate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.GetWorkspace))
+		run(args[0].(context.Context), args[1].(api.UpdateProjectRateParam))
 	})
 	return _c
 }
 
-func (_c *MockClient_GetWorkspace_Call) Return(_a0 dto.Workspace, _a1 error) *MockClient_GetWorkspace_Call {
+func (_c *MockClient_UpdateProjectBillableRate_Call) Return(_a0 dto.Project, _a1 error) *MockClient_UpdateProjectBillableRate_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// GetWorkspaces provides a mock function with given fields: _a0
-func (_m *MockClient) GetWorkspaces(_a0 api.GetWorkspaces) ([]dto.Workspace, error) {
-	ret := _m.Called(_a0)
+// UpdateProjectCostRate provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) UpdateProjectCostRate(_a0 context.Context, _a1 api.UpdateProjectRateParam) (dto.Project, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 []dto.Workspace
-	if rf, ok := ret.Get(0).(func(api.GetWorkspaces) []dto.Workspace); ok {
-		r0 = rf(_a0)
+	var r0 dto.Project
+	if rf, ok := ret.Get(0).(func(context.Context, api.UpdateProjectRateParam) dto.Project); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]dto.Workspace)
-		}
+		r0 = ret.Get(0).(dto.Project)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(api.GetWorkspaces) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, api.UpdateProjectRateParam) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1110,45 +2903,44 @@ func (_m *MockClient) GetWorkspaces(_a0 api.GetWorkspaces) ([]dto.Workspace, err
 	return r0, r1
 }
 
-// MockClient_GetWorkspaces_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaces'
-type MockClient_GetWorkspaces_Call struct {
+// MockClient_UpdateProjectCostRate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProjectCostRate'
+type MockClient_UpdateProjectCostRate_Call struct {
 	*mock.Call
 }
 
-// GetWorkspaces is a helper method to define mock.On call
-//   - _a0 api.GetWorkspaces
-func (_e *MockClient_Expecter) GetWorkspaces(_a0 interface{}) *MockClient_GetWorkspaces_Call {
-	return &MockClient_GetWorkspaces_Call{Call: _e.mock.On("GetWorkspaces", _a0)}
+// UpdateProjectCostRate is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.UpdateProjectRateParam
+func (_e *MockClient_Expecter) UpdateProjectCostRate(_a0 interface{}, _a1 interface{}) *MockClient_UpdateProjectCostRate_Call {
+	return &MockClient_UpdateProjectCostRate_Call{Call: _e.mock.On("UpdateProjectCostRate", _a0, _a1)}
 }
 
-func (_c *MockClient_GetWorkspaces_Call) Run(run func(_a0 api.GetWorkspaces)) *MockClient_GetWorkspaces_Call {
+func (_c *MockClient_UpdateProjectCostRate_Call) Run(run func(_a0 context.Context, _a1 api.UpdateProjectRateParam)) *MockClient_UpdateProjectCostRate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.GetWorkspaces))
+		run(args[0].(context.Context), args[1].(api.UpdateProjectRateParam))
 	})
 	return _c
 }
 
-func (_c *MockClient_GetWorkspaces_Call) Return(_a0 []dto.Workspace, _a1 error) *MockClient_GetWorkspaces_Call {
+func (_c *MockClient_UpdateProjectCostRate_Call) Return(_a0 dto.Project, _a1 error) *MockClient_UpdateProjectCostRate_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// Log provides a mock function with given fields: _a0
-func (_m *MockClient) Log(_a0 api.LogParam) ([]dto.TimeEntry, error) {
-	ret := _m.Called(_a0)
+// UpdateProjectEstimate provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) UpdateProjectEstimate(_a0 context.Context, _a1 api.UpdateProjectEstimateParam) (dto.Project, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 []dto.TimeEntry
-	if rf, ok := ret.Get(0).(func(api.LogParam) []dto.TimeEntry); ok {
-		r0 = rf(_a0)
+	var r0 dto.Project
+	if rf, ok := ret.Get(0).(func(context.Context, api.UpdateProjectEstimateParam) dto.Project); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]dto.TimeEntry)
-		}
+		r0 = ret.Get(0).(dto.Project)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(api.LogParam) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, api.UpdateProjectEstimateParam) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1156,45 +2948,44 @@ func (_m *MockClient) Log(_a0 api.LogParam) ([]dto.TimeEntry, error) {
 	return r0, r1
 }
 
-// MockClient_Log_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Log'
-type MockClient_Log_Call struct {
+// MockClient_UpdateProjectEstimate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProjectEstimate'
+type MockClient_UpdateProjectEstimate_Call struct {
 	*mock.Call
 }
 
-// Log is a helper method to define mock.On call
-//   - _a0 api.LogParam
-func (_e *MockClient_Expecter) Log(_a0 interface{}) *MockClient_Log_Call {
-	return &MockClient_Log_Call{Call: _e.mock.On("Log", _a0)}
+// UpdateProjectEstimate is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.UpdateProjectEstimateParam
+func (_e *MockClient_Expecter) UpdateProjectEstimate(_a0 interface{}, _a1 interface{}) *MockClient_UpdateProjectEstimate_Call {
+	return &MockClient_UpdateProjectEstimate_Call{Call: _e.mock.On("UpdateProjectEstimate", _a0, _a1)}
 }
 
-func (_c *MockClient_Log_Call) Run(run func(_a0 api.LogParam)) *MockClient_Log_Call {
+func (_c *MockClient_UpdateProjectEstimate_Call) Run(run func(_a0 context.Context, _a1 api.UpdateProjectEstimateParam)) *MockClient_UpdateProjectEstimate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.LogParam))
+		run(args[0].(context.Context), args[1].(api.UpdateProjectEstimateParam))
 	})
 	return _c
 }
 
-func (_c *MockClient_Log_Call) Return(_a0 []dto.TimeEntry, _a1 error) *MockClient_Log_Call {
+func (_c *MockClient_UpdateProjectEstimate_Call) Return(_a0 dto.Project, _a1 error) *MockClient_UpdateProjectEstimate_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// LogRange provides a mock function with given fields: _a0
-func (_m *MockClient) LogRange(_a0 api.LogRangeParam) ([]dto.TimeEntry, error) {
-	ret := _m.Called(_a0)
+// UpdateProjectMemberships provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) UpdateProjectMemberships(_a0 context.Context, _a1 api.UpdateProjectMembershipsParam) (dto.Project, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 []dto.TimeEntry
-	if rf, ok := ret.Get(0).(func(api.LogRangeParam) []dto.TimeEntry); ok {
-		r0 = rf(_a0)
+	var r0 dto.Project
+	if rf, ok := ret.Get(0).(func(context.Context, api.UpdateProjectMembershipsParam) dto.Project); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]dto.TimeEntry)
-		}
+		r0 = ret.Get(0).(dto.Project)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(api.LogRangeParam) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, api.UpdateProjectMembershipsParam) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1202,158 +2993,179 @@ func (_m *MockClient) LogRange(_a0 api.LogRangeParam) ([]dto.TimeEntry, error) {
 	return r0, r1
 }
 
-// MockClient_LogRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogRange'
-type MockClient_LogRange_Call struct {
+// MockClient_UpdateProjectMemberships_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProjectMemberships'
+type MockClient_UpdateProjectMemberships_Call struct {
 	*mock.Call
 }
 
-// LogRange is a helper method to define mock.On call
-//   - _a0 api.LogRangeParam
-func (_e *MockClient_Expecter) LogRange(_a0 interface{}) *MockClient_LogRange_Call {
-	return &MockClient_LogRange_Call{Call: _e.mock.On("LogRange", _a0)}
+// UpdateProjectMemberships is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.UpdateProjectMembershipsParam
+func (_e *MockClient_Expecter) UpdateProjectMemberships(_a0 interface{}, _a1 interface{}) *MockClient_UpdateProjectMemberships_Call {
+	return &MockClient_UpdateProjectMemberships_Call{Call: _e.mock.On("UpdateProjectMemberships", _a0, _a1)}
 }
 
-func (_c *MockClient_LogRange_Call) Run(run func(_a0 api.LogRangeParam)) *MockClient_LogRange_Call {
+func (_c *MockClient_UpdateProjectMemberships_Call) Run(run func(_a0 context.Context, _a1 api.UpdateProjectMembershipsParam)) *MockClient_UpdateProjectMemberships_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.LogRangeParam))
+		run(args[0].(context.Context), args[1].(api.UpdateProjectMembershipsParam))
 	})
 	return _c
 }
 
-func (_c *MockClient_LogRange_Call) Return(_a0 []dto.TimeEntry, _a1 error) *MockClient_LogRange_Call {
+func (_c *MockClient_UpdateProjectMemberships_Call) Return(_a0 dto.Project, _a1 error) *MockClient_UpdateProjectMemberships_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// Out provides a mock function with given fields: _a0
-func (_m *MockClient) Out(_a0 api.OutParam) error {
-	ret := _m.Called(_a0)
+// UpdateProjectTemplate provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) UpdateProjectTemplate(_a0 context.Context, _a1 api.UpdateProjectTemplateParam) (dto.Project, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(api.OutParam) error); ok {
-		r0 = rf(_a0)
+	var r0 dto.Project
+	if rf, ok := ret.Get(0).(func(context.Context, api.UpdateProjectTemplateParam) dto.Project); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(dto.Project)
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.UpdateProjectTemplateParam) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockClient_Out_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Out'
-type MockClient_Out_Call struct {
+// MockClient_UpdateProjectTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProjectTemplate'
+type MockClient_UpdateProjectTemplate_Call struct {
 	*mock.Call
 }
 
-// Out is a helper method to define mock.On call
-//   - _a0 api.OutParam
-func (_e *MockClient_Expecter) Out(_a0 interface{}) *MockClient_Out_Call {
-	return &MockClient_Out_Call{Call: _e.mock.On("Out", _a0)}
+// UpdateProjectTemplate is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.UpdateProjectTemplateParam
+func (_e *MockClient_Expecter) UpdateProjectTemplate(_a0 interface{}, _a1 interface{}) *MockClient_UpdateProjectTemplate_Call {
+	return &MockClient_UpdateProjectTemplate_Call{Call: _e.mock.On("UpdateProjectTemplate", _a0, _a1)}
 }
 
-func (_c *MockClient_Out_Call) Run(run func(_a0 api.OutParam)) *MockClient_Out_Call {
+func (_c *MockClient_UpdateProjectTemplate_Call) Run(run func(_a0 context.Context, _a1 api.UpdateProjectTemplateParam)) *MockClient_UpdateProjectTemplate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.OutParam))
+		run(args[0].(context.Context), args[1].(api.UpdateProjectTemplateParam))
 	})
 	return _c
 }
 
-func (_c *MockClient_Out_Call) Return(_a0 error) *MockClient_Out_Call {
-	_c.Call.Return(_a0)
+func (_c *MockClient_UpdateProjectTemplate_Call) Return(_a0 dto.Project, _a1 error) *MockClient_UpdateProjectTemplate_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// SetDebugLogger provides a mock function with given fields: logger
-func (_m *MockClient) SetDebugLogger(logger api.Logger) api.Client {
-	ret := _m.Called(logger)
+// UpdateProjectUserBillableRate provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) UpdateProjectUserBillableRate(_a0 context.Context, _a1 api.UpdateProjectUserRateParam) (dto.Project, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 api.Client
-	if rf, ok := ret.Get(0).(func(api.Logger) api.Client); ok {
-		r0 = rf(logger)
+	var r0 dto.Project
+	if rf, ok := ret.Get(0).(func(context.Context, api.UpdateProjectUserRateParam) dto.Project); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(api.Client)
-		}
+		r0 = ret.Get(0).(dto.Project)
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.UpdateProjectUserRateParam) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockClient_SetDebugLogger_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetDebugLogger'
-type MockClient_SetDebugLogger_Call struct {
+// MockClient_UpdateProjectUserBillableRate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProjectUserBillableRate'
+type MockClient_UpdateProjectUserBillableRate_Call struct {
 	*mock.Call
 }
 
-// SetDebugLogger is a helper method to define mock.On call
-//   - logger api.Logger
-func (_e *MockClient_Expecter) SetDebugLogger(logger interface{}) *MockClient_SetDebugLogger_Call {
-	return &MockClient_SetDebugLogger_Call{Call: _e.mock.On("SetDebugLogger", logger)}
+// UpdateProjectUserBillableRate is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.UpdateProjectUserRateParam
+func (_e *MockClient_Expecter) UpdateProjectUserBillableRate(_a0 interface{}, _a1 interface{}) *MockClient_UpdateProjectUserBillableRate_Call {
+	return &MockClient_UpdateProjectUserBillableRate_Call{Call: _e.mock.On("UpdateProjectUserBillableRate", _a0, _a1)}
 }
 
-func (_c *MockClient_SetDebugLogger_Call) Run(run func(logger api.Logger)) *MockClient_SetDebugLogger_Call {
+func (_c *MockClient_UpdateProjectUserBillableRate_Call) Run(run func(_a0 context.Context, _a1 api.UpdateProjectUserRateParam)) *MockClient_UpdateProjectUserBillableRate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.Logger))
+		run(args[0].(context.Context), args[1].(api.UpdateProjectUserRateParam))
 	})
 	return _c
 }
 
-func (_c *MockClient_SetDebugLogger_Call) Return(_a0 api.Client) *MockClient_SetDebugLogger_Call {
-	_c.Call.Return(_a0)
+func (_c *MockClient_UpdateProjectUserBillableRate_Call) Return(_a0 dto.Project, _a1 error) *MockClient_UpdateProjectUserBillableRate_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// SetInfoLogger provides a mock function with given fields: logger
-func (_m *MockClient) SetInfoLogger(logger api.Logger) api.Client {
-	ret := _m.Called(logger)
+// UpdateProjectUserCostRate provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) UpdateProjectUserCostRate(_a0 context.Context, _a1 api.UpdateProjectUserRateParam) (dto.Project, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 api.Client
-	if rf, ok := ret.Get(0).(func(api.Logger) api.Client); ok {
-		r0 = rf(logger)
+	var r0 dto.Project
+	if rf, ok := ret.Get(0).(func(context.Context, api.UpdateProjectUserRateParam) dto.Project); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(api.Client)
-		}
+		r0 = ret.Get(0).(dto.Project)
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.UpdateProjectUserRateParam) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockClient_SetInfoLogger_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetInfoLogger'
-type MockClient_SetInfoLogger_Call struct {
+// MockClient_UpdateProjectUserCostRate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProjectUserCostRate'
+type MockClient_UpdateProjectUserCostRate_Call struct {
 	*mock.Call
 }
 
-// SetInfoLogger is a helper method to define mock.On call
-//   - logger api.Logger
-func (_e *MockClient_Expecter) SetInfoLogger(logger interface{}) *MockClient_SetInfoLogger_Call {
-	return &MockClient_SetInfoLogger_Call{Call: _e.mock.On("SetInfoLogger", logger)}
+// UpdateProjectUserCostRate is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.UpdateProjectUserRateParam
+func (_e *MockClient_Expecter) UpdateProjectUserCostRate(_a0 interface{}, _a1 interface{}) *MockClient_UpdateProjectUserCostRate_Call {
+	return &MockClient_UpdateProjectUserCostRate_Call{Call: _e.mock.On("UpdateProjectUserCostRate", _a0, _a1)}
 }
 
-func (_c *MockClient_SetInfoLogger_Call) Run(run func(logger api.Logger)) *MockClient_SetInfoLogger_Call {
+func (_c *MockClient_UpdateProjectUserCostRate_Call) Run(run func(_a0 context.Context, _a1 api.UpdateProjectUserRateParam)) *MockClient_UpdateProjectUserCostRate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.Logger))
+		run(args[0].(context.Context), args[1].(api.UpdateProjectUserRateParam))
 	})
 	return _c
 }
 
-func (_c *MockClient_SetInfoLogger_Call) Return(_a0 api.Client) *MockClient_SetInfoLogger_Call {
-	_c.Call.Return(_a0)
+func (_c *MockClient_UpdateProjectUserCostRate_Call) Return(_a0 dto.Project, _a1 error) *MockClient_UpdateProjectUserCostRate_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// UpdateProject provides a mock function with given fields: _a0
-func (_m *MockClient) UpdateProject(_a0 api.UpdateProjectParam) (dto.Project, error) {
-	ret := _m.Called(_a0)
+// UpdateTask provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) UpdateTask(_a0 context.Context, _a1 api.UpdateTaskParam) (dto.Task, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 dto.Project
-	if rf, ok := ret.Get(0).(func(api.UpdateProjectParam) dto.Project); ok {
-		r0 = rf(_a0)
+	var r0 dto.Task
+	if rf, ok := ret.Get(0).(func(context.Context, api.UpdateTaskParam) dto.Task); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		r0 = ret.Get(0).(dto.Project)
+		r0 = ret.Get(0).(dto.Task)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(api.UpdateProjectParam) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, api.UpdateTaskParam) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1361,43 +3173,46 @@ func (_m *MockClient) UpdateProject(_a0 api.UpdateProjectParam) (dto.Project, er
 	return r0, r1
 }
 
-// MockClient_UpdateProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProject'
-type MockClient_UpdateProject_Call struct {
+// MockClient_UpdateTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTask'
+type MockClient_UpdateTask_Call struct {
 	*mock.Call
 }
 
-// UpdateProject is a helper method to define mock.On call
-//   - _a0 api.UpdateProjectParam
-func (_e *MockClient_Expecter) UpdateProject(_a0 interface{}) *MockClient_UpdateProject_Call {
-	return &MockClient_UpdateProject_Call{Call: _e.mock.On("UpdateProject", _a0)}
+// UpdateTask is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.UpdateTaskParam
+func (_e *MockClient_Expecter) UpdateTask(_a0 interface{}, _a1 interface{}) *MockClient_UpdateTask_Call {
+	return &MockClient_UpdateTask_Call{Call: _e.mock.On("UpdateTask", _a0, _a1)}
 }
 
-func (_c *MockClient_UpdateProject_Call) Run(run func(_a0 api.UpdateProjectParam)) *MockClient_UpdateProject_Call {
+func (_c *MockClient_UpdateTask_Call) Run(run func(_a0 context.Context, _a1 api.UpdateTaskParam)) *MockClient_UpdateTask_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.UpdateProjectParam))
+		run(args[0].(context.Context), args[1].(api.UpdateTaskParam))
 	})
 	return _c
 }
 
-func (_c *MockClient_UpdateProject_Call) Return(_a0 dto.Project, _a1 error) *MockClient_UpdateProject_Call {
+func (_c *MockClient_UpdateTask_Call) Return(_a0 dto.Task, _a1 error) *MockClient_UpdateTask_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// UpdateProjectEstimate provides a mock function with given fields: _a0
-func (_m *MockClient) UpdateProjectEstimate(_a0 api.UpdateProjectEstimateParam) (dto.Project, error) {
-	ret := _m.Called(_a0)
+// UpdateTimeEntries provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) UpdateTimeEntries(_a0 context.Context, _a1 api.UpdateTimeEntriesParam) ([]dto.TimeEntryImpl, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 dto.Project
-	if rf, ok := ret.Get(0).(func(api.UpdateProjectEstimateParam) dto.Project); ok {
-		r0 = rf(_a0)
+	var r0 []dto.TimeEntryImpl
+	if rf, ok := ret.Get(0).(func(context.Context, api.UpdateTimeEntriesParam) []dto.TimeEntryImpl); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		r0 = ret.Get(0).(dto.Project)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.TimeEntryImpl)
+		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(api.UpdateProjectEstimateParam) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, api.UpdateTimeEntriesParam) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1405,43 +3220,44 @@ func (_m *MockClient) UpdateProjectEstimate(_a0 api.UpdateProjectEstimateParam)
 	return r0, r1
 }
 
-// MockClient_UpdateProjectEstimate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProjectEstimate'
-type MockClient_UpdateProjectEstimate_Call struct {
+// MockClient_UpdateTimeEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTimeEntries'
+type MockClient_UpdateTimeEntries_Call struct {
 	*mock.Call
 }
 
-// UpdateProjectEstimate is a helper method to define mock.On call
-//   - _a0 api.UpdateProjectEstimateParam
-func (_e *MockClient_Expecter) UpdateProjectEstimate(_a0 interface{}) *MockClient_UpdateProjectEstimate_Call {
-	return &MockClient_UpdateProjectEstimate_Call{Call: _e.mock.On("UpdateProjectEstimate", _a0)}
+// UpdateTimeEntries is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.UpdateTimeEntriesParam
+func (_e *MockClient_Expecter) UpdateTimeEntries(_a0 interface{}, _a1 interface{}) *MockClient_UpdateTimeEntries_Call {
+	return &MockClient_UpdateTimeEntries_Call{Call: _e.mock.On("UpdateTimeEntries", _a0, _a1)}
 }
 
-func (_c *MockClient_UpdateProjectEstimate_Call) Run(run func(_a0 api.UpdateProjectEstimateParam)) *MockClient_UpdateProjectEstimate_Call {
+func (_c *MockClient_UpdateTimeEntries_Call) Run(run func(_a0 context.Context, _a1 api.UpdateTimeEntriesParam)) *MockClient_UpdateTimeEntries_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.UpdateProjectEstimateParam))
+		run(args[0].(context.Context), args[1].(api.UpdateTimeEntriesParam))
 	})
 	return _c
 }
 
-func (_c *MockClient_UpdateProjectEstimate_Call) Return(_a0 dto.Project, _a1 error) *MockClient_UpdateProjectEstimate_Call {
+func (_c *MockClient_UpdateTimeEntries_Call) Return(_a0 []dto.TimeEntryImpl, _a1 error) *MockClient_UpdateTimeEntries_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// UpdateProjectMemberships provides a mock function with given fields: _a0
-func (_m *MockClient) UpdateProjectMemberships(_a0 api.UpdateProjectMembershipsParam) (dto.Project, error) {
-	ret := _m.Called(_a0)
+// UpdateTimeEntry provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) UpdateTimeEntry(_a0 context.Context, _a1 api.UpdateTimeEntryParam) (dto.TimeEntryImpl, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 dto.Project
-	if rf, ok := ret.Get(0).(func(api.UpdateProjectMembershipsParam) dto.Project); ok {
-		r0 = rf(_a0)
+	var r0 dto.TimeEntryImpl
+	if rf, ok := ret.Get(0).(func(context.Context, api.UpdateTimeEntryParam) dto.TimeEntryImpl); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		r0 = ret.Get(0).(dto.Project)
+		r0 = ret.Get(0).(dto.TimeEntryImpl)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(api.UpdateProjectMembershipsParam) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, api.UpdateTimeEntryParam) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1449,43 +3265,44 @@ func (_m *MockClient) UpdateProjectMemberships(_a0 api.UpdateProjectMembershipsP
 	return r0, r1
 }
 
-// MockClient_UpdateProjectMemberships_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProjectMemberships'
-type MockClient_UpdateProjectMemberships_Call struct {
+// MockClient_UpdateTimeEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTimeEntry'
+type MockClient_UpdateTimeEntry_Call struct {
 	*mock.Call
 }
 
-// UpdateProjectMemberships is a helper method to define mock.On call
-//   - _a0 api.UpdateProjectMembershipsParam
-func (_e *MockClient_Expecter) UpdateProjectMemberships(_a0 interface{}) *MockClient_UpdateProjectMemberships_Call {
-	return &MockClient_UpdateProjectMemberships_Call{Call: _e.mock.On("UpdateProjectMemberships", _a0)}
+// UpdateTimeEntry is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.UpdateTimeEntryParam
+func (_e *MockClient_Expecter) UpdateTimeEntry(_a0 interface{}, _a1 interface{}) *MockClient_UpdateTimeEntry_Call {
+	return &MockClient_UpdateTimeEntry_Call{Call: _e.mock.On("UpdateTimeEntry", _a0, _a1)}
 }
 
-func (_c *MockClient_UpdateProjectMemberships_Call) Run(run func(_a0 api.UpdateProjectMembershipsParam)) *MockClient_UpdateProjectMemberships_Call {
+func (_c *MockClient_UpdateTimeEntry_Call) Run(run func(_a0 context.Context, _a1 api.UpdateTimeEntryParam)) *MockClient_UpdateTimeEntry_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.UpdateProjectMembershipsParam))
+		run(args[0].(context.Context), args[1].(api.UpdateTimeEntryParam))
 	})
 	return _c
 }
 
-func (_c *MockClient_UpdateProjectMemberships_Call) Return(_a0 dto.Project, _a1 error) *MockClient_UpdateProjectMemberships_Call {
+func (_c *MockClient_UpdateTimeEntry_Call) Return(_a0 dto.TimeEntryImpl, _a1 error) *MockClient_UpdateTimeEntry_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// UpdateProjectTemplate provides a mock function with given fields: _a0
-func (_m *MockClient) UpdateProjectTemplate(_a0 api.UpdateProjectTemplateParam) (dto.Project, error) {
-	ret := _m.Called(_a0)
+// UpdateUserBillableRate provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) UpdateUserBillableRate(_a0 context.Context, _a1 api.UpdateUserRateParam) (dto.Workspace, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 dto.Project
-	if rf, ok := ret.Get(0).(func(api.UpdateProjectTemplateParam) dto.Project); ok {
-		r0 = rf(_a0)
+	var r0 dto.Workspace
+	if rf, ok := ret.Get(0).(func(context.Context, api.UpdateUserRateParam) dto.Workspace); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		r0 = ret.Get(0).(dto.Project)
+		r0 = ret.Get(0).(dto.Workspace)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(api.UpdateProjectTemplateParam) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, api.UpdateUserRateParam) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1493,43 +3310,44 @@ func (_m *MockClient) UpdateProjectTemplate(_a0 api.UpdateProjectTemplateParam)
 	return r0, r1
 }
 
-// MockClient_UpdateProjectTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProjectTemplate'
-type MockClient_UpdateProjectTemplate_Call struct {
+// MockClient_UpdateUserBillableRate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserBillableRate'
+type MockClient_UpdateUserBillableRate_Call struct {
 	*mock.Call
 }
 
-// UpdateProjectTemplate is a helper method to define mock.On call
-//   - _a0 api.UpdateProjectTemplateParam
-func (_e *MockClient_Expecter) UpdateProjectTemplate(_a0 interface{}) *MockClient_UpdateProjectTemplate_Call {
-	return &MockClient_UpdateProjectTemplate_Call{Call: _e.mock.On("UpdateProjectTemplate", _a0)}
+// UpdateUserBillableRate is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.UpdateUserRateParam
+func (_e *MockClient_Expecter) UpdateUserBillableRate(_a0 interface{}, _a1 interface{}) *MockClient_UpdateUserBillableRate_Call {
+	return &MockClient_UpdateUserBillableRate_Call{Call: _e.mock.On("UpdateUserBillableRate", _a0, _a1)}
 }
 
-func (_c *MockClient_UpdateProjectTemplate_Call) Run(run func(_a0 api.UpdateProjectTemplateParam)) *MockClient_UpdateProjectTemplate_Call {
+func (_c *MockClient_UpdateUserBillableRate_Call) Run(run func(_a0 context.Context, _a1 api.UpdateUserRateParam)) *MockClient_UpdateUserBillableRate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.UpdateProjectTemplateParam))
+		run(args[0].(context.Context), args[1].(api.UpdateUserRateParam))
 	})
 	return _c
 }
 
-func (_c *MockClient_UpdateProjectTemplate_Call) Return(_a0 dto.Project, _a1 error) *MockClient_UpdateProjectTemplate_Call {
+func (_c *MockClient_UpdateUserBillableRate_Call) Return(_a0 dto.Workspace, _a1 error) *MockClient_UpdateUserBillableRate_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// UpdateProjectUserBillableRate provides a mock function with given fields: _a0
-func (_m *MockClient) UpdateProjectUserBillableRate(_a0 api.UpdateProjectUserRateParam) (dto.Project, error) {
-	ret := _m.Called(_a0)
+// UpdateUserCostRate provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) UpdateUserCostRate(_a0 context.Context, _a1 api.UpdateUserRateParam) (dto.Workspace, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 dto.Project
-	if rf, ok := ret.Get(0).(func(api.UpdateProjectUserRateParam) dto.Project); ok {
-		r0 = rf(_a0)
+	var r0 dto.Workspace
+	if rf, ok := ret.Get(0).(func(context.Context, api.UpdateUserRateParam) dto.Workspace); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		r0 = ret.Get(0).(dto.Project)
+		r0 = ret.Get(0).(dto.Workspace)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(api.UpdateProjectUserRateParam) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, api.UpdateUserRateParam) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1537,43 +3355,44 @@ func (_m *MockClient) UpdateProjectUserBillableRate(_a0 api.UpdateProjectUserRat
 	return r0, r1
 }
 
-// MockClient_UpdateProjectUserBillableRate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProjectUserBillableRate'
-type MockClient_UpdateProjectUserBillableRate_Call struct {
+// MockClient_UpdateUserCostRate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserCostRate'
+type MockClient_UpdateUserCostRate_Call struct {
 	*mock.Call
 }
 
-// UpdateProjectUserBillableRate is a helper method to define mock.On call
-//   - _a0 api.UpdateProjectUserRateParam
-func (_e *MockClient_Expecter) UpdateProjectUserBillableRate(_a0 interface{}) *MockClient_UpdateProjectUserBillableRate_Call {
-	return &MockClient_UpdateProjectUserBillableRate_Call{Call: _e.mock.On("UpdateProjectUserBillableRate", _a0)}
+// UpdateUserCostRate is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.UpdateUserRateParam
+func (_e *MockClient_Expecter) UpdateUserCostRate(_a0 interface{}, _a1 interface{}) *MockClient_UpdateUserCostRate_Call {
+	return &MockClient_UpdateUserCostRate_Call{Call: _e.mock.On("UpdateUserCostRate", _a0, _a1)}
 }
 
-func (_c *MockClient_UpdateProjectUserBillableRate_Call) Run(run func(_a0 api.UpdateProjectUserRateParam)) *MockClient_UpdateProjectUserBillableRate_Call {
+func (_c *MockClient_UpdateUserCostRate_Call) Run(run func(_a0 context.Context, _a1 api.UpdateUserRateParam)) *MockClient_UpdateUserCostRate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.UpdateProjectUserRateParam))
+		run(args[0].(context.Context), args[1].(api.UpdateUserRateParam))
 	})
 	return _c
 }
 
-func (_c *MockClient_UpdateProjectUserBillableRate_Call) Return(_a0 dto.Project, _a1 error) *MockClient_UpdateProjectUserBillableRate_Call {
+func (_c *MockClient_UpdateUserCostRate_Call) Return(_a0 dto.Workspace, _a1 error) *MockClient_UpdateUserCostRate_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// UpdateProjectUserCostRate provides a mock function with given fields: _a0
-func (_m *MockClient) UpdateProjectUserCostRate(_a0 api.UpdateProjectUserRateParam) (dto.Project, error) {
-	ret := _m.Called(_a0)
+// UpdateWorkspaceBillableRate provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) UpdateWorkspaceBillableRate(_a0 context.Context, _a1 api.UpdateWorkspaceRateParam) (dto.Workspace, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 dto.Project
-	if rf, ok := ret.Get(0).(func(api.UpdateProjectUserRateParam) dto.Project); ok {
-		r0 = rf(_a0)
+	var r0 dto.Workspace
+	if rf, ok := ret.Get(0).(func(context.Context, api.UpdateWorkspaceRateParam) dto.Workspace); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		r0 = ret.Get(0).(dto.Project)
+		r0 = ret.Get(0).(dto.Workspace)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(api.UpdateProjectUserRateParam) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, api.UpdateWorkspaceRateParam) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1581,43 +3400,44 @@ func (_m *MockClient) UpdateProjectUserCostRate(_a0 api.UpdateProjectUserRatePar
 	return r0, r1
 }
 
-// MockClient_UpdateProjectUserCostRate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProjectUserCostRate'
-type MockClient_UpdateProjectUserCostRate_Call struct {
+// MockClient_UpdateWorkspaceBillableRate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWorkspaceBillableRate'
+type MockClient_UpdateWorkspaceBillableRate_Call struct {
 	*mock.Call
 }
 
-// UpdateProjectUserCostRate is a helper method to define mock.On call
-//   - _a0 api.UpdateProjectUserRateParam
-func (_e *MockClient_Expecter) UpdateProjectUserCostRate(_a0 interface{}) *MockClient_UpdateProjectUserCostRate_Call {
-	return &MockClient_UpdateProjectUserCostRate_Call{Call: _e.mock.On("UpdateProjectUserCostRate", _a0)}
+// UpdateWorkspaceBillableRate is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.UpdateWorkspaceRateParam
+func (_e *MockClient_Expecter) UpdateWorkspaceBillableRate(_a0 interface{}, _a1 interface{}) *MockClient_UpdateWorkspaceBillableRate_Call {
+	return &MockClient_UpdateWorkspaceBillableRate_Call{Call: _e.mock.On("UpdateWorkspaceBillableRate", _a0, _a1)}
 }
 
-func (_c *MockClient_UpdateProjectUserCostRate_Call) Run(run func(_a0 api.UpdateProjectUserRateParam)) *MockClient_UpdateProjectUserCostRate_Call {
+func (_c *MockClient_UpdateWorkspaceBillableRate_Call) Run(run func(_a0 context.Context, _a1 api.UpdateWorkspaceRateParam)) *MockClient_UpdateWorkspaceBillableRate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.UpdateProjectUserRateParam))
+		run(args[0].(context.Context), args[1].(api.UpdateWorkspaceRateParam))
 	})
 	return _c
 }
 
-func (_c *MockClient_UpdateProjectUserCostRate_Call) Return(_a0 dto.Project, _a1 error) *MockClient_UpdateProjectUserCostRate_Call {
+func (_c *MockClient_UpdateWorkspaceBillableRate_Call) Return(_a0 dto.Workspace, _a1 error) *MockClient_UpdateWorkspaceBillableRate_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// UpdateTask provides a mock function with given fields: _a0
-func (_m *MockClient) UpdateTask(_a0 api.UpdateTaskParam) (dto.Task, error) {
-	ret := _m.Called(_a0)
+// UpdateWorkspaceCostRate provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) UpdateWorkspaceCostRate(_a0 context.Context, _a1 api.UpdateWorkspaceRateParam) (dto.Workspace, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 dto.Task
-	if rf, ok := ret.Get(0).(func(api.UpdateTaskParam) dto.Task); ok {
-		r0 = rf(_a0)
+	var r0 dto.Workspace
+	if rf, ok := ret.Get(0).(func(context.Context, api.UpdateWorkspaceRateParam) dto.Workspace); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		r0 = ret.Get(0).(dto.Task)
+		r0 = ret.Get(0).(dto.Workspace)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(api.UpdateTaskParam) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, api.UpdateWorkspaceRateParam) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1625,43 +3445,44 @@ func (_m *MockClient) UpdateTask(_a0 api.UpdateTaskParam) (dto.Task, error) {
 	return r0, r1
 }
 
-// MockClient_UpdateTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTask'
-type MockClient_UpdateTask_Call struct {
+// MockClient_UpdateWorkspaceCostRate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWorkspaceCostRate'
+type MockClient_UpdateWorkspaceCostRate_Call struct {
 	*mock.Call
 }
 
-// UpdateTask is a helper method to define mock.On call
-//   - _a0 api.UpdateTaskParam
-func (_e *MockClient_Expecter) UpdateTask(_a0 interface{}) *MockClient_UpdateTask_Call {
-	return &MockClient_UpdateTask_Call{Call: _e.mock.On("UpdateTask", _a0)}
+// UpdateWorkspaceCostRate is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.UpdateWorkspaceRateParam
+func (_e *MockClient_Expecter) UpdateWorkspaceCostRate(_a0 interface{}, _a1 interface{}) *MockClient_UpdateWorkspaceCostRate_Call {
+	return &MockClient_UpdateWorkspaceCostRate_Call{Call: _e.mock.On("UpdateWorkspaceCostRate", _a0, _a1)}
 }
 
-func (_c *MockClient_UpdateTask_Call) Run(run func(_a0 api.UpdateTaskParam)) *MockClient_UpdateTask_Call {
+func (_c *MockClient_UpdateWorkspaceCostRate_Call) Run(run func(_a0 context.Context, _a1 api.UpdateWorkspaceRateParam)) *MockClient_UpdateWorkspaceCostRate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.UpdateTaskParam))
+		run(args[0].(context.Context), args[1].(api.UpdateWorkspaceRateParam))
 	})
 	return _c
 }
 
-func (_c *MockClient_UpdateTask_Call) Return(_a0 dto.Task, _a1 error) *MockClient_UpdateTask_Call {
+func (_c *MockClient_UpdateWorkspaceCostRate_Call) Return(_a0 dto.Workspace, _a1 error) *MockClient_UpdateWorkspaceCostRate_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// UpdateTimeEntry provides a mock function with given fields: _a0
-func (_m *MockClient) UpdateTimeEntry(_a0 api.UpdateTimeEntryParam) (dto.TimeEntryImpl, error) {
-	ret := _m.Called(_a0)
+// UpdateWorkspaceSettings provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) UpdateWorkspaceSettings(_a0 context.Context, _a1 api.UpdateWorkspaceSettingsParam) (dto.WorkspaceSettings, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 dto.TimeEntryImpl
-	if rf, ok := ret.Get(0).(func(api.UpdateTimeEntryParam) dto.TimeEntryImpl); ok {
-		r0 = rf(_a0)
+	var r0 dto.WorkspaceSettings
+	if rf, ok := ret.Get(0).(func(context.Context, api.UpdateWorkspaceSettingsParam) dto.WorkspaceSettings); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		r0 = ret.Get(0).(dto.TimeEntryImpl)
+		r0 = ret.Get(0).(dto.WorkspaceSettings)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(api.UpdateTimeEntryParam) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, api.UpdateWorkspaceSettingsParam) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1669,36 +3490,37 @@ func (_m *MockClient) UpdateTimeEntry(_a0 api.UpdateTimeEntryParam) (dto.TimeEnt
 	return r0, r1
 }
 
-// MockClient_UpdateTimeEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTimeEntry'
-type MockClient_UpdateTimeEntry_Call struct {
+// MockClient_UpdateWorkspaceSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWorkspaceSettings'
+type MockClient_UpdateWorkspaceSettings_Call struct {
 	*mock.Call
 }
 
-// UpdateTimeEntry is a helper method to define mock.On call
-//   - _a0 api.UpdateTimeEntryParam
-func (_e *MockClient_Expecter) UpdateTimeEntry(_a0 interface{}) *MockClient_UpdateTimeEntry_Call {
-	return &MockClient_UpdateTimeEntry_Call{Call: _e.mock.On("UpdateTimeEntry", _a0)}
+// UpdateWorkspaceSettings is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 api.UpdateWorkspaceSettingsParam
+func (_e *MockClient_Expecter) UpdateWorkspaceSettings(_a0 interface{}, _a1 interface{}) *MockClient_UpdateWorkspaceSettings_Call {
+	return &MockClient_UpdateWorkspaceSettings_Call{Call: _e.mock.On("UpdateWorkspaceSettings", _a0, _a1)}
 }
 
-func (_c *MockClient_UpdateTimeEntry_Call) Run(run func(_a0 api.UpdateTimeEntryParam)) *MockClient_UpdateTimeEntry_Call {
+func (_c *MockClient_UpdateWorkspaceSettings_Call) Run(run func(_a0 context.Context, _a1 api.UpdateWorkspaceSettingsParam)) *MockClient_UpdateWorkspaceSettings_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.UpdateTimeEntryParam))
+		run(args[0].(context.Context), args[1].(api.UpdateWorkspaceSettingsParam))
 	})
 	return _c
 }
 
-func (_c *MockClient_UpdateTimeEntry_Call) Return(_a0 dto.TimeEntryImpl, _a1 error) *MockClient_UpdateTimeEntry_Call {
+func (_c *MockClient_UpdateWorkspaceSettings_Call) Return(_a0 dto.WorkspaceSettings, _a1 error) *MockClient_UpdateWorkspaceSettings_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// WorkspaceUsers provides a mock function with given fields: _a0
-func (_m *MockClient) WorkspaceUsers(_a0 api.WorkspaceUsersParam) ([]dto.User, error) {
-	ret := _m.Called(_a0)
+// WorkspaceUsers provides a mock function with given fields: _a0, _a1
+func (_m *MockClient) WorkspaceUsers(_a0 context.Context, _a1 api.WorkspaceUsersParam) ([]dto.User, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 []dto.User
-	if rf, ok := ret.Get(0).(func(api.WorkspaceUsersParam) []dto.User); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, api.WorkspaceUsersParam) []dto.User); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]dto.User)
@@ -1706,8 +3528,8 @@ func (_m *MockClient) WorkspaceUsers(_a0 api.WorkspaceUsersParam) ([]dto.User, e
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(api.WorkspaceUsersParam) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, api.WorkspaceUsersParam) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1721,14 +3543,15 @@ type MockClient_WorkspaceUsers_Call struct {
 }
 
 // WorkspaceUsers is a helper method to define mock.On call
-//   - _a0 api.WorkspaceUsersParam
-func (_e *MockClient_Expecter) WorkspaceUsers(_a0 interface{}) *MockClient_WorkspaceUsers_Call {
-	return &MockClient_WorkspaceUsers_Call{Call: _e.mock.On("WorkspaceUsers", _a0)}
+//   - _a0 context.Context
+//   - _a1 api.WorkspaceUsersParam
+func (_e *MockClient_Expecter) WorkspaceUsers(_a0 interface{}, _a1 interface{}) *MockClient_WorkspaceUsers_Call {
+	return &MockClient_WorkspaceUsers_Call{Call: _e.mock.On("WorkspaceUsers", _a0, _a1)}
 }
 
-func (_c *MockClient_WorkspaceUsers_Call) Run(run func(_a0 api.WorkspaceUsersParam)) *MockClient_WorkspaceUsers_Call {
+func (_c *MockClient_WorkspaceUsers_Call) Run(run func(_a0 context.Context, _a1 api.WorkspaceUsersParam)) *MockClient_WorkspaceUsers_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(api.WorkspaceUsersParam))
+		run(args[0].(context.Context), args[1].(api.WorkspaceUsersParam))
 	})
 	return _c
 }
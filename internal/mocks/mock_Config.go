@@ -498,6 +498,35 @@ func (_c *MockConfig_Save_Call) Return(_a0 error) *MockConfig_Save_Call {
 	return _c
 }
 
+// Set provides a mock function with given fields: _a0, _a1
+func (_m *MockConfig) Set(_a0 string, _a1 interface{}) {
+	_m.Called(_a0, _a1)
+}
+
+// MockConfig_Set_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Set'
+type MockConfig_Set_Call struct {
+	*mock.Call
+}
+
+// Set is a helper method to define mock.On call
+//   - _a0 string
+//   - _a1 interface{}
+func (_e *MockConfig_Expecter) Set(_a0 interface{}, _a1 interface{}) *MockConfig_Set_Call {
+	return &MockConfig_Set_Call{Call: _e.mock.On("Set", _a0, _a1)}
+}
+
+func (_c *MockConfig_Set_Call) Run(run func(_a0 string, _a1 interface{})) *MockConfig_Set_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(interface{}))
+	})
+	return _c
+}
+
+func (_c *MockConfig_Set_Call) Return() *MockConfig_Set_Call {
+	_c.Call.Return()
+	return _c
+}
+
 // SetBool provides a mock function with given fields: _a0, _a1
 func (_m *MockConfig) SetBool(_a0 string, _a1 bool) {
 	_m.Called(_a0, _a1)
@@ -8,6 +8,8 @@ import (
 
 	dto "github.com/lucassabreu/clockify-cli/api/dto"
 
+	reports "github.com/lucassabreu/clockify-cli/api/reports"
+
 	mock "github.com/stretchr/testify/mock"
 
 	ui "github.com/lucassabreu/clockify-cli/pkg/ui"
@@ -238,6 +240,51 @@ func (_c *MockFactory_GetWorkspaceID_Call) Return(_a0 string, _a1 error) *MockFa
 	return _c
 }
 
+// ReportsClient provides a mock function with given fields:
+func (_m *MockFactory) ReportsClient() (reports.Client, error) {
+	ret := _m.Called()
+
+	var r0 reports.Client
+	if rf, ok := ret.Get(0).(func() reports.Client); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(reports.Client)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFactory_ReportsClient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportsClient'
+type MockFactory_ReportsClient_Call struct {
+	*mock.Call
+}
+
+// ReportsClient is a helper method to define mock.On call
+func (_e *MockFactory_Expecter) ReportsClient() *MockFactory_ReportsClient_Call {
+	return &MockFactory_ReportsClient_Call{Call: _e.mock.On("ReportsClient")}
+}
+
+func (_c *MockFactory_ReportsClient_Call) Run(run func()) *MockFactory_ReportsClient_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockFactory_ReportsClient_Call) Return(_a0 reports.Client, _a1 error) *MockFactory_ReportsClient_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // UI provides a mock function with given fields:
 func (_m *MockFactory) UI() ui.UI {
 	ret := _m.Called()
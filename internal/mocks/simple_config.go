@@ -120,6 +120,10 @@ func (*SimpleConfig) Get(_ string) interface{} {
 	panic("should not call")
 }
 
+func (*SimpleConfig) Set(_ string, _ interface{}) {
+	panic("should not call")
+}
+
 func (*SimpleConfig) All() map[string]interface{} {
 	panic("should not call")
 }
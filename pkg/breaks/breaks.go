@@ -0,0 +1,79 @@
+// Package breaks keeps on disk the break being taken by the user, so it can
+// be ended by another execution of the CLI
+package breaks
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Break is a break started by "break start"
+type Break struct {
+	Start     time.Time `json:"start"`
+	Workspace string    `json:"workspace"`
+	UserID    string    `json:"userId"`
+	// PausedID is the time entry stopped for the break, to be resumed
+	// after it
+	PausedID string `json:"pausedId,omitempty"`
+	// TimeEntryID is the time entry started for the break, when a project
+	// for breaks is set
+	TimeEntryID string `json:"timeEntryId,omitempty"`
+}
+
+// Store keeps the current break on a file
+type Store struct {
+	path string
+}
+
+// NewStore creates a Store on the file
+func NewStore(path string) *Store {
+	return &Store{path: path}
+}
+
+// Current returns the break being taken, nil if there is none
+func (s *Store) Current() (*Break, error) {
+	b, err := os.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var br Break
+	if err := json.Unmarshal(b, &br); err != nil {
+		return nil, errors.Wrapf(err, "break %s is not valid", s.path)
+	}
+
+	return &br, nil
+}
+
+// Start keeps the break as the one being taken
+func (s *Store) Start(br Break) error {
+	br.Start = br.Start.UTC()
+	b, err := json.Marshal(br)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0700); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(os.WriteFile(s.path, b, 0600))
+}
+
+// End removes the break being taken
+func (s *Store) End() error {
+	err := os.Remove(s.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	return errors.WithStack(err)
+}
@@ -0,0 +1,53 @@
+package breaks_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/pkg/breaks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache", "break.json")
+	s := breaks.NewStore(path)
+
+	br, err := s.Current()
+	assert.NoError(t, err)
+	assert.Nil(t, br)
+
+	start := time.Date(2022, 6, 1, 12, 0, 0, 0, time.FixedZone("", -3*3600))
+	assert.NoError(t, s.Start(breaks.Break{
+		Start:     start,
+		Workspace: "w",
+		UserID:    "u",
+		PausedID:  "te1",
+	}))
+
+	info, err := os.Stat(path)
+	if assert.NoError(t, err) {
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	}
+
+	br, err = s.Current()
+	assert.NoError(t, err)
+	assert.Equal(t, &breaks.Break{
+		Start:     start.UTC(),
+		Workspace: "w",
+		UserID:    "u",
+		PausedID:  "te1",
+	}, br)
+
+	assert.NoError(t, s.End())
+	br, err = s.Current()
+	assert.NoError(t, err)
+	assert.Nil(t, br)
+
+	assert.NoError(t, s.End())
+
+	assert.NoError(t, os.WriteFile(path, []byte("{"), 0600))
+	_, err = s.Current()
+	assert.Error(t, err)
+}
@@ -0,0 +1,181 @@
+package cache
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/pkg/errors"
+)
+
+// Cache keeps the projects, clients, tags and tasks of the workspaces on
+// disk, so looking up them by name and completing them on the shell does
+// not need to fetch them every time
+type Cache struct {
+	dir string
+	ttl time.Duration
+	now func() time.Time
+}
+
+// New creates a Cache on the directory, where the entries are valid until
+// the ttl expires
+func New(dir string, ttl time.Duration) *Cache {
+	return &Cache{dir: dir, ttl: ttl, now: time.Now}
+}
+
+// DefaultDir returns the directory used to cache data of the CLI, inside the
+// cache directory of the user (like: ~/.cache/clockify-cli)
+func DefaultDir() (string, error) {
+	d, err := os.UserCacheDir()
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return filepath.Join(d, "clockify-cli"), nil
+}
+
+// Dir is where the entries are stored
+func (c *Cache) Dir() string {
+	return c.dir
+}
+
+// TTL is how long the entries are valid
+func (c *Cache) TTL() time.Duration {
+	return c.ttl
+}
+
+type entry struct {
+	SavedAt time.Time       `json:"savedAt"`
+	Items   json.RawMessage `json:"items"`
+}
+
+func (c *Cache) workspaceDir(workspace string) string {
+	return filepath.Join(c.dir, "workspaces", workspace)
+}
+
+func (c *Cache) path(workspace string, name ...string) string {
+	return filepath.Join(
+		append([]string{c.workspaceDir(workspace)}, name...)...) + ".json"
+}
+
+// load reads the entry into v, returning false when it does not exist or
+// is expired
+func (c *Cache) load(path string, v interface{}) bool {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return false
+	}
+
+	var e entry
+	if err := json.Unmarshal(b, &e); err != nil {
+		return false
+	}
+
+	if c.now().Sub(e.SavedAt) > c.ttl {
+		return false
+	}
+
+	return json.Unmarshal(e.Items, v) == nil
+}
+
+func (c *Cache) save(path string, v interface{}) error {
+	items, err := json.Marshal(v)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	b, err := json.Marshal(entry{SavedAt: c.now(), Items: items})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(os.WriteFile(path, b, 0600))
+}
+
+func (c *Cache) workspacesPath() string {
+	return filepath.Join(c.dir, "workspaces.json")
+}
+
+// Workspaces returns the workspaces of the user, if cached
+func (c *Cache) Workspaces() ([]dto.Workspace, bool) {
+	var ws []dto.Workspace
+	return ws, c.load(c.workspacesPath(), &ws)
+}
+
+// SetWorkspaces stores the workspaces of the user
+func (c *Cache) SetWorkspaces(ws []dto.Workspace) error {
+	return c.save(c.workspacesPath(), ws)
+}
+
+// Projects returns all the projects of the workspace, if cached
+func (c *Cache) Projects(workspace string) ([]dto.Project, bool) {
+	var ps []dto.Project
+	return ps, c.load(c.path(workspace, "projects"), &ps)
+}
+
+// SetProjects stores all the projects of the workspace
+func (c *Cache) SetProjects(workspace string, ps []dto.Project) error {
+	return c.save(c.path(workspace, "projects"), ps)
+}
+
+// Clients returns all the clients of the workspace, if cached
+func (c *Cache) Clients(workspace string) ([]dto.Client, bool) {
+	var cs []dto.Client
+	return cs, c.load(c.path(workspace, "clients"), &cs)
+}
+
+// SetClients stores all the clients of the workspace
+func (c *Cache) SetClients(workspace string, cs []dto.Client) error {
+	return c.save(c.path(workspace, "clients"), cs)
+}
+
+// Tags returns all the tags of the workspace, if cached
+func (c *Cache) Tags(workspace string) ([]dto.Tag, bool) {
+	var ts []dto.Tag
+	return ts, c.load(c.path(workspace, "tags"), &ts)
+}
+
+// SetTags stores all the tags of the workspace
+func (c *Cache) SetTags(workspace string, ts []dto.Tag) error {
+	return c.save(c.path(workspace, "tags"), ts)
+}
+
+// Tasks returns all the tasks of the project, if cached
+func (c *Cache) Tasks(workspace, project string) ([]dto.Task, bool) {
+	var ts []dto.Task
+	return ts, c.load(c.path(workspace, "tasks", project), &ts)
+}
+
+// SetTasks stores all the tasks of the project
+func (c *Cache) SetTasks(workspace, project string, ts []dto.Task) error {
+	return c.save(c.path(workspace, "tasks", project), ts)
+}
+
+// Invalidate removes one of the lists of the workspace (projects, clients
+// or tags), so it will be fetched again
+func (c *Cache) Invalidate(workspace, name string) error {
+	return errors.WithStack(os.RemoveAll(c.path(workspace, name)))
+}
+
+// InvalidateTasks removes the tasks of the project, so they will be fetched
+// again
+func (c *Cache) InvalidateTasks(workspace, project string) error {
+	return errors.WithStack(
+		os.RemoveAll(c.path(workspace, "tasks", project)))
+}
+
+// ClearWorkspace removes everything cached about the workspace
+func (c *Cache) ClearWorkspace(workspace string) error {
+	return errors.WithStack(os.RemoveAll(c.workspaceDir(workspace)))
+}
+
+// Clear removes everything on the cache directory
+func (c *Cache) Clear() error {
+	return errors.WithStack(os.RemoveAll(c.dir))
+}
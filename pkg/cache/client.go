@@ -0,0 +1,280 @@
+package cache
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+type client struct {
+	api.Client
+	cache *Cache
+}
+
+// NewClient decorates the api.Client to use the Cache when the workspaces or
+// all the projects, clients, tags or tasks of a project are listed
+// (filtering by archived or active is done locally). Changes done through it
+// remove the lists affected from the Cache
+func NewClient(c api.Client, ch *Cache) api.Client {
+	return &client{Client: c, cache: ch}
+}
+
+func (c *client) SetDebugLogger(logger api.Logger) api.Client {
+	c.Client.SetDebugLogger(logger)
+	return c
+}
+
+func (c *client) SetInfoLogger(logger api.Logger) api.Client {
+	c.Client.SetInfoLogger(logger)
+	return c
+}
+
+func (c *client) SetMaxRetries(n int) api.Client {
+	c.Client.SetMaxRetries(n)
+	return c
+}
+
+func (c *client) SetRetryWait(d time.Duration) api.Client {
+	c.Client.SetRetryWait(d)
+	return c
+}
+
+func (c *client) SetTimeout(d time.Duration) api.Client {
+	c.Client.SetTimeout(d)
+	return c
+}
+
+func (c *client) SetCacheDir(dir string) api.Client {
+	c.Client.SetCacheDir(dir)
+	return c
+}
+
+func (c *client) SetHTTPTrace(out io.Writer) api.Client {
+	c.Client.SetHTTPTrace(out)
+	return c
+}
+
+func (c *client) SetTransport(t http.RoundTripper) api.Client {
+	c.Client.SetTransport(t)
+	return c
+}
+
+// GetWorkspaces uses the cache when no name is set
+func (c *client) GetWorkspaces(
+	ctx context.Context, p api.GetWorkspaces) ([]dto.Workspace, error) {
+	if p.Name != "" {
+		return c.Client.GetWorkspaces(ctx, p)
+	}
+
+	ws, ok := c.cache.Workspaces()
+	if ok {
+		return ws, nil
+	}
+
+	ws, err := c.Client.GetWorkspaces(ctx, p)
+	if err != nil {
+		return ws, err
+	}
+
+	_ = c.cache.SetWorkspaces(ws)
+	return ws, nil
+}
+
+// GetProjects uses the cache when no filter other than archived is set
+func (c *client) GetProjects(
+	ctx context.Context, p api.GetProjectsParam) ([]dto.Project, error) {
+	if p.Name != "" || len(p.Clients) > 0 || p.Hydrate || !p.AllPages ||
+		p.Limit > 0 {
+		return c.Client.GetProjects(ctx, p)
+	}
+
+	ps, ok := c.cache.Projects(p.Workspace)
+	if !ok {
+		var err error
+		if ps, err = c.Client.GetProjects(ctx, api.GetProjectsParam{
+			Workspace:       p.Workspace,
+			PaginationParam: api.AllPages(),
+		}); err != nil {
+			return ps, err
+		}
+
+		// the list is still valid, even if it could not be cached
+		_ = c.cache.SetProjects(p.Workspace, ps)
+	}
+
+	if p.Archived == nil {
+		return ps, nil
+	}
+
+	l := make([]dto.Project, 0, len(ps))
+	for i := range ps {
+		if ps[i].Archived == *p.Archived {
+			l = append(l, ps[i])
+		}
+	}
+
+	return l, nil
+}
+
+// GetClients uses the cache when no filter other than archived is set
+func (c *client) GetClients(
+	ctx context.Context, p api.GetClientsParam) ([]dto.Client, error) {
+	if p.Name != "" || !p.AllPages || p.Limit > 0 {
+		return c.Client.GetClients(ctx, p)
+	}
+
+	cs, ok := c.cache.Clients(p.Workspace)
+	if !ok {
+		var err error
+		if cs, err = c.Client.GetClients(ctx, api.GetClientsParam{
+			Workspace:       p.Workspace,
+			PaginationParam: api.AllPages(),
+		}); err != nil {
+			return cs, err
+		}
+
+		_ = c.cache.SetClients(p.Workspace, cs)
+	}
+
+	if p.Archived == nil {
+		return cs, nil
+	}
+
+	l := make([]dto.Client, 0, len(cs))
+	for i := range cs {
+		if cs[i].Archived == *p.Archived {
+			l = append(l, cs[i])
+		}
+	}
+
+	return l, nil
+}
+
+// GetTags uses the cache when no filter other than archived is set
+func (c *client) GetTags(
+	ctx context.Context, p api.GetTagsParam) ([]dto.Tag, error) {
+	if p.Name != "" || !p.AllPages || p.Limit > 0 {
+		return c.Client.GetTags(ctx, p)
+	}
+
+	ts, ok := c.cache.Tags(p.Workspace)
+	if !ok {
+		var err error
+		if ts, err = c.Client.GetTags(ctx, api.GetTagsParam{
+			Workspace:       p.Workspace,
+			PaginationParam: api.AllPages(),
+		}); err != nil {
+			return ts, err
+		}
+
+		_ = c.cache.SetTags(p.Workspace, ts)
+	}
+
+	if p.Archived == nil {
+		return ts, nil
+	}
+
+	l := make([]dto.Tag, 0, len(ts))
+	for i := range ts {
+		if ts[i].Archived == *p.Archived {
+			l = append(l, ts[i])
+		}
+	}
+
+	return l, nil
+}
+
+// GetTasks uses the cache when no filter other than active is set
+func (c *client) GetTasks(
+	ctx context.Context, p api.GetTasksParam) ([]dto.Task, error) {
+	if p.Name != "" || !p.AllPages || p.Limit > 0 {
+		return c.Client.GetTasks(ctx, p)
+	}
+
+	ts, ok := c.cache.Tasks(p.Workspace, p.ProjectID)
+	if !ok {
+		var err error
+		if ts, err = c.Client.GetTasks(ctx, api.GetTasksParam{
+			Workspace:       p.Workspace,
+			ProjectID:       p.ProjectID,
+			PaginationParam: api.AllPages(),
+		}); err != nil {
+			return ts, err
+		}
+
+		_ = c.cache.SetTasks(p.Workspace, p.ProjectID, ts)
+	}
+
+	if !p.Active {
+		return ts, nil
+	}
+
+	l := make([]dto.Task, 0, len(ts))
+	for i := range ts {
+		if ts[i].Status == dto.TaskStatusActive {
+			l = append(l, ts[i])
+		}
+	}
+
+	return l, nil
+}
+
+func (c *client) AddProject(
+	ctx context.Context, p api.AddProjectParam) (dto.Project, error) {
+	defer c.invalidate(p.Workspace, "projects")
+	return c.Client.AddProject(ctx, p)
+}
+
+func (c *client) UpdateProject(
+	ctx context.Context, p api.UpdateProjectParam) (dto.Project, error) {
+	defer c.invalidate(p.Workspace, "projects")
+	return c.Client.UpdateProject(ctx, p)
+}
+
+func (c *client) DeleteProject(
+	ctx context.Context, p api.DeleteProjectParam) (dto.Project, error) {
+	defer c.invalidate(p.Workspace, "projects")
+	return c.Client.DeleteProject(ctx, p)
+}
+
+func (c *client) AddClient(
+	ctx context.Context, p api.AddClientParam) (dto.Client, error) {
+	defer c.invalidate(p.Workspace, "clients")
+	return c.Client.AddClient(ctx, p)
+}
+
+func (c *client) AddTag(
+	ctx context.Context, p api.AddTagParam) (dto.Tag, error) {
+	defer c.invalidate(p.Workspace, "tags")
+	return c.Client.AddTag(ctx, p)
+}
+
+func (c *client) AddTask(
+	ctx context.Context, p api.AddTaskParam) (dto.Task, error) {
+	defer c.invalidateTasks(p.Workspace, p.ProjectID)
+	return c.Client.AddTask(ctx, p)
+}
+
+func (c *client) UpdateTask(
+	ctx context.Context, p api.UpdateTaskParam) (dto.Task, error) {
+	defer c.invalidateTasks(p.Workspace, p.ProjectID)
+	return c.Client.UpdateTask(ctx, p)
+}
+
+func (c *client) DeleteTask(
+	ctx context.Context, p api.DeleteTaskParam) (dto.Task, error) {
+	defer c.invalidateTasks(p.Workspace, p.ProjectID)
+	return c.Client.DeleteTask(ctx, p)
+}
+
+func (c *client) invalidate(workspace, name string) {
+	_ = c.cache.Invalidate(workspace, name)
+}
+
+func (c *client) invalidateTasks(workspace, project string) {
+	_ = c.cache.InvalidateTasks(workspace, project)
+}
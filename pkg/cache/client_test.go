@@ -0,0 +1,93 @@
+package cache_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestClientUsesCache(t *testing.T) {
+	ch := cache.New(t.TempDir(), time.Hour)
+
+	tags := []dto.Tag{
+		{ID: "t1", Name: "Meeting"},
+		{ID: "t2", Name: "Old", Archived: true},
+	}
+
+	m := mocks.NewMockClient(t)
+	m.EXPECT().GetTags(mock.Anything, api.GetTagsParam{
+		Workspace:       "w",
+		PaginationParam: api.AllPages(),
+	}).Return(tags, nil).Once()
+
+	c := cache.NewClient(m, ch)
+
+	l, err := c.GetTags(context.Background(), api.GetTagsParam{
+		Workspace:       "w",
+		PaginationParam: api.AllPages(),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, tags, l)
+
+	archived := false
+	l, err = c.GetTags(context.Background(), api.GetTagsParam{
+		Workspace:       "w",
+		Archived:        &archived,
+		PaginationParam: api.AllPages(),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, tags[:1], l, "should filter the cached tags")
+
+	m.EXPECT().GetTags(mock.Anything, api.GetTagsParam{
+		Workspace:       "w",
+		Name:            "meet",
+		PaginationParam: api.AllPages(),
+	}).Return(tags[:1], nil).Once()
+
+	l, err = c.GetTags(context.Background(), api.GetTagsParam{
+		Workspace:       "w",
+		Name:            "meet",
+		PaginationParam: api.AllPages(),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, tags[:1], l, "should not use the cache with name")
+}
+
+func TestClientInvalidatesTasks(t *testing.T) {
+	ch := cache.New(t.TempDir(), time.Hour)
+	assert.NoError(t, ch.SetTasks("w", "p", []dto.Task{{ID: "t1"}}))
+
+	m := mocks.NewMockClient(t)
+	m.EXPECT().AddTask(mock.Anything, api.AddTaskParam{
+		Workspace: "w",
+		ProjectID: "p",
+		Name:      "new",
+	}).Return(dto.Task{ID: "t2"}, nil)
+
+	c := cache.NewClient(m, ch)
+	_, err := c.AddTask(context.Background(), api.AddTaskParam{
+		Workspace: "w",
+		ProjectID: "p",
+		Name:      "new",
+	})
+	assert.NoError(t, err)
+
+	_, ok := ch.Tasks("w", "p")
+	assert.False(t, ok, "tasks of the project should be removed")
+}
+
+func TestCacheExpires(t *testing.T) {
+	ch := cache.New(t.TempDir(), 0)
+	assert.NoError(t, ch.SetClients("w", []dto.Client{{ID: "c1"}}))
+
+	time.Sleep(time.Millisecond)
+	_, ok := ch.Clients("w")
+	assert.False(t, ok)
+}
@@ -0,0 +1,86 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"golang.org/x/sync/errgroup"
+)
+
+// RefreshResult is how many of each entity were stored by Refresh
+type RefreshResult struct {
+	Projects int
+	Clients  int
+	Tags     int
+	Tasks    int
+}
+
+// Refresh removes everything cached about the workspace and stores its
+// projects, clients, tags and tasks again, fetching them in parallel
+func (c *Cache) Refresh(ctx context.Context, cl api.Client, workspace string) (
+	r RefreshResult, err error) {
+	if err = c.ClearWorkspace(workspace); err != nil {
+		return
+	}
+
+	var g errgroup.Group
+	g.Go(func() error {
+		ps, err := cl.GetProjects(ctx, api.GetProjectsParam{
+			Workspace:       workspace,
+			Hydrate:         true,
+			PaginationParam: api.AllPages(),
+		})
+		if err != nil {
+			return err
+		}
+
+		for i := range ps {
+			ts := ps[i].Tasks
+			if ts == nil {
+				ts = []dto.Task{}
+			}
+
+			if err = c.SetTasks(workspace, ps[i].ID, ts); err != nil {
+				return err
+			}
+
+			r.Tasks += len(ts)
+			ps[i].Tasks = nil
+			ps[i].CustomFields = nil
+			ps[i].Hydrated = false
+		}
+
+		r.Projects = len(ps)
+		return c.SetProjects(workspace, ps)
+	})
+
+	g.Go(func() error {
+		cs, err := cl.GetClients(ctx, api.GetClientsParam{
+			Workspace:       workspace,
+			PaginationParam: api.AllPages(),
+		})
+		if err != nil {
+			return err
+		}
+
+		r.Clients = len(cs)
+		return c.SetClients(workspace, cs)
+	})
+
+	g.Go(func() error {
+		tags, err := cl.GetTags(ctx, api.GetTagsParam{
+			Workspace:       workspace,
+			PaginationParam: api.AllPages(),
+		})
+		if err != nil {
+			return err
+		}
+
+		r.Tags = len(tags)
+		return c.SetTags(workspace, tags)
+	})
+
+	err = g.Wait()
+	return
+}
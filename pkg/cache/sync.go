@@ -0,0 +1,66 @@
+package cache
+
+import (
+	"context"
+	"os"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
+)
+
+// maxParallelSync is how many workspaces are refreshed at the same time by
+// Sync, each one still fetches its lists in parallel
+const maxParallelSync = 4
+
+// SyncResult is what was stored by Sync for one workspace
+type SyncResult struct {
+	Workspace dto.Workspace
+	RefreshResult
+}
+
+// Sync stores the workspaces of the user and refreshes the cache of the
+// ones informed, or of all of them if none is, in parallel. The results
+// follow the order the workspaces are listed by the API
+func (c *Cache) Sync(ctx context.Context, cl api.Client, workspaces ...string) (
+	[]SyncResult, error) {
+	// so a client decorated by NewClient does not answer from the cache
+	if err := os.RemoveAll(c.workspacesPath()); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	ws, err := cl.GetWorkspaces(ctx, api.GetWorkspaces{})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.SetWorkspaces(ws); err != nil {
+		return nil, err
+	}
+
+	only := make(map[string]bool, len(workspaces))
+	for _, w := range workspaces {
+		only[w] = true
+	}
+
+	rs := make([]SyncResult, 0, len(ws))
+	for i := range ws {
+		if len(only) == 0 || only[ws[i].ID] {
+			rs = append(rs, SyncResult{Workspace: ws[i]})
+		}
+	}
+
+	var g errgroup.Group
+	g.SetLimit(maxParallelSync)
+	for i := range rs {
+		r := &rs[i]
+		g.Go(func() error {
+			var err error
+			r.RefreshResult, err = c.Refresh(ctx, cl, r.Workspace.ID)
+			return err
+		})
+	}
+
+	return rs, g.Wait()
+}
@@ -0,0 +1,100 @@
+package cache_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSync(t *testing.T) {
+	ch := cache.New(t.TempDir(), time.Hour)
+
+	ws := []dto.Workspace{{ID: "w1", Name: "Work"}, {ID: "w2", Name: "Home"}}
+
+	m := mocks.NewMockClient(t)
+	m.EXPECT().GetWorkspaces(mock.Anything,
+		api.GetWorkspaces{}).Return(ws, nil).Once()
+
+	for _, w := range []string{"w1", "w2"} {
+		m.EXPECT().GetProjects(mock.Anything, api.GetProjectsParam{
+			Workspace:       w,
+			Hydrate:         true,
+			PaginationParam: api.AllPages(),
+		}).Return([]dto.Project{{
+			ID:    "p-" + w,
+			Tasks: []dto.Task{{ID: "t1"}, {ID: "t2"}},
+		}}, nil).Once()
+
+		m.EXPECT().GetClients(mock.Anything, api.GetClientsParam{
+			Workspace:       w,
+			PaginationParam: api.AllPages(),
+		}).Return([]dto.Client{{ID: "c1"}}, nil).Once()
+
+		m.EXPECT().GetTags(mock.Anything, api.GetTagsParam{
+			Workspace:       w,
+			PaginationParam: api.AllPages(),
+		}).Return([]dto.Tag{}, nil).Once()
+	}
+
+	rs, err := ch.Sync(context.Background(), m)
+	assert.NoError(t, err)
+	assert.Equal(t, []cache.SyncResult{
+		{
+			Workspace: ws[0],
+			RefreshResult: cache.RefreshResult{
+				Projects: 1, Clients: 1, Tags: 0, Tasks: 2},
+		},
+		{
+			Workspace: ws[1],
+			RefreshResult: cache.RefreshResult{
+				Projects: 1, Clients: 1, Tags: 0, Tasks: 2},
+		},
+	}, rs)
+
+	c := cache.NewClient(m, ch)
+	l, err := c.GetWorkspaces(context.Background(), api.GetWorkspaces{})
+	assert.NoError(t, err)
+	assert.Equal(t, ws, l, "should use the cached workspaces")
+
+	ts, err := c.GetTasks(context.Background(), api.GetTasksParam{
+		Workspace:       "w2",
+		ProjectID:       "p-w2",
+		PaginationParam: api.AllPages(),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, ts, 2, "should use the cached tasks")
+}
+
+func TestSyncOnlyInformedWorkspaces(t *testing.T) {
+	ch := cache.New(t.TempDir(), time.Hour)
+
+	m := mocks.NewMockClient(t)
+	m.EXPECT().GetWorkspaces(mock.Anything, api.GetWorkspaces{}).
+		Return([]dto.Workspace{{ID: "w1"}, {ID: "w2"}}, nil)
+	m.EXPECT().GetProjects(mock.Anything, api.GetProjectsParam{
+		Workspace:       "w2",
+		Hydrate:         true,
+		PaginationParam: api.AllPages(),
+	}).Return([]dto.Project{}, nil)
+	m.EXPECT().GetClients(mock.Anything, api.GetClientsParam{
+		Workspace:       "w2",
+		PaginationParam: api.AllPages(),
+	}).Return([]dto.Client{}, nil)
+	m.EXPECT().GetTags(mock.Anything, api.GetTagsParam{
+		Workspace:       "w2",
+		PaginationParam: api.AllPages(),
+	}).Return([]dto.Tag{}, nil)
+
+	rs, err := ch.Sync(context.Background(), m, "w2")
+	assert.NoError(t, err)
+	assert.Equal(t, []cache.SyncResult{
+		{Workspace: dto.Workspace{ID: "w2"}},
+	}, rs)
+}
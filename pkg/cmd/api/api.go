@@ -0,0 +1,133 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdAPI represents the api command
+func NewCmdAPI(f cmdutil.Factory) *cobra.Command {
+	var body, input string
+
+	cmd := &cobra.Command{
+		Use:   "api <method> <path>",
+		Args:  cmdutil.RequiredNamedArgs("method", "path"),
+		Short: "Sends a request to Clockify's API and prints its response",
+		Long: heredoc.Doc(`
+			Sends a request to Clockify's API, using the token configured,
+			and prints the JSON response as is.
+
+			It is meant to be used for the endpoints not available on the
+			other commands yet.
+
+			The path is relative to the API url (https://api.clockify.me/api/v1)
+			and the placeholders {workspace} and {user} will be replaced by
+			the current workspace and user ids.
+		`),
+		Example: heredoc.Docf(`
+			$ %[1]s GET /workspaces/{workspace}/projects?page=2
+			$ %[1]s POST /workspaces/{workspace}/tags --body '{"name":"cli"}'
+			$ echo '{"name":"cli"}' | %[1]s POST /workspaces/{workspace}/tags --input -
+		`, "clockify-cli api"),
+		ValidArgsFunction: cmdcompl.CombineSuggestionsToArgs(
+			func(*cobra.Command, []string, string) (
+				cmdcompl.ValidArgs, error) {
+				return cmdcompl.ValidArgsSlide{
+					"GET", "POST", "PUT", "PATCH", "DELETE"}, nil
+			},
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmdutil.XorFlagSet(
+				cmd.Flags(), "body", "input"); err != nil {
+				return err
+			}
+
+			var b []byte
+			if body != "" {
+				b = []byte(body)
+			}
+
+			if input != "" {
+				var err error
+				if b, err = readInput(cmd.InOrStdin(), input); err != nil {
+					return err
+				}
+			}
+
+			if len(b) > 0 && !json.Valid(b) {
+				return cmdutil.FlagErrorWrap(
+					errors.New("the body must be a valid JSON"))
+			}
+
+			path, err := replacePlaceholders(f, args[1])
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			r, err := c.Raw(cmd.Context(), api.RawParam{
+				Method: args[0],
+				Path:   path,
+				Body:   b,
+			})
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(r))
+			return err
+		},
+	}
+
+	cmd.Flags().StringVarP(&body, "body", "d", "",
+		"JSON to be sent as the body of the request")
+	cmd.Flags().StringVarP(&input, "input", "i", "",
+		"file with the JSON to be sent as the body of the request "+
+			"(use \"-\" to read from stdin)")
+
+	return cmd
+}
+
+func readInput(stdin io.Reader, input string) ([]byte, error) {
+	if input == "-" {
+		return io.ReadAll(stdin)
+	}
+
+	return os.ReadFile(input)
+}
+
+// replacePlaceholders changes {workspace} and {user} to the ids of the
+// current workspace and user, only looking up them if used
+func replacePlaceholders(f cmdutil.Factory, path string) (string, error) {
+	if strings.Contains(path, "{workspace}") {
+		w, err := f.GetWorkspaceID()
+		if err != nil {
+			return "", err
+		}
+		path = strings.ReplaceAll(path, "{workspace}", w)
+	}
+
+	if strings.Contains(path, "{user}") {
+		u, err := f.GetUserID()
+		if err != nil {
+			return "", err
+		}
+		path = strings.ReplaceAll(path, "{user}", u)
+	}
+
+	return path, nil
+}
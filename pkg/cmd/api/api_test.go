@@ -0,0 +1,111 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	apicmd "github.com/lucassabreu/clockify-cli/pkg/cmd/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCmdAPI(t *testing.T) {
+	tts := []struct {
+		name   string
+		args   []string
+		stdin  string
+		setup  func(*mocks.MockFactory, *mocks.MockClient)
+		err    string
+		output string
+	}{
+		{
+			name: "requires method and path",
+			args: []string{"GET"},
+			err:  "requires args method and path; 1 of those received",
+		},
+		{
+			name: "invalid body",
+			args: []string{"POST", "/tags", "-d", "{name"},
+			err:  "the body must be a valid JSON",
+		},
+		{
+			name: "body and input",
+			args: []string{"POST", "/tags", "-d", "{}", "-i", "-"},
+			err: "the following flags can't be used together: " +
+				"`body` and `input`",
+		},
+		{
+			name: "placeholders",
+			args: []string{"get",
+				"/workspaces/{workspace}/projects?page=2&user={user}"},
+			setup: func(f *mocks.MockFactory, c *mocks.MockClient) {
+				f.On("GetWorkspaceID").Return("w", nil)
+				f.On("GetUserID").Return("u", nil)
+				c.On("Raw", mock.Anything, api.RawParam{
+					Method: "get",
+					Path:   "/workspaces/w/projects?page=2&user=u",
+				}).Return(json.RawMessage(`[{"id":"p1"}]`), nil)
+			},
+			output: "[{\"id\":\"p1\"}]\n",
+		},
+		{
+			name:  "body from stdin",
+			args:  []string{"POST", "/workspaces/x/tags", "--input", "-"},
+			stdin: `{"name":"t"}`,
+			setup: func(f *mocks.MockFactory, c *mocks.MockClient) {
+				c.On("Raw", mock.Anything, api.RawParam{
+					Method: "POST",
+					Path:   "/workspaces/x/tags",
+					Body:   []byte(`{"name":"t"}`),
+				}).Return(json.RawMessage(`{"id":"t1"}`), nil)
+			},
+			output: "{\"id\":\"t1\"}\n",
+		},
+		{
+			name: "api error",
+			args: []string{"DELETE", "/workspaces/x/tags/t1"},
+			setup: func(f *mocks.MockFactory, c *mocks.MockClient) {
+				c.On("Raw", mock.Anything, api.RawParam{
+					Method: "DELETE",
+					Path:   "/workspaces/x/tags/t1",
+				}).Return(json.RawMessage(nil), errors.New("not found"))
+			},
+			err: "not found",
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			f := mocks.NewMockFactory(t)
+			if tt.setup != nil {
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+				tt.setup(f, c)
+			}
+
+			cmd := apicmd.NewCmdAPI(f)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+			cmd.SetArgs(tt.args)
+			cmd.SetIn(strings.NewReader(tt.stdin))
+
+			out := bytes.NewBufferString("")
+			cmd.SetOut(out)
+
+			_, err := cmd.ExecuteC()
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.output, out.String())
+		})
+	}
+}
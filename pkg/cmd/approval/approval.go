@@ -0,0 +1,28 @@
+package approval
+
+import (
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/approval/approve"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/approval/list"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/approval/reject"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/approval/submit"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/approval/withdraw"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdApproval represents the approval command
+func NewCmdApproval(f cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "approval",
+		Aliases: []string{"approvals"},
+		Short:   "Submit, approve or reject timesheets on Clockify",
+	}
+
+	cmd.AddCommand(list.NewCmdList(f, nil))
+	cmd.AddCommand(submit.NewCmdSubmit(f, nil))
+	cmd.AddCommand(withdraw.NewCmdWithdraw(f, nil))
+	cmd.AddCommand(approve.NewCmdApprove(f, nil))
+	cmd.AddCommand(reject.NewCmdReject(f, nil))
+
+	return cmd
+}
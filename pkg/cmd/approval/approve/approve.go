@@ -0,0 +1,54 @@
+package approve
+
+import (
+	"io"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/approval/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdApprove represents the approve command
+func NewCmdApprove(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, []dto.ApprovalRequest) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	var note string
+	cmd := &cobra.Command{
+		Use:   "approve <approval request>...",
+		Args:  cmdutil.RequiredNamedArgs("approval request"),
+		Short: "Approves pending timesheets",
+		Example: heredoc.Docf(`
+			$ %[1]s 64c2b8f1a3d4e5f6a7b8c9d0 64c2b8f1a3d4e5f6a7b8c9d1 -q
+			64c2b8f1a3d4e5f6a7b8c9d0
+			64c2b8f1a3d4e5f6a7b8c9d1
+		`, "clockify-cli approval approve"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			as, err := util.UpdateState(
+				cmd.Context(),
+				f, args, dto.ApprovalStateApproved, note)
+			if err != nil {
+				return err
+			}
+
+			if report != nil {
+				return report(cmd.OutOrStdout(), &of, as)
+			}
+
+			return util.Report(as, cmd.OutOrStdout(), of)
+		},
+	}
+
+	cmd.Flags().StringVarP(&note, "note", "n", "",
+		"a note to the owners of the timesheets")
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
@@ -0,0 +1,105 @@
+package list
+
+import (
+	"io"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/approval/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+var states = map[string]dto.ApprovalState{
+	"pending":   dto.ApprovalStatePending,
+	"approved":  dto.ApprovalStateApproved,
+	"withdrawn": dto.ApprovalStateWithdrawnApproval,
+	"all":       "",
+}
+
+// NewCmdList represents the list command
+func NewCmdList(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, []dto.ApprovalRequest) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	var status string
+	pagination := api.AllPages()
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Args:    cobra.ExactArgs(0),
+		Short:   "List approval requests of a Clockify workspace",
+		Long: heredoc.Doc(`
+			List approval requests of a Clockify workspace
+
+			By default only the requests pending approval are listed.
+		`),
+		Example: heredoc.Docf(`
+			$ %[1]s
+			+--------------------------+----------+------------+------------+---------+
+			|            ID            |   USER   |   START    |    END     |  STATE  |
+			+--------------------------+----------+------------+------------+---------+
+			| 64c2b8f1a3d4e5f6a7b8c9d0 | John Due | 2023-07-24 | 2023-07-30 | PENDING |
+			+--------------------------+----------+------------+------------+---------+
+
+			$ %[1]s --status approved --quiet
+			64c2b8f1a3d4e5f6a7b8c9d0
+		`, "clockify-cli approval list"),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			state, ok := states[strings.ToLower(status)]
+			if !ok {
+				return cmdutil.FlagErrorWrap(&api.InvalidOptionError{
+					Field:   "status",
+					Options: statusArgs(),
+				})
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			as, err := c.GetApprovalRequests(cmd.Context(),
+				api.GetApprovalRequestsParam{
+					Workspace:       w,
+					Status:          state,
+					PaginationParam: pagination,
+				})
+			if err != nil {
+				return err
+			}
+
+			if report != nil {
+				return report(cmd.OutOrStdout(), &of, as)
+			}
+
+			return util.Report(as, cmd.OutOrStdout(), of)
+		},
+	}
+
+	cmd.Flags().StringVarP(&status, "status", "s", "pending",
+		"which requests to list: pending, approved, withdrawn or all")
+	_ = cmdcompl.AddFixedSuggestionsToFlag(cmd, "status",
+		cmdcompl.ValidArgsSlide(statusArgs()))
+	util.AddReportFlags(cmd, &of)
+	cmdutil.AddPaginationFlags(cmd.Flags(), &pagination)
+
+	return cmd
+}
+
+func statusArgs() []string {
+	return []string{"pending", "approved", "withdrawn", "all"}
+}
@@ -0,0 +1,58 @@
+package reject
+
+import (
+	"io"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/approval/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdReject represents the reject command
+func NewCmdReject(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, []dto.ApprovalRequest) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	var note string
+	cmd := &cobra.Command{
+		Use:   "reject <approval request>...",
+		Args:  cmdutil.RequiredNamedArgs("approval request"),
+		Short: "Rejects pending timesheets",
+		Long: heredoc.Doc(`
+			Rejects pending timesheets, so they can be fixed and submitted
+			again by their owners
+		`),
+		Example: heredoc.Docf(`
+			$ %[1]s 64c2b8f1a3d4e5f6a7b8c9d0 -n "missing friday" -q
+			64c2b8f1a3d4e5f6a7b8c9d0
+		`, "clockify-cli approval reject"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			as, err := util.UpdateState(
+				cmd.Context(),
+				f, args, dto.ApprovalStateRejected, note)
+			if err != nil {
+				return err
+			}
+
+			if report != nil {
+				return report(cmd.OutOrStdout(), &of, as)
+			}
+
+			return util.Report(as, cmd.OutOrStdout(), of)
+		},
+	}
+
+	cmd.Flags().StringVarP(&note, "note", "n", "",
+		"why the timesheets were rejected")
+	_ = cmd.MarkFlagRequired("note")
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
@@ -0,0 +1,161 @@
+package submit
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/approval/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/spf13/cobra"
+)
+
+var periods = map[string]dto.ApprovalPeriod{
+	"weekly":       dto.ApprovalPeriodWeekly,
+	"semi-monthly": dto.ApprovalPeriodSemiMonthly,
+	"monthly":      dto.ApprovalPeriodMonthly,
+}
+
+var weekdays = map[string]time.Weekday{
+	string(dto.WeekStartSunday):    time.Sunday,
+	string(dto.WeekStartMonday):    time.Monday,
+	string(dto.WeekStartTuesday):   time.Tuesday,
+	string(dto.WeekStartWednesday): time.Wednesday,
+	string(dto.WeekStartThursday):  time.Thursday,
+	string(dto.WeekStartFriday):    time.Friday,
+	string(dto.WeekStartSaturday):  time.Saturday,
+}
+
+// NewCmdSubmit represents the submit command
+func NewCmdSubmit(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, dto.ApprovalRequest) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	var period string
+	cmd := &cobra.Command{
+		Use:   "submit [<date>]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Submits the timesheet of a period for approval",
+		Long: heredoc.Doc(`
+			Submits the timesheet of a period for approval
+
+			The period submitted is the one containing the date informed
+			(format 2006-01-02), or today when none is informed. Weekly
+			periods start on the week start set on the user's settings.
+		`),
+		Example: heredoc.Docf(`
+			# submits the current week
+			$ %[1]s -q
+			64c2b8f1a3d4e5f6a7b8c9d0
+
+			# submits the last month
+			$ %[1]s --period monthly 2023-06-01
+			+--------------------------+----------+------------+------------+---------+
+			|            ID            |   USER   |   START    |    END     |  STATE  |
+			+--------------------------+----------+------------+------------+---------+
+			| 64c2b8f1a3d4e5f6a7b8c9d1 | John Due | 2023-06-01 | 2023-06-30 | PENDING |
+			+--------------------------+----------+------------+------------+---------+
+		`, "clockify-cli approval submit"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			p := api.SubmitApprovalRequestParam{}
+
+			var ok bool
+			if p.Period, ok = periods[strings.ToLower(period)]; !ok {
+				return cmdutil.FlagErrorWrap(&api.InvalidOptionError{
+					Field:   "period",
+					Options: periodArgs(),
+				})
+			}
+
+			ref := timehlp.Today()
+			if len(args) > 0 {
+				var err error
+				if ref, err = time.ParseInLocation(
+					"2006-01-02", args[0], time.Local); err != nil {
+					return errors.New("date must be in the format 2006-01-02")
+				}
+			}
+
+			var err error
+			if p.Workspace, err = f.GetWorkspaceID(); err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			weekStart := time.Monday
+			if p.Period == dto.ApprovalPeriodWeekly {
+				u, err := c.GetMe(ctx)
+				if err != nil {
+					return err
+				}
+
+				if d, ok := weekdays[u.Settings.WeekStart]; ok {
+					weekStart = d
+				}
+			}
+
+			p.PeriodStart = PeriodStart(p.Period, ref, weekStart)
+			a, err := c.SubmitApprovalRequest(ctx, p)
+			if err != nil {
+				return err
+			}
+
+			if report != nil {
+				return report(cmd.OutOrStdout(), &of, a)
+			}
+
+			return util.ReportOne(a, cmd.OutOrStdout(), of)
+		},
+	}
+
+	cmd.Flags().StringVarP(&period, "period", "p", "weekly",
+		"length of the timesheet: weekly, semi-monthly or monthly")
+	_ = cmdcompl.AddFixedSuggestionsToFlag(cmd, "period",
+		cmdcompl.ValidArgsSlide(periodArgs()))
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
+
+func periodArgs() []string {
+	return []string{"weekly", "semi-monthly", "monthly"}
+}
+
+// PeriodStart returns the first day of the period containing ref, at
+// midnight UTC as the API expects
+func PeriodStart(
+	p dto.ApprovalPeriod, ref time.Time, weekStart time.Weekday,
+) time.Time {
+	y, m, d := ref.Date()
+	switch p {
+	case dto.ApprovalPeriodMonthly:
+		d = 1
+	case dto.ApprovalPeriodSemiMonthly:
+		if d > 15 {
+			d = 16
+		} else {
+			d = 1
+		}
+	default:
+		d = d - (int(ref.Weekday())-int(weekStart)+7)%7
+	}
+
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,112 @@
+package submit_test
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/approval/submit"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/approval/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPeriodStart(t *testing.T) {
+	// 2023-07-26 is a wednesday
+	ref := time.Date(2023, 7, 26, 15, 0, 0, 0, time.Local)
+	day := func(m time.Month, d int) time.Time {
+		return time.Date(2023, m, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	tts := []struct {
+		name      string
+		period    dto.ApprovalPeriod
+		weekStart time.Weekday
+		ref       time.Time
+		expected  time.Time
+	}{
+		{
+			name:      "week starting on monday",
+			period:    dto.ApprovalPeriodWeekly,
+			weekStart: time.Monday,
+			ref:       ref,
+			expected:  day(7, 24),
+		},
+		{
+			name:      "week starting on thursday",
+			period:    dto.ApprovalPeriodWeekly,
+			weekStart: time.Thursday,
+			ref:       ref,
+			expected:  day(7, 20),
+		},
+		{
+			name:      "first day of the week",
+			period:    dto.ApprovalPeriodWeekly,
+			weekStart: time.Wednesday,
+			ref:       ref,
+			expected:  day(7, 26),
+		},
+		{
+			name:     "second half of the month",
+			period:   dto.ApprovalPeriodSemiMonthly,
+			ref:      ref,
+			expected: day(7, 16),
+		},
+		{
+			name:     "first half of the month",
+			period:   dto.ApprovalPeriodSemiMonthly,
+			ref:      ref.AddDate(0, 0, -11),
+			expected: day(7, 1),
+		},
+		{
+			name:     "month",
+			period:   dto.ApprovalPeriodMonthly,
+			ref:      ref,
+			expected: day(7, 1),
+		},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected,
+				submit.PeriodStart(tt.period, tt.ref, tt.weekStart))
+		})
+	}
+}
+
+func TestCmdSubmit(t *testing.T) {
+	f := mocks.NewMockFactory(t)
+	c := mocks.NewMockClient(t)
+	f.On("GetWorkspaceID").Return("w", nil)
+	f.On("Client").Return(c, nil)
+	c.On("GetMe", mock.Anything).Return(dto.User{
+		Settings: dto.UserSettings{WeekStart: "SUNDAY"},
+	}, nil)
+	c.On("SubmitApprovalRequest", mock.Anything, api.SubmitApprovalRequestParam{
+		Workspace:   "w",
+		Period:      dto.ApprovalPeriodWeekly,
+		PeriodStart: time.Date(2023, 7, 23, 0, 0, 0, 0, time.UTC),
+	}).Return(dto.ApprovalRequest{ID: "a1"}, nil)
+
+	called := false
+	cmd := submit.NewCmdSubmit(f,
+		func(_ io.Writer, _ *util.OutputFlags, a dto.ApprovalRequest) error {
+			called = true
+			assert.Equal(t, "a1", a.ID)
+			return nil
+		})
+	cmd.SilenceUsage = true
+	cmd.SetArgs([]string{"2023-07-26"})
+
+	_, err := cmd.ExecuteC()
+	assert.NoError(t, err)
+	assert.True(t, called)
+
+	cmd.SetArgs([]string{"--period=yearly"})
+	_, err = cmd.ExecuteC()
+	assert.Error(t, err)
+	assert.Regexp(t, "valid options for period are", err.Error())
+}
@@ -0,0 +1,99 @@
+package util
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	output "github.com/lucassabreu/clockify-cli/pkg/output/approval"
+	"github.com/lucassabreu/clockify-cli/strhlp"
+	"github.com/spf13/cobra"
+)
+
+// OutputFlags sets how to print out a list of approval requests
+type OutputFlags struct {
+	Format string
+	JSON   bool
+	YAML   bool
+	Quiet  bool
+}
+
+func (of OutputFlags) Check() error {
+	return cmdutil.XorFlag(map[string]bool{
+		"format": of.Format != "",
+		"json":   of.JSON,
+		"yaml":   of.YAML,
+		"quiet":  of.Quiet,
+	})
+}
+
+// AddReportFlags adds the default output flags for approval requests
+func AddReportFlags(cmd *cobra.Command, of *OutputFlags) {
+	cmd.Flags().StringVarP(&of.Format, "format", "f", "",
+		"golang text/template format to be applied on each Approval Request")
+	cmdutil.AddTemplateSourceFlags(cmd.Flags())
+	cmd.Flags().BoolVarP(&of.JSON, "json", "j", false, "print as JSON")
+	cmd.Flags().BoolVar(&of.YAML, "yaml", false, "print as YAML")
+	cmd.Flags().BoolVarP(&of.Quiet, "quiet", "q", false, "only display ids")
+}
+
+// Report prints out the approval requests
+func Report(as []dto.ApprovalRequest, out io.Writer, of OutputFlags) error {
+	switch {
+	case of.JSON:
+		return output.ApprovalsJSONPrint(as, out)
+	case of.YAML:
+		return output.ApprovalsYAMLPrint(as, out)
+	case of.Format != "":
+		return output.ApprovalPrintWithTemplate(of.Format)(as, out)
+	case of.Quiet:
+		return output.ApprovalPrintQuietly(as, out)
+	default:
+		return output.ApprovalPrint(as, out)
+	}
+}
+
+// ReportOne prints out a single approval request
+func ReportOne(a dto.ApprovalRequest, out io.Writer, of OutputFlags) error {
+	if of.JSON {
+		return output.ApprovalJSONPrint(a, out)
+	}
+
+	return Report([]dto.ApprovalRequest{a}, out, of)
+}
+
+// UpdateState changes the state of each approval request, with the same note
+func UpdateState(
+	ctx context.Context,
+	f cmdutil.Factory, ids []string, state dto.ApprovalState, note string,
+) ([]dto.ApprovalRequest, error) {
+	w, err := f.GetWorkspaceID()
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := f.Client()
+	if err != nil {
+		return nil, err
+	}
+
+	ids = strhlp.Unique(strhlp.Map(strings.TrimSpace, ids))
+	as := make([]dto.ApprovalRequest, len(ids))
+	for i := range ids {
+		if as[i], err = c.UpdateApprovalRequest(
+			ctx,
+			api.UpdateApprovalRequestParam{
+				Workspace:         w,
+				ApprovalRequestID: ids[i],
+				State:             state,
+				Note:              note,
+			}); err != nil {
+			return as, err
+		}
+	}
+
+	return as, nil
+}
@@ -0,0 +1,53 @@
+package withdraw
+
+import (
+	"io"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/approval/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdWithdraw represents the withdraw command
+func NewCmdWithdraw(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, []dto.ApprovalRequest) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	var note string
+	cmd := &cobra.Command{
+		Use:   "withdraw <approval request>...",
+		Args:  cmdutil.RequiredNamedArgs("approval request"),
+		Short: "Withdraws timesheets submitted for approval",
+		Example: heredoc.Docf(`
+			$ %[1]s 64c2b8f1a3d4e5f6a7b8c9d0 -q
+			64c2b8f1a3d4e5f6a7b8c9d0
+		`, "clockify-cli approval withdraw"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			as, err := util.UpdateState(
+				cmd.Context(),
+				f, args, dto.ApprovalStateWithdrawnSubmission, note)
+			if err != nil {
+				return err
+			}
+
+			if report != nil {
+				return report(cmd.OutOrStdout(), &of, as)
+			}
+
+			return util.Report(as, cmd.OutOrStdout(), of)
+		},
+	}
+
+	cmd.Flags().StringVarP(&note, "note", "n", "",
+		"why the timesheets were withdrawn")
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
@@ -0,0 +1,43 @@
+package cache
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/cache/clear"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/cache/refresh"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdCache represents the cache command
+func NewCmdCache(f cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cache",
+		Short: "Manages the local cache of projects, clients, tags and tasks",
+		Args:  cobra.MaximumNArgs(0),
+		Long: heredoc.Doc(`
+			Manages the local cache of projects, clients, tags and tasks
+
+			When "cache-ttl" is set, the lists of projects, clients, tags and
+			tasks are kept on disk for that long and used to look up the
+			entities by name and to complete them on the shell.
+		`),
+		Example: heredoc.Doc(`
+			# keep the cache for one hour
+			$ clockify-cli config set cache-ttl 1h
+
+			# fetch everything of the current workspace again
+			$ clockify-cli cache refresh
+
+			# fetch everything of all the workspaces
+			$ clockify-cli sync
+
+			# remove the cache of all workspaces
+			$ clockify-cli cache clear
+		`),
+	}
+
+	cmd.AddCommand(refresh.NewCmdRefresh(f))
+	cmd.AddCommand(clear.NewCmdClear(f))
+
+	return cmd
+}
@@ -0,0 +1,25 @@
+package clear
+
+import (
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdClear represents the cache clear command
+func NewCmdClear(f cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clear",
+		Short: "Removes everything kept on the cache",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			lc, err := cmdutil.LocalCache(f)
+			if err != nil {
+				return err
+			}
+
+			return lc.Clear()
+		},
+	}
+
+	return cmd
+}
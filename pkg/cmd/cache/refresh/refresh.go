@@ -0,0 +1,51 @@
+package refresh
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdRefresh represents the cache refresh command
+func NewCmdRefresh(f cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "refresh",
+		Short: "Fetches the projects, clients, tags and tasks of the " +
+			"workspace into the cache",
+		Args: cobra.ExactArgs(0),
+		Example: heredoc.Doc(`
+			$ clockify-cli cache refresh
+			cached 12 projects, 3 clients, 8 tags and 40 tasks
+		`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			lc, err := cmdutil.LocalCache(f)
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			r, err := lc.Refresh(cmd.Context(), c, w)
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintf(cmd.OutOrStdout(),
+				"cached %d projects, %d clients, %d tags and %d tasks\n",
+				r.Projects, r.Clients, r.Tags, r.Tasks)
+			return err
+		},
+	}
+
+	return cmd
+}
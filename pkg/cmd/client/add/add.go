@@ -52,7 +52,7 @@ func NewCmdAdd(
 			}
 
 			name, _ := cmd.Flags().GetString("name")
-			cl, err := c.AddClient(api.AddClientParam{
+			cl, err := c.AddClient(cmd.Context(), api.AddClientParam{
 				Workspace: w,
 				Name:      name,
 			})
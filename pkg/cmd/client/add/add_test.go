@@ -12,6 +12,7 @@ import (
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/client/util"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestCmdAdd(t *testing.T) {
@@ -69,7 +70,7 @@ func TestCmdAdd(t *testing.T) {
 				f.On("GetWorkspaceID").
 					Return("w", nil)
 				f.On("Client").Return(c, nil)
-				c.On("AddClient", api.AddClientParam{
+				c.On("AddClient", mock.Anything, api.AddClientParam{
 					Workspace: "w",
 					Name:      "error",
 				}).
@@ -139,7 +140,7 @@ func TestCmdAddReport(t *testing.T) {
 			f.On("GetWorkspaceID").
 				Return("w", nil)
 
-			c.On("AddClient", api.AddClientParam{
+			c.On("AddClient", mock.Anything, api.AddClientParam{
 				Workspace: "w",
 				Name:      "rockr",
 			}).
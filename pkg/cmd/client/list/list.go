@@ -18,6 +18,7 @@ func NewCmdList(
 ) *cobra.Command {
 	of := util.OutputFlags{}
 	var archived, notArchived bool
+	pagination := api.AllPages()
 	cmd := &cobra.Command{
 		Use:     "list",
 		Aliases: []string{"ls"},
@@ -53,7 +54,7 @@ func NewCmdList(
 			}
 
 			p := api.GetClientsParam{
-				PaginationParam: api.AllPages(),
+				PaginationParam: pagination,
 			}
 
 			var err error
@@ -71,7 +72,7 @@ func NewCmdList(
 				p.Archived = &archived
 			}
 
-			clients, err := c.GetClients(p)
+			clients, err := c.GetClients(cmd.Context(), p)
 			if err != nil {
 				return err
 			}
@@ -92,6 +93,7 @@ func NewCmdList(
 		&archived, "archived", "", false, "list only archived projects")
 
 	util.AddReportFlags(cmd, &of)
+	cmdutil.AddPaginationFlags(cmd.Flags(), &pagination)
 
 	return cmd
 }
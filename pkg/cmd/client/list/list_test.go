@@ -12,6 +12,7 @@ import (
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/client/util"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 type report func(io.Writer, *util.OutputFlags, []dto.Client) error
@@ -74,7 +75,7 @@ func TestCmdList(t *testing.T) {
 				f.On("GetWorkspaceID").
 					Return("w", nil)
 				f.On("Client").Return(c, nil)
-				c.On("GetClients", api.GetClientsParam{
+				c.On("GetClients", mock.Anything, api.GetClientsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).
@@ -93,7 +94,7 @@ func TestCmdList(t *testing.T) {
 					Return("w", nil)
 
 				b := true
-				c.On("GetClients", api.GetClientsParam{
+				c.On("GetClients", mock.Anything, api.GetClientsParam{
 					Workspace:       "w",
 					Archived:        &b,
 					PaginationParam: api.AllPages(),
@@ -121,7 +122,7 @@ func TestCmdList(t *testing.T) {
 					Return("w", nil)
 
 				b := false
-				c.On("GetClients", api.GetClientsParam{
+				c.On("GetClients", mock.Anything, api.GetClientsParam{
 					Workspace:       "w",
 					Archived:        &b,
 					PaginationParam: api.AllPages(),
@@ -148,7 +149,7 @@ func TestCmdList(t *testing.T) {
 				f.On("GetWorkspaceID").
 					Return("w", nil)
 
-				c.On("GetClients", api.GetClientsParam{
+				c.On("GetClients", mock.Anything, api.GetClientsParam{
 					Workspace:       "w",
 					Name:            "rockr",
 					PaginationParam: api.AllPages(),
@@ -166,6 +167,36 @@ func TestCmdList(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "limit",
+			args: []string{"--limit", "2", "--page-size", "10"},
+			factory: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+				f.On("GetWorkspaceID").
+					Return("w", nil)
+
+				c.On("GetClients", mock.Anything, api.GetClientsParam{
+					Workspace: "w",
+					PaginationParam: api.PaginationParam{
+						AllPages: true,
+						PageSize: 10,
+						Limit:    2,
+					},
+				}).
+					Return(cs, nil)
+
+				called := false
+				t.Cleanup(func() { assert.True(t, called, "was not called") })
+				return f, func(
+					_ io.Writer, of *util.OutputFlags, u []dto.Client) error {
+					called = true
+					assert.Equal(t, cs, u)
+					return nil
+				}
+			},
+		},
 		{
 			name: "report json",
 			args: []string{"--json"},
@@ -176,7 +207,7 @@ func TestCmdList(t *testing.T) {
 				f.On("GetWorkspaceID").
 					Return("w", nil)
 
-				c.On("GetClients", api.GetClientsParam{
+				c.On("GetClients", mock.Anything, api.GetClientsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).
@@ -193,6 +224,33 @@ func TestCmdList(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "report yaml",
+			args: []string{"--yaml"},
+			factory: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+				f.On("GetWorkspaceID").
+					Return("w", nil)
+
+				c.On("GetClients", mock.Anything, api.GetClientsParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).
+					Return(cs, nil)
+
+				called := false
+				t.Cleanup(func() { assert.True(t, called, "was not called") })
+				return f, func(
+					_ io.Writer, of *util.OutputFlags, u []dto.Client) error {
+					called = true
+					assert.Equal(t, cs, u)
+					assert.True(t, of.YAML)
+					return nil
+				}
+			},
+		},
 		{
 			name: "report format",
 			args: []string{"--format={{.Name}}"},
@@ -203,7 +261,7 @@ func TestCmdList(t *testing.T) {
 				f.On("GetWorkspaceID").
 					Return("w", nil)
 
-				c.On("GetClients", api.GetClientsParam{
+				c.On("GetClients", mock.Anything, api.GetClientsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).
@@ -14,13 +14,16 @@ type OutputFlags struct {
 	Format string
 	CSV    bool
 	JSON   bool
+	YAML   bool
 	Quiet  bool
+	Sort   string
 }
 
 func (of OutputFlags) Check() error {
 	return cmdutil.XorFlag(map[string]bool{
 		"format": of.Format != "",
 		"json":   of.JSON,
+		"yaml":   of.YAML,
 		"csv":    of.CSV,
 		"quiet":  of.Quiet,
 	})
@@ -30,16 +33,28 @@ func (of OutputFlags) Check() error {
 func AddReportFlags(cmd *cobra.Command, of *OutputFlags) {
 	cmd.Flags().StringVarP(&of.Format, "format", "f", "",
 		"golang text/template format to be applied on each Client")
+	cmdutil.AddTemplateSourceFlags(cmd.Flags())
 	cmd.Flags().BoolVarP(&of.JSON, "json", "j", false, "print as JSON")
+	cmd.Flags().BoolVar(&of.YAML, "yaml", false, "print as YAML")
+	cmd.Flags().StringVar(&of.Sort, "sort", "",
+		"sorts the clients by a field, like: name or name:desc")
 	cmd.Flags().BoolVarP(&of.CSV, "csv", "v", false, "print as CSV")
 	cmd.Flags().BoolVarP(&of.Quiet, "quiet", "q", false, "only display ids")
 }
 
 // Report prints out the clients
 func Report(cs []dto.Client, out io.Writer, of OutputFlags) error {
+	if of.Sort != "" {
+		if err := output.SortClients(cs, of.Sort); err != nil {
+			return err
+		}
+	}
+
 	switch {
 	case of.JSON:
 		return output.ClientsJSONPrint(cs, out)
+	case of.YAML:
+		return output.ClientsYAMLPrint(cs, out)
 	case of.CSV:
 		return output.ClientsCSVPrint(cs, out)
 	case of.Format != "":
@@ -5,6 +5,7 @@ import (
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/config/get"
 	initialize "github.com/lucassabreu/clockify-cli/pkg/cmd/config/init"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/config/list"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/config/profile"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/config/set"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
@@ -34,6 +35,68 @@ var validParameters = cmdcompl.ValidArgsMap{
 	cmdutil.CONF_LOG_LEVEL: "how much logs should be shown values: " +
 		"none , error , info and debug",
 	cmdutil.CONF_ALLOW_ARCHIVED_TAGS: "should allow and suggest archived tags",
+	cmdutil.CONF_DURATION_FORMAT: "default format of durations on time " +
+		"entry reports: hms, decimal, minutes, hm or iso",
+	cmdutil.CONF_TIME_ZONE: "time zone used to show the start and end of " +
+		"time entries (like: America/Sao_Paulo or UTC)",
+	cmdutil.CONF_LOCALE: "locale used to show dates and decimal numbers " +
+		"on time entry reports (like: de-DE or pt-BR)",
+	cmdutil.CONF_NO_COLOR: "disables the colors on all outputs",
+	cmdutil.CONF_COLOR_HEADER: "colors of the header row of tables " +
+		"(like: bold,cyan)",
+	cmdutil.CONF_COLOR_TOTAL: "colors of the totals row of tables " +
+		"(like: bold,yellow)",
+	cmdutil.CONF_COLOR_PROJECT: "should use the project's color on tables",
+	cmdutil.CONF_CACHE_TTL: "how long projects, clients, tags and tasks " +
+		"are kept on the local cache (like: 1h, 0 disables it)",
+	cmdutil.CONF_MAX_RETRIES: "how many times a request will be retried " +
+		"when the API is rate limiting or it timed out",
+	cmdutil.CONF_RETRY_COUNT: "same as " + cmdutil.CONF_MAX_RETRIES,
+	cmdutil.CONF_RETRY_WAIT: "how long the first retry waits, each next " +
+		"retry waits twice as long (like: 1s)",
+	cmdutil.CONF_HTTP_TIMEOUT: "how long each request can take before " +
+		"being cancelled and retried if idempotent (like: 30s, 0 " +
+		"disables it)",
+	cmdutil.CONF_PROXY: "url of the proxy used to access the API " +
+		"(defaults to $HTTPS_PROXY)",
+	cmdutil.CONF_CA_BUNDLE: "PEM file with certificates to trust besides " +
+		"the ones of the system, for proxies that inspect the traffic",
+	cmdutil.CONF_INSECURE_SKIP_VERIFY: "disables the verification of the " +
+		"API certificates (use only if there is no other way)",
+	cmdutil.CONF_PROFILE: "name of the profile used, whose configs are " +
+		"used instead of the ones outside of it (see \"config profile\")",
+	cmdutil.CONF_JSON_ERRORS: "prints errors on stderr as JSON, exiting " +
+		"with a code for each kind of error",
+	cmdutil.CONF_SPLIT_MIDNIGHT: "splits the time entries ended by " +
+		"\"out\", \"manual\" and \"edit\" into one for each day they cross",
+	cmdutil.CONF_JOURNAL_SIZE: "how many executions that changed time " +
+		"entries are kept to be reverted by \"undo\" (0 disables it)",
+	cmdutil.CONF_GIT_TICKET_PATTERN: "regular expression to find the " +
+		"ticket ID on the branch name for \"in --from-git\" " +
+		"(like: [A-Z]+-[0-9]+)",
+	cmdutil.CONF_JIRA_URL: "base url of the Jira used by \"--jira\" and " +
+		"\"jira worklog push\" (like: https://acme.atlassian.net)",
+	cmdutil.CONF_JIRA_USER: "e-mail of the user on Jira Cloud, leave it " +
+		"empty to use \"jira.token\" as a personal access token",
+	cmdutil.CONF_JIRA_TOKEN: "API token (or personal access token) used " +
+		"to call Jira",
+	cmdutil.CONF_GITHUB_TOKEN: "token used by \"in --gh\" to read " +
+		"issues and pull requests of private repositories (GH_TOKEN and " +
+		"GITHUB_TOKEN are used when not set)",
+	cmdutil.CONF_GITHUB_API_URL: "base url of the GitHub API, for GitHub " +
+		"Enterprise Server (like: https://github.acme.com/api/v3)",
+	cmdutil.CONF_GITLAB_URL: "base url of the GitLab used by \"in --gl\" " +
+		"and \"gitlab spend push\" (https://gitlab.com when not set)",
+	cmdutil.CONF_GITLAB_TOKEN: "personal access token used to call " +
+		"GitLab (GITLAB_TOKEN is used when not set)",
+	cmdutil.CONF_BREAK_PROJECT: "project (name or ID) of the time entries " +
+		"started by \"break start\", when not set the time entry " +
+		"running is only paused",
+	cmdutil.CONF_API_URL: "base url of Clockify's API, for regional or " +
+		"self-hosted instances (like: https://euc1.clockify.me/api)",
+	cmdutil.CONF_REPORTS_API_URL: "base url of Clockify's Reports API, for " +
+		"regional or self-hosted instances " +
+		"(like: https://euc1.clockify.me/report/v1)",
 }
 
 // NewCmdConfig represents the config command
@@ -66,6 +129,7 @@ func NewCmdConfig(f cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(set.NewCmdSet(f, validParameters))
 	cmd.AddCommand(get.NewCmdGet(f, validParameters))
 	cmd.AddCommand(list.NewCmdList(f))
+	cmd.AddCommand(profile.NewCmdProfile(f))
 
 	return cmd
 }
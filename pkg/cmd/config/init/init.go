@@ -18,6 +18,8 @@ func NewCmdInit(f cmdutil.Factory) *cobra.Command {
 			"user and behaviors",
 		Args: cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
 			i := f.UI()
 			config := f.Config()
 
@@ -38,7 +40,7 @@ func NewCmdInit(f cmdutil.Factory) *cobra.Command {
 				return err
 			}
 
-			ws, err := c.GetWorkspaces(api.GetWorkspaces{})
+			ws, err := c.GetWorkspaces(ctx, api.GetWorkspaces{})
 			if err != nil {
 				return err
 			}
@@ -61,7 +63,7 @@ func NewCmdInit(f cmdutil.Factory) *cobra.Command {
 			config.SetString(cmdutil.CONF_WORKSPACE,
 				strings.TrimSpace(w[0:strings.Index(w, " - ")]))
 
-			users, err := c.WorkspaceUsers(api.WorkspaceUsersParam{
+			users, err := c.WorkspaceUsers(ctx, api.WorkspaceUsersParam{
 				Workspace:       config.GetString(cmdutil.CONF_WORKSPACE),
 				PaginationParam: api.AllPages(),
 			})
@@ -53,7 +53,7 @@ func TestInitCmd(t *testing.T) {
 
 			f.EXPECT().Client().Return(client, nil)
 
-			client.EXPECT().GetWorkspaces(api.GetWorkspaces{}).
+			client.EXPECT().GetWorkspaces(mock.Anything, api.GetWorkspaces{}).
 				Return([]dto.Workspace{
 					{ID: "1", Name: "First"},
 					{ID: "2", Name: "Second"},
@@ -61,10 +61,11 @@ func TestInitCmd(t *testing.T) {
 
 			call := setStringFn(config, cmdutil.CONF_WORKSPACE, "2")
 
-			client.EXPECT().WorkspaceUsers(api.WorkspaceUsersParam{
-				Workspace:       "2",
-				PaginationParam: api.AllPages(),
-			}).
+			client.EXPECT().WorkspaceUsers(mock.Anything,
+				api.WorkspaceUsersParam{
+					Workspace:       "2",
+					PaginationParam: api.AllPages(),
+				}).
 				NotBefore(call).
 				Return([]dto.User{
 					{ID: "user-1", Name: "John Due"},
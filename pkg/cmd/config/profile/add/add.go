@@ -0,0 +1,79 @@
+package add
+
+import (
+	"errors"
+	"regexp"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+var validName = regexp.MustCompile(`^[a-z0-9_-]+$`)
+
+// NewCmdAdd creates a new profile on the config
+func NewCmdAdd(f cmdutil.Factory) *cobra.Command {
+	fl := struct {
+		token     string
+		workspace string
+		userID    string
+		use       bool
+	}{}
+
+	cmd := &cobra.Command{
+		Use:   "add <name>",
+		Args:  cmdutil.RequiredNamedArgs("name"),
+		Short: "Creates or changes a profile",
+		Long: heredoc.Doc(`
+			Creates a profile with its own token, workspace and user
+
+			If the workspace or user are not set, the default workspace and
+			the user of the token are used.
+		`),
+		Example: heredoc.Docf(`
+			$ %[1]s work --token "Yamdas569"
+			$ %[1]s personal --token "Kdsaoi987" --workspace 62a0fe0b7e9e8a4d1c3b5f21 --switch
+		`, "clockify-cli config profile add"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			if !validName.MatchString(name) {
+				return cmdutil.FlagErrorWrap(errors.New(
+					"profile name must have only lowercase letters, " +
+						"numbers, - or _"))
+			}
+
+			if fl.token == "" {
+				return cmdutil.FlagErrorWrap(errors.New("token is required"))
+			}
+
+			c := f.Config()
+			c.SetString(cmdutil.ProfileKey(name, cmdutil.CONF_TOKEN), fl.token)
+			if fl.workspace != "" {
+				c.SetString(cmdutil.ProfileKey(name, cmdutil.CONF_WORKSPACE),
+					fl.workspace)
+			}
+
+			if fl.userID != "" {
+				c.SetString(cmdutil.ProfileKey(name, cmdutil.CONF_USER_ID),
+					fl.userID)
+			}
+
+			if fl.use {
+				c.SetString(cmdutil.CONF_PROFILE, name)
+			}
+
+			return c.Save()
+		},
+	}
+
+	cmd.Flags().StringVarP(&fl.token, "token", "t", "",
+		"clockify's token of the profile")
+	cmd.Flags().StringVarP(&fl.workspace, "workspace", "w", "",
+		"workspace used by the profile")
+	cmd.Flags().StringVarP(&fl.userID, "user-id", "u", "",
+		"user used by the profile")
+	cmd.Flags().BoolVar(&fl.use, "switch", false,
+		"starts using the profile")
+
+	return cmd
+}
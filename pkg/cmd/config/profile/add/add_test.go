@@ -0,0 +1,75 @@
+package add_test
+
+import (
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/config/profile/add"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCmdAdd(t *testing.T) {
+	tts := []struct {
+		name  string
+		args  []string
+		setup func(*mocks.MockConfig)
+		err   string
+	}{
+		{
+			name: "token is required",
+			args: []string{"work"},
+			err:  "token is required",
+		},
+		{
+			name: "invalid name",
+			args: []string{"my.work", "--token", "t"},
+			err: "profile name must have only lowercase letters, " +
+				"numbers, - or _",
+		},
+		{
+			name: "only token",
+			args: []string{"work", "--token", "t"},
+			setup: func(c *mocks.MockConfig) {
+				c.On("SetString", "profiles.work.token", "t").Once()
+				c.On("Save").Once().Return(nil)
+			},
+		},
+		{
+			name: "all and switch",
+			args: []string{"personal", "-t", "t", "-w", "w", "-u", "u",
+				"--switch"},
+			setup: func(c *mocks.MockConfig) {
+				c.On("SetString", "profiles.personal.token", "t").Once()
+				c.On("SetString", "profiles.personal.workspace", "w").Once()
+				c.On("SetString", "profiles.personal.user.id", "u").Once()
+				c.On("SetString", "profile", "personal").Once()
+				c.On("Save").Once().Return(nil)
+			},
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			f := mocks.NewMockFactory(t)
+			if tt.setup != nil {
+				c := mocks.NewMockConfig(t)
+				f.On("Config").Return(c)
+				tt.setup(c)
+			}
+
+			cmd := add.NewCmdAdd(f)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+			cmd.SetArgs(tt.args)
+
+			_, err := cmd.ExecuteC()
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
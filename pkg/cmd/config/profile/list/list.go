@@ -0,0 +1,43 @@
+package list
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdList shows the names of the profiles, marking the one in use
+func NewCmdList(f cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Short:   "List the profiles, marking the one in use",
+		Example: heredoc.Doc(`
+			$ clockify-cli config profile list
+			  personal
+			* work
+		`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			c := f.Config()
+			current := c.GetString(cmdutil.CONF_PROFILE)
+			for _, n := range cmdutil.ProfileNames(c) {
+				mark := " "
+				if n == current {
+					mark = "*"
+				}
+
+				if _, err := fmt.Fprintln(
+					cmd.OutOrStdout(), mark, n); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
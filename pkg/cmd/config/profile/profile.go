@@ -0,0 +1,42 @@
+package profile
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/config/profile/add"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/config/profile/list"
+	switchcmd "github.com/lucassabreu/clockify-cli/pkg/cmd/config/profile/switch"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdProfile represents the config profile command
+func NewCmdProfile(f cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "profile",
+		Short: "Manages profiles with different tokens and configs",
+		Args:  cobra.MaximumNArgs(0),
+		Long: heredoc.Doc(`
+			Manages profiles, which allow to use more than one account (or
+			workspace) of Clockify without changing the configs every time.
+
+			Each profile has its own token, workspace, user and any other
+			config, which are used instead of the ones outside of the
+			profiles. Configs changed while using a profile are saved into it.
+
+			The profile used can be set by "config profile switch", or by
+			--profile (or $CLOCKIFY_PROFILE) for a single execution.
+		`),
+		Example: heredoc.Doc(`
+			$ clockify-cli config profile add work --token "Yamdas569"
+			$ clockify-cli config profile switch work
+			$ clockify-cli config set duration-format decimal
+			$ clockify-cli --profile personal report this-week
+		`),
+	}
+
+	cmd.AddCommand(add.NewCmdAdd(f))
+	cmd.AddCommand(switchcmd.NewCmdSwitch(f))
+	cmd.AddCommand(list.NewCmdList(f))
+
+	return cmd
+}
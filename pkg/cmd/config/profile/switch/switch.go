@@ -0,0 +1,41 @@
+package switchcmd
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/strhlp"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdSwitch changes the profile used by the CLI
+func NewCmdSwitch(f cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "switch <name>",
+		Args:  cmdutil.RequiredNamedArgs("name"),
+		Short: "Changes the profile used by the next executions",
+		Example: heredoc.Doc(`
+			$ clockify-cli config profile switch work
+		`),
+		ValidArgsFunction: cmdcompl.CombineSuggestionsToArgs(
+			func(*cobra.Command, []string, string) (
+				cmdcompl.ValidArgs, error) {
+				return cmdcompl.ValidArgsSlide(
+					cmdutil.ProfileNames(f.Config())), nil
+			},
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c := f.Config()
+			if strhlp.Search(args[0], cmdutil.ProfileNames(c)) == -1 {
+				return fmt.Errorf("profile %s does not exist", args[0])
+			}
+
+			c.SetString(cmdutil.CONF_PROFILE, args[0])
+			return c.Save()
+		},
+	}
+
+	return cmd
+}
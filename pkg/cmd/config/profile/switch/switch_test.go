@@ -0,0 +1,46 @@
+package switchcmd_test
+
+import (
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	switchcmd "github.com/lucassabreu/clockify-cli/pkg/cmd/config/profile/switch"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCmdSwitch(t *testing.T) {
+	profiles := map[string]interface{}{
+		"work":     map[string]interface{}{"token": "t1"},
+		"personal": map[string]interface{}{"token": "t2"},
+	}
+
+	t.Run("not found", func(t *testing.T) {
+		f := mocks.NewMockFactory(t)
+		c := mocks.NewMockConfig(t)
+		f.On("Config").Return(c)
+		c.On("Get", "profiles").Return(profiles)
+
+		cmd := switchcmd.NewCmdSwitch(f)
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+		cmd.SetArgs([]string{"other"})
+
+		_, err := cmd.ExecuteC()
+		assert.EqualError(t, err, "profile other does not exist")
+	})
+
+	t.Run("switch", func(t *testing.T) {
+		f := mocks.NewMockFactory(t)
+		c := mocks.NewMockConfig(t)
+		f.On("Config").Return(c)
+		c.On("Get", "profiles").Return(profiles)
+		c.On("SetString", "profile", "work").Once()
+		c.On("Save").Once().Return(nil)
+
+		cmd := switchcmd.NewCmdSwitch(f)
+		cmd.SetArgs([]string{"work"})
+
+		_, err := cmd.ExecuteC()
+		assert.NoError(t, err)
+	})
+}
@@ -28,6 +28,7 @@ func NewCmdSet(
 			$ %[1]s workweek-days monday,tuesday,wednesday,thursday,friday
 			$ %[1]s show-task true
 			$ %[1]s user.id 4564d5a6s4d54a5s4dasd5
+			$ %[1]s templates.short "{{ .ID }} - {{ .Description }}"
 		`, "clockify-cli config set"),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			param := args[0]
@@ -0,0 +1,143 @@
+package add
+
+import (
+	"errors"
+	"io"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/expense/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/search"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdAdd represents the add command
+func NewCmdAdd(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, dto.Expense) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	p := api.AddExpenseParam{}
+	var date string
+	cmd := &cobra.Command{
+		Use:     "add",
+		Aliases: []string{"new", "create"},
+		Args:    cobra.ExactArgs(0),
+		Short:   "Records an expense on a project of the Clockify workspace",
+		Long: heredoc.Doc(`
+			Records an expense on a project of the Clockify workspace
+
+			The amount is the total spent, and the date defaults to today.
+		`),
+		Example: heredoc.Docf(`
+			$ %[1]s -p cli --category travel --amount 42.50 --notes Taxi -q
+			64c1a7e2b1f8a45d2c7e9a01
+
+			$ %[1]s -p cli --category 64c1a6f0b1f8a45d2c7e99f0 \
+				--amount 10 --date 2023-07-25 --billable
+		`, "clockify-cli expense add"),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			if p.Amount <= 0 {
+				return cmdutil.FlagErrorWrap(
+					errors.New("amount must be greater than zero"))
+			}
+
+			p.Date = timehlp.Today()
+			if date != "" {
+				d, err := time.ParseInLocation("2006-01-02", date, time.Local)
+				if err != nil {
+					return cmdutil.FlagErrorWrap(
+						errors.New("date must be in the format 2006-01-02"))
+				}
+				p.Date = d
+			}
+
+			var err error
+			if p.Workspace, err = f.GetWorkspaceID(); err != nil {
+				return err
+			}
+
+			if p.UserID, err = f.GetUserID(); err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			p.ProjectID, _ = cmd.Flags().GetString("project")
+			if f.Config().IsAllowNameForID() {
+				if p.ProjectID, err = search.GetProjectByName(
+					ctx,
+					c, p.Workspace, p.ProjectID); err != nil {
+					return err
+				}
+
+				if p.CategoryID, err = search.GetExpenseCategoryByName(
+					ctx,
+					c, p.Workspace, p.CategoryID); err != nil {
+					return err
+				}
+
+				if p.TaskID != "" {
+					if p.TaskID, err = search.GetTaskByName(
+						ctx,
+						c,
+						api.GetTasksParam{
+							Workspace: p.Workspace,
+							ProjectID: p.ProjectID,
+						},
+						p.TaskID,
+					); err != nil {
+						return err
+					}
+				}
+			}
+
+			e, err := c.AddExpense(ctx, p)
+			if err != nil {
+				return err
+			}
+
+			if report != nil {
+				return report(cmd.OutOrStdout(), &of, e)
+			}
+
+			return util.ReportOne(e, cmd.OutOrStdout(), of)
+		},
+	}
+
+	cmdutil.AddProjectFlags(cmd, f)
+	cmd.Flags().StringVar(&p.TaskID, "task", "",
+		"the name/id of the task of the project")
+	cmd.Flags().StringVarP(&p.CategoryID, "category", "c", "",
+		"the name/id of the expense category")
+	_ = cmd.MarkFlagRequired("category")
+	_ = cmdcompl.AddSuggestionsToFlag(cmd, "category",
+		cmdcomplutil.NewExpenseCategoryAutoComplete(f))
+	cmd.Flags().Float64Var(&p.Amount, "amount", 0, "the total spent")
+	_ = cmd.MarkFlagRequired("amount")
+	cmd.Flags().StringVarP(&date, "date", "d", "",
+		"day of the expense, with the format 2006-01-02 (default today)")
+	cmd.Flags().StringVarP(&p.Notes, "notes", "n", "",
+		"notes about the expense")
+	cmd.Flags().BoolVarP(&p.Billable, "billable", "b", false,
+		"the expense is billable")
+
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
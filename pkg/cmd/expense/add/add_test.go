@@ -0,0 +1,109 @@
+package add_test
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/expense/add"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/expense/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCmdAdd(t *testing.T) {
+	tts := []struct {
+		name    string
+		args    []string
+		factory func(*testing.T) cmdutil.Factory
+		param   api.AddExpenseParam
+		err     string
+	}{
+		{
+			name: "category required",
+			args: []string{"-p=p1", "--amount=10"},
+			err:  `"category" not set`,
+			factory: func(t *testing.T) cmdutil.Factory {
+				return mocks.NewMockFactory(t)
+			},
+		},
+		{
+			name: "amount must be positive",
+			args: []string{"-p=p1", "-c=c1", "--amount=-1"},
+			err:  "amount must be greater than zero",
+			factory: func(t *testing.T) cmdutil.Factory {
+				return mocks.NewMockFactory(t)
+			},
+		},
+		{
+			name: "invalid date",
+			args: []string{"-p=p1", "-c=c1", "--amount=1", "-d=yesterday"},
+			err:  "date must be in the format 2006-01-02",
+			factory: func(t *testing.T) cmdutil.Factory {
+				return mocks.NewMockFactory(t)
+			},
+		},
+		{
+			name: "all fields",
+			args: []string{"-p=p1", "-c=c1", "--task=t1", "--amount=42.5",
+				"-d=2023-07-25", "-n=Taxi", "-b"},
+			param: api.AddExpenseParam{
+				Workspace:  "w",
+				UserID:     "u",
+				ProjectID:  "p1",
+				TaskID:     "t1",
+				CategoryID: "c1",
+				Date:       time.Date(2023, 7, 25, 0, 0, 0, 0, time.Local),
+				Amount:     42.5,
+				Notes:      "Taxi",
+				Billable:   true,
+			},
+		},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.name, func(t *testing.T) {
+			var f cmdutil.Factory
+			called := false
+			if tt.factory != nil {
+				f = tt.factory(t)
+			} else {
+				mf := mocks.NewMockFactory(t)
+				c := mocks.NewMockClient(t)
+				cf := mocks.NewMockConfig(t)
+				mf.On("GetWorkspaceID").Return("w", nil)
+				mf.On("GetUserID").Return("u", nil)
+				mf.On("Client").Return(c, nil)
+				mf.On("Config").Return(cf)
+				cf.On("IsAllowNameForID").Return(false)
+				c.On("AddExpense", mock.Anything, tt.param).
+					Return(dto.Expense{ID: "e1"}, nil)
+				f = mf
+
+				t.Cleanup(func() { assert.True(t, called) })
+			}
+
+			cmd := add.NewCmdAdd(f,
+				func(_ io.Writer, _ *util.OutputFlags, e dto.Expense) error {
+					called = true
+					assert.Equal(t, "e1", e.ID)
+					return nil
+				})
+			cmd.SilenceUsage = true
+			cmd.SetArgs(tt.args)
+
+			_, err := cmd.ExecuteC()
+			if tt.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.Error(t, err)
+			assert.Regexp(t, tt.err, err.Error())
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package del
+
+import (
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdDelete represents the delete command
+func NewCmdDelete(f cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete <expense>",
+		Aliases: []string{"remove", "rm", "del"},
+		Args:    cmdutil.RequiredNamedArgs("expense"),
+		Short:   "Removes an expense from the Clockify workspace",
+		Example: heredoc.Docf(`
+			$ %[1]s 64c1a7e2b1f8a45d2c7e9a01
+		`, "clockify-cli expense delete"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			return c.DeleteExpense(cmd.Context(), api.DeleteExpenseParam{
+				Workspace: w,
+				ExpenseID: strings.TrimSpace(args[0]),
+			})
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,24 @@
+package expense
+
+import (
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/expense/add"
+	del "github.com/lucassabreu/clockify-cli/pkg/cmd/expense/delete"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/expense/list"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdExpense represents the expense command
+func NewCmdExpense(f cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "expense",
+		Aliases: []string{"expenses"},
+		Short:   "Work with Clockify expenses",
+	}
+
+	cmd.AddCommand(list.NewCmdList(f, nil))
+	cmd.AddCommand(add.NewCmdAdd(f, nil))
+	cmd.AddCommand(del.NewCmdDelete(f))
+
+	return cmd
+}
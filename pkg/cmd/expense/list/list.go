@@ -0,0 +1,81 @@
+package list
+
+import (
+	"io"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/expense/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdList represents the list command
+func NewCmdList(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, []dto.Expense) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	var all bool
+	pagination := api.AllPages()
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Args:    cobra.ExactArgs(0),
+		Short:   "List expenses of the user on a Clockify workspace",
+		Example: heredoc.Docf(`
+			$ %[1]s
+			+--------------------------+------------+--------------------------+--------------------------+--------+-------+
+			|            ID            |    DATE    |         PROJECT          |         CATEGORY         | AMOUNT | NOTES |
+			+--------------------------+------------+--------------------------+--------------------------+--------+-------+
+			| 64c1a7e2b1f8a45d2c7e9a01 | 2023-07-26 | 621948458cb9606d934ebb1c | 64c1a6f0b1f8a45d2c7e99f0 |  42.50 | Taxi  |
+			+--------------------------+------------+--------------------------+--------------------------+--------+-------+
+
+			$ %[1]s --all --quiet
+			64c1a7e2b1f8a45d2c7e9a01
+			64c1a8f3b1f8a45d2c7e9a02
+		`, "clockify-cli expense list"),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			p := api.GetExpensesParam{PaginationParam: pagination}
+
+			var err error
+			if p.Workspace, err = f.GetWorkspaceID(); err != nil {
+				return err
+			}
+
+			if !all {
+				if p.UserID, err = f.GetUserID(); err != nil {
+					return err
+				}
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			es, err := c.GetExpenses(cmd.Context(), p)
+			if err != nil {
+				return err
+			}
+
+			if report != nil {
+				return report(cmd.OutOrStdout(), &of, es)
+			}
+
+			return util.Report(es, cmd.OutOrStdout(), of)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&all, "all", "a", false,
+		"list the expenses of all users of the workspace")
+	util.AddReportFlags(cmd, &of)
+	cmdutil.AddPaginationFlags(cmd.Flags(), &pagination)
+
+	return cmd
+}
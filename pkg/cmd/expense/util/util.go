@@ -0,0 +1,62 @@
+package util
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	output "github.com/lucassabreu/clockify-cli/pkg/output/expense"
+	"github.com/spf13/cobra"
+)
+
+// OutputFlags sets how to print out a list of expenses
+type OutputFlags struct {
+	Format string
+	JSON   bool
+	YAML   bool
+	Quiet  bool
+}
+
+func (of OutputFlags) Check() error {
+	return cmdutil.XorFlag(map[string]bool{
+		"format": of.Format != "",
+		"json":   of.JSON,
+		"yaml":   of.YAML,
+		"quiet":  of.Quiet,
+	})
+}
+
+// AddReportFlags adds the default output flags for expenses
+func AddReportFlags(cmd *cobra.Command, of *OutputFlags) {
+	cmd.Flags().StringVarP(&of.Format, "format", "f", "",
+		"golang text/template format to be applied on each Expense")
+	cmdutil.AddTemplateSourceFlags(cmd.Flags())
+	cmd.Flags().BoolVarP(&of.JSON, "json", "j", false, "print as JSON")
+	cmd.Flags().BoolVar(&of.YAML, "yaml", false, "print as YAML")
+	cmd.Flags().BoolVarP(&of.Quiet, "quiet", "q", false, "only display ids")
+}
+
+// Report prints out the expenses
+func Report(es []dto.Expense, out io.Writer, of OutputFlags) error {
+	switch {
+	case of.JSON:
+		return output.ExpensesJSONPrint(es, out)
+	case of.YAML:
+		return output.ExpensesYAMLPrint(es, out)
+	case of.Format != "":
+		return output.ExpensePrintWithTemplate(of.Format)(es, out)
+	case of.Quiet:
+		return output.ExpensePrintQuietly(es, out)
+	default:
+		return output.ExpensePrint(es, out)
+	}
+}
+
+// ReportOne prints out a single expense
+func ReportOne(e dto.Expense, out io.Writer, of OutputFlags) error {
+	if of.JSON {
+		return output.ExpenseJSONPrint(e, out)
+	}
+
+	return Report([]dto.Expense{e}, out, of)
+}
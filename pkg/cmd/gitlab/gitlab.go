@@ -0,0 +1,34 @@
+package gitlabcmd
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/gitlab/spend"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdGitLab represents the gitlab command
+func NewCmdGitLab(f cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gitlab",
+		Short: "Integrates the time entries with GitLab merge requests",
+		Long: heredoc.Docf(`
+			Integrates the time entries with the merge requests and issues
+			of GitLab.
+
+			Time entries started with "in --gl" use the title of the merge
+			request or issue as description, with its reference, then
+			"gitlab spend push" adds their duration to the time spent on the
+			merge requests.
+
+			To use a self-managed GitLab or read private projects use:
+			clockify-cli config set %s https://gitlab.acme.com
+			clockify-cli config set %s <personal-access-token>
+		`, cmdutil.CONF_GITLAB_URL, cmdutil.CONF_GITLAB_TOKEN),
+		Args: cobra.NoArgs,
+	}
+
+	cmd.AddCommand(spend.NewCmdSpend(f))
+
+	return cmd
+}
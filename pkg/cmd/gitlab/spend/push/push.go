@@ -0,0 +1,189 @@
+package push
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/gitlab"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdPush represents the gitlab spend push command
+func NewCmdPush(f cmdutil.Factory) *cobra.Command {
+	var since, until string
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "push",
+		Short: "Adds the duration of the time entries to their merge requests",
+		Long: heredoc.Doc(`
+			Adds a note with a "/spend" quick action to the GitLab merge
+			request of each time entry of the user started between --since
+			and --until, adding its duration (rounded to minutes) to the time
+			spent on it.
+
+			The merge request is the first one referenced on the description
+			(like: group/project!42), as added by "in --gl".
+
+			The notes reference the time entry, so the time entries already
+			pushed are skipped when running it again.
+		`) + "\n" +
+			"When setting `--since` and `--until` you can use any of the " +
+			"following formats:\n" +
+			util.HelpDateTimeFormats,
+		Example: heredoc.Docf(`
+			$ %[1]s --since yesterday
+			acme/cli!42: spent 1h30m of 62ae29fdc22de9759e73d343
+			acme/web!7: spent 25m of 62ae29fdc22de9759e73d344
+
+			# check what would be spent this week
+			$ %[1]s --since "last monday" --dry-run
+			acme/cli!42: would spend 1h30m of 62ae29fdc22de9759e73d343
+		`, "clockify-cli gitlab spend push"),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			s, err := timehlp.ConvertToTime(since)
+			if err != nil {
+				return cmdutil.FlagErrorWrap(
+					errors.Wrap(err, "invalid --since"))
+			}
+
+			u, err := timehlp.ConvertToTime(until)
+			if err != nil {
+				return cmdutil.FlagErrorWrap(
+					errors.Wrap(err, "invalid --until"))
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			userID, err := f.GetUserID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			tes, err := c.GetUsersHydratedTimeEntries(
+				cmd.Context(),
+				api.GetUserTimeEntriesParam{
+					Workspace:       w,
+					UserID:          userID,
+					Start:           &s,
+					End:             &u,
+					PaginationParam: api.AllPages(),
+				})
+			if err != nil {
+				return err
+			}
+
+			gc := cmdutil.GitLabClient(f.Config())
+			out := cmd.OutOrStdout()
+			stderr := cmd.ErrOrStderr()
+			notes := map[string][]string{}
+			failed, total := 0, 0
+			// the API returns the newest first
+			for i := len(tes) - 1; i >= 0; i-- {
+				te := tes[i]
+				r, ok := gitlab.FindMergeRequest(te.Description)
+				if !ok || te.TimeInterval.End == nil {
+					continue
+				}
+
+				d := te.TimeInterval.End.Sub(te.TimeInterval.Start).
+					Round(time.Minute)
+				if d < time.Minute {
+					fmt.Fprintf(stderr,
+						"%s: %s is shorter than a minute, skipped\n",
+						r, te.ID)
+					continue
+				}
+
+				ns, ok := notes[r.String()]
+				if !ok {
+					if ns, err = gc.Notes(r); err != nil {
+						failed++
+						fmt.Fprintf(stderr, "%s: %s\n", r, err)
+						continue
+					}
+					notes[r.String()] = ns
+				}
+
+				if wasSpent(ns, te.ID) {
+					continue
+				}
+
+				total++
+				if dryRun {
+					fmt.Fprintf(out, "%s: would spend %s of %s\n",
+						r, gitlab.FormatDuration(d), te.ID)
+					continue
+				}
+
+				if err := gc.Spend(r, note(te), d,
+					te.TimeInterval.Start.Local()); err != nil {
+					failed++
+					fmt.Fprintf(stderr, "%s: failed to spend %s: %s\n",
+						r, te.ID, err)
+					continue
+				}
+
+				fmt.Fprintf(out, "%s: spent %s of %s\n",
+					r, gitlab.FormatDuration(d), te.ID)
+			}
+
+			if failed > 0 {
+				return errors.Errorf(
+					"%d time entries failed to be spent on GitLab", failed)
+			}
+
+			if total == 0 {
+				fmt.Fprintln(stderr, "there are no time entries to be spent")
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&since, "since", "today",
+		"spends the time entries started since")
+	cmd.Flags().StringVar(&until, "until", timehlp.NowTimeFormat,
+		"spends the time entries started until")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false,
+		"only prints what would be spent")
+
+	return cmd
+}
+
+func marker(id string) string {
+	return "[clockify:" + id + "]"
+}
+
+func note(te dto.TimeEntry) string {
+	return "Time tracked on Clockify: " + te.Description + " " +
+		marker(te.ID)
+}
+
+func wasSpent(ns []string, id string) bool {
+	m := marker(id)
+	for _, n := range ns {
+		if strings.Contains(n, m) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,115 @@
+package push_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/gitlab/spend/push"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCmdPush(t *testing.T) {
+	spent := map[string][]string{}
+	s := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method + " " + r.URL.Path {
+			case "GET /api/v4/projects/acme/cli/merge_requests/42/notes":
+				_, _ = w.Write([]byte(`[{"body":"Time tracked on ` +
+					`Clockify: docs [clockify:te1]\n\n/spend 1h 2022-06-01"}]`))
+			case "GET /api/v4/projects/acme/web/merge_requests/7/notes":
+				_, _ = w.Write([]byte(`[]`))
+			case "POST /api/v4/projects/acme/cli/merge_requests/42/notes",
+				"POST /api/v4/projects/acme/web/merge_requests/7/notes":
+				var b map[string]string
+				_ = json.NewDecoder(r.Body).Decode(&b)
+				spent[r.URL.Path] = append(spent[r.URL.Path], b["body"])
+				_, _ = w.Write([]byte(`{"id":1}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+	defer s.Close()
+
+	t.Setenv("GITLAB_TOKEN", "")
+	f := mocks.NewMockFactory(t)
+	f.EXPECT().GetWorkspaceID().Return("w", nil)
+	f.EXPECT().GetUserID().Return("u", nil)
+
+	conf := mocks.NewMockConfig(t)
+	f.EXPECT().Config().Return(conf)
+	conf.EXPECT().GetString(cmdutil.CONF_GITLAB_URL).Return(s.URL)
+	conf.EXPECT().GetString(cmdutil.CONF_GITLAB_TOKEN).Return("")
+
+	c := mocks.NewMockClient(t)
+	f.EXPECT().Client().Return(c, nil)
+
+	at := func(h, m int) time.Time {
+		return time.Date(2022, 6, 1, h, m, 0, 0, time.Local)
+	}
+	ti := func(s, e time.Time) dto.TimeInterval {
+		return dto.NewTimeInterval(s, &e)
+	}
+
+	c.EXPECT().GetUsersHydratedTimeEntries(mock.Anything, mock.MatchedBy(
+		func(p api.GetUserTimeEntriesParam) bool {
+			return p.Workspace == "w" && p.UserID == "u"
+		})).
+		Return([]dto.TimeEntry{
+			{
+				ID:           "te5",
+				Description:  "running (acme/cli!42)",
+				TimeInterval: dto.NewTimeInterval(at(15, 0), nil),
+			},
+			{
+				ID:           "te4",
+				Description:  "issue (acme/cli#3)",
+				TimeInterval: ti(at(13, 0), at(14, 0)),
+			},
+			{
+				ID:           "te3",
+				Description:  "Fix menu (acme/web!7)",
+				TimeInterval: ti(at(11, 0), at(11, 25)),
+			},
+			{
+				ID:           "te2",
+				Description:  "more docs (acme/cli!42)",
+				TimeInterval: ti(at(10, 0), at(11, 30)),
+			},
+			{
+				ID:           "te1",
+				Description:  "docs (acme/cli!42)",
+				TimeInterval: ti(at(9, 0), at(10, 0)),
+			},
+		}, nil)
+
+	cmd := push.NewCmdPush(f)
+	out := bytes.NewBufferString("")
+	cmd.SetOut(out)
+	cmd.SetErr(bytes.NewBufferString(""))
+	cmd.SetArgs([]string{"--since", "2022-06-01 00:00",
+		"--until", "2022-06-02 00:00"})
+
+	_, err := cmd.ExecuteC()
+	assert.NoError(t, err)
+	assert.Equal(t, "acme/cli!42: spent 1h30m of te2\n"+
+		"acme/web!7: spent 25m of te3\n", out.String())
+	assert.Equal(t, map[string][]string{
+		"/api/v4/projects/acme/cli/merge_requests/42/notes": {
+			"Time tracked on Clockify: more docs (acme/cli!42) " +
+				"[clockify:te2]\n\n/spend 1h30m 2022-06-01",
+		},
+		"/api/v4/projects/acme/web/merge_requests/7/notes": {
+			"Time tracked on Clockify: Fix menu (acme/web!7) " +
+				"[clockify:te3]\n\n/spend 25m 2022-06-01",
+		},
+	}, spent)
+}
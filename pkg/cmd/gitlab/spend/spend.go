@@ -0,0 +1,20 @@
+package spend
+
+import (
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/gitlab/spend/push"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdSpend represents the gitlab spend command
+func NewCmdSpend(f cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "spend",
+		Short: "Mirrors the time entries into the time spent on merge requests",
+		Args:  cobra.NoArgs,
+	}
+
+	cmd.AddCommand(push.NewCmdPush(f))
+
+	return cmd
+}
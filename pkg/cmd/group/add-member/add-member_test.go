@@ -0,0 +1,62 @@
+package addmember_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	addmember "github.com/lucassabreu/clockify-cli/pkg/cmd/group/add-member"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/group/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCmdAddMember(t *testing.T) {
+	f := mocks.NewMockFactory(t)
+	c := mocks.NewMockClient(t)
+	cf := mocks.NewMockConfig(t)
+	f.On("GetWorkspaceID").Return("w", nil)
+	f.On("Client").Return(c, nil)
+	f.On("Config").Return(cf)
+	cf.On("IsAllowNameForID").Return(true)
+
+	c.On("GetUserGroups", mock.Anything, api.GetUserGroupsParam{
+		Workspace:       "w",
+		PaginationParam: api.AllPages(),
+	}).Return([]dto.UserGroup{
+		{ID: "g1", Name: "Designers"},
+		{ID: "g2", Name: "Developers"},
+	}, nil)
+
+	c.On("WorkspaceUsers", mock.Anything, api.WorkspaceUsersParam{
+		Workspace:       "w",
+		PaginationParam: api.AllPages(),
+	}).Return([]dto.User{
+		{ID: "u1", Name: "John", Email: "john@example.com"},
+		{ID: "u2", Name: "Mary", Email: "mary@example.com"},
+	}, nil)
+
+	c.On("AddUserToGroup", mock.Anything, api.UserGroupMemberParam{
+		Workspace: "w", UserGroupID: "g2", UserID: "u1",
+	}).Return(dto.UserGroup{ID: "g2", UserIDs: []string{"u1"}}, nil).Once()
+	c.On("AddUserToGroup", mock.Anything, api.UserGroupMemberParam{
+		Workspace: "w", UserGroupID: "g2", UserID: "u2",
+	}).Return(dto.UserGroup{ID: "g2", UserIDs: []string{"u1", "u2"}}, nil).
+		Once()
+
+	called := false
+	cmd := addmember.NewCmdAddMember(f,
+		func(_ io.Writer, _ *util.OutputFlags, g dto.UserGroup) error {
+			called = true
+			assert.Equal(t, []string{"u1", "u2"}, g.UserIDs)
+			return nil
+		})
+	cmd.SilenceUsage = true
+	cmd.SetArgs([]string{"dev", "john", "mary", "john"})
+
+	_, err := cmd.ExecuteC()
+	assert.NoError(t, err)
+	assert.True(t, called)
+}
@@ -0,0 +1,64 @@
+package add
+
+import (
+	"io"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/group/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdAdd represents the add command
+func NewCmdAdd(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, dto.UserGroup) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	cmd := &cobra.Command{
+		Use:     "add <name>",
+		Aliases: []string{"new", "create"},
+		Args:    cmdutil.RequiredNamedArgs("name"),
+		Short:   "Creates a user group on the Clockify workspace",
+		Example: heredoc.Docf(`
+			$ %[1]s Developers -q
+			64c5f1c4d6a7b8c9d0e1f2a3
+		`, "clockify-cli group add"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			g, err := c.AddUserGroup(cmd.Context(), api.AddUserGroupParam{
+				Workspace: w,
+				Name:      strings.TrimSpace(args[0]),
+			})
+			if err != nil {
+				return err
+			}
+
+			if report != nil {
+				return report(cmd.OutOrStdout(), &of, g)
+			}
+
+			return util.ReportOne(g, cmd.OutOrStdout(), of)
+		},
+	}
+
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
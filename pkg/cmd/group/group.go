@@ -0,0 +1,26 @@
+package group
+
+import (
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/group/add"
+	addmember "github.com/lucassabreu/clockify-cli/pkg/cmd/group/add-member"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/group/list"
+	removemember "github.com/lucassabreu/clockify-cli/pkg/cmd/group/remove-member"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdGroup represents the group command
+func NewCmdGroup(f cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "group",
+		Aliases: []string{"groups", "user-group"},
+		Short:   "Work with Clockify user groups",
+	}
+
+	cmd.AddCommand(list.NewCmdList(f, nil))
+	cmd.AddCommand(add.NewCmdAdd(f, nil))
+	cmd.AddCommand(addmember.NewCmdAddMember(f, nil))
+	cmd.AddCommand(removemember.NewCmdRemoveMember(f, nil))
+
+	return cmd
+}
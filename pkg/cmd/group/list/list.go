@@ -0,0 +1,77 @@
+package list
+
+import (
+	"io"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/group/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdList represents the list command
+func NewCmdList(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, []dto.UserGroup) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	var name string
+	pagination := api.AllPages()
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Args:    cobra.ExactArgs(0),
+		Short:   "List user groups of a Clockify workspace",
+		Example: heredoc.Docf(`
+			$ %[1]s
+			+--------------------------+------------+---------+
+			|            ID            |    NAME    | MEMBERS |
+			+--------------------------+------------+---------+
+			| 64c5f1c4d6a7b8c9d0e1f2a3 | Developers | 4       |
+			| 64c5f1c4d6a7b8c9d0e1f2a4 | Designers  | 2       |
+			+--------------------------+------------+---------+
+
+			$ %[1]s --name dev --quiet
+			64c5f1c4d6a7b8c9d0e1f2a3
+		`, "clockify-cli group list"),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			gs, err := c.GetUserGroups(cmd.Context(), api.GetUserGroupsParam{
+				Workspace:       w,
+				Name:            name,
+				PaginationParam: pagination,
+			})
+			if err != nil {
+				return err
+			}
+
+			if report != nil {
+				return report(cmd.OutOrStdout(), &of, gs)
+			}
+
+			return util.Report(gs, cmd.OutOrStdout(), of)
+		},
+	}
+
+	cmd.Flags().StringVarP(&name, "name", "n", "",
+		"will be used to filter the groups by name")
+	util.AddReportFlags(cmd, &of)
+	cmdutil.AddPaginationFlags(cmd.Flags(), &pagination)
+
+	return cmd
+}
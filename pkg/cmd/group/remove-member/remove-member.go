@@ -0,0 +1,55 @@
+package removemember
+
+import (
+	"io"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/group/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdRemoveMember represents the remove-member command
+func NewCmdRemoveMember(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, dto.UserGroup) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	cmd := &cobra.Command{
+		Use:   "remove-member <group> <user>...",
+		Args:  cmdutil.RequiredNamedArgs("group", "user"),
+		Short: "Removes users from a user group",
+		Example: heredoc.Docf(`
+			$ %[1]s Developers john@example.com -q
+			64c5f1c4d6a7b8c9d0e1f2a3
+		`, "clockify-cli group remove-member"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			g, err := util.ChangeMembers(ctx, f, args[0], args[1:],
+				func(c api.Client, p api.UserGroupMemberParam) (
+					dto.UserGroup, error) {
+					return c.RemoveUserFromGroup(ctx, p)
+				})
+			if err != nil {
+				return err
+			}
+
+			if report != nil {
+				return report(cmd.OutOrStdout(), &of, g)
+			}
+
+			return util.ReportOne(g, cmd.OutOrStdout(), of)
+		},
+	}
+
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
@@ -0,0 +1,114 @@
+package util
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	output "github.com/lucassabreu/clockify-cli/pkg/output/user-group"
+	"github.com/lucassabreu/clockify-cli/pkg/search"
+	"github.com/lucassabreu/clockify-cli/strhlp"
+	"github.com/spf13/cobra"
+)
+
+// OutputFlags sets how to print out a list of user groups
+type OutputFlags struct {
+	Format string
+	JSON   bool
+	YAML   bool
+	Quiet  bool
+}
+
+func (of OutputFlags) Check() error {
+	return cmdutil.XorFlag(map[string]bool{
+		"format": of.Format != "",
+		"json":   of.JSON,
+		"yaml":   of.YAML,
+		"quiet":  of.Quiet,
+	})
+}
+
+// AddReportFlags adds the default output flags for user groups
+func AddReportFlags(cmd *cobra.Command, of *OutputFlags) {
+	cmd.Flags().StringVarP(&of.Format, "format", "f", "",
+		"golang text/template format to be applied on each User Group")
+	cmdutil.AddTemplateSourceFlags(cmd.Flags())
+	cmd.Flags().BoolVarP(&of.JSON, "json", "j", false, "print as JSON")
+	cmd.Flags().BoolVar(&of.YAML, "yaml", false, "print as YAML")
+	cmd.Flags().BoolVarP(&of.Quiet, "quiet", "q", false, "only display ids")
+}
+
+// Report prints out the user groups
+func Report(gs []dto.UserGroup, out io.Writer, of OutputFlags) error {
+	switch {
+	case of.JSON:
+		return output.UserGroupsJSONPrint(gs, out)
+	case of.YAML:
+		return output.UserGroupsYAMLPrint(gs, out)
+	case of.Format != "":
+		return output.UserGroupPrintWithTemplate(of.Format)(gs, out)
+	case of.Quiet:
+		return output.UserGroupPrintQuietly(gs, out)
+	default:
+		return output.UserGroupPrint(gs, out)
+	}
+}
+
+// ReportOne prints out a single user group
+func ReportOne(g dto.UserGroup, out io.Writer, of OutputFlags) error {
+	if of.JSON {
+		return output.UserGroupJSONPrint(g, out)
+	}
+
+	return Report([]dto.UserGroup{g}, out, of)
+}
+
+// ChangeMembers looks up the group and users informed, and calls fn for
+// each user, returning the group as it was after the last change
+func ChangeMembers(
+	ctx context.Context,
+	f cmdutil.Factory,
+	group string,
+	users []string,
+	fn func(api.Client, api.UserGroupMemberParam) (dto.UserGroup, error),
+) (g dto.UserGroup, err error) {
+	p := api.UserGroupMemberParam{
+		UserGroupID: strings.TrimSpace(group),
+	}
+
+	if p.Workspace, err = f.GetWorkspaceID(); err != nil {
+		return g, err
+	}
+
+	c, err := f.Client()
+	if err != nil {
+		return g, err
+	}
+
+	users = strhlp.Unique(strhlp.Map(strings.TrimSpace, users))
+	if f.Config().IsAllowNameForID() {
+		if p.UserGroupID, err = search.GetUserGroupByName(
+			ctx,
+			c, p.Workspace, p.UserGroupID); err != nil {
+			return g, err
+		}
+
+		if users, err = search.GetUsersByName(
+			ctx,
+			c, p.Workspace, users); err != nil {
+			return g, err
+		}
+	}
+
+	for _, u := range users {
+		p.UserID = u
+		if g, err = fn(c, p); err != nil {
+			return g, err
+		}
+	}
+
+	return g, nil
+}
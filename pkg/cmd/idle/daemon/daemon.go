@@ -0,0 +1,182 @@
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/idle"
+	"github.com/lucassabreu/clockify-cli/pkg/notify"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdDaemon represents the idle daemon command
+func NewCmdDaemon(f cmdutil.Factory) *cobra.Command {
+	interval := 30 * time.Second
+	threshold := 5 * time.Minute
+	var notifyUser bool
+
+	cmd := &cobra.Command{
+		Use:   "daemon",
+		Short: "Records the periods the user was away from the computer",
+		Long: heredoc.Doc(`
+			Checks for how long the keyboard and mouse were not used on each
+			--interval, and records the periods longer than --threshold the
+			user was away, so "out --idle-check" can trim them from the time
+			entry being stopped. The periods are kept for a week.
+
+			With --notify, a desktop notification is shown when the user is
+			back and there is a time entry running.
+
+			It runs until stopped with Ctrl+C, so start it with the session of
+			the desktop (like on the autostart applications).
+		`),
+		Example: heredoc.Docf(`
+			$ %[1]s --notify
+			idle for 25m30s, from 2022-06-19 12:01:00 until 2022-06-19 12:26:30
+
+			# record only the periods longer than 15 minutes
+			$ %[1]s --threshold 15m
+		`, "clockify-cli idle daemon"),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+
+			if interval <= 0 {
+				return cmdutil.FlagErrorWrap(
+					errors.New("`interval` must be a positive duration"))
+			}
+
+			if threshold < interval {
+				return cmdutil.FlagErrorWrap(errors.New(
+					"`threshold` can't be shorter than `interval`"))
+			}
+
+			l, err := cmdutil.IdleLog()
+			if err != nil {
+				return err
+			}
+
+			if _, err := idle.Current(); err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			stderr := cmd.ErrOrStderr()
+			return run(ctx, &idle.Watcher{Min: threshold},
+				interval, idle.Current, stderr,
+				func(p idle.Period) error {
+					fmt.Fprintf(out, "idle for %s, from %s until %s\n",
+						p.Duration().Round(time.Second),
+						p.Start.Format(timehlp.FullTimeFormat),
+						p.End.Format(timehlp.FullTimeFormat))
+
+					if err := l.Add(p); err != nil {
+						return err
+					}
+
+					if notifyUser {
+						notifyRunning(ctx, f, p, stderr)
+					}
+
+					return nil
+				})
+		},
+	}
+
+	cmd.Flags().DurationVar(&interval, "interval", interval,
+		"how often the idle time is checked")
+	cmd.Flags().DurationVar(&threshold, "threshold", threshold,
+		"how long the user must be idle to be taken as away")
+	cmd.Flags().BoolVar(&notifyUser, "notify", false,
+		"shows a desktop notification when the user is back and there is "+
+			"a time entry running")
+
+	return cmd
+}
+
+// run observes the idle time on each interval, until the context is done,
+// calling back with the periods the user was away
+func run(
+	ctx context.Context, w *idle.Watcher, interval time.Duration,
+	current func() (time.Duration, error), stderr io.Writer,
+	back func(idle.Period) error,
+) error {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case now := <-t.C:
+			d, err := current()
+			if err != nil {
+				// a failure now and then is not a reason to stop
+				fmt.Fprintln(stderr, err)
+				continue
+			}
+
+			p, ok := w.Observe(now, d)
+			if !ok {
+				continue
+			}
+
+			if err := back(p); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// notifyRunning shows a desktop notification about the period, when there
+// is a time entry running since before it ended
+func notifyRunning(
+	ctx context.Context, f cmdutil.Factory, p idle.Period, stderr io.Writer) {
+	te, err := running(ctx, f)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return
+	}
+
+	if te == nil || !te.TimeInterval.Start.Before(p.End) {
+		return
+	}
+
+	if err := notify.Desktop("Clockify CLI", fmt.Sprintf(
+		"You were away for %s while a time entry was running, use "+
+			"\"clockify-cli out --idle-check\" to trim it",
+		p.Duration().Round(time.Minute))); err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+}
+
+func running(
+	ctx context.Context, f cmdutil.Factory) (*dto.TimeEntryImpl, error) {
+	w, err := f.GetWorkspaceID()
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := f.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := f.Client()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetTimeEntryInProgress(ctx, api.GetTimeEntryInProgressParam{
+		Workspace: w,
+		UserID:    u,
+	})
+}
@@ -0,0 +1,28 @@
+package idlecmd
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/idle/daemon"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdIdle represents the idle command
+func NewCmdIdle(f cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "idle",
+		Short: "Keeps track of the time away from the computer",
+		Long: heredoc.Doc(`
+			Keeps track of the periods the keyboard and mouse were not used,
+			so they can be trimmed from the time entries by "out --idle-check".
+
+			The idle time is read with ioreg on macOS, and with xprintidle
+			(X11) or the idle monitor of GNOME on Linux.
+		`),
+		Args: cobra.NoArgs,
+	}
+
+	cmd.AddCommand(daemon.NewCmdDaemon(f))
+
+	return cmd
+}
@@ -0,0 +1,34 @@
+package jiracmd
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/jira/worklog"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdJira represents the jira command
+func NewCmdJira(f cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "jira",
+		Short: "Integrates the time entries with Jira issues",
+		Long: heredoc.Docf(`
+			Integrates the time entries with the issues of a Jira instance.
+
+			Time entries started with "in --jira" or "manual --jira" use the
+			summary of the issue as description and are tagged with its key,
+			then "jira worklog push" logs their duration on the issue.
+
+			To set up the integration use:
+			clockify-cli config set %s https://acme.atlassian.net
+			clockify-cli config set %s me@acme.com
+			clockify-cli config set %s <api-token>
+		`, cmdutil.CONF_JIRA_URL, cmdutil.CONF_JIRA_USER,
+			cmdutil.CONF_JIRA_TOKEN),
+		Args: cobra.NoArgs,
+	}
+
+	cmd.AddCommand(worklog.NewCmdWorklog(f))
+
+	return cmd
+}
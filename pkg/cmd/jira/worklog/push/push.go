@@ -0,0 +1,212 @@
+package push
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/jira"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdPush represents the jira worklog push command
+func NewCmdPush(f cmdutil.Factory) *cobra.Command {
+	var since, until string
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "push",
+		Short: "Logs the duration of the time entries on their Jira issues",
+		Long: heredoc.Docf(`
+			Creates a worklog on Jira for each time entry of the user started
+			between --since and --until that is about a Jira issue, with its
+			duration (rounded to minutes) and description.
+
+			The issue is the tag with the key of an issue (as added by
+			"in --jira"), or the first issue key found on the description.
+
+			The worklogs reference the time entry on their comment, so the
+			time entries already pushed are skipped when running it again.
+
+			Jira is set with "%s", "%s" and "%s".
+		`, cmdutil.CONF_JIRA_URL, cmdutil.CONF_JIRA_USER,
+			cmdutil.CONF_JIRA_TOKEN) + "\n" +
+			"When setting `--since` and `--until` you can use any of the " +
+			"following formats:\n" +
+			util.HelpDateTimeFormats,
+		Example: heredoc.Docf(`
+			$ %[1]s --since yesterday
+			PROJ-123: logged 1h30m0s of 62ae29fdc22de9759e73d343
+			PROJ-7: logged 25m0s of 62ae29fdc22de9759e73d344
+
+			# check what would be logged this week
+			$ %[1]s --since "last monday" --dry-run
+			PROJ-123: would log 1h30m0s of 62ae29fdc22de9759e73d343
+		`, "clockify-cli jira worklog push"),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			s, err := timehlp.ConvertToTime(since)
+			if err != nil {
+				return cmdutil.FlagErrorWrap(
+					errors.Wrap(err, "invalid --since"))
+			}
+
+			u, err := timehlp.ConvertToTime(until)
+			if err != nil {
+				return cmdutil.FlagErrorWrap(
+					errors.Wrap(err, "invalid --until"))
+			}
+
+			jc, err := cmdutil.JiraClient(f.Config())
+			if err != nil {
+				return err
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			userID, err := f.GetUserID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			tes, err := c.GetUsersHydratedTimeEntries(
+				cmd.Context(),
+				api.GetUserTimeEntriesParam{
+					Workspace:       w,
+					UserID:          userID,
+					Start:           &s,
+					End:             &u,
+					PaginationParam: api.AllPages(),
+				})
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			stderr := cmd.ErrOrStderr()
+			logged := map[string][]jira.Worklog{}
+			failed, total := 0, 0
+			// the API returns the newest first
+			for i := len(tes) - 1; i >= 0; i-- {
+				te := tes[i]
+				key, ok := issueKey(te)
+				if !ok || te.TimeInterval.End == nil {
+					continue
+				}
+
+				d := te.TimeInterval.End.Sub(te.TimeInterval.Start).
+					Round(time.Minute)
+				if d < time.Minute {
+					fmt.Fprintf(stderr,
+						"%s: %s is shorter than a minute, skipped\n",
+						key, te.ID)
+					continue
+				}
+
+				ws, ok := logged[key]
+				if !ok {
+					if ws, err = jc.Worklogs(key); err != nil {
+						failed++
+						fmt.Fprintf(stderr, "%s: %s\n", key, err)
+						continue
+					}
+					logged[key] = ws
+				}
+
+				if wasLogged(ws, te.ID) {
+					continue
+				}
+
+				total++
+				if dryRun {
+					fmt.Fprintf(out, "%s: would log %s of %s\n",
+						key, d, te.ID)
+					continue
+				}
+
+				if _, err := jc.AddWorklog(key, jira.Worklog{
+					Started:   te.TimeInterval.Start,
+					TimeSpent: d,
+					Comment:   comment(te),
+				}); err != nil {
+					failed++
+					fmt.Fprintf(stderr, "%s: failed to log %s: %s\n",
+						key, te.ID, err)
+					continue
+				}
+
+				fmt.Fprintf(out, "%s: logged %s of %s\n", key, d, te.ID)
+			}
+
+			if failed > 0 {
+				return errors.Errorf(
+					"%d time entries failed to be logged on Jira", failed)
+			}
+
+			if total == 0 {
+				fmt.Fprintln(stderr, "there are no time entries to be logged")
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&since, "since", "today",
+		"logs the time entries started since")
+	cmd.Flags().StringVar(&until, "until", timehlp.NowTimeFormat,
+		"logs the time entries started until")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false,
+		"only prints what would be logged")
+
+	return cmd
+}
+
+// issueKey returns the key of the Jira issue of the time entry, from its
+// tags or else its description
+func issueKey(te dto.TimeEntry) (string, bool) {
+	for _, t := range te.Tags {
+		if jira.IsIssueKey(t.Name) {
+			return t.Name, true
+		}
+	}
+
+	return jira.FindIssueKey(te.Description)
+}
+
+func marker(id string) string {
+	return "[clockify:" + id + "]"
+}
+
+func comment(te dto.TimeEntry) string {
+	if te.Description == "" {
+		return marker(te.ID)
+	}
+
+	return te.Description + "\n\n" + marker(te.ID)
+}
+
+func wasLogged(ws []jira.Worklog, id string) bool {
+	m := marker(id)
+	for _, w := range ws {
+		if strings.Contains(w.Comment, m) {
+			return true
+		}
+	}
+
+	return false
+}
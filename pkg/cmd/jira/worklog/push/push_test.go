@@ -0,0 +1,140 @@
+package push_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/jira/worklog/push"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCmdPush(t *testing.T) {
+	added := map[string][]map[string]interface{}{}
+	s := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method + " " + r.URL.Path {
+			case "GET /rest/api/2/issue/PROJ-1/worklog":
+				_, _ = w.Write([]byte(`{"total":1,"worklogs":[
+					{"id":"1","started":"2022-06-01T09:00:00.000+0000",
+					 "timeSpentSeconds":3600,
+					 "comment":"docs\n\n[clockify:te1]"}]}`))
+			case "GET /rest/api/2/issue/PROJ-2/worklog":
+				_, _ = w.Write([]byte(`{"total":0,"worklogs":[]}`))
+			case "POST /rest/api/2/issue/PROJ-1/worklog",
+				"POST /rest/api/2/issue/PROJ-2/worklog":
+				var b map[string]interface{}
+				_ = json.NewDecoder(r.Body).Decode(&b)
+				added[r.URL.Path] = append(added[r.URL.Path], b)
+				_, _ = w.Write([]byte(`{"id":"9",` +
+					`"started":"2022-06-01T09:00:00.000+0000"}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+	defer s.Close()
+
+	f := mocks.NewMockFactory(t)
+	f.EXPECT().GetWorkspaceID().Return("w", nil)
+	f.EXPECT().GetUserID().Return("u", nil)
+
+	conf := mocks.NewMockConfig(t)
+	f.EXPECT().Config().Return(conf)
+	conf.EXPECT().GetString(cmdutil.CONF_JIRA_URL).Return(s.URL)
+	conf.EXPECT().GetString(cmdutil.CONF_JIRA_USER).Return("")
+	conf.EXPECT().GetString(cmdutil.CONF_JIRA_TOKEN).Return("a-pat")
+
+	c := mocks.NewMockClient(t)
+	f.EXPECT().Client().Return(c, nil)
+
+	at := func(h, m int) time.Time {
+		return time.Date(2022, 6, 1, h, m, 0, 0, time.UTC)
+	}
+	ti := func(s, e time.Time) dto.TimeInterval {
+		return dto.NewTimeInterval(s, &e)
+	}
+
+	c.EXPECT().GetUsersHydratedTimeEntries(mock.Anything, mock.MatchedBy(
+		func(p api.GetUserTimeEntriesParam) bool {
+			return p.Workspace == "w" && p.UserID == "u" &&
+				p.Start != nil && p.End != nil
+		})).
+		Return([]dto.TimeEntry{
+			{
+				ID:           "te5",
+				Description:  "running PROJ-1",
+				TimeInterval: dto.NewTimeInterval(at(15, 0), nil),
+			},
+			{
+				ID:           "te4",
+				Description:  "no issue",
+				TimeInterval: ti(at(13, 0), at(14, 0)),
+			},
+			{
+				ID:           "te3",
+				Description:  "review of PROJ-2",
+				TimeInterval: ti(at(11, 0), at(11, 25)),
+			},
+			{
+				ID:           "te2",
+				Description:  "more docs",
+				Tags:         []dto.Tag{{ID: "t1", Name: "PROJ-1"}},
+				TimeInterval: ti(at(10, 0), at(11, 29)),
+			},
+			{
+				ID:           "te1",
+				Description:  "docs",
+				Tags:         []dto.Tag{{ID: "t1", Name: "PROJ-1"}},
+				TimeInterval: ti(at(9, 0), at(10, 0)),
+			},
+		}, nil)
+
+	cmd := push.NewCmdPush(f)
+	out := bytes.NewBufferString("")
+	cmd.SetOut(out)
+	cmd.SetErr(bytes.NewBufferString(""))
+	cmd.SetArgs([]string{"--since", "2022-06-01 00:00", "--until", "2022-06-02 00:00"})
+
+	_, err := cmd.ExecuteC()
+	assert.NoError(t, err)
+	assert.Equal(t, "PROJ-1: logged 1h29m0s of te2\n"+
+		"PROJ-2: logged 25m0s of te3\n", out.String())
+	assert.Equal(t, map[string][]map[string]interface{}{
+		"/rest/api/2/issue/PROJ-1/worklog": {{
+			"started":          "2022-06-01T10:00:00.000+0000",
+			"timeSpentSeconds": float64(89 * 60),
+			"comment":          "more docs\n\n[clockify:te2]",
+		}},
+		"/rest/api/2/issue/PROJ-2/worklog": {{
+			"started":          "2022-06-01T11:00:00.000+0000",
+			"timeSpentSeconds": float64(25 * 60),
+			"comment":          "review of PROJ-2\n\n[clockify:te3]",
+		}},
+	}, added)
+}
+
+func TestCmdPushNeedsJira(t *testing.T) {
+	f := mocks.NewMockFactory(t)
+	conf := mocks.NewMockConfig(t)
+	f.EXPECT().Config().Return(conf)
+	conf.EXPECT().GetString(mock.Anything).Return("")
+
+	cmd := push.NewCmdPush(f)
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	cmd.SetOut(bytes.NewBufferString(""))
+	cmd.SetArgs([]string{})
+
+	_, err := cmd.ExecuteC()
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "jira is not configured")
+	}
+}
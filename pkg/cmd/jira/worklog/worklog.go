@@ -0,0 +1,20 @@
+package worklog
+
+import (
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/jira/worklog/push"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdWorklog represents the jira worklog command
+func NewCmdWorklog(f cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "worklog",
+		Short: "Mirrors the time entries into worklogs of Jira issues",
+		Args:  cobra.NoArgs,
+	}
+
+	cmd.AddCommand(push.NewCmdPush(f))
+
+	return cmd
+}
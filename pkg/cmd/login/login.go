@@ -0,0 +1,91 @@
+package login
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/ui"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdLogin represents the login command
+func NewCmdLogin(f cmdutil.Factory) *cobra.Command {
+	withToken := false
+	cmd := &cobra.Command{
+		Use:   "login",
+		Args:  cobra.NoArgs,
+		Short: "Authenticates the CLI with a token of Clockify",
+		Long: heredoc.Doc(`
+			Authenticates the CLI with a token of Clockify, checking it is
+			valid and setting the default workspace and the user of the token
+			to be used.
+
+			Clockify does not offer a OAuth (or device authorization) flow to
+			third party tools, so the token must be generated on the settings
+			of the user: https://clockify.me/user/settings#generateApiKeyBtn
+
+			The token is kept on the config file, which is readable only by
+			the user. If a profile is in use, it is saved into the profile.
+		`),
+		Example: heredoc.Doc(`
+			$ clockify-cli login
+			$ echo "Yamdas569" | clockify-cli login --with-token
+			$ clockify-cli --profile work login
+		`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			var token string
+			var err error
+			if withToken {
+				var b []byte
+				if b, err = io.ReadAll(cmd.InOrStdin()); err != nil {
+					return err
+				}
+				token = strings.TrimSpace(string(b))
+			} else {
+				token, err = f.UI().AskForText("Token:",
+					ui.WithHelp("Can be generated in the following link, "+
+						"in the API section: "+
+						"https://clockify.me/user/settings#generateApiKeyBtn"))
+				if err != nil {
+					return err
+				}
+			}
+
+			if token == "" {
+				return cmdutil.FlagErrorWrap(errors.New("token is required"))
+			}
+
+			config := f.Config()
+			config.SetString(cmdutil.CONF_TOKEN, token)
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			u, err := c.GetMe(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			config.SetString(cmdutil.CONF_WORKSPACE, u.DefaultWorkspace)
+			config.SetString(cmdutil.CONF_USER_ID, u.ID)
+			if err := config.Save(); err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintf(cmd.OutOrStdout(),
+				"Logged in as %s (%s)\n", u.Name, u.Email)
+			return err
+		},
+	}
+
+	cmd.Flags().BoolVar(&withToken, "with-token", false,
+		"reads the token from the standard input")
+
+	return cmd
+}
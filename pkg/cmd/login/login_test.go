@@ -0,0 +1,95 @@
+package login_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/login"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCmdLogin(t *testing.T) {
+	tts := []struct {
+		name   string
+		stdin  string
+		setup  func(*mocks.MockFactory)
+		err    string
+		output string
+	}{
+		{
+			name:  "empty token",
+			stdin: "\n",
+			err:   "token is required",
+		},
+		{
+			name:  "invalid token",
+			stdin: "wrong\n",
+			setup: func(f *mocks.MockFactory) {
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("SetString", "token", "wrong").Once()
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+				c.On("GetMe", mock.Anything).
+					Return(dto.User{}, errors.New("unauthorized"))
+			},
+			err: "unauthorized",
+		},
+		{
+			name:  "logged in",
+			stdin: "a-token\n",
+			setup: func(f *mocks.MockFactory) {
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("SetString", "token", "a-token").Once()
+				cf.On("SetString", "workspace", "w1").Once()
+				cf.On("SetString", "user.id", "u1").Once()
+				cf.On("Save").Once().Return(nil)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+				c.On("GetMe", mock.Anything).Return(dto.User{
+					ID:               "u1",
+					Name:             "John",
+					Email:            "john@example.com",
+					DefaultWorkspace: "w1",
+				}, nil)
+			},
+			output: "Logged in as John (john@example.com)\n",
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			f := mocks.NewMockFactory(t)
+			if tt.setup != nil {
+				tt.setup(f)
+			}
+
+			cmd := login.NewCmdLogin(f)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+			cmd.SetArgs([]string{"--with-token"})
+			cmd.SetIn(strings.NewReader(tt.stdin))
+
+			out := bytes.NewBufferString("")
+			cmd.SetOut(out)
+
+			_, err := cmd.ExecuteC()
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.output, out.String())
+		})
+	}
+}
@@ -52,6 +52,8 @@ func NewCmdAdd(
 
 		`, "clockify-cli project add"),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
 			if err := of.Check(); err != nil {
 				return err
 			}
@@ -76,6 +78,7 @@ func NewCmdAdd(
 
 			if p.ClientId != "" && f.Config().IsAllowNameForID() {
 				cs, err := search.GetClientsByName(
+					ctx,
 					c, p.Workspace, []string{p.ClientId})
 				if err != nil {
 					return err
@@ -91,7 +94,7 @@ func NewCmdAdd(
 				p.Color = "#" + hex.EncodeToString(bytes)
 			}
 
-			project, err := c.AddProject(p)
+			project, err := c.AddProject(ctx, p)
 			if err != nil {
 				return err
 			}
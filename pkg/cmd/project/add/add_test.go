@@ -86,7 +86,7 @@ func TestCmdAdd(t *testing.T) {
 				f.On("Config").Return(cf)
 				cf.On("IsAllowNameForID").Return(true)
 
-				c.On("GetClients", api.GetClientsParam{
+				c.On("GetClients", mock.Anything, api.GetClientsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).
@@ -104,7 +104,7 @@ func TestCmdAdd(t *testing.T) {
 				f.On("GetWorkspaceID").
 					Return("w", nil)
 				f.On("Client").Return(c, nil)
-				c.On("AddProject", api.AddProjectParam{
+				c.On("AddProject", mock.Anything, api.AddProjectParam{
 					Workspace: "w",
 					Name:      "error",
 				}).
@@ -133,13 +133,13 @@ func TestCmdAdd(t *testing.T) {
 				f.On("Config").Return(cf)
 				cf.On("IsAllowNameForID").Return(true)
 
-				c.On("GetClients", api.GetClientsParam{
+				c.On("GetClients", mock.Anything, api.GetClientsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).
 					Return([]dto.Client{{ID: "c-1", Name: "Myself"}}, nil)
 
-				c.On("AddProject", api.AddProjectParam{
+				c.On("AddProject", mock.Anything, api.AddProjectParam{
 					Workspace: "w",
 					Name:      "Clockify",
 					ClientId:  "c-1",
@@ -185,9 +185,10 @@ func TestCmdAdd(t *testing.T) {
 				f.On("Config").Return(cf)
 				cf.On("IsAllowNameForID").Return(false)
 
-				c.On("AddProject", mock.AnythingOfType("api.AddProjectParam")).
+				c.On("AddProject", mock.Anything,
+					mock.AnythingOfType("api.AddProjectParam")).
 					Run(func(args mock.Arguments) {
-						p := args.Get(0).(api.AddProjectParam)
+						p := args.Get(1).(api.AddProjectParam)
 
 						assert.Equal(t, p.Workspace, "w")
 						assert.Equal(t, p.Name, "Clockify")
@@ -297,7 +298,7 @@ func TestCmdAddReport(t *testing.T) {
 			f.On("GetWorkspaceID").
 				Return("w", nil)
 
-			c.On("AddProject", api.AddProjectParam{
+			c.On("AddProject", mock.Anything, api.AddProjectParam{
 				Workspace: "w",
 				Name:      "rockr",
 			}).
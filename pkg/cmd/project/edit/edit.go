@@ -3,12 +3,15 @@ package edit
 import (
 	"errors"
 	"io"
+	"math"
 	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/lucassabreu/clockify-cli/api"
 	"github.com/lucassabreu/clockify-cli/api/dto"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 	"github.com/lucassabreu/clockify-cli/pkg/search"
 	"github.com/lucassabreu/clockify-cli/strhlp"
@@ -61,6 +64,16 @@ func NewCmdEdit(
 			two lines
 			three lines
 
+			# estimate the project in 120 hours, reset monthly
+			$ clockify-cli project edit cli --estimate-method time \
+				--estimate 120 --estimate-reset monthly -q
+			621948458cb9606d934ebb1c
+
+			# use the budget of the tasks as the estimate of the project
+			$ clockify-cli project edit cli --estimate-method budget \
+				--estimate-type task -q
+			621948458cb9606d934ebb1c
+
 			# archive multiple projects
 			$ clockify-cli project first second \
 				--archived \
@@ -70,6 +83,8 @@ func NewCmdEdit(
 		`),
 		Args: cmdutil.RequiredNamedArgs("project"),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
 			if err := of.Check(); err != nil {
 				return err
 			}
@@ -94,6 +109,11 @@ func NewCmdEdit(
 				return err
 			}
 
+			estimate, err := readEstimateFlags(cmd)
+			if err != nil {
+				return err
+			}
+
 			if len(args) > 1 && cmd.Flags().Changed("name") {
 				return errors.New(
 					"`--name` can't be changed for multiple projects")
@@ -122,17 +142,20 @@ func NewCmdEdit(
 
 			if f.Config().IsAllowNameForID() {
 				if ids, err = search.GetProjectsByName(
+					ctx,
 					c, w, ids); err != nil {
 					return err
 				}
 
 				if ids, err = search.GetProjectsByName(
+					ctx,
 					c, w, ids); err != nil {
 					return err
 				}
 
 				if client != nil && *client != "" {
 					if *client, err = search.GetClientByName(
+						ctx,
 						c, w, *client); err != nil {
 						return err
 					}
@@ -177,7 +200,16 @@ func NewCmdEdit(
 				g.Go(func() error {
 					cp := p
 					cp.ProjectID = ids[j]
-					projects[j], err = c.UpdateProject(cp)
+					pr, err := c.UpdateProject(ctx, cp)
+					if err != nil || estimate == nil {
+						projects[j] = pr
+						return err
+					}
+
+					e := *estimate
+					e.Workspace = w
+					e.ProjectID = ids[j]
+					projects[j], err = c.UpdateProjectEstimate(ctx, e)
 					return err
 				})
 			}
@@ -220,7 +252,71 @@ func NewCmdEdit(
 	cmd.Flags().BoolP("active", "a", false,
 		"set the projects as active")
 
+	cmd.Flags().String("estimate-method", "",
+		"how the projects are estimated (none, time or budget)")
+	_ = cmdcompl.AddFixedSuggestionsToFlag(cmd, "estimate-method",
+		cmdcompl.ValidArgsSlide{
+			string(api.EstimateMethodNone),
+			string(api.EstimateMethodTime),
+			string(api.EstimateMethodBudget),
+		})
+	cmd.Flags().String("estimate-type", string(api.EstimateTypeProject),
+		"if the estimate is set on the project or is the sum of its tasks")
+	_ = cmdcompl.AddFixedSuggestionsToFlag(cmd, "estimate-type",
+		cmdcompl.ValidArgsSlide{
+			string(api.EstimateTypeProject),
+			string(api.EstimateTypeTask),
+		})
+	cmd.Flags().Float64("estimate", 0,
+		"the estimate of the projects, in hours for time or "+
+			"on the currency of the workspace for budget")
+	cmd.Flags().String("estimate-reset", "",
+		"resets the estimate every period (monthly)")
+	_ = cmdcompl.AddFixedSuggestionsToFlag(cmd, "estimate-reset",
+		cmdcompl.ValidArgsSlide{string(api.EstimateResetOptionMonthly)})
+
 	util.AddReportFlags(cmd, &of)
 
 	return cmd
 }
+
+// readEstimateFlags returns the estimate to be set on the projects, or nil if
+// it should not be changed
+func readEstimateFlags(cmd *cobra.Command) (
+	*api.UpdateProjectEstimateParam, error) {
+	if !cmd.Flags().Changed("estimate-method") {
+		for _, n := range []string{
+			"estimate", "estimate-type", "estimate-reset"} {
+			if cmd.Flags().Changed(n) {
+				return nil, cmdutil.FlagErrorWrap(errors.New(
+					"--estimate-method must be set to change the estimate"))
+			}
+		}
+
+		return nil, nil
+	}
+
+	m, _ := cmd.Flags().GetString("estimate-method")
+	t, _ := cmd.Flags().GetString("estimate-type")
+	r, _ := cmd.Flags().GetString("estimate-reset")
+	v, _ := cmd.Flags().GetFloat64("estimate")
+	if v < 0 {
+		return nil, cmdutil.FlagErrorWrap(
+			errors.New("estimate can't be negative"))
+	}
+
+	p := &api.UpdateProjectEstimateParam{
+		Method:      api.EstimateMethod(strings.ToLower(m)),
+		Type:        api.EstimateType(strings.ToLower(t)),
+		ResetOption: api.EstimateResetOption(strings.ToLower(r)),
+	}
+
+	switch p.Method {
+	case api.EstimateMethodTime:
+		p.Estimate = int64(v * float64(time.Hour))
+	case api.EstimateMethodBudget:
+		p.Estimate = int64(math.Round(v * 100))
+	}
+
+	return p, nil
+}
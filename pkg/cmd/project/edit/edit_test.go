@@ -12,6 +12,7 @@ import (
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/util"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 type report func(io.Writer, *util.OutputFlags, []dto.Project) error
@@ -114,7 +115,7 @@ func TestEditCmd(t *testing.T) {
 				c := mocks.NewMockClient(t)
 				f.On("Client").Return(c, nil)
 
-				c.On("GetProjects", api.GetProjectsParam{
+				c.On("GetProjects", mock.Anything, api.GetProjectsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).Return([]dto.Project{}, nil)
@@ -143,7 +144,7 @@ func TestEditCmd(t *testing.T) {
 
 				b := true
 				client := ""
-				c.On("UpdateProject", api.UpdateProjectParam{
+				c.On("UpdateProject", mock.Anything, api.UpdateProjectParam{
 					Workspace: "w",
 					ProjectID: "cli",
 					ClientId:  &client,
@@ -152,7 +153,7 @@ func TestEditCmd(t *testing.T) {
 					Archived:  &b,
 				}).Return(dto.Project{}, nil)
 
-				c.On("UpdateProject", api.UpdateProjectParam{
+				c.On("UpdateProject", mock.Anything, api.UpdateProjectParam{
 					Workspace: "w",
 					ProjectID: "second",
 					ClientId:  &client,
@@ -183,7 +184,7 @@ func TestEditCmd(t *testing.T) {
 				c := mocks.NewMockClient(t)
 				f.On("Client").Return(c, nil)
 
-				c.On("GetProjects", api.GetProjectsParam{
+				c.On("GetProjects", mock.Anything, api.GetProjectsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).Return([]dto.Project{
@@ -191,7 +192,7 @@ func TestEditCmd(t *testing.T) {
 					{ID: "p-2", Name: "Second"},
 				}, nil)
 
-				c.On("GetClients", api.GetClientsParam{
+				c.On("GetClients", mock.Anything, api.GetClientsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).Return([]dto.Client{
@@ -201,7 +202,7 @@ func TestEditCmd(t *testing.T) {
 				b := false
 				client := "c-1"
 				n := "active, but not billable"
-				c.On("UpdateProject", api.UpdateProjectParam{
+				c.On("UpdateProject", mock.Anything, api.UpdateProjectParam{
 					Workspace: "w",
 					ProjectID: "p-1",
 					ClientId:  &client,
@@ -211,7 +212,7 @@ func TestEditCmd(t *testing.T) {
 					Note:      &n,
 				}).Return(dto.Project{ID: "cli"}, nil)
 
-				c.On("UpdateProject", api.UpdateProjectParam{
+				c.On("UpdateProject", mock.Anything, api.UpdateProjectParam{
 					Workspace: "w",
 					ProjectID: "p-2",
 					ClientId:  &client,
@@ -248,14 +249,14 @@ func TestEditCmd(t *testing.T) {
 				c := mocks.NewMockClient(t)
 				f.On("Client").Return(c, nil)
 
-				c.On("GetProjects", api.GetProjectsParam{
+				c.On("GetProjects", mock.Anything, api.GetProjectsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).Return([]dto.Project{
 					{ID: "p-1", Name: "First"},
 				}, nil)
 
-				c.On("GetClients", api.GetClientsParam{
+				c.On("GetClients", mock.Anything, api.GetClientsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).Return([]dto.Client{
@@ -263,7 +264,7 @@ func TestEditCmd(t *testing.T) {
 				}, nil)
 
 				client := "c-1"
-				c.On("UpdateProject", api.UpdateProjectParam{
+				c.On("UpdateProject", mock.Anything, api.UpdateProjectParam{
 					Workspace: "w",
 					ProjectID: "p-1",
 					ClientId:  &client,
@@ -281,6 +282,61 @@ func TestEditCmd(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "estimate requires method",
+			args: []string{"cli", "--estimate=10"},
+			err:  "--estimate-method must be set to change the estimate",
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				return mocks.NewMockFactory(t), nil
+			},
+		},
+		{
+			name: "change estimate",
+			args: []string{"cli", "second",
+				"--estimate-method=budget",
+				"--estimate=1500.5",
+				"--estimate-reset=monthly"},
+			params: func(t *testing.T) (cmdutil.Factory, report) {
+				f := mocks.NewMockFactory(t)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				cf.On("IsAllowNameForID").Return(false)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				for _, id := range []string{"cli", "second"} {
+					c.On("UpdateProject", mock.Anything, api.UpdateProjectParam{
+						Workspace: "w",
+						ProjectID: id,
+					}).Return(dto.Project{ID: id}, nil)
+
+					c.On("UpdateProjectEstimate", mock.Anything,
+						api.UpdateProjectEstimateParam{
+							Workspace:   "w",
+							ProjectID:   id,
+							Method:      api.EstimateMethodBudget,
+							Type:        api.EstimateTypeProject,
+							ResetOption: api.EstimateResetOptionMonthly,
+							Estimate:    150050,
+						}).Return(dto.Project{ID: id, Name: "estimated"}, nil)
+				}
+
+				called := false
+				t.Cleanup(func() { assert.True(t, called, "was not called") })
+				return f, func(
+					w io.Writer, of *util.OutputFlags, p []dto.Project) error {
+					called = true
+					assert.Equal(t, []dto.Project{
+						{ID: "cli", Name: "estimated"},
+						{ID: "second", Name: "estimated"},
+					}, p)
+					return nil
+				}
+			},
+		},
 	}
 
 	for _, tt := range tts {
@@ -370,7 +426,7 @@ func TestEditCmdReport(t *testing.T) {
 			cf.On("IsAllowNameForID").Return(false)
 			f.On("Config").Return(cf)
 
-			c.On("UpdateProject", api.UpdateProjectParam{
+			c.On("UpdateProject", mock.Anything, api.UpdateProjectParam{
 				Workspace: "w",
 				ProjectID: "p-1",
 				Name:      "Myself",
@@ -44,6 +44,8 @@ func NewCmdGet(
 			Other - #03A9F4 | 6202634a28782767054eec26
 		`, "clockify-cli project get"),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			ctx := cmd.Context()
+
 			if p.ProjectID = strings.TrimSpace(args[0]); p.ProjectID == "" {
 				return errors.New("project id should not be empty")
 			}
@@ -63,12 +65,13 @@ func NewCmdGet(
 
 			if f.Config().IsAllowNameForID() {
 				if p.ProjectID, err = search.GetProjectByName(
+					ctx,
 					c, p.Workspace, p.ProjectID); err != nil {
 					return err
 				}
 			}
 
-			project, err := c.GetProject(p)
+			project, err := c.GetProject(ctx, p)
 			if err != nil {
 				return err
 			}
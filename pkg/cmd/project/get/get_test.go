@@ -12,6 +12,7 @@ import (
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/util"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestCmdGet(t *testing.T) {
@@ -79,7 +80,7 @@ func TestCmdGet(t *testing.T) {
 
 				f.EXPECT().GetWorkspaceID().Return("w", nil)
 
-				c.EXPECT().GetProject(api.GetProjectParam{
+				c.EXPECT().GetProject(mock.Anything, api.GetProjectParam{
 					Workspace: "w",
 					ProjectID: "p1",
 				}).
@@ -102,7 +103,7 @@ func TestCmdGet(t *testing.T) {
 				c := mocks.NewMockClient(t)
 				f.EXPECT().Client().Return(c, nil)
 
-				c.EXPECT().GetProject(api.GetProjectParam{
+				c.EXPECT().GetProject(mock.Anything, api.GetProjectParam{
 					Workspace: "w",
 					ProjectID: "p1",
 				}).
@@ -128,13 +129,13 @@ func TestCmdGet(t *testing.T) {
 				c := mocks.NewMockClient(t)
 				f.EXPECT().Client().Return(c, nil)
 
-				c.EXPECT().GetProjects(api.GetProjectsParam{
+				c.EXPECT().GetProjects(mock.Anything, api.GetProjectsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).
 					Return([]dto.Project{{Name: "project", ID: "p1"}}, nil)
 
-				c.EXPECT().GetProject(api.GetProjectParam{
+				c.EXPECT().GetProject(mock.Anything, api.GetProjectParam{
 					Workspace: "w",
 					ProjectID: "p1",
 				}).
@@ -159,13 +160,13 @@ func TestCmdGet(t *testing.T) {
 				c := mocks.NewMockClient(t)
 				f.EXPECT().Client().Return(c, nil)
 
-				c.EXPECT().GetProjects(api.GetProjectsParam{
+				c.EXPECT().GetProjects(mock.Anything, api.GetProjectsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).
 					Return([]dto.Project{{Name: "project", ID: "p1"}}, nil)
 
-				c.EXPECT().GetProject(api.GetProjectParam{
+				c.EXPECT().GetProject(mock.Anything, api.GetProjectParam{
 					Workspace: "w",
 					ProjectID: "p1",
 					Hydrate:   true,
@@ -263,7 +264,7 @@ func TestCmdGetReport(t *testing.T) {
 			f.EXPECT().Config().Return(cf)
 			cf.EXPECT().IsAllowNameForID().Return(false)
 
-			c.EXPECT().GetProject(api.GetProjectParam{
+			c.EXPECT().GetProject(mock.Anything, api.GetProjectParam{
 				Workspace: "w",
 				ProjectID: "p1",
 			}).
@@ -61,8 +61,19 @@ func NewCmdList(
 			+--------------------------+-------------------+-----------------------------------------+
 			| 62894c3ed2df9d2867dc750b | Something Newer   | Special (6202634a28782767054eec26)      |
 			+--------------------------+-------------------+-----------------------------------------+
+
+			$ %[1]s --with-budget
+			+--------------------------+-----------------+----------+---------+-----------+
+			|            ID            |      NAME       | ESTIMATE | TRACKED | REMAINING |
+			+--------------------------+-----------------+----------+---------+-----------+
+			| 621948458cb9606d934ebb1c | Clockify Cli    | 120:00   | 87:30   | 32:30     |
+			| 62a8b52d67f40258719037f2 | New One         | 5000.00  | 1200.00 | 3800.00   |
+			| 62a8b59067f40258719038fc | Other           |          | 3:15    |           |
+			+--------------------------+-----------------+----------+---------+-----------+
 		`, "clockify-cli project list"),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			ctx := cmd.Context()
+
 			if err := of.Check(); err != nil {
 				return err
 			}
@@ -85,6 +96,7 @@ func NewCmdList(
 
 			if len(p.Clients) > 0 && f.Config().IsAllowNameForID() {
 				if p.Clients, err = search.GetClientsByName(
+					ctx,
 					c, p.Workspace, p.Clients); err != nil {
 					return err
 				}
@@ -94,7 +106,7 @@ func NewCmdList(
 				p.Archived = &archived
 			}
 
-			projects, err := c.GetProjects(p)
+			projects, err := c.GetProjects(ctx, p)
 			if err != nil {
 				return err
 			}
@@ -123,7 +135,11 @@ func NewCmdList(
 		"projects will have custom fields, tasks and memberships "+
 			"filled for json and format outputs")
 
+	cmd.Flags().BoolVar(&of.Budget, "with-budget", false,
+		"show the estimate of the projects and how much of it remains")
+
 	util.AddReportFlags(cmd, &of)
+	cmdutil.AddPaginationFlags(cmd.Flags(), &p.PaginationParam)
 
 	return cmd
 }
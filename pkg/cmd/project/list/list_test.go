@@ -12,6 +12,7 @@ import (
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/project/util"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestCmdList(t *testing.T) {
@@ -86,7 +87,7 @@ func TestCmdList(t *testing.T) {
 				f.EXPECT().Config().Return(cf)
 				cf.EXPECT().IsAllowNameForID().Return(true)
 
-				c.EXPECT().GetClients(api.GetClientsParam{
+				c.EXPECT().GetClients(mock.Anything, api.GetClientsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).
@@ -109,7 +110,7 @@ func TestCmdList(t *testing.T) {
 				f.EXPECT().Config().Return(cf)
 				cf.EXPECT().IsAllowNameForID().Return(true)
 
-				c.EXPECT().GetClients(api.GetClientsParam{
+				c.EXPECT().GetClients(mock.Anything, api.GetClientsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).
@@ -127,7 +128,7 @@ func TestCmdList(t *testing.T) {
 				f.EXPECT().GetWorkspaceID().
 					Return("w", nil)
 				f.EXPECT().Client().Return(c, nil)
-				c.EXPECT().GetProjects(api.GetProjectsParam{
+				c.EXPECT().GetProjects(mock.Anything, api.GetProjectsParam{
 					Workspace:       "w",
 					Name:            "error",
 					Clients:         []string{},
@@ -155,7 +156,7 @@ func TestCmdList(t *testing.T) {
 
 				c := mocks.NewMockClient(t)
 				f.EXPECT().Client().Return(c, nil)
-				c.EXPECT().GetClients(api.GetClientsParam{
+				c.EXPECT().GetClients(mock.Anything, api.GetClientsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).
@@ -165,7 +166,7 @@ func TestCmdList(t *testing.T) {
 					}, nil)
 
 				b := true
-				c.EXPECT().GetProjects(api.GetProjectsParam{
+				c.EXPECT().GetProjects(mock.Anything, api.GetProjectsParam{
 					Workspace:       "w",
 					Name:            "cli",
 					Clients:         []string{"c1", "c2"},
@@ -192,7 +193,7 @@ func TestCmdList(t *testing.T) {
 				f.EXPECT().Client().Return(c, nil)
 
 				b := false
-				c.EXPECT().GetProjects(api.GetProjectsParam{
+				c.EXPECT().GetProjects(mock.Anything, api.GetProjectsParam{
 					Workspace:       "w",
 					Name:            "cli",
 					Clients:         []string{},
@@ -217,7 +218,7 @@ func TestCmdList(t *testing.T) {
 				c := mocks.NewMockClient(t)
 				f.EXPECT().Client().Return(c, nil)
 
-				c.EXPECT().GetProjects(api.GetProjectsParam{
+				c.EXPECT().GetProjects(mock.Anything, api.GetProjectsParam{
 					Workspace:       "w",
 					Clients:         []string{},
 					Hydrate:         true,
@@ -311,7 +312,7 @@ func TestCmdListReport(t *testing.T) {
 			f.EXPECT().GetWorkspaceID().
 				Return("w", nil)
 
-			c.EXPECT().GetProjects(api.GetProjectsParam{
+			c.EXPECT().GetProjects(mock.Anything, api.GetProjectsParam{
 				Workspace:       "w",
 				Clients:         []string{},
 				PaginationParam: api.AllPages(),
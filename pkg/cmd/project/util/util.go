@@ -13,15 +13,19 @@ import (
 // OutputFlags defines how to print the project
 type OutputFlags struct {
 	JSON   bool
+	YAML   bool
 	CSV    bool
 	Quiet  bool
 	Format string
+	Sort   string
+	Budget bool
 }
 
 func (of OutputFlags) Check() error {
 	return cmdutil.XorFlag(map[string]bool{
 		"format": of.Format != "",
 		"json":   of.JSON,
+		"yaml":   of.YAML,
 		"csv":    of.CSV,
 		"quiet":  of.Quiet,
 	})
@@ -31,22 +35,36 @@ func (of OutputFlags) Check() error {
 func AddReportFlags(cmd *cobra.Command, of *OutputFlags) {
 	cmd.Flags().StringVarP(&of.Format, "format", "f", "",
 		"golang text/template format to be applied on each Project")
+	cmdutil.AddTemplateSourceFlags(cmd.Flags())
 	cmd.Flags().BoolVarP(&of.JSON, "json", "j", false, "print as JSON")
+	cmd.Flags().BoolVar(&of.YAML, "yaml", false, "print as YAML")
+	cmd.Flags().StringVar(&of.Sort, "sort", "",
+		"sorts the projects by a field, like: name or name:desc")
 	cmd.Flags().BoolVarP(&of.CSV, "csv", "v", false, "print as CSV")
 	cmd.Flags().BoolVarP(&of.Quiet, "quiet", "q", false, "only display ids")
 }
 
 // Report will print the projects as set by the flags
 func Report(list []dto.Project, out io.Writer, f OutputFlags) error {
+	if f.Sort != "" {
+		if err := project.SortProjects(list, f.Sort); err != nil {
+			return err
+		}
+	}
+
 	switch {
 	case f.JSON:
 		return project.ProjectsJSONPrint(list, out)
+	case f.YAML:
+		return project.ProjectsYAMLPrint(list, out)
 	case f.CSV:
 		return project.ProjectsCSVPrint(list, out)
 	case f.Quiet:
 		return project.ProjectPrintQuietly(list, out)
 	case f.Format != "":
 		return project.ProjectPrintWithTemplate(f.Format)(list, out)
+	case f.Budget:
+		return project.ProjectPrintWithBudget(list, os.Stdout)
 	default:
 		return project.ProjectPrint(list, os.Stdout)
 	}
@@ -57,6 +75,8 @@ func ReportOne(p dto.Project, out io.Writer, f OutputFlags) error {
 	switch {
 	case f.JSON:
 		return project.ProjectJSONPrint(p, out)
+	case f.YAML:
+		return project.ProjectYAMLPrint(p, out)
 	default:
 		return Report([]dto.Project{p}, os.Stdout, f)
 	}
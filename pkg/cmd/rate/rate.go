@@ -0,0 +1,20 @@
+package rate
+
+import (
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/rate/set"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdRate represents the rate command
+func NewCmdRate(f cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rate",
+		Aliases: []string{"rates"},
+		Short:   "Work with Clockify billable and cost rates",
+	}
+
+	cmd.AddCommand(set.NewCmdSet(f))
+
+	return cmd
+}
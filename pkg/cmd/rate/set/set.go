@@ -0,0 +1,185 @@
+package set
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/search"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdSet represents the rate set command
+func NewCmdSet(f cmdutil.Factory) *cobra.Command {
+	fl := struct {
+		project  string
+		user     string
+		amount   float64
+		currency string
+		since    string
+		cost     bool
+	}{}
+
+	cmd := &cobra.Command{
+		Use:  "set",
+		Args: cobra.NoArgs,
+		Short: "Changes the billable or cost rate of the workspace, " +
+			"a project or a user",
+		Long: heredoc.Doc(`
+			Changes the billable (or cost, with --cost) rate per hour.
+
+			Which rate is changed depends on the flags used:
+			  - no flags: the default rate of the workspace
+			  - --project: the rate of the project
+			  - --user: the rate of the user on the workspace
+			  - --project and --user: the rate of the user on the project
+
+			When --since is set, the time entries from that day forward will
+			also be updated to the new rate.
+		`),
+		Example: heredoc.Docf(`
+			$ %[1]s --amount 50 --currency USD
+			$ %[1]s --project cli --amount 80
+			$ %[1]s --user john --amount 30 --cost
+			$ %[1]s --project cli --user john --amount 80 --since 2023-07-01
+		`, "clockify-cli rate set"),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+
+			if fl.amount < 0 {
+				return cmdutil.FlagErrorWrap(
+					errors.New("amount can't be negative"))
+			}
+			amount := uint(math.Round(fl.amount * 100))
+
+			if fl.currency != "" && (fl.project != "" || fl.user != "") {
+				return cmdutil.FlagErrorWrap(errors.New(
+					"currency can only be set on the workspace rate"))
+			}
+
+			var since *time.Time
+			if fl.since != "" {
+				d, err := time.ParseInLocation(
+					"2006-01-02", fl.since, time.Local)
+				if err != nil {
+					return cmdutil.FlagErrorWrap(
+						errors.New("since must be in the format 2006-01-02"))
+				}
+				since = &d
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project := strings.TrimSpace(fl.project)
+			user := strings.TrimSpace(fl.user)
+			if f.Config().IsAllowNameForID() {
+				if project != "" {
+					if project, err = search.GetProjectByName(
+						ctx,
+						c, w, project); err != nil {
+						return err
+					}
+				}
+
+				if user != "" {
+					us, err := search.GetUsersByName(
+						ctx,
+						c, w, []string{user})
+					if err != nil {
+						return err
+					}
+					user = us[0]
+				}
+			}
+
+			switch {
+			case project != "" && user != "":
+				p := api.UpdateProjectUserRateParam{
+					Workspace: w,
+					ProjectID: project,
+					UserID:    user,
+					Amount:    amount,
+					Since:     since,
+				}
+				if fl.cost {
+					_, err = c.UpdateProjectUserCostRate(ctx, p)
+				} else {
+					_, err = c.UpdateProjectUserBillableRate(ctx, p)
+				}
+			case project != "":
+				p := api.UpdateProjectRateParam{
+					Workspace: w,
+					ProjectID: project,
+					Amount:    amount,
+					Since:     since,
+				}
+				if fl.cost {
+					_, err = c.UpdateProjectCostRate(ctx, p)
+				} else {
+					_, err = c.UpdateProjectBillableRate(ctx, p)
+				}
+			case user != "":
+				p := api.UpdateUserRateParam{
+					Workspace: w,
+					UserID:    user,
+					Amount:    amount,
+					Since:     since,
+				}
+				if fl.cost {
+					_, err = c.UpdateUserCostRate(ctx, p)
+				} else {
+					_, err = c.UpdateUserBillableRate(ctx, p)
+				}
+			default:
+				p := api.UpdateWorkspaceRateParam{
+					Workspace: w,
+					Amount:    amount,
+					Currency:  fl.currency,
+					Since:     since,
+				}
+				if fl.cost {
+					_, err = c.UpdateWorkspaceCostRate(ctx, p)
+				} else {
+					_, err = c.UpdateWorkspaceBillableRate(ctx, p)
+				}
+			}
+
+			return err
+		},
+	}
+
+	cmd.Flags().StringVarP(&fl.project, "project", "p", "",
+		"the name/id of the project to change the rate")
+	_ = cmdcompl.AddSuggestionsToFlag(cmd, "project",
+		cmdcomplutil.NewProjectAutoComplete(f))
+	cmd.Flags().StringVarP(&fl.user, "user", "u", "",
+		"the name/email/id of the user to change the rate")
+	_ = cmdcompl.AddSuggestionsToFlag(cmd, "user",
+		cmdcomplutil.NewUserAutoComplete(f))
+	cmd.Flags().Float64Var(&fl.amount, "amount", 0,
+		"the new rate per hour (like: 80 or 42.50)")
+	_ = cmd.MarkFlagRequired("amount")
+	cmd.Flags().StringVar(&fl.currency, "currency", "",
+		"the currency of the workspace rate")
+	cmd.Flags().StringVar(&fl.since, "since", "",
+		"update the time entries from this day forward, "+
+			"with the format 2006-01-02")
+	cmd.Flags().BoolVar(&fl.cost, "cost", false,
+		"change the cost rate instead of the billable rate")
+
+	return cmd
+}
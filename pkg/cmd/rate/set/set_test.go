@@ -0,0 +1,132 @@
+package set_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/rate/set"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCmdSet(t *testing.T) {
+	since, _ := time.ParseInLocation("2006-01-02", "2023-07-01", time.Local)
+
+	tts := []struct {
+		name  string
+		args  []string
+		setup func(*mocks.MockClient)
+		err   string
+	}{
+		{
+			name: "workspace",
+			args: []string{"--amount", "50", "--currency", "USD"},
+			setup: func(c *mocks.MockClient) {
+				c.On("UpdateWorkspaceBillableRate", mock.Anything,
+					api.UpdateWorkspaceRateParam{
+						Workspace: "w",
+						Amount:    5000,
+						Currency:  "USD",
+					}).Return(dto.Workspace{}, nil)
+			},
+		},
+		{
+			name: "project",
+			args: []string{"-p", "cli", "--amount", "42.5"},
+			setup: func(c *mocks.MockClient) {
+				c.On("UpdateProjectBillableRate", mock.Anything,
+					api.UpdateProjectRateParam{
+						Workspace: "w",
+						ProjectID: "p1",
+						Amount:    4250,
+					}).Return(dto.Project{}, nil)
+			},
+		},
+		{
+			name: "user cost",
+			args: []string{"-u", "john", "--amount", "30", "--cost"},
+			setup: func(c *mocks.MockClient) {
+				c.On("UpdateUserCostRate", mock.Anything,
+					api.UpdateUserRateParam{
+						Workspace: "w",
+						UserID:    "u1",
+						Amount:    3000,
+					}).Return(dto.Workspace{}, nil)
+			},
+		},
+		{
+			name: "project member",
+			args: []string{"-p", "cli", "-u", "john", "--amount", "80",
+				"--since", "2023-07-01"},
+			setup: func(c *mocks.MockClient) {
+				c.On("UpdateProjectUserBillableRate", mock.Anything,
+					api.UpdateProjectUserRateParam{
+						Workspace: "w",
+						ProjectID: "p1",
+						UserID:    "u1",
+						Amount:    8000,
+						Since:     &since,
+					}).Return(dto.Project{}, nil)
+			},
+		},
+		{
+			name: "currency on project",
+			args: []string{"-p", "cli", "--amount", "1", "--currency", "USD"},
+			err:  "currency can only be set on the workspace rate",
+		},
+		{
+			name: "negative",
+			args: []string{"--amount", "-1"},
+			err:  "amount can't be negative",
+		},
+		{
+			name: "invalid since",
+			args: []string{"--amount", "1", "--since", "yesterday"},
+			err:  "since must be in the format 2006-01-02",
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			f := mocks.NewMockFactory(t)
+			if tt.setup != nil {
+				c := mocks.NewMockClient(t)
+				cf := mocks.NewMockConfig(t)
+				f.On("GetWorkspaceID").Return("w", nil)
+				f.On("Client").Return(c, nil)
+				f.On("Config").Return(cf)
+				cf.On("IsAllowNameForID").Return(true)
+
+				c.On("GetProjects", mock.Anything, api.GetProjectsParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.Project{{ID: "p1", Name: "CLI"}}, nil).
+					Maybe()
+				c.On("WorkspaceUsers", mock.Anything, api.WorkspaceUsersParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.User{{ID: "u1", Name: "John"}}, nil).
+					Maybe()
+
+				tt.setup(c)
+			}
+
+			cmd := set.NewCmdSet(f)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+			cmd.SetArgs(tt.args)
+
+			_, err := cmd.ExecuteC()
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -1,16 +1,32 @@
 package cmd
 
 import (
+	"github.com/lucassabreu/clockify-cli/api"
+	apicmd "github.com/lucassabreu/clockify-cli/pkg/cmd/api"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/approval"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/cache"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/client"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/completion"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/config"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/expense"
+	gitlabcmd "github.com/lucassabreu/clockify-cli/pkg/cmd/gitlab"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/group"
+	idlecmd "github.com/lucassabreu/clockify-cli/pkg/cmd/idle"
+	jiracmd "github.com/lucassabreu/clockify-cli/pkg/cmd/jira"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/login"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/project"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/rate"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/schedule"
+	synccmd "github.com/lucassabreu/clockify-cli/pkg/cmd/sync"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/tag"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/task"
 	timeentry "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry"
+	timeoff "github.com/lucassabreu/clockify-cli/pkg/cmd/time-off"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/undo"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/user"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/user/me"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/version"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/webhook"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/workspace"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
@@ -49,6 +65,68 @@ func NewCmdRoot(f cmdutil.Factory) *cobra.Command {
 	cmd.PersistentFlags().BoolP("allow-name-for-id", "", false,
 		"allow use of project/client/tag's name when id is asked")
 
+	cmd.PersistentFlags().Bool("no-color", false,
+		"disables the colors on the outputs (also disabled if the env "+
+			"$NO_COLOR is set)")
+
+	cmd.PersistentFlags().Int("max-retries", api.DefaultMaxRetries,
+		"how many times a request will be retried when the API is "+
+			"rate limiting or it timed out (0 disables the retries)")
+
+	cmd.PersistentFlags().Duration("retry-wait", api.DefaultRetryWait,
+		"how long the first retry waits when the API does not inform it, "+
+			"each next retry waits twice as long")
+
+	cmd.PersistentFlags().Duration("http-timeout", 0,
+		"how long each request can take before being cancelled and "+
+			"retried if idempotent (like: 30s, 0 disables it)")
+
+	cmd.PersistentFlags().String("proxy", "",
+		"url of the proxy used to access the API "+
+			"(default is $HTTPS_PROXY or $HTTP_PROXY)")
+
+	cmd.PersistentFlags().Bool("http-cache", false,
+		"keeps the responses of the API on disk, to be revalidated "+
+			"instead of downloaded again by the next executions")
+
+	cmd.PersistentFlags().Duration("cache-ttl", 0,
+		"keeps the projects, clients, tags and tasks on disk for this "+
+			"long (like: 1h), to look up and complete them without "+
+			"fetching (0 disables it)")
+
+	cmd.PersistentFlags().String("api-url", "",
+		"base url of Clockify's API, for regional or self-hosted "+
+			"instances (default \"https://api.clockify.me/api\")")
+	cmd.PersistentFlags().String("reports-api-url", "",
+		"base url of Clockify's Reports API, for regional or self-hosted "+
+			"instances (default \"https://reports.api.clockify.me/v1\")")
+
+	cmd.PersistentFlags().Bool("debug-http", false,
+		"logs the requests and responses to the API on stderr, with the "+
+			"token redacted and the bodies truncated")
+
+	cmd.PersistentFlags().String("profile", "",
+		"name of the profile to use, instead of the one set by "+
+			"\"config profile switch\"")
+	_ = cmdcompl.AddSuggestionsToFlag(cmd, "profile",
+		func(*cobra.Command, []string, string) (cmdcompl.ValidArgs, error) {
+			return cmdcompl.ValidArgsSlide(
+				cmdutil.ProfileNames(f.Config())), nil
+		})
+
+	cmd.PersistentFlags().Bool("json-errors", false,
+		"prints errors on stderr as JSON (with kind, message, code, "+
+			"field and status) and exits with a code for each kind of error")
+
+	cmd.PersistentFlags().String("record", "",
+		"writes the requests to the API and their responses into this "+
+			"directory, to be used later with --replay")
+	cmd.PersistentFlags().String("replay", "",
+		"answers the requests with the responses recorded into this "+
+			"directory by --record, without calling the API")
+	_ = cobra.MarkFlagDirname(cmd.PersistentFlags(), "record")
+	_ = cobra.MarkFlagDirname(cmd.PersistentFlags(), "replay")
+
 	cmd.PersistentFlags().String(
 		"log-level", cmdutil.LOG_LEVEL_NONE, "set log level")
 	_ = cmdcompl.AddFixedSuggestionsToFlag(cmd, "log-level",
@@ -63,6 +141,7 @@ func NewCmdRoot(f cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(version.NewCmdVersion(f))
 
 	cmd.AddCommand(config.NewCmdConfig(f))
+	cmd.AddCommand(login.NewCmdLogin(f))
 
 	cmd.AddCommand(workspace.NewCmdWorkspace(f))
 
@@ -75,7 +154,23 @@ func NewCmdRoot(f cmdutil.Factory) *cobra.Command {
 
 	cmd.AddCommand(tag.NewCmdTag(f))
 
+	cmd.AddCommand(webhook.NewCmdWebhook(f))
+	cmd.AddCommand(expense.NewCmdExpense(f))
+	cmd.AddCommand(approval.NewCmdApproval(f))
+	cmd.AddCommand(timeoff.NewCmdTimeOff(f))
+	cmd.AddCommand(schedule.NewCmdSchedule(f))
+	cmd.AddCommand(group.NewCmdGroup(f))
+	cmd.AddCommand(rate.NewCmdRate(f))
+	cmd.AddCommand(apicmd.NewCmdAPI(f))
+
 	cmd.AddCommand(timeentry.NewCmdTimeEntry(f)...)
+	cmd.AddCommand(undo.NewCmdUndo(f))
+
+	cmd.AddCommand(cache.NewCmdCache(f))
+	cmd.AddCommand(synccmd.NewCmdSync(f))
+	cmd.AddCommand(jiracmd.NewCmdJira(f))
+	cmd.AddCommand(gitlabcmd.NewCmdGitLab(f))
+	cmd.AddCommand(idlecmd.NewCmdIdle(f))
 
 	cmd.AddCommand(completion.NewCmdCompletion())
 
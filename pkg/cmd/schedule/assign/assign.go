@@ -0,0 +1,163 @@
+package assign
+
+import (
+	"errors"
+	"io"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/schedule/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/search"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdAssign represents the assign command
+func NewCmdAssign(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, []dto.Assignment) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	p := api.AddAssignmentParam{}
+	var users []string
+	var start, end string
+	cmd := &cobra.Command{
+		Use:     "assign",
+		Aliases: []string{"add"},
+		Args:    cobra.ExactArgs(0),
+		Short:   "Schedules users to work on a project during a period",
+		Long: heredoc.Doc(`
+			Schedules users to work on a project during a period
+
+			When no user is informed the current user is assigned, and when
+			no end is informed only the start day is scheduled.
+		`),
+		Example: heredoc.Docf(`
+			$ %[1]s -p cli --start 2023-08-07 --end 2023-08-11 --hours 6 -q
+			64c4e0b3c5f6a7b8c9d0e1f2
+
+			$ %[1]s -p cli --user john@example.com --user mary@example.com \
+				--start 2023-08-14 --hours 4 --billable
+		`, "clockify-cli schedule assign"),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			if p.HoursPerDay <= 0 {
+				return cmdutil.FlagErrorWrap(
+					errors.New("hours must be greater than zero"))
+			}
+
+			var err error
+			if p.Start, err = util.ParseDate("start", start); err != nil {
+				return cmdutil.FlagErrorWrap(err)
+			}
+
+			p.End = p.Start
+			if end != "" {
+				if p.End, err = util.ParseDate("end", end); err != nil {
+					return cmdutil.FlagErrorWrap(err)
+				}
+			}
+
+			if p.End.Before(p.Start) {
+				return cmdutil.FlagErrorWrap(
+					errors.New("end must not be before start"))
+			}
+
+			if p.Workspace, err = f.GetWorkspaceID(); err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			if len(users) == 0 {
+				u, err := f.GetUserID()
+				if err != nil {
+					return err
+				}
+				users = []string{u}
+			}
+
+			p.ProjectID, _ = cmd.Flags().GetString("project")
+			if f.Config().IsAllowNameForID() {
+				if users, err = search.GetUsersByName(
+					ctx,
+					c, p.Workspace, users); err != nil {
+					return err
+				}
+
+				if p.ProjectID, err = search.GetProjectByName(
+					ctx,
+					c, p.Workspace, p.ProjectID); err != nil {
+					return err
+				}
+
+				if p.TaskID != "" {
+					if p.TaskID, err = search.GetTaskByName(
+						ctx,
+						c,
+						api.GetTasksParam{
+							Workspace: p.Workspace,
+							ProjectID: p.ProjectID,
+						},
+						p.TaskID,
+					); err != nil {
+						return err
+					}
+				}
+			}
+
+			as := make([]dto.Assignment, 0, len(users))
+			for _, u := range users {
+				p.UserID = u
+				l, err := c.AddAssignment(ctx, p)
+				if err != nil {
+					return err
+				}
+				as = append(as, l...)
+			}
+
+			if report != nil {
+				return report(cmd.OutOrStdout(), &of, as)
+			}
+
+			return util.Report(as, cmd.OutOrStdout(), of)
+		},
+	}
+
+	cmdutil.AddProjectFlags(cmd, f)
+	cmd.Flags().StringVar(&p.TaskID, "task", "",
+		"the name/id of the task of the project")
+	cmd.Flags().StringSliceVar(&users, "user", []string{},
+		"users to be assigned (name/email/id), defaults to the current user")
+	_ = cmdcompl.AddSuggestionsToFlag(cmd, "user",
+		cmdcomplutil.NewUserAutoComplete(f))
+	cmd.Flags().StringVar(&start, "start", "",
+		"first day of the assignment (format 2006-01-02)")
+	_ = cmd.MarkFlagRequired("start")
+	cmd.Flags().StringVar(&end, "end", "",
+		"last day of the assignment (format 2006-01-02)")
+	cmd.Flags().Float64Var(&p.HoursPerDay, "hours", 0,
+		"hours per day the users are expected to work on the project")
+	_ = cmd.MarkFlagRequired("hours")
+	cmd.Flags().BoolVarP(&p.Billable, "billable", "b", false,
+		"the assignment is billable")
+	cmd.Flags().BoolVar(&p.IncludeNonWorkingDays, "include-non-working-days",
+		false, "schedule the users on weekends too")
+	cmd.Flags().StringVarP(&p.Note, "note", "n", "",
+		"a note about the assignment")
+
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
@@ -0,0 +1,200 @@
+package list
+
+import (
+	"context"
+	"io"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/schedule/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	output "github.com/lucassabreu/clockify-cli/pkg/output/schedule"
+	"github.com/lucassabreu/clockify-cli/pkg/search"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/lucassabreu/clockify-cli/strhlp"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdList represents the list command
+func NewCmdList(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, []output.AssignmentHours) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	var all, tracked bool
+	var users []string
+	var start, end string
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Args:    cobra.ExactArgs(0),
+		Short:   "List the assignments scheduled on a period",
+		Long: heredoc.Doc(`
+			List the assignments scheduled on a period
+
+			By default the assignments of the user on the current week are
+			listed. Use --tracked to compare the hours planned for each
+			assignment with the hours the user tracked on its project.
+		`),
+		Example: heredoc.Docf(`
+			$ %[1]s --start 2023-08-07 --end 2023-08-11 --tracked
+			+--------------------------+--------------------------+--------------------------+------------+------------+-----------+---------+---------+
+			|            ID            |           USER           |         PROJECT          |   START    |    END     | HOURS/DAY | PLANNED | TRACKED |
+			+--------------------------+--------------------------+--------------------------+------------+------------+-----------+---------+---------+
+			| 64c4e0b3c5f6a7b8c9d0e1f2 | 5c6bf21db079873a55facc08 | 621948458cb9606d934ebb1c | 2023-08-07 | 2023-08-11 | 6         | 30.00   | 27.50   |
+			+--------------------------+--------------------------+--------------------------+------------+------------+-----------+---------+---------+
+
+			$ %[1]s --all -q
+			64c4e0b3c5f6a7b8c9d0e1f2
+			64c4e0b3c5f6a7b8c9d0e1f3
+		`, "clockify-cli schedule list"),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			if err := cmdutil.XorFlag(map[string]bool{
+				"all":  all,
+				"user": len(users) > 0,
+			}); err != nil {
+				return err
+			}
+
+			p := api.GetAssignmentsParam{PaginationParam: api.AllPages()}
+			p.Start, p.End = timehlp.GetWeekRange(timehlp.Today())
+			p.End = p.End.Add(-1)
+
+			var err error
+			if start != "" {
+				if p.Start, err = util.ParseDate("start", start); err != nil {
+					return cmdutil.FlagErrorWrap(err)
+				}
+			}
+
+			if end != "" {
+				if p.End, err = util.ParseDate("end", end); err != nil {
+					return cmdutil.FlagErrorWrap(err)
+				}
+				p.End = p.End.AddDate(0, 0, 1).Add(-1)
+			}
+
+			if p.Workspace, err = f.GetWorkspaceID(); err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			if !all && len(users) == 0 {
+				u, err := f.GetUserID()
+				if err != nil {
+					return err
+				}
+				users = []string{u}
+			}
+
+			if f.Config().IsAllowNameForID() {
+				if users, err = search.GetUsersByName(
+					ctx,
+					c, p.Workspace, users); err != nil {
+					return err
+				}
+			}
+
+			as, err := c.GetAssignments(ctx, p)
+			if err != nil {
+				return err
+			}
+
+			if len(users) > 0 {
+				l := make([]dto.Assignment, 0, len(as))
+				for i := range as {
+					if strhlp.InSlice(as[i].UserID, users) {
+						l = append(l, as[i])
+					}
+				}
+				as = l
+			}
+
+			hs := make([]output.AssignmentHours, len(as))
+			for i := range as {
+				hs[i].Assignment = as[i]
+				hs[i].Planned = dto.Duration{
+					Duration: output.PlannedHours(as[i])}
+			}
+
+			if tracked {
+				if err = trackedHours(ctx, c, p, hs); err != nil {
+					return err
+				}
+			}
+
+			if report != nil {
+				return report(cmd.OutOrStdout(), &of, hs)
+			}
+
+			if !tracked {
+				return util.Report(as, cmd.OutOrStdout(), of)
+			}
+
+			return util.ReportHours(hs, cmd.OutOrStdout(), of)
+		},
+	}
+
+	cmd.Flags().StringVar(&start, "start", "",
+		"first day of the period (format 2006-01-02), "+
+			"defaults to the start of the week")
+	cmd.Flags().StringVar(&end, "end", "",
+		"last day of the period (format 2006-01-02), "+
+			"defaults to the end of the week")
+	cmd.Flags().BoolVarP(&all, "all", "a", false,
+		"list the assignments of all users of the workspace")
+	cmd.Flags().StringSliceVar(&users, "user", []string{},
+		"list the assignments of these users (name/email/id)")
+	_ = cmdcompl.AddSuggestionsToFlag(cmd, "user",
+		cmdcomplutil.NewUserAutoComplete(f))
+	cmd.Flags().BoolVar(&tracked, "tracked", false,
+		"show the hours planned and tracked on each assignment")
+
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
+
+// trackedHours loads the time entries of each user on the period to sum
+// how much was tracked on their assignments
+func trackedHours(
+	ctx context.Context,
+	c api.Client, p api.GetAssignmentsParam, hs []output.AssignmentHours,
+) error {
+	entries := map[string][]dto.TimeEntryImpl{}
+	for i := range hs {
+		u := hs[i].UserID
+		tes, ok := entries[u]
+		if !ok {
+			var err error
+			if tes, err = c.GetUserTimeEntries(ctx, api.GetUserTimeEntriesParam{
+				Workspace:       p.Workspace,
+				UserID:          u,
+				Start:           &p.Start,
+				End:             &p.End,
+				PaginationParam: api.AllPages(),
+			}); err != nil {
+				return err
+			}
+			entries[u] = tes
+		}
+
+		hs[i].Tracked = dto.Duration{
+			Duration: output.TrackedHours(hs[i].Assignment, tes)}
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package list_test
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/schedule/list"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/schedule/util"
+	output "github.com/lucassabreu/clockify-cli/pkg/output/schedule"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCmdListTracked(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2023, 8, d, 0, 0, 0, 0, time.Local)
+	}
+	at := func(d, h int) time.Time {
+		return time.Date(2023, 8, d, h, 0, 0, 0, time.UTC)
+	}
+	end := func(d, h int) *time.Time {
+		e := at(d, h)
+		return &e
+	}
+
+	start, last := day(7), day(14).Add(-1)
+
+	f := mocks.NewMockFactory(t)
+	c := mocks.NewMockClient(t)
+	cf := mocks.NewMockConfig(t)
+	f.On("GetWorkspaceID").Return("w", nil)
+	f.On("GetUserID").Return("u1", nil)
+	f.On("Client").Return(c, nil)
+	f.On("Config").Return(cf)
+	cf.On("IsAllowNameForID").Return(false)
+
+	week := dto.Assignment{
+		ID:          "a1",
+		UserID:      "u1",
+		ProjectID:   "p1",
+		HoursPerDay: 4,
+		Period: dto.AssignmentPeriod{
+			Start: at(7, 0),
+			End:   at(13, 0),
+		},
+	}
+	weekend := dto.Assignment{
+		ID:                    "a2",
+		UserID:                "u1",
+		ProjectID:             "p2",
+		HoursPerDay:           2,
+		IncludeNonWorkingDays: true,
+		Period: dto.AssignmentPeriod{
+			Start: at(12, 0),
+			End:   at(13, 0),
+		},
+	}
+
+	c.On("GetAssignments", mock.Anything, api.GetAssignmentsParam{
+		Workspace:       "w",
+		Start:           start,
+		End:             last,
+		PaginationParam: api.AllPages(),
+	}).Return([]dto.Assignment{
+		week,
+		weekend,
+		{ID: "a3", UserID: "u2", ProjectID: "p1"},
+	}, nil)
+
+	c.On("GetUserTimeEntries", mock.Anything, api.GetUserTimeEntriesParam{
+		Workspace:       "w",
+		UserID:          "u1",
+		Start:           &start,
+		End:             &last,
+		PaginationParam: api.AllPages(),
+	}).Return([]dto.TimeEntryImpl{
+		{ProjectID: "p1", TimeInterval: dto.TimeInterval{
+			Start: at(7, 9), End: end(7, 12)}},
+		{ProjectID: "p1", TimeInterval: dto.TimeInterval{
+			Start: at(8, 9), End: end(8, 14)}},
+		{ProjectID: "p2", TimeInterval: dto.TimeInterval{
+			Start: at(12, 9), End: end(12, 10)}},
+		{ProjectID: "p1", TimeInterval: dto.TimeInterval{
+			Start: at(14, 9), End: end(14, 10)}},
+	}, nil).Once()
+
+	called := false
+	cmd := list.NewCmdList(f, func(
+		_ io.Writer, _ *util.OutputFlags, hs []output.AssignmentHours,
+	) error {
+		called = true
+		hours := func(h float64) dto.Duration {
+			return dto.Duration{
+				Duration: time.Duration(h * float64(time.Hour))}
+		}
+
+		assert.Equal(t, []output.AssignmentHours{
+			{Assignment: week, Planned: hours(20), Tracked: hours(8)},
+			{Assignment: weekend, Planned: hours(4), Tracked: hours(1)},
+		}, hs)
+		return nil
+	})
+	cmd.SilenceUsage = true
+	cmd.SetArgs([]string{
+		"--start=2023-08-07", "--end=2023-08-13", "--tracked"})
+
+	_, err := cmd.ExecuteC()
+	assert.NoError(t, err)
+	assert.True(t, called)
+}
@@ -0,0 +1,22 @@
+package schedule
+
+import (
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/schedule/assign"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/schedule/list"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdSchedule represents the schedule command
+func NewCmdSchedule(f cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "schedule",
+		Aliases: []string{"scheduling"},
+		Short:   "Work with the assignments scheduled on Clockify",
+	}
+
+	cmd.AddCommand(list.NewCmdList(f, nil))
+	cmd.AddCommand(assign.NewCmdAssign(f, nil))
+
+	return cmd
+}
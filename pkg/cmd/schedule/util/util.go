@@ -0,0 +1,87 @@
+package util
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	output "github.com/lucassabreu/clockify-cli/pkg/output/schedule"
+	"github.com/spf13/cobra"
+)
+
+// OutputFlags sets how to print out a list of assignments
+type OutputFlags struct {
+	Format string
+	JSON   bool
+	YAML   bool
+	Quiet  bool
+}
+
+func (of OutputFlags) Check() error {
+	return cmdutil.XorFlag(map[string]bool{
+		"format": of.Format != "",
+		"json":   of.JSON,
+		"yaml":   of.YAML,
+		"quiet":  of.Quiet,
+	})
+}
+
+// AddReportFlags adds the default output flags for assignments
+func AddReportFlags(cmd *cobra.Command, of *OutputFlags) {
+	cmd.Flags().StringVarP(&of.Format, "format", "f", "",
+		"golang text/template format to be applied on each Assignment")
+	cmdutil.AddTemplateSourceFlags(cmd.Flags())
+	cmd.Flags().BoolVarP(&of.JSON, "json", "j", false, "print as JSON")
+	cmd.Flags().BoolVar(&of.YAML, "yaml", false, "print as YAML")
+	cmd.Flags().BoolVarP(&of.Quiet, "quiet", "q", false, "only display ids")
+}
+
+// Report prints out the assignments
+func Report(as []dto.Assignment, out io.Writer, of OutputFlags) error {
+	switch {
+	case of.JSON:
+		return output.AssignmentsJSONPrint(as, out)
+	case of.YAML:
+		return output.AssignmentsYAMLPrint(as, out)
+	case of.Format != "":
+		return output.AssignmentPrintWithTemplate(of.Format)(as, out)
+	case of.Quiet:
+		return output.AssignmentPrintQuietly(as, out)
+	default:
+		return output.AssignmentPrint(as, out)
+	}
+}
+
+// ReportHours prints out the assignments with their planned and tracked
+// hours
+func ReportHours(
+	hs []output.AssignmentHours, out io.Writer, of OutputFlags) error {
+	switch {
+	case of.JSON:
+		return output.AssignmentHoursJSONPrint(hs, out)
+	case of.YAML:
+		return output.AssignmentHoursYAMLPrint(hs, out)
+	case of.Format != "":
+		return output.AssignmentHoursPrintWithTemplate(of.Format)(hs, out)
+	case of.Quiet:
+		as := make([]dto.Assignment, len(hs))
+		for i := range hs {
+			as[i] = hs[i].Assignment
+		}
+		return output.AssignmentPrintQuietly(as, out)
+	default:
+		return output.AssignmentHoursPrint(hs, out)
+	}
+}
+
+// ParseDate reads a day in the format 2006-01-02 as local time
+func ParseDate(name, value string) (time.Time, error) {
+	d, err := time.ParseInLocation("2006-01-02", value, time.Local)
+	if err != nil {
+		return d, fmt.Errorf("%s must be in the format 2006-01-02", name)
+	}
+
+	return d, nil
+}
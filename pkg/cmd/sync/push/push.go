@@ -0,0 +1,177 @@
+package push
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/offline"
+	"github.com/lucassabreu/clockify-cli/pkg/timeentryhlp"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdPush represents the sync push command
+func NewCmdPush(f cmdutil.Factory) *cobra.Command {
+	var dryRun, discard bool
+	cmd := &cobra.Command{
+		Use:   "push",
+		Args:  cobra.ExactArgs(0),
+		Short: "Sends the time entries queued while offline to Clockify",
+		Long: heredoc.Doc(`
+			Sends the time entries started ("in"), created ("manual") and
+			ended ("out") while the API was unreachable or with --offline,
+			in the same order they were done.
+
+			Names are looked up and the workspace and project rules are
+			checked as the commands would, if one of them fails it is kept
+			on the queue with the ones after it, so it can be tried again.
+		`),
+		Example: heredoc.Doc(`
+			$ clockify-cli in -p "Clockify CLI" -d "Writing docs" --offline
+			queued, use "clockify-cli sync push" to send it
+			$ clockify-cli out --offline
+			queued, use "clockify-cli sync push" to send it
+
+			$ clockify-cli sync push --dry-run
+			in 2022-06-01 09:00:00 "Writing docs"
+			out 2022-06-01 10:30:00
+
+			$ clockify-cli sync push
+			in 2022-06-01 09:00:00 "Writing docs": 62ae4b304ebb4f143c931d50
+			out 2022-06-01 10:30:00: 62ae4b304ebb4f143c931d50
+
+			# forget what was queued
+			$ clockify-cli sync push --discard
+		`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if dryRun && discard {
+				return cmdutil.FlagErrorWrap(errors.New(
+					"`dry-run` and `discard` can't be used together"))
+			}
+
+			q, err := cmdutil.OfflineQueue()
+			if err != nil {
+				return err
+			}
+
+			ops, err := q.Operations()
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			if discard || dryRun {
+				for _, op := range ops {
+					fmt.Fprintln(out, describe(op))
+				}
+
+				if discard {
+					return q.Save(nil)
+				}
+
+				return nil
+			}
+
+			if len(ops) == 0 {
+				fmt.Fprintln(cmd.ErrOrStderr(), "there is nothing to push")
+				return nil
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			for i, op := range ops {
+				id, err := push(cmd.Context(), f, c, op)
+				if err != nil {
+					if serr := q.Save(ops[i:]); serr != nil {
+						return serr
+					}
+
+					return errors.Wrapf(err, "failed to push \"%s\"",
+						describe(op))
+				}
+
+				fmt.Fprintf(out, "%s: %s\n", describe(op), id)
+			}
+
+			return q.Save(nil)
+		},
+	}
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false,
+		"only shows what is queued")
+	cmd.Flags().BoolVar(&discard, "discard", false,
+		"removes everything queued, without sending it")
+
+	return cmd
+}
+
+// push does the operation as the command would, returning the ID of the
+// time entry created or ended
+func push(
+	ctx context.Context, f cmdutil.Factory, c api.Client, op offline.Operation,
+) (string, error) {
+	if op.Kind == offline.KindOut {
+		te, err := c.GetTimeEntryInProgress(ctx,
+			api.GetTimeEntryInProgressParam{
+				Workspace: op.Workspace,
+				UserID:    op.UserID,
+			})
+		if err != nil {
+			return "", err
+		}
+
+		if te == nil {
+			return "", timeentryhlp.ErrNoTimeEntry
+		}
+
+		return te.ID, c.Out(ctx, api.OutParam{
+			Workspace: op.Workspace,
+			UserID:    op.UserID,
+			End:       *op.End,
+		})
+	}
+
+	steps := []util.Step{
+		util.ValidateClosingTimeEntry(ctx, f),
+		util.GetAllowNameForIDsFn(ctx, f.Config(), c),
+		util.LookupCustomFieldsFn(ctx, c),
+	}
+
+	if op.Kind == offline.KindIn {
+		steps = append(steps,
+			util.GetValidateTimeEntryFn(ctx, f),
+			util.OutInProgressFn(ctx, c),
+		)
+	}
+
+	te, err := util.Do(util.OperationToTimeEntryDTO(op),
+		append(steps, util.CreateTimeEntryFn(ctx, c))...)
+	return te.ID, err
+}
+
+func describe(op offline.Operation) string {
+	const format = "2006-01-02 15:04:05"
+	var s string
+	switch op.Kind {
+	case offline.KindOut:
+		return op.Kind + " " + op.End.Local().Format(format)
+	case offline.KindManual:
+		s = op.Kind + " " + op.Start.Local().Format(format) + " - " +
+			op.End.Local().Format(format)
+	default:
+		s = op.Kind + " " + op.Start.Local().Format(format)
+	}
+
+	if op.Description != "" {
+		s += fmt.Sprintf(" %q", op.Description)
+	}
+
+	return s
+}
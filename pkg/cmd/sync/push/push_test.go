@@ -0,0 +1,138 @@
+package push_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/sync/push"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/offline"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newQueue(t *testing.T, ops ...offline.Operation) *offline.Queue {
+	dir := t.TempDir()
+	t.Setenv("XDG_CACHE_HOME", dir)
+	t.Setenv("HOME", dir)
+
+	q, err := cmdutil.OfflineQueue()
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	for _, op := range ops {
+		assert.NoError(t, q.Add(op))
+	}
+
+	return q
+}
+
+func TestCmdPush(t *testing.T) {
+	start := time.Date(2022, 6, 1, 9, 0, 0, 0, time.UTC)
+	end := start.Add(90 * time.Minute)
+	q := newQueue(t,
+		offline.Operation{
+			Kind:        offline.KindIn,
+			Workspace:   "w",
+			UserID:      "u",
+			ProjectID:   "p1",
+			Description: "Writing docs",
+			Start:       start,
+		},
+		offline.Operation{
+			Kind:      offline.KindOut,
+			Workspace: "w",
+			UserID:    "u",
+			End:       &end,
+		},
+	)
+
+	f := mocks.NewMockFactory(t)
+	conf := mocks.NewMockConfig(t)
+	f.EXPECT().Config().Return(conf)
+	conf.EXPECT().GetBool(cmdutil.CONF_ALLOW_INCOMPLETE).Return(true)
+	conf.EXPECT().GetBool(mock.Anything).Return(false).Maybe()
+
+	c := mocks.NewMockClient(t)
+	f.EXPECT().Client().Return(c, nil)
+
+	inProgress := api.GetTimeEntryInProgressParam{Workspace: "w", UserID: "u"}
+	c.EXPECT().GetTimeEntryInProgress(mock.Anything,
+		inProgress).Return(nil, nil).Once()
+	c.EXPECT().Out(mock.Anything,
+		api.OutParam{Workspace: "w", UserID: "u", End: start}).
+		Return(api.ErrorNotFound).Once()
+	c.EXPECT().CreateTimeEntry(mock.Anything, api.CreateTimeEntryParam{
+		Workspace:   "w",
+		Start:       start,
+		ProjectID:   "p1",
+		Description: "Writing docs",
+	}).Return(dto.TimeEntryImpl{ID: "te1", WorkspaceID: "w"}, nil).Once()
+
+	c.EXPECT().GetTimeEntryInProgress(mock.Anything, inProgress).
+		Return(&dto.TimeEntryImpl{ID: "te1"}, nil).Once()
+	c.EXPECT().Out(mock.Anything,
+		api.OutParam{Workspace: "w", UserID: "u", End: end}).
+		Return(nil).Once()
+
+	cmd := push.NewCmdPush(f)
+	out := bytes.NewBufferString("")
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{})
+
+	_, err := cmd.ExecuteC()
+	assert.NoError(t, err)
+	assert.Contains(t, out.String(), `"Writing docs": te1`)
+
+	ops, err := q.Operations()
+	assert.NoError(t, err)
+	assert.Len(t, ops, 0)
+}
+
+func TestCmdPushKeepsTheFailedOnes(t *testing.T) {
+	end := time.Date(2022, 6, 1, 9, 0, 0, 0, time.UTC)
+	q := newQueue(t,
+		offline.Operation{
+			Kind:      offline.KindOut,
+			Workspace: "w",
+			UserID:    "u",
+			End:       &end,
+		},
+		offline.Operation{
+			Kind:      offline.KindOut,
+			Workspace: "w",
+			UserID:    "u",
+			End:       &end,
+		},
+	)
+
+	f := mocks.NewMockFactory(t)
+	c := mocks.NewMockClient(t)
+	f.EXPECT().Client().Return(c, nil)
+
+	c.EXPECT().GetTimeEntryInProgress(mock.Anything,
+		api.GetTimeEntryInProgressParam{
+			Workspace: "w",
+			UserID:    "u",
+		}).Return(nil, nil).Once()
+
+	cmd := push.NewCmdPush(f)
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	cmd.SetOut(bytes.NewBufferString(""))
+	cmd.SetArgs([]string{})
+
+	_, err := cmd.ExecuteC()
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "failed to push \"out ")
+	}
+
+	ops, err := q.Operations()
+	assert.NoError(t, err)
+	assert.Len(t, ops, 2)
+}
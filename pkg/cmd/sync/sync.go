@@ -0,0 +1,95 @@
+package synccmd
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/sync/push"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdSync represents the sync command
+func NewCmdSync(f cmdutil.Factory) *cobra.Command {
+	current := false
+	cmd := &cobra.Command{
+		Use:  "sync",
+		Args: cobra.NoArgs,
+		Short: "Fetches the workspaces and their projects, clients, tags " +
+			"and tasks into the local cache",
+		Long: heredoc.Doc(`
+			Fetches the workspaces of the user and the projects, clients,
+			tags and tasks of each one into the local cache, in parallel.
+
+			Commands looking up entities by name, the interactive mode and
+			the shell completion will use them instead of calling the API,
+			until "cache-ttl" expires. Without "cache-ttl" set the cache is
+			not used.
+
+			To send the time entries queued while offline use "sync push".
+		`),
+		Example: heredoc.Doc(`
+			$ clockify-cli config set cache-ttl 12h
+			$ clockify-cli sync
+			Work: cached 12 projects, 3 clients, 8 tags and 40 tasks
+			Personal: cached 2 projects, 0 clients, 1 tags and 0 tasks
+
+			$ clockify-cli sync --current
+			Work: cached 12 projects, 3 clients, 8 tags and 40 tasks
+		`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			lc, err := cmdutil.LocalCache(f)
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			var ws []string
+			if current {
+				w, err := f.GetWorkspaceID()
+				if err != nil {
+					return err
+				}
+				ws = append(ws, w)
+			}
+
+			rs, err := lc.Sync(cmd.Context(), c, ws...)
+			if err != nil {
+				return err
+			}
+
+			if current && len(rs) == 0 {
+				return fmt.Errorf(
+					"workspace \"%s\" was not found for the user", ws[0])
+			}
+
+			out := cmd.OutOrStdout()
+			for _, r := range rs {
+				fmt.Fprintf(out,
+					"%s: cached %d projects, %d clients, %d tags and "+
+						"%d tasks\n",
+					r.Workspace.Name,
+					r.Projects, r.Clients, r.Tags, r.Tasks)
+			}
+
+			if lc.TTL() <= 0 {
+				fmt.Fprintln(cmd.ErrOrStderr(),
+					"\"cache-ttl\" is not set, so the cache will not be "+
+						"used (see \"clockify-cli config set cache-ttl\")")
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&current, "current", false,
+		"only the current workspace, instead of all of them")
+
+	cmd.AddCommand(push.NewCmdPush(f))
+
+	return cmd
+}
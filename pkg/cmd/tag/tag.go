@@ -1,6 +1,7 @@
 package tag
 
 import (
+	"context"
 	"os"
 
 	"github.com/MakeNowJust/heredoc"
@@ -13,6 +14,7 @@ import (
 
 // NewCmdTag represents the tags command
 func NewCmdTag(f cmdutil.Factory) *cobra.Command {
+	pagination := api.AllPages()
 	cmd := &cobra.Command{
 		Use:     "tag",
 		Aliases: []string{"tags"},
@@ -37,9 +39,11 @@ func NewCmdTag(f cmdutil.Factory) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			format, _ := cmd.Flags().GetString("format")
 			quiet, _ := cmd.Flags().GetBool("quiet")
+			yaml, _ := cmd.Flags().GetBool("yaml")
 			if err := cmdutil.XorFlag(map[string]bool{
 				"format": format != "",
 				"quiet":  quiet,
+				"yaml":   yaml,
 			}); err != nil {
 				return err
 			}
@@ -47,7 +51,7 @@ func NewCmdTag(f cmdutil.Factory) *cobra.Command {
 			archived, _ := cmd.Flags().GetBool("archived")
 			name, _ := cmd.Flags().GetString("name")
 
-			tags, err := getTags(f, name, archived)
+			tags, err := getTags(cmd.Context(), f, name, archived, pagination)
 			if err != nil {
 				return err
 			}
@@ -61,6 +65,10 @@ func NewCmdTag(f cmdutil.Factory) *cobra.Command {
 				return output.TagPrintQuietly(tags, out)
 			}
 
+			if yaml {
+				return output.TagsYAMLPrint(tags, out)
+			}
+
 			return output.TagPrint(tags, os.Stdout)
 		},
 	}
@@ -69,13 +77,19 @@ func NewCmdTag(f cmdutil.Factory) *cobra.Command {
 		"will be used to filter the tag by name")
 	cmd.Flags().StringP("format", "f", "",
 		"golang text/template format to be applied on each Tag")
+	cmdutil.AddTemplateSourceFlags(cmd.Flags())
 	cmd.Flags().BoolP("quiet", "q", false, "only display ids")
+	cmd.Flags().Bool("yaml", false, "print as YAML")
 	cmd.Flags().BoolP("archived", "", false, "only display archived tags")
+	cmdutil.AddPaginationFlags(cmd.Flags(), &pagination)
 
 	return cmd
 }
 
-func getTags(f cmdutil.Factory, name string, archived bool) ([]dto.Tag, error) {
+func getTags(
+	ctx context.Context,
+	f cmdutil.Factory, name string, archived bool, p api.PaginationParam,
+) ([]dto.Tag, error) {
 	c, err := f.Client()
 	if err != nil {
 		return []dto.Tag{}, err
@@ -86,10 +100,10 @@ func getTags(f cmdutil.Factory, name string, archived bool) ([]dto.Tag, error) {
 		return []dto.Tag{}, err
 	}
 
-	return c.GetTags(api.GetTagsParam{
+	return c.GetTags(ctx, api.GetTagsParam{
 		Workspace:       w,
 		Name:            name,
 		Archived:        &archived,
-		PaginationParam: api.AllPages(),
+		PaginationParam: p,
 	})
 }
@@ -24,7 +24,7 @@ func NewCmdAdd(
 			Adds a new active task to a project on Clockify, also allows to assign users to it at the same time
 
 			Tasks will be created as billable or not depending on the project settings.
-			If you set a estimate for the task, but the project is set as manual estimation, then it will have no effect on Clockify.
+			If you set a estimate or budget for the task, but the project is set as manual estimation, then it will have no effect on Clockify.
 		`),
 		Example: heredoc.Docf(`
 			$ %[1]s -p special --name="Very Important"
@@ -67,11 +67,12 @@ func NewCmdAdd(
 				return err
 			}
 
-			task, err := c.AddTask(api.AddTaskParam{
+			task, err := c.AddTask(cmd.Context(), api.AddTaskParam{
 				Workspace:   fl.Workspace,
 				ProjectID:   fl.ProjectID,
 				Name:        fl.Name,
 				Estimate:    fl.Estimate,
+				Budget:      fl.Budget,
 				AssigneeIDs: fl.AssigneeIDs,
 				Billable:    fl.Billable,
 			})
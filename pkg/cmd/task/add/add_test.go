@@ -13,6 +13,7 @@ import (
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/task/util"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestCmdAdd(t *testing.T) {
@@ -119,7 +120,7 @@ func TestCmdAdd(t *testing.T) {
 				f.On("Config").Return(cf)
 				cf.On("IsAllowNameForID").Return(true)
 
-				c.On("GetProjects", api.GetProjectsParam{
+				c.On("GetProjects", mock.Anything, api.GetProjectsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).
@@ -142,13 +143,13 @@ func TestCmdAdd(t *testing.T) {
 				f.On("Config").Return(cf)
 				cf.On("IsAllowNameForID").Return(true)
 
-				c.On("GetProjects", api.GetProjectsParam{
+				c.On("GetProjects", mock.Anything, api.GetProjectsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).
 					Return([]dto.Project{{Name: "Cli"}}, nil)
 
-				c.On("WorkspaceUsers", api.WorkspaceUsersParam{
+				c.On("WorkspaceUsers", mock.Anything, api.WorkspaceUsersParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).
@@ -172,7 +173,7 @@ func TestCmdAdd(t *testing.T) {
 				f.On("Config").Return(cf)
 				cf.On("IsAllowNameForID").Return(false)
 
-				c.On("AddTask", api.AddTaskParam{
+				c.On("AddTask", mock.Anything, api.AddTaskParam{
 					Workspace: "w",
 					ProjectID: "ok",
 					Name:      "error",
@@ -188,6 +189,7 @@ func TestCmdAdd(t *testing.T) {
 				"--project=cli",
 				"--billable",
 				"--estimate", "32",
+				"--budget", "1250.99",
 			},
 			factory: func(t *testing.T) cmdutil.Factory {
 				f := mocks.NewMockFactory(t)
@@ -200,7 +202,7 @@ func TestCmdAdd(t *testing.T) {
 				f.On("Config").Return(cf)
 				cf.On("IsAllowNameForID").Return(true)
 
-				c.On("GetProjects", api.GetProjectsParam{
+				c.On("GetProjects", mock.Anything, api.GetProjectsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).Return(
@@ -208,12 +210,14 @@ func TestCmdAdd(t *testing.T) {
 
 				b := true
 				e := time.Hour * 32
-				c.On("AddTask", api.AddTaskParam{
+				budget := uint64(125099)
+				c.On("AddTask", mock.Anything, api.AddTaskParam{
 					Workspace: "w",
 					Name:      "Add",
 					ProjectID: "p-1",
 					Billable:  &b,
 					Estimate:  &e,
+					Budget:    &budget,
 				}).
 					Return(dto.Task{ID: "t-id"}, nil)
 
@@ -241,13 +245,13 @@ func TestCmdAdd(t *testing.T) {
 				f.On("Config").Return(cf)
 				cf.On("IsAllowNameForID").Return(true)
 
-				c.On("GetProjects", api.GetProjectsParam{
+				c.On("GetProjects", mock.Anything, api.GetProjectsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).Return(
 					[]dto.Project{{ID: "p-1", Name: "Clockify CLI"}}, nil)
 
-				c.On("WorkspaceUsers", api.WorkspaceUsersParam{
+				c.On("WorkspaceUsers", mock.Anything, api.WorkspaceUsersParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).Return(
@@ -258,7 +262,7 @@ func TestCmdAdd(t *testing.T) {
 
 				b := false
 				as := []string{"u-1", "u-2"}
-				c.On("AddTask", api.AddTaskParam{
+				c.On("AddTask", mock.Anything, api.AddTaskParam{
 					Workspace:   "w",
 					Name:        "Add Task",
 					ProjectID:   "p-1",
@@ -359,7 +363,7 @@ func TestCmdAddReport(t *testing.T) {
 
 			cf.On("IsAllowNameForID").Return(false)
 
-			c.On("AddTask", api.AddTaskParam{
+			c.On("AddTask", mock.Anything, api.AddTaskParam{
 				Workspace: "w",
 				ProjectID: "p-1",
 				Name:      "Task Add",
@@ -52,6 +52,8 @@ func NewCmdDelete(
 			No task with id or name containing '62aa4eed49445270d7b9666c' was found
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
 			project, _ := cmd.Flags().GetString("project")
 			task := strings.TrimSpace(args[0])
 			if project == "" || task == "" {
@@ -70,11 +72,13 @@ func NewCmdDelete(
 
 			if f.Config().IsAllowNameForID() {
 				if project, err = search.GetProjectByName(
+					ctx,
 					c, w, project); err != nil {
 					return err
 				}
 
 				if task, err = search.GetTaskByName(
+					ctx,
 					c,
 					api.GetTasksParam{Workspace: w, ProjectID: project},
 					task,
@@ -83,7 +87,7 @@ func NewCmdDelete(
 				}
 			}
 
-			t, err := c.DeleteTask(api.DeleteTaskParam{
+			t, err := c.DeleteTask(ctx, api.DeleteTaskParam{
 				Workspace: w,
 				ProjectID: project,
 				TaskID:    task,
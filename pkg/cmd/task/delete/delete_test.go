@@ -12,6 +12,7 @@ import (
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/task/util"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 type report func(io.Writer, *util.OutputFlags, dto.Task) error
@@ -77,7 +78,7 @@ func TestCmdDelete(t *testing.T) {
 
 				c := mocks.NewMockClient(t)
 				f.On("Client").Return(c, nil)
-				c.On("GetProjects", api.GetProjectsParam{
+				c.On("GetProjects", mock.Anything, api.GetProjectsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).Return([]dto.Project{}, nil)
@@ -99,12 +100,12 @@ func TestCmdDelete(t *testing.T) {
 
 				c := mocks.NewMockClient(t)
 				f.On("Client").Return(c, nil)
-				c.On("GetProjects", api.GetProjectsParam{
+				c.On("GetProjects", mock.Anything, api.GetProjectsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).Return([]dto.Project{{ID: "p-1"}}, nil)
 
-				c.On("GetTasks", api.GetTasksParam{
+				c.On("GetTasks", mock.Anything, api.GetTasksParam{
 					Workspace:       "w",
 					ProjectID:       "p-1",
 					PaginationParam: api.AllPages(),
@@ -127,7 +128,7 @@ func TestCmdDelete(t *testing.T) {
 
 				c := mocks.NewMockClient(t)
 				f.On("Client").Return(c, nil)
-				c.On("GetProjects", api.GetProjectsParam{
+				c.On("GetProjects", mock.Anything, api.GetProjectsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).Return([]dto.Project{{ID: "p-1"}}, nil)
@@ -137,13 +138,13 @@ func TestCmdDelete(t *testing.T) {
 					Name:      "Delete Task",
 					ProjectID: "p-1",
 				}
-				c.On("GetTasks", api.GetTasksParam{
+				c.On("GetTasks", mock.Anything, api.GetTasksParam{
 					Workspace:       "w",
 					ProjectID:       "p-1",
 					PaginationParam: api.AllPages(),
 				}).Return([]dto.Task{te}, nil)
 
-				c.On("DeleteTask", api.DeleteTaskParam{
+				c.On("DeleteTask", mock.Anything, api.DeleteTaskParam{
 					Workspace: "w",
 					ProjectID: "p-1",
 					TaskID:    "task-id",
@@ -165,7 +166,7 @@ func TestCmdDelete(t *testing.T) {
 
 				c := mocks.NewMockClient(t)
 				f.On("Client").Return(c, nil)
-				c.On("GetProjects", api.GetProjectsParam{
+				c.On("GetProjects", mock.Anything, api.GetProjectsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).Return([]dto.Project{{ID: "p-1"}}, nil)
@@ -175,13 +176,13 @@ func TestCmdDelete(t *testing.T) {
 					Name:      "Delete Task",
 					ProjectID: "p-1",
 				}
-				c.On("GetTasks", api.GetTasksParam{
+				c.On("GetTasks", mock.Anything, api.GetTasksParam{
 					Workspace:       "w",
 					ProjectID:       "p-1",
 					PaginationParam: api.AllPages(),
 				}).Return([]dto.Task{te}, nil)
 
-				c.On("DeleteTask", api.DeleteTaskParam{
+				c.On("DeleteTask", mock.Anything, api.DeleteTaskParam{
 					Workspace: "w",
 					ProjectID: "p-1",
 					TaskID:    "task-id",
@@ -284,7 +285,7 @@ func TestCmdDeleteReport(t *testing.T) {
 
 			cf.On("IsAllowNameForID").Return(false)
 
-			c.On("DeleteTask", api.DeleteTaskParam{
+			c.On("DeleteTask", mock.Anything, api.DeleteTaskParam{
 				Workspace: "w",
 				ProjectID: "p-1",
 				TaskID:    "t-1",
@@ -61,6 +61,8 @@ func NewCmdDone(
 			No active task with id or name containing 'five' was found
 		`, "clockify-cli task -p cli"),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
 			if err := of.Check(); err != nil {
 				return err
 			}
@@ -88,11 +90,13 @@ func NewCmdDone(
 
 			if f.Config().IsAllowNameForID() {
 				if project, err = search.GetProjectByName(
+					ctx,
 					c, workspace, project); err != nil {
 					return err
 				}
 
 				if ids, err = search.GetTasksByName(
+					ctx,
 					c,
 					api.GetTasksParam{
 						Workspace: workspace,
@@ -116,7 +120,7 @@ func NewCmdDone(
 			for i := 0; i < len(ids); i++ {
 				j := i
 				g.Go(func() error {
-					t, err := c.GetTask(api.GetTaskParam{
+					t, err := c.GetTask(ctx, api.GetTaskParam{
 						Workspace: workspace,
 						ProjectID: project,
 						TaskID:    ids[j],
@@ -125,7 +129,7 @@ func NewCmdDone(
 						return err
 					}
 
-					tasks[j], err = c.UpdateTask(api.UpdateTaskParam{
+					tasks[j], err = c.UpdateTask(ctx, api.UpdateTaskParam{
 						Workspace: workspace,
 						ProjectID: t.ProjectID,
 						TaskID:    t.ID,
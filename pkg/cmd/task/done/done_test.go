@@ -12,6 +12,7 @@ import (
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/task/util"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestCmdDone(t *testing.T) {
@@ -124,7 +125,7 @@ func TestCmdDone(t *testing.T) {
 				f.On("Config").Return(cf)
 				cf.On("IsAllowNameForID").Return(true)
 
-				c.On("GetProjects", api.GetProjectsParam{
+				c.On("GetProjects", mock.Anything, api.GetProjectsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).
@@ -147,13 +148,13 @@ func TestCmdDone(t *testing.T) {
 				f.On("Config").Return(cf)
 				cf.On("IsAllowNameForID").Return(true)
 
-				c.On("GetProjects", api.GetProjectsParam{
+				c.On("GetProjects", mock.Anything, api.GetProjectsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).
 					Return([]dto.Project{{ID: "p-1"}}, nil)
 
-				c.On("GetTasks", api.GetTasksParam{
+				c.On("GetTasks", mock.Anything, api.GetTasksParam{
 					Workspace:       "w",
 					ProjectID:       "p-1",
 					Active:          true,
@@ -179,7 +180,7 @@ func TestCmdDone(t *testing.T) {
 				f.On("Config").Return(cf)
 				cf.On("IsAllowNameForID").Return(true)
 
-				c.On("GetProjects", api.GetProjectsParam{
+				c.On("GetProjects", mock.Anything, api.GetProjectsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).
@@ -189,7 +190,7 @@ func TestCmdDone(t *testing.T) {
 					{ID: "t-1", ProjectID: "p-1", Name: "Task 1"},
 					{ID: "t-2", ProjectID: "p-1", Name: "Task 2"},
 				}
-				c.On("GetTasks", api.GetTasksParam{
+				c.On("GetTasks", mock.Anything, api.GetTasksParam{
 					Workspace:       "w",
 					ProjectID:       "p-1",
 					Active:          true,
@@ -197,14 +198,14 @@ func TestCmdDone(t *testing.T) {
 				}).
 					Return(ts, nil)
 
-				c.On("GetTask", api.GetTaskParam{
+				c.On("GetTask", mock.Anything, api.GetTaskParam{
 					Workspace: "w",
 					ProjectID: ts[0].ProjectID,
 					TaskID:    ts[0].ID,
 				}).
 					Return(ts[0], nil)
 
-				c.On("UpdateTask", api.UpdateTaskParam{
+				c.On("UpdateTask", mock.Anything, api.UpdateTaskParam{
 					Workspace: "w",
 					ProjectID: ts[0].ProjectID,
 					TaskID:    ts[0].ID,
@@ -213,7 +214,7 @@ func TestCmdDone(t *testing.T) {
 				}).
 					Return(ts[0], nil)
 
-				c.On("GetTask", api.GetTaskParam{
+				c.On("GetTask", mock.Anything, api.GetTaskParam{
 					Workspace: "w",
 					ProjectID: ts[1].ProjectID,
 					TaskID:    ts[1].ID,
@@ -238,7 +239,7 @@ func TestCmdDone(t *testing.T) {
 				f.On("Config").Return(cf)
 				cf.On("IsAllowNameForID").Return(true)
 
-				c.On("GetProjects", api.GetProjectsParam{
+				c.On("GetProjects", mock.Anything, api.GetProjectsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).
@@ -248,7 +249,7 @@ func TestCmdDone(t *testing.T) {
 					{ID: "t-1", ProjectID: "p-1", Name: "Task 1"},
 					{ID: "t-2", ProjectID: "p-1", Name: "Task 2"},
 				}
-				c.On("GetTasks", api.GetTasksParam{
+				c.On("GetTasks", mock.Anything, api.GetTasksParam{
 					Workspace:       "w",
 					ProjectID:       "p-1",
 					Active:          true,
@@ -256,14 +257,14 @@ func TestCmdDone(t *testing.T) {
 				}).
 					Return(ts, nil)
 
-				c.On("GetTask", api.GetTaskParam{
+				c.On("GetTask", mock.Anything, api.GetTaskParam{
 					Workspace: "w",
 					ProjectID: ts[0].ProjectID,
 					TaskID:    ts[0].ID,
 				}).
 					Return(ts[0], nil)
 
-				c.On("UpdateTask", api.UpdateTaskParam{
+				c.On("UpdateTask", mock.Anything, api.UpdateTaskParam{
 					Workspace: "w",
 					ProjectID: ts[0].ProjectID,
 					TaskID:    ts[0].ID,
@@ -272,14 +273,14 @@ func TestCmdDone(t *testing.T) {
 				}).
 					Return(ts[0], nil)
 
-				c.On("GetTask", api.GetTaskParam{
+				c.On("GetTask", mock.Anything, api.GetTaskParam{
 					Workspace: "w",
 					ProjectID: ts[1].ProjectID,
 					TaskID:    ts[1].ID,
 				}).
 					Return(ts[1], nil)
 
-				c.On("UpdateTask", api.UpdateTaskParam{
+				c.On("UpdateTask", mock.Anything, api.UpdateTaskParam{
 					Workspace: "w",
 					ProjectID: ts[1].ProjectID,
 					TaskID:    ts[1].ID,
@@ -305,14 +306,14 @@ func TestCmdDone(t *testing.T) {
 				f.On("Config").Return(cf)
 				cf.On("IsAllowNameForID").Return(false)
 
-				c.On("GetTask", api.GetTaskParam{
+				c.On("GetTask", mock.Anything, api.GetTaskParam{
 					Workspace: "w",
 					ProjectID: te.ProjectID,
 					TaskID:    te.ID,
 				}).
 					Return(te, nil)
 
-				c.On("UpdateTask", api.UpdateTaskParam{
+				c.On("UpdateTask", mock.Anything, api.UpdateTaskParam{
 					Workspace: "w",
 					ProjectID: te.ProjectID,
 					TaskID:    te.ID,
@@ -417,14 +418,14 @@ func TestCmdDoneReport(t *testing.T) {
 			cf.On("IsAllowNameForID").Return(false)
 
 			fn := func(t dto.Task) {
-				c.On("GetTask", api.GetTaskParam{
+				c.On("GetTask", mock.Anything, api.GetTaskParam{
 					Workspace: "w",
 					ProjectID: t.ProjectID,
 					TaskID:    t.ID,
 				}).
 					Return(t, nil)
 
-				c.On("UpdateTask", api.UpdateTaskParam{
+				c.On("UpdateTask", mock.Anything, api.UpdateTaskParam{
 					Workspace: "w",
 					ProjectID: t.ProjectID,
 					TaskID:    t.ID,
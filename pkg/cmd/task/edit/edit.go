@@ -32,7 +32,7 @@ func NewCmdEdit(
 		Long: heredoc.Doc(`
 			Edits a task on a Clockify's project, allowing to change the name, estimated time, assignees, status and billable settings.
 
-			If you set a estimate for the task, but the project is set as manual estimation, then it will have no effect on Clockify.
+			If you set a estimate or budget for the task, but the project is set as manual estimation, then it will have no effect on Clockify.
 		`),
 		Example: heredoc.Docf(`
 			$ %[1]s -p special 62aa5d7049445270d7b979d6 --name="Very Important"
@@ -65,6 +65,8 @@ func NewCmdEdit(
 			Very Important | ACTIVE | []
 		`, "clockify-cli task edit"),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
 			task := strings.TrimSpace(args[0])
 			if task == "" {
 				return errors.New("task id should not be empty")
@@ -86,6 +88,7 @@ func NewCmdEdit(
 
 			if f.Config().IsAllowNameForID() {
 				if task, err = search.GetTaskByName(
+					ctx,
 					c,
 					api.GetTasksParam{
 						Workspace: fl.Workspace, ProjectID: fl.ProjectID},
@@ -100,12 +103,13 @@ func NewCmdEdit(
 				TaskID:      task,
 				Name:        fl.Name,
 				Estimate:    fl.Estimate,
+				Budget:      fl.Budget,
 				AssigneeIDs: fl.AssigneeIDs,
 				Billable:    fl.Billable,
 			}
 
 			if !cmd.Flags().Changed("name") {
-				t, err := c.GetTask(api.GetTaskParam{
+				t, err := c.GetTask(ctx, api.GetTaskParam{
 					Workspace: fl.Workspace,
 					ProjectID: fl.ProjectID,
 					TaskID:    task,
@@ -126,7 +130,7 @@ func NewCmdEdit(
 				p.Status = api.TaskStatusDefault
 			}
 
-			t, err := c.UpdateTask(p)
+			t, err := c.UpdateTask(ctx, p)
 			if err != nil {
 				return err
 			}
@@ -13,6 +13,7 @@ import (
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/task/util"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestCmdEdit(t *testing.T) {
@@ -128,7 +129,7 @@ func TestCmdEdit(t *testing.T) {
 				f.On("Config").Return(cf)
 				cf.On("IsAllowNameForID").Return(true)
 
-				c.On("GetProjects", api.GetProjectsParam{
+				c.On("GetProjects", mock.Anything, api.GetProjectsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).
@@ -151,13 +152,13 @@ func TestCmdEdit(t *testing.T) {
 				f.On("Config").Return(cf)
 				cf.On("IsAllowNameForID").Return(true)
 
-				c.On("GetProjects", api.GetProjectsParam{
+				c.On("GetProjects", mock.Anything, api.GetProjectsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).
 					Return([]dto.Project{{Name: "Cli"}}, nil)
 
-				c.On("WorkspaceUsers", api.WorkspaceUsersParam{
+				c.On("WorkspaceUsers", mock.Anything, api.WorkspaceUsersParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).
@@ -181,13 +182,13 @@ func TestCmdEdit(t *testing.T) {
 				f.On("Config").Return(cf)
 				cf.On("IsAllowNameForID").Return(true)
 
-				c.On("GetProjects", api.GetProjectsParam{
+				c.On("GetProjects", mock.Anything, api.GetProjectsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).
 					Return([]dto.Project{{ID: "p-1", Name: "Cli"}}, nil)
 
-				c.On("GetTasks", api.GetTasksParam{
+				c.On("GetTasks", mock.Anything, api.GetTasksParam{
 					Workspace:       "w",
 					ProjectID:       "p-1",
 					PaginationParam: api.AllPages(),
@@ -212,7 +213,7 @@ func TestCmdEdit(t *testing.T) {
 				f.On("Config").Return(cf)
 				cf.On("IsAllowNameForID").Return(false)
 
-				c.On("UpdateTask", api.UpdateTaskParam{
+				c.On("UpdateTask", mock.Anything, api.UpdateTaskParam{
 					Workspace: "w",
 					TaskID:    "task-id",
 					ProjectID: "ok",
@@ -243,13 +244,13 @@ func TestCmdEdit(t *testing.T) {
 				f.On("Config").Return(cf)
 				cf.On("IsAllowNameForID").Return(true)
 
-				c.On("GetProjects", api.GetProjectsParam{
+				c.On("GetProjects", mock.Anything, api.GetProjectsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).Return(
 					[]dto.Project{{ID: "p-1", Name: "Clockify CLI"}}, nil)
 
-				c.On("GetTasks", api.GetTasksParam{
+				c.On("GetTasks", mock.Anything, api.GetTasksParam{
 					Workspace:       "w",
 					ProjectID:       "p-1",
 					PaginationParam: api.AllPages(),
@@ -259,7 +260,7 @@ func TestCmdEdit(t *testing.T) {
 				b := true
 				e := time.Hour * 32
 				us := []string{}
-				c.On("UpdateTask", api.UpdateTaskParam{
+				c.On("UpdateTask", mock.Anything, api.UpdateTaskParam{
 					Workspace:   "w",
 					TaskID:      "t-1",
 					Name:        "Edit",
@@ -294,21 +295,21 @@ func TestCmdEdit(t *testing.T) {
 				f.On("Config").Return(cf)
 				cf.On("IsAllowNameForID").Return(true)
 
-				c.On("GetProjects", api.GetProjectsParam{
+				c.On("GetProjects", mock.Anything, api.GetProjectsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).Return(
 					[]dto.Project{{ID: "p-1", Name: "Clockify CLI"}}, nil)
 
 				ts := dto.Task{ID: "t-1", Name: "Edit Command"}
-				c.On("GetTasks", api.GetTasksParam{
+				c.On("GetTasks", mock.Anything, api.GetTasksParam{
 					Workspace:       "w",
 					ProjectID:       "p-1",
 					PaginationParam: api.AllPages(),
 				}).Return(
 					[]dto.Task{ts}, nil)
 
-				c.On("WorkspaceUsers", api.WorkspaceUsersParam{
+				c.On("WorkspaceUsers", mock.Anything, api.WorkspaceUsersParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).
@@ -317,7 +318,7 @@ func TestCmdEdit(t *testing.T) {
 						{ID: "u-2", Name: "John Due"},
 					}, nil)
 
-				c.On("GetTask", api.GetTaskParam{
+				c.On("GetTask", mock.Anything, api.GetTaskParam{
 					Workspace: "w",
 					ProjectID: "p-1",
 					TaskID:    "t-1",
@@ -325,7 +326,7 @@ func TestCmdEdit(t *testing.T) {
 
 				b := false
 				as := []string{"u-1", "u-2"}
-				c.On("UpdateTask", api.UpdateTaskParam{
+				c.On("UpdateTask", mock.Anything, api.UpdateTaskParam{
 					Workspace:   "w",
 					TaskID:      "t-1",
 					Name:        "Edit Command",
@@ -360,7 +361,7 @@ func TestCmdEdit(t *testing.T) {
 				cf.On("IsAllowNameForID").Return(false)
 
 				ts := dto.Task{ID: "t-1", Name: "Edit Command"}
-				c.On("GetTask", api.GetTaskParam{
+				c.On("GetTask", mock.Anything, api.GetTaskParam{
 					Workspace: "w",
 					ProjectID: "p-1",
 					TaskID:    "t-1",
@@ -368,7 +369,7 @@ func TestCmdEdit(t *testing.T) {
 
 				b := false
 				as := []string{"u-1", "u-2"}
-				c.On("UpdateTask", api.UpdateTaskParam{
+				c.On("UpdateTask", mock.Anything, api.UpdateTaskParam{
 					Workspace:   "w",
 					TaskID:      "t-1",
 					Name:        "Edit Command",
@@ -470,7 +471,7 @@ func TestCmdEditReport(t *testing.T) {
 
 			cf.On("IsAllowNameForID").Return(false)
 
-			c.On("UpdateTask", api.UpdateTaskParam{
+			c.On("UpdateTask", mock.Anything, api.UpdateTaskParam{
 				Workspace: "w",
 				ProjectID: "p-1",
 				TaskID:    "t-1",
@@ -18,6 +18,7 @@ func NewCmdList(
 	report func(io.Writer, *util.OutputFlags, []dto.Task) error,
 ) *cobra.Command {
 	of := util.OutputFlags{}
+	pagination := api.AllPages()
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List tasks in a Clockify project",
@@ -41,6 +42,8 @@ func NewCmdList(
 		`, "clockify-cli task list"),
 		Aliases: []string{"ls"},
 		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
 			workspace, err := f.GetWorkspaceID()
 			if err != nil {
 				return err
@@ -53,7 +56,7 @@ func NewCmdList(
 
 			p := api.GetTasksParam{
 				Workspace:       workspace,
-				PaginationParam: api.AllPages(),
+				PaginationParam: pagination,
 			}
 
 			p.Active, _ = cmd.Flags().GetBool("active")
@@ -63,12 +66,13 @@ func NewCmdList(
 			if f.Config().IsAllowNameForID() &&
 				p.ProjectID != "" {
 				if p.ProjectID, err = search.GetProjectByName(
+					ctx,
 					c, workspace, p.ProjectID); err != nil {
 					return err
 				}
 			}
 
-			tasks, err := c.GetTasks(p)
+			tasks, err := c.GetTasks(ctx, p)
 			if err != nil {
 				return err
 			}
@@ -87,6 +91,7 @@ func NewCmdList(
 
 	util.TaskAddReportFlags(cmd, &of)
 	cmdutil.AddProjectFlags(cmd, f)
+	cmdutil.AddPaginationFlags(cmd.Flags(), &pagination)
 
 	return cmd
 }
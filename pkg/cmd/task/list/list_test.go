@@ -12,6 +12,7 @@ import (
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/task/util"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 type report func(io.Writer, *util.OutputFlags, []dto.Task) error
@@ -67,7 +68,7 @@ func TestCmdList(t *testing.T) {
 				c := mocks.NewMockClient(t)
 				f.On("Client").Return(c, nil)
 
-				c.On("GetProjects", api.GetProjectsParam{
+				c.On("GetProjects", mock.Anything, api.GetProjectsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).Return([]dto.Project{}, nil)
@@ -91,7 +92,7 @@ func TestCmdList(t *testing.T) {
 				c := mocks.NewMockClient(t)
 				f.On("Client").Return(c, nil)
 
-				c.On("GetTasks", api.GetTasksParam{
+				c.On("GetTasks", mock.Anything, api.GetTasksParam{
 					Workspace:       "w",
 					ProjectID:       "p-1",
 					PaginationParam: api.AllPages(),
@@ -115,7 +116,7 @@ func TestCmdList(t *testing.T) {
 				c := mocks.NewMockClient(t)
 				f.On("Client").Return(c, nil)
 
-				c.On("GetProjects", api.GetProjectsParam{
+				c.On("GetProjects", mock.Anything, api.GetProjectsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).Return([]dto.Project{
@@ -126,7 +127,7 @@ func TestCmdList(t *testing.T) {
 					{ID: "1", Name: "List"},
 					{ID: "2", Name: "List Tasks"},
 				}
-				c.On("GetTasks", api.GetTasksParam{
+				c.On("GetTasks", mock.Anything, api.GetTasksParam{
 					Workspace:       "w",
 					ProjectID:       "p-1",
 					Active:          true,
@@ -235,7 +236,7 @@ func TestCmdListReport(t *testing.T) {
 
 			cf.On("IsAllowNameForID").Return(false)
 
-			c.On("GetTasks", api.GetTasksParam{
+			c.On("GetTasks", mock.Anything, api.GetTasksParam{
 				Workspace:       "w",
 				ProjectID:       "p-1",
 				PaginationParam: api.AllPages(),
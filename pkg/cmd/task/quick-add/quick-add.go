@@ -45,6 +45,8 @@ func NewCmdQuickAdd(
 			62ab145ec22de9759e6f6e36,Not Billable,ACTIVE
 		`, "clockify-cli task quick-add"),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
 			if err := of.Check(); err != nil {
 				return err
 			}
@@ -61,7 +63,8 @@ func NewCmdQuickAdd(
 
 			p, _ := cmd.Flags().GetString("project")
 			if f.Config().IsAllowNameForID() {
-				if p, err = search.GetProjectByName(c, w, p); err != nil {
+				if p, err = search.GetProjectByName(ctx, c, w,
+					p); err != nil {
 					return err
 				}
 			}
@@ -72,7 +75,7 @@ func NewCmdQuickAdd(
 			for j := range names {
 				i := j
 				g.Go(func() error {
-					tasks[i], err = c.AddTask(api.AddTaskParam{
+					tasks[i], err = c.AddTask(ctx, api.AddTaskParam{
 						Workspace: w,
 						ProjectID: p,
 						Name:      names[i],
@@ -12,6 +12,7 @@ import (
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/task/util"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestCmdQuickAdd(t *testing.T) {
@@ -95,7 +96,7 @@ func TestCmdQuickAdd(t *testing.T) {
 				f.EXPECT().Config().Return(cf)
 				cf.EXPECT().IsAllowNameForID().Return(true)
 
-				c.EXPECT().GetProjects(api.GetProjectsParam{
+				c.EXPECT().GetProjects(mock.Anything, api.GetProjectsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).
@@ -118,7 +119,7 @@ func TestCmdQuickAdd(t *testing.T) {
 				f.EXPECT().Config().Return(cf)
 				cf.EXPECT().IsAllowNameForID().Return(false)
 
-				c.EXPECT().AddTask(api.AddTaskParam{
+				c.EXPECT().AddTask(mock.Anything, api.AddTaskParam{
 					Workspace: "w",
 					ProjectID: "ok",
 					Name:      "error",
@@ -144,13 +145,13 @@ func TestCmdQuickAdd(t *testing.T) {
 				f.EXPECT().Config().Return(cf)
 				cf.EXPECT().IsAllowNameForID().Return(true)
 
-				c.EXPECT().GetProjects(api.GetProjectsParam{
+				c.EXPECT().GetProjects(mock.Anything, api.GetProjectsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).Return(
 					[]dto.Project{{ID: "p-1", Name: "Clockify CLI"}}, nil)
 
-				c.EXPECT().AddTask(api.AddTaskParam{
+				c.EXPECT().AddTask(mock.Anything, api.AddTaskParam{
 					Workspace: "w",
 					Name:      "Add",
 					ProjectID: "p-1",
@@ -180,27 +181,27 @@ func TestCmdQuickAdd(t *testing.T) {
 				f.EXPECT().Config().Return(cf)
 				cf.EXPECT().IsAllowNameForID().Return(true)
 
-				c.EXPECT().GetProjects(api.GetProjectsParam{
+				c.EXPECT().GetProjects(mock.Anything, api.GetProjectsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).Return(
 					[]dto.Project{{ID: "p-1", Name: "Clockify CLI"}}, nil)
 
-				c.EXPECT().AddTask(api.AddTaskParam{
+				c.EXPECT().AddTask(mock.Anything, api.AddTaskParam{
 					Workspace: "w",
 					Name:      "Task 00",
 					ProjectID: "p-1",
 				}).
 					Return(dto.Task{ID: "00"}, nil)
 
-				c.EXPECT().AddTask(api.AddTaskParam{
+				c.EXPECT().AddTask(mock.Anything, api.AddTaskParam{
 					Workspace: "w",
 					Name:      "Task 01",
 					ProjectID: "p-1",
 				}).
 					Return(dto.Task{ID: "01"}, nil)
 
-				c.EXPECT().AddTask(api.AddTaskParam{
+				c.EXPECT().AddTask(mock.Anything, api.AddTaskParam{
 					Workspace: "w",
 					Name:      "Task 02",
 					ProjectID: "p-1",
@@ -299,7 +300,7 @@ func TestCmdQuickAddReport(t *testing.T) {
 
 			cf.EXPECT().IsAllowNameForID().Return(false)
 
-			c.EXPECT().AddTask(api.AddTaskParam{
+			c.EXPECT().AddTask(mock.Anything, api.AddTaskParam{
 				Workspace: "w",
 				ProjectID: "p-1",
 				Name:      "Task Add",
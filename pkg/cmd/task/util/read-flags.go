@@ -1,6 +1,8 @@
 package util
 
 import (
+	"errors"
+	"math"
 	"time"
 
 	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
@@ -14,6 +16,8 @@ import (
 func TaskAddPropFlags(cmd *cobra.Command, f cmdutil.Factory) {
 	cmd.Flags().StringP("name", "n", "", "new name of the task")
 	cmd.Flags().Int32P("estimate", "E", 0, "estimation on hours")
+	cmd.Flags().Float64("budget", 0,
+		"budget estimation, on the currency of the workspace")
 	cmd.Flags().Bool("billable", false, "sets the task as billable")
 	cmd.Flags().Bool("not-billable", false, "sets the task as not billable")
 
@@ -34,6 +38,7 @@ type FlagsDTO struct {
 	ProjectID   string
 	Name        string
 	Estimate    *time.Duration
+	Budget      *uint64
 	AssigneeIDs *[]string
 	Billable    *bool
 }
@@ -67,6 +72,17 @@ func TaskReadFlags(cmd *cobra.Command, f cmdutil.Factory) (p FlagsDTO, err error
 		p.Estimate = &d
 	}
 
+	if cmd.Flags().Changed("budget") {
+		b, _ := cmd.Flags().GetFloat64("budget")
+		if b < 0 {
+			return p, cmdutil.FlagErrorWrap(
+				errors.New("budget can't be negative"))
+		}
+
+		v := uint64(math.Round(b * 100))
+		p.Budget = &v
+	}
+
 	if cmd.Flags().Changed("assignee") {
 		assignees, _ := cmd.Flags().GetStringSlice("assignee")
 		p.AssigneeIDs = &assignees
@@ -79,6 +95,7 @@ func TaskReadFlags(cmd *cobra.Command, f cmdutil.Factory) (p FlagsDTO, err error
 		}
 
 		if p.ProjectID, err = search.GetProjectByName(
+			cmd.Context(),
 			c, p.Workspace, p.ProjectID); err != nil {
 			return p, err
 		}
@@ -86,6 +103,7 @@ func TaskReadFlags(cmd *cobra.Command, f cmdutil.Factory) (p FlagsDTO, err error
 		if p.AssigneeIDs != nil {
 			as := *p.AssigneeIDs
 			if as, err = search.GetUsersByName(
+				cmd.Context(),
 				c, p.Workspace, as); err != nil {
 				return p, err
 			}
@@ -11,8 +11,10 @@ import (
 type OutputFlags struct {
 	Format string
 	JSON   bool
+	YAML   bool
 	CSV    bool
 	Quiet  bool
+	Sort   string
 }
 
 // Check guaranties that only one type of output is chosen
@@ -20,6 +22,7 @@ func (of OutputFlags) Check() error {
 	return cmdutil.XorFlag(map[string]bool{
 		"format": of.Format != "",
 		"json":   of.JSON,
+		"yaml":   of.YAML,
 		"csv":    of.CSV,
 		"quiet":  of.Quiet,
 	})
@@ -29,7 +32,11 @@ func (of OutputFlags) Check() error {
 func TaskAddReportFlags(cmd *cobra.Command, of *OutputFlags) {
 	cmd.Flags().StringVarP(&of.Format, "format", "f", "",
 		"golang text/template format to be applied on each Client")
+	cmdutil.AddTemplateSourceFlags(cmd.Flags())
 	cmd.Flags().BoolVarP(&of.JSON, "json", "j", false, "print as JSON")
+	cmd.Flags().BoolVar(&of.YAML, "yaml", false, "print as YAML")
+	cmd.Flags().StringVar(&of.Sort, "sort", "",
+		"sorts the tasks by a field, like: name or name:desc")
 	cmd.Flags().BoolVarP(&of.CSV, "csv", "v", false, "print as CSV")
 	cmd.Flags().BoolVarP(&of.Quiet, "quiet", "q", false, "only display ids")
 }
@@ -37,10 +44,17 @@ func TaskAddReportFlags(cmd *cobra.Command, of *OutputFlags) {
 // TaskReport will output the task as set by the flags
 func TaskReport(cmd *cobra.Command, of OutputFlags, tasks ...dto.Task) error {
 	out := cmd.OutOrStdout()
+	if of.Sort != "" {
+		if err := task.SortTasks(tasks, of.Sort); err != nil {
+			return err
+		}
+	}
 
 	switch {
 	case of.JSON:
 		return task.TasksJSONPrint(tasks, out)
+	case of.YAML:
+		return task.TasksYAMLPrint(tasks, out)
 	case of.CSV:
 		return task.TasksCSVPrint(tasks, out)
 	case of.Quiet:
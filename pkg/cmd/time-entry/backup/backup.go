@@ -0,0 +1,131 @@
+package backup
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	reportutil "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdBackup represents the backup command
+func NewCmdBackup(f cmdutil.Factory) *cobra.Command {
+	var since, until, out string
+
+	cmd := &cobra.Command{
+		Use:   "backup",
+		Short: "Saves your time entries into a file, to be restored later",
+		Long: heredoc.Doc(`
+			Saves your finished time entries of the workspace started between
+			--since and --until (inclusive) into a JSON file, with their tags,
+			tasks and custom fields.
+
+			Use "clockify-cli restore" to create them again from the file.
+		`),
+		Example: heredoc.Docf(`
+			# save the time entries of 2022
+			$ %[1]s --since 2022-01-01 --until 2022-12-31 --out backup.json
+
+			# save the time entries since june until today
+			$ %[1]s --since 2022-06-01 > backup.json
+		`, "clockify-cli backup"),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if since == "" {
+				return cmdutil.FlagErrorWrap(
+					errors.New("--since is required"))
+			}
+
+			start, end, err := reportutil.ParseRangeArgs(
+				[]string{since, until})
+			if err != nil {
+				return cmdutil.FlagErrorWrap(errors.Wrap(err,
+					"--since and --until must be dates like 2022-06-01"))
+			}
+
+			start = timehlp.TruncateDate(start)
+			end = timehlp.TruncateDate(end).Add(time.Hour * 24)
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			u, err := f.GetUserID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			log, err := c.LogRange(cmd.Context(), api.LogRangeParam{
+				Workspace:       w,
+				UserID:          u,
+				FirstDate:       start,
+				LastDate:        end,
+				PaginationParam: api.AllPages(),
+			})
+			if err != nil {
+				return err
+			}
+
+			tes := make([]dto.TimeEntry, 0, len(log))
+			for i := range log {
+				if log[i].TimeInterval.End != nil {
+					tes = append(tes, log[i])
+				}
+			}
+
+			var o io.Writer = cmd.OutOrStdout()
+			if out != "" && out != "-" {
+				fh, err := os.Create(out)
+				if err != nil {
+					return errors.Wrap(err, "failed to create the backup file")
+				}
+				defer fh.Close()
+				o = fh
+			}
+
+			if err := util.WriteBackup(o, util.Backup{
+				CreatedAt:   timehlp.Now(),
+				WorkspaceID: w,
+				UserID:      u,
+				Since:       start,
+				Until:       end,
+				TimeEntries: tes,
+			}); err != nil {
+				return err
+			}
+
+			if o != cmd.OutOrStdout() {
+				fmt.Fprintf(cmd.ErrOrStderr(),
+					"%d time entries saved into %s\n", len(tes), out)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&since, "since", "",
+		"first day of the time entries to save (like: 2022-06-01)")
+	cmd.Flags().StringVar(&until, "until", "today",
+		"last day of the time entries to save (like: 2022-06-30)")
+	cmd.Flags().StringVarP(&out, "out", "o", "",
+		"file to save the time entries into, when not set they are "+
+			"printed on the stdout")
+	_ = cmd.MarkFlagFilename("out", "json")
+
+	return cmd
+}
@@ -0,0 +1,99 @@
+package backup_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/backup"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCmdBackup(t *testing.T) {
+	start := time.Date(2022, 6, 1, 9, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	finished := dto.TimeEntry{
+		ID:          "te1",
+		Description: "Writing docs",
+		ProjectID:   "p1",
+		Task:        &dto.Task{ID: "t1", Name: "Docs"},
+		Tags:        []dto.Tag{{ID: "tg1", Name: "Dev"}},
+		TimeInterval: dto.TimeInterval{
+			Start: start,
+			End:   &end,
+		},
+		CustomFields: []dto.TimeEntryCustomField{
+			{CustomFieldID: "cf1", Name: "Ticket", Value: "CLI-1"},
+		},
+	}
+
+	f := mocks.NewMockFactory(t)
+	f.EXPECT().GetWorkspaceID().Return("w", nil)
+	f.EXPECT().GetUserID().Return("u", nil)
+
+	c := mocks.NewMockClient(t)
+	f.EXPECT().Client().Return(c, nil)
+
+	c.EXPECT().LogRange(mock.Anything, api.LogRangeParam{
+		Workspace:       "w",
+		UserID:          "u",
+		FirstDate:       time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC),
+		LastDate:        time.Date(2022, 7, 1, 0, 0, 0, 0, time.UTC),
+		PaginationParam: api.AllPages(),
+	}).
+		Return([]dto.TimeEntry{
+			finished,
+			{
+				ID:           "running",
+				TimeInterval: dto.TimeInterval{Start: end},
+			},
+		}, nil)
+
+	out := filepath.Join(t.TempDir(), "backup.json")
+
+	cmd := backup.NewCmdBackup(f)
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	stderr := bytes.NewBufferString("")
+	cmd.SetOut(bytes.NewBufferString(""))
+	cmd.SetErr(stderr)
+	cmd.SetArgs([]string{
+		"--since=2022-06-01", "--until=2022-06-30", "--out=" + out})
+
+	_, err := cmd.ExecuteC()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, "1 time entries saved into "+out+"\n", stderr.String())
+
+	fh, err := os.Open(out)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer fh.Close()
+
+	b, err := util.ReadBackup(fh)
+	assert.NoError(t, err)
+	assert.Equal(t, "w", b.WorkspaceID)
+	assert.Equal(t, "u", b.UserID)
+	assert.Equal(t, []dto.TimeEntry{finished}, b.TimeEntries)
+}
+
+func TestCmdBackupRequiresSince(t *testing.T) {
+	cmd := backup.NewCmdBackup(mocks.NewMockFactory(t))
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	cmd.SetArgs([]string{})
+
+	_, err := cmd.ExecuteC()
+	assert.EqualError(t, err, "--since is required")
+}
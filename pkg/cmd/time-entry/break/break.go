@@ -0,0 +1,38 @@
+package breakcmd
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/break/end"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/break/start"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdBreak represents the break command
+func NewCmdBreak(f cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "break",
+		Short: "Tracks the breaks taken while working",
+		Long: heredoc.Docf(`
+			Tracks the breaks taken while working: "break start" pauses the
+			time entry running and "break end" resumes it.
+
+			When the config %[1]s is set, a time entry on that project is
+			running while the break is taken, so the breaks can be seen on the
+			reports (and left out of them with --no-breaks). Otherwise the
+			break is only the gap between the paused time entry and the one
+			resuming it.
+
+			To set a project for breaks use:
+			clockify-cli config set %[1]s Break
+		`, cmdutil.CONF_BREAK_PROJECT),
+		Args: cobra.NoArgs,
+	}
+
+	cmd.AddCommand(
+		start.NewCmdStart(f),
+		end.NewCmdEnd(f),
+	)
+
+	return cmd
+}
@@ -0,0 +1,153 @@
+package end
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	output "github.com/lucassabreu/clockify-cli/pkg/output/time-entry"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdEnd represents the break end command
+func NewCmdEnd(f cmdutil.Factory) *cobra.Command {
+	of := util.OutputFlags{TimeFormat: output.TimeFormatSimple}
+	var at string
+	var noResume bool
+
+	cmd := &cobra.Command{
+		Use:   "end",
+		Short: "Ends the break, resuming the time entry paused",
+		Long: heredoc.Doc(`
+			Ends the break started by "break start", stopping the time entry
+			of the break (if any) and starting a copy of the time entry paused
+			by it.
+
+			If another time entry was started while on the break, the time
+			entry paused is not resumed.
+		`) + "\n" +
+			"When setting `--at` you can use any of the following formats:\n" +
+			util.HelpDateTimeFormats + "\n" +
+			util.HelpMoreInfoAboutPrinting,
+		Example: heredoc.Docf(`
+			$ %[1]s -q
+			break of 45m0s
+			62ae4b304ebb4f143c931d52
+
+			# back from the break, but not to the same task
+			$ %[1]s --no-resume
+			break of 15m0s
+		`, "clockify-cli break end"),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			end, err := timehlp.ConvertToTime(at)
+			if err != nil {
+				return cmdutil.FlagErrorWrap(
+					errors.Wrap(err, "invalid --at"))
+			}
+
+			s, err := cmdutil.BreakStore()
+			if err != nil {
+				return err
+			}
+
+			br, err := s.Current()
+			if err != nil {
+				return err
+			}
+
+			if br == nil {
+				return errors.New("there is no break started, use " +
+					"\"clockify-cli break start\"")
+			}
+
+			if end.Before(br.Start) {
+				return cmdutil.FlagErrorWrap(errors.Errorf(
+					"`at` can't be before the break started (%s)",
+					br.Start.In(time.Local).Format(timehlp.FullTimeFormat)))
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			te, err := c.GetTimeEntryInProgress(ctx,
+				api.GetTimeEntryInProgressParam{
+					Workspace: br.Workspace,
+					UserID:    br.UserID,
+				})
+			if err != nil {
+				return err
+			}
+
+			if te != nil && br.TimeEntryID != "" && te.ID == br.TimeEntryID {
+				if err := c.Out(ctx, api.OutParam{
+					Workspace: br.Workspace,
+					UserID:    br.UserID,
+					End:       end,
+				}); err != nil {
+					return err
+				}
+
+				te = nil
+			}
+
+			stderr := cmd.ErrOrStderr()
+			fmt.Fprintf(stderr, "break of %s\n",
+				end.Sub(br.Start).Round(time.Second))
+
+			if te != nil && !noResume && br.PausedID != "" {
+				noResume = true
+				fmt.Fprintln(stderr, "another time entry was started on "+
+					"the break, so the one paused was not resumed")
+			}
+
+			if noResume || br.PausedID == "" {
+				return s.End()
+			}
+
+			p, err := c.GetHydratedTimeEntry(ctx, api.GetTimeEntryParam{
+				Workspace:   br.Workspace,
+				TimeEntryID: br.PausedID,
+			})
+			if err != nil {
+				return err
+			}
+
+			cp := util.CopyTimeEntryParam(br.Workspace, *p)
+			cp.Start = end
+			n, err := c.CreateTimeEntry(ctx, cp)
+			if err != nil {
+				return err
+			}
+
+			if err := s.End(); err != nil {
+				return err
+			}
+
+			n.WorkspaceID = br.Workspace
+			return util.PrintTimeEntryImpl(ctx, n, f,
+				cmd.OutOrStdout(), of)
+		},
+	}
+
+	cmd.Flags().StringVar(&at, "at", timehlp.NowTimeFormat,
+		"when the break ended")
+	cmd.Flags().BoolVar(&noResume, "no-resume", false,
+		"does not resume the time entry paused by the break")
+	util.AddPrintTimeEntriesFlags(cmd, &of)
+
+	return cmd
+}
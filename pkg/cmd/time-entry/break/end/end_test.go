@@ -0,0 +1,173 @@
+package end_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/breaks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/break/end"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCmdEnd(t *testing.T) {
+	start := time.Date(2022, 6, 1, 12, 0, 0, 0, time.Local)
+	at := start.Add(45 * time.Minute)
+	billable := true
+
+	resume := func(c *mocks.MockClient) {
+		c.EXPECT().GetHydratedTimeEntry(mock.Anything, api.GetTimeEntryParam{
+			Workspace: "w", TimeEntryID: "te1"}).
+			Return(&dto.TimeEntry{
+				ID:          "te1",
+				Billable:    true,
+				Description: "Writing docs",
+				Project:     &dto.Project{ID: "p1"},
+				Tags:        []dto.Tag{{ID: "tg1"}},
+			}, nil).Once()
+		c.EXPECT().CreateTimeEntry(mock.Anything, api.CreateTimeEntryParam{
+			Workspace:   "w",
+			Start:       at,
+			Billable:    &billable,
+			Description: "Writing docs",
+			ProjectID:   "p1",
+			TagIDs:      []string{"tg1"},
+		}).
+			Return(dto.TimeEntryImpl{ID: "te3"}, nil)
+		c.EXPECT().GetHydratedTimeEntry(mock.Anything, api.GetTimeEntryParam{
+			Workspace: "w", TimeEntryID: "te3"}).
+			Return(&dto.TimeEntry{ID: "te3"}, nil).Once()
+	}
+
+	tts := []struct {
+		name    string
+		args    []string
+		current *breaks.Break
+		running *dto.TimeEntryImpl
+		mock    func(*mocks.MockClient)
+		output  string
+		stderr  string
+		err     string
+	}{
+		{
+			name: "no break",
+			err:  "there is no break started, use \"clockify-cli break start\"",
+		},
+		{
+			name: "before the break",
+			args: []string{"--at", "2022-06-01 11:00"},
+			current: &breaks.Break{Start: start, Workspace: "w",
+				UserID: "u", PausedID: "te1"},
+			err: "`at` can't be before the break started " +
+				"(2022-06-01 12:00:00)",
+		},
+		{
+			name: "resume",
+			current: &breaks.Break{Start: start, Workspace: "w",
+				UserID: "u", PausedID: "te1"},
+			mock:   resume,
+			output: "te3\n",
+			stderr: "break of 45m0s\n",
+		},
+		{
+			name: "stops the break time entry",
+			current: &breaks.Break{Start: start, Workspace: "w",
+				UserID: "u", PausedID: "te1", TimeEntryID: "te2"},
+			running: &dto.TimeEntryImpl{ID: "te2"},
+			mock: func(c *mocks.MockClient) {
+				c.EXPECT().Out(mock.Anything, api.OutParam{
+					Workspace: "w", UserID: "u", End: at}).
+					Return(nil)
+				resume(c)
+			},
+			output: "te3\n",
+			stderr: "break of 45m0s\n",
+		},
+		{
+			name: "another time entry started",
+			current: &breaks.Break{Start: start, Workspace: "w",
+				UserID: "u", PausedID: "te1", TimeEntryID: "te2"},
+			running: &dto.TimeEntryImpl{ID: "te9"},
+			stderr: "break of 45m0s\nanother time entry was started on " +
+				"the break, so the one paused was not resumed\n",
+		},
+		{
+			name: "no resume",
+			args: []string{"--no-resume"},
+			current: &breaks.Break{Start: start, Workspace: "w",
+				UserID: "u", PausedID: "te1", TimeEntryID: "te2"},
+			running: &dto.TimeEntryImpl{ID: "te2"},
+			mock: func(c *mocks.MockClient) {
+				c.EXPECT().Out(mock.Anything, api.OutParam{
+					Workspace: "w", UserID: "u", End: at}).
+					Return(nil)
+			},
+			stderr: "break of 45m0s\n",
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			t.Setenv("XDG_CACHE_HOME", dir)
+			t.Setenv("HOME", dir)
+
+			s, err := cmdutil.BreakStore()
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			if tt.current != nil {
+				assert.NoError(t, s.Start(*tt.current))
+			}
+
+			f := mocks.NewMockFactory(t)
+
+			conf := mocks.NewMockConfig(t)
+			f.EXPECT().Config().Return(conf).Maybe()
+			conf.EXPECT().GetBool(mock.Anything).Return(false).Maybe()
+			conf.EXPECT().GetString(mock.Anything).Return("").Maybe()
+			conf.EXPECT().SetBool(mock.Anything, mock.Anything).Maybe()
+
+			c := mocks.NewMockClient(t)
+			f.EXPECT().Client().Return(c, nil).Maybe()
+			c.EXPECT().GetTimeEntryInProgress(mock.Anything,
+				api.GetTimeEntryInProgressParam{
+					Workspace: "w", UserID: "u"}).
+				Return(tt.running, nil).Maybe()
+			if tt.mock != nil {
+				tt.mock(c)
+			}
+
+			cmd := end.NewCmdEnd(f)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+			out := bytes.NewBufferString("")
+			stderr := bytes.NewBufferString("")
+			cmd.SetOut(out)
+			cmd.SetErr(stderr)
+			cmd.SetArgs(append([]string{"--at", "2022-06-01 12:45", "-q"},
+				tt.args...))
+
+			_, err = cmd.ExecuteC()
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.output, out.String())
+			assert.Equal(t, tt.stderr, stderr.String())
+
+			br, err := s.Current()
+			assert.NoError(t, err)
+			assert.Nil(t, br)
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package start
+
+import (
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/pkg/breaks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	output "github.com/lucassabreu/clockify-cli/pkg/output/time-entry"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdStart represents the break start command
+func NewCmdStart(f cmdutil.Factory) *cobra.Command {
+	of := util.OutputFlags{TimeFormat: output.TimeFormatSimple}
+	var at, description string
+
+	cmd := &cobra.Command{
+		Use:   "start",
+		Short: "Starts a break, pausing the time entry running",
+		Long: heredoc.Docf(`
+			Starts a break, stopping the time entry running, so it can be
+			resumed by "break end".
+
+			When the config %[1]s is set, a time entry on that project
+			is started for the break, and printed; otherwise the time entry
+			paused is printed.
+		`, cmdutil.CONF_BREAK_PROJECT) + "\n" +
+			"When setting `--at` you can use any of the following formats:\n" +
+			util.HelpDateTimeFormats + "\n" +
+			util.HelpMoreInfoAboutPrinting,
+		Example: heredoc.Docf(`
+			# lunch time
+			$ %[1]s -d Lunch -q
+			62ae4b304ebb4f143c931d50
+
+			# the break started 5 minutes ago
+			$ %[1]s --at "5m ago" -q
+			62ae4b304ebb4f143c931d51
+		`, "clockify-cli break start"),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			start, err := timehlp.ConvertToTime(at)
+			if err != nil {
+				return cmdutil.FlagErrorWrap(
+					errors.Wrap(err, "invalid --at"))
+			}
+
+			s, err := cmdutil.BreakStore()
+			if err != nil {
+				return err
+			}
+
+			br, err := s.Current()
+			if err != nil {
+				return err
+			}
+
+			if br != nil {
+				return errors.Errorf("a break was started at %s, end it "+
+					"with \"clockify-cli break end\"",
+					br.Start.In(time.Local).Format(timehlp.FullTimeFormat))
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			u, err := f.GetUserID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			te, err := c.GetTimeEntryInProgress(
+				ctx,
+				api.GetTimeEntryInProgressParam{Workspace: w, UserID: u})
+			if err != nil {
+				return err
+			}
+
+			project := f.Config().GetString(cmdutil.CONF_BREAK_PROJECT)
+			if te == nil && project == "" {
+				return errors.New("there is no time entry in progress " +
+					"to be paused")
+			}
+
+			br = &breaks.Break{Start: start, Workspace: w, UserID: u}
+			if te != nil {
+				if err := c.Out(ctx, api.OutParam{
+					Workspace: w,
+					UserID:    u,
+					End:       start,
+				}); err != nil {
+					return err
+				}
+
+				br.PausedID = te.ID
+			}
+
+			if project != "" {
+				tei, err := util.Do(util.TimeEntryDTO{
+					Workspace:   w,
+					UserID:      u,
+					ProjectID:   project,
+					Description: description,
+					Start:       start,
+				},
+					util.GetAllowNameForIDsFn(ctx, f.Config(), c),
+					util.CreateTimeEntryFn(ctx, c),
+				)
+				if err != nil {
+					return err
+				}
+
+				br.TimeEntryID = tei.ID
+				impl := util.TimeEntryDTOToImpl(tei)
+				te = &impl
+			}
+
+			if err := s.Start(*br); err != nil {
+				return err
+			}
+
+			return util.PrintTimeEntryImpl(ctx, *te, f,
+				cmd.OutOrStdout(), of)
+		},
+	}
+
+	cmd.Flags().StringVar(&at, "at", timehlp.NowTimeFormat,
+		"when the break started")
+	cmd.Flags().StringVarP(&description, "description", "d", "Break",
+		"description of the time entry of the break (only with "+
+			cmdutil.CONF_BREAK_PROJECT+")")
+	util.AddPrintTimeEntriesFlags(cmd, &of)
+
+	return cmd
+}
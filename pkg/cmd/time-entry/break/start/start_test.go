@@ -0,0 +1,166 @@
+package start_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/breaks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/break/start"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCmdStart(t *testing.T) {
+	at := time.Date(2022, 6, 1, 12, 0, 0, 0, time.Local)
+
+	tts := []struct {
+		name    string
+		project string
+		running *dto.TimeEntryImpl
+		current *breaks.Break
+		mock    func(*mocks.MockClient)
+		output  string
+		err     string
+		stored  *breaks.Break
+	}{
+		{
+			name:    "already on a break",
+			current: &breaks.Break{Start: at.Add(-time.Hour)},
+			err: "a break was started at 2022-06-01 11:00:00, " +
+				"end it with \"clockify-cli break end\"",
+		},
+		{
+			name: "nothing to pause",
+			err:  "there is no time entry in progress to be paused",
+		},
+		{
+			name:    "pause",
+			running: &dto.TimeEntryImpl{ID: "te1", WorkspaceID: "w"},
+			mock: func(c *mocks.MockClient) {
+				c.EXPECT().Out(mock.Anything, api.OutParam{
+					Workspace: "w", UserID: "u", End: at}).
+					Return(nil)
+				c.EXPECT().GetHydratedTimeEntry(mock.Anything,
+					api.GetTimeEntryParam{
+						Workspace: "w", TimeEntryID: "te1"}).
+					Return(&dto.TimeEntry{ID: "te1"}, nil)
+			},
+			output: "te1\n",
+			stored: &breaks.Break{Start: at.UTC(), Workspace: "w",
+				UserID: "u", PausedID: "te1"},
+		},
+		{
+			name:    "break project",
+			project: "p-break",
+			running: &dto.TimeEntryImpl{ID: "te1", WorkspaceID: "w"},
+			mock: func(c *mocks.MockClient) {
+				c.EXPECT().Out(mock.Anything, api.OutParam{
+					Workspace: "w", UserID: "u", End: at}).
+					Return(nil)
+				c.EXPECT().CreateTimeEntry(mock.Anything,
+					api.CreateTimeEntryParam{
+						Workspace:   "w",
+						Start:       at,
+						ProjectID:   "p-break",
+						Description: "Lunch",
+					}).
+					Return(dto.TimeEntryImpl{ID: "te2", WorkspaceID: "w"}, nil)
+				c.EXPECT().GetHydratedTimeEntry(mock.Anything,
+					api.GetTimeEntryParam{
+						Workspace: "w", TimeEntryID: "te2"}).
+					Return(&dto.TimeEntry{ID: "te2"}, nil)
+			},
+			output: "te2\n",
+			stored: &breaks.Break{Start: at.UTC(), Workspace: "w",
+				UserID: "u", PausedID: "te1", TimeEntryID: "te2"},
+		},
+		{
+			name:    "break project without time entry running",
+			project: "p-break",
+			mock: func(c *mocks.MockClient) {
+				c.EXPECT().CreateTimeEntry(mock.Anything,
+					api.CreateTimeEntryParam{
+						Workspace:   "w",
+						Start:       at,
+						ProjectID:   "p-break",
+						Description: "Lunch",
+					}).
+					Return(dto.TimeEntryImpl{ID: "te2", WorkspaceID: "w"}, nil)
+				c.EXPECT().GetHydratedTimeEntry(mock.Anything,
+					api.GetTimeEntryParam{
+						Workspace: "w", TimeEntryID: "te2"}).
+					Return(&dto.TimeEntry{ID: "te2"}, nil)
+			},
+			output: "te2\n",
+			stored: &breaks.Break{Start: at.UTC(), Workspace: "w",
+				UserID: "u", TimeEntryID: "te2"},
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			t.Setenv("XDG_CACHE_HOME", dir)
+			t.Setenv("HOME", dir)
+
+			s, err := cmdutil.BreakStore()
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			if tt.current != nil {
+				assert.NoError(t, s.Start(*tt.current))
+			}
+
+			f := mocks.NewMockFactory(t)
+			f.EXPECT().GetWorkspaceID().Return("w", nil).Maybe()
+			f.EXPECT().GetUserID().Return("u", nil).Maybe()
+
+			conf := mocks.NewMockConfig(t)
+			f.EXPECT().Config().Return(conf).Maybe()
+			conf.EXPECT().GetString(cmdutil.CONF_BREAK_PROJECT).
+				Return(tt.project).Maybe()
+			conf.EXPECT().GetBool(mock.Anything).Return(false).Maybe()
+			conf.EXPECT().GetString(mock.Anything).Return("").Maybe()
+			conf.EXPECT().SetBool(mock.Anything, mock.Anything).Maybe()
+
+			c := mocks.NewMockClient(t)
+			f.EXPECT().Client().Return(c, nil).Maybe()
+			c.EXPECT().GetTimeEntryInProgress(mock.Anything,
+				api.GetTimeEntryInProgressParam{
+					Workspace: "w", UserID: "u"}).
+				Return(tt.running, nil).Maybe()
+			if tt.mock != nil {
+				tt.mock(c)
+			}
+
+			cmd := start.NewCmdStart(f)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+			out := bytes.NewBufferString("")
+			cmd.SetOut(out)
+			cmd.SetErr(bytes.NewBufferString(""))
+			cmd.SetArgs([]string{"--at", "2022-06-01 12:00", "-d", "Lunch",
+				"-q"})
+
+			_, err = cmd.ExecuteC()
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.output, out.String())
+
+			br, err := s.Current()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.stored, br)
+		})
+	}
+}
@@ -80,6 +80,8 @@ func NewCmdClone(f cmdutil.Factory) *cobra.Command {
 		Args:      cmdutil.RequiredNamedArgs("time entry id"),
 		ValidArgs: []string{timeentryhlp.AliasLast},
 		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
 			if err := of.Check(); err != nil {
 				return err
 			}
@@ -104,7 +106,7 @@ func NewCmdClone(f cmdutil.Factory) *cobra.Command {
 				id = timeentryhlp.AliasLatest
 			}
 
-			tec, err := timeentryhlp.GetTimeEntry(c, w, u, id)
+			tec, err := timeentryhlp.GetTimeEntry(ctx, c, w, u, id)
 			if err != nil {
 				return err
 			}
@@ -114,7 +116,7 @@ func NewCmdClone(f cmdutil.Factory) *cobra.Command {
 
 			noClosing, _ := cmd.Flags().GetBool("no-closing")
 
-			dc := util.NewDescriptionCompleter(f)
+			dc := util.NewDescriptionCompleter(ctx, f)
 
 			te := util.TimeEntryImplToDTO(tec)
 			if te, err = util.Do(
@@ -125,25 +127,27 @@ func NewCmdClone(f cmdutil.Factory) *cobra.Command {
 						return tec, nil
 					}
 
-					return util.ValidateClosingTimeEntry(f)(tec)
+					return util.ValidateClosingTimeEntry(ctx, f)(tec)
 				},
-				util.GetAllowNameForIDsFn(f.Config(), c),
-				util.GetPropsInteractiveFn(dc, f),
+				util.GetAllowNameForIDsFn(ctx, f.Config(), c),
+				util.LookupCustomFieldsFn(ctx, c),
+				util.GetPropsInteractiveFn(ctx, dc, f),
 				util.GetDatesInteractiveFn(f),
-				util.GetValidateTimeEntryFn(f),
+				util.GetValidateTimeEntryFn(ctx, f),
 				func(tec util.TimeEntryDTO) (util.TimeEntryDTO, error) {
 					if noClosing {
 						return tec, nil
 					}
 
-					return util.OutInProgressFn(c)(tec)
+					return util.OutInProgressFn(ctx, c)(tec)
 				},
-				util.CreateTimeEntryFn(c),
+				util.CreateTimeEntryFn(ctx, c),
 			); err != nil {
 				return err
 			}
 
 			return util.PrintTimeEntryImpl(
+				ctx,
 				util.TimeEntryDTOToImpl(te), f, cmd.OutOrStdout(), of)
 		},
 	}
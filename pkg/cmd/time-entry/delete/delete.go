@@ -49,6 +49,8 @@ func NewCmdDelete(f cmdutil.Factory) *cobra.Command {
 			# no output
 		`, "clockify-cli delete"),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
 			var err error
 			var w, u string
 
@@ -65,16 +67,15 @@ func NewCmdDelete(f cmdutil.Factory) *cobra.Command {
 				return err
 			}
 
+			ids := make([]string, len(args))
 			for i := range args {
-				p := api.DeleteTimeEntryParam{
-					Workspace:   w,
-					TimeEntryID: args[i],
-				}
+				ids[i] = args[i]
 
-				if p.TimeEntryID == timeentryhlp.AliasCurrent {
+				if ids[i] == timeentryhlp.AliasCurrent {
 					te, err := c.GetTimeEntryInProgress(
+						ctx,
 						api.GetTimeEntryInProgressParam{
-							Workspace: p.Workspace,
+							Workspace: w,
 							UserID:    u,
 						})
 
@@ -86,25 +87,34 @@ func NewCmdDelete(f cmdutil.Factory) *cobra.Command {
 						return errors.New("there is no time entry in progress")
 					}
 
-					p.TimeEntryID = te.ID
+					ids[i] = te.ID
 				}
 
-				if p.TimeEntryID == timeentryhlp.AliasLast {
-					te, err := timeentryhlp.GetLatestEntryEntry(c, p.Workspace, u)
+				if ids[i] == timeentryhlp.AliasLast {
+					te, err := timeentryhlp.GetLatestEntryEntry(ctx, c, w, u)
 
 					if err != nil {
 						return err
 					}
 
-					p.TimeEntryID = te.ID
+					ids[i] = te.ID
 				}
+			}
 
-				if err := c.DeleteTimeEntry(p); err != nil {
-					return err
-				}
+			if len(ids) == 1 {
+				return c.DeleteTimeEntry(ctx, api.DeleteTimeEntryParam{
+					Workspace:   w,
+					TimeEntryID: ids[0],
+				})
 			}
 
-			return nil
+			// deleting all at once does not trip the rate limit
+			_, err = c.DeleteTimeEntries(ctx, api.DeleteTimeEntriesParam{
+				Workspace:    w,
+				UserID:       u,
+				TimeEntryIDs: ids,
+			})
+			return err
 		},
 	}
 
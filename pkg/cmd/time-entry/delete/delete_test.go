@@ -0,0 +1,78 @@
+package del_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	del "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/delete"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCmdDelete(t *testing.T) {
+	tts := []struct {
+		name  string
+		args  []string
+		setup func(*mocks.MockClient)
+	}{
+		{
+			name: "only one",
+			args: []string{"te1"},
+			setup: func(c *mocks.MockClient) {
+				c.EXPECT().DeleteTimeEntry(mock.Anything,
+					api.DeleteTimeEntryParam{
+						Workspace:   "w",
+						TimeEntryID: "te1",
+					}).Return(nil)
+			},
+		},
+		{
+			name: "multiple at once",
+			args: []string{"te1", "current", "te3"},
+			setup: func(c *mocks.MockClient) {
+				c.EXPECT().GetTimeEntryInProgress(mock.Anything,
+					api.GetTimeEntryInProgressParam{
+						Workspace: "w",
+						UserID:    "u",
+					}).
+					Return(&dto.TimeEntryImpl{ID: "te2"}, nil)
+
+				c.EXPECT().DeleteTimeEntries(mock.Anything,
+					api.DeleteTimeEntriesParam{
+						Workspace:    "w",
+						UserID:       "u",
+						TimeEntryIDs: []string{"te1", "te2", "te3"},
+					}).Return(nil, nil)
+			},
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			f := mocks.NewMockFactory(t)
+			f.EXPECT().GetWorkspaceID().Return("w", nil)
+			f.EXPECT().GetUserID().Return("u", nil)
+
+			c := mocks.NewMockClient(t)
+			f.EXPECT().Client().Return(c, nil)
+			tt.setup(c)
+
+			cmd := del.NewCmdDelete(f)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			out := bytes.NewBufferString("")
+			cmd.SetOut(out)
+			cmd.SetErr(out)
+			cmd.SetArgs(tt.args)
+
+			_, err := cmd.ExecuteC()
+			assert.NoError(t, err)
+			assert.Empty(t, out.String())
+		})
+	}
+}
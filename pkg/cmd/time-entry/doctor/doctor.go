@@ -0,0 +1,166 @@
+package doctor
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	reportutil "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdDoctor represents the doctor command
+func NewCmdDoctor(f cmdutil.Factory) *cobra.Command {
+	var overlaps, dryRun bool
+	var since, until, strategy string
+
+	cmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "Finds and fixes problems on your time entries",
+		Long: heredoc.Docf(`
+			Looks for problems on your time entries started between --since
+			and --until (inclusive), and fixes them.
+
+			When no check is set, all of them are run. The checks are:
+			  --overlaps: time entries overlapping each other
+
+			Each problem can be fixed using one of the strategies:
+			%s
+
+			When --strategy is not set and interactive mode is enabled, it
+			asks which one to use for each problem, otherwise the problems
+			are only listed and the command fails if any is found.
+		`, strings.TrimSuffix(strategies().Long(), "\n")),
+		Example: heredoc.Docf(`
+			# list the overlapping time entries of today
+			$ %[1]s --overlaps
+
+			# trim the time entries overlapping the next ones on june
+			$ %[1]s --overlaps --since 2022-06-01 --until 2022-06-30 \
+			    --strategy trim
+
+			# check what would be deleted, without changing anything
+			$ %[1]s --overlaps --strategy delete --dry-run
+		`, "clockify-cli doctor"),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+
+			if strategy != "" {
+				if _, ok := strategies()[strategy]; !ok {
+					return cmdutil.FlagErrorWrap(errors.Errorf(
+						"--strategy must be one of: %s",
+						strings.Join(strategies().OnlyArgs(), ", ")))
+				}
+			}
+
+			all := !overlaps
+
+			args := []string{timehlp.Today().Format("2006-01-02"), until}
+			if since != "" {
+				args[0] = since
+			}
+
+			start, end, err := reportutil.ParseRangeArgs(args)
+			if err != nil {
+				return cmdutil.FlagErrorWrap(errors.Wrap(err,
+					"--since and --until must be dates like 2022-06-01"))
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			u, err := f.GetUserID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			tes, err := c.LogRange(ctx, api.LogRangeParam{
+				Workspace:       w,
+				UserID:          u,
+				FirstDate:       timehlp.TruncateDate(start),
+				LastDate:        timehlp.TruncateDate(end).Add(time.Hour * 24),
+				PaginationParam: api.AllPages(),
+			})
+			if err != nil {
+				return err
+			}
+
+			var ask askFn
+			if strategy == "" && f.Config().IsInteractive() {
+				ask = askStrategy(f)
+			} else {
+				ask = func(overlap) (string, error) { return strategy, nil }
+			}
+
+			o := &fixer{
+				c:         c,
+				workspace: w,
+				dryRun:    dryRun,
+				out:       cmd.OutOrStdout(),
+			}
+
+			found := 0
+			if overlaps || all {
+				n, err := o.fixOverlaps(ctx, tes, ask)
+				found += n
+				if err != nil {
+					return err
+				}
+			}
+
+			if found == 0 {
+				fmt.Fprintln(cmd.OutOrStdout(), "no problems found")
+				return nil
+			}
+
+			if o.unfixed > 0 {
+				return errors.Errorf(
+					"%d of %d problems were not fixed", o.unfixed, found)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&overlaps, "overlaps", false,
+		"looks for time entries overlapping each other")
+	cmd.Flags().StringVar(&since, "since", "",
+		"first day of the time entries to check (default today)")
+	cmd.Flags().StringVar(&until, "until", "today",
+		"last day of the time entries to check")
+	cmd.Flags().StringVar(&strategy, "strategy", "",
+		"how to fix the problems found, without asking")
+	_ = cmdcompl.AddFixedSuggestionsToFlag(cmd, "strategy", strategies())
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false,
+		"only prints how the problems would be fixed")
+
+	return cmd
+}
+
+// fixer applies the strategies to the problems found
+type fixer struct {
+	c         api.Client
+	workspace string
+	dryRun    bool
+	out       io.Writer
+	unfixed   int
+}
+
+func (o *fixer) printf(format string, args ...interface{}) {
+	fmt.Fprintf(o.out, format+"\n", args...)
+}
@@ -0,0 +1,299 @@
+package doctor_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/consoletest"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/doctor"
+	"github.com/lucassabreu/clockify-cli/pkg/ui"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var start = time.Date(2022, 6, 1, 9, 0, 0, 0, time.Local)
+
+func at(h, m int) *time.Time {
+	t := start.Add(time.Duration(h-9)*time.Hour +
+		time.Duration(m)*time.Minute)
+	return &t
+}
+
+func log() []dto.TimeEntry {
+	return []dto.TimeEntry{
+		{
+			ID:           "b1",
+			Description:  "meeting",
+			TimeInterval: dto.TimeInterval{Start: *at(10, 0), End: at(10, 30)},
+		},
+		{
+			ID:           "a1",
+			Description:  "docs",
+			ProjectID:    "p1",
+			Tags:         []dto.Tag{{ID: "tg1"}},
+			TimeInterval: dto.TimeInterval{Start: *at(9, 0), End: at(11, 0)},
+		},
+		{
+			ID:           "c1",
+			Description:  "review",
+			TimeInterval: dto.TimeInterval{Start: *at(11, 0), End: at(12, 0)},
+		},
+	}
+}
+
+func expectLog(c *mocks.MockClient, tes []dto.TimeEntry) {
+	c.EXPECT().LogRange(mock.Anything, api.LogRangeParam{
+		Workspace:       "w",
+		UserID:          "u",
+		FirstDate:       time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC),
+		LastDate:        time.Date(2022, 6, 2, 0, 0, 0, 0, time.UTC),
+		PaginationParam: api.AllPages(),
+	}).
+		Return(tes, nil)
+}
+
+func TestCmdDoctorOverlaps(t *testing.T) {
+	f := false
+	trimA1 := api.UpdateTimeEntryParam{
+		Workspace:   "w",
+		TimeEntryID: "a1",
+		Start:       *at(9, 0),
+		End:         at(10, 0),
+		Description: "docs",
+		ProjectID:   "p1",
+		TagIDs:      []string{"tg1"},
+	}
+
+	tts := []struct {
+		name     string
+		args     []string
+		err      string
+		out      string
+		expectFn func(*mocks.MockClient)
+	}{
+		{
+			name: "only list",
+			err:  "1 of 1 problems were not fixed",
+			out:  "b1 (2022-06-01 10:00:00 - 2022-06-01 10:30:00) overlaps a1 ",
+		},
+		{
+			name: "skip",
+			args: []string{"--strategy", "skip"},
+			err:  "1 of 1 problems were not fixed",
+		},
+		{
+			name: "trim",
+			args: []string{"--strategy", "trim"},
+			out:  "  a1 now ends at 2022-06-01 10:00:00\n",
+			expectFn: func(c *mocks.MockClient) {
+				c.EXPECT().UpdateTimeEntry(mock.Anything, trimA1).
+					Return(dto.TimeEntryImpl{ID: "a1"}, nil).
+					Once()
+			},
+		},
+		{
+			name: "trim dry run",
+			args: []string{"--strategy", "trim", "--dry-run"},
+			out:  "  a1 now ends at 2022-06-01 10:00:00\n",
+		},
+		{
+			name: "delete",
+			args: []string{"--strategy", "delete"},
+			out:  "  b1 was deleted\n",
+			expectFn: func(c *mocks.MockClient) {
+				c.EXPECT().DeleteTimeEntry(mock.Anything,
+					api.DeleteTimeEntryParam{
+						Workspace:   "w",
+						TimeEntryID: "b1",
+					}).
+					Return(nil).
+					Once()
+			},
+		},
+		{
+			name: "split",
+			args: []string{"--strategy", "split"},
+			out: "  a1 now ends at 2022-06-01 10:00:00, " +
+				"and continues after b1\n",
+			expectFn: func(c *mocks.MockClient) {
+				c.EXPECT().UpdateTimeEntry(mock.Anything, trimA1).
+					Return(dto.TimeEntryImpl{ID: "a1"}, nil).
+					Once()
+
+				c.EXPECT().CreateTimeEntry(mock.Anything,
+					api.CreateTimeEntryParam{
+						Workspace:   "w",
+						Start:       *at(10, 30),
+						End:         at(11, 0),
+						Billable:    &f,
+						Description: "docs",
+						ProjectID:   "p1",
+						TagIDs:      []string{"tg1"},
+					}).
+					Return(dto.TimeEntryImpl{ID: "a2"}, nil).
+					Once()
+			},
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			f := mocks.NewMockFactory(t)
+			f.EXPECT().GetWorkspaceID().Return("w", nil)
+			f.EXPECT().GetUserID().Return("u", nil)
+			f.EXPECT().Config().Return(&mocks.SimpleConfig{}).Maybe()
+
+			c := mocks.NewMockClient(t)
+			f.EXPECT().Client().Return(c, nil)
+			expectLog(c, log())
+
+			if tt.expectFn != nil {
+				tt.expectFn(c)
+			}
+
+			cmd := doctor.NewCmdDoctor(f)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			out := bytes.NewBufferString("")
+			cmd.SetOut(out)
+			cmd.SetErr(out)
+			cmd.SetArgs(append([]string{"--overlaps",
+				"--since", "2022-06-01", "--until", "2022-06-01"},
+				tt.args...))
+
+			_, err := cmd.ExecuteC()
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+			assert.Contains(t, out.String(), tt.out)
+		})
+	}
+}
+
+func TestCmdDoctorSplitRunning(t *testing.T) {
+	f := mocks.NewMockFactory(t)
+	f.EXPECT().GetWorkspaceID().Return("w", nil)
+	f.EXPECT().GetUserID().Return("u", nil)
+	f.EXPECT().Config().Return(&mocks.SimpleConfig{}).Maybe()
+
+	c := mocks.NewMockClient(t)
+	f.EXPECT().Client().Return(c, nil)
+
+	// a1 ends after now, so it ends after the running b1
+	later := time.Now().Add(24 * time.Hour)
+	expectLog(c, []dto.TimeEntry{
+		{
+			ID:           "a1",
+			Description:  "docs",
+			TimeInterval: dto.TimeInterval{Start: *at(9, 0), End: &later},
+		},
+		{
+			ID:           "b1",
+			Description:  "meeting",
+			TimeInterval: dto.TimeInterval{Start: *at(10, 0)},
+		},
+	})
+
+	c.EXPECT().UpdateTimeEntry(mock.Anything, api.UpdateTimeEntryParam{
+		Workspace:   "w",
+		TimeEntryID: "a1",
+		Start:       *at(9, 0),
+		End:         at(10, 0),
+		Description: "docs",
+		TagIDs:      []string{},
+	}).
+		Return(dto.TimeEntryImpl{ID: "a1"}, nil).
+		Once()
+
+	cmd := doctor.NewCmdDoctor(f)
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	out := bytes.NewBufferString("")
+	cmd.SetOut(out)
+	cmd.SetErr(out)
+	cmd.SetArgs([]string{"--overlaps", "--strategy", "split",
+		"--since", "2022-06-01", "--until", "2022-06-01"})
+
+	_, err := cmd.ExecuteC()
+	assert.NoError(t, err)
+	assert.Contains(t, out.String(), "  a1 now ends at 2022-06-01 10:00:00\n")
+}
+
+func TestCmdDoctorNoOverlaps(t *testing.T) {
+	f := mocks.NewMockFactory(t)
+	f.EXPECT().GetWorkspaceID().Return("w", nil)
+	f.EXPECT().GetUserID().Return("u", nil)
+	f.EXPECT().Config().Return(&mocks.SimpleConfig{})
+
+	c := mocks.NewMockClient(t)
+	f.EXPECT().Client().Return(c, nil)
+	expectLog(c, log()[2:])
+
+	cmd := doctor.NewCmdDoctor(f)
+	out := bytes.NewBufferString("")
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{"--since", "2022-06-01", "--until", "2022-06-01"})
+
+	_, err := cmd.ExecuteC()
+	assert.NoError(t, err)
+	assert.Equal(t, "no problems found\n", out.String())
+}
+
+func TestCmdDoctorInvalidStrategy(t *testing.T) {
+	cmd := doctor.NewCmdDoctor(mocks.NewMockFactory(t))
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	cmd.SetArgs([]string{"--strategy", "fix"})
+
+	_, err := cmd.ExecuteC()
+	assert.EqualError(t, err,
+		"--strategy must be one of: delete, skip, split, trim")
+}
+
+func TestCmdDoctorInteractive(t *testing.T) {
+	consoletest.RunTestConsole(t,
+		func(out consoletest.FileWriter, in consoletest.FileReader) error {
+			f := mocks.NewMockFactory(t)
+			f.EXPECT().GetWorkspaceID().Return("w", nil)
+			f.EXPECT().GetUserID().Return("u", nil)
+			f.EXPECT().Config().Return(&mocks.SimpleConfig{Interactive: true})
+			f.EXPECT().UI().Return(ui.NewUI(in, out, out))
+
+			c := mocks.NewMockClient(t)
+			f.EXPECT().Client().Return(c, nil)
+			expectLog(c, log())
+
+			c.EXPECT().DeleteTimeEntry(mock.Anything, api.DeleteTimeEntryParam{
+				Workspace:   "w",
+				TimeEntryID: "b1",
+			}).
+				Return(nil).
+				Once()
+
+			cmd := doctor.NewCmdDoctor(f)
+			cmd.SetOut(out)
+			cmd.SetArgs([]string{"--overlaps",
+				"--since", "2022-06-01", "--until", "2022-06-01"})
+
+			_, err := cmd.ExecuteC()
+			return err
+		},
+		func(c consoletest.ExpectConsole) {
+			c.ExpectString("b1 (2022-06-01 10:00:00 - 2022-06-01 10:30:00)" +
+				" overlaps a1 (2022-06-01 09:00:00 - 2022-06-01 11:00:00)")
+			c.ExpectString("How to fix it?")
+			c.ExpectString("trim: end a1 at 2022-06-01 10:00:00")
+			c.SendLine("delete")
+			c.ExpectString("b1 was deleted")
+			c.ExpectEOF()
+		})
+}
@@ -0,0 +1,255 @@
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/pkg/errors"
+)
+
+const (
+	strategyTrim   = "trim"
+	strategyDelete = "delete"
+	strategySplit  = "split"
+	strategySkip   = "skip"
+)
+
+func strategies() cmdcompl.ValidArgsMap {
+	return cmdcompl.ValidArgsMap{
+		strategyTrim: "ends the earlier time entry when the later starts",
+		strategyDelete: "deletes the later time entry, " +
+			"useful for duplicates",
+		strategySplit: "splits the earlier time entry around the later one",
+		strategySkip:  "does nothing",
+	}
+}
+
+// overlap are two time entries overlapping, the first one starts earlier
+type overlap struct {
+	first  *dto.TimeEntry
+	second *dto.TimeEntry
+}
+
+type askFn func(overlap) (string, error)
+
+func askStrategy(f cmdutil.Factory) askFn {
+	return func(o overlap) (string, error) {
+		options := []string{
+			fmt.Sprintf("trim: end %s at %s", o.first.ID,
+				format(o.second.TimeInterval.Start)),
+			fmt.Sprintf("delete: delete %s", o.second.ID),
+			fmt.Sprintf("split: split %s around %s",
+				o.first.ID, o.second.ID),
+			"skip: do nothing",
+		}
+		choices := []string{
+			strategyTrim, strategyDelete, strategySplit, strategySkip}
+
+		s, err := f.UI().AskFromOptions(
+			"How to fix it?", options, options[len(options)-1])
+		if err != nil {
+			return "", err
+		}
+
+		for i := range options {
+			if options[i] == s {
+				return choices[i], nil
+			}
+		}
+
+		return strategySkip, nil
+	}
+}
+
+func format(t time.Time) string {
+	return t.In(time.Local).Format(timehlp.FullTimeFormat)
+}
+
+func end(te *dto.TimeEntry) time.Time {
+	if te.TimeInterval.End == nil {
+		return timehlp.Now()
+	}
+
+	return *te.TimeInterval.End
+}
+
+func describe(te *dto.TimeEntry) string {
+	e := "now"
+	if te.TimeInterval.End != nil {
+		e = format(*te.TimeInterval.End)
+	}
+
+	return fmt.Sprintf("%s (%s - %s)", te.ID,
+		format(te.TimeInterval.Start), e)
+}
+
+// fixOverlaps looks for time entries starting before the previous ones end,
+// applying the strategy chosen for each, it returns how many were found
+func (o *fixer) fixOverlaps(
+	ctx context.Context, tes []dto.TimeEntry, ask askFn) (int, error) {
+	sort.SliceStable(tes, func(i, j int) bool {
+		return tes[i].TimeInterval.Start.Before(tes[j].TimeInterval.Start)
+	})
+
+	found := 0
+	var prev *dto.TimeEntry
+	for i := range tes {
+		te := &tes[i]
+		if prev == nil || !te.TimeInterval.Start.Before(end(prev)) {
+			prev = te
+			continue
+		}
+
+		found++
+		ov := overlap{first: prev, second: te}
+		o.printf("%s overlaps %s", describe(te), describe(prev))
+
+		s, err := ask(ov)
+		if err != nil {
+			return found, err
+		}
+
+		next, err := o.fixOverlap(ctx, ov, s)
+		if err != nil {
+			return found, err
+		}
+
+		prev = next
+	}
+
+	return found, nil
+}
+
+// fixOverlap applies the strategy, returning the time entry which the next
+// ones should be compared with
+func (o *fixer) fixOverlap(
+	ctx context.Context, ov overlap, s string) (*dto.TimeEntry, error) {
+	first, second := ov.first, ov.second
+	later := second
+	if end(first).After(end(second)) {
+		later = first
+	}
+
+	switch s {
+	case strategyTrim:
+		start := second.TimeInterval.Start
+		first.TimeInterval.End = &start
+		if err := o.update(ctx, first); err != nil {
+			return nil, err
+		}
+
+		o.printf("  %s now ends at %s", first.ID, format(start))
+		return second, nil
+	case strategyDelete:
+		if !o.dryRun {
+			if err := o.c.DeleteTimeEntry(ctx, api.DeleteTimeEntryParam{
+				Workspace:   o.workspace,
+				TimeEntryID: second.ID,
+			}); err != nil {
+				return nil, errors.Wrapf(err,
+					"failed to delete time entry %s", second.ID)
+			}
+		}
+
+		o.printf("  %s was deleted", second.ID)
+		return first, nil
+	case strategySplit:
+		// there is no rest to continue after a running time entry
+		if later != first || second.TimeInterval.End == nil {
+			return o.fixOverlap(ctx, ov, strategyTrim)
+		}
+
+		n := *first
+		n.ID = ""
+		n.TimeInterval = dto.TimeInterval{
+			Start: *second.TimeInterval.End,
+			End:   first.TimeInterval.End,
+		}
+
+		start := second.TimeInterval.Start
+		first.TimeInterval.End = &start
+		if err := o.update(ctx, first); err != nil {
+			return nil, err
+		}
+
+		if !o.dryRun {
+			r, err := o.c.CreateTimeEntry(ctx, createParam(o.workspace, n))
+			if err != nil {
+				return nil, errors.Wrapf(err,
+					"failed to create the rest of time entry %s", first.ID)
+			}
+			n.ID = r.ID
+		}
+
+		o.printf("  %s now ends at %s, and continues after %s",
+			first.ID, format(start), second.ID)
+		return &n, nil
+	default:
+		o.unfixed++
+		return later, nil
+	}
+}
+
+func (o *fixer) update(ctx context.Context, te *dto.TimeEntry) error {
+	if o.dryRun {
+		return nil
+	}
+
+	c := createParam(o.workspace, *te)
+	_, err := o.c.UpdateTimeEntry(ctx, api.UpdateTimeEntryParam{
+		Workspace:    o.workspace,
+		TimeEntryID:  te.ID,
+		Start:        c.Start,
+		End:          c.End,
+		Billable:     te.Billable,
+		Description:  c.Description,
+		ProjectID:    c.ProjectID,
+		TaskID:       c.TaskID,
+		TagIDs:       c.TagIDs,
+		CustomFields: c.CustomFields,
+	})
+
+	return errors.Wrapf(err, "failed to update time entry %s", te.ID)
+}
+
+func createParam(w string, te dto.TimeEntry) api.CreateTimeEntryParam {
+	p := api.CreateTimeEntryParam{
+		Workspace:   w,
+		Start:       te.TimeInterval.Start,
+		End:         te.TimeInterval.End,
+		Billable:    &te.Billable,
+		Description: te.Description,
+		ProjectID:   te.ProjectID,
+		TagIDs:      make([]string, len(te.Tags)),
+	}
+
+	if p.ProjectID == "" && te.Project != nil {
+		p.ProjectID = te.Project.ID
+	}
+
+	if te.Task != nil {
+		p.TaskID = te.Task.ID
+	}
+
+	for i := range te.Tags {
+		p.TagIDs[i] = te.Tags[i].ID
+	}
+
+	for _, cf := range te.CustomFields {
+		if cf.Value != nil {
+			p.CustomFields = append(p.CustomFields, dto.CustomFieldValue{
+				CustomFieldID: cf.CustomFieldID,
+				Value:         cf.Value,
+			})
+		}
+	}
+
+	return p
+}
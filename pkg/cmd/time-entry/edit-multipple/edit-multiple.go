@@ -87,6 +87,8 @@ func NewCmdEditMultiple(f cmdutil.Factory) *cobra.Command {
 			  Tags: [Development (62ae28b72518aa18da2acb49)]
 		`, "clockify-cli"),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
 			var err error
 			var w, u string
 
@@ -105,7 +107,8 @@ func NewCmdEditMultiple(f cmdutil.Factory) *cobra.Command {
 
 			teis := make([]util.TimeEntryDTO, len(args))
 			for i := range args {
-				t, err := timeentryhlp.GetTimeEntry(c, w, u, args[i])
+				t, err := timeentryhlp.GetTimeEntry(ctx, c, w, u,
+					args[i])
 				if err != nil {
 					return err
 				}
@@ -113,37 +116,52 @@ func NewCmdEditMultiple(f cmdutil.Factory) *cobra.Command {
 			}
 
 			tei := teis[0]
-			editFn := func(tei util.TimeEntryDTO) (util.TimeEntryDTO, error) {
-				t, err := c.UpdateTimeEntry(api.UpdateTimeEntryParam{
-					Workspace:   tei.Workspace,
-					TimeEntryID: tei.ID,
-					Description: tei.Description,
-					Start:       tei.Start,
-					End:         tei.End,
-					Billable:    *tei.Billable,
-					ProjectID:   tei.ProjectID,
-					TaskID:      tei.TaskID,
-					TagIDs:      tei.TagIDs,
+			// all the time entries are updated with one request, so
+			// editing many of them does not trip the rate limit
+			updateAll := func() error {
+				ps := make([]api.UpdateTimeEntryParam, len(teis))
+				for i, tei := range teis {
+					ps[i] = api.UpdateTimeEntryParam{
+						Workspace:   tei.Workspace,
+						TimeEntryID: tei.ID,
+						Description: tei.Description,
+						Start:       tei.Start,
+						End:         tei.End,
+						Billable:    *tei.Billable,
+						ProjectID:   tei.ProjectID,
+						TaskID:      tei.TaskID,
+						TagIDs:      tei.TagIDs,
+
+						CustomFields: tei.CustomFields,
+					}
+				}
+
+				ts, err := c.UpdateTimeEntries(ctx, api.UpdateTimeEntriesParam{
+					Workspace:   w,
+					UserID:      u,
+					TimeEntries: ps,
 				})
+				if err != nil {
+					return err
+				}
 
-				return util.TimeEntryImplToDTO(t), err
+				for i := range ts {
+					teis[i] = util.TimeEntryImplToDTO(ts[i])
+				}
+
+				return nil
 			}
 
 			fn := func(input util.TimeEntryDTO) (util.TimeEntryDTO, error) {
-				var err error
 				for i, tei := range teis {
-					input.Start = tei.Start
-					input.End = tei.End
-					input.ID = tei.ID
-
-					if tei, err = editFn(input); err != nil {
-						return input, err
-					}
-
-					teis[i] = tei
+					t := input
+					t.Start = tei.Start
+					t.End = tei.End
+					t.ID = tei.ID
+					teis[i] = t
 				}
 
-				return input, err
+				return input, updateAll()
 			}
 
 			if !f.Config().IsInteractive() {
@@ -170,23 +188,26 @@ func NewCmdEditMultiple(f cmdutil.Factory) *cobra.Command {
 							tei.Billable = input.Billable
 						}
 
-						teis[i] = tei
-						if _, err = editFn(tei); err != nil {
-							return tei, err
+						if c("custom-field") {
+							tei.CustomFields = input.CustomFields
 						}
+
+						teis[i] = tei
 					}
-					return input, nil
+
+					return input, updateAll()
 				}
 			}
 
-			dc := util.NewDescriptionCompleter(f)
+			dc := util.NewDescriptionCompleter(ctx, f)
 
 			if _, err = util.Do(
 				tei,
 				util.FillTimeEntryWithFlags(cmd.Flags()),
-				util.GetAllowNameForIDsFn(f.Config(), c),
-				util.GetPropsInteractiveFn(dc, f),
-				util.GetValidateTimeEntryFn(f),
+				util.GetAllowNameForIDsFn(ctx, f.Config(), c),
+				util.LookupCustomFieldsFn(ctx, c),
+				util.GetPropsInteractiveFn(ctx, dc, f),
+				util.GetValidateTimeEntryFn(ctx, f),
 				fn,
 			); err != nil {
 				return err
@@ -195,7 +216,7 @@ func NewCmdEditMultiple(f cmdutil.Factory) *cobra.Command {
 			tes := make([]dto.TimeEntry, len(teis))
 			var t *dto.TimeEntry
 			for i, tei := range teis {
-				t, err = c.GetHydratedTimeEntry(api.GetTimeEntryParam{
+				t, err = c.GetHydratedTimeEntry(ctx, api.GetTimeEntryParam{
 					TimeEntryID: tei.ID,
 					Workspace:   tei.Workspace,
 				})
@@ -20,6 +20,7 @@ func NewCmdEdit(
 	report func(dto.TimeEntryImpl, io.Writer, util.OutputFlags) error,
 ) *cobra.Command {
 	of := util.OutputFlags{TimeFormat: output.TimeFormatSimple}
+	var splitMidnight bool
 	va := cmdcompl.ValidArgsSlide{
 		timeentryhlp.AliasCurrent, timeentryhlp.AliasLast}
 	cmd := &cobra.Command{
@@ -92,6 +93,8 @@ func NewCmdEdit(
 			 * Pair Programming (%[2]s621948708cb9606d934ebba7%[2]s)
 		`, "clockify-cli", "`"),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
 			if err := of.Check(); err != nil {
 				return err
 			}
@@ -112,6 +115,7 @@ func NewCmdEdit(
 			}
 
 			tei, err := timeentryhlp.GetTimeEntry(
+				ctx,
 				c,
 				w,
 				userID,
@@ -122,20 +126,21 @@ func NewCmdEdit(
 			}
 
 			te := util.TimeEntryImplToDTO(tei)
-			dc := util.NewDescriptionCompleter(f)
+			dc := util.NewDescriptionCompleter(ctx, f)
 
 			if te, err = util.Do(
 				te,
 				util.FillTimeEntryWithFlags(cmd.Flags()),
-				util.GetAllowNameForIDsFn(f.Config(), c),
-				util.GetPropsInteractiveFn(dc, f),
+				util.GetAllowNameForIDsFn(ctx, f.Config(), c),
+				util.LookupCustomFieldsFn(ctx, c),
+				util.GetPropsInteractiveFn(ctx, dc, f),
 				util.GetDatesInteractiveFn(f),
-				util.GetValidateTimeEntryFn(f),
+				util.GetValidateTimeEntryFn(ctx, f),
 			); err != nil {
 				return err
 			}
 
-			if tei, err = c.UpdateTimeEntry(api.UpdateTimeEntryParam{
+			if tei, err = c.UpdateTimeEntry(ctx, api.UpdateTimeEntryParam{
 				Workspace:   te.Workspace,
 				TimeEntryID: te.ID,
 				Description: te.Description,
@@ -145,19 +150,35 @@ func NewCmdEdit(
 				ProjectID:   te.ProjectID,
 				TaskID:      te.TaskID,
 				TagIDs:      te.TagIDs,
+
+				CustomFields: te.CustomFields,
 			}); err != nil {
 				return err
 			}
 
+			if splitMidnight {
+				tes, err := util.SplitAtMidnight(ctx, c, w, tei.ID)
+				if err != nil {
+					return err
+				}
+
+				if len(tes) > 1 {
+					return util.PrintTimeEntries(
+						tes, cmd.OutOrStdout(), f.Config(), of)
+				}
+			}
+
 			if report != nil {
 				return report(tei, cmd.OutOrStdout(), of)
 			}
 
-			return util.PrintTimeEntryImpl(tei, f, cmd.OutOrStdout(), of)
+			return util.PrintTimeEntryImpl(ctx, tei, f,
+				cmd.OutOrStdout(), of)
 		},
 	}
 
 	util.AddTimeEntryFlags(cmd, f, &of)
+	util.AddSplitMidnightFlag(cmd, &splitMidnight)
 
 	cmd.Flags().StringP("when", "s", "",
 		"when the entry should be started")
@@ -12,6 +12,7 @@ import (
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/edit"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestNewCmdEditWhenChangingProjectOrTask(t *testing.T) {
@@ -98,21 +99,22 @@ func TestNewCmdEditWhenChangingProjectOrTask(t *testing.T) {
 			c := mocks.NewMockClient(t)
 			f.EXPECT().Client().Return(c, nil)
 
-			c.EXPECT().GetTimeEntryInProgress(api.GetTimeEntryInProgressParam{
-				Workspace: "w",
-				UserID:    "u",
-			}).
+			c.EXPECT().GetTimeEntryInProgress(mock.Anything,
+				api.GetTimeEntryInProgressParam{
+					Workspace: "w",
+					UserID:    "u",
+				}).
 				Return(&te, nil)
 
 			p := tt.project
 			if p != nil {
-				c.EXPECT().GetProjects(api.GetProjectsParam{
+				c.EXPECT().GetProjects(mock.Anything, api.GetProjectsParam{
 					Workspace:       w.ID,
 					PaginationParam: api.AllPages(),
 				}).
 					Return([]dto.Project{*p}, nil)
 
-				c.EXPECT().GetProject(api.GetProjectParam{
+				c.EXPECT().GetProject(mock.Anything, api.GetProjectParam{
 					Workspace: w.ID,
 					ProjectID: p.ID,
 				}).
@@ -120,7 +122,7 @@ func TestNewCmdEditWhenChangingProjectOrTask(t *testing.T) {
 			}
 
 			if tt.updateParam.TaskID != "" {
-				c.EXPECT().GetTasks(api.GetTasksParam{
+				c.EXPECT().GetTasks(mock.Anything, api.GetTasksParam{
 					Workspace:       w.ID,
 					ProjectID:       tt.updateParam.ProjectID,
 					Active:          true,
@@ -129,7 +131,7 @@ func TestNewCmdEditWhenChangingProjectOrTask(t *testing.T) {
 					Return([]dto.Task{{ID: tt.updateParam.TaskID}}, nil)
 			}
 
-			c.EXPECT().UpdateTimeEntry(tt.updateParam).
+			c.EXPECT().UpdateTimeEntry(mock.Anything, tt.updateParam).
 				Return(te, nil)
 
 			called := false
@@ -0,0 +1,270 @@
+package git
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/gitrepo"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdGit represents the import git command
+func NewCmdGit(f cmdutil.Factory) *cobra.Command {
+	of := util.OutputFlags{TimeFormat: timehlp.FullTimeFormat}
+	var repos, tags []string
+	var since, until, author, project, task string
+	var gap, lead time.Duration
+	var dryRun, yes bool
+
+	cmd := &cobra.Command{
+		Use:   "git",
+		Short: "Create time entries from the commits of git repositories",
+		Long: heredoc.Docf(`
+			Proposes time entries from the commits of the user on git
+			repositories, and creates them after confirming.
+
+			Commits done less than --gap apart are grouped into one time
+			entry, which starts --lead before its first commit and ends on
+			its last one. The description is the subject of its commits.
+
+			The project, task, tags and billable are the ones set on the %[1]s
+			of each repository (see "in --from-git"), unless --project,
+			--task or --tag are set.
+
+			When not interactive, --yes must be set to create them.
+		`, gitrepo.ConfigFile) + "\n" +
+			"When setting `--since` and `--until` you can use any of the " +
+			"following formats:\n" +
+			util.HelpDateTimeFormats,
+		Example: heredoc.Docf(`
+			# check what would be created for the commits since yesterday
+			$ %[1]s --since yesterday --repo . --dry-run
+
+			# for two repositories, with blocks of work split by 2 hours
+			$ %[1]s --since "last monday" --repo ~/api --repo ~/web --gap 2h
+
+			# create them without asking, printing only their IDs
+			$ %[1]s --since yesterday -p "Clockify CLI" --yes -q
+		`, "clockify-cli import git"),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+
+			if gap <= 0 {
+				return cmdutil.FlagErrorWrap(
+					errors.New("`gap` must be a positive duration"))
+			}
+
+			if lead < 0 {
+				return cmdutil.FlagErrorWrap(
+					errors.New("`lead` can't be a negative duration"))
+			}
+
+			if task != "" && project == "" {
+				return cmdutil.FlagErrorWrap(
+					errors.New("`task` can only be used with `project`"))
+			}
+
+			s, err := timehlp.ConvertToTime(since)
+			if err != nil {
+				return cmdutil.FlagErrorWrap(
+					errors.Wrap(err, "invalid --since"))
+			}
+
+			u, err := timehlp.ConvertToTime(until)
+			if err != nil {
+				return cmdutil.FlagErrorWrap(
+					errors.Wrap(err, "invalid --until"))
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			userID, err := f.GetUserID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			teis := []util.TimeEntryDTO{}
+			tes := []dto.TimeEntry{}
+			for _, dir := range repos {
+				r, err := gitrepo.Open(dir)
+				if err != nil {
+					return err
+				}
+
+				a := author
+				if a == "" {
+					if a, err = gitrepo.UserEmail(r.Root); err != nil {
+						return errors.Errorf(
+							"%s has no user.email, set --author", r.Root)
+					}
+				}
+
+				cs, err := gitrepo.Log(r.Root, s, u, a)
+				if err != nil {
+					return err
+				}
+
+				base := util.TimeEntryDTO{
+					Workspace: w,
+					UserID:    userID,
+					ProjectID: r.Config.Project,
+					TaskID:    r.Config.Task,
+					TagIDs:    r.Config.Tags,
+					Billable:  r.Config.Billable,
+				}
+				if project != "" {
+					base.ProjectID = project
+					base.TaskID = task
+				}
+				if len(tags) > 0 {
+					base.TagIDs = tags
+				}
+
+				for _, b := range gitrepo.Blocks(cs, gap, lead) {
+					tei := base
+					tei.Description = b.Description()
+					tei.Start = b.Start
+					end := b.End
+					tei.End = &end
+
+					// the tags are replaced by their IDs on the same slice
+					named := tei
+					if len(base.TagIDs) > 0 {
+						tei.TagIDs = append([]string{}, base.TagIDs...)
+					}
+					if tei, err = util.Do(tei,
+						util.GetAllowNameForIDsFn(ctx, f.Config(), c),
+						util.GetValidateTimeEntryFn(ctx, f),
+					); err != nil {
+						return err
+					}
+
+					teis = append(teis, tei)
+					tes = append(tes, util.TimeEntryDTOToNamed(tei, named))
+				}
+			}
+
+			if len(teis) == 0 {
+				fmt.Fprintln(cmd.ErrOrStderr(), "no commits were found")
+				return nil
+			}
+
+			if dryRun {
+				return util.PrintTimeEntries(
+					tes, cmd.OutOrStdout(), f.Config(), of)
+			}
+
+			if !yes {
+				if !f.Config().IsInteractive() {
+					return cmdutil.FlagErrorWrap(errors.New(
+						"set `yes` to create them without confirming"))
+				}
+
+				if err := util.PrintTimeEntries(tes, cmd.ErrOrStderr(),
+					f.Config(), util.OutputFlags{
+						TimeFormat: timehlp.FullTimeFormat,
+					}); err != nil {
+					return err
+				}
+
+				ok, err := f.UI().Confirm(fmt.Sprintf(
+					"Create these %d time entries?", len(tes)), true)
+				if err != nil || !ok {
+					return err
+				}
+			}
+
+			failed := 0
+			created := make([]dto.TimeEntry, 0, len(tes))
+			stderr := cmd.ErrOrStderr()
+			for i, tei := range teis {
+				te, err := c.CreateTimeEntry(ctx, api.CreateTimeEntryParam{
+					Workspace:   w,
+					Start:       tei.Start,
+					End:         tei.End,
+					Billable:    tei.Billable,
+					Description: tei.Description,
+					ProjectID:   tei.ProjectID,
+					TaskID:      tei.TaskID,
+					TagIDs:      tei.TagIDs,
+				})
+				if err != nil {
+					failed++
+					fmt.Fprintf(stderr, "%s: %s\n",
+						tei.Start.Format(timehlp.FullTimeFormat), err)
+					continue
+				}
+
+				tes[i].ID = te.ID
+				created = append(created, tes[i])
+			}
+
+			if err := util.PrintTimeEntries(
+				created, cmd.OutOrStdout(), f.Config(), of); err != nil {
+				return err
+			}
+
+			if failed > 0 {
+				return errors.Errorf(
+					"%d of %d time entries failed to be created",
+					failed, len(teis))
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&repos, "repo", []string{"."},
+		"directory of the git repository (can be used multiple times)")
+	_ = cmd.MarkFlagDirname("repo")
+	cmd.Flags().StringVar(&since, "since", "today",
+		"look for commits done since")
+	cmd.Flags().StringVar(&until, "until", timehlp.NowTimeFormat,
+		"look for commits done until")
+	cmd.Flags().StringVar(&author, "author", "",
+		"author of the commits (default is the user.email of the repository)")
+	cmd.Flags().DurationVar(&gap, "gap", time.Hour,
+		"commits further apart than this are on different time entries")
+	cmd.Flags().DurationVar(&lead, "lead", 30*time.Minute,
+		"how long before the first commit each time entry starts")
+
+	cmd.Flags().StringVarP(&project, "project", "p", "",
+		"project of the time entries")
+	_ = cmdcompl.AddSuggestionsToFlag(cmd, "project",
+		cmdcomplutil.NewProjectAutoComplete(f))
+	cmd.Flags().StringVar(&task, "task", "", "task of the time entries")
+	cmd.Flags().StringSliceVarP(&tags, "tag", "T", []string{},
+		"tags of the time entries")
+	_ = cmdcompl.AddSuggestionsToFlag(cmd, "tag",
+		cmdcomplutil.NewTagAutoComplete(f))
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false,
+		"only prints the time entries, without creating them")
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false,
+		"creates the time entries without confirming")
+
+	cmd.Flags().StringVarP(&of.Format, "format", "f", "",
+		"golang text/template format to be applied on each time entry")
+	cmd.Flags().BoolVarP(&of.JSON, "json", "j", false, "print as JSON")
+	cmd.Flags().BoolVarP(&of.Quiet, "quiet", "q", false, "print only ID")
+
+	return cmd
+}
@@ -0,0 +1,117 @@
+package git_test
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/import/git"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newRepo(t *testing.T, commits map[string]string) string {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	dir := t.TempDir()
+	run := func(env []string, args ...string) {
+		cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+		cmd.Env = append(os.Environ(), env...)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %s", args, out)
+		}
+	}
+
+	run(nil, "init", "-q")
+	run(nil, "config", "user.email", "me@example.com")
+	run(nil, "config", "user.name", "Me")
+	for date, subject := range commits {
+		run([]string{"GIT_AUTHOR_DATE=" + date, "GIT_COMMITTER_DATE=" + date},
+			"commit", "-q", "--allow-empty", "-m", subject)
+	}
+
+	return dir
+}
+
+func TestCmdGit(t *testing.T) {
+	dir := newRepo(t, map[string]string{
+		"2022-06-01T09:00:00Z": "start docs",
+		"2022-06-01T14:00:00Z": "after lunch",
+	})
+
+	f := mocks.NewMockFactory(t)
+	f.EXPECT().GetWorkspaceID().Return("w", nil)
+	f.EXPECT().GetUserID().Return("u", nil)
+
+	conf := mocks.NewMockConfig(t)
+	f.EXPECT().Config().Return(conf)
+	conf.EXPECT().GetBool(cmdutil.CONF_ALLOW_INCOMPLETE).Return(true)
+	conf.EXPECT().GetBool(mock.Anything).Return(false).Maybe()
+	conf.EXPECT().GetString(mock.Anything).Return("").Maybe()
+
+	c := mocks.NewMockClient(t)
+	f.EXPECT().Client().Return(c, nil)
+
+	at := func(h int) *time.Time {
+		t := time.Date(2022, 6, 1, h, 0, 0, 0, time.UTC).Local()
+		return &t
+	}
+	for i, h := range []int{9, 14} {
+		c.EXPECT().CreateTimeEntry(mock.Anything, api.CreateTimeEntryParam{
+			Workspace: "w",
+			Start:     at(h).Add(-time.Hour),
+			End:       at(h),
+			ProjectID: "p1",
+			Description: map[int]string{
+				9: "start docs", 14: "after lunch"}[h],
+		}).
+			Return(dto.TimeEntryImpl{ID: []string{"te1", "te2"}[i]}, nil).
+			Once()
+	}
+
+	cmd := git.NewCmdGit(f)
+	out := bytes.NewBufferString("")
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{"--repo", dir, "-p", "p1", "--lead", "1h",
+		"--since", "2022-05-30 00:00", "--until", "2022-06-03 00:00",
+		"--yes", "-q"})
+
+	_, err := cmd.ExecuteC()
+	assert.NoError(t, err)
+	assert.Equal(t, "te1\nte2\n", out.String())
+}
+
+func TestCmdGitNeedsConfirmation(t *testing.T) {
+	dir := newRepo(t, map[string]string{
+		"2022-06-01T09:00:00Z": "start docs",
+	})
+
+	f := mocks.NewMockFactory(t)
+	f.EXPECT().GetWorkspaceID().Return("w", nil)
+	f.EXPECT().GetUserID().Return("u", nil)
+	f.EXPECT().Client().Return(mocks.NewMockClient(t), nil)
+
+	conf := mocks.NewMockConfig(t)
+	f.EXPECT().Config().Return(conf)
+	conf.EXPECT().GetBool(cmdutil.CONF_ALLOW_INCOMPLETE).Return(true)
+	conf.EXPECT().GetBool(mock.Anything).Return(false).Maybe()
+	conf.EXPECT().IsInteractive().Return(false)
+
+	cmd := git.NewCmdGit(f)
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	cmd.SetOut(bytes.NewBufferString(""))
+	cmd.SetArgs([]string{"--repo", dir,
+		"--since", "2022-05-30 00:00", "--until", "2022-06-03 00:00"})
+
+	_, err := cmd.ExecuteC()
+	assert.EqualError(t, err, "set `yes` to create them without confirming")
+}
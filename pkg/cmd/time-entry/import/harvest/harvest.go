@@ -0,0 +1,339 @@
+package harvest
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/harvest"
+	"github.com/lucassabreu/clockify-cli/pkg/search"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdHarvest represents the import harvest command
+func NewCmdHarvest(f cmdutil.Factory) *cobra.Command {
+	of := util.OutputFlags{TimeFormat: timehlp.FullTimeFormat}
+	var file, mappingFile, dayStart string
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "harvest",
+		Short: "Create time entries from a CSV exported by Harvest",
+		Long: heredoc.Doc(`
+			Create time entries from a "Detailed time" report exported as CSV
+			by Harvest, to help migrating from it.
+
+			Harvest does not keep when the time entries started, so the time
+			entries of each day are created one after the other, starting at
+			--day-start.
+
+			The projects and tasks are looked up by name on the workspace, using
+			the same names they have on Harvest. To use other ones, or to add
+			tags, set a mapping file (--mapping) like:
+
+			  projects:
+			    - harvest: Website       # name of the project on Harvest
+			      client: Acme           # only for the project of this client
+			      clockify: Acme Website # name or ID of the project on Clockify
+			    - harvest: Internal
+			      clockify: ""           # the time entries will have no project
+			  tasks:
+			    - harvest: Design        # name of the task on Harvest
+			      project: Website       # only for the task of this project
+			      clockify: UI           # name or ID of the task on Clockify
+			    - harvest: Meetings
+			      clockify: ""           # the time entries will have no task
+			      tags: [Meeting]        # names or IDs of tags to add
+
+			The first rule matching the project or task is used.
+			If one of the time entries can't be mapped, none is created.
+		`),
+		Example: heredoc.Docf(`
+			# check what would be created
+			$ %[1]s --file harvest_time_report.csv --mapping harvest.yaml --dry-run
+
+			# create the time entries, starting each day at 08:00
+			$ %[1]s --file harvest_time_report.csv --mapping harvest.yaml \
+			    --day-start 08:00
+		`, "clockify-cli import harvest"),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+
+			if file == "" {
+				return cmdutil.FlagErrorWrap(
+					errors.New("a file must be informed with --file"))
+			}
+
+			start, err := time.Parse(timehlp.SimplerOnlyTimeFormat, dayStart)
+			if err != nil {
+				return cmdutil.FlagErrorWrap(errors.New(
+					"`day-start` must be a time of the day, like: 09:00"))
+			}
+
+			m, err := readMapping(mappingFile)
+			if err != nil {
+				return err
+			}
+
+			es, err := readFile(cmd, file)
+			if err != nil {
+				return err
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			stderr := cmd.ErrOrStderr()
+			r := newResolver(c, w)
+			next := map[time.Time]time.Time{}
+
+			failed := 0
+			tes := make([]dto.TimeEntry, 0, len(es))
+			for _, e := range es {
+				s, ok := next[e.Date]
+				if !ok {
+					s = e.Date.Add(
+						time.Duration(start.Hour())*time.Hour +
+							time.Duration(start.Minute())*time.Minute)
+				}
+				end := s.Add(e.Duration)
+				next[e.Date] = end
+
+				te := dto.TimeEntry{
+					Description: e.Notes,
+					Billable:    e.Billable,
+					TimeInterval: dto.TimeInterval{
+						Start: s,
+						End:   &end,
+					},
+				}
+
+				if err := r.resolve(ctx, &te, m, e); err != nil {
+					failed++
+					fmt.Fprintf(stderr, "line %d: %s\n", e.Line, err)
+					continue
+				}
+
+				tes = append(tes, te)
+			}
+
+			if failed > 0 {
+				return errors.Errorf(
+					"%d of %d entries can't be imported, "+
+						"no time entry was created", failed, len(es))
+			}
+
+			if !dryRun {
+				created := make([]dto.TimeEntry, 0, len(tes))
+				for i := range tes {
+					te, err := c.CreateTimeEntry(ctx, api.CreateTimeEntryParam{
+						Workspace:   w,
+						Start:       tes[i].TimeInterval.Start,
+						End:         tes[i].TimeInterval.End,
+						Billable:    &tes[i].Billable,
+						Description: tes[i].Description,
+						ProjectID:   tes[i].ProjectID,
+						TaskID:      taskID(tes[i]),
+						TagIDs:      tagIDs(tes[i]),
+					})
+					if err != nil {
+						failed++
+						fmt.Fprintf(stderr, "line %d: %s\n", es[i].Line, err)
+						continue
+					}
+
+					tes[i].ID = te.ID
+					created = append(created, tes[i])
+				}
+				tes = created
+			}
+
+			if err := util.PrintTimeEntries(
+				tes, cmd.OutOrStdout(), f.Config(), of); err != nil {
+				return err
+			}
+
+			if failed > 0 {
+				return errors.Errorf("%d of %d entries failed to be created",
+					failed, len(es))
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&file, "file", "",
+		"CSV file of a \"Detailed time\" report exported by Harvest "+
+			"(use - to read from stdin)")
+	_ = cmd.MarkFlagFilename("file", "csv")
+	cmd.Flags().StringVar(&mappingFile, "mapping", "",
+		"YAML file with rules to map the projects and tasks of Harvest")
+	_ = cmd.MarkFlagFilename("mapping", "yaml", "yml")
+	cmd.Flags().StringVar(&dayStart, "day-start", "09:00",
+		"when the first time entry of each day starts")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false,
+		"only checks and prints the time entries, without creating them")
+
+	cmd.Flags().StringVarP(&of.Format, "format", "f", "",
+		"golang text/template format to be applied on each time entry")
+	cmd.Flags().BoolVarP(&of.JSON, "json", "j", false, "print as JSON")
+	cmd.Flags().BoolVarP(&of.Quiet, "quiet", "q", false, "print only ID")
+
+	return cmd
+}
+
+func readMapping(file string) (harvest.Mapping, error) {
+	if file == "" {
+		return harvest.Mapping{}, nil
+	}
+
+	fh, err := os.Open(file)
+	if err != nil {
+		return harvest.Mapping{}, errors.Wrap(
+			err, "failed to open the mapping file")
+	}
+	defer fh.Close()
+
+	return harvest.LoadMapping(fh)
+}
+
+func readFile(cmd *cobra.Command, file string) ([]harvest.Entry, error) {
+	if file == "-" {
+		return harvest.ReadCSV(cmd.InOrStdin(), time.Local)
+	}
+
+	fh, err := os.Open(file)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open the CSV file")
+	}
+	defer fh.Close()
+
+	return harvest.ReadCSV(fh, time.Local)
+}
+
+// resolver finds the IDs of the projects, tasks and tags on Clockify,
+// looking up each one only once
+type resolver struct {
+	c         api.Client
+	workspace string
+	ids       map[string]string
+	errs      map[string]error
+}
+
+func newResolver(c api.Client, workspace string) *resolver {
+	return &resolver{
+		c:         c,
+		workspace: workspace,
+		ids:       map[string]string{},
+		errs:      map[string]error{},
+	}
+}
+
+func (r *resolver) find(key string, fn func() (string, error)) (
+	string, error) {
+	if err, ok := r.errs[key]; ok {
+		return "", err
+	}
+
+	if id, ok := r.ids[key]; ok {
+		return id, nil
+	}
+
+	id, err := fn()
+	if err != nil {
+		r.errs[key] = err
+		return "", err
+	}
+
+	r.ids[key] = id
+	return id, nil
+}
+
+// resolve sets the project, task and tags of the time entry, mapping the
+// ones of the Harvest entry
+func (r *resolver) resolve(
+	ctx context.Context,
+	te *dto.TimeEntry, m harvest.Mapping, e harvest.Entry) (err error) {
+	project := m.Project(e.Client, e.Project)
+	task, tags := m.Task(e.Project, e.Task)
+
+	if project != "" {
+		if te.ProjectID, err = r.find("project/"+project,
+			func() (string, error) {
+				return search.GetProjectByName(ctx, r.c, r.workspace, project)
+			}); err != nil {
+			return err
+		}
+		te.Project = &dto.Project{ID: te.ProjectID, Name: project}
+	}
+
+	if task != "" {
+		if te.ProjectID == "" {
+			return errors.Errorf(
+				`task "%s" can't be set without a project`, task)
+		}
+
+		id, err := r.find("task/"+te.ProjectID+"/"+task,
+			func() (string, error) {
+				return search.GetTaskByName(ctx, r.c, api.GetTasksParam{
+					Workspace: r.workspace,
+					ProjectID: te.ProjectID,
+				}, task)
+			})
+		if err != nil {
+			return err
+		}
+		te.Task = &dto.Task{ID: id, Name: task}
+	}
+
+	for _, t := range tags {
+		id, err := r.find("tag/"+t, func() (string, error) {
+			ids, err := search.GetTagsByName(
+				ctx,
+				r.c, r.workspace, []string{t})
+			if err != nil {
+				return "", err
+			}
+			return ids[0], nil
+		})
+		if err != nil {
+			return err
+		}
+		te.Tags = append(te.Tags, dto.Tag{ID: id, Name: t})
+	}
+
+	return nil
+}
+
+func taskID(te dto.TimeEntry) string {
+	if te.Task == nil {
+		return ""
+	}
+
+	return te.Task.ID
+}
+
+func tagIDs(te dto.TimeEntry) []string {
+	ids := make([]string, len(te.Tags))
+	for i := range te.Tags {
+		ids[i] = te.Tags[i].ID
+	}
+
+	return ids
+}
@@ -0,0 +1,180 @@
+package harvest_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/import/harvest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	projectID = "621948458cb9606d934ebb1c"
+	taskID    = "62194a8d2e8ec75a3d1b1f5e"
+	tagID     = "62ae28b72518aa18da2acb49"
+)
+
+var export = heredoc.Doc(`
+	Date,Client,Project,Project Code,Task,Notes,Hours,Billable?
+	2022-06-19,Acme,Website,WEB,Design,Writing docs,1.5,Yes
+	2022-06-19,,Internal,,Meetings,Daily,0.25,No
+	2022-06-20,Acme,Website,WEB,Design,More docs,2,Yes
+`)
+
+var mapping = heredoc.Doc(`
+	projects:
+	  - harvest: Website
+	    clockify: Clockify CLI
+	  - harvest: Internal
+	    clockify: ""
+	tasks:
+	  - harvest: Meetings
+	    clockify: ""
+	    tags: [Meeting]
+`)
+
+func newClient(t *testing.T) *mocks.MockClient {
+	c := mocks.NewMockClient(t)
+	c.EXPECT().GetProjects(mock.Anything, api.GetProjectsParam{
+		Workspace:       "w",
+		PaginationParam: api.AllPages(),
+	}).
+		Return([]dto.Project{{ID: projectID, Name: "Clockify CLI"}}, nil).
+		Once()
+	c.EXPECT().GetTasks(mock.Anything, api.GetTasksParam{
+		Workspace:       "w",
+		ProjectID:       projectID,
+		PaginationParam: api.AllPages(),
+	}).
+		Return([]dto.Task{{ID: taskID, Name: "Design"}}, nil).
+		Once()
+	c.EXPECT().GetTags(mock.Anything, api.GetTagsParam{
+		Workspace:       "w",
+		PaginationParam: api.AllPages(),
+	}).
+		Return([]dto.Tag{{ID: tagID, Name: "Meeting"}}, nil).
+		Once()
+
+	return c
+}
+
+func runCmd(t *testing.T, c api.Client, mapping string, args ...string) (
+	string, string, error) {
+	f := mocks.NewMockFactory(t)
+	f.EXPECT().GetWorkspaceID().Return("w", nil)
+	f.EXPECT().Client().Return(c, nil)
+	f.EXPECT().Config().Return(&mocks.SimpleConfig{}).Maybe()
+
+	m := filepath.Join(t.TempDir(), "mapping.yaml")
+	if err := os.WriteFile(m, []byte(mapping), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := harvest.NewCmdHarvest(f)
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	out := bytes.NewBufferString("")
+	stderr := bytes.NewBufferString("")
+	cmd.SetOut(out)
+	cmd.SetErr(stderr)
+	cmd.SetIn(strings.NewReader(export))
+	cmd.SetArgs(append([]string{"--file=-", "--mapping=" + m}, args...))
+
+	_, err := cmd.ExecuteC()
+	return out.String(), stderr.String(), err
+}
+
+func TestCmdHarvestDryRun(t *testing.T) {
+	out, stderr, err := runCmd(t, newClient(t), mapping,
+		"--dry-run", "--day-start=08:30",
+		`--format={{.TimeInterval.Start.Format "2006-01-02 15:04"}}-`+
+			`{{.TimeInterval.End.Format "15:04"}};{{.Description}};`+
+			"{{with .Project}}{{.ID}}{{end}};{{with .Task}}{{.ID}}{{end}};"+
+			"{{range .Tags}}{{.ID}}{{end}};{{.Billable}}")
+
+	assert.NoError(t, err)
+	assert.Empty(t, stderr)
+	assert.Equal(t, heredoc.Doc(`
+		2022-06-19 08:30-10:00;Writing docs;`+projectID+`;`+taskID+`;;true
+		2022-06-19 10:00-10:15;Daily;;;`+tagID+`;false
+		2022-06-20 08:30-10:30;More docs;`+projectID+`;`+taskID+`;;true
+	`), out)
+}
+
+func TestCmdHarvest(t *testing.T) {
+	c := newClient(t)
+
+	day := time.Date(2022, 6, 19, 9, 0, 0, 0, time.Local)
+	for _, p := range []api.CreateTimeEntryParam{
+		{
+			Start:       day,
+			End:         timePtr(day.Add(90 * time.Minute)),
+			Billable:    boolPtr(true),
+			Description: "Writing docs",
+			ProjectID:   projectID,
+			TaskID:      taskID,
+			TagIDs:      []string{},
+		},
+		{
+			Start:       day.Add(90 * time.Minute),
+			End:         timePtr(day.Add(105 * time.Minute)),
+			Billable:    boolPtr(false),
+			Description: "Daily",
+			TagIDs:      []string{tagID},
+		},
+		{
+			Start:       day.AddDate(0, 0, 1),
+			End:         timePtr(day.AddDate(0, 0, 1).Add(2 * time.Hour)),
+			Billable:    boolPtr(true),
+			Description: "More docs",
+			ProjectID:   projectID,
+			TaskID:      taskID,
+			TagIDs:      []string{},
+		},
+	} {
+		p.Workspace = "w"
+		c.EXPECT().CreateTimeEntry(mock.Anything, p).
+			Return(dto.TimeEntryImpl{ID: "te-" + p.Description}, nil).
+			Once()
+	}
+
+	out, stderr, err := runCmd(t, c, mapping, "-q")
+
+	assert.NoError(t, err)
+	assert.Empty(t, stderr)
+	assert.Equal(t, "te-Writing docs\nte-Daily\nte-More docs\n", out)
+}
+
+func TestCmdHarvestNotMapped(t *testing.T) {
+	c := mocks.NewMockClient(t)
+	c.EXPECT().GetProjects(mock.Anything, api.GetProjectsParam{
+		Workspace:       "w",
+		PaginationParam: api.AllPages(),
+	}).
+		Return([]dto.Project{{ID: projectID, Name: "Clockify CLI"}}, nil).
+		Times(2)
+
+	_, stderr, err := runCmd(t, c, "")
+
+	assert.EqualError(t, err,
+		"3 of 3 entries can't be imported, no time entry was created")
+	assert.Equal(t, heredoc.Doc(`
+		line 2: No project with id or name containing 'Website' was found
+		line 3: No project with id or name containing 'Internal' was found
+		line 4: No project with id or name containing 'Website' was found
+	`), stderr)
+}
+
+func timePtr(t time.Time) *time.Time { return &t }
+
+func boolPtr(b bool) *bool { return &b }
@@ -0,0 +1,315 @@
+package ics
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/ics"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdICS represents the import ics command
+func NewCmdICS(f cmdutil.Factory) *cobra.Command {
+	of := util.OutputFlags{TimeFormat: timehlp.FullTimeFormat}
+	var tags []string
+	var since, until, mappingFile, project, task string
+	var dryRun, yes bool
+
+	cmd := &cobra.Command{
+		Use:   "ics <file>",
+		Short: "Create time entries from the events of a calendar (.ics)",
+		Long: heredoc.Doc(`
+			Proposes time entries from the events of an iCalendar file (.ics)
+			started between --since and --until, and creates them after
+			confirming. Recurring events are expanded into their occurrences.
+
+			All-day, cancelled and zero-length events are ignored.
+
+			The description is the title of the event, and the project, task
+			and tags are the ones set by --project, --task and --tag. To use
+			other ones depending on the title, or to ignore some events, set a
+			mapping file (--mapping) like:
+
+			  rules:
+			    - title: "(?i)^lunch"    # regular expression for the title
+			      skip: true             # the events are ignored
+			    - title: "^\[(\w+)\] (.*)"
+			      project: Clients       # name or ID of the project on Clockify
+			      task: Meetings         # name or ID of the task on Clockify
+			      tags: [Meeting]        # names or IDs of tags on Clockify
+			      billable: true
+			      description: "$1: $2"  # can use the groups of the title
+			    - title: "(?i)stand-?up|retro|planning"
+			      project: Internal
+			      tags: [Meeting]
+
+			The first rule matching the title is used.
+
+			Use - as the file to read the calendar from stdin. When not
+			interactive, --yes must be set to create them.
+		`) + "\n" +
+			"When setting `--since` and `--until` you can use any of the " +
+			"following formats:\n" +
+			util.HelpDateTimeFormats,
+		Example: heredoc.Docf(`
+			# check what would be created for the meetings of this week
+			$ %[1]s calendar.ics --since "last monday" --mapping calendar.yaml --dry-run
+
+			# create the time entries of yesterday, without asking
+			$ %[1]s calendar.ics --since "yesterday 00:00" --until "today 00:00" \
+			    --mapping calendar.yaml --yes -q
+		`, "clockify-cli import ics"),
+		Args: cobra.MatchAll(
+			cmdutil.RequiredNamedArgs("file"),
+			cobra.ExactArgs(1),
+		),
+		ValidArgsFunction: func(*cobra.Command, []string, string) (
+			[]string, cobra.ShellCompDirective) {
+			return []string{"ics"}, cobra.ShellCompDirectiveFilterFileExt
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			if task != "" && project == "" {
+				return cmdutil.FlagErrorWrap(
+					errors.New("`task` can only be used with `project`"))
+			}
+
+			s, err := timehlp.ConvertToTime(since)
+			if err != nil {
+				return cmdutil.FlagErrorWrap(
+					errors.Wrap(err, "invalid --since"))
+			}
+
+			u, err := timehlp.ConvertToTime(until)
+			if err != nil {
+				return cmdutil.FlagErrorWrap(
+					errors.Wrap(err, "invalid --until"))
+			}
+
+			m, err := readMapping(mappingFile)
+			if err != nil {
+				return err
+			}
+
+			es, err := readFile(cmd.InOrStdin(), args[0])
+			if err != nil {
+				return err
+			}
+
+			stderr := cmd.ErrOrStderr()
+			es, errs := ics.Occurrences(es, s, u)
+			for _, err := range errs {
+				fmt.Fprintf(stderr, "%s, ignored\n", err)
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			userID, err := f.GetUserID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			teis := []util.TimeEntryDTO{}
+			tes := []dto.TimeEntry{}
+			for _, e := range es {
+				if e.AllDay || e.Cancelled || !e.End.After(e.Start) ||
+					e.End.After(u) {
+					continue
+				}
+
+				tei := util.TimeEntryDTO{
+					Workspace:   w,
+					UserID:      userID,
+					ProjectID:   project,
+					TaskID:      task,
+					Description: e.Summary,
+					Start:       e.Start,
+				}
+				if len(tags) > 0 {
+					tei.TagIDs = tags
+				}
+				end := e.End
+				tei.End = &end
+
+				if r, d, ok := m.Match(e.Summary); ok {
+					if r.Skip {
+						continue
+					}
+
+					tei.Description = d
+					tei.Billable = r.Billable
+					if r.Project != "" {
+						tei.ProjectID = r.Project
+						tei.TaskID = r.Task
+					}
+					if len(r.Tags) > 0 {
+						tei.TagIDs = r.Tags
+					}
+				}
+
+				// the tags are replaced by their IDs on the same slice
+				named := tei
+				if len(tei.TagIDs) > 0 {
+					tei.TagIDs = append([]string{}, tei.TagIDs...)
+				}
+				if tei, err = util.Do(tei,
+					util.GetAllowNameForIDsFn(ctx, f.Config(), c),
+					util.GetValidateTimeEntryFn(ctx, f),
+				); err != nil {
+					return errors.Wrapf(err, "event \"%s\" at %s",
+						e.Summary, e.Start.Format(timehlp.FullTimeFormat))
+				}
+
+				teis = append(teis, tei)
+				tes = append(tes, util.TimeEntryDTOToNamed(tei, named))
+			}
+
+			if len(teis) == 0 {
+				fmt.Fprintln(stderr, "no events were found")
+				return nil
+			}
+
+			if dryRun {
+				return util.PrintTimeEntries(
+					tes, cmd.OutOrStdout(), f.Config(), of)
+			}
+
+			if !yes {
+				if !f.Config().IsInteractive() {
+					return cmdutil.FlagErrorWrap(errors.New(
+						"set `yes` to create them without confirming"))
+				}
+
+				if err := util.PrintTimeEntries(tes, stderr,
+					f.Config(), util.OutputFlags{
+						TimeFormat: timehlp.FullTimeFormat,
+					}); err != nil {
+					return err
+				}
+
+				ok, err := f.UI().Confirm(fmt.Sprintf(
+					"Create these %d time entries?", len(tes)), true)
+				if err != nil || !ok {
+					return err
+				}
+			}
+
+			failed := 0
+			created := make([]dto.TimeEntry, 0, len(tes))
+			for i, tei := range teis {
+				te, err := c.CreateTimeEntry(ctx, api.CreateTimeEntryParam{
+					Workspace:   w,
+					Start:       tei.Start,
+					End:         tei.End,
+					Billable:    tei.Billable,
+					Description: tei.Description,
+					ProjectID:   tei.ProjectID,
+					TaskID:      tei.TaskID,
+					TagIDs:      tei.TagIDs,
+				})
+				if err != nil {
+					failed++
+					fmt.Fprintf(stderr, "event \"%s\" at %s: %s\n",
+						tei.Description,
+						tei.Start.Format(timehlp.FullTimeFormat), err)
+					continue
+				}
+
+				tes[i].ID = te.ID
+				created = append(created, tes[i])
+			}
+
+			if err := util.PrintTimeEntries(
+				created, cmd.OutOrStdout(), f.Config(), of); err != nil {
+				return err
+			}
+
+			if failed > 0 {
+				return errors.Errorf(
+					"%d of %d time entries failed to be created",
+					failed, len(teis))
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&since, "since", "today",
+		"imports the events started since")
+	cmd.Flags().StringVar(&until, "until", timehlp.NowTimeFormat,
+		"imports the events ended until")
+	cmd.Flags().StringVar(&mappingFile, "mapping", "",
+		"YAML file with rules to map the events by their titles")
+	_ = cmd.MarkFlagFilename("mapping", "yaml", "yml")
+
+	cmd.Flags().StringVarP(&project, "project", "p", "",
+		"project of the time entries not set by the mapping")
+	_ = cmdcompl.AddSuggestionsToFlag(cmd, "project",
+		cmdcomplutil.NewProjectAutoComplete(f))
+	cmd.Flags().StringVar(&task, "task", "",
+		"task of the time entries not set by the mapping")
+	cmd.Flags().StringSliceVarP(&tags, "tag", "T", []string{},
+		"tags of the time entries not set by the mapping")
+	_ = cmdcompl.AddSuggestionsToFlag(cmd, "tag",
+		cmdcomplutil.NewTagAutoComplete(f))
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false,
+		"only prints the time entries, without creating them")
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false,
+		"creates the time entries without confirming")
+
+	cmd.Flags().StringVarP(&of.Format, "format", "f", "",
+		"golang text/template format to be applied on each time entry")
+	cmd.Flags().BoolVarP(&of.JSON, "json", "j", false, "print as JSON")
+	cmd.Flags().BoolVarP(&of.Quiet, "quiet", "q", false, "print only ID")
+
+	return cmd
+}
+
+func readMapping(file string) (ics.Mapping, error) {
+	if file == "" {
+		return ics.Mapping{}, nil
+	}
+
+	r, err := os.Open(file)
+	if err != nil {
+		return ics.Mapping{}, errors.Wrap(err, "failed to open the mapping")
+	}
+	defer r.Close()
+
+	return ics.LoadMapping(r)
+}
+
+func readFile(stdin io.Reader, file string) ([]ics.Event, error) {
+	if file == "-" {
+		return ics.Read(stdin, time.Local)
+	}
+
+	r, err := os.Open(file)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open the calendar")
+	}
+	defer r.Close()
+
+	return ics.Read(r, time.Local)
+}
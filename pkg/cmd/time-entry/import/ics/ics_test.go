@@ -0,0 +1,146 @@
+package ics_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/import/ics"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const calendar = `BEGIN:VCALENDAR
+BEGIN:VEVENT
+SUMMARY:[Acme] Website review
+DTSTART:20220601T130000Z
+DTEND:20220601T140000Z
+END:VEVENT
+BEGIN:VEVENT
+SUMMARY:Lunch
+DTSTART:20220601T120000Z
+DTEND:20220601T130000Z
+END:VEVENT
+BEGIN:VEVENT
+SUMMARY:Holiday
+DTSTART;VALUE=DATE:20220602
+END:VEVENT
+BEGIN:VEVENT
+SUMMARY:Daily
+DTSTART:20220601T090000Z
+DURATION:PT15M
+RRULE:FREQ=DAILY;COUNT=2
+END:VEVENT
+END:VCALENDAR
+`
+
+func writeFile(t *testing.T, name, content string) string {
+	p := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(p, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return p
+}
+
+func TestCmdICS(t *testing.T) {
+	mapping := writeFile(t, "mapping.yaml", heredoc.Doc(`
+		rules:
+		  - title: (?i)^lunch
+		    skip: true
+		  - title: ^\[(\w+)\] (.*)
+		    project: p2
+		    tags: [tg1]
+		    description: "$1: $2"
+	`))
+
+	f := mocks.NewMockFactory(t)
+	f.EXPECT().GetWorkspaceID().Return("w", nil)
+	f.EXPECT().GetUserID().Return("u", nil)
+
+	conf := mocks.NewMockConfig(t)
+	f.EXPECT().Config().Return(conf)
+	conf.EXPECT().GetBool(cmdutil.CONF_ALLOW_INCOMPLETE).Return(true)
+	conf.EXPECT().GetBool(mock.Anything).Return(false).Maybe()
+	conf.EXPECT().GetString(mock.Anything).Return("").Maybe()
+
+	c := mocks.NewMockClient(t)
+	f.EXPECT().Client().Return(c, nil)
+
+	at := func(d, h, m int) *time.Time {
+		t := time.Date(2022, 6, d, h, m, 0, 0, time.UTC).Local()
+		return &t
+	}
+	for i, p := range []api.CreateTimeEntryParam{
+		{
+			Start:       *at(1, 9, 0),
+			End:         at(1, 9, 15),
+			ProjectID:   "p1",
+			Description: "Daily",
+		},
+		{
+			Start:       *at(1, 13, 0),
+			End:         at(1, 14, 0),
+			ProjectID:   "p2",
+			TagIDs:      []string{"tg1"},
+			Description: "Acme: Website review",
+		},
+		{
+			Start:       *at(2, 9, 0),
+			End:         at(2, 9, 15),
+			ProjectID:   "p1",
+			Description: "Daily",
+		},
+	} {
+		p.Workspace = "w"
+		c.EXPECT().CreateTimeEntry(mock.Anything, p).
+			Return(dto.TimeEntryImpl{
+				ID: []string{"te1", "te2", "te3"}[i]}, nil).
+			Once()
+	}
+
+	cmd := ics.NewCmdICS(f)
+	out := bytes.NewBufferString("")
+	cmd.SetOut(out)
+	cmd.SetErr(bytes.NewBufferString(""))
+	cmd.SetArgs([]string{writeFile(t, "calendar.ics", calendar),
+		"--mapping", mapping, "-p", "p1",
+		"--since", "2022-05-30 00:00", "--until", "2022-06-03 00:00",
+		"--yes", "-q"})
+
+	_, err := cmd.ExecuteC()
+	assert.NoError(t, err)
+	assert.Equal(t, "te1\nte2\nte3\n", out.String())
+}
+
+func TestCmdICSFromStdinNeedsConfirmation(t *testing.T) {
+	f := mocks.NewMockFactory(t)
+	f.EXPECT().GetWorkspaceID().Return("w", nil)
+	f.EXPECT().GetUserID().Return("u", nil)
+	f.EXPECT().Client().Return(mocks.NewMockClient(t), nil)
+
+	conf := mocks.NewMockConfig(t)
+	f.EXPECT().Config().Return(conf)
+	conf.EXPECT().GetBool(cmdutil.CONF_ALLOW_INCOMPLETE).Return(true)
+	conf.EXPECT().GetBool(mock.Anything).Return(false).Maybe()
+	conf.EXPECT().IsInteractive().Return(false)
+
+	cmd := ics.NewCmdICS(f)
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	cmd.SetIn(strings.NewReader(calendar))
+	cmd.SetOut(bytes.NewBufferString(""))
+	cmd.SetArgs([]string{"-",
+		"--since", "2022-05-30 00:00", "--until", "2022-06-03 00:00"})
+
+	_, err := cmd.ExecuteC()
+	assert.EqualError(t, err, "set `yes` to create them without confirming")
+}
@@ -0,0 +1,182 @@
+package importcmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/import/git"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/import/harvest"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/import/ics"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/import/toggl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdImport represents the import command
+func NewCmdImport(f cmdutil.Factory) *cobra.Command {
+	of := util.OutputFlags{TimeFormat: timehlp.FullTimeFormat}
+	var file string
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Create time entries from a CSV file",
+		Long: heredoc.Doc(`
+			Create time entries from a CSV file, one for each row.
+
+			The first row must be a header naming the columns, in any order:
+
+			  description              description of the time entry
+			  project, project.id,     name or ID of the project
+			  project.name
+			  task, task.id,           name or ID of the task (requires a project)
+			  task.name
+			  start                    when the time entry started (required)
+			  end                      when the time entry ended
+			  duration, dur            used when end is empty, like: 1:30 or 1h30m
+			  billable                 true, false, yes or no
+			  tags                     name or ID of a tag, must be the last column
+			                           and each column after it is another tag
+
+			Columns only printed by "report --csv" (id, user.*, rate, amount and
+			status) are ignored, so an exported report can be imported back.
+			Names are compared ignoring case, and values like "Name (ID)" use the ID.
+
+			Every row is checked before any time entry is created; if one of them is
+			invalid nothing is created. Rows that fail to be created are reported
+			and the others are still created.
+		`) + "\n" +
+			"The start and end columns accept the following formats:\n" +
+			util.HelpDateTimeFormats,
+		Example: heredoc.Docf(`
+			$ cat entries.csv
+			description,project,task,start,end,tags
+			Writing docs,Clockify CLI,Docs,2022-06-19 09:00,2022-06-19 10:30,Docs
+			Reviewing PRs,Clockify CLI,,2022-06-19 10:30,2022-06-19 12:00
+
+			# check what would be created
+			$ %[1]s --csv entries.csv --dry-run
+
+			# create the time entries, printing only their IDs
+			$ %[1]s --csv entries.csv --quiet
+		`, "clockify-cli import"),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+
+			if file == "" {
+				return cmdutil.FlagErrorWrap(
+					errors.New("a file must be informed with --csv"))
+			}
+
+			var r io.Reader = cmd.InOrStdin()
+			if file != "-" {
+				fh, err := os.Open(file)
+				if err != nil {
+					return errors.Wrap(err, "failed to open the CSV file")
+				}
+				defer fh.Close()
+				r = fh
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			rows, err := readRows(ctx, r, newResolver(c, w))
+			if err != nil {
+				return err
+			}
+
+			stderr := cmd.ErrOrStderr()
+			failed := 0
+			for _, r := range rows {
+				if r.err == nil {
+					continue
+				}
+
+				failed++
+				fmt.Fprintf(stderr, "line %d: %s\n", r.line, r.err)
+			}
+
+			if failed > 0 {
+				return errors.Errorf(
+					"%d of %d rows are invalid, no time entry was created",
+					failed, len(rows))
+			}
+
+			tes := make([]dto.TimeEntry, 0, len(rows))
+			for _, r := range rows {
+				if dryRun {
+					tes = append(tes, r.te)
+					continue
+				}
+
+				te, err := c.CreateTimeEntry(ctx, api.CreateTimeEntryParam{
+					Workspace:   w,
+					Start:       r.te.TimeInterval.Start,
+					End:         r.te.TimeInterval.End,
+					Billable:    r.billable,
+					Description: r.te.Description,
+					ProjectID:   r.te.ProjectID,
+					TaskID:      r.taskID(),
+					TagIDs:      r.tagIDs(),
+				})
+				if err != nil {
+					failed++
+					fmt.Fprintf(stderr, "line %d: %s\n", r.line, err)
+					continue
+				}
+
+				r.te.ID = te.ID
+				tes = append(tes, r.te)
+			}
+
+			if err := util.PrintTimeEntries(
+				tes, cmd.OutOrStdout(), f.Config(), of); err != nil {
+				return err
+			}
+
+			if failed > 0 {
+				return errors.Errorf("%d of %d rows failed to be created",
+					failed, len(rows))
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&file, "csv", "",
+		"CSV file with the time entries to create (use - to read from stdin)")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false,
+		"only checks and prints the time entries, without creating them")
+	_ = cmd.MarkFlagFilename("csv", "csv")
+
+	// --csv is the input here, so only some of the print flags are used
+	cmd.Flags().StringVarP(&of.Format, "format", "f", "",
+		"golang text/template format to be applied on each time entry")
+	cmd.Flags().BoolVarP(&of.JSON, "json", "j", false, "print as JSON")
+	cmd.Flags().BoolVarP(&of.Quiet, "quiet", "q", false, "print only ID")
+
+	cmd.AddCommand(
+		toggl.NewCmdToggl(f, nil),
+		harvest.NewCmdHarvest(f),
+		git.NewCmdGit(f),
+		ics.NewCmdICS(f),
+	)
+
+	return cmd
+}
@@ -0,0 +1,214 @@
+package importcmd_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	importcmd "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/import"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	projectID = "621948458cb9606d934ebb1c"
+	taskID    = "62194a8d2e8ec75a3d1b1f5e"
+	tagID     = "62ae28b72518aa18da2acb49"
+)
+
+func newClient(t *testing.T) *mocks.MockClient {
+	c := mocks.NewMockClient(t)
+	c.EXPECT().GetProjects(mock.Anything, api.GetProjectsParam{
+		Workspace:       "w",
+		PaginationParam: api.AllPages(),
+	}).
+		Return([]dto.Project{
+			{ID: "p2", Name: "Other"},
+			{ID: projectID, Name: "Clockify CLI"},
+		}, nil).
+		Maybe()
+	c.EXPECT().GetTasks(mock.Anything, api.GetTasksParam{
+		Workspace:       "w",
+		ProjectID:       projectID,
+		PaginationParam: api.AllPages(),
+	}).
+		Return([]dto.Task{{ID: taskID, Name: "Docs"}}, nil).
+		Maybe()
+	c.EXPECT().GetTags(mock.Anything, api.GetTagsParam{
+		Workspace:       "w",
+		PaginationParam: api.AllPages(),
+	}).
+		Return([]dto.Tag{
+			{ID: tagID, Name: "Development"},
+			{ID: "t2", Name: "Meeting"},
+		}, nil).
+		Maybe()
+
+	return c
+}
+
+func runCmd(t *testing.T, c api.Client, csv string, args ...string) (
+	string, string, error) {
+	f := mocks.NewMockFactory(t)
+	f.EXPECT().GetWorkspaceID().Return("w", nil)
+	f.EXPECT().Client().Return(c, nil)
+	f.EXPECT().Config().Return(&mocks.SimpleConfig{}).Maybe()
+
+	cmd := importcmd.NewCmdImport(f)
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	out := bytes.NewBufferString("")
+	stderr := bytes.NewBufferString("")
+	cmd.SetOut(out)
+	cmd.SetErr(stderr)
+	cmd.SetIn(strings.NewReader(csv))
+	cmd.SetArgs(append([]string{"--csv=-"}, args...))
+
+	_, err := cmd.ExecuteC()
+	return out.String(), stderr.String(), err
+}
+
+func TestCmdImportDryRun(t *testing.T) {
+	c := newClient(t)
+
+	out, stderr, err := runCmd(t, c, heredoc.Doc(`
+		id,description,project.id,project.name,task.name,start,dur,billable,tags
+		x,Writing docs,,clockify cli,docs,2022-06-19 09:00,1:30,yes,Development (`+tagID+`),Meeting
+		x,Reviewing PRs,`+projectID+`,Other,,2022-06-19 10:30:00,2h,,
+	`), "--dry-run",
+		"--format={{.Description}};{{.Project.Name}};{{with .Task}}{{.ID}}{{end}};"+
+			"{{.TimeInterval.End.Format \"15:04\"}};{{.Billable}};{{len .Tags}}")
+
+	assert.NoError(t, err)
+	assert.Empty(t, stderr)
+	assert.Equal(t, heredoc.Doc(`
+		Writing docs;Clockify CLI;`+taskID+`;10:30;true;2
+		Reviewing PRs;Clockify CLI;;12:30;false;0
+	`), out)
+}
+
+func TestCmdImport(t *testing.T) {
+	c := newClient(t)
+
+	start := time.Date(2022, 6, 19, 9, 0, 0, 0, time.Local)
+	end := start.Add(time.Hour)
+	b := true
+	c.EXPECT().CreateTimeEntry(mock.Anything, api.CreateTimeEntryParam{
+		Workspace:   "w",
+		Start:       start,
+		End:         &end,
+		Billable:    &b,
+		Description: "Writing docs",
+		ProjectID:   projectID,
+		TaskID:      taskID,
+		TagIDs:      []string{tagID},
+	}).
+		Return(dto.TimeEntryImpl{ID: "te1"}, nil).
+		Once()
+
+	start2 := end
+	end2 := start2.Add(30 * time.Minute)
+	c.EXPECT().CreateTimeEntry(mock.Anything, api.CreateTimeEntryParam{
+		Workspace: "w",
+		Start:     start2,
+		End:       &end2,
+		TagIDs:    []string{},
+	}).
+		Return(dto.TimeEntryImpl{}, errors.New("create time entry: locked")).
+		Once()
+
+	start3 := end2
+	end3 := start3.Add(30 * time.Minute)
+	c.EXPECT().CreateTimeEntry(mock.Anything, mock.MatchedBy(
+		func(p api.CreateTimeEntryParam) bool {
+			return p.Start.Equal(start3) && p.End.Equal(end3)
+		})).
+		Return(dto.TimeEntryImpl{ID: "te3"}, nil).
+		Once()
+
+	out, stderr, err := runCmd(t, c, heredoc.Doc(`
+		description,project,task,start,end,billable,tags
+		Writing docs,Clockify CLI,Docs,2022-06-19 09:00,2022-06-19 10:00,true,development
+		,,,2022-06-19 10:00,2022-06-19 10:30,,
+		Meeting,,,2022-06-19 10:30,2022-06-19 11:00,,
+	`), "--quiet")
+
+	assert.EqualError(t, err, "1 of 3 rows failed to be created")
+	assert.Equal(t, "line 3: create time entry: locked\n", stderr)
+	assert.Equal(t, "te1\nte3\n", out)
+}
+
+func TestCmdImportInvalidRows(t *testing.T) {
+	c := newClient(t)
+
+	_, stderr, err := runCmd(t, c, heredoc.Doc(`
+		description,project,task,start,end,duration,billable,tags
+		ok,,,2022-06-19 09:00,,1h,,
+		no end,,,2022-06-19 09:00,,,,
+		bad start,,,someday,,1h,,
+		bad duration,,,2022-06-19 09:00,,1.5,,
+		bad billable,,,2022-06-19 09:00,,1h,maybe,
+		unknown project,Nope,,2022-06-19 09:00,,1h,,
+		task without project,,Docs,2022-06-19 09:00,,1h,,
+		unknown tag,,,2022-06-19 09:00,,1h,,Nope
+		backwards,,,2022-06-19 09:00,2022-06-19 08:00,,,
+	`), "--quiet")
+
+	assert.EqualError(t, err,
+		"8 of 9 rows are invalid, no time entry was created")
+	assert.Regexp(t, "^"+heredoc.Doc(`
+		line 3: end or duration is required
+		line 4: invalid start: .+
+		line 5: invalid duration "1.5", use a format like 1:30 or 1h30m
+		line 6: invalid billable "maybe", use true or false
+		line 7: project "Nope" was not found
+		line 8: a project is required to set a task
+		line 9: tag "Nope" was not found
+		line 10: end is before start
+	`)+"$", stderr)
+}
+
+func TestCmdImportInvalidHeader(t *testing.T) {
+	tts := []struct {
+		name string
+		csv  string
+		err  string
+	}{
+		{name: "empty", csv: "", err: "the CSV file is empty"},
+		{
+			name: "unknown column",
+			csv:  "description,start,end,foo\n",
+			err:  `unknown column "foo" on the header`,
+		},
+		{
+			name: "no start",
+			csv:  "description,end\n",
+			err:  `the column "start" is required`,
+		},
+		{
+			name: "no end",
+			csv:  "description,start\n",
+			err:  `one of the columns "end" or "duration" is required`,
+		},
+		{
+			name: "tags not last",
+			csv:  "tags,start,end\n",
+			err:  `the column "tags" must be the last one`,
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := runCmd(t, mocks.NewMockClient(t), tt.csv)
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
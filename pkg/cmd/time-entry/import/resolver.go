@@ -0,0 +1,105 @@
+package importcmd
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/pkg/errors"
+)
+
+// resolver looks up projects, tasks and tags by name or ID, fetching each
+// list only once for all the rows
+type resolver struct {
+	c         api.Client
+	workspace string
+
+	projects []dto.Project
+	tasks    map[string][]dto.Task
+	tags     []dto.Tag
+}
+
+func newResolver(c api.Client, workspace string) *resolver {
+	return &resolver{c: c, workspace: workspace, tasks: map[string][]dto.Task{}}
+}
+
+// idOnRef finds the ID on values like "Name (ID)", as printed by the CSV
+// output for tags
+var idOnRef = regexp.MustCompile(`\(([0-9a-fA-F]{24})\)$`)
+
+func matches(ref, id, name string) bool {
+	if m := idOnRef.FindStringSubmatch(ref); m != nil {
+		return m[1] == id
+	}
+
+	return ref == id || strings.EqualFold(strings.TrimSpace(name), ref)
+}
+
+func (r *resolver) project(
+	ctx context.Context, ref string) (*dto.Project, error) {
+	if r.projects == nil {
+		ps, err := r.c.GetProjects(ctx, api.GetProjectsParam{
+			Workspace:       r.workspace,
+			PaginationParam: api.AllPages(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		r.projects = ps
+	}
+
+	for i := range r.projects {
+		if matches(ref, r.projects[i].ID, r.projects[i].Name) {
+			return &r.projects[i], nil
+		}
+	}
+
+	return nil, errors.Errorf(`project "%s" was not found`, ref)
+}
+
+func (r *resolver) task(
+	ctx context.Context, project, ref string) (*dto.Task, error) {
+	ts, ok := r.tasks[project]
+	if !ok {
+		var err error
+		if ts, err = r.c.GetTasks(ctx, api.GetTasksParam{
+			Workspace:       r.workspace,
+			ProjectID:       project,
+			PaginationParam: api.AllPages(),
+		}); err != nil {
+			return nil, err
+		}
+		r.tasks[project] = ts
+	}
+
+	for i := range ts {
+		if matches(ref, ts[i].ID, ts[i].Name) {
+			return &ts[i], nil
+		}
+	}
+
+	return nil, errors.Errorf(`task "%s" was not found`, ref)
+}
+
+func (r *resolver) tag(ctx context.Context, ref string) (*dto.Tag, error) {
+	if r.tags == nil {
+		ts, err := r.c.GetTags(ctx, api.GetTagsParam{
+			Workspace:       r.workspace,
+			PaginationParam: api.AllPages(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		r.tags = ts
+	}
+
+	for i := range r.tags {
+		if matches(ref, r.tags[i].ID, r.tags[i].Name) {
+			return &r.tags[i], nil
+		}
+	}
+
+	return nil, errors.Errorf(`tag "%s" was not found`, ref)
+}
@@ -0,0 +1,296 @@
+package importcmd
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/pkg/errors"
+)
+
+// column is the field of the time entry a CSV column is read into
+type column int
+
+const (
+	colIgnored column = iota
+	colDescription
+	colProject
+	colTask
+	colStart
+	colEnd
+	colDuration
+	colBillable
+	colTags
+)
+
+var columns = map[string]column{
+	"description":  colDescription,
+	"project":      colProject,
+	"project.id":   colProject,
+	"project.name": colProject,
+	"task":         colTask,
+	"task.id":      colTask,
+	"task.name":    colTask,
+	"start":        colStart,
+	"end":          colEnd,
+	"duration":     colDuration,
+	"dur":          colDuration,
+	"billable":     colBillable,
+	"tags":         colTags,
+	"tags...":      colTags,
+
+	// printed by "report --csv", but can't be set when creating
+	"id":         colIgnored,
+	"user.id":    colIgnored,
+	"user.email": colIgnored,
+	"user.name":  colIgnored,
+	"rate":       colIgnored,
+	"amount":     colIgnored,
+	"status":     colIgnored,
+}
+
+// row is a time entry read from a line of the CSV, err is set when it can't
+// be created
+type row struct {
+	line     int
+	te       dto.TimeEntry
+	billable *bool
+	err      error
+}
+
+func (r row) taskID() string {
+	if r.te.Task == nil {
+		return ""
+	}
+
+	return r.te.Task.ID
+}
+
+func (r row) tagIDs() []string {
+	ids := make([]string, len(r.te.Tags))
+	for i := range r.te.Tags {
+		ids[i] = r.te.Tags[i].ID
+	}
+
+	return ids
+}
+
+// readRows reads the time entries from the CSV, a row is returned for each
+// line after the header, even the invalid ones
+func readRows(ctx context.Context, r io.Reader, rs *resolver) ([]row, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	h, err := cr.Read()
+	if err == io.EOF {
+		return nil, errors.New("the CSV file is empty")
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read the CSV file")
+	}
+
+	cols, err := parseHeader(h)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]row, 0)
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read the CSV file")
+		}
+
+		line, _ := cr.FieldPos(0)
+		te, billable, err := toTimeEntry(ctx, record, cols, rs)
+		rows = append(rows, row{
+			line:     line,
+			te:       te,
+			billable: billable,
+			err:      err,
+		})
+	}
+
+	return rows, nil
+}
+
+func parseHeader(h []string) ([]column, error) {
+	cols := make([]column, len(h))
+	has := map[column]bool{}
+	for i := range h {
+		n := strings.ToLower(strings.TrimSpace(h[i]))
+		c, ok := columns[n]
+		if !ok {
+			return nil, errors.Errorf(`unknown column "%s" on the header`, n)
+		}
+
+		if c == colTags && i != len(h)-1 {
+			return nil, errors.New(`the column "tags" must be the last one`)
+		}
+
+		cols[i] = c
+		has[c] = true
+	}
+
+	if !has[colStart] {
+		return nil, errors.New(`the column "start" is required`)
+	}
+
+	if !has[colEnd] && !has[colDuration] {
+		return nil, errors.New(
+			`one of the columns "end" or "duration" is required`)
+	}
+
+	return cols, nil
+}
+
+func toTimeEntry(
+	ctx context.Context, record []string, cols []column, rs *resolver,
+) (te dto.TimeEntry, billable *bool, err error) {
+	values := map[column]string{}
+	tags := make([]string, 0)
+	for i := range record {
+		c := cols[len(cols)-1]
+		if i < len(cols) {
+			c = cols[i]
+		} else if c != colTags {
+			return te, nil, errors.New(
+				"there are more columns than on the header")
+		}
+
+		v := strings.TrimSpace(record[i])
+		if v == "" {
+			continue
+		}
+
+		if c == colTags {
+			tags = append(tags, v)
+			continue
+		}
+
+		// when both the ID and name are exported, the first one is used
+		if _, ok := values[c]; !ok {
+			values[c] = v
+		}
+	}
+
+	te.Description = values[colDescription]
+
+	if values[colStart] == "" {
+		return te, nil, errors.New("start is required")
+	}
+
+	if te.TimeInterval.Start, err = timehlp.ConvertToTime(
+		values[colStart]); err != nil {
+		return te, nil, errors.Wrap(err, "invalid start")
+	}
+
+	switch {
+	case values[colEnd] != "":
+		end, err := timehlp.ConvertToTime(values[colEnd])
+		if err != nil {
+			return te, nil, errors.Wrap(err, "invalid end")
+		}
+		te.TimeInterval.End = &end
+	case values[colDuration] != "":
+		d, err := parseDuration(values[colDuration])
+		if err != nil {
+			return te, nil, err
+		}
+		end := te.TimeInterval.Start.Add(d)
+		te.TimeInterval.End = &end
+	default:
+		return te, nil, errors.New("end or duration is required")
+	}
+
+	if te.TimeInterval.End.Before(te.TimeInterval.Start) {
+		return te, nil, errors.New("end is before start")
+	}
+
+	if v := values[colBillable]; v != "" {
+		b, err := parseBool(v)
+		if err != nil {
+			return te, nil, err
+		}
+		te.Billable = b
+		billable = &b
+	}
+
+	if v := values[colProject]; v != "" {
+		if te.Project, err = rs.project(ctx, v); err != nil {
+			return te, nil, err
+		}
+		te.ProjectID = te.Project.ID
+	}
+
+	if v := values[colTask]; v != "" {
+		if te.ProjectID == "" {
+			return te, nil, errors.New("a project is required to set a task")
+		}
+
+		if te.Task, err = rs.task(ctx, te.ProjectID, v); err != nil {
+			return te, nil, err
+		}
+	}
+
+	for _, v := range tags {
+		t, err := rs.tag(ctx, v)
+		if err != nil {
+			return te, nil, err
+		}
+		te.Tags = append(te.Tags, *t)
+	}
+
+	return te, billable, nil
+}
+
+// parseDuration accepts durations like 1:30, 1:30:00 or 1h30m
+func parseDuration(s string) (time.Duration, error) {
+	err := errors.Errorf(
+		`invalid duration "%s", use a format like 1:30 or 1h30m`, s)
+	if !strings.Contains(s, ":") {
+		d, perr := time.ParseDuration(s)
+		if perr != nil || d < 0 {
+			return 0, err
+		}
+		return d, nil
+	}
+
+	ps := strings.Split(s, ":")
+	if len(ps) > 3 {
+		return 0, err
+	}
+
+	units := []time.Duration{time.Hour, time.Minute, time.Second}
+	var d time.Duration
+	for i := range ps {
+		n, perr := strconv.Atoi(ps[i])
+		if perr != nil || n < 0 {
+			return 0, err
+		}
+		d += time.Duration(n) * units[i]
+	}
+
+	return d, nil
+}
+
+func parseBool(s string) (bool, error) {
+	switch strings.ToLower(s) {
+	case "true", "yes", "y", "1":
+		return true, nil
+	case "false", "no", "n", "0":
+		return false, nil
+	default:
+		return false, errors.Errorf(
+			`invalid billable "%s", use true or false`, s)
+	}
+}
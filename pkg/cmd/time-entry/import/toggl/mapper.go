@@ -0,0 +1,281 @@
+package toggl
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/toggl"
+	"github.com/pkg/errors"
+)
+
+// mapper finds the clients, projects, tasks and tags of Clockify with the
+// same names of the ones on Toggl Track, creating them when allowed
+type mapper struct {
+	c         api.Client
+	workspace string
+	create    bool
+	dryRun    bool
+	out       io.Writer
+
+	clients  []dto.Client
+	projects []dto.Project
+	tasks    map[string][]dto.Task
+	tags     []dto.Tag
+	loaded   map[string]bool
+}
+
+func newMapper(
+	c api.Client, workspace string, create, dryRun bool, out io.Writer,
+) *mapper {
+	return &mapper{
+		c:         c,
+		workspace: workspace,
+		create:    create,
+		dryRun:    dryRun,
+		out:       out,
+		tasks:     map[string][]dto.Task{},
+		loaded:    map[string]bool{},
+	}
+}
+
+// missing fails when the resource can't be created, or tells the user it
+// will be
+func (m *mapper) missing(kind, name string) error {
+	if !m.create {
+		return errors.Errorf(
+			`%s "%s" does not exist on Clockify `+
+				"(use --create-missing to create it)", kind, name)
+	}
+
+	if m.dryRun {
+		fmt.Fprintf(m.out, "%s \"%s\" will be created\n", kind, name)
+	} else {
+		fmt.Fprintf(m.out, "creating %s \"%s\"\n", kind, name)
+	}
+
+	return nil
+}
+
+// toTimeEntry maps the Toggl Track entry into a time entry of Clockify
+func (m *mapper) toTimeEntry(
+	ctx context.Context, e toggl.Entry) (dto.TimeEntry, error) {
+	end := e.End
+	te := dto.TimeEntry{
+		Description: e.Description,
+		Billable:    e.Billable,
+		TimeInterval: dto.TimeInterval{
+			Start: e.Start,
+			End:   &end,
+		},
+	}
+
+	if e.Project == "" {
+		if e.Task != "" {
+			return te, errors.New("a project is required to set a task")
+		}
+	} else {
+		clientID := ""
+		if e.Client != "" {
+			c, err := m.client(ctx, e.Client)
+			if err != nil {
+				return te, err
+			}
+			clientID = c.ID
+		}
+
+		p, err := m.project(ctx, e.Project, clientID)
+		if err != nil {
+			return te, err
+		}
+		te.Project = p
+		te.ProjectID = p.ID
+	}
+
+	if e.Task != "" {
+		t, err := m.task(ctx, te.Project, e.Task)
+		if err != nil {
+			return te, err
+		}
+		te.Task = t
+	}
+
+	for _, n := range e.Tags {
+		t, err := m.tag(ctx, n)
+		if err != nil {
+			return te, err
+		}
+		te.Tags = append(te.Tags, *t)
+	}
+
+	return te, nil
+}
+
+func (m *mapper) client(ctx context.Context, name string) (*dto.Client, error) {
+	if !m.loaded["clients"] {
+		cs, err := m.c.GetClients(ctx, api.GetClientsParam{
+			Workspace:       m.workspace,
+			PaginationParam: api.AllPages(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		m.clients = cs
+		m.loaded["clients"] = true
+	}
+
+	for i := range m.clients {
+		if strings.EqualFold(m.clients[i].Name, name) {
+			return &m.clients[i], nil
+		}
+	}
+
+	if err := m.missing("client", name); err != nil {
+		return nil, err
+	}
+
+	c := dto.Client{Name: name}
+	if !m.dryRun {
+		var err error
+		if c, err = m.c.AddClient(ctx, api.AddClientParam{
+			Workspace: m.workspace,
+			Name:      name,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	m.clients = append(m.clients, c)
+	return &m.clients[len(m.clients)-1], nil
+}
+
+// project finds the project by name, when the client is set it must be the
+// same
+func (m *mapper) project(
+	ctx context.Context, name, clientID string) (*dto.Project, error) {
+	if !m.loaded["projects"] {
+		ps, err := m.c.GetProjects(ctx, api.GetProjectsParam{
+			Workspace:       m.workspace,
+			PaginationParam: api.AllPages(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		m.projects = ps
+		m.loaded["projects"] = true
+	}
+
+	for i := range m.projects {
+		if strings.EqualFold(m.projects[i].Name, name) &&
+			(clientID == "" || m.projects[i].ClientID == clientID) {
+			return &m.projects[i], nil
+		}
+	}
+
+	if err := m.missing("project", name); err != nil {
+		return nil, err
+	}
+
+	p := dto.Project{Name: name, ClientID: clientID}
+	if !m.dryRun {
+		var err error
+		if p, err = m.c.AddProject(ctx, api.AddProjectParam{
+			Workspace: m.workspace,
+			Name:      name,
+			ClientId:  clientID,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	m.projects = append(m.projects, p)
+	return &m.projects[len(m.projects)-1], nil
+}
+
+func (m *mapper) task(
+	ctx context.Context, p *dto.Project, name string) (*dto.Task, error) {
+	// tasks of projects still to be created are not fetched
+	key := p.ID
+	if key == "" {
+		key = p.ClientID + "/" + p.Name
+	}
+
+	ts, ok := m.tasks[key]
+	if !ok && p.ID != "" {
+		var err error
+		if ts, err = m.c.GetTasks(ctx, api.GetTasksParam{
+			Workspace:       m.workspace,
+			ProjectID:       p.ID,
+			PaginationParam: api.AllPages(),
+		}); err != nil {
+			return nil, err
+		}
+		m.tasks[key] = ts
+	}
+
+	for i := range ts {
+		if strings.EqualFold(ts[i].Name, name) {
+			return &ts[i], nil
+		}
+	}
+
+	if err := m.missing("task", p.Name+" / "+name); err != nil {
+		return nil, err
+	}
+
+	t := dto.Task{Name: name, ProjectID: p.ID}
+	if !m.dryRun {
+		var err error
+		if t, err = m.c.AddTask(ctx, api.AddTaskParam{
+			Workspace: m.workspace,
+			ProjectID: p.ID,
+			Name:      name,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	m.tasks[key] = append(ts, t)
+	return &t, nil
+}
+
+func (m *mapper) tag(ctx context.Context, name string) (*dto.Tag, error) {
+	if !m.loaded["tags"] {
+		ts, err := m.c.GetTags(ctx, api.GetTagsParam{
+			Workspace:       m.workspace,
+			PaginationParam: api.AllPages(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		m.tags = ts
+		m.loaded["tags"] = true
+	}
+
+	for i := range m.tags {
+		if strings.EqualFold(m.tags[i].Name, name) {
+			return &m.tags[i], nil
+		}
+	}
+
+	if err := m.missing("tag", name); err != nil {
+		return nil, err
+	}
+
+	t := dto.Tag{Name: name}
+	if !m.dryRun {
+		var err error
+		if t, err = m.c.AddTag(ctx, api.AddTagParam{
+			Workspace: m.workspace,
+			Name:      name,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	m.tags = append(m.tags, t)
+	return &m.tags[len(m.tags)-1], nil
+}
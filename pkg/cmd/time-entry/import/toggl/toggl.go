@@ -0,0 +1,252 @@
+package toggl
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/lucassabreu/clockify-cli/pkg/toggl"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdToggl represents the import toggl command
+func NewCmdToggl(
+	f cmdutil.Factory,
+	newTogglClient func(token string) *toggl.Client,
+) *cobra.Command {
+	if newTogglClient == nil {
+		newTogglClient = toggl.NewClient
+	}
+
+	of := util.OutputFlags{TimeFormat: timehlp.FullTimeFormat}
+	var file, token, since, until string
+	var create, dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "toggl",
+		Short: "Create time entries from the ones on Toggl Track",
+		Long: heredoc.Doc(`
+			Create time entries from the ones on Toggl Track, to help migrating
+			from it.
+
+			The time entries can be read from a "Detailed" report exported as
+			CSV (--file) or from the API of Toggl Track using the API token found
+			on your profile (--token or the environment variable
+			TOGGL_API_TOKEN), then --since and --until set which ones to import.
+
+			The clients, projects, tasks and tags are looked up by name on the
+			workspace, if one of them does not exist no time entry is created,
+			unless --create-missing is set, then they are created.
+		`),
+		Example: heredoc.Docf(`
+			# check what would be created from an exported report
+			$ %[1]s --file Toggl_time_entries.csv --dry-run
+
+			# import the time entries of june from the API, creating the
+			# projects and tags not found
+			$ %[1]s --token <token> --since 2022-06-01 --until 2022-07-01 \
+			    --create-missing
+		`, "clockify-cli import toggl"),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+
+			if token == "" && !cmd.Flags().Changed("file") {
+				token = os.Getenv("TOGGL_API_TOKEN")
+			}
+
+			if err := cmdutil.XorFlag(map[string]bool{
+				"file":  file != "",
+				"token": token != "",
+			}); err != nil {
+				return err
+			}
+
+			var es []toggl.Entry
+			var err error
+			switch {
+			case file != "":
+				if es, err = readFile(cmd.InOrStdin(), file); err != nil {
+					return err
+				}
+			case token != "":
+				s, u, err := parseRange(since, until)
+				if err != nil {
+					return err
+				}
+
+				if es, err = newTogglClient(token).
+					TimeEntries(s, u); err != nil {
+					return err
+				}
+			default:
+				return cmdutil.FlagErrorWrap(errors.New(
+					"one of --file or --token must be set"))
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			stderr := cmd.ErrOrStderr()
+			m := newMapper(c, w, create, dryRun, stderr)
+
+			failed := 0
+			tes := make([]dto.TimeEntry, 0, len(es))
+			for i := range es {
+				te, err := m.toTimeEntry(ctx, es[i])
+				if err != nil {
+					failed++
+					fmt.Fprintf(stderr, "entry %d (started at %s): %s\n",
+						i+1, es[i].Start.Format(timehlp.SimplerTimeFormat),
+						err)
+					continue
+				}
+
+				tes = append(tes, te)
+			}
+
+			if failed > 0 {
+				return errors.Errorf(
+					"%d of %d entries can't be imported, "+
+						"no time entry was created", failed, len(es))
+			}
+
+			if !dryRun {
+				created := make([]dto.TimeEntry, 0, len(tes))
+				for i := range tes {
+					te, err := c.CreateTimeEntry(ctx, api.CreateTimeEntryParam{
+						Workspace:   w,
+						Start:       tes[i].TimeInterval.Start,
+						End:         tes[i].TimeInterval.End,
+						Billable:    &tes[i].Billable,
+						Description: tes[i].Description,
+						ProjectID:   tes[i].ProjectID,
+						TaskID:      taskID(tes[i]),
+						TagIDs:      tagIDs(tes[i]),
+					})
+					if err != nil {
+						failed++
+						fmt.Fprintf(stderr, "entry %d: %s\n", i+1, err)
+						continue
+					}
+
+					tes[i].ID = te.ID
+					created = append(created, tes[i])
+				}
+				tes = created
+			}
+
+			if err := util.PrintTimeEntries(
+				tes, cmd.OutOrStdout(), f.Config(), of); err != nil {
+				return err
+			}
+
+			if failed > 0 {
+				return errors.Errorf("%d of %d entries failed to be created",
+					failed, len(es))
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&file, "file", "",
+		"CSV file of a \"Detailed\" report exported by Toggl Track "+
+			"(use - to read from stdin)")
+	_ = cmd.MarkFlagFilename("file", "csv")
+	cmd.Flags().StringVar(&token, "token", "",
+		"API token of Toggl Track, to read the time entries from its API")
+	cmd.Flags().StringVar(&since, "since", "",
+		"import time entries started since this date (like: 2022-06-01), "+
+			"when using --token")
+	cmd.Flags().StringVar(&until, "until", "now",
+		"import time entries started until this date, when using --token")
+	cmd.Flags().BoolVar(&create, "create-missing", false,
+		"create the clients, projects, tasks and tags not found on Clockify")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false,
+		"only checks and prints the time entries, without creating them "+
+			"(or the missing resources)")
+
+	cmd.Flags().StringVarP(&of.Format, "format", "f", "",
+		"golang text/template format to be applied on each time entry")
+	cmd.Flags().BoolVarP(&of.JSON, "json", "j", false, "print as JSON")
+	cmd.Flags().BoolVarP(&of.Quiet, "quiet", "q", false, "print only ID")
+
+	return cmd
+}
+
+func readFile(stdin io.Reader, file string) ([]toggl.Entry, error) {
+	r := stdin
+	if file != "-" {
+		fh, err := os.Open(file)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to open the CSV file")
+		}
+		defer fh.Close()
+		r = fh
+	}
+
+	return toggl.ReadCSV(r, time.Local)
+}
+
+func parseRange(since, until string) (s, u time.Time, err error) {
+	if since == "" {
+		return s, u, cmdutil.FlagErrorWrap(errors.New(
+			"--since is required when using --token"))
+	}
+
+	if s, err = parseDate(since); err != nil {
+		return s, u, cmdutil.FlagErrorWrap(
+			errors.Wrap(err, "invalid --since"))
+	}
+
+	if u, err = parseDate(until); err != nil {
+		return s, u, cmdutil.FlagErrorWrap(
+			errors.Wrap(err, "invalid --until"))
+	}
+
+	return s, u, nil
+}
+
+// parseDate accepts dates (like 2022-06-01) besides the formats of
+// timehlp.ConvertToTime
+func parseDate(s string) (time.Time, error) {
+	if d, err := time.ParseInLocation(
+		"2006-01-02", s, time.Local); err == nil {
+		return d, nil
+	}
+
+	return timehlp.ConvertToTime(s)
+}
+
+func taskID(te dto.TimeEntry) string {
+	if te.Task == nil {
+		return ""
+	}
+
+	return te.Task.ID
+}
+
+func tagIDs(te dto.TimeEntry) []string {
+	ids := make([]string, len(te.Tags))
+	for i := range te.Tags {
+		ids[i] = te.Tags[i].ID
+	}
+
+	return ids
+}
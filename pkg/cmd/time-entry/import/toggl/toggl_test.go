@@ -0,0 +1,203 @@
+package toggl_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/import/toggl"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var export = heredoc.Doc(`
+	User,Email,Client,Project,Task,Description,Billable,Start date,Start time,End date,End time,Duration,Tags,Amount (USD)
+	John,john@example.com,Acme,Website,Design,Writing docs,Yes,2022-06-19,09:00:00,2022-06-19,10:30:00,01:30:00,"Docs, Meeting",
+	John,john@example.com,,,,Lunch,No,2022-06-19,12:00:00,2022-06-19,13:00:00,01:00:00,,
+`)
+
+func newClient(t *testing.T) *mocks.MockClient {
+	c := mocks.NewMockClient(t)
+	c.EXPECT().GetClients(mock.Anything, api.GetClientsParam{
+		Workspace:       "w",
+		PaginationParam: api.AllPages(),
+	}).
+		Return([]dto.Client{{ID: "c1", Name: "acme"}}, nil).
+		Once()
+	c.EXPECT().GetProjects(mock.Anything, api.GetProjectsParam{
+		Workspace:       "w",
+		PaginationParam: api.AllPages(),
+	}).
+		Return([]dto.Project{
+			{ID: "p0", Name: "Website", ClientID: "c0"},
+		}, nil).
+		Once()
+	c.EXPECT().GetTags(mock.Anything, api.GetTagsParam{
+		Workspace:       "w",
+		PaginationParam: api.AllPages(),
+	}).
+		Return([]dto.Tag{{ID: "t1", Name: "docs"}}, nil).
+		Maybe()
+
+	return c
+}
+
+func runCmd(t *testing.T, c api.Client, args ...string) (
+	string, string, error) {
+	f := mocks.NewMockFactory(t)
+	f.EXPECT().GetWorkspaceID().Return("w", nil)
+	f.EXPECT().Client().Return(c, nil)
+	f.EXPECT().Config().Return(&mocks.SimpleConfig{}).Maybe()
+
+	cmd := toggl.NewCmdToggl(f, nil)
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	out := bytes.NewBufferString("")
+	stderr := bytes.NewBufferString("")
+	cmd.SetOut(out)
+	cmd.SetErr(stderr)
+	cmd.SetIn(strings.NewReader(export))
+	cmd.SetArgs(append([]string{"--file=-"}, args...))
+
+	_, err := cmd.ExecuteC()
+	return out.String(), stderr.String(), err
+}
+
+func TestCmdTogglMissing(t *testing.T) {
+	_, stderr, err := runCmd(t, newClient(t))
+
+	assert.EqualError(t, err,
+		"1 of 2 entries can't be imported, no time entry was created")
+	assert.Equal(t, `entry 1 (started at 2022-06-19 09:00): `+
+		`project "Website" does not exist on Clockify `+
+		"(use --create-missing to create it)\n", stderr)
+}
+
+func TestCmdTogglDryRun(t *testing.T) {
+	out, stderr, err := runCmd(t, newClient(t),
+		"--create-missing", "--dry-run",
+		"--format={{.Description}};{{with .Project}}{{.Name}}{{end}};"+
+			"{{with .Task}}{{.Name}}{{end}};{{len .Tags}};{{.Billable}}")
+
+	assert.NoError(t, err)
+	assert.Equal(t, heredoc.Doc(`
+		project "Website" will be created
+		task "Website / Design" will be created
+		tag "Meeting" will be created
+	`), stderr)
+	assert.Equal(t, heredoc.Doc(`
+		Writing docs;Website;Design;2;true
+		Lunch;;;0;false
+	`), out)
+}
+
+func TestCmdTogglCreateMissing(t *testing.T) {
+	c := newClient(t)
+	c.EXPECT().AddProject(mock.Anything, api.AddProjectParam{
+		Workspace: "w",
+		Name:      "Website",
+		ClientId:  "c1",
+	}).
+		Return(dto.Project{ID: "p1", Name: "Website", ClientID: "c1"}, nil).
+		Once()
+	c.EXPECT().GetTasks(mock.Anything, api.GetTasksParam{
+		Workspace:       "w",
+		ProjectID:       "p1",
+		PaginationParam: api.AllPages(),
+	}).
+		Return([]dto.Task{}, nil).
+		Once()
+	c.EXPECT().AddTask(mock.Anything, api.AddTaskParam{
+		Workspace: "w",
+		ProjectID: "p1",
+		Name:      "Design",
+	}).
+		Return(dto.Task{ID: "k1", Name: "Design"}, nil).
+		Once()
+	c.EXPECT().AddTag(mock.Anything,
+		api.AddTagParam{Workspace: "w", Name: "Meeting"}).
+		Return(dto.Tag{ID: "t2", Name: "Meeting"}, nil).
+		Once()
+
+	start := time.Date(2022, 6, 19, 9, 0, 0, 0, time.Local)
+	end := start.Add(90 * time.Minute)
+	b := true
+	c.EXPECT().CreateTimeEntry(mock.Anything, api.CreateTimeEntryParam{
+		Workspace:   "w",
+		Start:       start,
+		End:         &end,
+		Billable:    &b,
+		Description: "Writing docs",
+		ProjectID:   "p1",
+		TaskID:      "k1",
+		TagIDs:      []string{"t1", "t2"},
+	}).
+		Return(dto.TimeEntryImpl{ID: "te1"}, nil).
+		Once()
+
+	start2 := time.Date(2022, 6, 19, 12, 0, 0, 0, time.Local)
+	end2 := start2.Add(time.Hour)
+	nb := false
+	c.EXPECT().CreateTimeEntry(mock.Anything, api.CreateTimeEntryParam{
+		Workspace:   "w",
+		Start:       start2,
+		End:         &end2,
+		Billable:    &nb,
+		Description: "Lunch",
+		TagIDs:      []string{},
+	}).
+		Return(dto.TimeEntryImpl{ID: "te2"}, nil).
+		Once()
+
+	out, stderr, err := runCmd(t, c, "--create-missing", "-q")
+
+	assert.NoError(t, err)
+	assert.Equal(t, heredoc.Doc(`
+		creating project "Website"
+		creating task "Website / Design"
+		creating tag "Meeting"
+	`), stderr)
+	assert.Equal(t, "te1\nte2\n", out)
+}
+
+func TestCmdTogglFlags(t *testing.T) {
+	t.Setenv("TOGGL_API_TOKEN", "")
+	f := mocks.NewMockFactory(t)
+
+	for _, tt := range []struct {
+		args []string
+		err  string
+	}{
+		{err: "one of --file or --token must be set"},
+		{
+			args: []string{"--file=a.csv", "--token=t"},
+			err: "the following flags can't be used together: " +
+				"`file` and `token`",
+		},
+		{
+			args: []string{"--token=t"},
+			err:  "--since is required when using --token",
+		},
+		{
+			args: []string{"--token=t", "--since=someday"},
+			err:  "invalid --since: supported formats are: .+",
+		},
+	} {
+		cmd := toggl.NewCmdToggl(f, nil)
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+		cmd.SetOut(bytes.NewBufferString(""))
+		cmd.SetArgs(tt.args)
+
+		_, err := cmd.ExecuteC()
+		if assert.Error(t, err) {
+			assert.Regexp(t, "^"+tt.err+"$", err.Error())
+		}
+	}
+}
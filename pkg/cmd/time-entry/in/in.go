@@ -1,7 +1,9 @@
 package in
 
 import (
+	"errors"
 	"io"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/lucassabreu/clockify-cli/api/dto"
@@ -9,6 +11,8 @@ import (
 	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/gitrepo"
+	"github.com/lucassabreu/clockify-cli/pkg/offline"
 	output "github.com/lucassabreu/clockify-cli/pkg/output/time-entry"
 	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
 
@@ -21,6 +25,9 @@ func NewCmdIn(
 	report func(dto.TimeEntryImpl, io.Writer, util.OutputFlags) error,
 ) *cobra.Command {
 	of := util.OutputFlags{TimeFormat: output.TimeFormatSimple}
+	pf := pomodoroFlags{}
+	var template, jiraKey, ghRef, glRef string
+	var isOffline, fromGit bool
 	cmd := &cobra.Command{
 		Use:   "in [<project-id>] [<description>]",
 		Short: "Create a new Clockify time entry ",
@@ -29,6 +36,7 @@ func NewCmdIn(
 
 			Running time entry will be stopped using the start time of this new entry.
 		`) + "\n" +
+			util.HelpOffline + "\n" +
 			util.HelpTimeEntryNowIfNotSet + "\n" +
 			util.HelpInteractiveByDefault + "\n" +
 			util.HelpTimeInputOnTimeEntry + "\n" +
@@ -73,6 +81,40 @@ func NewCmdIn(
 			$ %[1]s -i=0 -p 621948458cb9606d934ebb1c -s -10m --task "in command"
 			62ae29fdc22de9759e73d343
 
+			# start a timer for 4 pomodoros of 25 minutes, tracking the breaks of 5 minutes between them
+			$ %[1]s -i=0 -p "Clockify CLI" -d "Writing docs" --pomodoro 25m --cycles 4 --auto-stop -q
+			62ae29fdc22de9759e73d343
+			Pomodoro 1 of 4 is over, take a 5m0s break
+			62ae2a1bc22de9759e73d352
+			Break is over, starting pomodoro 2 of 4
+			62ae2a3fc22de9759e73d361
+
+			# start a timer with the project, task, tags and description of the template "standup"
+			$ %[1]s -i=0 --template standup -q
+			62ae29fdc22de9759e73d343
+
+			# start a timer for the ticket of the current git branch ("feature/CLI-42-docs")
+			$ cat .clockify.yaml
+			project: 621948458cb9606d934ebb1c
+			tags: [62ae28b72518aa18da2acb49]
+			ticket-pattern: "[A-Z]+-[0-9]+"
+			$ %[1]s -i=0 --from-git -q
+			62ae29fdc22de9759e73d344
+
+			# start a timer for the Jira issue PROJ-123, described by its summary
+			$ %[1]s -i=0 -p "Clockify CLI" --jira PROJ-123 -q
+			62ae29fdc22de9759e73d345
+
+			# start a timer for a GitHub issue, and for the pull request of the current branch
+			$ %[1]s -i=0 -p "Clockify CLI" --gh lucassabreu/clockify-cli#42 -q
+			62ae29fdc22de9759e73d346
+			$ %[1]s -i=0 -p "Clockify CLI" --gh pr -q
+			62ae29fdc22de9759e73d347
+
+			# start a timer for a GitLab merge request
+			$ %[1]s -i=0 -p "Clockify CLI" --gl acme/cli!42 -q
+			62ae29fdc22de9759e73d348
+
 			# start a timer interactively
 			$ %[1]s -i
 			? Choose your project: 621948458cb9606d934ebb1c - Clockify Cli      | Client: Myself (6202634a28782767054eec26)
@@ -90,10 +132,26 @@ func NewCmdIn(
 			+--------------------------+----------+----------+---------+--------------+----------------------+-----------------------------------------+
 		`, "clockify-cli in"),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
 			if err := of.Check(); err != nil {
 				return err
 			}
 
+			if err := pf.check(cmd.Flags().Changed); err != nil {
+				return err
+			}
+
+			if isOffline && pf.Work != 0 {
+				return cmdutil.FlagErrorWrap(errors.New(
+					"`pomodoro` can't be used with `offline`"))
+			}
+
+			if isOffline && jiraKey != "" {
+				return cmdutil.FlagErrorWrap(errors.New(
+					"`jira` can't be used with `offline`"))
+			}
+
 			var err error
 			tei := util.TimeEntryDTO{
 				Start: timehlp.Now(),
@@ -120,34 +178,99 @@ func NewCmdIn(
 				tei.Description = args[1]
 			}
 
-			dc := util.NewDescriptionCompleter(f)
+			dc := util.NewDescriptionCompleter(ctx, f)
+
+			// kept to start the next pomodoros the same way
+			var input util.TimeEntryDTO
 
 			if tei, err = util.Do(
 				tei,
+				util.FillTimeEntryWithTemplate(f.Config(), template),
+				util.FillTimeEntryWithGit(f.Config(), fromGit),
 				util.FillTimeEntryWithFlags(cmd.Flags()),
-				util.ValidateClosingTimeEntry(f),
-				util.GetAllowNameForIDsFn(f.Config(), c),
-				util.GetPropsInteractiveFn(dc, f),
-				util.GetDatesInteractiveFn(f),
-				util.GetValidateTimeEntryFn(f),
-				util.OutInProgressFn(c),
-				util.CreateTimeEntryFn(c),
+				util.FillTimeEntryWithGitHub(f.Config(), ghRef),
+				util.FillTimeEntryWithGitLab(f.Config(), glRef),
 			); err != nil {
 				return err
 			}
 
-			if report != nil {
-				return report(
-					util.TimeEntryDTOToImpl(tei), cmd.OutOrStdout(), of)
+			if isOffline {
+				return util.QueueOffline(
+					offline.KindIn, tei, true, nil, cmd.ErrOrStderr())
+			}
+
+			queued := tei
+			if tei, err = util.Do(
+				tei,
+				util.ValidateClosingTimeEntry(ctx, f),
+				util.GetAllowNameForIDsFn(ctx, f.Config(), c),
+				util.FillTimeEntryWithJira(ctx, f.Config(), c,
+					jiraKey),
+				util.LookupCustomFieldsFn(ctx, c),
+				util.GetPropsInteractiveFn(ctx, dc, f),
+				util.GetDatesInteractiveFn(f),
+				util.GetValidateTimeEntryFn(ctx, f),
+				util.OutInProgressFn(ctx, c),
+				func(tei util.TimeEntryDTO) (util.TimeEntryDTO, error) {
+					input = tei
+					return tei, nil
+				},
+				util.CreateTimeEntryFn(ctx, c),
+			); err != nil {
+				return util.QueueOffline(
+					offline.KindIn, queued, false, err, cmd.ErrOrStderr())
+			}
+
+			print := func(tei util.TimeEntryDTO) error {
+				if report != nil {
+					return report(util.TimeEntryDTOToImpl(tei),
+						cmd.OutOrStdout(), of)
+				}
+
+				return util.PrintTimeEntryImpl(ctx,
+					util.TimeEntryDTOToImpl(tei),
+					f, cmd.OutOrStdout(), of)
+			}
+
+			if err := print(tei); err != nil || pf.Work == 0 {
+				return err
 			}
 
-			return util.PrintTimeEntryImpl(
-				util.TimeEntryDTOToImpl(tei), f, cmd.OutOrStdout(), of)
+			return runPomodoro(ctx, c, input, pf, print,
+				cmd.ErrOrStderr())
 		},
 	}
 
 	util.AddTimeEntryFlags(cmd, f, &of)
 	util.AddTimeEntryDateFlags(cmd)
+	util.AddOfflineFlag(cmd, &isOffline)
+	util.AddJiraFlag(cmd, &jiraKey)
+	util.AddGitHubFlag(cmd, &ghRef)
+	util.AddGitLabFlag(cmd, &glRef)
+
+	cmd.Flags().BoolVar(&fromGit, "from-git", false,
+		"uses the ticket on the current git branch (or its name) as "+
+			"description, and the values set on the "+gitrepo.ConfigFile+
+			" of the repository")
+
+	cmd.Flags().StringVar(&template, "template", "",
+		"uses the values of a time entry template, "+
+			"see \"clockify-cli template\"")
+	_ = cmdcompl.AddSuggestionsToFlag(cmd, "template",
+		util.NewTemplateAutoComplete(f))
+
+	cmd.Flags().DurationVar(&pf.Work, "pomodoro", 0,
+		"keeps running until the time entry reaches this duration, then "+
+			"notifies it (like: 25m)")
+	cmd.Flags().DurationVar(&pf.Break, "break", 5*time.Minute,
+		"how long the break after each pomodoro is")
+	cmd.Flags().IntVar(&pf.Cycles, "cycles", 1,
+		"how many pomodoros should be done, with breaks between them")
+	cmd.Flags().BoolVar(&pf.AutoStop, "auto-stop", false,
+		"stops the time entry when the pomodoro ends, tracks the break and "+
+			"starts a new time entry after it")
+	cmd.Flags().StringVar(&pf.BreakDescription, "break-description",
+		"Break", "description of the time entries of the breaks")
 
 	return cmd
 }
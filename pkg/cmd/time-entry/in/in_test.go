@@ -2,7 +2,10 @@ package in_test
 
 import (
 	"bytes"
+	"errors"
 	"io"
+	"net"
+	"net/url"
 	"testing"
 	"time"
 
@@ -12,8 +15,10 @@ import (
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/in"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/offline"
 	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 var w = dto.Workspace{ID: "w"}
@@ -111,19 +116,20 @@ func TestNewCmdIn_ShouldNotSetBillable_WhenNotAsked(t *testing.T) {
 			c := mocks.NewMockClient(t)
 			f.EXPECT().Client().Return(c, nil)
 
-			c.EXPECT().GetTimeEntryInProgress(api.GetTimeEntryInProgressParam{
-				Workspace: w.ID,
-				UserID:    "u",
-			}).
+			c.EXPECT().GetTimeEntryInProgress(mock.Anything,
+				api.GetTimeEntryInProgressParam{
+					Workspace: w.ID,
+					UserID:    "u",
+				}).
 				Return(nil, nil)
 
-			c.EXPECT().Out(api.OutParam{
+			c.EXPECT().Out(mock.Anything, api.OutParam{
 				Workspace: w.ID,
 				UserID:    "u",
 				End:       tt.param.Start,
 			}).Return(api.ErrorNotFound)
 
-			c.EXPECT().CreateTimeEntry(tt.param).
+			c.EXPECT().CreateTimeEntry(mock.Anything, tt.param).
 				Return(dto.TimeEntryImpl{ID: "te"}, nil)
 
 			called := false
@@ -155,3 +161,215 @@ func TestNewCmdIn_ShouldNotSetBillable_WhenNotAsked(t *testing.T) {
 	}
 
 }
+
+func TestNewCmdIn_Pomodoro(t *testing.T) {
+	// started in the past, so there is no need to wait
+	start := time.Date(2020, 1, 1, 8, 0, 0, 0, time.Local)
+	ms := time.Millisecond
+
+	f := mocks.NewMockFactory(t)
+
+	f.EXPECT().GetUserID().Return("u", nil)
+	f.EXPECT().GetWorkspace().Return(w, nil)
+	f.EXPECT().GetWorkspaceID().Return(w.ID, nil)
+
+	f.EXPECT().Config().Return(&mocks.SimpleConfig{})
+
+	c := mocks.NewMockClient(t)
+	f.EXPECT().Client().Return(c, nil)
+
+	c.EXPECT().GetTimeEntryInProgress(mock.Anything,
+		api.GetTimeEntryInProgressParam{
+			Workspace: w.ID,
+			UserID:    "u",
+		}).
+		Return(nil, nil)
+
+	c.EXPECT().Out(mock.Anything, api.OutParam{
+		Workspace: w.ID,
+		UserID:    "u",
+		End:       start,
+	}).Return(api.ErrorNotFound).Once()
+
+	c.EXPECT().CreateTimeEntry(mock.Anything, api.CreateTimeEntryParam{
+		Workspace:   w.ID,
+		Start:       start,
+		Description: "focus",
+	}).
+		Return(dto.TimeEntryImpl{ID: "te1"}, nil).Once()
+
+	c.EXPECT().Out(mock.Anything, api.OutParam{
+		Workspace: w.ID,
+		UserID:    "u",
+		End:       start.Add(10 * ms),
+	}).Return(nil).Once()
+
+	c.EXPECT().CreateTimeEntry(mock.Anything, api.CreateTimeEntryParam{
+		Workspace:   w.ID,
+		Start:       start.Add(10 * ms),
+		Description: "Break",
+	}).
+		Return(dto.TimeEntryImpl{ID: "break1"}, nil).Once()
+
+	c.EXPECT().CreateTimeEntry(mock.Anything, api.CreateTimeEntryParam{
+		Workspace:   w.ID,
+		Start:       start.Add(15 * ms),
+		Description: "focus",
+	}).
+		Return(dto.TimeEntryImpl{ID: "te2"}, nil).Once()
+
+	c.EXPECT().Out(mock.Anything, api.OutParam{
+		Workspace: w.ID,
+		UserID:    "u",
+		End:       start.Add(25 * ms),
+	}).Return(nil).Once()
+
+	printed := []string{}
+	cmd := in.NewCmdIn(f, func(
+		te dto.TimeEntryImpl, _ io.Writer, _ util.OutputFlags) error {
+		printed = append(printed, te.ID)
+		return nil
+	})
+
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	out := bytes.NewBufferString("")
+	stderr := bytes.NewBufferString("")
+	cmd.SetOut(out)
+	cmd.SetErr(stderr)
+
+	cmd.SetArgs([]string{"-s=2020-01-01 08:00", "-d=focus", "-q",
+		"--pomodoro=10ms", "--break=5ms", "--cycles=2", "--auto-stop"})
+	_, err := cmd.ExecuteC()
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"te1", "break1", "te2"}, printed)
+	assert.Equal(t, "\aPomodoro 1 of 2 is over, take a 5ms break\n"+
+		"\aBreak is over, starting pomodoro 2 of 2\n"+
+		"\aPomodoro 2 of 2 is over\n", stderr.String())
+}
+
+func TestNewCmdIn_PomodoroFlags(t *testing.T) {
+	tts := []struct {
+		args []string
+		err  string
+	}{
+		{
+			args: []string{"--cycles=2"},
+			err:  "`cycles` can only be used with `pomodoro`",
+		},
+		{
+			args: []string{"--pomodoro=25m", "--cycles=0"},
+			err:  "`cycles` must be at least 1",
+		},
+		{
+			args: []string{"--pomodoro=25m", "-e=10:00"},
+			err:  "`pomodoro` can't be used with `when-to-close`",
+		},
+		{
+			args: []string{"--pomodoro=25m", "--offline"},
+			err:  "`pomodoro` can't be used with `offline`",
+		},
+		{
+			args: []string{"--jira=PROJ-1", "--offline"},
+			err:  "`jira` can't be used with `offline`",
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.err, func(t *testing.T) {
+			cmd := in.NewCmdIn(mocks.NewMockFactory(t), nil)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+
+			cmd.SetArgs(tt.args)
+			_, err := cmd.ExecuteC()
+
+			var flagErr *cmdutil.FlagError
+			assert.ErrorAs(t, err, &flagErr)
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestNewCmdIn_Offline(t *testing.T) {
+	unreachable := &url.Error{
+		Op:  "Get",
+		URL: "https://api.clockify.me/api/v1",
+		Err: &net.OpError{Op: "dial", Err: errors.New("no route to host")},
+	}
+
+	tts := []struct {
+		name  string
+		args  []string
+		calls func(*mocks.MockClient)
+	}{
+		{
+			name: "forced",
+			args: []string{"--offline"},
+		},
+		{
+			name: "api unreachable",
+			calls: func(c *mocks.MockClient) {
+				c.EXPECT().GetTimeEntryInProgress(mock.Anything,
+					api.GetTimeEntryInProgressParam{
+						Workspace: w.ID,
+						UserID:    "u",
+					}).
+					Return(nil, unreachable)
+			},
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			t.Setenv("XDG_CACHE_HOME", dir)
+			t.Setenv("HOME", dir)
+
+			f := mocks.NewMockFactory(t)
+			f.EXPECT().GetUserID().Return("u", nil)
+			f.EXPECT().GetWorkspaceID().Return(w.ID, nil)
+			f.EXPECT().Config().Return(&mocks.SimpleConfig{})
+
+			c := mocks.NewMockClient(t)
+			f.EXPECT().Client().Return(c, nil)
+			if tt.calls != nil {
+				tt.calls(c)
+			}
+
+			cmd := in.NewCmdIn(f, func(
+				dto.TimeEntryImpl, io.Writer, util.OutputFlags) error {
+				t.Fatal("should not print a time entry")
+				return nil
+			})
+
+			stderr := bytes.NewBufferString("")
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(stderr)
+			cmd.SetArgs(append(tt.args,
+				"-p", "Clockify CLI", "-d", "docs", "-s", "08:00"))
+
+			_, err := cmd.ExecuteC()
+			assert.NoError(t, err)
+			assert.Contains(t, stderr.String(), "clockify-cli sync push")
+
+			q, err := cmdutil.OfflineQueue()
+			assert.NoError(t, err)
+			ops, err := q.Operations()
+			assert.NoError(t, err)
+			if assert.Len(t, ops, 1) {
+				assert.Equal(t, offline.KindIn, ops[0].Kind)
+				assert.Equal(t, "Clockify CLI", ops[0].ProjectID)
+				assert.Equal(t, "docs", ops[0].Description)
+				assert.Equal(t,
+					timehlp.Today().Add(8*time.Hour), ops[0].Start.Local())
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package in
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/notify"
+	"golang.org/x/term"
+)
+
+// pomodoroFlags configures the cycles of work and breaks started by the in
+// command
+type pomodoroFlags struct {
+	Work             time.Duration
+	Break            time.Duration
+	Cycles           int
+	AutoStop         bool
+	BreakDescription string
+}
+
+func (p pomodoroFlags) check(changed func(string) bool) error {
+	if p.Work < 0 {
+		return cmdutil.FlagErrorWrap(
+			errors.New("`pomodoro` must be a positive duration"))
+	}
+
+	if p.Work == 0 {
+		for _, n := range []string{
+			"break", "cycles", "auto-stop", "break-description"} {
+			if changed(n) {
+				return cmdutil.FlagErrorWrap(errors.New(
+					"`" + n + "` can only be used with `pomodoro`"))
+			}
+		}
+
+		return nil
+	}
+
+	if p.Break < 0 {
+		return cmdutil.FlagErrorWrap(
+			errors.New("`break` must be a positive duration"))
+	}
+
+	if p.Cycles < 1 {
+		return cmdutil.FlagErrorWrap(
+			errors.New("`cycles` must be at least 1"))
+	}
+
+	if changed("when-to-close") {
+		return cmdutil.FlagErrorWrap(
+			errors.New("`pomodoro` can't be used with `when-to-close`"))
+	}
+
+	return nil
+}
+
+// runPomodoro waits each cycle of the time entry to end, notifying the user
+// about it and the breaks between them. With AutoStop the time entry is
+// stopped at the end of the cycle, a time entry is started for the break
+// and a time entry with the same input is started after it
+func runPomodoro(
+	ctx context.Context, c api.Client, tei util.TimeEntryDTO,
+	p pomodoroFlags, print func(util.TimeEntryDTO) error, stderr io.Writer,
+) error {
+	start := tei.Start
+	for i := 1; i <= p.Cycles; i++ {
+		end := start.Add(p.Work)
+		if err := waitUntil(ctx, end); err != nil {
+			return err
+		}
+
+		last := i == p.Cycles
+		msg := fmt.Sprintf("Pomodoro %d of %d is over", i, p.Cycles)
+		if !last && p.Break > 0 {
+			msg += ", take a " + p.Break.String() + " break"
+		}
+		notifyUser(stderr, msg)
+
+		if p.AutoStop {
+			// it may have been stopped by the user already
+			if err := c.Out(ctx, api.OutParam{
+				Workspace: tei.Workspace,
+				UserID:    tei.UserID,
+				End:       end,
+			}); api.ErrorKindOf(err) != api.ErrorKindNotFound && err != nil {
+				return err
+			}
+		}
+
+		if last {
+			return nil
+		}
+
+		start = end.Add(p.Break)
+		if p.Break > 0 {
+			if p.AutoStop {
+				b, err := util.CreateTimeEntryFn(ctx, c)(util.TimeEntryDTO{
+					Workspace:   tei.Workspace,
+					UserID:      tei.UserID,
+					Start:       end,
+					Description: p.BreakDescription,
+				})
+				if err != nil {
+					return err
+				}
+
+				if err := print(b); err != nil {
+					return err
+				}
+			}
+
+			if err := waitUntil(ctx, start); err != nil {
+				return err
+			}
+
+			notifyUser(stderr, fmt.Sprintf(
+				"Break is over, starting pomodoro %d of %d", i+1, p.Cycles))
+		}
+
+		if !p.AutoStop {
+			continue
+		}
+
+		next := tei
+		next.Start = start
+		next, err := util.CreateTimeEntryFn(ctx, c)(next)
+		if err != nil {
+			return err
+		}
+
+		if err := print(next); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// waitUntil blocks until the time is reached or the context is done
+func waitUntil(ctx context.Context, t time.Time) error {
+	d := time.Until(t)
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// notifyUser rings the terminal bell with the message, and shows it on the
+// desktop when running on a terminal
+func notifyUser(stderr io.Writer, msg string) {
+	fmt.Fprintln(stderr, "\a"+msg)
+
+	if f, ok := stderr.(*os.File); ok && term.IsTerminal(int(f.Fd())) {
+		_ = notify.Desktop("Clockify CLI", msg)
+	}
+}
@@ -82,6 +82,8 @@ func changeInvoiced(
 	f cmdutil.Factory, of *util.OutputFlags, invoiced bool,
 ) func(cmd *cobra.Command, args []string) error {
 	return func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
 		if err := of.Check(); err != nil {
 			return err
 		}
@@ -107,7 +109,8 @@ func changeInvoiced(
 		for i, id := range args {
 			if id == timeentryhlp.AliasCurrent ||
 				id == timeentryhlp.AliasLast {
-				tei, err := timeentryhlp.GetTimeEntry(c, w, u, id)
+				tei, err := timeentryhlp.GetTimeEntry(ctx, c, w, u,
+					id)
 				if err != nil {
 					return err
 				}
@@ -115,7 +118,7 @@ func changeInvoiced(
 				args[i] = id
 			}
 
-			te, err := c.GetHydratedTimeEntry(api.GetTimeEntryParam{
+			te, err := c.GetHydratedTimeEntry(ctx, api.GetTimeEntryParam{
 				Workspace:   w,
 				TimeEntryID: id,
 			})
@@ -126,7 +129,7 @@ func changeInvoiced(
 			tes[i] = *te
 		}
 
-		if err := c.ChangeInvoiced(api.ChangeInvoicedParam{
+		if err := c.ChangeInvoiced(ctx, api.ChangeInvoicedParam{
 			Workspace:    w,
 			TimeEntryIDs: args,
 			Invoiced:     invoiced,
@@ -9,14 +9,18 @@ import (
 	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/offline"
 	output "github.com/lucassabreu/clockify-cli/pkg/output/time-entry"
 	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
 
 // NewCmdManual represents the manual command
 func NewCmdManual(f cmdutil.Factory) *cobra.Command {
 	of := util.OutputFlags{TimeFormat: output.TimeFormatSimple}
+	var splitMidnight, isOffline bool
+	var jiraKey string
 	cmd := &cobra.Command{
 		Use:   "manual [<project-id>] [<start>] [<end>] [<description>]",
 		Short: "Create a new complete time entry",
@@ -32,12 +36,20 @@ func NewCmdManual(f cmdutil.Factory) *cobra.Command {
 			util.HelpInteractiveByDefault + "\n" +
 			util.HelpTimeInputOnTimeEntry + "\n" +
 			util.HelpNamesForIds + "\n" +
+			util.HelpOffline + "\n" +
 			util.HelpMoreInfoAboutStarting + "\n" +
 			util.HelpMoreInfoAboutPrinting,
 		Args: cobra.MaximumNArgs(4),
 		ValidArgsFunction: cmdcompl.CombineSuggestionsToArgs(
 			cmdcomplutil.NewProjectAutoComplete(f)),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			if isOffline && jiraKey != "" {
+				return cmdutil.FlagErrorWrap(errors.New(
+					"`jira` can't be used with `offline`"))
+			}
+
 			var whenToCloseDate time.Time
 			var err error
 			tei := util.TimeEntryDTO{
@@ -82,7 +94,7 @@ func NewCmdManual(f cmdutil.Factory) *cobra.Command {
 				tei.Description = args[3]
 			}
 
-			dc := util.NewDescriptionCompleter(f)
+			dc := util.NewDescriptionCompleter(ctx, f)
 
 			if tei, err = util.Do(
 				tei,
@@ -96,22 +108,55 @@ func NewCmdManual(f cmdutil.Factory) *cobra.Command {
 					tei.End = &now
 					return tei, nil
 				},
-				util.GetAllowNameForIDsFn(f.Config(), c),
-				util.GetPropsInteractiveFn(dc, f),
-				util.GetDatesInteractiveFn(f),
-				util.ValidateClosingTimeEntry(f),
-				util.CreateTimeEntryFn(c),
 			); err != nil {
 				return err
 			}
 
+			if isOffline {
+				return util.QueueOffline(
+					offline.KindManual, tei, true, nil, cmd.ErrOrStderr())
+			}
+
+			queued := tei
+			if tei, err = util.Do(
+				tei,
+				util.GetAllowNameForIDsFn(ctx, f.Config(), c),
+				util.FillTimeEntryWithJira(ctx, f.Config(), c,
+					jiraKey),
+				util.LookupCustomFieldsFn(ctx, c),
+				util.GetPropsInteractiveFn(ctx, dc, f),
+				util.GetDatesInteractiveFn(f),
+				util.ValidateClosingTimeEntry(ctx, f),
+				util.CreateTimeEntryFn(ctx, c),
+			); err != nil {
+				return util.QueueOffline(offline.KindManual, queued, false,
+					err, cmd.ErrOrStderr())
+			}
+
+			if splitMidnight {
+				tes, err := util.SplitAtMidnight(ctx, c,
+					tei.Workspace, tei.ID)
+				if err != nil {
+					return err
+				}
+
+				if len(tes) > 1 {
+					return util.PrintTimeEntries(
+						tes, cmd.OutOrStdout(), f.Config(), of)
+				}
+			}
+
 			return util.PrintTimeEntryImpl(
+				ctx,
 				util.TimeEntryDTOToImpl(tei), f, cmd.OutOrStdout(), of)
 		},
 	}
 
 	util.AddTimeEntryFlags(cmd, f, &of)
 	util.AddTimeEntryDateFlags(cmd)
+	util.AddSplitMidnightFlag(cmd, &splitMidnight)
+	util.AddOfflineFlag(cmd, &isOffline)
+	util.AddJiraFlag(cmd, &jiraKey)
 
 	return cmd
 }
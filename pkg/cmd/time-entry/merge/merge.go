@@ -0,0 +1,369 @@
+package merge
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	reportutil "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/timeentryhlp"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdMerge represents the merge command
+func NewCmdMerge(f cmdutil.Factory) *cobra.Command {
+	of := util.OutputFlags{TimeFormat: timehlp.FullTimeFormat}
+	va := cmdcompl.ValidArgsSlide{
+		timeentryhlp.AliasCurrent, timeentryhlp.AliasLast}
+	var auto, dryRun bool
+	var gap int
+	var since, until string
+
+	cmd := &cobra.Command{
+		Use: "merge { <time-entry-id> | " + va.IntoUseOptions() +
+			" | ^<n> }... | --auto",
+		Short: "Merges time entries into a single one",
+		Long: heredoc.Docf(`
+			Merges time entries into a single one, starting when the first
+			starts and ending when the last ends, the other time entries are
+			deleted.
+
+			The merged time entry keeps the project, task, description,
+			billable and custom fields of the first time entry, and the tags
+			of all of them.
+
+			With --auto, the time entries between --since and --until with the
+			same project, task and description, which overlap or are
+			separated by up to --gap minutes, are merged.
+
+			If you want to merge the current (running) time entry you can use
+			"%s" instead of its ID, for the last ended time entry use "%s".
+		`, timeentryhlp.AliasCurrent, timeentryhlp.AliasLast) + "\n" +
+			util.HelpMoreInfoAboutPrinting,
+		Example: heredoc.Docf(`
+			# merge the running time entry with the last one
+			$ %[1]s current last
+
+			# merge two time entries
+			$ %[1]s 62ae4b304ebb4f143c931d50 62ae4f48bb1a5c4f1c1c3fbb
+
+			# check which time entries of today would be merged
+			$ %[1]s --auto --dry-run
+
+			# merge the time entries of the week separated by up to 15 minutes
+			$ %[1]s --auto --gap 15 --since 2022-06-06 --until 2022-06-10
+		`, "clockify-cli merge"),
+		ValidArgs: va.IntoValidArgs(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			if auto && len(args) > 0 {
+				return cmdutil.FlagErrorWrap(errors.New(
+					"time entry ids can't be set with --auto"))
+			}
+
+			if !auto && len(args) < 2 {
+				return cmdutil.FlagErrorWrap(errors.New(
+					"at least two time entries must be set, or use --auto"))
+			}
+
+			if gap < 0 {
+				return cmdutil.FlagErrorWrap(errors.New(
+					"--gap can't be negative"))
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			u, err := f.GetUserID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			var groups [][]dto.TimeEntry
+			if auto {
+				if groups, err = autoGroups(ctx, c, w, u, since,
+					until,
+					time.Duration(gap)*time.Minute); err != nil {
+					return err
+				}
+			} else {
+				tes, err := getTimeEntries(ctx, c, w, u, args)
+				if err != nil {
+					return err
+				}
+
+				groups = [][]dto.TimeEntry{tes}
+			}
+
+			tes := make([]dto.TimeEntry, 0, len(groups))
+			for _, g := range groups {
+				te := combine(g)
+				if !dryRun {
+					if err := apply(ctx, c, w, u, te, g); err != nil {
+						return err
+					}
+				}
+
+				tes = append(tes, te)
+			}
+
+			return util.PrintTimeEntries(
+				tes, cmd.OutOrStdout(), f.Config(), of)
+		},
+	}
+
+	cmd.Flags().BoolVar(&auto, "auto", false,
+		"merges time entries with the same project, task and description "+
+			"close to each other")
+	cmd.Flags().IntVar(&gap, "gap", 5,
+		"max minutes between time entries to be merged by --auto")
+	cmd.Flags().StringVar(&since, "since", "",
+		"first day of the time entries merged by --auto (default today)")
+	cmd.Flags().StringVar(&until, "until", "today",
+		"last day of the time entries merged by --auto")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false,
+		"only prints the merged time entries, without changing them")
+
+	util.AddPrintTimeEntriesFlags(cmd, &of)
+	util.AddPrintMultipleTimeEntriesFlags(cmd)
+
+	return cmd
+}
+
+// getTimeEntries looks up the time entries, sorted by when they started
+func getTimeEntries(
+	ctx context.Context,
+	c api.Client, w, u string, ids []string) ([]dto.TimeEntry, error) {
+	tes := make([]dto.TimeEntry, 0, len(ids))
+	found := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		tei, err := timeentryhlp.GetTimeEntry(ctx, c, w, u, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if found[tei.ID] {
+			return nil, errors.Errorf(
+				"time entry %s is set more than once", tei.ID)
+		}
+		found[tei.ID] = true
+
+		te, err := c.GetHydratedTimeEntry(ctx, api.GetTimeEntryParam{
+			Workspace:   w,
+			TimeEntryID: tei.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		tes = append(tes, *te)
+	}
+
+	sortByStart(tes)
+	return tes, nil
+}
+
+// autoGroups finds the time entries of the range which should be merged
+// together
+func autoGroups(
+	ctx context.Context,
+	c api.Client, w, u, since, until string, gap time.Duration,
+) ([][]dto.TimeEntry, error) {
+	args := []string{timehlp.Today().Format("2006-01-02"), until}
+	if since != "" {
+		args[0] = since
+	}
+
+	start, end, err := reportutil.ParseRangeArgs(args)
+	if err != nil {
+		return nil, cmdutil.FlagErrorWrap(errors.Wrap(err,
+			"--since and --until must be dates like 2022-06-01"))
+	}
+
+	log, err := c.LogRange(ctx, api.LogRangeParam{
+		Workspace:       w,
+		UserID:          u,
+		FirstDate:       timehlp.TruncateDate(start),
+		LastDate:        timehlp.TruncateDate(end).Add(time.Hour * 24),
+		PaginationParam: api.AllPages(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return group(log, gap), nil
+}
+
+// group splits the time entries into groups of consecutive ones with the
+// same project, task and description separated by up to gap; groups with
+// only one time entry are dropped
+func group(tes []dto.TimeEntry, gap time.Duration) [][]dto.TimeEntry {
+	sortByStart(tes)
+
+	groups := make([][]dto.TimeEntry, 0)
+	var end *time.Time
+	for i, te := range tes {
+		last := len(groups) - 1
+		if i > 0 && end != nil && key(te) == key(tes[i-1]) &&
+			!te.TimeInterval.Start.After(end.Add(gap)) {
+			groups[last] = append(groups[last], te)
+			if te.TimeInterval.End == nil || te.TimeInterval.End.After(*end) {
+				end = te.TimeInterval.End
+			}
+			continue
+		}
+
+		end = te.TimeInterval.End
+		groups = append(groups, []dto.TimeEntry{te})
+	}
+
+	r := make([][]dto.TimeEntry, 0, len(groups))
+	for _, g := range groups {
+		if len(g) > 1 {
+			r = append(r, g)
+		}
+	}
+
+	return r
+}
+
+func key(te dto.TimeEntry) string {
+	return projectID(te) + "|" + taskID(te) + "|" +
+		strings.TrimSpace(te.Description)
+}
+
+func sortByStart(tes []dto.TimeEntry) {
+	sort.SliceStable(tes, func(i, j int) bool {
+		return tes[i].TimeInterval.Start.Before(tes[j].TimeInterval.Start)
+	})
+}
+
+// combine returns the first time entry changed to cover all of them
+func combine(tes []dto.TimeEntry) dto.TimeEntry {
+	te := tes[0]
+	te.ProjectID = projectID(te)
+
+	tags := make([]dto.Tag, 0, len(te.Tags))
+	hasTag := map[string]bool{}
+	for _, o := range tes {
+		for _, t := range o.Tags {
+			if !hasTag[t.ID] {
+				hasTag[t.ID] = true
+				tags = append(tags, t)
+			}
+		}
+
+		if te.TimeInterval.End == nil {
+			continue
+		}
+
+		if o.TimeInterval.End == nil ||
+			o.TimeInterval.End.After(*te.TimeInterval.End) {
+			te.TimeInterval.End = o.TimeInterval.End
+		}
+	}
+
+	te.Tags = tags
+	return te
+}
+
+// apply updates the first time entry with the merged values and deletes the
+// others
+func apply(
+	ctx context.Context,
+	c api.Client, w, u string, te dto.TimeEntry, tes []dto.TimeEntry,
+) error {
+	p := api.UpdateTimeEntryParam{
+		Workspace:   w,
+		TimeEntryID: te.ID,
+		Start:       te.TimeInterval.Start,
+		End:         te.TimeInterval.End,
+		Billable:    te.Billable,
+		Description: te.Description,
+		ProjectID:   te.ProjectID,
+		TaskID:      taskID(te),
+		TagIDs:      make([]string, len(te.Tags)),
+	}
+
+	for i := range te.Tags {
+		p.TagIDs[i] = te.Tags[i].ID
+	}
+
+	for _, cf := range te.CustomFields {
+		if cf.Value != nil {
+			p.CustomFields = append(p.CustomFields, dto.CustomFieldValue{
+				CustomFieldID: cf.CustomFieldID,
+				Value:         cf.Value,
+			})
+		}
+	}
+
+	if _, err := c.UpdateTimeEntry(ctx, p); err != nil {
+		return errors.Wrapf(err, "failed to update time entry %s", te.ID)
+	}
+
+	ids := make([]string, 0, len(tes)-1)
+	for i := range tes {
+		if tes[i].ID != te.ID {
+			ids = append(ids, tes[i].ID)
+		}
+	}
+
+	var err error
+	if len(ids) == 1 {
+		err = c.DeleteTimeEntry(ctx, api.DeleteTimeEntryParam{
+			Workspace:   w,
+			TimeEntryID: ids[0],
+		})
+	} else {
+		_, err = c.DeleteTimeEntries(ctx, api.DeleteTimeEntriesParam{
+			Workspace:    w,
+			UserID:       u,
+			TimeEntryIDs: ids,
+		})
+	}
+
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete time entries %s",
+			strings.Join(ids, ", "))
+	}
+
+	return nil
+}
+
+func projectID(te dto.TimeEntry) string {
+	if te.ProjectID == "" && te.Project != nil {
+		return te.Project.ID
+	}
+
+	return te.ProjectID
+}
+
+func taskID(te dto.TimeEntry) string {
+	if te.Task == nil {
+		return ""
+	}
+
+	return te.Task.ID
+}
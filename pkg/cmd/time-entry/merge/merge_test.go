@@ -0,0 +1,268 @@
+package merge_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/merge"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func entry(
+	id, desc, project string, start time.Time, d time.Duration,
+	tags ...string,
+) dto.TimeEntry {
+	end := start.Add(d)
+	te := dto.TimeEntry{
+		ID:           id,
+		Description:  desc,
+		ProjectID:    project,
+		TimeInterval: dto.TimeInterval{Start: start, End: &end},
+	}
+
+	for _, t := range tags {
+		te.Tags = append(te.Tags, dto.Tag{ID: t, Name: t})
+	}
+
+	return te
+}
+
+func TestCmdMerge(t *testing.T) {
+	start := time.Date(2022, 6, 1, 9, 0, 0, 0, time.UTC)
+	tes := []dto.TimeEntry{
+		entry("te2", "other", "p2", start.Add(2*time.Hour), time.Hour,
+			"tg2", "tg1"),
+		entry("te1", "docs", "p1", start, time.Hour, "tg1"),
+		entry("te3", "more", "", start.Add(4*time.Hour), time.Hour),
+	}
+	tes[1].CustomFields = []dto.TimeEntryCustomField{
+		{CustomFieldID: "cf1", Value: "CLI-1"},
+	}
+
+	f := mocks.NewMockFactory(t)
+	f.EXPECT().GetWorkspaceID().Return("w", nil)
+	f.EXPECT().GetUserID().Return("u", nil)
+	f.EXPECT().Config().Return(&mocks.SimpleConfig{})
+
+	c := mocks.NewMockClient(t)
+	f.EXPECT().Client().Return(c, nil)
+
+	for i := range tes {
+		te := tes[i]
+		p := api.GetTimeEntryParam{Workspace: "w", TimeEntryID: te.ID}
+		c.EXPECT().GetTimeEntry(mock.Anything, p).
+			Return(&dto.TimeEntryImpl{ID: te.ID}, nil)
+		c.EXPECT().GetHydratedTimeEntry(mock.Anything, p).Return(&te, nil)
+	}
+
+	end := start.Add(5 * time.Hour)
+	c.EXPECT().UpdateTimeEntry(mock.Anything, api.UpdateTimeEntryParam{
+		Workspace:   "w",
+		TimeEntryID: "te1",
+		Start:       start,
+		End:         &end,
+		Description: "docs",
+		ProjectID:   "p1",
+		TagIDs:      []string{"tg1", "tg2"},
+		CustomFields: []dto.CustomFieldValue{
+			{CustomFieldID: "cf1", Value: "CLI-1"},
+		},
+	}).
+		Return(dto.TimeEntryImpl{ID: "te1"}, nil).
+		Once()
+
+	c.EXPECT().DeleteTimeEntries(mock.Anything, api.DeleteTimeEntriesParam{
+		Workspace:    "w",
+		UserID:       "u",
+		TimeEntryIDs: []string{"te2", "te3"},
+	}).
+		Return(nil, nil).
+		Once()
+
+	cmd := merge.NewCmdMerge(f)
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	out := bytes.NewBufferString("")
+	cmd.SetOut(out)
+	cmd.SetErr(out)
+	cmd.SetArgs([]string{"te2", "te1", "te3", "--format",
+		`{{.ID}} {{.TimeInterval.End.Format "15:04"}} {{len .Tags}}`})
+
+	_, err := cmd.ExecuteC()
+	assert.NoError(t, err)
+	assert.Equal(t, "te1 14:00 2\n", out.String())
+}
+
+func TestCmdMergeAuto(t *testing.T) {
+	start := time.Date(2022, 6, 1, 9, 0, 0, 0, time.UTC)
+	log := []dto.TimeEntry{
+		// other time entry between them
+		entry("a3", "docs", "p1", start.Add(2*time.Hour), time.Hour),
+		entry("b1", "review", "p1", start.Add(90*time.Minute), time.Hour),
+		entry("a2", "docs", "p1", start.Add(62*time.Minute), time.Minute),
+		entry("a1", "docs", "p1", start, time.Hour),
+		// gap too big
+		entry("c1", "docs", "p1", start.Add(3*time.Hour+6*time.Minute),
+			time.Hour),
+		// overlapping
+		entry("c2", "docs", "p1", start.Add(4*time.Hour), time.Hour),
+	}
+
+	tts := []struct {
+		name   string
+		dryRun bool
+	}{
+		{name: "dry run", dryRun: true},
+		{name: "merge"},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			f := mocks.NewMockFactory(t)
+			f.EXPECT().GetWorkspaceID().Return("w", nil)
+			f.EXPECT().GetUserID().Return("u", nil)
+			f.EXPECT().Config().Return(&mocks.SimpleConfig{})
+
+			c := mocks.NewMockClient(t)
+			f.EXPECT().Client().Return(c, nil)
+
+			c.EXPECT().LogRange(mock.Anything, api.LogRangeParam{
+				Workspace:       "w",
+				UserID:          "u",
+				FirstDate:       time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC),
+				LastDate:        time.Date(2022, 6, 3, 0, 0, 0, 0, time.UTC),
+				PaginationParam: api.AllPages(),
+			}).
+				Return(append([]dto.TimeEntry{}, log...), nil)
+
+			args := []string{"--auto", "--since", "2022-06-01",
+				"--until", "2022-06-02", "--format",
+				`{{.ID}} {{.TimeInterval.End.Format "15:04"}}`}
+			if tt.dryRun {
+				args = append(args, "--dry-run")
+			} else {
+				for _, g := range []struct {
+					id, del string
+					end     time.Time
+				}{
+					{id: "a1", del: "a2", end: start.Add(63 * time.Minute)},
+					{id: "c1", del: "c2", end: start.Add(5 * time.Hour)},
+				} {
+					end := g.end
+					c.EXPECT().UpdateTimeEntry(mock.Anything,
+						api.UpdateTimeEntryParam{
+							Workspace:   "w",
+							TimeEntryID: g.id,
+							Start:       getStart(log, g.id),
+							End:         &end,
+							Description: "docs",
+							ProjectID:   "p1",
+							TagIDs:      []string{},
+						}).
+						Return(dto.TimeEntryImpl{ID: g.id}, nil).
+						Once()
+
+					c.EXPECT().DeleteTimeEntry(mock.Anything,
+						api.DeleteTimeEntryParam{
+							Workspace:   "w",
+							TimeEntryID: g.del,
+						}).
+						Return(nil).
+						Once()
+				}
+			}
+
+			cmd := merge.NewCmdMerge(f)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			out := bytes.NewBufferString("")
+			cmd.SetOut(out)
+			cmd.SetErr(out)
+			cmd.SetArgs(args)
+
+			_, err := cmd.ExecuteC()
+			assert.NoError(t, err)
+			assert.Equal(t, "a1 10:03\nc1 14:00\n", out.String())
+		})
+	}
+}
+
+func getStart(tes []dto.TimeEntry, id string) time.Time {
+	for _, te := range tes {
+		if te.ID == id {
+			return te.TimeInterval.Start
+		}
+	}
+
+	return time.Time{}
+}
+
+func TestCmdMergeInvalidArgs(t *testing.T) {
+	tts := []struct {
+		name string
+		args []string
+		err  string
+	}{
+		{
+			name: "only one",
+			args: []string{"te1"},
+			err:  "at least two time entries must be set, or use --auto",
+		},
+		{
+			name: "ids and auto",
+			args: []string{"te1", "te2", "--auto"},
+			err:  "time entry ids can't be set with --auto",
+		},
+		{
+			name: "negative gap",
+			args: []string{"--auto", "--gap", "-1"},
+			err:  "--gap can't be negative",
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := merge.NewCmdMerge(mocks.NewMockFactory(t))
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+			cmd.SetOut(bytes.NewBufferString(""))
+			cmd.SetErr(bytes.NewBufferString(""))
+			cmd.SetArgs(tt.args)
+
+			_, err := cmd.ExecuteC()
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestCmdMergeSameTimeEntry(t *testing.T) {
+	f := mocks.NewMockFactory(t)
+	f.EXPECT().GetWorkspaceID().Return("w", nil)
+	f.EXPECT().GetUserID().Return("u", nil)
+
+	c := mocks.NewMockClient(t)
+	f.EXPECT().Client().Return(c, nil)
+
+	p := api.GetTimeEntryParam{Workspace: "w", TimeEntryID: "te1"}
+	c.EXPECT().GetTimeEntry(mock.Anything,
+		p).Return(&dto.TimeEntryImpl{ID: "te1"}, nil)
+	c.EXPECT().GetHydratedTimeEntry(mock.Anything,
+		p).Return(&dto.TimeEntry{ID: "te1"}, nil)
+
+	cmd := merge.NewCmdMerge(f)
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	cmd.SetArgs([]string{"te1", "TE1"})
+
+	_, err := cmd.ExecuteC()
+	assert.EqualError(t, err, "time entry te1 is set more than once")
+}
@@ -0,0 +1,268 @@
+package out
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/idle"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/lucassabreu/clockify-cli/strhlp"
+	"github.com/pkg/errors"
+)
+
+const (
+	idleActionTrim  = "trim"
+	idleActionSplit = "split"
+	idleActionKeep  = "keep"
+)
+
+var idleActions = []string{idleActionTrim, idleActionSplit, idleActionKeep}
+
+// currentIdle is replaced on tests
+var currentIdle = idle.Current
+
+// idleFlags configures how the out command handles the time the user was
+// away while the time entry was running
+type idleFlags struct {
+	Check     bool
+	Threshold time.Duration
+	Action    string
+}
+
+func (i idleFlags) check(changed func(string) bool) error {
+	if !i.Check {
+		for _, n := range []string{"idle-threshold", "idle-action"} {
+			if changed(n) {
+				return cmdutil.FlagErrorWrap(errors.New(
+					"`" + n + "` can only be used with `idle-check`"))
+			}
+		}
+
+		return nil
+	}
+
+	if i.Threshold <= 0 {
+		return cmdutil.FlagErrorWrap(
+			errors.New("`idle-threshold` must be a positive duration"))
+	}
+
+	if i.Action != "" && !strhlp.InSlice(i.Action, idleActions) {
+		return cmdutil.FlagErrorWrap(errors.Errorf(
+			"`idle-action` must be one of %s",
+			strhlp.ListForHumans(idleActions)))
+	}
+
+	return nil
+}
+
+// idlePeriods returns when the user was away for longer than the threshold
+// between start and end, as recorded by "idle daemon" and for how long the
+// user is idle now
+func idlePeriods(
+	start, end time.Time, threshold time.Duration, stderr io.Writer,
+) ([]idle.Period, error) {
+	l, err := cmdutil.IdleLog()
+	if err != nil {
+		return nil, err
+	}
+
+	ps, err := l.Periods()
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := currentIdle()
+	switch {
+	case err == idle.ErrNotSupported:
+		fmt.Fprintln(stderr, "the idle time can't be read on this system, "+
+			"only the periods recorded by \"idle daemon\" are checked")
+	case err != nil:
+		return nil, err
+	default:
+		now := time.Now()
+		ps = append(ps, idle.Period{Start: now.Add(-d), End: now})
+	}
+
+	return idle.Between(ps, start, end, threshold), nil
+}
+
+// askIdleAction shows the periods the user was away and asks what to do
+// with them, unless it was set by flag
+func askIdleAction(
+	f cmdutil.Factory, i idleFlags, ps []idle.Period, stderr io.Writer,
+) (string, error) {
+	for _, p := range ps {
+		fmt.Fprintf(stderr, "idle for %s, from %s until %s\n",
+			p.Duration().Round(time.Second),
+			p.Start.In(time.Local).Format(timehlp.FullTimeFormat),
+			p.End.In(time.Local).Format(timehlp.FullTimeFormat))
+	}
+
+	if i.Action != "" {
+		return i.Action, nil
+	}
+
+	if !f.Config().IsInteractive() {
+		return "", cmdutil.FlagErrorWrap(errors.Errorf(
+			"set `idle-action` to %s the idle time without asking",
+			strhlp.ListForHumans(idleActions)))
+	}
+
+	// in the same order of idleActions
+	labels := []string{
+		"Trim the idle time from the time entry",
+		"Split the time entry around the idle time",
+		"Keep the time entry as it is",
+	}
+	o, err := f.UI().AskFromOptions(
+		"What should be done with the idle time?", labels, labels[0])
+	if err != nil {
+		return "", err
+	}
+
+	for i := range labels {
+		if labels[i] == o {
+			return idleActions[i], nil
+		}
+	}
+
+	return idleActionKeep, nil
+}
+
+// idleIntervals returns the intervals the time entry should have: trimming
+// the idle periods are left out, splitting they are intervals of their own
+func idleIntervals(
+	start, end time.Time, ps []idle.Period, trim bool,
+) []dto.TimeInterval {
+	is := []dto.TimeInterval{}
+	add := func(s, e time.Time) {
+		if e.After(s) {
+			is = append(is, dto.TimeInterval{Start: s, End: &e})
+		}
+	}
+
+	s := start
+	for _, p := range ps {
+		add(s, p.Start)
+		if !trim {
+			add(p.Start, p.End)
+		}
+		s = p.End
+	}
+	add(s, end)
+
+	return is
+}
+
+// createCopies creates a time entry like te for each interval. When one
+// fails to be created, te is restored to the interval it had before the
+// idle check (orig) and the copies already created are deleted, so no time
+// is lost
+func createCopies(
+	ctx context.Context,
+	c api.Client, w string, te dto.TimeEntry, orig dto.TimeInterval,
+	is []dto.TimeInterval,
+) ([]dto.TimeEntry, error) {
+	p := util.CopyTimeEntryParam(w, te)
+	tes := make([]dto.TimeEntry, 0, len(is))
+	for _, i := range is {
+		p.Start = i.Start
+		p.End = i.End
+		n, err := c.CreateTimeEntry(ctx, p)
+		if err != nil {
+			err = errors.Wrapf(err,
+				"failed to create the time entry starting at %s",
+				i.Start.In(time.Local).Format(timehlp.FullTimeFormat))
+
+			te.TimeInterval = orig
+			if rerr := rollback(ctx, c, w, te, tes); rerr != nil {
+				return nil, errors.Errorf(
+					"%s, and failed to undo the idle check: %s", err, rerr)
+			}
+
+			return nil, err
+		}
+
+		t := te
+		t.ID = n.ID
+		t.TimeInterval = i
+		tes = append(tes, t)
+	}
+
+	return tes, nil
+}
+
+// rollback restores the interval of the time entry and deletes the copies
+// created from it
+func rollback(
+	ctx context.Context,
+	c api.Client, w string, te dto.TimeEntry, created []dto.TimeEntry,
+) error {
+	if err := moveStart(ctx, c, w, te, te.TimeInterval.Start); err != nil {
+		return err
+	}
+
+	for i := range created {
+		if err := c.DeleteTimeEntry(ctx, api.DeleteTimeEntryParam{
+			Workspace:   w,
+			TimeEntryID: created[i].ID,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkIdle returns the intervals the time entry should be split into,
+// none when it should only be stopped at end
+func checkIdle(
+	f cmdutil.Factory, i idleFlags, te dto.TimeEntry, end time.Time,
+	stderr io.Writer,
+) ([]dto.TimeInterval, error) {
+	ps, err := idlePeriods(te.TimeInterval.Start, end, i.Threshold, stderr)
+	if err != nil || len(ps) == 0 {
+		return nil, err
+	}
+
+	a, err := askIdleAction(f, i, ps, stderr)
+	if err != nil || a == idleActionKeep {
+		return nil, err
+	}
+
+	is := idleIntervals(te.TimeInterval.Start, end, ps, a == idleActionTrim)
+	if len(is) == 0 {
+		return nil, errors.New("the user was idle while all the time entry " +
+			"was running, use \"clockify-cli delete current\" to remove it")
+	}
+
+	return is, nil
+}
+
+// moveStart changes when the time entry started, as the idle time on its
+// start was trimmed
+func moveStart(
+	ctx context.Context,
+	c api.Client, w string, te dto.TimeEntry, start time.Time) error {
+	p := util.CopyTimeEntryParam(w, te)
+	_, err := c.UpdateTimeEntry(ctx, api.UpdateTimeEntryParam{
+		Workspace:    w,
+		TimeEntryID:  te.ID,
+		Start:        start,
+		End:          te.TimeInterval.End,
+		Billable:     te.Billable,
+		Description:  p.Description,
+		ProjectID:    p.ProjectID,
+		TaskID:       p.TaskID,
+		TagIDs:       p.TagIDs,
+		CustomFields: p.CustomFields,
+	})
+
+	return err
+}
@@ -0,0 +1,272 @@
+package out
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/idle"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestIdleIntervals(t *testing.T) {
+	at := func(h, m int) time.Time {
+		return time.Date(2022, 6, 19, h, m, 0, 0, time.UTC)
+	}
+	ps := []idle.Period{
+		{Start: at(9, 0), End: at(9, 30)},
+		{Start: at(12, 0), End: at(13, 0)},
+	}
+	interval := func(s, e time.Time) dto.TimeInterval {
+		return dto.TimeInterval{Start: s, End: &e}
+	}
+
+	assert.Equal(t, []dto.TimeInterval{
+		interval(at(9, 30), at(12, 0)),
+	}, idleIntervals(at(9, 0), at(13, 0), ps, true))
+
+	assert.Equal(t, []dto.TimeInterval{
+		interval(at(9, 0), at(9, 30)),
+		interval(at(9, 30), at(12, 0)),
+		interval(at(12, 0), at(13, 0)),
+	}, idleIntervals(at(9, 0), at(13, 0), ps, false))
+
+	assert.Equal(t, []dto.TimeInterval{
+		interval(at(8, 0), at(9, 0)),
+		interval(at(9, 30), at(12, 0)),
+		interval(at(13, 0), at(14, 0)),
+	}, idleIntervals(at(8, 0), at(14, 0), ps, true))
+
+	assert.Empty(t, idleIntervals(at(9, 0), at(9, 30), ps[:1], true))
+}
+
+func TestOutIdleCheck(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_CACHE_HOME", dir)
+	t.Setenv("HOME", dir)
+
+	currentIdle = func() (time.Duration, error) {
+		return 0, idle.ErrNotSupported
+	}
+	defer func() { currentIdle = idle.Current }()
+
+	at := func(h, m int) time.Time {
+		return time.Date(2022, 6, 19, h, m, 0, 0, time.Local)
+	}
+
+	l, err := cmdutil.IdleLog()
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.NoError(t, l.Add(idle.Period{Start: at(12, 0), End: at(12, 40)}))
+	assert.NoError(t, l.Add(idle.Period{Start: at(15, 0), End: at(15, 5)}))
+
+	f := mocks.NewMockFactory(t)
+	f.EXPECT().GetWorkspaceID().Return("w", nil)
+	f.EXPECT().GetUserID().Return("u", nil)
+
+	conf := mocks.NewMockConfig(t)
+	f.EXPECT().Config().Return(conf).Maybe()
+	conf.EXPECT().GetBool(mock.Anything).Return(false).Maybe()
+	conf.EXPECT().GetString(mock.Anything).Return("").Maybe()
+
+	c := mocks.NewMockClient(t)
+	f.EXPECT().Client().Return(c, nil)
+
+	c.EXPECT().GetHydratedTimeEntryInProgress(mock.Anything,
+		api.GetTimeEntryInProgressParam{Workspace: "w", UserID: "u"}).
+		Return(&dto.TimeEntry{
+			ID:           "te1",
+			Description:  "Writing docs",
+			Project:      &dto.Project{ID: "p1"},
+			Tags:         []dto.Tag{{ID: "tg1"}},
+			TimeInterval: dto.TimeInterval{Start: at(9, 0)},
+		}, nil)
+
+	// the periods are kept in UTC
+	c.EXPECT().Out(mock.Anything, api.OutParam{
+		Workspace: "w", UserID: "u", End: at(12, 0).UTC()}).
+		Return(nil)
+
+	after := at(17, 0)
+	billable := false
+	c.EXPECT().CreateTimeEntry(mock.Anything, api.CreateTimeEntryParam{
+		Workspace:   "w",
+		Start:       at(12, 40).UTC(),
+		End:         &after,
+		Billable:    &billable,
+		Description: "Writing docs",
+		ProjectID:   "p1",
+		TagIDs:      []string{"tg1"},
+	}).
+		Return(dto.TimeEntryImpl{ID: "te2"}, nil)
+
+	cmd := NewCmdOut(f)
+	out := bytes.NewBufferString("")
+	stderr := bytes.NewBufferString("")
+	cmd.SetOut(out)
+	cmd.SetErr(stderr)
+	cmd.SetArgs([]string{"--when", "2022-06-19 17:00", "-q",
+		"--idle-check", "--idle-action", "trim"})
+
+	_, err = cmd.ExecuteC()
+	assert.NoError(t, err)
+	assert.Equal(t, "te1\nte2\n", out.String())
+	assert.Contains(t, stderr.String(), "idle for 40m0s, "+
+		"from 2022-06-19 12:00:00 until 2022-06-19 12:40:00\n")
+	assert.NotContains(t, stderr.String(), "idle for 5m0s")
+}
+
+func TestOutIdleCheckRestoresWhenCreateFails(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_CACHE_HOME", dir)
+	t.Setenv("HOME", dir)
+
+	currentIdle = func() (time.Duration, error) {
+		return 0, idle.ErrNotSupported
+	}
+	defer func() { currentIdle = idle.Current }()
+
+	at := func(h, m int) time.Time {
+		return time.Date(2022, 6, 19, h, m, 0, 0, time.Local)
+	}
+
+	l, err := cmdutil.IdleLog()
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.NoError(t, l.Add(idle.Period{Start: at(12, 0), End: at(12, 40)}))
+	assert.NoError(t, l.Add(idle.Period{Start: at(15, 0), End: at(15, 30)}))
+
+	f := mocks.NewMockFactory(t)
+	f.EXPECT().GetWorkspaceID().Return("w", nil)
+	f.EXPECT().GetUserID().Return("u", nil)
+
+	conf := mocks.NewMockConfig(t)
+	f.EXPECT().Config().Return(conf).Maybe()
+	conf.EXPECT().GetBool(mock.Anything).Return(false).Maybe()
+	conf.EXPECT().GetString(mock.Anything).Return("").Maybe()
+
+	c := mocks.NewMockClient(t)
+	f.EXPECT().Client().Return(c, nil)
+
+	c.EXPECT().GetHydratedTimeEntryInProgress(mock.Anything,
+		api.GetTimeEntryInProgressParam{Workspace: "w", UserID: "u"}).
+		Return(&dto.TimeEntry{
+			ID:           "te1",
+			Description:  "Writing docs",
+			Project:      &dto.Project{ID: "p1"},
+			Tags:         []dto.Tag{{ID: "tg1"}},
+			TimeInterval: dto.TimeInterval{Start: at(9, 0)},
+		}, nil)
+
+	c.EXPECT().Out(mock.Anything, api.OutParam{
+		Workspace: "w", UserID: "u", End: at(12, 0).UTC()}).
+		Return(nil)
+
+	billable := false
+	idleStart := at(15, 0).UTC()
+	c.EXPECT().CreateTimeEntry(mock.Anything, api.CreateTimeEntryParam{
+		Workspace:   "w",
+		Start:       at(12, 40).UTC(),
+		End:         &idleStart,
+		Billable:    &billable,
+		Description: "Writing docs",
+		ProjectID:   "p1",
+		TagIDs:      []string{"tg1"},
+	}).
+		Return(dto.TimeEntryImpl{ID: "te2"}, nil).Once()
+
+	after := at(17, 0)
+	c.EXPECT().CreateTimeEntry(mock.Anything, api.CreateTimeEntryParam{
+		Workspace:   "w",
+		Start:       at(15, 30).UTC(),
+		End:         &after,
+		Billable:    &billable,
+		Description: "Writing docs",
+		ProjectID:   "p1",
+		TagIDs:      []string{"tg1"},
+	}).
+		Return(dto.TimeEntryImpl{}, errors.New("rate limited")).Once()
+
+	c.EXPECT().UpdateTimeEntry(mock.Anything, api.UpdateTimeEntryParam{
+		Workspace:   "w",
+		TimeEntryID: "te1",
+		Start:       at(9, 0),
+		End:         &after,
+		Description: "Writing docs",
+		ProjectID:   "p1",
+		TagIDs:      []string{"tg1"},
+	}).
+		Return(dto.TimeEntryImpl{ID: "te1"}, nil).Once()
+
+	c.EXPECT().DeleteTimeEntry(mock.Anything, api.DeleteTimeEntryParam{
+		Workspace: "w", TimeEntryID: "te2"}).
+		Return(nil).Once()
+
+	cmd := NewCmdOut(f)
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	out := bytes.NewBufferString("")
+	cmd.SetOut(out)
+	cmd.SetErr(bytes.NewBufferString(""))
+	cmd.SetArgs([]string{"--when", "2022-06-19 17:00", "-q",
+		"--idle-check", "--idle-action", "trim"})
+
+	_, err = cmd.ExecuteC()
+	if assert.Error(t, err) {
+		assert.Regexp(t,
+			"failed to create the time entry starting at .*: rate limited$",
+			err.Error())
+	}
+	assert.Empty(t, out.String())
+}
+
+func TestOutIdleFlags(t *testing.T) {
+	tts := []struct {
+		name string
+		args []string
+		err  string
+	}{
+		{
+			name: "action without check",
+			args: []string{"--idle-action", "trim"},
+			err:  "`idle-action` can only be used with `idle-check`",
+		},
+		{
+			name: "invalid action",
+			args: []string{"--idle-check", "--idle-action", "drop"},
+			err:  "`idle-action` must be one of trim, split and keep",
+		},
+		{
+			name: "threshold",
+			args: []string{"--idle-check", "--idle-threshold", "0s"},
+			err:  "`idle-threshold` must be a positive duration",
+		},
+		{
+			name: "offline",
+			args: []string{"--idle-check", "--offline"},
+			err:  "`idle-check` can't be used with `offline`",
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := NewCmdOut(mocks.NewMockFactory(t))
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+			cmd.SetOut(bytes.NewBufferString(""))
+			cmd.SetArgs(tt.args)
+
+			_, err := cmd.ExecuteC()
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
@@ -2,12 +2,16 @@ package out
 
 import (
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/offline"
 	output "github.com/lucassabreu/clockify-cli/pkg/output/time-entry"
 	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
 	"github.com/spf13/cobra"
@@ -16,6 +20,8 @@ import (
 // NewCmdOut represents the out command
 func NewCmdOut(f cmdutil.Factory) *cobra.Command {
 	of := util.OutputFlags{TimeFormat: output.TimeFormatSimple}
+	var splitMidnight, isOffline bool
+	ic := idleFlags{Threshold: 10 * time.Minute}
 	cmd := &cobra.Command{
 		Use:   "out",
 		Short: "Stops the running time entry",
@@ -28,6 +34,10 @@ func NewCmdOut(f cmdutil.Factory) *cobra.Command {
 			%[2]s
 
 			Use %[1]sclockify-cli edit current%[1]s to edit any properties before ending it.
+
+			When the API is unreachable (or %[1]s--offline%[1]s is set), ending it is queued on disk instead, to be sent by %[1]sclockify-cli sync push%[1]s.
+
+			With %[1]s--idle-check%[1]s, the periods longer than %[1]s--idle-threshold%[1]s the keyboard and mouse were not used while the time entry was running are shown, and they can be trimmed from it (splitting it when they are in the middle) or split into time entries of their own. Only the idle time until now is known, unless %[1]sclockify-cli idle daemon%[1]s was running to record the periods before it.
 			%[3]s
 		`, "`",
 			util.HelpDateTimeFormats,
@@ -66,10 +76,21 @@ func NewCmdOut(f cmdutil.Factory) *cobra.Command {
 			 * Development (%[2]s62ae28b72518aa18da2acb49%[2]s)
 		`, "clockify-cli", "`"),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
 			if err := of.Check(); err != nil {
 				return err
 			}
 
+			if err := ic.check(cmd.Flags().Changed); err != nil {
+				return err
+			}
+
+			if ic.Check && isOffline {
+				return cmdutil.FlagErrorWrap(errors.New(
+					"`idle-check` can't be used with `offline`"))
+			}
+
 			var whenDate time.Time
 			var err error
 
@@ -93,7 +114,18 @@ func NewCmdOut(f cmdutil.Factory) *cobra.Command {
 				return err
 			}
 
+			queued := util.TimeEntryDTO{
+				Workspace: w,
+				UserID:    userID,
+				End:       &whenDate,
+			}
+			if isOffline {
+				return util.QueueOffline(
+					offline.KindOut, queued, true, nil, cmd.ErrOrStderr())
+			}
+
 			te, err := c.GetHydratedTimeEntryInProgress(
+				ctx,
 				api.GetTimeEntryInProgressParam{
 					Workspace: w,
 					UserID:    userID,
@@ -103,29 +135,96 @@ func NewCmdOut(f cmdutil.Factory) *cobra.Command {
 				return errors.New("no time entry in progress")
 			}
 
+			var is []dto.TimeInterval
+			if err == nil && ic.Check {
+				if is, err = checkIdle(f, ic, *te, whenDate,
+					cmd.ErrOrStderr()); err != nil {
+					return err
+				}
+			}
+
+			end := whenDate
+			if len(is) > 0 {
+				end = *is[0].End
+				queued.End = &end
+			}
+
+			if err == nil {
+				err = c.Out(ctx, api.OutParam{
+					Workspace: w,
+					UserID:    userID,
+					End:       end,
+				})
+			}
+
 			if err != nil {
-				return err
+				return util.QueueOffline(offline.KindOut, queued, false,
+					err, cmd.ErrOrStderr())
 			}
 
-			if err = c.Out(api.OutParam{
-				Workspace: w,
-				UserID:    userID,
-				End:       whenDate,
-			}); err != nil {
-				return err
+			orig := dto.TimeInterval{
+				Start: te.TimeInterval.Start, End: &whenDate}
+			te.TimeInterval.End = &end
+			if len(is) > 0 && !is[0].Start.Equal(te.TimeInterval.Start) {
+				if err := moveStart(ctx, c, w, *te,
+					is[0].Start); err != nil {
+					return err
+				}
+				te.TimeInterval.Start = is[0].Start
+			}
+
+			tes := []dto.TimeEntry{*te}
+			if len(is) > 1 {
+				cs, err := createCopies(ctx, c, w, *te, orig, is[1:])
+				if err != nil {
+					return err
+				}
+				tes = append(tes, cs...)
 			}
 
-			te.TimeInterval.End = &whenDate
+			if splitMidnight {
+				all := make([]dto.TimeEntry, 0, len(tes))
+				for _, t := range tes {
+					s, err := util.SplitAtMidnight(ctx, c, w, t.ID)
+					if err != nil {
+						return err
+					}
+
+					if len(s) == 1 {
+						s[0] = t
+					}
+					all = append(all, s...)
+				}
+				tes = all
+			}
+
+			if len(tes) > 1 {
+				return util.PrintTimeEntries(
+					tes, cmd.OutOrStdout(), f.Config(), of)
+			}
 
 			return util.PrintTimeEntry(te, cmd.OutOrStdout(), f.Config(), of)
 		},
 	}
 
 	util.AddPrintTimeEntriesFlags(cmd, &of)
+	util.AddSplitMidnightFlag(cmd, &splitMidnight)
+	util.AddOfflineFlag(cmd, &isOffline)
 
 	cmd.Flags().String("when", time.Now().Format(timehlp.FullTimeFormat),
 		"when the entry should be closed, "+
 			"if not informed will use current time")
 
+	cmd.Flags().BoolVar(&ic.Check, "idle-check", false,
+		"checks if the user was idle while the time entry was running, "+
+			"to trim or split it")
+	cmd.Flags().DurationVar(&ic.Threshold, "idle-threshold", ic.Threshold,
+		"how long the user must be idle to be checked")
+	cmd.Flags().StringVar(&ic.Action, "idle-action", "",
+		"what to do with the idle time without asking ("+
+			strings.Join(idleActions, ", ")+")")
+	_ = cmdcompl.AddFixedSuggestionsToFlag(cmd, "idle-action",
+		cmdcompl.ValidArgsSlide(idleActions))
+
 	return cmd
 }
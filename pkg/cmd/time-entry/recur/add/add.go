@@ -0,0 +1,140 @@
+package add
+
+import (
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/recur/util"
+	teutil "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdAdd saves a new recurring time entry on the config
+func NewCmdAdd(f cmdutil.Factory) *cobra.Command {
+	fl := struct {
+		project  string
+		task     string
+		tags     []string
+		billable bool
+		at       string
+		dur      time.Duration
+		days     string
+	}{}
+
+	cmd := &cobra.Command{
+		Use:   "add <description>",
+		Args:  cmdutil.RequiredNamedArgs("description"),
+		Short: "Saves a new recurring time entry",
+		Long: heredoc.Doc(`
+			Saves a new recurring time entry on the config, which will be
+			created by "recur run" on the days set.
+
+			The days are weekdays (or their first three letters), or ranges of
+			them, separated by commas. If not set, the workweek days of the
+			config are used, or every day when there are none.
+		`),
+		Example: heredoc.Docf(`
+			$ %[1]s "daily standup" --project meetings --at 09:30 --for 15m --days mon-fri
+			$ %[1]s "1:1" -p meetings --at 14:00 --for 30m --days tue,thu
+		`, "clockify-cli recur add"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if fl.project == "" {
+				return cmdutil.FlagErrorWrap(
+					errors.New("project is required"))
+			}
+
+			if _, err := time.Parse(
+				timehlp.SimplerOnlyTimeFormat, fl.at); err != nil {
+				return cmdutil.FlagErrorWrap(
+					errors.New("--at must be a time like 09:30"))
+			}
+
+			if fl.dur <= 0 {
+				return cmdutil.FlagErrorWrap(
+					errors.New("--for must be a positive duration"))
+			}
+
+			conf := f.Config()
+			days := conf.GetWorkWeekdays()
+			if fl.days != "" {
+				var err error
+				if days, err = util.ParseDays(fl.days); err != nil {
+					return cmdutil.FlagErrorWrap(err)
+				}
+			} else if len(days) == 0 {
+				days = util.AllDays()
+			}
+
+			rs, err := util.Load(conf)
+			if err != nil {
+				return err
+			}
+
+			if util.Find(rs, args[0]) != -1 {
+				return errors.Errorf(
+					"recurring time entry \"%s\" already exists", args[0])
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			te, err := teutil.Do(
+				teutil.TimeEntryDTO{
+					Workspace: w,
+					ProjectID: fl.project,
+					TaskID:    fl.task,
+					TagIDs:    fl.tags,
+				},
+				teutil.GetAllowNameForIDsFn(cmd.Context(), conf, c),
+			)
+			if err != nil {
+				return err
+			}
+
+			return util.Save(conf, append(rs, util.Rule{
+				Description: args[0],
+				ProjectID:   te.ProjectID,
+				TaskID:      te.TaskID,
+				TagIDs:      te.TagIDs,
+				Billable:    fl.billable,
+				At:          fl.at,
+				For:         fl.dur.String(),
+				Days:        days,
+			}))
+		},
+	}
+
+	cmd.Flags().StringVarP(&fl.project, "project", "p", "",
+		"project of the time entries")
+	_ = cmdcompl.AddSuggestionsToFlag(cmd, "project",
+		cmdcomplutil.NewProjectAutoComplete(f))
+	cmd.Flags().StringVar(&fl.task, "task", "", "task of the time entries")
+	_ = cmdcompl.AddSuggestionsToFlag(cmd, "task",
+		cmdcomplutil.NewTaskAutoComplete(f, true))
+	cmd.Flags().StringSliceVarP(&fl.tags, "tag", "T", []string{},
+		"tags of the time entries (can be used multiple times)")
+	_ = cmdcompl.AddSuggestionsToFlag(cmd, "tag",
+		cmdcomplutil.NewTagAutoComplete(f))
+	cmd.Flags().BoolVarP(&fl.billable, "billable", "b", false,
+		"the time entries are billable")
+	cmd.Flags().StringVar(&fl.at, "at", "",
+		"when the time entries start (like: 09:30)")
+	cmd.Flags().DurationVar(&fl.dur, "for", 0,
+		"how long the time entries are (like: 15m)")
+	cmd.Flags().StringVar(&fl.days, "days", "",
+		"weekdays to create the time entries (like: mon-fri)")
+
+	return cmd
+}
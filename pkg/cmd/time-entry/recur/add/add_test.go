@@ -0,0 +1,147 @@
+package add_test
+
+import (
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/recur/add"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/stretchr/testify/assert"
+)
+
+var standup = map[string]interface{}{
+	"description": "daily standup",
+	"project":     "p1",
+	"at":          "09:30",
+	"for":         "15m0s",
+	"days":        []interface{}{"monday", "tuesday"},
+}
+
+func TestCmdAdd(t *testing.T) {
+	tts := []struct {
+		name     string
+		args     []string
+		existing interface{}
+		workweek []string
+		set      []interface{}
+		err      string
+	}{
+		{
+			name: "first one",
+			args: []string{"daily standup", "-p", "p1",
+				"--at", "09:30", "--for", "15m", "--days", "mon-tue"},
+			set: []interface{}{standup},
+		},
+		{
+			name: "workweek days",
+			args: []string{"1:1", "-p", "p1", "--task", "t1", "-T", "tg1",
+				"-b", "--at", "14:00", "--for", "30m"},
+			existing: []interface{}{standup},
+			workweek: []string{"thursday"},
+			set: []interface{}{
+				standup,
+				map[string]interface{}{
+					"description": "1:1",
+					"project":     "p1",
+					"task":        "t1",
+					"tags":        []interface{}{"tg1"},
+					"billable":    true,
+					"at":          "14:00",
+					"for":         "30m0s",
+					"days":        []interface{}{"thursday"},
+				},
+			},
+		},
+		{
+			name: "every day",
+			args: []string{"lunch", "-p", "p1",
+				"--at", "12:00", "--for", "1h"},
+			set: []interface{}{map[string]interface{}{
+				"description": "lunch",
+				"project":     "p1",
+				"at":          "12:00",
+				"for":         "1h0m0s",
+				"days": []interface{}{"monday", "tuesday", "wednesday",
+					"thursday", "friday", "saturday", "sunday"},
+			}},
+		},
+		{
+			name: "already exists",
+			args: []string{"Daily Standup", "-p", "p1",
+				"--at", "09:30", "--for", "15m"},
+			existing: []interface{}{standup},
+			err:      `recurring time entry "Daily Standup" already exists`,
+		},
+		{
+			name: "invalid days",
+			args: []string{"daily standup", "-p", "p1",
+				"--at", "09:30", "--for", "15m", "--days", "weekdays"},
+			err: `"weekdays" is not a weekday`,
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			f := mocks.NewMockFactory(t)
+			conf := mocks.NewMockConfig(t)
+			f.EXPECT().Config().Return(conf)
+			conf.EXPECT().GetWorkWeekdays().Return(tt.workweek)
+			conf.EXPECT().Get(cmdutil.CONF_RECURRING).
+				Return(tt.existing).Maybe()
+
+			if tt.set != nil {
+				f.EXPECT().GetWorkspaceID().Return("w", nil)
+				f.EXPECT().Client().Return(mocks.NewMockClient(t), nil)
+				conf.EXPECT().GetBool(cmdutil.CONF_ALLOW_NAME_FOR_ID).
+					Return(false)
+				conf.EXPECT().Set(cmdutil.CONF_RECURRING, tt.set).Once()
+				conf.EXPECT().Save().Return(nil).Once()
+			}
+
+			cmd := add.NewCmdAdd(f)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+			cmd.SetArgs(tt.args)
+
+			_, err := cmd.ExecuteC()
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCmdAddInvalidFlags(t *testing.T) {
+	tts := []struct {
+		args []string
+		err  string
+	}{
+		{
+			args: []string{"standup", "--at", "09:30", "--for", "15m"},
+			err:  "project is required",
+		},
+		{
+			args: []string{"standup", "-p", "p1", "--at", "9h",
+				"--for", "15m"},
+			err: "--at must be a time like 09:30",
+		},
+		{
+			args: []string{"standup", "-p", "p1", "--at", "09:30"},
+			err:  "--for must be a positive duration",
+		},
+	}
+
+	for _, tt := range tts {
+		cmd := add.NewCmdAdd(mocks.NewMockFactory(t))
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+		cmd.SetArgs(tt.args)
+
+		_, err := cmd.ExecuteC()
+		assert.EqualError(t, err, tt.err)
+	}
+}
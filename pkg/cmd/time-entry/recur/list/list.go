@@ -0,0 +1,68 @@
+package list
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/recur/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	outpututil "github.com/lucassabreu/clockify-cli/pkg/output/util"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdList shows the recurring time entries saved on the config
+func NewCmdList(f cmdutil.Factory) *cobra.Command {
+	var asJSON bool
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Short:   "List the recurring time entries",
+		Example: heredoc.Doc(`
+			$ clockify-cli recur list
+			+---------------+-------+-------+---------------------+--------------------------+
+			|  DESCRIPTION  |  AT   |  FOR  |        DAYS         |         PROJECT          |
+			+---------------+-------+-------+---------------------+--------------------------+
+			| daily standup | 09:30 | 15m0s | mon,tue,wed,thu,fri | 621948458cb9606d934ebb1c |
+			+---------------+-------+-------+---------------------+--------------------------+
+		`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			rs, err := util.Load(f.Config())
+			if err != nil {
+				return err
+			}
+
+			if asJSON {
+				return json.NewEncoder(cmd.OutOrStdout()).Encode(rs)
+			}
+
+			tw := tablewriter.NewWriter(cmd.OutOrStdout())
+			outpututil.SetThemedHeader(tw,
+				[]string{"Description", "At", "For", "Days", "Project"})
+			for _, r := range rs {
+				days := make([]string, len(r.Days))
+				for i, d := range r.Days {
+					if len(d) > 3 {
+						d = d[:3]
+					}
+					days[i] = d
+				}
+
+				tw.Append([]string{
+					r.Description, r.At, r.For,
+					strings.Join(days, ","), r.ProjectID,
+				})
+			}
+			tw.Render()
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&asJSON, "json", "j", false, "print as JSON")
+
+	return cmd
+}
@@ -0,0 +1,39 @@
+package recur
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/recur/add"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/recur/list"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/recur/remove"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/recur/run"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdRecur represents the recur command
+func NewCmdRecur(f cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recur",
+		Short: "Manages time entries which repeat every week",
+		Args:  cobra.MaximumNArgs(0),
+		Long: heredoc.Doc(`
+			Manages recurring time entries, like meetings, which happen at the
+			same time on some days of the week.
+
+			The recurring time entries are saved on the config, and their time
+			entries are created by "recur run", which can be called by cron,
+			systemd timers or similar tools.
+		`),
+		Example: heredoc.Doc(`
+			$ clockify-cli recur add "daily standup" --project meetings --at 09:30 --for 15m --days mon-fri
+			$ clockify-cli recur run
+		`),
+	}
+
+	cmd.AddCommand(add.NewCmdAdd(f))
+	cmd.AddCommand(list.NewCmdList(f))
+	cmd.AddCommand(remove.NewCmdRemove(f))
+	cmd.AddCommand(run.NewCmdRun(f))
+
+	return cmd
+}
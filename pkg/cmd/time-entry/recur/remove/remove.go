@@ -0,0 +1,43 @@
+package remove
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/recur/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdRemove removes a recurring time entry from the config
+func NewCmdRemove(f cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove <description>",
+		Aliases: []string{"rm"},
+		Args:    cmdutil.RequiredNamedArgs("description"),
+		Short:   "Removes a recurring time entry",
+		Long: heredoc.Doc(`
+			Removes a recurring time entry from the config, the time entries
+			already created by it are kept.
+		`),
+		Example: heredoc.Doc(`
+			$ clockify-cli recur remove "daily standup"
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			conf := f.Config()
+			rs, err := util.Load(conf)
+			if err != nil {
+				return err
+			}
+
+			i := util.Find(rs, args[0])
+			if i == -1 {
+				return errors.Errorf(
+					"recurring time entry \"%s\" does not exist", args[0])
+			}
+
+			return util.Save(conf, append(rs[:i], rs[i+1:]...))
+		},
+	}
+
+	return cmd
+}
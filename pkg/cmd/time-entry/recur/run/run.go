@@ -0,0 +1,215 @@
+package run
+
+import (
+	"context"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/recur/util"
+	reportutil "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/util"
+	teutil "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdRun creates the time entries of the recurring time entries
+func NewCmdRun(f cmdutil.Factory) *cobra.Command {
+	of := teutil.OutputFlags{TimeFormat: timehlp.FullTimeFormat}
+	var since, until string
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "run",
+		Args:  cobra.NoArgs,
+		Short: "Creates the time entries of the recurring time entries",
+		Long: heredoc.Doc(`
+			Creates the time entries of the recurring time entries for each
+			day between --since and --until (inclusive).
+
+			A time entry is not created when there is already one starting at
+			the same time with the same description, so it's safe to run it
+			more than once for the same days, like from a cron job.
+		`) + "\n" + teutil.HelpMoreInfoAboutPrinting,
+		Example: heredoc.Docf(`
+			# creates the time entries of today
+			$ %[1]s
+
+			# check which time entries would be created this week
+			$ %[1]s --since 2022-06-06 --until 2022-06-10 --dry-run
+
+			# crontab running every day at 08:00
+			0 8 * * * clockify-cli recur run --quiet
+		`, "clockify-cli recur run"),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			args := []string{timehlp.Today().Format("2006-01-02"), until}
+			if since != "" {
+				args[0] = since
+			}
+
+			start, end, err := reportutil.ParseRangeArgs(args)
+			if err != nil {
+				return cmdutil.FlagErrorWrap(errors.Wrap(err,
+					"--since and --until must be dates like 2022-06-01"))
+			}
+
+			start = timehlp.TruncateDate(start)
+			end = timehlp.TruncateDate(end).Add(time.Hour * 24)
+
+			rs, err := util.Load(f.Config())
+			if err != nil {
+				return err
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			u, err := f.GetUserID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			log, err := c.LogRange(ctx, api.LogRangeParam{
+				Workspace:       w,
+				UserID:          u,
+				FirstDate:       start,
+				LastDate:        end,
+				PaginationParam: api.AllPages(),
+			})
+			if err != nil {
+				return err
+			}
+
+			tes, err := materialize(rs, start, end, log)
+			if err != nil {
+				return err
+			}
+
+			if !dryRun {
+				for i := range tes {
+					if tes[i], err = create(ctx, c, w,
+						tes[i]); err != nil {
+						return err
+					}
+				}
+			}
+
+			return teutil.PrintTimeEntries(
+				tes, cmd.OutOrStdout(), f.Config(), of)
+		},
+	}
+
+	cmd.Flags().StringVar(&since, "since", "",
+		"first day to create the time entries (default today)")
+	cmd.Flags().StringVar(&until, "until", "today",
+		"last day to create the time entries")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false,
+		"only prints the time entries, without creating them")
+
+	teutil.AddPrintTimeEntriesFlags(cmd, &of)
+	teutil.AddPrintMultipleTimeEntriesFlags(cmd)
+
+	return cmd
+}
+
+// materialize returns the time entries of the rules for each day between
+// start and end, which are not on the log yet
+func materialize(
+	rs []util.Rule, start, end time.Time, log []dto.TimeEntry,
+) ([]dto.TimeEntry, error) {
+	exists := make(map[string]bool, len(log))
+	key := func(s time.Time, d string) string {
+		return s.UTC().Format(time.RFC3339) + "|" + d
+	}
+	for _, te := range log {
+		exists[key(te.TimeInterval.Start, te.Description)] = true
+	}
+
+	tes := make([]dto.TimeEntry, 0)
+	for d := start; d.Before(end); d = d.AddDate(0, 0, 1) {
+		for _, r := range rs {
+			if !r.On(d) {
+				continue
+			}
+
+			s, e, err := r.Interval(d)
+			if err != nil {
+				return tes, err
+			}
+
+			if exists[key(s, r.Description)] {
+				continue
+			}
+
+			tags := make([]dto.Tag, len(r.TagIDs))
+			for i := range r.TagIDs {
+				tags[i] = dto.Tag{ID: r.TagIDs[i]}
+			}
+
+			te := dto.TimeEntry{
+				Description:  r.Description,
+				ProjectID:    r.ProjectID,
+				Project:      &dto.Project{ID: r.ProjectID},
+				Billable:     r.Billable,
+				Tags:         tags,
+				TimeInterval: dto.NewTimeInterval(s, &e),
+			}
+
+			if r.TaskID != "" {
+				te.Task = &dto.Task{ID: r.TaskID}
+			}
+
+			tes = append(tes, te)
+		}
+	}
+
+	return tes, nil
+}
+
+func create(
+	ctx context.Context, c api.Client, w string, te dto.TimeEntry,
+) (dto.TimeEntry, error) {
+	p := api.CreateTimeEntryParam{
+		Workspace:   w,
+		Start:       te.TimeInterval.Start,
+		End:         te.TimeInterval.End,
+		Billable:    &te.Billable,
+		Description: te.Description,
+		ProjectID:   te.ProjectID,
+		TagIDs:      make([]string, len(te.Tags)),
+	}
+
+	if te.Task != nil {
+		p.TaskID = te.Task.ID
+	}
+
+	for i := range te.Tags {
+		p.TagIDs[i] = te.Tags[i].ID
+	}
+
+	n, err := c.CreateTimeEntry(ctx, p)
+	if err != nil {
+		return te, errors.Wrapf(err,
+			"failed to create \"%s\" for %s", te.Description,
+			te.TimeInterval.Start.Format(timehlp.SimplerTimeFormat))
+	}
+
+	te.ID = n.ID
+	return te, nil
+}
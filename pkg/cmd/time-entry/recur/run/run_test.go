@@ -0,0 +1,131 @@
+package run_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/recur/run"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func at(day, h, m int) time.Time {
+	return time.Date(2022, 6, day, h, m, 0, 0, time.Local).UTC()
+}
+
+func TestCmdRun(t *testing.T) {
+	rules := []interface{}{
+		map[string]interface{}{
+			"description": "daily standup",
+			"project":     "p1",
+			"tags":        []interface{}{"tg1"},
+			"at":          "09:30",
+			"for":         "15m0s",
+			"days":        []interface{}{"wednesday", "thursday"},
+		},
+		map[string]interface{}{
+			"description": "1:1",
+			"project":     "p2",
+			"task":        "t2",
+			"billable":    true,
+			"at":          "14:00",
+			"for":         "30m",
+			"days":        []interface{}{"thursday"},
+		},
+	}
+
+	tts := []struct {
+		name    string
+		args    []string
+		created []string
+		out     string
+	}{
+		{
+			name:    "create",
+			created: []string{"te2", "te3"},
+			out:     "te2\nte3\n",
+		},
+		{
+			name: "dry run",
+			args: []string{"--dry-run"},
+			out:  "\n\n",
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			f := mocks.NewMockFactory(t)
+			conf := mocks.NewMockConfig(t)
+			f.EXPECT().Config().Return(conf)
+			conf.EXPECT().Get(cmdutil.CONF_RECURRING).Return(rules)
+
+			f.EXPECT().GetWorkspaceID().Return("w", nil)
+			f.EXPECT().GetUserID().Return("u", nil)
+
+			c := mocks.NewMockClient(t)
+			f.EXPECT().Client().Return(c, nil)
+
+			// the standup of wednesday was already created
+			end := at(1, 9, 45)
+			c.EXPECT().LogRange(mock.Anything, api.LogRangeParam{
+				Workspace:       "w",
+				UserID:          "u",
+				FirstDate:       time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC),
+				LastDate:        time.Date(2022, 6, 3, 0, 0, 0, 0, time.UTC),
+				PaginationParam: api.AllPages(),
+			}).
+				Return([]dto.TimeEntry{{
+					ID:           "te1",
+					Description:  "daily standup",
+					TimeInterval: dto.NewTimeInterval(at(1, 9, 30), &end),
+				}}, nil)
+
+			if len(tt.created) > 0 {
+				fb, tb := false, true
+				e1, e2 := at(2, 9, 45), at(2, 14, 30)
+				c.EXPECT().CreateTimeEntry(mock.Anything,
+					api.CreateTimeEntryParam{
+						Workspace:   "w",
+						Start:       at(2, 9, 30),
+						End:         &e1,
+						Billable:    &fb,
+						Description: "daily standup",
+						ProjectID:   "p1",
+						TagIDs:      []string{"tg1"},
+					}).
+					Return(dto.TimeEntryImpl{ID: tt.created[0]}, nil).
+					Once()
+				c.EXPECT().CreateTimeEntry(mock.Anything,
+					api.CreateTimeEntryParam{
+						Workspace:   "w",
+						Start:       at(2, 14, 0),
+						End:         &e2,
+						Billable:    &tb,
+						Description: "1:1",
+						ProjectID:   "p2",
+						TaskID:      "t2",
+						TagIDs:      []string{},
+					}).
+					Return(dto.TimeEntryImpl{ID: tt.created[1]}, nil).
+					Once()
+			}
+
+			cmd := run.NewCmdRun(f)
+			out := bytes.NewBufferString("")
+			cmd.SetOut(out)
+			cmd.SetArgs(append([]string{"--quiet",
+				"--since", "2022-06-01", "--until", "2022-06-02"},
+				tt.args...))
+
+			_, err := cmd.ExecuteC()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.out, out.String())
+		})
+	}
+}
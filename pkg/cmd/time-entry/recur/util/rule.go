@@ -0,0 +1,126 @@
+package util
+
+import (
+	"strings"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/lucassabreu/clockify-cli/strhlp"
+	"github.com/pkg/errors"
+)
+
+// Rule describes a time entry created on some days of the week, at the
+// same time and with the same duration
+type Rule struct {
+	Description string   `yaml:"description" json:"description"`
+	ProjectID   string   `yaml:"project" json:"project"`
+	TaskID      string   `yaml:"task,omitempty" json:"task,omitempty"`
+	TagIDs      []string `yaml:"tags,omitempty" json:"tags,omitempty"`
+	Billable    bool     `yaml:"billable,omitempty" json:"billable,omitempty"`
+	At          string   `yaml:"at" json:"at"`
+	For         string   `yaml:"for" json:"for"`
+	Days        []string `yaml:"days" json:"days"`
+}
+
+// On reports if the rule creates a time entry on the day
+func (r Rule) On(d time.Time) bool {
+	return strhlp.Search(strings.ToLower(d.Weekday().String()), r.Days) != -1
+}
+
+// Interval returns when the time entry of the rule starts and ends on the
+// day (local time)
+func (r Rule) Interval(d time.Time) (time.Time, time.Time, error) {
+	at, err := time.Parse(timehlp.SimplerOnlyTimeFormat, r.At)
+	if err != nil {
+		return time.Time{}, time.Time{}, errors.Errorf(
+			"recurring time entry \"%s\" has a invalid time: %s",
+			r.Description, r.At)
+	}
+
+	dur, err := time.ParseDuration(r.For)
+	if err != nil {
+		return time.Time{}, time.Time{}, errors.Errorf(
+			"recurring time entry \"%s\" has a invalid duration: %s",
+			r.Description, r.For)
+	}
+
+	s := time.Date(d.Year(), d.Month(), d.Day(),
+		at.Hour(), at.Minute(), 0, 0, time.Local)
+	return s, s.Add(dur), nil
+}
+
+var weekdays = []string{
+	"sunday", "monday", "tuesday", "wednesday",
+	"thursday", "friday", "saturday",
+}
+
+// ParseDays reads a list of weekdays, or ranges of them, separated by
+// commas (like: mon-fri or mon,wed,fri) into their full names
+func ParseDays(s string) ([]string, error) {
+	on := make([]bool, len(weekdays))
+	for _, p := range strings.Split(s, ",") {
+		r := strings.SplitN(p, "-", 2)
+		first, err := timehlp.ParseWeekday(r[0])
+		if err != nil {
+			return nil, err
+		}
+
+		last := first
+		if len(r) == 2 {
+			if last, err = timehlp.ParseWeekday(r[1]); err != nil {
+				return nil, err
+			}
+		}
+
+		for i := int(first); ; i = (i + 1) % len(weekdays) {
+			on[i] = true
+			if i == int(last) {
+				break
+			}
+		}
+	}
+
+	days := make([]string, 0, len(weekdays))
+	// starting the week on monday
+	for j := 1; j <= len(weekdays); j++ {
+		i := j % len(weekdays)
+		if on[i] {
+			days = append(days, weekdays[i])
+		}
+	}
+
+	return days, nil
+}
+
+// AllDays returns the full names of the weekdays, starting on monday
+func AllDays() []string {
+	return append(append([]string{}, weekdays[1:]...), weekdays[0])
+}
+
+// Load reads the recurring time entries from the config
+func Load(c cmdutil.Config) ([]Rule, error) {
+	rs := make([]Rule, 0)
+	err := cmdutil.UnmarshalConfig(c, cmdutil.CONF_RECURRING, &rs)
+	return rs, err
+}
+
+// Save writes the recurring time entries into the config
+func Save(c cmdutil.Config, rs []Rule) error {
+	if err := cmdutil.MarshalConfig(c, cmdutil.CONF_RECURRING, rs); err != nil {
+		return err
+	}
+
+	return c.Save()
+}
+
+// Find returns the position of the rule with the description, or -1
+func Find(rs []Rule, description string) int {
+	for i := range rs {
+		if strings.EqualFold(rs[i].Description, description) {
+			return i
+		}
+	}
+
+	return -1
+}
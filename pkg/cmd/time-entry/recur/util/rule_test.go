@@ -0,0 +1,75 @@
+package util_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/recur/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDays(t *testing.T) {
+	tts := []struct {
+		in   string
+		days []string
+		err  string
+	}{
+		{
+			in: "mon-fri",
+			days: []string{
+				"monday", "tuesday", "wednesday", "thursday", "friday"},
+		},
+		{
+			in:   "Friday,mon, wed",
+			days: []string{"monday", "wednesday", "friday"},
+		},
+		{
+			in:   "sat-mon",
+			days: []string{"monday", "saturday", "sunday"},
+		},
+		{
+			in:  "mon-fry",
+			err: `"fry" is not a weekday`,
+		},
+		{
+			in:  "",
+			err: `"" is not a weekday`,
+		},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.in, func(t *testing.T) {
+			days, err := util.ParseDays(tt.in)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.days, days)
+		})
+	}
+}
+
+func TestRuleInterval(t *testing.T) {
+	r := util.Rule{
+		Description: "daily standup",
+		At:          "09:30",
+		For:         "15m",
+		Days:        []string{"wednesday"},
+	}
+
+	d := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+	assert.True(t, r.On(d))
+	assert.False(t, r.On(d.AddDate(0, 0, 1)))
+
+	s, e, err := r.Interval(d)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2022, 6, 1, 9, 30, 0, 0, time.Local), s)
+	assert.Equal(t, time.Date(2022, 6, 1, 9, 45, 0, 0, time.Local), e)
+
+	r.At = "9h"
+	_, _, err = r.Interval(d)
+	assert.EqualError(t, err,
+		`recurring time entry "daily standup" has a invalid time: 9h`)
+}
@@ -0,0 +1,83 @@
+package detailed
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/reports"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	output "github.com/lucassabreu/clockify-cli/pkg/output/report"
+	timeentry "github.com/lucassabreu/clockify-cli/pkg/output/time-entry"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdDetailed represents report detailed command
+func NewCmdDetailed(f cmdutil.Factory) *cobra.Command {
+	rf := util.ReportsAPIFlags{}
+	cmd := &cobra.Command{
+		Use:   "detailed [<start>] [<end>]",
+		Short: "Lists the time entries with their amounts, calculated by Clockify",
+		Long: heredoc.Docf(`
+			Lists the time entries with their amounts, calculated by Clockify's Reports API
+
+			Using the flag --rounding the durations will be rounded following the settings of the workspace.
+
+			If no parameter is set, shows today's report, the arguments work like the ones of "report".
+
+			%s
+		`, util.HelpNamesForIds),
+		Example: heredoc.Doc(`
+			# time entries of a project with the cost of them
+			$ clockify-cli report detailed 2022-06-01 2022-06-30 \
+				--project "Clockify Cli" --amount cost
+
+			# time entries of every user of the workspace as JSON
+			$ clockify-cli report detailed 2022-06-01 2022-06-30 --all-users --json
+		`),
+		Args: cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			if err := rf.Check(); err != nil {
+				return err
+			}
+
+			start, end, err := util.ParseRangeArgs(args)
+			if err != nil {
+				return err
+			}
+
+			p := reports.DetailedParam{PaginationParam: api.AllPages()}
+			if p.Filter, err = util.ReportsAPIFilter(
+				ctx,
+				f, start, end, rf); err != nil {
+				return err
+			}
+
+			c, err := f.ReportsClient()
+			if err != nil {
+				return err
+			}
+
+			r, err := c.Detailed(ctx, p)
+			if err != nil {
+				return err
+			}
+
+			if rf.JSON {
+				return output.JSONPrint(r, cmd.OutOrStdout())
+			}
+
+			df, err := timeentry.DurationFormatter(rf.DurationFormat)
+			if err != nil {
+				return err
+			}
+
+			return output.DetailedPrint(df)(r, cmd.OutOrStdout())
+		},
+	}
+
+	util.AddReportsAPIFlags(f, cmd, &rf)
+
+	return cmd
+}
@@ -0,0 +1,272 @@
+package gaps
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	timeentry "github.com/lucassabreu/clockify-cli/pkg/output/time-entry"
+	outpututil "github.com/lucassabreu/clockify-cli/pkg/output/util"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/lucassabreu/clockify-cli/strhlp"
+	"github.com/olekukonko/tablewriter"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// Gap is a stretch of the work hours without time entries
+type Gap struct {
+	Start    time.Time     `json:"start"`
+	End      time.Time     `json:"end"`
+	Duration time.Duration `json:"duration"`
+}
+
+// NewCmdGaps represents report gaps command
+func NewCmdGaps(f cmdutil.Factory) *cobra.Command {
+	var since, until, workHours, durationFormat string
+	var minGap time.Duration
+	var asJSON bool
+
+	cmd := &cobra.Command{
+		Use:   "gaps",
+		Short: "Lists the stretches of the work hours without time entries",
+		Long: heredoc.Doc(`
+			Lists the stretches of the work hours of each day between --since
+			and --until (inclusive) without time entries, to help finding
+			forgotten time before sending the timesheet.
+
+			When the workweek days are set on the config, only those days are
+			checked, see "clockify-cli config set workweek-days".
+		`),
+		Example: heredoc.Docf(`
+			# gaps of at least 15 minutes this month
+			$ %[1]s --since 2022-06-01 --min-gap 15m
+			+------------+----------+----------+----------+
+			|    DATE    |  START   |   END    | DURATION |
+			+------------+----------+----------+----------+
+			| 2022-06-01 | 12:00:00 | 13:00:00 |  1:00:00 |
+			| 2022-06-02 | 09:00:00 | 09:30:00 |  0:30:00 |
+			| TOTAL      |          |          |  1:30:00 |
+			+------------+----------+----------+----------+
+
+			# gaps of today, working from 08:00 to 17:00
+			$ %[1]s --work-hours 08:00-17:00
+		`, "clockify-cli report gaps"),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ws, we, err := parseWorkHours(workHours)
+			if err != nil {
+				return err
+			}
+
+			if minGap < 0 {
+				return cmdutil.FlagErrorWrap(
+					errors.New("`min-gap` must be a positive duration"))
+			}
+
+			df, err := timeentry.DurationFormatter(durationFormat)
+			if err != nil {
+				return cmdutil.FlagErrorWrap(err)
+			}
+
+			args := []string{timehlp.Today().Format("2006-01-02"), until}
+			if since != "" {
+				args[0] = since
+			}
+
+			start, end, err := util.ParseRangeArgs(args)
+			if err != nil {
+				return cmdutil.FlagErrorWrap(errors.Wrap(err,
+					"--since and --until must be dates like 2022-06-01"))
+			}
+
+			start = timehlp.TruncateDate(start)
+			end = timehlp.TruncateDate(end).Add(time.Hour * 24)
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			u, err := f.GetUserID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			log, err := c.LogRange(cmd.Context(), api.LogRangeParam{
+				Workspace:       w,
+				UserID:          u,
+				FirstDate:       start,
+				LastDate:        end,
+				PaginationParam: api.AllPages(),
+			})
+			if err != nil {
+				return err
+			}
+
+			gs := findGaps(log, start, end, ws, we, minGap,
+				f.Config().GetWorkWeekdays(), timehlp.Now())
+
+			if asJSON {
+				return json.NewEncoder(cmd.OutOrStdout()).Encode(gs)
+			}
+
+			return printGaps(gs, df, cmd.OutOrStdout())
+		},
+	}
+
+	cmd.Flags().StringVar(&since, "since", "",
+		"first day to check (default today)")
+	cmd.Flags().StringVar(&until, "until", "today", "last day to check")
+	cmd.Flags().DurationVar(&minGap, "min-gap", 0,
+		"only lists gaps at least this long (like: 15m)")
+	cmd.Flags().StringVar(&workHours, "work-hours", "09:00-18:00",
+		"when the work day starts and ends")
+	cmd.Flags().StringVar(&durationFormat, "duration-format", "hms",
+		"how durations are shown: "+
+			strings.Join(timeentry.DurationFormats, ", "))
+	_ = cmdcompl.AddFixedSuggestionsToFlag(cmd, "duration-format",
+		cmdcompl.ValidArgsSlide(timeentry.DurationFormats))
+	cmd.Flags().BoolVarP(&asJSON, "json", "j", false, "print as JSON")
+
+	return cmd
+}
+
+// parseWorkHours reads a range like 09:00-18:00 as durations since the
+// start of the day
+func parseWorkHours(s string) (time.Duration, time.Duration, error) {
+	err := cmdutil.FlagErrorWrap(errors.New(
+		"`work-hours` must be a range of times, like: 09:00-18:00"))
+
+	parts := strings.SplitN(s, "-", 2)
+	if len(parts) != 2 {
+		return 0, 0, err
+	}
+
+	r := make([]time.Duration, 2)
+	for i := range parts {
+		t, perr := time.Parse(
+			timehlp.SimplerOnlyTimeFormat, strings.TrimSpace(parts[i]))
+		if perr != nil {
+			return 0, 0, err
+		}
+
+		r[i] = time.Duration(t.Hour())*time.Hour +
+			time.Duration(t.Minute())*time.Minute
+	}
+
+	if r[0] >= r[1] {
+		return 0, 0, cmdutil.FlagErrorWrap(errors.New(
+			"`work-hours` must end after it starts"))
+	}
+
+	return r[0], r[1], nil
+}
+
+// findGaps looks for the stretches of the work hours (in local time) of each
+// day between start and end without any time entry; days not in the
+// workweek (when set) and the future are ignored
+func findGaps(
+	tes []dto.TimeEntry, start, end time.Time, ws, we, minGap time.Duration,
+	workweek []string, now time.Time,
+) []Gap {
+	sort.Slice(tes, func(i, j int) bool {
+		return tes[i].TimeInterval.Start.Before(tes[j].TimeInterval.Start)
+	})
+
+	gs := make([]Gap, 0)
+	add := func(s, e time.Time) {
+		if e.Sub(s) > 0 && e.Sub(s) >= minGap {
+			gs = append(gs, Gap{Start: s, End: e, Duration: e.Sub(s)})
+		}
+	}
+
+	for d := start; d.Before(end); d = d.AddDate(0, 0, 1) {
+		if len(workweek) > 0 && strhlp.Search(
+			strings.ToLower(d.Weekday().String()), workweek) == -1 {
+			continue
+		}
+
+		day := time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, time.Local)
+		cursor := day.Add(ws)
+		dayEnd := day.Add(we)
+		if dayEnd.After(now) {
+			dayEnd = now
+		}
+
+		for i := range tes {
+			if !cursor.Before(dayEnd) {
+				break
+			}
+
+			s := tes[i].TimeInterval.Start
+			e := now
+			if tes[i].TimeInterval.End != nil {
+				e = *tes[i].TimeInterval.End
+			}
+
+			if !e.After(cursor) {
+				continue
+			}
+
+			if s.After(dayEnd) {
+				break
+			}
+
+			if s.After(cursor) {
+				add(cursor, s)
+			}
+			cursor = e
+		}
+
+		if cursor.Before(dayEnd) {
+			add(cursor, dayEnd)
+		}
+	}
+
+	return gs
+}
+
+func printGaps(gs []Gap, df func(time.Duration) string, w io.Writer) error {
+	tw := tablewriter.NewWriter(w)
+	outpututil.SetThemedHeader(tw,
+		[]string{"Date", "Start", "End", "Duration"})
+	tw.SetColumnAlignment([]int{
+		tablewriter.ALIGN_LEFT, tablewriter.ALIGN_LEFT,
+		tablewriter.ALIGN_LEFT, tablewriter.ALIGN_RIGHT,
+	})
+
+	var total time.Duration
+	for _, g := range gs {
+		total += g.Duration
+		s := g.Start.In(time.Local)
+		tw.Append([]string{
+			s.Format("2006-01-02"),
+			s.Format(timehlp.OnlyTimeFormat),
+			g.End.In(time.Local).Format(timehlp.OnlyTimeFormat),
+			df(g.Duration),
+		})
+	}
+
+	colors := make([]tablewriter.Colors, 4)
+	for i := range colors {
+		colors[i] = outpututil.TotalColor()
+	}
+	tw.Rich([]string{"TOTAL", "", "", df(total)}, colors)
+	tw.Render()
+
+	return nil
+}
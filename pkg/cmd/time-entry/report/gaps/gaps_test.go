@@ -0,0 +1,153 @@
+package gaps_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/gaps"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func te(day, sh, sm, eh, em int) dto.TimeEntry {
+	s := time.Date(2022, 6, day, sh, sm, 0, 0, time.Local)
+	e := time.Date(2022, 6, day, eh, em, 0, 0, time.Local)
+	return dto.TimeEntry{TimeInterval: dto.TimeInterval{Start: s, End: &e}}
+}
+
+func TestCmdGaps(t *testing.T) {
+	log := []dto.TimeEntry{
+		te(2, 9, 30, 12, 0),
+		// overlapping the previous one
+		te(2, 11, 0, 12, 5),
+		te(2, 13, 0, 17, 50),
+		te(1, 8, 0, 12, 0),
+		te(1, 13, 0, 19, 0),
+		te(3, 8, 0, 18, 0),
+		// saturday, not on the workweek
+		te(4, 10, 0, 11, 0),
+	}
+
+	tts := []struct {
+		name string
+		args []string
+		out  string
+	}{
+		{
+			name: "table",
+			args: []string{"--min-gap", "15m"},
+			out: table(
+				"| 2022-06-01 | 12:00:00 | 13:00:00 |  1:00:00 |",
+				"| 2022-06-02 | 09:00:00 | 09:30:00 |  0:30:00 |",
+				"| 2022-06-02 | 12:05:00 | 13:00:00 |  0:55:00 |",
+				"2:25:00",
+			),
+		},
+		{
+			name: "duration format",
+			args: []string{"--duration-format", "minutes",
+				"--work-hours", "12:00-18:00"},
+			out: table(
+				"| 2022-06-01 | 12:00:00 | 13:00:00 |       60 |",
+				"| 2022-06-02 | 12:05:00 | 13:00:00 |       55 |",
+				"| 2022-06-02 | 17:50:00 | 18:00:00 |       10 |",
+				"125",
+			),
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			f := mocks.NewMockFactory(t)
+			f.EXPECT().GetWorkspaceID().Return("w", nil)
+			f.EXPECT().GetUserID().Return("u", nil)
+			f.EXPECT().Config().Return(&mocks.SimpleConfig{
+				WorkweekDays: []string{
+					"monday", "tuesday", "wednesday", "thursday", "friday"},
+			})
+
+			c := mocks.NewMockClient(t)
+			f.EXPECT().Client().Return(c, nil)
+			c.EXPECT().LogRange(mock.Anything, api.LogRangeParam{
+				Workspace:       "w",
+				UserID:          "u",
+				FirstDate:       time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC),
+				LastDate:        time.Date(2022, 6, 5, 0, 0, 0, 0, time.UTC),
+				PaginationParam: api.AllPages(),
+			}).
+				Return(append([]dto.TimeEntry{}, log...), nil)
+
+			cmd := gaps.NewCmdGaps(f)
+			out := bytes.NewBufferString("")
+			cmd.SetOut(out)
+			cmd.SetArgs(append([]string{
+				"--since", "2022-06-01", "--until", "2022-06-04"},
+				tt.args...))
+
+			_, err := cmd.ExecuteC()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.out, out.String())
+		})
+	}
+}
+
+func table(lines ...string) string {
+	total := lines[len(lines)-1]
+	sep := "+------------+----------+----------+----------+\n"
+	s := sep + "|    DATE    |  START   |   END    | DURATION |\n" + sep
+	for _, l := range lines[:len(lines)-1] {
+		s += l + "\n"
+	}
+
+	return s +
+		"| TOTAL      |          |          | " +
+		padLeft(total, 8) + " |\n" + sep
+}
+
+func padLeft(s string, n int) string {
+	for len(s) < n {
+		s = " " + s
+	}
+	return s
+}
+
+func TestCmdGapsInvalidFlags(t *testing.T) {
+	tts := []struct {
+		args []string
+		err  string
+	}{
+		{
+			args: []string{"--work-hours", "9"},
+			err:  "`work-hours` must be a range of times, like: 09:00-18:00",
+		},
+		{
+			args: []string{"--work-hours", "18:00-09:00"},
+			err:  "`work-hours` must end after it starts",
+		},
+		{
+			args: []string{"--min-gap", "-1m"},
+			err:  "`min-gap` must be a positive duration",
+		},
+		{
+			args: []string{"--since", "someday"},
+			err: "--since and --until must be dates like 2022-06-01: " +
+				`parsing time "someday" as "2006-01-02": ` +
+				`cannot parse "someday" as "2006"`,
+		},
+	}
+
+	for _, tt := range tts {
+		cmd := gaps.NewCmdGaps(mocks.NewMockFactory(t))
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+		cmd.SetArgs(tt.args)
+
+		_, err := cmd.ExecuteC()
+		assert.EqualError(t, err, tt.err)
+	}
+}
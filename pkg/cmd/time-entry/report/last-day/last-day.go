@@ -14,6 +14,8 @@ func NewCmdLastDay(f cmdutil.Factory) *cobra.Command {
 		Use:   "last-day",
 		Short: "List time entries from last day were a time entry was created",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
 			if err := of.Check(); err != nil {
 				return err
 			}
@@ -33,12 +35,13 @@ func NewCmdLastDay(f cmdutil.Factory) *cobra.Command {
 				return err
 			}
 
-			te, err := timeentryhlp.GetLatestEntryEntry(c, w, u)
+			te, err := timeentryhlp.GetLatestEntryEntry(ctx, c, w, u)
 			if err != nil {
 				return err
 			}
 
 			return util.ReportWithRange(
+				ctx,
 				f, te.TimeInterval.Start, te.TimeInterval.Start,
 				cmd.OutOrStdout(), of)
 		},
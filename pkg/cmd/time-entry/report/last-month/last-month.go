@@ -20,7 +20,8 @@ func NewCmdLastMonth(f cmdutil.Factory) *cobra.Command {
 
 			first, last := timehlp.GetMonthRange(
 				timehlp.Today().AddDate(0, -1, 0))
-			return util.ReportWithRange(f, first, last, cmd.OutOrStdout(), of)
+			return util.ReportWithRange(cmd.Context(), f, first, last,
+				cmd.OutOrStdout(), of)
 		},
 	}
 
@@ -37,6 +37,8 @@ func NewCmdLastWeekDay(f cmdutil.Factory) *cobra.Command {
 		),
 
 		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
 			if err := of.Check(); err != nil {
 				return err
 			}
@@ -49,7 +51,8 @@ func NewCmdLastWeekDay(f cmdutil.Factory) *cobra.Command {
 			day := timehlp.Today().Add(-1)
 			if strhlp.Search(
 				strings.ToLower(day.Weekday().String()), workweek) != -1 {
-				return util.ReportWithRange(f, day, day, cmd.OutOrStdout(), of)
+				return util.ReportWithRange(ctx, f, day, day,
+					cmd.OutOrStdout(), of)
 			}
 
 			dayWeekday := int(day.Weekday())
@@ -67,7 +70,8 @@ func NewCmdLastWeekDay(f cmdutil.Factory) *cobra.Command {
 
 			day = day.Add(
 				time.Duration(-24*(dayWeekday-lastWeekDay)) * time.Hour)
-			return util.ReportWithRange(f, day, day, cmd.OutOrStdout(), of)
+			return util.ReportWithRange(ctx, f, day, day,
+				cmd.OutOrStdout(), of)
 		},
 	}
 
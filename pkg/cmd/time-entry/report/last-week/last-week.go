@@ -20,7 +20,8 @@ func NewCmdLastWeek(f cmdutil.Factory) *cobra.Command {
 
 			first, last := timehlp.GetWeekRange(
 				timehlp.TruncateDate(timehlp.Today()).AddDate(0, 0, -7))
-			return util.ReportWithRange(f, first, last, cmd.OutOrStdout(), of)
+			return util.ReportWithRange(cmd.Context(), f, first, last,
+				cmd.OutOrStdout(), of)
 		},
 	}
 
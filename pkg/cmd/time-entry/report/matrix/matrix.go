@@ -0,0 +1,67 @@
+package matrix
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdMatrix represents report matrix command
+func NewCmdMatrix(f cmdutil.Factory) *cobra.Command {
+	of := util.NewReportFlags()
+	cmd := &cobra.Command{
+		Use:   "matrix [<start>] [<end>]",
+		Short: "Shows the durations of each project per day as a table",
+		Long: heredoc.Docf(`
+			Shows the durations of each project per day as a table
+
+			The projects are the rows and each day of the range is a column, with the totals of each project and day.
+
+			If no parameter is set, shows the current week, the arguments work like the ones of "report".
+
+			%s
+		`, util.HelpNamesForIds),
+		Example: heredoc.Doc(`
+			# durations of each project between two dates
+			$ clockify-cli report matrix 2022-06-20 2022-06-22
+			+--------------+----------------+----------------+----------------+---------+
+			|   PROJECT    | MON 2022-06-20 | TUE 2022-06-21 | WED 2022-06-22 |  TOTAL  |
+			+--------------+----------------+----------------+----------------+---------+
+			| Clockify Cli |        2:00:00 |                |        4:00:00 | 6:00:00 |
+			+--------------+----------------+----------------+----------------+---------+
+			| Special      |        0:30:00 |                |                | 0:30:00 |
+			+--------------+----------------+----------------+----------------+---------+
+			| TOTAL        |        2:30:00 |        0:00:00 |        4:00:00 | 6:30:00 |
+			+--------------+----------------+----------------+----------------+---------+
+
+			# durations as decimal hours of a month
+			$ clockify-cli report matrix 2022-06-01 2022-06-30 --duration-format decimal
+		`),
+		Args: cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			of.DateRange = len(args) > 0
+			of.Matrix = true
+			of.FillMissingDates = true
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			start, end := timehlp.GetWeekRange(timehlp.Today())
+			if len(args) > 0 {
+				var err error
+				if start, end, err = util.ParseRangeArgs(args); err != nil {
+					return err
+				}
+			}
+
+			return util.ReportWithRange(cmd.Context(), f, start, end,
+				cmd.OutOrStdout(), of)
+		},
+	}
+
+	util.AddReportFlags(f, cmd, &of)
+
+	return cmd
+}
@@ -1,17 +1,21 @@
 package report
 
 import (
-	"time"
-
 	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/detailed"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/gaps"
 	lastday "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/last-day"
 	lastmonth "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/last-month"
 	lastweek "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/last-week"
 	lastweekday "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/last-week-day"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/matrix"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/summary"
 	thismonth "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/this-month"
 	thisweek "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/this-week"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/timeline"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/today"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/weekly"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/yesterday"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
@@ -31,7 +35,8 @@ func NewCmdReport(f cmdutil.Factory) *cobra.Command {
 			Aliases today/now can be used for <end> argument to represent current date
 			Alias yesterday can be used for <end> argument to represent previous date
 
-			To choose a specific date to start or end use the format "2006-01-02"
+			To choose a specific date to start or end use the format "2006-01-02",
+			or expressions like "yesterday", "friday", "last friday" or "3 days ago"
 
 			%s
 			All the subcommands have the same flags to filter and format the time entries, but will act as aliases to relative date ranges.
@@ -95,6 +100,41 @@ func NewCmdReport(f cmdutil.Factory) *cobra.Command {
 			#  - since(s time.Time, [e time.Time]) => returns the time difference between the first and second time (or now if not set)
 			#  - until(e time.Time, [s time.Time]) => returns the time difference between the second and first time (or now if not set)
 			#  - yaml(interface{})                 => encodes a value to yaml
+			#
+			# there are also helpers, with the same names and arguments as the sprig library, to:
+			#
+			#  - strings:   upper, lower, title, trim, trimPrefix, trimSuffix, replace, contains, hasPrefix, hasSuffix, repeat, trunc, quote, split, join and list
+			#  - defaults:  default, empty, coalesce and ternary
+			#  - numbers:   add, sub, mul, div, mod, max, min, addf, subf, mulf, divf and round
+			#  - durations: duration, addDuration, subDuration, mulDuration, hours, minutes and seconds
+			#  - dates:     date, dateModify, addDate and startOfDay
+
+			# format the time entries as invoice lines, with the amount for a rate of 50 per hour
+			$ %[1]s 2022-06-23 --format '{{ .Project.Name | upper | printf "%%-12s" }} {{ round (hours .TimeInterval.Duration | mulf 50) 2 }}' \
+			    --format-footer 'TOTAL {{ round (hours .Total | mulf 50) 2 }}'
+			CLOCKIFY CLI 50
+			SPECIAL      50
+			CLOCKIFY CLI 50
+			TOTAL 150
+
+			# use a template stored in a file, or on the config as "templates.invoice"
+			$ %[1]s 2022-06-23 --format-file invoice.gotmpl
+			$ %[1]s 2022-06-23 --format-name invoice
+
+			# reporting all time entries started in the last 24 hours
+			$ %[1]s --last 24h
+
+			# share time entries as a single line, and print them later
+			$ %[1]s --encoded > payload.txt
+			$ %[1]s --input-encoded - --csv < payload.txt
+
+			# chart of the hours of each day, with a line at 8 hours
+			$ %[1]s this-week --chart --chart-target 8h
+			2022-06-20 Mon ###############|#### 10:00:00
+			2022-06-21 Tue ########       |      4:00:00
+
+			# write last month timesheet to be signed
+			$ %[1]s last-month --pdf timesheet.pdf
 
 			# show time spent on the project "Clockify CLI" as float
 			$ %[1]s 2022-06-23 --duration-float -p "clockify cli"
@@ -134,33 +174,32 @@ func NewCmdReport(f cmdutil.Factory) *cobra.Command {
 		Args:    cobra.MaximumNArgs(2),
 		Aliases: []string{"log"},
 		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			of.DateRange = len(args) > 0
 			if err := of.Check(); err != nil {
 				return err
 			}
 
-			var err error
+			if of.InputEncoded != "" {
+				return util.ReportEncoded(
+					f, cmd.InOrStdin(), cmd.OutOrStdout(), of)
+			}
 
-			start := timehlp.Today()
-			if len(args) > 0 {
-				start, err = time.Parse("2006-01-02", args[0])
-				if err != nil {
-					return err
-				}
+			if of.Last != 0 {
+				now := timehlp.Now()
+				return util.ReportWithRange(ctx, f, now.Add(-of.Last),
+					now,
+					cmd.OutOrStdout(), of)
 			}
 
-			end := start
-			if len(args) > 1 {
-				if args[1] == "now" || args[1] == "today" {
-					end = timehlp.Today()
-				} else if args[1] == "yesterday" {
-					end = timehlp.Today().Add(-1)
-				} else if end, err = time.Parse(
-					"2006-01-02", args[1]); err != nil {
-					return err
-				}
+			start, end, err := util.ParseRangeArgs(args)
+			if err != nil {
+				return err
 			}
 
 			return util.ReportWithRange(
+				ctx,
 				f, start, end, cmd.OutOrStdout(), of)
 		},
 	}
@@ -173,8 +212,20 @@ func NewCmdReport(f cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(lastweekday.NewCmdLastWeekDay(f))
 	cmd.AddCommand(today.NewCmdToday(f))
 	cmd.AddCommand(yesterday.NewCmdYesterday(f))
+	cmd.AddCommand(timeline.NewCmdTimeline(f))
+	cmd.AddCommand(matrix.NewCmdMatrix(f))
+	cmd.AddCommand(summary.NewCmdSummary(f))
+	cmd.AddCommand(detailed.NewCmdDetailed(f))
+	cmd.AddCommand(weekly.NewCmdWeekly(f))
+	cmd.AddCommand(gaps.NewCmdGaps(f))
 
 	util.AddReportFlags(f, cmd, &of)
+	cmd.Flags().DurationVar(&of.Last, "last", 0,
+		"list only time entries started in this duration until now "+
+			"(like: 24h, 168h); can't be used with <start> and <end>")
+	cmd.Flags().StringVar(&of.InputEncoded, "input-encoded", "",
+		"prints the time entries from a payload created with --encoded "+
+			"instead of fetching them (use - to read from stdin)")
 	_ = cmd.MarkFlagRequired("workspace")
 	_ = cmd.MarkFlagRequired("user-id")
 
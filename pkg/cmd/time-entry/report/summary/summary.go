@@ -0,0 +1,136 @@
+package summary
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api/reports"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	output "github.com/lucassabreu/clockify-cli/pkg/output/report"
+	timeentry "github.com/lucassabreu/clockify-cli/pkg/output/time-entry"
+	"github.com/spf13/cobra"
+)
+
+var groups = map[string]reports.Group{
+	"project":     reports.GroupProject,
+	"client":      reports.GroupClient,
+	"task":        reports.GroupTask,
+	"tag":         reports.GroupTag,
+	"user":        reports.GroupUser,
+	"date":        reports.GroupDate,
+	"month":       reports.GroupMonth,
+	"description": reports.GroupTimeEntry,
+}
+
+// NewCmdSummary represents report summary command
+func NewCmdSummary(f cmdutil.Factory) *cobra.Command {
+	rf := util.ReportsAPIFlags{}
+	gs := []string{}
+	cmd := &cobra.Command{
+		Use:   "summary [<start>] [<end>]",
+		Short: "Shows the durations and amounts of the time entries grouped, calculated by Clockify",
+		Long: heredoc.Docf(`
+			Shows the durations and amounts of the time entries grouped, calculated by Clockify's Reports API
+
+			The groups can be nested (up to three levels) using the flag --group, by default the time entries are grouped by project and description.
+			Using the flag --rounding the durations will be rounded following the settings of the workspace.
+
+			If no parameter is set, shows today's report, the arguments work like the ones of "report".
+
+			%s
+		`, util.HelpNamesForIds),
+		Example: heredoc.Doc(`
+			# durations of each project and task this month
+			$ clockify-cli report summary 2022-06-01 2022-06-30 --group project,task
+			+-----------------+----------+
+			|      GROUP      | DURATION |
+			+-----------------+----------+
+			| Clockify Cli    |  6:00:00 |
+			|   Development   |  4:00:00 |
+			|   Documentation |  2:00:00 |
+			| Special         |  0:30:00 |
+			|   (without)     |  0:30:00 |
+			| TOTAL           |  6:30:00 |
+			+-----------------+----------+
+
+			# durations of each user of a client as decimal hours
+			$ clockify-cli report summary 2022-06-01 2022-06-30 --all-users \
+				--client Acme --group user --duration-format decimal
+		`),
+		Args: cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			if err := rf.Check(); err != nil {
+				return err
+			}
+
+			if len(gs) > 3 {
+				return cmdutil.FlagErrorWrap(errors.New(
+					"`group` can't have more than three levels"))
+			}
+
+			p := reports.SummaryParam{Groups: make([]reports.Group, len(gs))}
+			for i := range gs {
+				g, ok := groups[strings.ToLower(strings.TrimSpace(gs[i]))]
+				if !ok {
+					return cmdutil.FlagErrorWrap(errors.New(
+						"`group` must be one of: " +
+							strings.Join(groupNames(), ", ")))
+				}
+				p.Groups[i] = g
+			}
+
+			start, end, err := util.ParseRangeArgs(args)
+			if err != nil {
+				return err
+			}
+
+			if p.Filter, err = util.ReportsAPIFilter(
+				ctx,
+				f, start, end, rf); err != nil {
+				return err
+			}
+
+			c, err := f.ReportsClient()
+			if err != nil {
+				return err
+			}
+
+			r, err := c.Summary(ctx, p)
+			if err != nil {
+				return err
+			}
+
+			if rf.JSON {
+				return output.JSONPrint(r, cmd.OutOrStdout())
+			}
+
+			df, err := timeentry.DurationFormatter(rf.DurationFormat)
+			if err != nil {
+				return err
+			}
+
+			return output.SummaryPrint(df)(r, cmd.OutOrStdout())
+		},
+	}
+
+	util.AddReportsAPIFlags(f, cmd, &rf)
+	cmd.Flags().StringSliceVarP(&gs, "group", "g", []string{},
+		"how the time entries will be grouped (up to three levels): "+
+			strings.Join(groupNames(), ", "))
+	_ = cmdcompl.AddFixedSuggestionsToFlag(cmd, "group",
+		cmdcompl.ValidArgsSlide(groupNames()))
+
+	return cmd
+}
+
+func groupNames() []string {
+	return []string{
+		"project", "client", "task", "tag", "user", "date", "month",
+		"description",
+	}
+}
@@ -0,0 +1,219 @@
+package summary_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/api/reports"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/summary"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCmdSummary(t *testing.T) {
+	start := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2022, 6, 30, 23, 59, 59, 999000000, time.UTC)
+
+	report := reports.SummaryReport{
+		Totals: []reports.Totals{{TotalTime: 9000, TotalAmount: 25000}},
+		GroupOne: []reports.SummaryGroup{
+			{
+				Name: "Clockify Cli", Duration: 7200, Amount: 20000,
+				Children: []reports.SummaryGroup{
+					{Name: "Development", Duration: 7200, Amount: 20000},
+				},
+			},
+			{Name: "", Duration: 1800, Amount: 5000},
+		},
+	}
+
+	tts := []struct {
+		name     string
+		args     string
+		err      string
+		expected string
+		factory  func(*testing.T) cmdutil.Factory
+	}{
+		{
+			name: "invalid group",
+			args: "--group week",
+			factory: func(t *testing.T) cmdutil.Factory {
+				return mocks.NewMockFactory(t)
+			},
+			err: "`group` must be one of: project, client, task, tag, " +
+				"user, date, month, description",
+		},
+		{
+			name: "too many groups",
+			args: "--group project,task,tag,user",
+			factory: func(t *testing.T) cmdutil.Factory {
+				return mocks.NewMockFactory(t)
+			},
+			err: "`group` can't have more than three levels",
+		},
+		{
+			name: "invalid amount",
+			args: "--amount all",
+			factory: func(t *testing.T) cmdutil.Factory {
+				return mocks.NewMockFactory(t)
+			},
+			err: "`amount` must be one of: earned, cost, profit or hide",
+		},
+		{
+			name: "user and all users",
+			args: "--user u --all-users",
+			factory: func(t *testing.T) cmdutil.Factory {
+				return mocks.NewMockFactory(t)
+			},
+			err: "the following flags can't be used together: " +
+				"`all-users` and `user`",
+		},
+		{
+			name: "fails on api",
+			args: "2022-06-01 2022-06-30",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetWorkspaceID").Return("w-id", nil)
+				f.On("GetUserID").Return("user-id", nil)
+
+				c := mocks.NewMockReportsClient(t)
+				f.On("ReportsClient").Return(c, nil)
+				c.On("Summary", mock.Anything, reports.SummaryParam{
+					Filter: reports.Filter{
+						Workspace:  "w-id",
+						Start:      start,
+						End:        end,
+						UserIDs:    []string{"user-id"},
+						ClientIDs:  []string{},
+						ProjectIDs: []string{},
+						TagIDs:     []string{},
+					},
+					Groups: []reports.Group{},
+				}).Return(reports.SummaryReport{}, errors.New("failed"))
+
+				return f
+			},
+			err: "failed",
+		},
+		{
+			name: "groups and names",
+			args: "2022-06-01 2022-06-30 -g project,task --rounding " +
+				"--amount earned --billable --all-users -p cli",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetWorkspaceID").Return("w-id", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("IsAllowNameForID").Return(true)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+				c.On("GetProjects", mock.Anything, api.GetProjectsParam{
+					Workspace:       "w-id",
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.Project{
+					{ID: "p1", Name: "Clockify Cli"},
+				}, nil)
+
+				b := true
+				rc := mocks.NewMockReportsClient(t)
+				f.On("ReportsClient").Return(rc, nil)
+				rc.On("Summary", mock.Anything, reports.SummaryParam{
+					Filter: reports.Filter{
+						Workspace:   "w-id",
+						Start:       start,
+						End:         end,
+						Rounding:    true,
+						AmountShown: reports.AmountEarned,
+						Billable:    &b,
+						UserIDs:     []string{},
+						ClientIDs:   []string{},
+						ProjectIDs:  []string{"p1"},
+						TagIDs:      []string{},
+					},
+					Groups: []reports.Group{
+						reports.GroupProject, reports.GroupTask},
+				}).Return(report, nil)
+
+				return f
+			},
+			expected: heredoc.Doc(`
+				+---------------+----------+--------+
+				|     GROUP     | DURATION | AMOUNT |
+				+---------------+----------+--------+
+				| Clockify Cli  |  2:00:00 | 200.00 |
+				|   Development |  2:00:00 | 200.00 |
+				| (without)     |  0:30:00 |  50.00 |
+				| TOTAL         |  2:30:00 | 250.00 |
+				+---------------+----------+--------+
+			`),
+		},
+		{
+			name: "as json",
+			args: "2022-06-01 2022-06-30 --json",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetWorkspaceID").Return("w-id", nil)
+				f.On("GetUserID").Return("user-id", nil)
+
+				c := mocks.NewMockReportsClient(t)
+				f.On("ReportsClient").Return(c, nil)
+				c.On("Summary", mock.Anything, reports.SummaryParam{
+					Filter: reports.Filter{
+						Workspace:  "w-id",
+						Start:      start,
+						End:        end,
+						UserIDs:    []string{"user-id"},
+						ClientIDs:  []string{},
+						ProjectIDs: []string{},
+						TagIDs:     []string{},
+					},
+					Groups: []reports.Group{},
+				}).Return(reports.SummaryReport{
+					Totals: []reports.Totals{{TotalTime: 1800}},
+				}, nil)
+
+				return f
+			},
+			expected: `{"totals":[{"totalTime":1800,` +
+				`"totalBillableTime":0,"entriesCount":0,"totalAmount":0}],` +
+				`"groupOne":null}` + "\n",
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := summary.NewCmdSummary(tt.factory(t))
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			b := bytes.NewBufferString("")
+			cmd.SetOut(b)
+			cmd.SetErr(b)
+			if tt.args == "" {
+				cmd.SetArgs([]string{})
+			} else {
+				cmd.SetArgs(strings.Split(tt.args, " "))
+			}
+
+			_, err := cmd.ExecuteC()
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, b.String())
+		})
+	}
+}
@@ -19,7 +19,8 @@ func NewCmdThisWeek(f cmdutil.Factory) *cobra.Command {
 			}
 
 			first, last := timehlp.GetWeekRange(timehlp.Today())
-			return util.ReportWithRange(f, first, last, cmd.OutOrStdout(), of)
+			return util.ReportWithRange(cmd.Context(), f, first, last,
+				cmd.OutOrStdout(), of)
 		},
 	}
 
@@ -0,0 +1,53 @@
+package timeline
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdTimeline represents report timeline command
+func NewCmdTimeline(f cmdutil.Factory) *cobra.Command {
+	of := util.NewReportFlags()
+	cmd := &cobra.Command{
+		Use:   "timeline [<start>] [<end>]",
+		Short: "Shows the time entries of each day as a timeline",
+		Long: heredoc.Docf(`
+			Shows the time entries of each day as a timeline
+
+			Each day is a bar across the hours of the day (or only the ones set with --timeline-hours), "#" are the parts of the day with a time entry and "!" the ones with overlapping time entries, making the gaps and overlaps easier to spot.
+
+			If no parameter is set, shows today's time entries, the arguments work like the ones of "report".
+
+			%s
+		`, util.HelpNamesForIds),
+		Example: heredoc.Doc(`
+			# timeline of the working hours of two days
+			$ COLUMNS=56 clockify-cli report timeline 2022-06-20 2022-06-21 --timeline-hours 8-18
+			                08 09 10 11 12 13 14 15 16 17 18
+			2022-06-20 Mon |######.#####!!!###............| 6:30:00
+			2022-06-21 Tue |......############............| 4:00:00
+		`),
+		Args: cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			of.DateRange = len(args) > 0
+			of.Timeline = true
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			start, end, err := util.ParseRangeArgs(args)
+			if err != nil {
+				return err
+			}
+
+			return util.ReportWithRange(cmd.Context(), f, start, end,
+				cmd.OutOrStdout(), of)
+		},
+	}
+
+	util.AddReportFlags(f, cmd, &of)
+
+	return cmd
+}
@@ -19,7 +19,8 @@ func NewCmdToday(f cmdutil.Factory) *cobra.Command {
 			}
 
 			today := timehlp.Today()
-			return util.ReportWithRange(f, today, today, cmd.OutOrStdout(), of)
+			return util.ReportWithRange(cmd.Context(), f, today, today,
+				cmd.OutOrStdout(), of)
 		},
 	}
 
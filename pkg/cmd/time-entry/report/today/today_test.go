@@ -14,6 +14,7 @@ import (
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/today"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestCmdToday(t *testing.T) {
@@ -63,7 +64,7 @@ func TestCmdToday(t *testing.T) {
 
 				c := mocks.NewMockClient(t)
 				f.On("Client").Return(c, nil)
-				c.On("LogRange", api.LogRangeParam{
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
 					Workspace:       "w-id",
 					UserID:          "user-id",
 					FirstDate:       first,
@@ -89,7 +90,7 @@ func TestCmdToday(t *testing.T) {
 
 				c := mocks.NewMockClient(t)
 				f.On("Client").Return(c, nil)
-				c.On("LogRange", api.LogRangeParam{
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
 					Workspace:       "w-id",
 					UserID:          "user-id",
 					FirstDate:       first,
@@ -121,7 +122,7 @@ func TestCmdToday(t *testing.T) {
 				c := mocks.NewMockClient(t)
 				f.On("Client").Return(c, nil)
 
-				c.On("GetProjects", api.GetProjectsParam{
+				c.On("GetProjects", mock.Anything, api.GetProjectsParam{
 					Workspace:       "w-id",
 					PaginationParam: api.AllPages(),
 				}).
@@ -133,7 +134,7 @@ func TestCmdToday(t *testing.T) {
 						nil,
 					)
 
-				c.On("LogRange", api.LogRangeParam{
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
 					Workspace:       "w-id",
 					UserID:          "user-id",
 					FirstDate:       first,
@@ -164,7 +165,7 @@ func TestCmdToday(t *testing.T) {
 				c := mocks.NewMockClient(t)
 				f.On("Client").Return(c, nil)
 
-				c.On("LogRange", api.LogRangeParam{
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
 					Workspace:       "w-id",
 					UserID:          "user-id",
 					FirstDate:       first,
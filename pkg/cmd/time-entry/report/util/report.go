@@ -1,8 +1,12 @@
 package util
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"io"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/lucassabreu/clockify-cli/api"
@@ -11,8 +15,12 @@ import (
 	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/github"
+	"github.com/lucassabreu/clockify-cli/pkg/gitlab"
+	output "github.com/lucassabreu/clockify-cli/pkg/output/time-entry"
 	"github.com/lucassabreu/clockify-cli/pkg/search"
 	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/lucassabreu/clockify-cli/strhlp"
 	"github.com/spf13/cobra"
 )
 
@@ -30,9 +38,51 @@ type ReportFlags struct {
 	Billable    bool
 	NotBillable bool
 
+	ManualOnly bool
+	TimerOnly  bool
+
+	OnlyLocked     bool
+	OnlyUnapproved bool
+
+	// Last keeps only the time entries started in this duration until now
+	Last time.Duration
+	// DateRange informs that a start or end date was set for the report
+	DateRange bool
+
+	// InputEncoded is a payload created with --encoded to be printed
+	// instead of fetching the time entries
+	InputEncoded string
+
+	// PDF is the file where a timesheet of the time entries will be written
+	PDF string
+
+	// Stream prints the time entries page by page as they are fetched,
+	// instead of loading all of them before printing
+	Stream bool
+
 	Description string
 	Project     string
 	TagIDs      []string
+
+	// GitHubRef keeps only the time entries referencing this GitHub issue
+	// or pull request on their description (like: owner/repo#123)
+	GitHubRef string
+	// GitLabRef keeps only the time entries referencing this GitLab issue
+	// or merge request on their description (like: group/project!42)
+	GitLabRef string
+
+	// NoBreaks removes the time entries on the project of the config
+	// break.project, and OnlyBreaks keeps only them
+	NoBreaks   bool
+	OnlyBreaks bool
+	// breakProject is the ID of the project for breaks
+	breakProject string
+
+	// Require are the fields every time entry must have (like project,
+	// task or description), when one is missing the report fails, unless
+	// DropInvalid is set, then the time entry is left out
+	Require     []string
+	DropInvalid bool
 }
 
 // Check will assure that there is no conflicting flag values
@@ -41,9 +91,117 @@ func (rf ReportFlags) Check() error {
 		return err
 	}
 
-	return cmdutil.XorFlag(map[string]bool{
+	if rf.Last < 0 {
+		return cmdutil.FlagErrorWrap(
+			errors.New("`last` must be a positive duration"))
+	}
+
+	if rf.Last != 0 && rf.DateRange {
+		return cmdutil.FlagErrorWrap(
+			errors.New("`last` can't be used with a date range"))
+	}
+
+	if rf.InputEncoded != "" && rf.DateRange {
+		return cmdutil.FlagErrorWrap(
+			errors.New("`input-encoded` can't be used with a date range"))
+	}
+
+	if rf.GitHubRef != "" {
+		if _, err := github.ParseRef(rf.GitHubRef); err != nil {
+			return cmdutil.FlagErrorWrap(errors.New(
+				"`gh` must be like owner/repo#123"))
+		}
+	}
+
+	if rf.GitLabRef != "" {
+		if _, err := gitlab.ParseRef(rf.GitLabRef); err != nil {
+			return cmdutil.FlagErrorWrap(errors.New(
+				"`gl` must be like group/project!42 or group/project#42"))
+		}
+	}
+
+	if rf.PDF != "" && rf.InputEncoded != "" {
+		return cmdutil.FlagErrorWrap(
+			errors.New("`pdf` can't be used with `input-encoded`"))
+	}
+
+	if rf.PDF != "" && rf.OutputFile != "" {
+		return cmdutil.FlagErrorWrap(
+			errors.New("`pdf` can't be used with `output-file`"))
+	}
+
+	outputs := rf.OutputFlags.Outputs()
+	outputs["pdf"] = rf.PDF != ""
+	if err := cmdutil.XorFlag(outputs); err != nil {
+		return err
+	}
+
+	for _, r := range rf.Require {
+		if !strhlp.InSlice(r, output.RequiredFields) {
+			return cmdutil.FlagErrorWrap(errors.New(
+				"`require` must be one of " +
+					strhlp.ListForHumans(output.RequiredFields)))
+		}
+	}
+
+	if rf.DropInvalid && len(rf.Require) == 0 {
+		return cmdutil.FlagErrorWrap(
+			errors.New("`drop-invalid` can only be used with `require`"))
+	}
+
+	if rf.Stream {
+		if len(rf.Require) > 0 && !rf.DropInvalid {
+			return cmdutil.FlagErrorWrap(errors.New(
+				"`stream` can only be used with `require` when " +
+					"`drop-invalid` is set"))
+		}
+
+		if !rf.Quiet && !rf.JSONLines {
+			return cmdutil.FlagErrorWrap(errors.New(
+				"`stream` can only be used with `quiet` or `jsonl`"))
+		}
+
+		for _, f := range []struct {
+			name string
+			set  bool
+		}{
+			{"fill-missing-dates", rf.FillMissingDates},
+			{"pdf", rf.PDF != ""},
+			{"output-file", rf.OutputFile != ""},
+			{"sort", rf.Sort != ""},
+			{"input-encoded", rf.InputEncoded != ""},
+		} {
+			if f.set {
+				return cmdutil.FlagErrorWrap(errors.New(
+					"`stream` can't be used with `" + f.name + "`"))
+			}
+		}
+	}
+
+	if err := cmdutil.XorFlag(map[string]bool{
+		"last":          rf.Last != 0,
+		"input-encoded": rf.InputEncoded != "",
+	}); err != nil {
+		return err
+	}
+
+	if err := cmdutil.XorFlag(map[string]bool{
 		"billable":     rf.Billable,
 		"not-billable": rf.NotBillable,
+	}); err != nil {
+		return err
+	}
+
+	if err := cmdutil.XorFlag(map[string]bool{
+		"no-breaks":   rf.NoBreaks,
+		"only-breaks": rf.OnlyBreaks,
+	}); err != nil {
+		return err
+	}
+
+	return cmdutil.XorFlag(map[string]bool{
+		"manual-only": rf.ManualOnly,
+		"timer-only":  rf.TimerOnly,
 	})
 }
 
@@ -73,15 +231,82 @@ func AddReportFlags(
 		"Will filter time entries using these tags")
 	_ = cmdcompl.AddSuggestionsToFlag(cmd, "tag",
 		cmdcomplutil.NewTagAutoComplete(f))
+	cmd.Flags().StringVar(&rf.GitHubRef, "gh", "",
+		"Will filter time entries referencing this GitHub issue or pull "+
+			"request on the description (like: owner/repo#123)")
+	cmd.Flags().StringVar(&rf.GitLabRef, "gl", "",
+		"Will filter time entries referencing this GitLab merge request or "+
+			"issue on the description (like: group/project!42)")
 
 	cmd.Flags().BoolVar(&rf.Billable, "billable", false,
 		"Will filter time entries that are billable")
 	cmd.Flags().BoolVar(&rf.NotBillable, "not-billable", false,
 		"Will filter time entries that are not billable")
+
+	cmd.Flags().StringVar(&rf.PDF, "pdf", "",
+		"writes a timesheet of the time entries into this PDF file, "+
+			"with lines to be signed")
+
+	cmd.Flags().BoolVar(&rf.OnlyLocked, "only-locked", false,
+		"Will filter time entries that are locked (approved or not)")
+	cmd.Flags().BoolVar(&rf.OnlyUnapproved, "only-unapproved", false,
+		"Will filter time entries that were not approved yet (open, "+
+			"pending or locked)")
+
+	cmd.Flags().BoolVar(&rf.ManualOnly, "manual-only", false,
+		"Will filter time entries that look manually added "+
+			"(start and end at whole minutes)")
+	cmd.Flags().BoolVar(&rf.TimerOnly, "timer-only", false,
+		"Will filter time entries that look tracked by a timer "+
+			"(running or with seconds on start or end)")
+
+	cmd.Flags().BoolVar(&rf.NoBreaks, "no-breaks", false,
+		"Will filter out time entries on the project for breaks (config "+
+			cmdutil.CONF_BREAK_PROJECT+")")
+	cmd.Flags().BoolVar(&rf.OnlyBreaks, "only-breaks", false,
+		"Will filter time entries on the project for breaks (config "+
+			cmdutil.CONF_BREAK_PROJECT+")")
+
+	cmd.Flags().StringSliceVar(&rf.Require, "require", []string{},
+		"fails if a time entry does not have these fields (one of: "+
+			strhlp.ListForHumans(output.RequiredFields)+")")
+	_ = cmdcompl.AddFixedSuggestionsToFlag(cmd, "require",
+		cmdcompl.ValidArgsSlide(output.RequiredFields))
+	cmd.Flags().BoolVar(&rf.DropInvalid, "drop-invalid", false,
+		"leaves out the time entries without the fields of --require, "+
+			"instead of failing")
+
+	cmd.Flags().BoolVar(&rf.Stream, "stream", false,
+		"prints the time entries as they are fetched, most recent first, "+
+			"without loading all of them (only with --quiet or --jsonl)")
+}
+
+// ParseRangeArgs reads the <start> and <end> arguments of the report
+// commands, when not informed the range is today; both accept dates like
+// 2006-01-02 or expressions like "yesterday" and "last friday"
+func ParseRangeArgs(args []string) (time.Time, time.Time, error) {
+	var err error
+
+	start := timehlp.Today()
+	if len(args) > 0 {
+		if start, err = timehlp.ConvertToDate(args[0]); err != nil {
+			return start, start, err
+		}
+	}
+
+	end := start
+	if len(args) > 1 {
+		if end, err = timehlp.ConvertToDate(args[1]); err != nil {
+			return start, end, err
+		}
+	}
+
+	return start, end, nil
 }
 
 // ReportWithRange fetches and prints out time entries
 func ReportWithRange(
+	ctx context.Context,
 	f cmdutil.Factory, start, end time.Time,
 	out io.Writer, rf ReportFlags,
 ) error {
@@ -102,6 +327,7 @@ func ReportWithRange(
 
 	if rf.Project != "" && f.Config().IsAllowNameForID() {
 		if rf.Project, err = search.GetProjectByName(
+			ctx,
 			c, workspace, rf.Project); err != nil {
 			return err
 		}
@@ -109,14 +335,36 @@ func ReportWithRange(
 
 	if len(rf.TagIDs) > 0 && f.Config().IsAllowNameForID() {
 		if rf.TagIDs, err = search.GetTagsByName(
+			ctx,
 			c, workspace, rf.TagIDs); err != nil {
 			return err
 		}
 	}
 
+	if rf.NoBreaks || rf.OnlyBreaks {
+		if rf.breakProject, err = breakProject(
+			ctx,
+			f.Config(), c, workspace); err != nil {
+			return err
+		}
+	}
+
 	start = timehlp.TruncateDate(start)
 	end = timehlp.TruncateDate(end).Add(time.Hour * 24)
-	log, err := c.LogRange(api.LogRangeParam{
+
+	if rf.Stream {
+		return reportStream(ctx, c, api.GetUserTimeEntriesParam{
+			Workspace:   workspace,
+			UserID:      userId,
+			Start:       &start,
+			End:         &end,
+			Description: rf.Description,
+			ProjectID:   rf.Project,
+			TagIDs:      rf.TagIDs,
+		}, out, rf)
+	}
+
+	log, err := c.LogRange(ctx, api.LogRangeParam{
 		Workspace:       workspace,
 		UserID:          userId,
 		FirstDate:       start,
@@ -131,8 +379,12 @@ func ReportWithRange(
 		return err
 	}
 
-	if rf.Billable || rf.NotBillable {
-		log = filterBilling(log, rf.Billable)
+	log = rf.filter(log, timehlp.Now())
+
+	if len(rf.Require) > 0 && !rf.DropInvalid {
+		if err := checkRequired(log, rf.Require); err != nil {
+			return err
+		}
 	}
 
 	sort.Slice(log, func(i, j int) bool {
@@ -158,6 +410,133 @@ func ReportWithRange(
 		log = append(log, fillMissing(nextDay, end)...)
 	}
 
+	if rf.PDF != "" {
+		return reportTimesheetPDF(ctx, c, workspace, userId,
+			start, end.AddDate(0, 0, -1), log, rf.PDF)
+	}
+
+	return util.PrintTimeEntries(
+		log, out, f.Config(), rf.OutputFlags)
+}
+
+// filter removes the time entries not matching the filter flags
+func (rf ReportFlags) filter(log []dto.TimeEntry, now time.Time) []dto.TimeEntry {
+	if rf.Billable || rf.NotBillable {
+		log = filterBilling(log, rf.Billable)
+	}
+
+	if rf.ManualOnly || rf.TimerOnly {
+		log = filterManual(log, rf.ManualOnly)
+	}
+
+	if rf.OnlyLocked {
+		log = filterLocked(log)
+	}
+
+	if rf.OnlyUnapproved {
+		log = filterUnapproved(log)
+	}
+
+	if rf.NoBreaks || rf.OnlyBreaks {
+		log = filterProject(log, rf.breakProject, rf.OnlyBreaks)
+	}
+
+	if rf.DropInvalid {
+		log = filterRequired(log, rf.Require)
+	}
+
+	if rf.Last != 0 {
+		log = filterStartedBetween(log, now.Add(-rf.Last), now)
+	}
+
+	if r, err := github.ParseRef(rf.GitHubRef); err == nil {
+		log = filterReferencing(log, r.Matcher())
+	}
+
+	if r, err := gitlab.ParseRef(rf.GitLabRef); err == nil {
+		log = filterReferencing(log, r.Matcher())
+	}
+
+	return log
+}
+
+// breakProject returns the ID of the project set for breaks
+func breakProject(
+	ctx context.Context,
+	conf cmdutil.Config, c api.Client, workspace string,
+) (string, error) {
+	p := conf.GetString(cmdutil.CONF_BREAK_PROJECT)
+	if p == "" {
+		return "", cmdutil.FlagErrorWrap(errors.New(
+			"`no-breaks` and `only-breaks` can only be used when the config " +
+				cmdutil.CONF_BREAK_PROJECT + " is set"))
+	}
+
+	if !conf.IsAllowNameForID() {
+		return p, nil
+	}
+
+	return search.GetProjectByName(ctx, c, workspace, p)
+}
+
+// reportStream prints the time entries one page at a time, as the API
+// returns them (most recent first)
+func reportStream(
+	ctx context.Context,
+	c api.Client, p api.GetUserTimeEntriesParam,
+	out io.Writer, rf ReportFlags,
+) error {
+	print := output.TimeEntriesPrintQuietly
+	if rf.JSONLines {
+		print = output.TimeEntriesJSONLinesPrint
+	}
+
+	now := timehlp.Now()
+	return output.TimeEntriesStreamPrint(c.TimeEntriesIter(ctx, p),
+		func(l []dto.TimeEntry, w io.Writer) error {
+			return print(rf.filter(l, now), w)
+		}, out)
+}
+
+func reportTimesheetPDF(
+	ctx context.Context,
+	c api.Client, workspace, userID string, start, end time.Time,
+	log []dto.TimeEntry, filename string,
+) error {
+	u, err := c.GetUser(ctx, api.GetUser{Workspace: workspace, UserID: userID})
+	if err != nil {
+		return err
+	}
+
+	return util.WriteFile(filename, func(w io.Writer) error {
+		return output.TimeEntriesTimesheetPDFPrint(output.TimesheetHeader{
+			User:  u.Name,
+			Start: start,
+			End:   end,
+		})(log, w)
+	})
+}
+
+// ReportEncoded prints out time entries decoded from a payload created with
+// the flag --encoded, if the payload is "-" it will be read from the input
+func ReportEncoded(
+	f cmdutil.Factory, in io.Reader, out io.Writer, rf ReportFlags,
+) error {
+	payload := rf.InputEncoded
+	if payload == "-" {
+		b, err := io.ReadAll(in)
+		if err != nil {
+			return err
+		}
+		payload = string(b)
+	}
+
+	log, err := output.DecodeTimeEntries(payload)
+	if err != nil {
+		return errors.New("input-encoded is not a valid payload: " +
+			err.Error())
+	}
+
 	return util.PrintTimeEntries(
 		log, out, f.Config(), rf.OutputFlags)
 }
@@ -173,6 +552,130 @@ func filterBilling(l []dto.TimeEntry, billable bool) []dto.TimeEntry {
 	return r
 }
 
+// isManualEntry guesses if a time entry was added manually, as Clockify does
+// not inform it, entries started and ended at whole minutes are considered
+// manual, because a timer will rarely stop at exactly zero seconds
+func isManualEntry(t dto.TimeEntry) bool {
+	if t.TimeInterval.End == nil {
+		return false
+	}
+
+	return t.TimeInterval.Start.Truncate(time.Minute).
+		Equal(t.TimeInterval.Start) &&
+		t.TimeInterval.End.Truncate(time.Minute).Equal(*t.TimeInterval.End)
+}
+
+func filterManual(l []dto.TimeEntry, manual bool) []dto.TimeEntry {
+	r := make([]dto.TimeEntry, 0, len(l))
+	for i := 0; i < len(l); i++ {
+		if isManualEntry(l[i]) == manual {
+			r = append(r, l[i])
+		}
+	}
+
+	return r
+}
+
+// filterReferencing keeps the time entries which description has a
+// reference, as informed by in
+func filterReferencing(
+	l []dto.TimeEntry, in func(string) bool) []dto.TimeEntry {
+	r := make([]dto.TimeEntry, 0, len(l))
+	for i := 0; i < len(l); i++ {
+		if in(l[i].Description) {
+			r = append(r, l[i])
+		}
+	}
+
+	return r
+}
+
+// filterProject keeps the time entries on the project, or the ones on other
+// projects when in is false
+func filterProject(l []dto.TimeEntry, id string, in bool) []dto.TimeEntry {
+	r := make([]dto.TimeEntry, 0, len(l))
+	for i := 0; i < len(l); i++ {
+		p := l[i].ProjectID
+		if p == "" && l[i].Project != nil {
+			p = l[i].Project.ID
+		}
+
+		if (p == id) == in {
+			r = append(r, l[i])
+		}
+	}
+
+	return r
+}
+
+// checkRequired fails listing the time entries missing any of the fields
+func checkRequired(l []dto.TimeEntry, fields []string) error {
+	var invalid []string
+	for i := 0; i < len(l); i++ {
+		if m := output.MissingFields(l[i], fields); len(m) > 0 {
+			invalid = append(invalid, fmt.Sprintf("%s (%s): %s",
+				l[i].ID,
+				l[i].TimeInterval.Start.In(time.Local).
+					Format(timehlp.FullTimeFormat),
+				strings.Join(m, ", ")))
+		}
+	}
+
+	if len(invalid) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%d time entries are missing required fields:\n%s",
+		len(invalid), strings.Join(invalid, "\n"))
+}
+
+// filterRequired keeps the time entries having all the fields
+func filterRequired(l []dto.TimeEntry, fields []string) []dto.TimeEntry {
+	r := make([]dto.TimeEntry, 0, len(l))
+	for i := 0; i < len(l); i++ {
+		if len(output.MissingFields(l[i], fields)) == 0 {
+			r = append(r, l[i])
+		}
+	}
+
+	return r
+}
+
+func filterLocked(l []dto.TimeEntry) []dto.TimeEntry {
+	r := make([]dto.TimeEntry, 0, len(l))
+	for i := 0; i < len(l); i++ {
+		if l[i].IsLocked {
+			r = append(r, l[i])
+		}
+	}
+
+	return r
+}
+
+func filterUnapproved(l []dto.TimeEntry) []dto.TimeEntry {
+	r := make([]dto.TimeEntry, 0, len(l))
+	for i := 0; i < len(l); i++ {
+		if l[i].ApprovalStatus() != dto.ApprovalStatusApproved {
+			r = append(r, l[i])
+		}
+	}
+
+	return r
+}
+
+func filterStartedBetween(
+	l []dto.TimeEntry, first, last time.Time) []dto.TimeEntry {
+	r := make([]dto.TimeEntry, 0, len(l))
+	for i := 0; i < len(l); i++ {
+		s := l[i].TimeInterval.Start
+		if !s.Before(first) && !s.After(last) {
+			r = append(r, l[i])
+		}
+	}
+
+	return r
+}
+
 func fillMissing(first, last time.Time) []dto.TimeEntry {
 	first = timehlp.TruncateDate(first)
 	last = timehlp.TruncateDate(last)
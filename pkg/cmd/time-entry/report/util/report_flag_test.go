@@ -2,6 +2,7 @@ package util_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/util"
 	"github.com/stretchr/testify/assert"
@@ -26,4 +27,386 @@ func TestReportFlagsChecks(t *testing.T) {
 	rf.NotBillable = false
 
 	assert.NoError(t, rf.Check())
+
+	rf.ManualOnly = true
+	rf.TimerOnly = true
+
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t,
+		"can't be used together.*manual-only.*timer-only", err.Error())
+
+	rf.TimerOnly = false
+	assert.NoError(t, rf.Check())
+
+	rf.NoBreaks = true
+	rf.OnlyBreaks = true
+
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t,
+		"can't be used together.*no-breaks.*only-breaks", err.Error())
+
+	rf.OnlyBreaks = false
+	assert.NoError(t, rf.Check())
+	rf.NoBreaks = false
+
+	rf.Require = []string{"project", "client"}
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "`require` must be one of project, task, "+
+		"description and tags", err.Error())
+
+	rf.Require = []string{}
+	rf.DropInvalid = true
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "`drop-invalid` can only be used with `require`",
+		err.Error())
+
+	rf.Require = []string{"project", "task"}
+	assert.NoError(t, rf.Check())
+	rf.Require = nil
+	rf.DropInvalid = false
+
+	rf.Last = time.Hour * 24
+	rf.DateRange = true
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "`last` can't be used with a date range", err.Error())
+
+	rf.DateRange = false
+	assert.NoError(t, rf.Check())
+
+	rf.GitHubRef = "#12"
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "`gh` must be like owner/repo#123", err.Error())
+
+	rf.GitHubRef = "acme/cli#12"
+	assert.NoError(t, rf.Check())
+	rf.GitHubRef = ""
+
+	rf.GitLabRef = "!42"
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "`gl` must be like group/project!42", err.Error())
+
+	rf.GitLabRef = "acme/tools/cli!42"
+	assert.NoError(t, rf.Check())
+	rf.GitLabRef = ""
+
+	rf.FormatHeader = "<ul>"
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "can only be used with `format`", err.Error())
+
+	rf.Format = "<li>{{ .ID }}</li>"
+	assert.NoError(t, rf.Check())
+
+	rf.Format = ""
+	rf.FormatHeader = ""
+	rf.GroupBy = "week"
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "`group-by` must be one of: client, day, project, "+
+		"tag and task", err.Error())
+
+	rf.GroupBy = "project"
+	rf.Quiet = true
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "`group-by` can only be used with the table",
+		err.Error())
+
+	rf.Quiet = false
+	assert.NoError(t, rf.Check())
+
+	rf.HTML = true
+	assert.NoError(t, rf.Check())
+
+	rf.DurationFormat = "days"
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, `duration format "days" is not valid`, err.Error())
+
+	rf.DurationFormat = "iso"
+	assert.NoError(t, rf.Check())
+
+	rf.TimeZone = "Mars/Olympus_Mons"
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "`time-zone` must be a IANA time zone name", err.Error())
+
+	rf.TimeZone = "UTC"
+	assert.NoError(t, rf.Check())
+
+	rf.Locale = "tlh"
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "locale \"tlh\" is not supported", err.Error())
+
+	rf.Locale = "en-GB"
+	assert.NoError(t, rf.Check())
+
+	rf.CSVDelimiter = ";"
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "`csv-delimiter` and `csv-no-header` can only be used "+
+		"with `csv`", err.Error())
+
+	rf.HTML = false
+	rf.CSV = true
+	rf.CSVDelimiter = ";;"
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "`csv-delimiter` must be a single character",
+		err.Error())
+
+	rf.CSVDelimiter = "tab"
+	rf.CSVNoHeader = true
+	assert.NoError(t, rf.Check())
+
+	rf.CSV = false
+	rf.TSV = true
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "`csv-delimiter` and `csv-no-header` can only be used "+
+		"with `csv`", err.Error())
+
+	rf.CSVDelimiter = ""
+	rf.GroupBy = ""
+	assert.NoError(t, rf.Check())
+	rf.GroupBy = "project"
+	rf.TSV = false
+	rf.CSV = true
+	rf.CSV = false
+	rf.CSVDelimiter = ""
+	rf.CSVNoHeader = false
+
+	rf.ShowAmounts = true
+	rf.Quiet = true
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "`show-amounts` can only be used with the table",
+		err.Error())
+
+	rf.Quiet = false
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "`show-amounts` can't be used with `group-by`",
+		err.Error())
+
+	rf.ShowAmounts = false
+
+	rf.GroupBy = "project"
+	rf.TagSplit = true
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "`tag-split` can only be used with `group-by tag`",
+		err.Error())
+
+	rf.GroupBy = "tag"
+	rf.GroupSummary = true
+	assert.NoError(t, rf.Check())
+
+	rf.JSON = true
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "`group-summary` can only be used with the table",
+		err.Error())
+
+	rf.JSON = false
+	rf.GroupBy = ""
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "`group-summary` and `tag-split` can only be used "+
+		"with `group-by`", err.Error())
+
+	rf.GroupSummary = false
+	rf.TagSplit = false
+	rf.GroupBy = "project"
+
+	rf.HTML = true
+	rf.NoTruncate = true
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "`no-truncate`, `table-width` and `max-width` can "+
+		"only be used with the table output", err.Error())
+
+	rf.HTML = false
+	assert.NoError(t, rf.Check())
+	rf.NoTruncate = false
+
+	for _, set := range []func(bool){
+		func(b bool) { rf.JSON = b },
+		func(b bool) { rf.CSV = b },
+		func(b bool) { rf.Quiet = b },
+	} {
+		set(true)
+		rf.HoursPerDay = 8
+		err = rf.Check()
+		assert.Error(t, err)
+		assert.Regexp(t, "`days-as-unit` and `shift-end` can only be used "+
+			"with the table or `plain` outputs", err.Error())
+		rf.HoursPerDay = 0
+
+		rf.ShiftEnd = "04:00"
+		err = rf.Check()
+		assert.Error(t, err)
+		assert.Regexp(t, "`days-as-unit` and `shift-end` can only be used "+
+			"with the table or `plain` outputs", err.Error())
+		rf.ShiftEnd = ""
+		set(false)
+	}
+
+	rf.HoursPerDay = 8
+	rf.ShiftEnd = "04:00"
+	assert.NoError(t, rf.Check())
+
+	rf.GroupBy = ""
+	rf.Plain = true
+	assert.NoError(t, rf.Check())
+	rf.Plain = false
+	rf.GroupBy = "project"
+
+	rf.GroupSummary = true
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "`days-as-unit` and `shift-end` can't be used with "+
+		"`group-summary`", err.Error())
+	rf.GroupSummary = false
+	rf.HoursPerDay = 0
+	rf.ShiftEnd = ""
+
+	rf.RoundUp = true
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "`round-up` and `round-nearest` can only be used with "+
+		"`round`", err.Error())
+
+	rf.Round = 15 * time.Minute
+	rf.RoundNearest = true
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t,
+		"can't be used together.*round-nearest.*round-up", err.Error())
+
+	rf.RoundNearest = false
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "`round` can't be used with `group-by`", err.Error())
+
+	rf.GroupBy = ""
+	assert.NoError(t, rf.Check())
+
+	rf.JSON = true
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "`round` can only be used with the table", err.Error())
+
+	rf.JSON = false
+	rf.Round = -time.Minute
+	rf.RoundUp = false
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "`round` must be a positive duration", err.Error())
+
+	rf.Round = 0
+	rf.TimelineHours = "8-18"
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "`timeline-hours` can only be used with `timeline`",
+		err.Error())
+
+	rf.Timeline = true
+	rf.TimelineHours = "18-8"
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "`timeline-hours` must be a range of hours", err.Error())
+
+	rf.TimelineHours = "8-18"
+	assert.NoError(t, rf.Check())
+	rf.Timeline = false
+	rf.TimelineHours = ""
+
+	rf.ChartTarget = 8 * time.Hour
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "`chart-target` can only be used with `chart`",
+		err.Error())
+	rf.ChartTarget = 0
+
+	rf.GroupBy = "project"
+
+	rf.OutputFile = "report.txt"
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "can't infer the output of `output-file` from \".txt\"",
+		err.Error())
+
+	rf.CSV = true
+	assert.NoError(t, rf.Check())
+	rf.CSV = false
+
+	rf.OutputFile = "report.json"
+	rf.GroupBy = "project"
+	assert.NoError(t, rf.Check())
+
+	rf.OutputFile = "report.md"
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "`group-by` can only be used with the table",
+		err.Error())
+	rf.OutputFile = ""
+
+	rf.Last = 0
+	rf.PDF = "timesheet.pdf"
+	rf.InputEncoded = "-"
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "`pdf` can't be used with `input-encoded`", err.Error())
+
+	rf.InputEncoded = ""
+	rf.CSV = true
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "flags can't be used together: `csv` and `pdf`",
+		err.Error())
+}
+
+func TestReportFlagsCheckStream(t *testing.T) {
+	rf := util.NewReportFlags()
+	rf.Stream = true
+
+	err := rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "`stream` can only be used with `quiet` or `jsonl`",
+		err.Error())
+
+	rf.JSONLines = true
+	assert.NoError(t, rf.Check())
+
+	rf.Require = []string{"task"}
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "`stream` can only be used with `require` when "+
+		"`drop-invalid` is set", err.Error())
+
+	rf.DropInvalid = true
+	assert.NoError(t, rf.Check())
+	rf.Require = nil
+	rf.DropInvalid = false
+
+	rf.FillMissingDates = true
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "`stream` can't be used with `fill-missing-dates`",
+		err.Error())
+
+	rf.FillMissingDates = false
+	rf.Sort = "duration"
+	err = rf.Check()
+	assert.Error(t, err)
+	assert.Regexp(t, "`stream` can't be used with `sort`", err.Error())
 }
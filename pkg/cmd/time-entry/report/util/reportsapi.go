@@ -0,0 +1,207 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/reports"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	timeentry "github.com/lucassabreu/clockify-cli/pkg/output/time-entry"
+	"github.com/lucassabreu/clockify-cli/pkg/search"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/spf13/cobra"
+)
+
+// amountsShown are the values accepted by the flag --amount
+var amountsShown = map[string]reports.AmountShown{
+	"earned": reports.AmountEarned,
+	"cost":   reports.AmountCost,
+	"profit": reports.AmountProfit,
+	"hide":   reports.AmountHide,
+}
+
+// ReportsAPIFlags reads the flags of the reports aggregated by Clockify's
+// Reports API
+type ReportsAPIFlags struct {
+	JSON           bool
+	DurationFormat string
+
+	// Rounding applies the rounding settings of the workspace
+	Rounding bool
+	Amount   string
+
+	Billable    bool
+	NotBillable bool
+
+	Description string
+	Users       []string
+	AllUsers    bool
+	Clients     []string
+	Projects    []string
+	TagIDs      []string
+}
+
+// Check will assure that there is no conflicting flag values
+func (rf ReportsAPIFlags) Check() error {
+	if rf.JSON && rf.DurationFormat != "" {
+		return cmdutil.FlagErrorWrap(errors.New(
+			"`duration-format` can't be used with `json`"))
+	}
+
+	if _, err := timeentry.DurationFormatter(rf.DurationFormat); err != nil {
+		return cmdutil.FlagErrorWrap(err)
+	}
+
+	if _, ok := amountsShown[strings.ToLower(rf.Amount)]; rf.Amount != "" &&
+		!ok {
+		return cmdutil.FlagErrorWrap(errors.New(
+			"`amount` must be one of: earned, cost, profit or hide"))
+	}
+
+	if err := cmdutil.XorFlag(map[string]bool{
+		"user":      len(rf.Users) > 0,
+		"all-users": rf.AllUsers,
+	}); err != nil {
+		return err
+	}
+
+	return cmdutil.XorFlag(map[string]bool{
+		"billable":     rf.Billable,
+		"not-billable": rf.NotBillable,
+	})
+}
+
+// AddReportsAPIFlags add flags to filter and print out the reports of the
+// Reports API
+func AddReportsAPIFlags(
+	f cmdutil.Factory, cmd *cobra.Command, rf *ReportsAPIFlags,
+) {
+	cmd.Flags().BoolVarP(&rf.JSON, "json", "j", false,
+		"print as JSON, as it was returned by the API")
+	cmd.Flags().StringVar(&rf.DurationFormat, "duration-format", "",
+		"how durations will be shown: "+
+			strings.Join(timeentry.DurationFormats, ", "))
+
+	cmd.Flags().BoolVar(&rf.Rounding, "rounding", false,
+		"apply the rounding settings of the workspace to the durations")
+	cmd.Flags().StringVar(&rf.Amount, "amount", "",
+		"which amount will be calculated: earned, cost, profit or hide")
+	_ = cmdcompl.AddFixedSuggestionsToFlag(cmd, "amount",
+		cmdcompl.ValidArgsSlide{"earned", "cost", "profit", "hide"})
+
+	cmd.Flags().StringVarP(&rf.Description, "description", "d", "",
+		"will filter time entries that contains this on the description field")
+	cmd.Flags().StringSliceVarP(&rf.Projects, "project", "p", []string{},
+		"will filter time entries using these projects")
+	_ = cmdcompl.AddSuggestionsToFlag(cmd, "project",
+		cmdcomplutil.NewProjectAutoComplete(f))
+	cmd.Flags().StringSliceVarP(&rf.Clients, "client", "c", []string{},
+		"will filter time entries of projects of these clients")
+	_ = cmdcompl.AddSuggestionsToFlag(cmd, "client",
+		cmdcomplutil.NewClientAutoComplete(f))
+	cmd.Flags().StringSliceVarP(&rf.TagIDs, "tag", "T", []string{},
+		"will filter time entries using these tags")
+	_ = cmdcompl.AddSuggestionsToFlag(cmd, "tag",
+		cmdcomplutil.NewTagAutoComplete(f))
+	cmd.Flags().StringSliceVar(&rf.Users, "user", []string{},
+		"will filter time entries of these users, instead of the "+
+			"current user")
+	_ = cmdcompl.AddSuggestionsToFlag(cmd, "user",
+		cmdcomplutil.NewUserAutoComplete(f))
+	cmd.Flags().BoolVar(&rf.AllUsers, "all-users", false,
+		"will not filter the time entries by user")
+
+	cmd.Flags().BoolVar(&rf.Billable, "billable", false,
+		"Will filter time entries that are billable")
+	cmd.Flags().BoolVar(&rf.NotBillable, "not-billable", false,
+		"Will filter time entries that are not billable")
+}
+
+// ReportsAPIFilter builds the filter used by the Reports API from the flags,
+// looking up the ids of the names informed when allowed. The range goes from
+// the start of the first day until the end of the last
+func ReportsAPIFilter(
+	ctx context.Context,
+	f cmdutil.Factory, start, end time.Time, rf ReportsAPIFlags,
+) (reports.Filter, error) {
+	w, err := f.GetWorkspaceID()
+	if err != nil {
+		return reports.Filter{}, err
+	}
+
+	filter := reports.Filter{
+		Workspace: w,
+		Start:     timehlp.TruncateDate(start),
+		End: timehlp.TruncateDate(end).
+			Add(24*time.Hour - time.Millisecond),
+		Description: rf.Description,
+		Rounding:    rf.Rounding,
+		AmountShown: amountsShown[strings.ToLower(rf.Amount)],
+		UserIDs:     rf.Users,
+		ClientIDs:   rf.Clients,
+		ProjectIDs:  rf.Projects,
+		TagIDs:      rf.TagIDs,
+	}
+
+	if rf.Billable || rf.NotBillable {
+		b := rf.Billable
+		filter.Billable = &b
+	}
+
+	if len(filter.UserIDs) == 0 && !rf.AllUsers {
+		u, err := f.GetUserID()
+		if err != nil {
+			return filter, err
+		}
+
+		filter.UserIDs = []string{u}
+	}
+
+	if len(rf.Users)+len(rf.Clients)+len(rf.Projects)+len(rf.TagIDs) == 0 ||
+		!f.Config().IsAllowNameForID() {
+		return filter, nil
+	}
+
+	c, err := f.Client()
+	if err != nil {
+		return filter, err
+	}
+
+	if len(rf.Users) > 0 {
+		if filter.UserIDs, err = search.GetUsersByName(
+			ctx,
+			c, w, filter.UserIDs); err != nil {
+			return filter, err
+		}
+	}
+
+	if len(rf.Clients) > 0 {
+		if filter.ClientIDs, err = search.GetClientsByName(
+			ctx,
+			c, w, filter.ClientIDs); err != nil {
+			return filter, err
+		}
+	}
+
+	if len(rf.Projects) > 0 {
+		if filter.ProjectIDs, err = search.GetProjectsByName(
+			ctx,
+			c, w, filter.ProjectIDs); err != nil {
+			return filter, err
+		}
+	}
+
+	if len(rf.TagIDs) > 0 {
+		if filter.TagIDs, err = search.GetTagsByName(
+			ctx,
+			c, w, filter.TagIDs); err != nil {
+			return filter, err
+		}
+	}
+
+	return filter, nil
+}
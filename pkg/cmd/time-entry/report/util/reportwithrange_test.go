@@ -1,8 +1,16 @@
 package util_test
 
 import (
+	"archive/zip"
 	"bytes"
+	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,7 +20,10 @@ import (
 	"github.com/lucassabreu/clockify-cli/internal/mocks"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/util"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	output "github.com/lucassabreu/clockify-cli/pkg/output/time-entry"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func newDate(s string) time.Time {
@@ -38,6 +49,7 @@ func TestReportWithRange(t *testing.T) {
 		factory  func(*testing.T) cmdutil.Factory
 		flags    func(*testing.T) util.ReportFlags
 		expected string
+		contains []string
 		err      string
 	}{
 		{
@@ -93,7 +105,7 @@ func TestReportWithRange(t *testing.T) {
 				c := mocks.NewMockClient(t)
 				f.On("Client").Return(c, nil)
 
-				c.On("GetProjects", api.GetProjectsParam{
+				c.On("GetProjects", mock.Anything, api.GetProjectsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).Return([]dto.Project{}, errors.New("http error"))
@@ -121,7 +133,7 @@ func TestReportWithRange(t *testing.T) {
 				c := mocks.NewMockClient(t)
 				f.On("Client").Return(c, nil)
 
-				c.On("GetProjects", api.GetProjectsParam{
+				c.On("GetProjects", mock.Anything, api.GetProjectsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).Return([]dto.Project{{Name: "right"}}, nil)
@@ -149,12 +161,12 @@ func TestReportWithRange(t *testing.T) {
 				c := mocks.NewMockClient(t)
 				f.On("Client").Return(c, nil)
 
-				c.On("GetProjects", api.GetProjectsParam{
+				c.On("GetProjects", mock.Anything, api.GetProjectsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).Return([]dto.Project{{ID: "p", Name: "right"}}, nil)
 
-				c.On("LogRange", api.LogRangeParam{
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
 					Workspace:       "w",
 					UserID:          "u",
 					ProjectID:       "p",
@@ -187,7 +199,7 @@ func TestReportWithRange(t *testing.T) {
 				c := mocks.NewMockClient(t)
 				f.On("Client").Return(c, nil)
 
-				c.On("LogRange", api.LogRangeParam{
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
 					Workspace:       "w",
 					UserID:          "u",
 					ProjectID:       "p",
@@ -229,7 +241,7 @@ func TestReportWithRange(t *testing.T) {
 				c := mocks.NewMockClient(t)
 				f.On("Client").Return(c, nil)
 
-				c.On("LogRange", api.LogRangeParam{
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
 					Workspace:       "w",
 					UserID:          "u",
 					FirstDate:       first,
@@ -272,7 +284,7 @@ func TestReportWithRange(t *testing.T) {
 				c := mocks.NewMockClient(t)
 				f.On("Client").Return(c, nil)
 
-				c.On("LogRange", api.LogRangeParam{
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
 					Workspace:       "w",
 					UserID:          "u",
 					FirstDate:       first,
@@ -310,7 +322,7 @@ func TestReportWithRange(t *testing.T) {
 				c := mocks.NewMockClient(t)
 				f.On("Client").Return(c, nil)
 
-				c.On("LogRange", api.LogRangeParam{
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
 					Workspace:       "w",
 					UserID:          "u",
 					FirstDate:       first,
@@ -349,12 +361,12 @@ func TestReportWithRange(t *testing.T) {
 				f.On("Client").Return(c, nil)
 
 				tag := dto.Tag{ID: "t1", Name: "Client"}
-				c.On("GetTags", api.GetTagsParam{
+				c.On("GetTags", mock.Anything, api.GetTagsParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).Return([]dto.Tag{tag}, nil)
 
-				c.On("LogRange", api.LogRangeParam{
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
 					Workspace:       "w",
 					UserID:          "u",
 					FirstDate:       first,
@@ -382,12 +394,3640 @@ func TestReportWithRange(t *testing.T) {
 				te-4
 			`),
 		},
+		{
+			name: "manual only",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				manualEnd := first.Add(time.Hour)
+				timerEnd := first.Add(time.Hour + 13*time.Second)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", TimeInterval: dto.TimeInterval{
+						Start: first, End: &manualEnd}},
+					{ID: "te-2", TimeInterval: dto.TimeInterval{
+						Start: first, End: &timerEnd}},
+					{ID: "te-3", TimeInterval: dto.TimeInterval{
+						Start: first}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.ManualOnly = true
+				rf.Quiet = true
+				return rf
+			},
+			expected: heredoc.Doc(`
+				te-1
+			`),
+		},
+		{
+			name: "github reference",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", Description: "Fix login (acme/cli#12)",
+						TimeInterval: dto.TimeInterval{Start: first}},
+					{ID: "te-2", Description: "Add docs (acme/cli#123)",
+						TimeInterval: dto.TimeInterval{Start: first}},
+					{ID: "te-3", Description: "ACME/CLI#12 review",
+						TimeInterval: dto.TimeInterval{Start: first}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.GitHubRef = "acme/cli#12"
+				rf.Quiet = true
+				return rf
+			},
+			expected: heredoc.Doc(`
+				te-1
+				te-3
+			`),
+		},
+		{
+			name: "gitlab reference",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", Description: "Add docs (acme/cli!42)",
+						TimeInterval: dto.TimeInterval{Start: first}},
+					{ID: "te-2", Description: "Fix login (acme/cli#42)",
+						TimeInterval: dto.TimeInterval{Start: first}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.GitLabRef = "acme/cli!42"
+				rf.Quiet = true
+				return rf
+			},
+			expected: heredoc.Doc(`
+				te-1
+			`),
+		},
+		{
+			name: "breaks without project",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("GetString", cmdutil.CONF_BREAK_PROJECT).Return("")
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.NoBreaks = true
+				return rf
+			},
+			err: "`no-breaks` and `only-breaks` can only be used when " +
+				"the config break.project is set",
+		},
+		{
+			name: "no breaks",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("GetString", cmdutil.CONF_BREAK_PROJECT).
+					Return("Break")
+				cf.On("IsAllowNameForID").Return(true)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				c.On("GetProjects", mock.Anything, api.GetProjectsParam{
+					Workspace:       "w",
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.Project{{ID: "p-break", Name: "Break"}}, nil)
+
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", Project: &dto.Project{ID: "p-work"},
+						TimeInterval: dto.TimeInterval{Start: first}},
+					{ID: "te-2", Project: &dto.Project{ID: "p-break"},
+						TimeInterval: dto.TimeInterval{Start: first}},
+					{ID: "te-3",
+						TimeInterval: dto.TimeInterval{Start: first}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.NoBreaks = true
+				rf.Quiet = true
+				return rf
+			},
+			expected: heredoc.Doc(`
+				te-1
+				te-3
+			`),
+		},
+		{
+			name: "only breaks",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("GetString", cmdutil.CONF_BREAK_PROJECT).
+					Return("p-break")
+				cf.On("IsAllowNameForID").Return(false)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", Project: &dto.Project{ID: "p-work"},
+						TimeInterval: dto.TimeInterval{Start: first}},
+					{ID: "te-2", ProjectID: "p-break",
+						TimeInterval: dto.TimeInterval{Start: first}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.OnlyBreaks = true
+				rf.Quiet = true
+				return rf
+			},
+			expected: heredoc.Doc(`
+				te-2
+			`),
+		},
+		{
+			name: "require",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", Description: "docs",
+						Project:      &dto.Project{ID: "p1"},
+						Task:         &dto.Task{ID: "t1"},
+						TimeInterval: dto.TimeInterval{Start: first}},
+					{ID: "te-2", Description: " ",
+						Project:      &dto.Project{ID: "p1"},
+						TimeInterval: dto.TimeInterval{Start: first}},
+					{ID: "te-3", ProjectID: "p1", Description: "review",
+						TimeInterval: dto.TimeInterval{Start: first}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.Require = []string{"project", "task", "description"}
+				rf.Quiet = true
+				return rf
+			},
+			err: "2 time entries are missing required fields:\n" +
+				"te-2 \\(.+\\): task, description\n" +
+				"te-3 \\(.+\\): task$",
+		},
+		{
+			name: "require and drop invalid",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", Description: "docs",
+						Project:      &dto.Project{ID: "p1"},
+						Task:         &dto.Task{ID: "t1"},
+						TimeInterval: dto.TimeInterval{Start: first}},
+					{ID: "te-2", Description: " ",
+						Project:      &dto.Project{ID: "p1"},
+						TimeInterval: dto.TimeInterval{Start: first}},
+					{ID: "te-3", ProjectID: "p1", Description: "review",
+						TimeInterval: dto.TimeInterval{Start: first}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.Require = []string{"project", "description"}
+				rf.DropInvalid = true
+				rf.Quiet = true
+				return rf
+			},
+			expected: heredoc.Doc(`
+				te-1
+				te-3
+			`),
+		},
+		{
+			name: "timer only",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				manualEnd := first.Add(time.Hour)
+				timerEnd := first.Add(time.Hour + 13*time.Second)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", TimeInterval: dto.TimeInterval{
+						Start: first, End: &manualEnd}},
+					{ID: "te-2", TimeInterval: dto.TimeInterval{
+						Start: first, End: &timerEnd}},
+					{ID: "te-3", TimeInterval: dto.TimeInterval{
+						Start: first}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.TimerOnly = true
+				rf.Quiet = true
+				return rf
+			},
+			expected: heredoc.Doc(`
+				te-2
+				te-3
+			`),
+		},
+		{
+			name: "format with header and footer",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				end := first.Add(time.Hour)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", TimeInterval: dto.TimeInterval{
+						Start: first, End: &end}},
+					{ID: "te-2", TimeInterval: dto.TimeInterval{
+						Start: first, End: &end}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.Format = "<li>{{ .ID }}</li>"
+				rf.FormatHeader = "<ul>"
+				rf.FormatFooter = "</ul><p>{{ .Count }} - {{ .Total }}</p>"
+				return rf
+			},
+			expected: heredoc.Doc(`
+				<ul>
+				<li>te-1</li>
+				<li>te-2</li>
+				</ul><p>2 - PT2H0M0S</p>
+			`),
+		},
+		{
+			name: "prometheus",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				end := first.Add(time.Hour)
+				p1 := dto.Project{ID: "p1", Name: "Clockify \"Cli\""}
+				p2 := dto.Project{ID: "p2", Name: "Special"}
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", Project: &p1, TimeInterval: dto.TimeInterval{
+						Start: first, End: &end}},
+					{ID: "te-2", Project: &p2, TimeInterval: dto.TimeInterval{
+						Start: first, End: &end}},
+					{ID: "te-3", Project: &p1, TimeInterval: dto.TimeInterval{
+						Start: first, End: &end}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.Prometheus = true
+				return rf
+			},
+			expected: heredoc.Doc(`
+				# HELP clockify_tracked_seconds_total Time tracked on Clockify per project, in seconds.
+				# TYPE clockify_tracked_seconds_total counter
+				clockify_tracked_seconds_total{project="Clockify \"Cli\""} 7200
+				clockify_tracked_seconds_total{project="Special"} 3600
+				# HELP clockify_tracked_seconds Time tracked on Clockify, in seconds.
+				# TYPE clockify_tracked_seconds gauge
+				clockify_tracked_seconds 10800
+			`),
+		},
+		{
+			name: "daily billable",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				day1 := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				day2 := day1.AddDate(0, 0, 1)
+				end1 := day1.Add(time.Hour)
+				end2 := day2.Add(2 * time.Hour)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", Billable: false, TimeInterval: dto.TimeInterval{
+						Start: day2, End: &end2}},
+					{ID: "te-2", Billable: true, TimeInterval: dto.TimeInterval{
+						Start: day1, End: &end1}},
+					{ID: "te-3", Billable: false, TimeInterval: dto.TimeInterval{
+						Start: day1, End: &end1}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.DailyBillable = true
+				return rf
+			},
+			expected: heredoc.Doc(`
+				+------------+----------+--------------+------------+
+				|    DATE    | BILLABLE | NON-BILLABLE | BILLABLE % |
+				+------------+----------+--------------+------------+
+				| 2006-01-02 | 1:00:00  | 1:00:00      | 50.00%     |
+				| 2006-01-03 | 0:00:00  | 2:00:00      | 0.00%      |
+				+------------+----------+--------------+------------+
+			`),
+		},
+		{
+			name: "table with invalid column alignment",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("GetBool", cmdutil.CONF_SHOW_TASKS).Return(false)
+				cf.On("GetBool", cmdutil.CONF_SHOW_TOTAL_DURATION).
+					Return(false)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.ColumnAlignment = map[string]string{"duration": "right"}
+				return rf
+			},
+			err: `column "duration" does not exist`,
+		},
+		{
+			name: "table with column alignment",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("GetBool", cmdutil.CONF_SHOW_TASKS).Return(false)
+				cf.On("GetBool", cmdutil.CONF_SHOW_TOTAL_DURATION).
+					Return(false)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end := start.Add(time.Hour)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", Description: "Some work",
+						TimeInterval: dto.TimeInterval{
+							Start: start, End: &end}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.ColumnAlignment = map[string]string{
+					"ID":          "center",
+					"Description": "right",
+				}
+				return rf
+			},
+			expected: heredoc.Doc(`
+				+------+---------------------+---------------------+---------+---------+-------------+------+
+				|  ID  |        START        |         END         |   DUR   | PROJECT | DESCRIPTION | TAGS |
+				+------+---------------------+---------------------+---------+---------+-------------+------+
+				| te-1 | 2006-01-02 10:00:00 | 2006-01-02 11:00:00 | 1:00:00 |         |   Some work |      |
+				+------+---------------------+---------------------+---------+---------+-------------+------+
+			`),
+		},
+		{
+			name: "table with days as unit",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("GetBool", cmdutil.CONF_SHOW_TASKS).Return(false)
+				cf.On("GetBool", cmdutil.CONF_SHOW_TOTAL_DURATION).
+					Return(true)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end := start.Add(6 * time.Hour)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", TimeInterval: dto.TimeInterval{
+						Start: start, End: &end}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.HoursPerDay = 8
+				return rf
+			},
+			expected: heredoc.Doc(`
+				+-------+---------------------+---------------------+--------+---------+-------------+------+
+				|  ID   |        START        |         END         |  DUR   | PROJECT | DESCRIPTION | TAGS |
+				+-------+---------------------+---------------------+--------+---------+-------------+------+
+				| te-1  | 2006-01-02 10:00:00 | 2006-01-02 16:00:00 | 0.75 d |         |             |      |
+				+-------+---------------------+---------------------+--------+---------+-------------+------+
+				| TOTAL |                     |                     | 0.75 d |         |             |      |
+				+-------+---------------------+---------------------+--------+---------+-------------+------+
+			`),
+		},
+		{
+			name: "last 24 hours",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", TimeInterval: dto.TimeInterval{
+						Start: timehlp.Now().Add(-48 * time.Hour)}},
+					{ID: "te-2", TimeInterval: dto.TimeInterval{
+						Start: timehlp.Now().Add(-2 * time.Hour)}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.Last = 24 * time.Hour
+				rf.Quiet = true
+				return rf
+			},
+			expected: heredoc.Doc(`
+				te-2
+			`),
+		},
+		{
+			name: "format with separator",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1"},
+					{ID: "te-2"},
+					{ID: "te-3"},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.Format = `"{{ .ID }}"`
+				sep := ", "
+				rf.FormatSeparator = &sep
+				rf.FormatHeader = "["
+				rf.FormatFooter = "]"
+				return rf
+			},
+			expected: heredoc.Doc(`
+				[
+				"te-1", "te-2", "te-3"]
+			`),
+		},
+		{
+			name: "format with separator ends without line break",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1"},
+					{ID: "te-2"},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.Format = "{{ .ID }}"
+				sep := ","
+				rf.FormatSeparator = &sep
+				return rf
+			},
+			expected: "te-1,te-2",
+		},
+		{
+			name: "table with shift end",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("GetBool", cmdutil.CONF_SHOW_TASKS).Return(false)
+				cf.On("GetBool", cmdutil.CONF_SHOW_TOTAL_DURATION).
+					Return(true)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 15, 0, 0, 0, time.Local)
+				end1 := start.Add(time.Hour)
+				end2 := start.Add(3*time.Hour + 30*time.Minute)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", TimeInterval: dto.TimeInterval{
+						Start: start, End: &end1}},
+					{ID: "te-2", TimeInterval: dto.TimeInterval{
+						Start: end1, End: &end2}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.ShiftEnd = "17:00"
+				rf.TimeFormat = "15:04"
+				return rf
+			},
+			expected: heredoc.Doc(`
+				+-------+-------+-------+------------+---------+-------------+------+
+				|  ID   | START |  END  |    DUR     | PROJECT | DESCRIPTION | TAGS |
+				+-------+-------+-------+------------+---------+-------------+------+
+				| te-1  | 15:00 | 16:00 | 1:00:00    |         |             |      |
+				+-------+-------+-------+------------+---------+-------------+------+
+				| te-2  | 16:00 | 18:30 | 2:30:00    |         |             |      |
+				|       |       |       | (+1:30:00) |         |             |      |
+				+-------+-------+-------+------------+---------+-------------+------+
+				| TOTAL |       |       | 3:30:00    |         |             |      |
+				|       |       |       | (+1:30:00) |         |             |      |
+				+-------+-------+-------+------------+---------+-------------+------+
+			`),
+		},
+		{
+			name: "taskwarrior",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				end := first.Add(time.Hour)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{
+						ID:          "te-1",
+						Description: "Ended",
+						Project:     &dto.Project{Name: "Clockify Cli"},
+						Tags:        []dto.Tag{{Name: "Code Review"}},
+						TimeInterval: dto.TimeInterval{
+							Start: first, End: &end},
+					},
+					{
+						ID:           "te-2",
+						Task:         &dto.Task{Name: "Running"},
+						TimeInterval: dto.TimeInterval{Start: end},
+					},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.Taskwarrior = true
+				return rf
+			},
+			expected: `[{"uuid":"9b935531-65d6-5ecd-bf79-434c5d55c6ac",` +
+				`"description":"Ended","status":"completed",` +
+				`"entry":"20060102T000000Z","end":"20060102T010000Z",` +
+				`"project":"Clockify Cli","tags":["Code_Review"]},` +
+				`{"uuid":"9bf1dede-8d68-5c9d-be4a-f1d88498d86a",` +
+				`"description":"Running",` +
+				`"status":"pending","entry":"20060102T010000Z",` +
+				`"start":"20060102T010000Z"}]` + "\n",
+		},
+		{
+			name: "counts",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				day1 := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				day2 := day1.AddDate(0, 0, 1)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{
+						ID:           "te-1",
+						Project:      &dto.Project{ID: "p1"},
+						Task:         &dto.Task{ID: "t1"},
+						Tags:         []dto.Tag{{ID: "tg1"}, {ID: "tg2"}},
+						TimeInterval: dto.TimeInterval{Start: day1},
+					},
+					{
+						ID:           "te-2",
+						Project:      &dto.Project{ID: "p1"},
+						Tags:         []dto.Tag{{ID: "tg1"}},
+						TimeInterval: dto.TimeInterval{Start: day1},
+					},
+					{
+						ID:           "te-3",
+						TimeInterval: dto.TimeInterval{Start: day2},
+					},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.Counts = true
+				return rf
+			},
+			expected: heredoc.Doc(`
+				+---------+----------+-------+------+------+
+				| ENTRIES | PROJECTS | TASKS | TAGS | DAYS |
+				+---------+----------+-------+------+------+
+				|       3 |        1 |     1 |    2 |    2 |
+				+---------+----------+-------+------+------+
+			`),
+		},
+		{
+			name: "invoice html",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end := start.Add(90 * time.Minute)
+				p := &dto.Project{
+					Name:       "Clockify <Cli>",
+					ClientName: "ACME",
+					HourlyRate: dto.Rate{Amount: 10000, Currency: "USD"},
+				}
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{
+						ID:           "te-1",
+						Billable:     true,
+						Description:  "with project rate",
+						Project:      p,
+						TimeInterval: dto.TimeInterval{Start: start, End: &end},
+					},
+					{
+						ID:          "te-2",
+						Billable:    true,
+						Description: "with own rate",
+						Project:     p,
+						HourlyRate:  dto.Rate{Amount: 5000, Currency: "USD"},
+						TimeInterval: dto.TimeInterval{
+							Start: end, End: &end},
+					},
+					{
+						ID:           "te-3",
+						Billable:     false,
+						Description:  "not billable",
+						TimeInterval: dto.TimeInterval{Start: start, End: &end},
+					},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.InvoiceHTML = true
+				return rf
+			},
+			contains: []string{
+				"<p>Period: 2006-01-02 - 2006-01-02</p>",
+				"<p>Client: ACME</p>",
+				"<tr><td>2006-01-02</td><td>with project rate</td>" +
+					"<td>Clockify &lt;Cli&gt;</td>" +
+					`<td class="number">1.50</td>` +
+					`<td class="number">100.00 USD</td>` +
+					`<td class="number">150.00 USD</td></tr>`,
+				`<td class="number">50.00 USD</td>`,
+				`<tr><td colspan="3">Total</td>` +
+					`<td class="number">1.50</td><td></td>` +
+					`<td class="number">150.00 USD</td></tr>`,
+			},
+		},
+		{
+			name: "yaml",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				end := first.Add(time.Hour)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{
+						ID:          "te-1",
+						Description: "yes: it works",
+						Tags:        []dto.Tag{{ID: "tg1", Name: "Tag"}},
+						TimeInterval: dto.TimeInterval{
+							Start: first, End: &end},
+					},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.YAML = true
+				return rf
+			},
+			expected: heredoc.Doc(`
+				- id: te-1
+				  billable: false
+				  description: 'yes: it works'
+				  hourlyRate:
+				    amount: 0
+				  isLocked: false
+				  project: null
+				  projectId: ""
+				  tags:
+				    - id: tg1
+				      name: Tag
+				      workspaceId: ""
+				  task: null
+				  timeInterval:
+				    duration: ""
+				    end: "2006-01-02T01:00:00Z"
+				    start: "2006-01-02T00:00:00Z"
+				  totalBillable: 0
+				  user: null
+				  workspaceId: ""
+			`),
+		},
+		{
+			name: "ics",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				end := first.Add(time.Hour)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{
+						ID:          "te-1",
+						Description: "Review, then merge",
+						Project:     &dto.Project{Name: "Clockify Cli"},
+						Tags:        []dto.Tag{{Name: "Code Review"}},
+						TimeInterval: dto.TimeInterval{
+							Start: first, End: &end},
+					},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.ICS = true
+				return rf
+			},
+			contains: []string{
+				"BEGIN:VCALENDAR\r\nVERSION:2.0\r\n",
+				"BEGIN:VEVENT\r\nUID:te-1@clockify.me\r\n",
+				"DTSTART:20060102T000000Z\r\n" +
+					"DTEND:20060102T010000Z\r\n" +
+					"STATUS:CONFIRMED\r\n" +
+					"SUMMARY:Review\\, then merge\r\n" +
+					"DESCRIPTION:Project: Clockify Cli\r\n" +
+					"CATEGORIES:Clockify Cli,Code Review\r\n" +
+					"END:VEVENT\r\nEND:VCALENDAR\r\n",
+			},
+		},
+		{
+			name: "caldav batch",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				end := first.Add(time.Hour)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{
+						ID:          "te-1",
+						Description: "Review, merge; deploy\\fix\nnotes",
+						Project:     &dto.Project{Name: "Clockify Cli"},
+						Tags:        []dto.Tag{{Name: "a;b"}},
+						TimeInterval: dto.TimeInterval{
+							Start: first, End: &end},
+					},
+					{
+						ID:           "te-2",
+						Description:  "Running",
+						TimeInterval: dto.TimeInterval{Start: end},
+					},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.CalDAVBatch = true
+				return rf
+			},
+			contains: []string{
+				`{"manifest":{"te-1@clockify.me":"te-1.ics",` +
+					`"te-2@clockify.me":"te-2.ics"},"resources":[` +
+					`{"uid":"te-1@clockify.me","path":"te-1.ics",` +
+					`"data":"BEGIN:VCALENDAR\r\nVERSION:2.0\r\n`,
+				`DTSTART:20060102T000000Z\r\n` +
+					`DTEND:20060102T010000Z\r\n` +
+					`STATUS:CONFIRMED\r\n` +
+					`SUMMARY:Review\\, merge\\; deploy\\\\fix\\nnotes\r\n` +
+					`DESCRIPTION:Project: Clockify Cli\r\n` +
+					`CATEGORIES:Clockify Cli,a\\;b\r\n` +
+					`END:VEVENT\r\nEND:VCALENDAR\r\n"}`,
+				`{"uid":"te-2@clockify.me","path":"te-2.ics",`,
+				`DTSTART:20060102T010000Z\r\n` +
+					`STATUS:TENTATIVE\r\n` +
+					`SUMMARY:Running\r\n` +
+					`END:VEVENT\r\n`,
+			},
+		},
+		{
+			name: "caldav batch folding long lines",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				end := first.Add(time.Hour)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{
+						ID:          "te-1",
+						Description: strings.Repeat("á", 40),
+						TimeInterval: dto.TimeInterval{
+							Start: first, End: &end},
+					},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.CalDAVBatch = true
+				return rf
+			},
+			contains: []string{
+				// 8 octets of "SUMMARY:" and 33 runes of 2 octets, the next
+				// one would pass the 75 octets
+				`STATUS:CONFIRMED\r\nSUMMARY:` + strings.Repeat("á", 33) +
+					`\r\n ` + strings.Repeat("á", 7) + `\r\nEND:VEVENT`,
+			},
+		},
+		{
+			name: "html grouped by project",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end := start.Add(time.Hour)
+				p := &dto.Project{Name: "Clockify <Cli>", Color: "#03A9F4"}
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{
+						ID:          "te-1",
+						Description: "first",
+						Project:     p,
+						Tags:        []dto.Tag{{Name: "a"}, {Name: "b"}},
+						TimeInterval: dto.TimeInterval{
+							Start: start, End: &end},
+					},
+					{
+						ID:          "te-2",
+						Description: "second",
+						TimeInterval: dto.TimeInterval{
+							Start: end, End: &end},
+					},
+					{
+						ID:          "te-3",
+						Description: "third",
+						Project:     p,
+						TimeInterval: dto.TimeInterval{
+							Start: start, End: &end},
+					},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.HTML = true
+				rf.GroupBy = "project"
+				return rf
+			},
+			contains: []string{
+				"<h2>Clockify &lt;Cli&gt;</h2>",
+				"<tr><td>te-1</td><td>2006-01-02 10:00:00</td>" +
+					"<td>2006-01-02 11:00:00</td>" +
+					`<td class="number">1:00:00</td>` +
+					`<td><span class="project" ` +
+					`style="background-color: #03A9F4"></span>` +
+					"Clockify &lt;Cli&gt;</td>" +
+					"<td>first</td><td>a, b</td></tr>",
+				`<td class="number">2:00:00</td>`,
+				"<h2>No Project</h2>",
+			},
+		},
+		{
+			name: "json lines",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", TimeInterval: dto.TimeInterval{Start: first}},
+					{ID: "te-2", TimeInterval: dto.TimeInterval{
+						Start: first.Add(time.Hour)}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.JSONLines = true
+				return rf
+			},
+			contains: []string{
+				`{"id":"te-1",`,
+				`"start":"2006-01-02T00:00:00Z"}` +
+					`,"totalBillable":0,"user":null,"workspaceId":""}` +
+					"\n" + `{"id":"te-2",`,
+			},
+		},
+		{
+			name: "stream",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				pages := [][]dto.TimeEntry{
+					{
+						{ID: "te-4", Billable: true},
+						{ID: "te-3", Billable: false},
+					},
+					{{ID: "te-2", Billable: true}},
+				}
+				c.On("TimeEntriesIter", mock.Anything,
+					api.GetUserTimeEntriesParam{
+						Workspace: "w",
+						UserID:    "u",
+						Start:     &first,
+						End:       &last,
+					}).Return(api.NewTimeEntryIterator(2,
+					func(page int) ([]dto.TimeEntry, error) {
+						return pages[page-1], nil
+					}))
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.Stream = true
+				rf.Billable = true
+				rf.Quiet = true
+				return rf
+			},
+			expected: heredoc.Doc(`
+				te-4
+				te-2
+			`),
+		},
+		{
+			name: "table with columns",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("GetBool", cmdutil.CONF_SHOW_TASKS).Return(false)
+				cf.On("GetBool", cmdutil.CONF_SHOW_TOTAL_DURATION).
+					Return(true)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end := start.Add(time.Hour)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", Description: "Some work",
+						Project: &dto.Project{Name: "Clockify Cli"},
+						Tags: []dto.Tag{
+							{ID: "tg-1", Name: "a"}, {ID: "tg-2", Name: "b"}},
+						TimeInterval: dto.TimeInterval{
+							Start: start, End: &end}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.Columns = []string{"Description", "dur", "project"}
+				return rf
+			},
+			expected: heredoc.Doc(`
+				+-------------+---------+--------------+
+				| DESCRIPTION |   DUR   |   PROJECT    |
+				+-------------+---------+--------------+
+				| Some work   | 1:00:00 | Clockify Cli |
+				+-------------+---------+--------------+
+				| TOTAL       | 1:00:00 |              |
+				+-------------+---------+--------------+
+			`),
+		},
+		{
+			name: "table with invalid columns",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("GetBool", cmdutil.CONF_SHOW_TASKS).Return(false)
+				cf.On("GetBool", cmdutil.CONF_SHOW_TOTAL_DURATION).
+					Return(false)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end := start.Add(time.Hour)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", Description: "Some work",
+						Project: &dto.Project{Name: "Clockify Cli"},
+						Tags: []dto.Tag{
+							{ID: "tg-1", Name: "a"}, {ID: "tg-2", Name: "b"}},
+						TimeInterval: dto.TimeInterval{
+							Start: start, End: &end}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.Columns = []string{"id", "client"}
+				return rf
+			},
+			err: `column "client" does not exist`,
+		},
+		{
+			name: "csv with columns",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end := start.Add(time.Hour)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", Description: "Some work",
+						Project: &dto.Project{Name: "Clockify Cli"},
+						Tags: []dto.Tag{
+							{ID: "tg-1", Name: "a"}, {ID: "tg-2", Name: "b"}},
+						TimeInterval: dto.TimeInterval{
+							Start: start, End: &end}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.CSV = true
+				rf.Columns = []string{"id", "dur", "project", "tags"}
+				return rf
+			},
+			expected: heredoc.Doc(`
+				id,duration,project.name,tags...
+				te-1,1:00:00,Clockify Cli,a (tg-1),b (tg-2)
+			`),
+		},
+		{
+			name: "csv with tags not last",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end := start.Add(time.Hour)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", Description: "Some work",
+						Project: &dto.Project{Name: "Clockify Cli"},
+						Tags: []dto.Tag{
+							{ID: "tg-1", Name: "a"}, {ID: "tg-2", Name: "b"}},
+						TimeInterval: dto.TimeInterval{
+							Start: start, End: &end}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.CSV = true
+				rf.Columns = []string{"tags", "id"}
+				return rf
+			},
+			err: `column "tags" must be the last one`,
+		},
+		{
+			name: "sort by duration desc",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end1 := start.Add(time.Hour)
+				end2 := start.Add(3 * time.Hour)
+				end3 := start.Add(2 * time.Hour)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", TimeInterval: dto.TimeInterval{
+						Start: start, End: &end1}},
+					{ID: "te-2", TimeInterval: dto.TimeInterval{
+						Start: start, End: &end2}},
+					{ID: "te-3", TimeInterval: dto.TimeInterval{
+						Start: start, End: &end3}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.Sort = "Duration:desc"
+				rf.Quiet = true
+				return rf
+			},
+			expected: heredoc.Doc(`
+				te-2
+				te-3
+				te-1
+			`),
+		},
+		{
+			name: "sort by invalid field",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end1 := start.Add(time.Hour)
+				end2 := start.Add(3 * time.Hour)
+				end3 := start.Add(2 * time.Hour)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", TimeInterval: dto.TimeInterval{
+						Start: start, End: &end1}},
+					{ID: "te-2", TimeInterval: dto.TimeInterval{
+						Start: start, End: &end2}},
+					{ID: "te-3", TimeInterval: dto.TimeInterval{
+						Start: start, End: &end3}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.Sort = "client"
+				rf.Quiet = true
+				return rf
+			},
+			err: `can't sort by "client", valid fields are: description, ` +
+				"duration, end, id, project, start and task",
+		},
+		{
+			name: "sort with invalid order",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end1 := start.Add(time.Hour)
+				end2 := start.Add(3 * time.Hour)
+				end3 := start.Add(2 * time.Hour)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", TimeInterval: dto.TimeInterval{
+						Start: start, End: &end1}},
+					{ID: "te-2", TimeInterval: dto.TimeInterval{
+						Start: start, End: &end2}},
+					{ID: "te-3", TimeInterval: dto.TimeInterval{
+						Start: start, End: &end3}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.Sort = "end:up"
+				rf.Quiet = true
+				return rf
+			},
+			err: `sort order "up" is not valid`,
+		},
+		{
+			name: "table grouped by tag",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("GetBool", cmdutil.CONF_SHOW_TASKS).Return(false)
+				cf.On("GetBool", cmdutil.CONF_SHOW_TOTAL_DURATION).
+					Return(false)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end1 := start.Add(time.Hour)
+				end2 := end1.Add(2 * time.Hour)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", Tags: []dto.Tag{
+						{ID: "tg-1", Name: "a"}, {ID: "tg-2", Name: "b"}},
+						TimeInterval: dto.TimeInterval{
+							Start: start, End: &end1}},
+					{ID: "te-2", Tags: []dto.Tag{{ID: "tg-2", Name: "b"}},
+						TimeInterval: dto.TimeInterval{
+							Start: end1, End: &end2}},
+					{ID: "te-3", TimeInterval: dto.TimeInterval{
+						Start: end2, End: &end2}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.GroupBy = "tag"
+				rf.Columns = []string{"id", "dur"}
+				return rf
+			},
+			expected: heredoc.Doc(`
+				a - 1:00:00
+				+------+---------+
+				|  ID  |   DUR   |
+				+------+---------+
+				| te-1 | 1:00:00 |
+				+------+---------+
+
+				b - 3:00:00
+				+------+---------+
+				|  ID  |   DUR   |
+				+------+---------+
+				| te-1 | 1:00:00 |
+				+------+---------+
+				| te-2 | 2:00:00 |
+				+------+---------+
+
+				No Tag - 0:00:00
+				+------+---------+
+				|  ID  |   DUR   |
+				+------+---------+
+				| te-3 | 0:00:00 |
+				+------+---------+
+
+				TOTAL - 3:00:00
+			`),
+		},
+		{
+			name: "csv grouped by tag",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end1 := start.Add(time.Hour)
+				end2 := end1.Add(2 * time.Hour)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", Tags: []dto.Tag{
+						{ID: "tg-1", Name: "a"}, {ID: "tg-2", Name: "b"}},
+						TimeInterval: dto.TimeInterval{
+							Start: start, End: &end1}},
+					{ID: "te-2", Tags: []dto.Tag{{ID: "tg-2", Name: "b"}},
+						TimeInterval: dto.TimeInterval{
+							Start: end1, End: &end2}},
+					{ID: "te-3", TimeInterval: dto.TimeInterval{
+						Start: end2, End: &end2}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.GroupBy = "tag"
+				rf.CSV = true
+				return rf
+			},
+			expected: heredoc.Doc(`
+				tag,count,duration
+				a,1,1:00:00
+				b,2,3:00:00
+				No Tag,1,0:00:00
+				TOTAL,3,3:00:00
+			`),
+		},
+		{
+			name: "json grouped by tag",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end1 := start.Add(time.Hour)
+				end2 := end1.Add(2 * time.Hour)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", Tags: []dto.Tag{
+						{ID: "tg-1", Name: "a"}, {ID: "tg-2", Name: "b"}},
+						TimeInterval: dto.TimeInterval{
+							Start: start, End: &end1}},
+					{ID: "te-2", Tags: []dto.Tag{{ID: "tg-2", Name: "b"}},
+						TimeInterval: dto.TimeInterval{
+							Start: end1, End: &end2}},
+					{ID: "te-3", TimeInterval: dto.TimeInterval{
+						Start: end2, End: &end2}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.GroupBy = "tag"
+				rf.JSON = true
+				return rf
+			},
+			contains: []string{
+				`{"groups":[{"name":"a","duration":"PT1H0M0S",` +
+					`"timeEntries":[{"id":"te-1",`,
+				`{"name":"b","duration":"PT3H0M0S",` +
+					`"timeEntries":[{"id":"te-1",`,
+				`],"duration":"PT3H0M0S"}` + "\n",
+			},
+		},
+		{
+			name: "table with iso durations",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("GetBool", cmdutil.CONF_SHOW_TASKS).Return(false)
+				cf.On("GetBool", cmdutil.CONF_SHOW_TOTAL_DURATION).
+					Return(true)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end1 := start.Add(90 * time.Minute)
+				end2 := end1.Add(15*time.Minute + 30*time.Second)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", TimeInterval: dto.TimeInterval{
+						Start: start, End: &end1}},
+					{ID: "te-2", TimeInterval: dto.TimeInterval{
+						Start: end1, End: &end2}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.DurationFormat = "iso"
+				rf.Columns = []string{"id", "dur"}
+				return rf
+			},
+			expected: heredoc.Doc(`
+				+-------+------------+
+				|  ID   |    DUR     |
+				+-------+------------+
+				| te-1  | PT1H30M    |
+				+-------+------------+
+				| te-2  | PT15M30S   |
+				+-------+------------+
+				| TOTAL | PT1H45M30S |
+				+-------+------------+
+			`),
+		},
+		{
+			name: "csv with decimal durations",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end1 := start.Add(90 * time.Minute)
+				end2 := end1.Add(15*time.Minute + 30*time.Second)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", TimeInterval: dto.TimeInterval{
+						Start: start, End: &end1}},
+					{ID: "te-2", TimeInterval: dto.TimeInterval{
+						Start: end1, End: &end2}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.DurationFormat = "decimal"
+				rf.CSV = true
+				rf.Columns = []string{"id", "dur"}
+				return rf
+			},
+			expected: heredoc.Doc(`
+				id,duration
+				te-1,1.50
+				te-2,0.26
+			`),
+		},
+		{
+			name: "duration formatted as hm",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end1 := start.Add(90 * time.Minute)
+				end2 := end1.Add(15*time.Minute + 30*time.Second)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", TimeInterval: dto.TimeInterval{
+						Start: start, End: &end1}},
+					{ID: "te-2", TimeInterval: dto.TimeInterval{
+						Start: end1, End: &end2}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.DurationFormat = "hm"
+				rf.DurationFormatted = true
+				return rf
+			},
+			expected: "01h45m\n",
+		},
+		{
+			name: "duration formatted as minutes",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end1 := start.Add(90 * time.Minute)
+				end2 := end1.Add(15*time.Minute + 30*time.Second)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", TimeInterval: dto.TimeInterval{
+						Start: start, End: &end1}},
+					{ID: "te-2", TimeInterval: dto.TimeInterval{
+						Start: end1, End: &end2}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.DurationFormat = "minutes"
+				rf.DurationFormatted = true
+				return rf
+			},
+			expected: "105\n",
+		},
+		{
+			name: "table with time zone",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("GetBool", cmdutil.CONF_SHOW_TASKS).Return(false)
+				cf.On("GetBool", cmdutil.CONF_SHOW_TOTAL_DURATION).
+					Return(false)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 13, 0, 0, 0, time.UTC)
+				end := start.Add(time.Hour)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", TimeInterval: dto.TimeInterval{
+						Start: start, End: &end}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.TimeZone = "Asia/Tokyo"
+				rf.Columns = []string{"id", "start", "end"}
+				return rf
+			},
+			expected: heredoc.Doc(`
+				+------+---------------------+---------------------+
+				|  ID  |        START        |         END         |
+				+------+---------------------+---------------------+
+				| te-1 | 2006-01-02 22:00:00 | 2006-01-02 23:00:00 |
+				+------+---------------------+---------------------+
+			`),
+		},
+		{
+			name: "csv with time zone",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 13, 0, 0, 0, time.UTC)
+				end := start.Add(time.Hour)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", TimeInterval: dto.TimeInterval{
+						Start: start, End: &end}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.TimeZone = "UTC"
+				rf.CSV = true
+				rf.Columns = []string{"id", "start", "end"}
+				return rf
+			},
+			expected: heredoc.Doc(`
+				id,start,end
+				te-1,2006-01-02 13:00:00,2006-01-02 14:00:00
+			`),
+		},
+		{
+			name: "format with time zone",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 13, 0, 0, 0, time.UTC)
+				end := start.Add(time.Hour)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", TimeInterval: dto.TimeInterval{
+						Start: start, End: &end}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.TimeZone = "America/Sao_Paulo"
+				rf.Format = "{{ .ID }} {{ fdt .TimeInterval.Start }} " +
+					"{{ fdt .TimeInterval.End }}"
+				return rf
+			},
+			expected: heredoc.Doc(`
+				te-1 2006-01-02 11:00:00 2006-01-02 12:00:00
+			`),
+		},
+		{
+			name: "table with locale",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("GetBool", cmdutil.CONF_SHOW_TASKS).Return(false)
+				cf.On("GetBool", cmdutil.CONF_SHOW_TOTAL_DURATION).
+					Return(false)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end := start.Add(90 * time.Minute)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", TimeInterval: dto.TimeInterval{
+						Start: start, End: &end}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.Locale = "de-DE"
+				rf.TimeFormat = "Mon 2006-01-02 15:04"
+				rf.DurationFormat = "decimal"
+				rf.Columns = []string{"id", "start", "dur"}
+				return rf
+			},
+			expected: heredoc.Doc(`
+				+------+---------------------+------+
+				|  ID  |        START        | DUR  |
+				+------+---------------------+------+
+				| te-1 | Mo 02.01.2006 10:00 | 1,50 |
+				+------+---------------------+------+
+			`),
+		},
+		{
+			name: "csv with locale",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end := start.Add(90 * time.Minute)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", TimeInterval: dto.TimeInterval{
+						Start: start, End: &end}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.Locale = "pt_BR"
+				rf.CSV = true
+				rf.DurationFormat = "decimal"
+				rf.Columns = []string{"id", "start", "dur"}
+				return rf
+			},
+			expected: heredoc.Doc(`
+				id,start,duration
+				te-1,02/01/2006 10:00:00,"1,50"
+			`),
+		},
+		{
+			name: "duration float with locale",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end := start.Add(90 * time.Minute)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", TimeInterval: dto.TimeInterval{
+						Start: start, End: &end}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.Locale = "fr"
+				rf.DurationFloat = true
+				return rf
+			},
+			expected: "1,500000\n",
+		},
+		{
+			name: "format with helper functions",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end := start.Add(90 * time.Minute)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{
+						ID:          "te-1",
+						Description: "  writing tests ",
+						Project:     &dto.Project{Name: "clockify cli"},
+						TimeInterval: dto.TimeInterval{
+							Start: start, End: &end, Duration: "PT1H30M"},
+					},
+					{
+						ID: "te-2",
+						TimeInterval: dto.TimeInterval{
+							Start: end, End: &end, Duration: "PT0S"},
+					},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.Format = "{{ .ID | upper }} " +
+					"{{ .Description | trim | title | default \"none\" }} " +
+					"{{ date \"Mon 02/01\" .TimeInterval.Start }} " +
+					"{{ round (hours .TimeInterval.Duration | mulf 50) 2 }} " +
+					"{{ dateModify \"PT1H\" .TimeInterval.Start | ft }}"
+				rf.FormatFooter = "{{ add .Count 1 }} " +
+					"{{ addDuration .Total \"30m\" }} " +
+					"{{ list \"a\" \"b\" | join \"-\" }}"
+				return rf
+			},
+			expected: heredoc.Doc(`
+				TE-1 Writing Tests Mon 02/01 75 11:00:00
+				TE-2 none Mon 02/01 0 12:30:00
+				3 PT2H0M0S a-b
+			`),
+		},
+		{
+			name: "csv with delimiter and no header",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end := start.Add(90 * time.Minute)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", TimeInterval: dto.TimeInterval{
+						Start: start, End: &end}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.CSV = true
+				rf.CSVDelimiter = ";"
+				rf.CSVNoHeader = true
+				rf.DurationFormat = "decimal"
+				rf.Locale = "de"
+				rf.Columns = []string{"id", "start", "dur"}
+				return rf
+			},
+			expected: heredoc.Doc(`
+				te-1;02.01.2006 10:00:00;1,50
+			`),
+		},
+		{
+			name: "markdown table",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end := start.Add(90 * time.Minute)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{
+						ID:          "te-1",
+						Description: "fix a | b\nand c",
+						Project:     &dto.Project{Name: "Clockify"},
+						Tags: []dto.Tag{
+							{Name: "dev"}, {Name: "cli"},
+						},
+						TimeInterval: dto.TimeInterval{
+							Start: start, End: &end},
+					},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.MarkdownTable = true
+				return rf
+			},
+			expected: heredoc.Doc(`
+				| ID | Start | End | Dur | Project | Description | Tags |
+				|---|---|---|--:|---|---|---|
+				| te-1 | 2006-01-02 10:00:00 | 2006-01-02 11:30:00 | 1:30:00 | Clockify | fix a \| b<br>and c | dev, cli |
+				| **TOTAL** |  |  | **1:30:00** |  |  |  |
+			`),
+		},
+		{
+			name: "table with amounts",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("GetBool", cmdutil.CONF_SHOW_TASKS).Return(false)
+				cf.On("GetBool", cmdutil.CONF_SHOW_TOTAL_DURATION).
+					Return(false)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end := start.Add(90 * time.Minute)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{
+						ID:       "te-1",
+						Billable: true,
+						HourlyRate: dto.Rate{
+							Amount: 5000, Currency: "USD"},
+						TimeInterval: dto.TimeInterval{
+							Start: start, End: &end},
+					},
+					{
+						ID: "te-2",
+						Project: &dto.Project{
+							Name: "p",
+							HourlyRate: dto.Rate{
+								Amount: 3000, Currency: "USD"},
+						},
+						TimeInterval: dto.TimeInterval{
+							Start: start, End: &end},
+					},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.ShowAmounts = true
+				rf.Columns = []string{"id", "dur"}
+				return rf
+			},
+			expected: heredoc.Doc(`
+				+-------+---------+-----------+-----------+
+				|  ID   |   DUR   |   RATE    |  AMOUNT   |
+				+-------+---------+-----------+-----------+
+				| te-1  | 1:30:00 | 50.00 USD | 75.00 USD |
+				+-------+---------+-----------+-----------+
+				| te-2  | 1:30:00 | 30.00 USD | 0.00 USD  |
+				+-------+---------+-----------+-----------+
+				| TOTAL | 3:00:00 |           | 75.00 USD |
+				+-------+---------+-----------+-----------+
+			`),
+		},
+		{
+			name: "csv with amounts",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end := start.Add(90 * time.Minute)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{
+						ID:       "te-1",
+						Billable: true,
+						HourlyRate: dto.Rate{
+							Amount: 5000, Currency: "USD"},
+						TimeInterval: dto.TimeInterval{
+							Start: start, End: &end},
+					},
+					{
+						ID: "te-2",
+						Project: &dto.Project{
+							Name: "p",
+							HourlyRate: dto.Rate{
+								Amount: 3000, Currency: "USD"},
+						},
+						TimeInterval: dto.TimeInterval{
+							Start: start, End: &end},
+					},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.CSV = true
+				rf.ShowAmounts = true
+				rf.Columns = []string{"id", "dur", "tags"}
+				return rf
+			},
+			expected: heredoc.Doc(`
+				id,duration,rate,amount,tags...
+				te-1,1:30:00,50.00 USD,75.00 USD
+				te-2,1:30:00,30.00 USD,0.00 USD
+				TOTAL,,,75.00 USD,
+			`),
+		},
+		{
+			name: "json with amounts",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end := start.Add(90 * time.Minute)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{
+						ID:       "te-1",
+						Billable: true,
+						HourlyRate: dto.Rate{
+							Amount: 5000, Currency: "USD"},
+						TimeInterval: dto.TimeInterval{
+							Start: start, End: &end},
+					},
+					{
+						ID: "te-2",
+						Project: &dto.Project{
+							Name: "p",
+							HourlyRate: dto.Rate{
+								Amount: 3000, Currency: "USD"},
+						},
+						TimeInterval: dto.TimeInterval{
+							Start: start, End: &end},
+					},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.JSON = true
+				rf.ShowAmounts = true
+				return rf
+			},
+			contains: []string{
+				`{"timeEntries":[{"id":"te-1","billable":true,`,
+				`"amount":{"amount":7500,"currency":"USD"}},`,
+				`"amount":{"amount":0,"currency":"USD"}}],` +
+					`"amounts":[{"amount":7500,"currency":"USD"}]}`,
+			},
+		},
+		{
+			name: "group summary by tag",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("GetBool", cmdutil.CONF_SHOW_TASKS).Return(false)
+				cf.On("GetBool", cmdutil.CONF_SHOW_TOTAL_DURATION).
+					Return(false)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end := start.Add(time.Hour)
+				end2 := end.Add(90 * time.Minute)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{
+						ID:   "te-1",
+						Tags: []dto.Tag{{Name: "dev"}, {Name: "meeting"}},
+						TimeInterval: dto.TimeInterval{
+							Start: start, End: &end},
+					},
+					{
+						ID:   "te-2",
+						Tags: []dto.Tag{{Name: "dev"}},
+						TimeInterval: dto.TimeInterval{
+							Start: end, End: &end2},
+					},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.GroupBy = "tag"
+				rf.GroupSummary = true
+				return rf
+			},
+			expected: heredoc.Doc(`
+				+---------+---------+----------+
+				|   TAG   | ENTRIES | DURATION |
+				+---------+---------+----------+
+				| dev     |       2 |  2:30:00 |
+				| meeting |       1 |  1:00:00 |
+				| TOTAL   |       2 |  2:30:00 |
+				+---------+---------+----------+
+			`),
+		},
+		{
+			name: "group summary by tag splitting durations",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("GetBool", cmdutil.CONF_SHOW_TASKS).Return(false)
+				cf.On("GetBool", cmdutil.CONF_SHOW_TOTAL_DURATION).
+					Return(false)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end := start.Add(time.Hour)
+				end2 := end.Add(90 * time.Minute)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{
+						ID:   "te-1",
+						Tags: []dto.Tag{{Name: "dev"}, {Name: "meeting"}},
+						TimeInterval: dto.TimeInterval{
+							Start: start, End: &end},
+					},
+					{
+						ID:   "te-2",
+						Tags: []dto.Tag{{Name: "dev"}},
+						TimeInterval: dto.TimeInterval{
+							Start: end, End: &end2},
+					},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.GroupBy = "tag"
+				rf.GroupSummary = true
+				rf.TagSplit = true
+				return rf
+			},
+			expected: heredoc.Doc(`
+				+---------+---------+----------+
+				|   TAG   | ENTRIES | DURATION |
+				+---------+---------+----------+
+				| dev     |       2 |  2:00:00 |
+				| meeting |       1 |  0:30:00 |
+				| TOTAL   |       2 |  2:30:00 |
+				+---------+---------+----------+
+			`),
+		},
+		{
+			name: "group by tag as csv splitting durations",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end := start.Add(time.Hour)
+				end2 := end.Add(90 * time.Minute)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{
+						ID:   "te-1",
+						Tags: []dto.Tag{{Name: "dev"}, {Name: "meeting"}},
+						TimeInterval: dto.TimeInterval{
+							Start: start, End: &end},
+					},
+					{
+						ID:   "te-2",
+						Tags: []dto.Tag{{Name: "dev"}},
+						TimeInterval: dto.TimeInterval{
+							Start: end, End: &end2},
+					},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.GroupBy = "tag"
+				rf.CSV = true
+				rf.TagSplit = true
+				return rf
+			},
+			expected: heredoc.Doc(`
+				tag,count,duration
+				dev,2,2:00:00
+				meeting,1,0:30:00
+				TOTAL,2,2:30:00
+			`),
+		},
+		{
+			name: "table without truncate",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("GetBool", cmdutil.CONF_SHOW_TASKS).Return(false)
+				cf.On("GetBool", cmdutil.CONF_SHOW_TOTAL_DURATION).
+					Return(false)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end := start.Add(time.Hour)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{
+						ID: "te-1",
+						Description: "a long description that would " +
+							"not fit in a small column",
+						TimeInterval: dto.TimeInterval{
+							Start: start, End: &end},
+					},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.NoTruncate = true
+				rf.Columns = []string{"id", "description"}
+				return rf
+			},
+			expected: heredoc.Doc(`
+				+------+---------------------------------------------------------+
+				|  ID  |                       DESCRIPTION                       |
+				+------+---------------------------------------------------------+
+				| te-1 | a long description that would not fit in a small column |
+				+------+---------------------------------------------------------+
+			`),
+		},
+		{
+			name: "table with max width",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("GetBool", cmdutil.CONF_SHOW_TASKS).Return(false)
+				cf.On("GetBool", cmdutil.CONF_SHOW_TOTAL_DURATION).
+					Return(false)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end := start.Add(time.Hour)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{
+						ID: "te-1",
+						Description: "a long description that would " +
+							"not fit in a small column",
+						TimeInterval: dto.TimeInterval{
+							Start: start, End: &end},
+					},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.ColumnMaxWidth = map[string]int{"description": 12}
+				rf.Columns = []string{"id", "description"}
+				return rf
+			},
+			expected: heredoc.Doc(`
+				+------+--------------+
+				|  ID  | DESCRIPTION  |
+				+------+--------------+
+				| te-1 | a long       |
+				|      | description  |
+				|      | that would   |
+				|      | not fit in a |
+				|      | small column |
+				+------+--------------+
+			`),
+		},
+		{
+			name: "csv with rounded durations",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end1 := start.Add(20 * time.Minute)
+				end2 := start.Add(70 * time.Minute)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", TimeInterval: dto.TimeInterval{
+						Start: start, End: &end1}},
+					{ID: "te-2", TimeInterval: dto.TimeInterval{
+						Start: end1, End: &end2}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.CSV = true
+				rf.Round = 15 * time.Minute
+				rf.Columns = []string{"id", "dur"}
+				return rf
+			},
+			expected: heredoc.Doc(`
+				id,duration
+				te-1,0:15:00
+				te-2,0:45:00
+			`),
+		},
+		{
+			name: "duration float rounded up",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end1 := start.Add(20 * time.Minute)
+				end2 := start.Add(70 * time.Minute)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", TimeInterval: dto.TimeInterval{
+						Start: start, End: &end1}},
+					{ID: "te-2", TimeInterval: dto.TimeInterval{
+						Start: end1, End: &end2}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.DurationFloat = true
+				rf.Round = 15 * time.Minute
+				rf.RoundUp = true
+				return rf
+			},
+			expected: "1.500000\n",
+		},
+		{
+			name: "duration formatted rounded to nearest",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end1 := start.Add(20 * time.Minute)
+				end2 := start.Add(70 * time.Minute)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", TimeInterval: dto.TimeInterval{
+						Start: start, End: &end1}},
+					{ID: "te-2", TimeInterval: dto.TimeInterval{
+						Start: end1, End: &end2}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.DurationFormatted = true
+				rf.Round = 30 * time.Minute
+				rf.RoundNearest = true
+				return rf
+			},
+			expected: "1:30:00\n",
+		},
+		{
+			name: "org-mode",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end1 := start.Add(90 * time.Minute)
+				end2 := end1.Add(30 * time.Minute)
+				p := &dto.Project{Name: "Clockify Cli"}
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", Description: "Code review", Project: p,
+						Tags: []dto.Tag{{Name: "Code Review"}},
+						TimeInterval: dto.TimeInterval{
+							Start: start, End: &end1}},
+					{ID: "te-2", Description: "Meeting",
+						TimeInterval: dto.TimeInterval{
+							Start: end1, End: &end2}},
+					{ID: "te-3", Description: "Code review", Project: p,
+						Tags:         []dto.Tag{{Name: "dev"}},
+						TimeInterval: dto.TimeInterval{Start: end2}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.Org = true
+				return rf
+			},
+			expected: heredoc.Doc(`
+				* Clockify Cli
+				** Code review :Code_Review:dev:
+				   :LOGBOOK:
+				   CLOCK: [2006-01-02 Mon 10:00]--[2006-01-02 Mon 11:30] =>  1:30
+				   CLOCK: [2006-01-02 Mon 12:00]
+				   :END:
+				* No Project
+				** Meeting
+				   :LOGBOOK:
+				   CLOCK: [2006-01-02 Mon 11:30]--[2006-01-02 Mon 12:00] =>  0:30
+				   :END:
+			`),
+		},
+		{
+			name: "timeline",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 8, 0, 0, 0, time.Local)
+				end1 := start.Add(2 * time.Hour)
+				end2 := end1.Add(3 * time.Hour)
+				start3 := end2.Add(-time.Hour)
+				end3 := start3.Add(2 * time.Hour)
+				start4 := start.AddDate(0, 0, 1).Add(2 * time.Hour)
+				end4 := start4.Add(4 * time.Hour)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", TimeInterval: dto.TimeInterval{
+						Start: start, End: &end1}},
+					{ID: "te-2", TimeInterval: dto.TimeInterval{
+						Start: end1.Add(30 * time.Minute), End: &end2}},
+					{ID: "te-3", TimeInterval: dto.TimeInterval{
+						Start: start3, End: &end3}},
+					{ID: "te-4", TimeInterval: dto.TimeInterval{
+						Start: start4, End: &end4}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				t.Setenv("COLUMNS", "56")
+				rf := util.NewReportFlags()
+				rf.Timeline = true
+				rf.TimelineHours = "8-18"
+				return rf
+			},
+			expected: heredoc.Doc(`
+				                08 09 10 11 12 13 14 15 16 17 18
+				2006-01-02 Mon |######.#####!!!###............| 6:30:00
+				2006-01-03 Tue |......############............| 4:00:00
+			`),
+		},
+		{
+			name: "chart with target",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 8, 0, 0, 0, time.Local)
+				end1 := start.Add(10 * time.Hour)
+				start2 := start.AddDate(0, 0, 1)
+				end2 := start2.Add(4 * time.Hour)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-2", TimeInterval: dto.TimeInterval{
+						Start: start2, End: &end2}},
+					{ID: "te-1", TimeInterval: dto.TimeInterval{
+						Start: start, End: &end1}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				t.Setenv("COLUMNS", "45")
+				rf := util.NewReportFlags()
+				rf.Chart = true
+				rf.ChartTarget = 8 * time.Hour
+				return rf
+			},
+			expected: heredoc.Doc(`
+				2006-01-02 Mon ###############|#### 10:00:00
+				2006-01-03 Tue ########       |      4:00:00
+			`),
+		},
+		{
+			name: "table with custom fields",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("GetBool", cmdutil.CONF_SHOW_TASKS).Return(false)
+				cf.On("GetBool", cmdutil.CONF_SHOW_TOTAL_DURATION).
+					Return(false)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end := start.Add(time.Hour)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", TimeInterval: dto.TimeInterval{
+						Start: start, End: &end},
+						CustomFields: []dto.TimeEntryCustomField{
+							{CustomFieldID: "cf-1", Name: "Ticket",
+								Type: "TXT", Value: "CLI-42"},
+							{CustomFieldID: "cf-2", Name: "Areas",
+								Type:  "DROPDOWN_MULTIPLE",
+								Value: []interface{}{"api", "docs"}},
+						}},
+					{ID: "te-2", TimeInterval: dto.TimeInterval{
+						Start: start, End: &end},
+						CustomFields: []dto.TimeEntryCustomField{
+							{CustomFieldID: "cf-3", Name: "Hours",
+								Type: "NUMBER", Value: 1.5},
+						}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.Columns = []string{"id", "cf:ticket", "cf:Areas"}
+				return rf
+			},
+			expected: heredoc.Doc(`
+				+------+--------+-----------+
+				|  ID  | TICKET |   AREAS   |
+				+------+--------+-----------+
+				| te-1 | CLI-42 | api, docs |
+				+------+--------+-----------+
+				| te-2 |        |           |
+				+------+--------+-----------+
+			`),
+		},
+		{
+			name: "csv with all custom fields",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end := start.Add(time.Hour)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", TimeInterval: dto.TimeInterval{
+						Start: start, End: &end},
+						CustomFields: []dto.TimeEntryCustomField{
+							{CustomFieldID: "cf-1", Name: "Ticket",
+								Type: "TXT", Value: "CLI-42"},
+							{CustomFieldID: "cf-2", Name: "Areas",
+								Type:  "DROPDOWN_MULTIPLE",
+								Value: []interface{}{"api", "docs"}},
+						}},
+					{ID: "te-2", TimeInterval: dto.TimeInterval{
+						Start: start, End: &end},
+						CustomFields: []dto.TimeEntryCustomField{
+							{CustomFieldID: "cf-3", Name: "Hours",
+								Type: "NUMBER", Value: 1.5},
+						}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.CSV = true
+				rf.Columns = []string{"id", "customFields.*"}
+				return rf
+			},
+			expected: heredoc.Doc(`
+				id,customFields.Ticket,customFields.Areas,customFields.Hours
+				te-1,CLI-42,"api, docs",
+				te-2,,,1.5
+			`),
+		},
+		{
+			name: "jsonl with custom fields",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end := start.Add(time.Hour)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", TimeInterval: dto.TimeInterval{
+						Start: start, End: &end},
+						CustomFields: []dto.TimeEntryCustomField{
+							{CustomFieldID: "cf-1", Name: "Ticket",
+								Type: "TXT", Value: "CLI-42"},
+							{CustomFieldID: "cf-2", Name: "Areas",
+								Type:  "DROPDOWN_MULTIPLE",
+								Value: []interface{}{"api", "docs"}},
+						}},
+					{ID: "te-2", TimeInterval: dto.TimeInterval{
+						Start: start, End: &end},
+						CustomFields: []dto.TimeEntryCustomField{
+							{CustomFieldID: "cf-3", Name: "Hours",
+								Type: "NUMBER", Value: 1.5},
+						}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.JSONLines = true
+				return rf
+			},
+			contains: []string{
+				`"customFieldValues":[{"customFieldId":"cf-1",` +
+					`"name":"Ticket","type":"TXT","value":"CLI-42"},` +
+					`{"customFieldId":"cf-2","name":"Areas",` +
+					`"type":"DROPDOWN_MULTIPLE","value":["api","docs"]}]}`,
+				`"customFieldValues":[{"customFieldId":"cf-3",` +
+					`"name":"Hours","type":"NUMBER","value":1.5}]}`,
+			},
+		},
+		{
+			name: "matrix of projects and days",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end1 := start.Add(2 * time.Hour)
+				end2 := end1.Add(30 * time.Minute)
+				start3 := start.AddDate(0, 0, 2)
+				end3 := start3.Add(4 * time.Hour)
+				cli := &dto.Project{Name: "Clockify Cli"}
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", Project: cli, TimeInterval: dto.TimeInterval{
+						Start: start, End: &end1}},
+					{ID: "te-2", TimeInterval: dto.TimeInterval{
+						Start: end1, End: &end2}},
+					{ID: "te-3", Project: cli, TimeInterval: dto.TimeInterval{
+						Start: start3, End: &end3}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.Matrix = true
+				rf.FillMissingDates = true
+				return rf
+			},
+			expected: heredoc.Doc(`
+				+--------------+----------------+----------------+----------------+---------+
+				|   PROJECT    | MON 2006-01-02 | TUE 2006-01-03 | WED 2006-01-04 |  TOTAL  |
+				+--------------+----------------+----------------+----------------+---------+
+				| Clockify Cli |        2:00:00 |                |        4:00:00 | 6:00:00 |
+				+--------------+----------------+----------------+----------------+---------+
+				| No Project   |        0:30:00 |                |                | 0:30:00 |
+				+--------------+----------------+----------------+----------------+---------+
+				| TOTAL        |        2:30:00 |        0:00:00 |        4:00:00 | 6:30:00 |
+				+--------------+----------------+----------------+----------------+---------+
+			`),
+		},
+		{
+			name: "csv with approval status",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				end := first.Add(time.Hour)
+				req := "ar-1"
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", IsLocked: true, ApprovalRequestID: &req,
+						TimeInterval: dto.TimeInterval{
+							Start: first, End: &end}},
+					{ID: "te-2", ApprovalRequestID: &req,
+						TimeInterval: dto.TimeInterval{
+							Start: first, End: &end}},
+					{ID: "te-3", IsLocked: true,
+						TimeInterval: dto.TimeInterval{
+							Start: first, End: &end}},
+					{ID: "te-4", TimeInterval: dto.TimeInterval{
+						Start: first, End: &end}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.CSV = true
+				rf.Columns = []string{"id", "status"}
+				return rf
+			},
+			expected: heredoc.Doc(`
+				id,status
+				te-1,approved
+				te-2,pending
+				te-3,locked
+				te-4,open
+			`),
+		},
+		{
+			name: "only locked",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				end := first.Add(time.Hour)
+				req := "ar-1"
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", IsLocked: true, ApprovalRequestID: &req,
+						TimeInterval: dto.TimeInterval{
+							Start: first, End: &end}},
+					{ID: "te-2", ApprovalRequestID: &req,
+						TimeInterval: dto.TimeInterval{
+							Start: first, End: &end}},
+					{ID: "te-3", IsLocked: true,
+						TimeInterval: dto.TimeInterval{
+							Start: first, End: &end}},
+					{ID: "te-4", TimeInterval: dto.TimeInterval{
+						Start: first, End: &end}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.OnlyLocked = true
+				rf.Quiet = true
+				return rf
+			},
+			expected: heredoc.Doc(`
+				te-1
+				te-3
+			`),
+		},
+		{
+			name: "only unapproved",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				end := first.Add(time.Hour)
+				req := "ar-1"
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", IsLocked: true, ApprovalRequestID: &req,
+						TimeInterval: dto.TimeInterval{
+							Start: first, End: &end}},
+					{ID: "te-2", ApprovalRequestID: &req,
+						TimeInterval: dto.TimeInterval{
+							Start: first, End: &end}},
+					{ID: "te-3", IsLocked: true,
+						TimeInterval: dto.TimeInterval{
+							Start: first, End: &end}},
+					{ID: "te-4", TimeInterval: dto.TimeInterval{
+						Start: first, End: &end}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.OnlyUnapproved = true
+				rf.Quiet = true
+				return rf
+			},
+			expected: heredoc.Doc(`
+				te-2
+				te-3
+				te-4
+			`),
+		},
+		{
+			name: "only locked and unapproved",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				end := first.Add(time.Hour)
+				req := "ar-1"
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", IsLocked: true, ApprovalRequestID: &req,
+						TimeInterval: dto.TimeInterval{
+							Start: first, End: &end}},
+					{ID: "te-2", ApprovalRequestID: &req,
+						TimeInterval: dto.TimeInterval{
+							Start: first, End: &end}},
+					{ID: "te-3", IsLocked: true,
+						TimeInterval: dto.TimeInterval{
+							Start: first, End: &end}},
+					{ID: "te-4", TimeInterval: dto.TimeInterval{
+						Start: first, End: &end}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.OnlyLocked = true
+				rf.OnlyUnapproved = true
+				rf.Quiet = true
+				return rf
+			},
+			expected: heredoc.Doc(`
+				te-3
+			`),
+		},
+		{
+			name: "tsv",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end := start.Add(90 * time.Minute)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", Description: "Line one\n\"two\"\tthree",
+						Tags: []dto.Tag{
+							{ID: "tg-1", Name: "a"}, {ID: "tg-2", Name: "b"}},
+						TimeInterval: dto.TimeInterval{
+							Start: start, End: &end}},
+					{ID: "te-2", Description: "Short",
+						TimeInterval: dto.TimeInterval{
+							Start: start, End: &end}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.TSV = true
+				rf.CSVNoHeader = true
+				rf.Columns = []string{"id", "dur", "description", "tags"}
+				return rf
+			},
+			expected: "te-1\t1:30:00\tLine one \"two\" three\ta (tg-1)\tb (tg-2)\n" +
+				"te-2\t1:30:00\tShort\n",
+		},
+		{
+			name: "plain",
+			factory: func(t *testing.T) cmdutil.Factory {
+				f := mocks.NewMockFactory(t)
+				f.On("GetUserID").Return("u", nil)
+				f.On("GetWorkspaceID").Return("w", nil)
+
+				cf := mocks.NewMockConfig(t)
+				f.On("Config").Return(cf)
+				cf.On("GetBool", cmdutil.CONF_SHOW_TASKS).Return(false)
+				cf.On("GetBool", cmdutil.CONF_SHOW_TOTAL_DURATION).
+					Return(true)
+
+				c := mocks.NewMockClient(t)
+				f.On("Client").Return(c, nil)
+
+				start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+				end := start.Add(90 * time.Minute)
+				c.On("LogRange", mock.Anything, api.LogRangeParam{
+					Workspace:       "w",
+					UserID:          "u",
+					FirstDate:       first,
+					LastDate:        last,
+					PaginationParam: api.AllPages(),
+				}).Return([]dto.TimeEntry{
+					{ID: "te-1", Description: "Line one\n\"two\"\tthree",
+						Tags: []dto.Tag{
+							{ID: "tg-1", Name: "a"}, {ID: "tg-2", Name: "b"}},
+						TimeInterval: dto.TimeInterval{
+							Start: start, End: &end}},
+					{ID: "te-2", Description: "Short",
+						TimeInterval: dto.TimeInterval{
+							Start: start, End: &end}},
+				}, nil)
+
+				return f
+			},
+			flags: func(t *testing.T) util.ReportFlags {
+				rf := util.NewReportFlags()
+				rf.Plain = true
+				rf.Columns = []string{"id", "dur", "description", "tags"}
+				return rf
+			},
+			expected: heredoc.Doc(`
+				ID     DUR      DESCRIPTION           TAGS
+				te-1   1:30:00  Line one "two" three  a (tg-1), b (tg-2)
+				te-2   1:30:00  Short
+				TOTAL  3:00:00
+			`),
+		},
 	}
 
 	for _, tt := range tts {
 		t.Run(tt.name, func(t *testing.T) {
 			b := bytes.NewBufferString("")
-			err := util.ReportWithRange(
+			err := util.ReportWithRange(context.Background(),
 				tt.factory(t),
 				date,
 				date.AddDate(0, 0, 2),
@@ -403,7 +4043,280 @@ func TestReportWithRange(t *testing.T) {
 			}
 
 			assert.NoError(t, err)
-			assert.Equal(t, tt.expected, b.String())
+			if tt.contains == nil {
+				assert.Equal(t, tt.expected, b.String())
+				return
+			}
+
+			for _, c := range tt.contains {
+				assert.Contains(t, b.String(), c)
+			}
 		})
 	}
 }
+
+func TestReportEncoded(t *testing.T) {
+	payload := bytes.NewBufferString("")
+	err := output.TimeEntriesEncodedPrint([]dto.TimeEntry{
+		{ID: "te-1", Description: "first"},
+		{ID: "te-2", Description: "second"},
+	}, payload)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	f := mocks.NewMockFactory(t)
+	f.On("Config").Return(mocks.NewMockConfig(t))
+
+	rf := util.NewReportFlags()
+	rf.InputEncoded = "-"
+	rf.Format = "{{ .ID }} - {{ .Description }}"
+
+	b := bytes.NewBufferString("")
+	err = util.ReportEncoded(f, payload, b, rf)
+	assert.NoError(t, err)
+	assert.Equal(t, heredoc.Doc(`
+		te-1 - first
+		te-2 - second
+	`), b.String())
+
+	rf.InputEncoded = "not a payload"
+	err = util.ReportEncoded(f, nil, b, rf)
+	if assert.Error(t, err) {
+		assert.Regexp(t, "input-encoded is not a valid payload", err.Error())
+	}
+}
+
+func TestReportXLSX(t *testing.T) {
+	first := time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC)
+	last := first.AddDate(0, 0, 8)
+
+	f := mocks.NewMockFactory(t)
+	f.On("GetUserID").Return("u", nil)
+	f.On("GetWorkspaceID").Return("w", nil)
+	f.On("Config").Return(mocks.NewMockConfig(t))
+
+	c := mocks.NewMockClient(t)
+	f.On("Client").Return(c, nil)
+
+	week1 := time.Date(2006, 1, 3, 10, 0, 0, 0, time.Local)
+	week2 := week1.AddDate(0, 0, 7)
+	end1 := week1.Add(time.Hour)
+	end2 := week2.Add(2 * time.Hour)
+	c.On("LogRange", mock.Anything, api.LogRangeParam{
+		Workspace:       "w",
+		UserID:          "u",
+		FirstDate:       first,
+		LastDate:        last,
+		PaginationParam: api.AllPages(),
+	}).Return([]dto.TimeEntry{
+		{ID: "te-1", Description: "first & only",
+			TimeInterval: dto.TimeInterval{Start: week1, End: &end1}},
+		{ID: "te-2", TimeInterval: dto.TimeInterval{Start: week2, End: &end2}},
+	}, nil)
+
+	rf := util.NewReportFlags()
+	rf.XLSX = filepath.Join(t.TempDir(), "report.xlsx")
+
+	err := util.ReportWithRange(context.Background(),
+		f, first, first.AddDate(0, 0, 7), bytes.NewBufferString(""), rf)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	z, err := zip.OpenReader(rf.XLSX)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer z.Close()
+
+	parts := map[string]string{}
+	for _, zf := range z.File {
+		r, err := zf.Open()
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		b, _ := io.ReadAll(r)
+		r.Close()
+		parts[zf.Name] = string(b)
+	}
+
+	assert.Contains(t, parts["xl/workbook.xml"],
+		`<sheet name="2006-W01" sheetId="1" r:id="rId1"/>`+
+			`<sheet name="2006-W02" sheetId="2" r:id="rId2"/>`)
+	assert.Contains(t, parts["xl/worksheets/sheet1.xml"],
+		`<t xml:space="preserve">first &amp; only</t>`)
+	assert.Contains(t, parts["xl/worksheets/sheet1.xml"],
+		`<c r="E3" s="3"><f>SUM(E2:E2)</f>`)
+	assert.Contains(t, parts["xl/worksheets/sheet2.xml"],
+		`<c r="E2" s="3"><v>0.08333333333333333</v></c>`)
+	assert.Contains(t, parts, "[Content_Types].xml")
+	assert.Contains(t, parts, "xl/styles.xml")
+}
+
+func TestReportCalDAVBatchIsStable(t *testing.T) {
+	first := time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC)
+	end := first.Add(time.Hour)
+
+	f := mocks.NewMockFactory(t)
+	f.On("GetUserID").Return("u", nil)
+	f.On("GetWorkspaceID").Return("w", nil)
+	f.On("Config").Return(mocks.NewMockConfig(t))
+
+	c := mocks.NewMockClient(t)
+	f.On("Client").Return(c, nil)
+
+	c.On("LogRange", mock.Anything, api.LogRangeParam{
+		Workspace:       "w",
+		UserID:          "u",
+		FirstDate:       first,
+		LastDate:        first.AddDate(0, 0, 1),
+		PaginationParam: api.AllPages(),
+	}).Return([]dto.TimeEntry{
+		{ID: "te-1", TimeInterval: dto.TimeInterval{Start: first, End: &end}},
+		{ID: "te-2", TimeInterval: dto.TimeInterval{Start: end}},
+	}, nil)
+
+	rf := util.NewReportFlags()
+	rf.CalDAVBatch = true
+
+	runs := make([]output.CalDAVBatch, 2)
+	for i := range runs {
+		b := bytes.NewBufferString("")
+		if !assert.NoError(t, util.ReportWithRange(context.Background(), f,
+			first, first, b, rf)) {
+			return
+		}
+
+		assert.NoError(t, json.Unmarshal(b.Bytes(), &runs[i]))
+	}
+
+	assert.Equal(t, map[string]string{
+		"te-1@clockify.me": "te-1.ics",
+		"te-2@clockify.me": "te-2.ics",
+	}, runs[0].Manifest)
+	assert.Equal(t, runs[0].Manifest, runs[1].Manifest)
+
+	if assert.Len(t, runs[1].Resources, len(runs[0].Resources)) {
+		for i, r := range runs[0].Resources {
+			assert.Equal(t, r.UID, runs[1].Resources[i].UID)
+			assert.Equal(t, r.Path, runs[1].Resources[i].Path)
+		}
+	}
+}
+
+func TestReportPDF(t *testing.T) {
+	first := time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	f := mocks.NewMockFactory(t)
+	f.On("GetUserID").Return("u", nil)
+	f.On("GetWorkspaceID").Return("w", nil)
+
+	c := mocks.NewMockClient(t)
+	f.On("Client").Return(c, nil)
+
+	c.On("GetUser", mock.Anything, api.GetUser{Workspace: "w", UserID: "u"}).
+		Return(dto.User{Name: "John (JD)"}, nil)
+
+	start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+	end := start.Add(90 * time.Minute)
+	c.On("LogRange", mock.Anything, api.LogRangeParam{
+		Workspace:       "w",
+		UserID:          "u",
+		FirstDate:       first,
+		LastDate:        first.AddDate(0, 0, 3),
+		PaginationParam: api.AllPages(),
+	}).Return([]dto.TimeEntry{
+		{
+			ID:           "te-1",
+			Description:  "Writing the timesheet",
+			Project:      &dto.Project{Name: "Clockify Cli"},
+			TimeInterval: dto.TimeInterval{Start: start, End: &end},
+		},
+	}, nil)
+
+	rf := util.NewReportFlags()
+	rf.PDF = filepath.Join(t.TempDir(), "timesheet.pdf")
+
+	b := bytes.NewBufferString("")
+	err := util.ReportWithRange(context.Background(), f, first,
+		first.AddDate(0, 0, 2), b, rf)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Empty(t, b.String())
+
+	pdf, err := os.ReadFile(rf.PDF)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	s := string(pdf)
+	assert.True(t, strings.HasPrefix(s, "%PDF-1.4\n"))
+	assert.True(t, strings.HasSuffix(s, "%%EOF\n"))
+	assert.Contains(t, s, `(User:   John \(JD\)) Tj T*`)
+	assert.Contains(t, s, "(Period: 2006-01-02 - 2006-01-04) Tj T*")
+	assert.Contains(t, s, "(2006-01-02 10:00 11:30 1:30:00  Clockify Cli")
+	assert.Contains(t, s, "(Total                  1:30:00) Tj T*")
+
+	var xref int
+	_, err = fmt.Sscanf(s[strings.LastIndex(s, "startxref\n"):],
+		"startxref\n%d", &xref)
+	if assert.NoError(t, err) {
+		assert.True(t, strings.HasPrefix(s[xref:], "xref\n"))
+	}
+}
+
+func TestReportOutputFile(t *testing.T) {
+	first := time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	f := mocks.NewMockFactory(t)
+	f.On("GetUserID").Return("u", nil)
+	f.On("GetWorkspaceID").Return("w", nil)
+	f.On("Config").Return(mocks.NewMockConfig(t))
+
+	c := mocks.NewMockClient(t)
+	f.On("Client").Return(c, nil)
+
+	start := time.Date(2006, 1, 2, 10, 0, 0, 0, time.Local)
+	end := start.Add(90 * time.Minute)
+	c.On("LogRange", mock.Anything, api.LogRangeParam{
+		Workspace:       "w",
+		UserID:          "u",
+		FirstDate:       first,
+		LastDate:        first.AddDate(0, 0, 3),
+		PaginationParam: api.AllPages(),
+	}).Return([]dto.TimeEntry{
+		{ID: "te-1", TimeInterval: dto.TimeInterval{Start: start, End: &end}},
+	}, nil)
+
+	dir := t.TempDir()
+	rf := util.NewReportFlags()
+	rf.OutputFile = filepath.Join(dir, "report.csv")
+	rf.Columns = []string{"id", "dur"}
+	if !assert.NoError(t, rf.Check()) {
+		return
+	}
+
+	b := bytes.NewBufferString("")
+	err := util.ReportWithRange(context.Background(), f, first,
+		first.AddDate(0, 0, 2), b, rf)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Empty(t, b.String())
+
+	content, err := os.ReadFile(rf.OutputFile)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, heredoc.Doc(`
+		id,duration
+		te-1,1:30:00
+	`), string(content))
+
+	files, _ := os.ReadDir(dir)
+	assert.Len(t, files, 1, "temporary file was not removed")
+}
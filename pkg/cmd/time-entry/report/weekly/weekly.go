@@ -0,0 +1,111 @@
+package weekly
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api/reports"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	output "github.com/lucassabreu/clockify-cli/pkg/output/report"
+	timeentry "github.com/lucassabreu/clockify-cli/pkg/output/time-entry"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/spf13/cobra"
+)
+
+var groups = map[string]reports.Group{
+	"project": reports.GroupProject,
+	"user":    reports.GroupUser,
+}
+
+// NewCmdWeekly represents report weekly command
+func NewCmdWeekly(f cmdutil.Factory) *cobra.Command {
+	rf := util.ReportsAPIFlags{}
+	g := "project"
+	cmd := &cobra.Command{
+		Use:   "weekly [<start>] [<end>]",
+		Short: "Shows the durations of each project or user per day, calculated by Clockify",
+		Long: heredoc.Docf(`
+			Shows the durations of each project or user per day, calculated by Clockify's Reports API
+
+			If no parameter is set, shows the current week, the arguments work like the ones of "report".
+
+			%s
+		`, util.HelpNamesForIds),
+		Example: heredoc.Doc(`
+			# durations of each project this week
+			$ clockify-cli report weekly
+			+--------------+----------------+----------------+----------------+---------+
+			|    GROUP     | MON 2022-06-20 | TUE 2022-06-21 | WED 2022-06-22 |  TOTAL  |
+			+--------------+----------------+----------------+----------------+---------+
+			| Clockify Cli |        2:00:00 |                |        4:00:00 | 6:00:00 |
+			| Special      |        0:30:00 |                |                | 0:30:00 |
+			| TOTAL        |        2:30:00 |        0:00:00 |        4:00:00 | 6:30:00 |
+			+--------------+----------------+----------------+----------------+---------+
+
+			# durations of each user of the workspace on a week
+			$ clockify-cli report weekly 2022-06-20 2022-06-26 --all-users --group user
+		`),
+		Args: cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			if err := rf.Check(); err != nil {
+				return err
+			}
+
+			group, ok := groups[strings.ToLower(g)]
+			if !ok {
+				return cmdutil.FlagErrorWrap(errors.New(
+					"`group` must be one of: project or user"))
+			}
+
+			start, end := timehlp.GetWeekRange(timehlp.Today())
+			if len(args) > 0 {
+				var err error
+				if start, end, err = util.ParseRangeArgs(args); err != nil {
+					return err
+				}
+			}
+
+			var err error
+			p := reports.WeeklyParam{Group: group}
+			if p.Filter, err = util.ReportsAPIFilter(
+				ctx,
+				f, start, end, rf); err != nil {
+				return err
+			}
+
+			c, err := f.ReportsClient()
+			if err != nil {
+				return err
+			}
+
+			r, err := c.Weekly(ctx, p)
+			if err != nil {
+				return err
+			}
+
+			if rf.JSON {
+				return output.JSONPrint(r, cmd.OutOrStdout())
+			}
+
+			df, err := timeentry.DurationFormatter(rf.DurationFormat)
+			if err != nil {
+				return err
+			}
+
+			return output.WeeklyPrint(df)(r, cmd.OutOrStdout())
+		},
+	}
+
+	util.AddReportsAPIFlags(f, cmd, &rf)
+	cmd.Flags().StringVarP(&g, "group", "g", g,
+		"how the time entries will be grouped: project or user")
+	_ = cmdcompl.AddFixedSuggestionsToFlag(cmd, "group",
+		cmdcompl.ValidArgsSlide{"project", "user"})
+
+	return cmd
+}
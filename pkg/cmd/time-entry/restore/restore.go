@@ -0,0 +1,209 @@
+package restore
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdRestore represents the restore command
+func NewCmdRestore(f cmdutil.Factory) *cobra.Command {
+	of := util.OutputFlags{TimeFormat: timehlp.FullTimeFormat}
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "restore <file>",
+		Short: "Creates the time entries saved by the backup command",
+		Long: heredoc.Doc(`
+			Creates the time entries saved into a file by "clockify-cli backup",
+			with the same project, task, tags and custom fields.
+
+			Time entries still existing (same start, end, description, project
+			and task) are skipped, so the same backup can be restored more than
+			once.
+
+			The backup must be restored into the workspace it was made from.
+		`),
+		Example: heredoc.Docf(`
+			# check which time entries would be created
+			$ %[1]s backup.json --dry-run
+
+			# create the time entries, printing only their IDs
+			$ %[1]s backup.json --quiet
+		`, "clockify-cli restore"),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			var r io.Reader = cmd.InOrStdin()
+			if args[0] != "-" {
+				fh, err := os.Open(args[0])
+				if err != nil {
+					return errors.Wrap(err, "failed to open the backup")
+				}
+				defer fh.Close()
+				r = fh
+			}
+
+			b, err := util.ReadBackup(r)
+			if err != nil {
+				return err
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			if b.WorkspaceID != w {
+				return errors.Errorf(
+					"the backup was made from the workspace %s, "+
+						"use --workspace to restore it there",
+					b.WorkspaceID)
+			}
+
+			u, err := f.GetUserID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			existing, err := c.LogRange(ctx, api.LogRangeParam{
+				Workspace:       w,
+				UserID:          u,
+				FirstDate:       b.Since,
+				LastDate:        b.Until,
+				PaginationParam: api.AllPages(),
+			})
+			if err != nil {
+				return err
+			}
+
+			exists := make(map[string]bool, len(existing))
+			for i := range existing {
+				exists[key(existing[i])] = true
+			}
+
+			stderr := cmd.ErrOrStderr()
+			skipped, failed := 0, 0
+			tes := make([]dto.TimeEntry, 0, len(b.TimeEntries))
+			for _, te := range b.TimeEntries {
+				if exists[key(te)] {
+					skipped++
+					continue
+				}
+
+				if dryRun {
+					tes = append(tes, te)
+					continue
+				}
+
+				n, err := c.CreateTimeEntry(ctx, toCreateParam(w, te))
+				if err != nil {
+					failed++
+					fmt.Fprintf(stderr, "time entry %s: %s\n", te.ID, err)
+					continue
+				}
+
+				te.ID = n.ID
+				tes = append(tes, te)
+			}
+
+			if skipped > 0 {
+				fmt.Fprintf(stderr,
+					"%d time entries already exist and were skipped\n",
+					skipped)
+			}
+
+			if err := util.PrintTimeEntries(
+				tes, cmd.OutOrStdout(), f.Config(), of); err != nil {
+				return err
+			}
+
+			if failed > 0 {
+				return errors.Errorf("%d of %d time entries failed to be "+
+					"restored", failed, len(b.TimeEntries))
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false,
+		"only prints the time entries, without creating them")
+	util.AddPrintTimeEntriesFlags(cmd, &of)
+	util.AddPrintMultipleTimeEntriesFlags(cmd)
+
+	return cmd
+}
+
+// key identifies a time entry by its values, not its ID, which changes when
+// it is restored
+func key(te dto.TimeEntry) string {
+	end := ""
+	if te.TimeInterval.End != nil {
+		end = te.TimeInterval.End.UTC().String()
+	}
+
+	taskID := ""
+	if te.Task != nil {
+		taskID = te.Task.ID
+	}
+
+	return te.TimeInterval.Start.UTC().String() + "|" + end + "|" +
+		te.Description + "|" + projectID(te) + "|" + taskID
+}
+
+func projectID(te dto.TimeEntry) string {
+	if te.ProjectID == "" && te.Project != nil {
+		return te.Project.ID
+	}
+
+	return te.ProjectID
+}
+
+func toCreateParam(w string, te dto.TimeEntry) api.CreateTimeEntryParam {
+	p := api.CreateTimeEntryParam{
+		Workspace:   w,
+		Start:       te.TimeInterval.Start,
+		End:         te.TimeInterval.End,
+		Billable:    &te.Billable,
+		Description: te.Description,
+		ProjectID:   projectID(te),
+		TagIDs:      make([]string, len(te.Tags)),
+	}
+
+	if te.Task != nil {
+		p.TaskID = te.Task.ID
+	}
+
+	for i := range te.Tags {
+		p.TagIDs[i] = te.Tags[i].ID
+	}
+
+	for _, cf := range te.CustomFields {
+		if cf.Value == nil {
+			continue
+		}
+
+		p.CustomFields = append(p.CustomFields, dto.CustomFieldValue{
+			CustomFieldID: cf.CustomFieldID,
+			Value:         cf.Value,
+		})
+	}
+
+	return p
+}
@@ -0,0 +1,166 @@
+package restore_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/restore"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCmdRestore(t *testing.T) {
+	since := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+	until := since.AddDate(0, 1, 0)
+
+	start := time.Date(2022, 6, 1, 9, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	deleted := dto.TimeEntry{
+		ID:          "te1",
+		Description: "Writing docs",
+		Billable:    true,
+		Project:     &dto.Project{ID: "p1", Name: "CLI"},
+		ProjectID:   "p1",
+		Task:        &dto.Task{ID: "t1", Name: "Docs"},
+		Tags:        []dto.Tag{{ID: "tg1", Name: "Dev"}},
+		TimeInterval: dto.TimeInterval{
+			Start: start,
+			End:   &end,
+		},
+		CustomFields: []dto.TimeEntryCustomField{
+			{CustomFieldID: "cf1", Name: "Ticket", Value: "CLI-1"},
+			{CustomFieldID: "cf2", Name: "Empty"},
+		},
+	}
+
+	start2 := end
+	end2 := start2.Add(time.Hour)
+	kept := dto.TimeEntry{
+		ID:          "te2",
+		Description: "Reviewing",
+		TimeInterval: dto.TimeInterval{
+			Start: start2,
+			End:   &end2,
+		},
+	}
+
+	backup := bytes.NewBufferString("")
+	if err := util.WriteBackup(backup, util.Backup{
+		WorkspaceID: "w",
+		UserID:      "u",
+		Since:       since,
+		Until:       until,
+		TimeEntries: []dto.TimeEntry{deleted, kept},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	tts := []struct {
+		name    string
+		args    []string
+		created bool
+		out     string
+	}{
+		{
+			name: "dry run",
+			args: []string{"--dry-run", "--format={{.ID}}"},
+			out:  "te1\n",
+		},
+		{
+			name:    "restore",
+			args:    []string{"-q"},
+			created: true,
+			out:     "new1\n",
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			f := mocks.NewMockFactory(t)
+			f.EXPECT().GetWorkspaceID().Return("w", nil)
+			f.EXPECT().GetUserID().Return("u", nil)
+			f.EXPECT().Config().Return(&mocks.SimpleConfig{})
+
+			c := mocks.NewMockClient(t)
+			f.EXPECT().Client().Return(c, nil)
+
+			existing := kept
+			existing.ID = "other"
+			c.EXPECT().LogRange(mock.Anything, api.LogRangeParam{
+				Workspace:       "w",
+				UserID:          "u",
+				FirstDate:       since,
+				LastDate:        until,
+				PaginationParam: api.AllPages(),
+			}).
+				Return([]dto.TimeEntry{existing}, nil)
+
+			if tt.created {
+				b := true
+				c.EXPECT().CreateTimeEntry(mock.Anything,
+					api.CreateTimeEntryParam{
+						Workspace:   "w",
+						Start:       start,
+						End:         &end,
+						Billable:    &b,
+						Description: "Writing docs",
+						ProjectID:   "p1",
+						TaskID:      "t1",
+						TagIDs:      []string{"tg1"},
+						CustomFields: []dto.CustomFieldValue{
+							{CustomFieldID: "cf1", Value: "CLI-1"},
+						},
+					}).
+					Return(dto.TimeEntryImpl{ID: "new1"}, nil).
+					Once()
+			}
+
+			cmd := restore.NewCmdRestore(f)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			out := bytes.NewBufferString("")
+			stderr := bytes.NewBufferString("")
+			cmd.SetOut(out)
+			cmd.SetErr(stderr)
+			cmd.SetIn(bytes.NewReader(backup.Bytes()))
+			cmd.SetArgs(append([]string{"-"}, tt.args...))
+
+			_, err := cmd.ExecuteC()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.out, out.String())
+			assert.Equal(t, "1 time entries already exist and were skipped\n",
+				stderr.String())
+		})
+	}
+}
+
+func TestCmdRestoreOtherWorkspace(t *testing.T) {
+	backup := bytes.NewBufferString("")
+	_ = util.WriteBackup(backup, util.Backup{WorkspaceID: "other"})
+
+	f := mocks.NewMockFactory(t)
+	f.EXPECT().GetWorkspaceID().Return("w", nil)
+
+	cmd := restore.NewCmdRestore(f)
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	cmd.SetIn(backup)
+	cmd.SetArgs([]string{"-"})
+
+	_, err := cmd.ExecuteC()
+	assert.EqualError(t, err, "the backup was made from the workspace "+
+		"other, use --workspace to restore it there")
+}
+
+func TestReadBackupVersion(t *testing.T) {
+	_, err := util.ReadBackup(bytes.NewBufferString(`{"version":2}`))
+	assert.EqualError(t, err,
+		"backup version 2 is not supported, only version 1 is")
+}
@@ -0,0 +1,150 @@
+package resume
+
+import (
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	timeentry "github.com/lucassabreu/clockify-cli/pkg/output/time-entry"
+	"github.com/lucassabreu/clockify-cli/pkg/timeentryhlp"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdResume represents the resume command
+func NewCmdResume(f cmdutil.Factory) *cobra.Command {
+	of := util.OutputFlags{TimeFormat: timeentry.TimeFormatSimple}
+	var at string
+	var noClosing bool
+
+	cmd := &cobra.Command{
+		Use:   "resume [<n>]",
+		Short: "Starts again the last stopped time entry",
+		Long: heredoc.Doc(`
+			Starts a copy of the last stopped time entry (or the n-th last
+			one), with the same project, task, description, tags and billable.
+
+			The running time entry will be stopped when the copy starts, if
+			you don't want to stop it, use the flag --no-closing.
+
+			The rules defined in the workspace and project will be checked
+			before creating it.
+		`) + "\n" +
+			"When setting `--at` you can use any of the following formats:\n" +
+			util.HelpDateTimeFormats + "\n" +
+			util.HelpMoreInfoAboutPrinting,
+		Example: heredoc.Docf(`
+			# back from lunch
+			$ %[1]s -q
+			62ae4b304ebb4f143c931d50
+
+			# started again 5 minutes ago
+			$ %[1]s --at "5m ago" -q
+			62ae4b304ebb4f143c931d51
+
+			# the one stopped before the last one
+			$ %[1]s 2 -q
+			62ae4b304ebb4f143c931d52
+		`, "clockify-cli resume"),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			n := 1
+			if len(args) > 0 {
+				var err error
+				if n, err = strconv.Atoi(args[0]); err != nil || n < 1 {
+					return cmdutil.FlagErrorWrap(errors.Errorf(
+						"n must be a positive integer, you sent: %s",
+						args[0]))
+				}
+			}
+
+			start, err := timehlp.ConvertToTime(at)
+			if err != nil {
+				return cmdutil.FlagErrorWrap(
+					errors.Wrap(err, "invalid --at"))
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			u, err := f.GetUserID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			b := false
+			tes, err := c.GetUserTimeEntries(ctx, api.GetUserTimeEntriesParam{
+				Workspace:      w,
+				UserID:         u,
+				OnlyInProgress: &b,
+				PaginationParam: api.PaginationParam{
+					PageSize: 1,
+					Page:     n,
+				},
+			})
+			if err != nil {
+				return err
+			}
+
+			if len(tes) == 0 {
+				return timeentryhlp.ErrNoTimeEntry
+			}
+
+			tec := tes[0]
+			tec.UserID = u
+			tec.TimeInterval = dto.NewTimeInterval(start, nil)
+
+			te := util.TimeEntryImplToDTO(tec)
+			if te, err = util.Do(
+				te,
+				func(tec util.TimeEntryDTO) (util.TimeEntryDTO, error) {
+					if noClosing {
+						return tec, nil
+					}
+
+					return util.ValidateClosingTimeEntry(ctx, f)(tec)
+				},
+				util.GetValidateTimeEntryFn(ctx, f),
+				func(tec util.TimeEntryDTO) (util.TimeEntryDTO, error) {
+					if noClosing {
+						return tec, nil
+					}
+
+					return util.OutInProgressFn(ctx, c)(tec)
+				},
+				util.CreateTimeEntryFn(ctx, c),
+			); err != nil {
+				return err
+			}
+
+			return util.PrintTimeEntryImpl(
+				ctx,
+				util.TimeEntryDTOToImpl(te), f, cmd.OutOrStdout(), of)
+		},
+	}
+
+	cmd.Flags().StringVar(&at, "at", timehlp.NowTimeFormat,
+		"when the time entry starts again")
+	cmd.Flags().BoolVar(&noClosing, "no-closing", false,
+		"don't close any active time entry")
+	util.AddPrintTimeEntriesFlags(cmd, &of)
+
+	return cmd
+}
@@ -0,0 +1,155 @@
+package resume_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/resume"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCmdResume(t *testing.T) {
+	bFalse := false
+	bTrue := true
+	at := timehlp.Today().Add(13 * time.Hour).UTC()
+
+	tts := []struct {
+		name   string
+		args   []string
+		page   int
+		closes bool
+	}{
+		{
+			name:   "last",
+			args:   []string{"--at", "13:00"},
+			page:   1,
+			closes: true,
+		},
+		{
+			name: "third without closing",
+			args: []string{"3", "--at", "13:00", "--no-closing"},
+			page: 3,
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			f := mocks.NewMockFactory(t)
+			f.EXPECT().GetWorkspaceID().Return("w", nil)
+			f.EXPECT().GetUserID().Return("u", nil)
+
+			conf := mocks.NewMockConfig(t)
+			f.EXPECT().Config().Return(conf)
+			conf.EXPECT().GetBool(cmdutil.CONF_ALLOW_INCOMPLETE).Return(true)
+			conf.EXPECT().GetBool(mock.Anything).Return(false).Maybe()
+			conf.EXPECT().SetBool(mock.Anything, mock.Anything).Maybe()
+			conf.EXPECT().GetString(mock.Anything).Return("").Maybe()
+
+			c := mocks.NewMockClient(t)
+			f.EXPECT().Client().Return(c, nil)
+
+			end := at.Add(-time.Hour)
+			c.EXPECT().GetUserTimeEntries(mock.Anything,
+				api.GetUserTimeEntriesParam{
+					Workspace:      "w",
+					UserID:         "u",
+					OnlyInProgress: &bFalse,
+					PaginationParam: api.PaginationParam{
+						PageSize: 1,
+						Page:     tt.page,
+					},
+				}).
+				Return([]dto.TimeEntryImpl{{
+					ID:          "te1",
+					WorkspaceID: "w",
+					ProjectID:   "p1",
+					TaskID:      "t1",
+					Description: "coding",
+					TagIDs:      []string{"tg1"},
+					Billable:    true,
+					TimeInterval: dto.NewTimeInterval(
+						at.Add(-3*time.Hour), &end),
+				}}, nil)
+
+			if tt.closes {
+				c.EXPECT().GetTimeEntryInProgress(mock.Anything,
+					api.GetTimeEntryInProgressParam{
+						Workspace: "w",
+						UserID:    "u",
+					}).
+					Return(nil, nil)
+
+				c.EXPECT().Out(mock.Anything, api.OutParam{
+					Workspace: "w",
+					UserID:    "u",
+					End:       at,
+				}).
+					Return(api.ErrorNotFound)
+			}
+
+			c.EXPECT().CreateTimeEntry(mock.Anything, api.CreateTimeEntryParam{
+				Workspace:   "w",
+				Start:       at,
+				Billable:    &bTrue,
+				Description: "coding",
+				ProjectID:   "p1",
+				TaskID:      "t1",
+				TagIDs:      []string{"tg1"},
+			}).
+				Return(dto.TimeEntryImpl{ID: "te2", WorkspaceID: "w"}, nil).
+				Once()
+
+			c.EXPECT().GetHydratedTimeEntry(mock.Anything,
+				api.GetTimeEntryParam{
+					Workspace:   "w",
+					TimeEntryID: "te2",
+				}).
+				Return(&dto.TimeEntry{ID: "te2"}, nil)
+
+			cmd := resume.NewCmdResume(f)
+			out := bytes.NewBufferString("")
+			cmd.SetOut(out)
+			cmd.SetArgs(append(tt.args, "-q"))
+
+			_, err := cmd.ExecuteC()
+			assert.NoError(t, err)
+			assert.Equal(t, "te2\n", out.String())
+		})
+	}
+}
+
+func TestCmdResumeInvalidArgs(t *testing.T) {
+	tts := []struct {
+		args []string
+		err  string
+	}{
+		{
+			args: []string{"0"},
+			err:  "n must be a positive integer, you sent: 0",
+		},
+		{
+			args: []string{"--at", "someday"},
+			err:  "invalid --at: supported formats are: .+",
+		},
+	}
+
+	for _, tt := range tts {
+		cmd := resume.NewCmdResume(mocks.NewMockFactory(t))
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+		cmd.SetArgs(tt.args)
+
+		_, err := cmd.ExecuteC()
+		if assert.Error(t, err) {
+			assert.Regexp(t, "^"+tt.err+"$", err.Error())
+		}
+	}
+}
@@ -47,6 +47,8 @@ func NewCmdShow(f cmdutil.Factory) *cobra.Command {
 			62af668b49445270d7c092e4
 		`, "clockify-cli show"),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
 			if err := of.Check(); err != nil {
 				return err
 			}
@@ -71,12 +73,14 @@ func NewCmdShow(f cmdutil.Factory) *cobra.Command {
 				return err
 			}
 
-			tei, err := timeentryhlp.GetTimeEntry(c, w, userID, id)
+			tei, err := timeentryhlp.GetTimeEntry(ctx, c, w, userID,
+				id)
 			if err != nil {
 				return err
 			}
 
-			return util.PrintTimeEntryImpl(tei, f, cmd.OutOrStdout(), of)
+			return util.PrintTimeEntryImpl(ctx, tei, f,
+				cmd.OutOrStdout(), of)
 		},
 	}
 
@@ -0,0 +1,289 @@
+package split
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/timeentryhlp"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdSplit represents the split command
+func NewCmdSplit(f cmdutil.Factory) *cobra.Command {
+	of := util.OutputFlags{TimeFormat: timehlp.FullTimeFormat}
+	va := cmdcompl.ValidArgsSlide{
+		timeentryhlp.AliasCurrent, timeentryhlp.AliasLast}
+	var at []string
+
+	cmd := &cobra.Command{
+		Use: "split { <time-entry-id> | " + va.IntoUseOptions() +
+			" | ^<n> } --at <time>...",
+		Short: "Splits a time entry into consecutive time entries",
+		Long: heredoc.Docf(`
+			Splits a time entry into consecutive time entries at the moments
+			set with --at, each new time entry has the same project, task,
+			tags, description and custom fields of the original one.
+
+			The original time entry ends at the first moment, and a new time
+			entry starts at each moment, ending at the next one (the last ends
+			when the original did, or keeps running if it was).
+
+			Moments with only the time (like 14:30) are on the day the time
+			entry started.
+
+			If you want to split the current (running) time entry you can use
+			"%s" instead of its ID, for the last ended time entry use "%s".
+		`, timeentryhlp.AliasCurrent, timeentryhlp.AliasLast) + "\n" +
+			util.HelpMoreInfoAboutPrinting,
+		Example: heredoc.Docf(`
+			# split the last time entry at 14:30
+			$ %[1]s last --at 14:30
+
+			# split a time entry in three
+			$ %[1]s 62ae4b304ebb4f143c931d50 --at 14:30 --at 16:00
+		`, "clockify-cli split"),
+		Args:      cmdutil.RequiredNamedArgs("time entry id"),
+		ValidArgs: va.IntoValidArgs(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			if len(at) == 0 {
+				return cmdutil.FlagErrorWrap(
+					errors.New("at least one --at must be set"))
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			u, err := f.GetUserID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			tei, err := timeentryhlp.GetTimeEntry(ctx, c, w, u,
+				args[0])
+			if err != nil {
+				return err
+			}
+
+			te, err := c.GetHydratedTimeEntry(ctx, api.GetTimeEntryParam{
+				Workspace:   w,
+				TimeEntryID: tei.ID,
+			})
+			if err != nil {
+				return err
+			}
+
+			points, err := parsePoints(at, te.TimeInterval)
+			if err != nil {
+				return err
+			}
+
+			tes, err := split(ctx, c, w, *te, points)
+			if err != nil {
+				return err
+			}
+
+			return util.PrintTimeEntries(
+				tes, cmd.OutOrStdout(), f.Config(), of)
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&at, "at", []string{},
+		"moment to split the time entry, can be set multiple times")
+
+	util.AddPrintTimeEntriesFlags(cmd, &of)
+	util.AddPrintMultipleTimeEntriesFlags(cmd)
+
+	return cmd
+}
+
+// parsePoints reads the moments to split the time entry, sorted, they must
+// be inside its interval
+func parsePoints(at []string, ti dto.TimeInterval) ([]time.Time, error) {
+	end := timehlp.Now()
+	if ti.End != nil {
+		end = *ti.End
+	}
+
+	ps := make([]time.Time, len(at))
+	for i := range at {
+		var err error
+		if ps[i], err = parseAt(at[i], ti.Start); err != nil {
+			return nil, cmdutil.FlagErrorWrap(
+				errors.Wrapf(err, `invalid --at "%s"`, at[i]))
+		}
+
+		if !ps[i].After(ti.Start) || !ps[i].Before(end) {
+			return nil, cmdutil.FlagErrorWrap(errors.Errorf(
+				`--at "%s" must be between the start and end `+
+					"of the time entry", at[i]))
+		}
+	}
+
+	sort.Slice(ps, func(i, j int) bool { return ps[i].Before(ps[j]) })
+
+	for i := 1; i < len(ps); i++ {
+		if ps[i].Equal(ps[i-1]) {
+			return nil, cmdutil.FlagErrorWrap(errors.Errorf(
+				"--at %s is set more than once",
+				ps[i].In(time.Local).Format(timehlp.FullTimeFormat)))
+		}
+	}
+
+	return ps, nil
+}
+
+// parseAt reads times without date on the same day of ref
+func parseAt(s string, ref time.Time) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	for _, l := range []string{
+		timehlp.OnlyTimeFormat, timehlp.SimplerOnlyTimeFormat} {
+		t, err := time.Parse(l, s)
+		if err != nil {
+			continue
+		}
+
+		d := ref.In(time.Local)
+		return time.Date(d.Year(), d.Month(), d.Day(),
+			t.Hour(), t.Minute(), t.Second(), 0, time.Local), nil
+	}
+
+	return timehlp.ConvertToTime(s)
+}
+
+// split ends the time entry at the first point and creates a copy of it for
+// each point, returning all of them. When a copy fails to be created, the
+// time entry is restored and the copies already created are deleted, so no
+// time is lost
+func split(
+	ctx context.Context,
+	c api.Client, w string, te dto.TimeEntry, points []time.Time,
+) ([]dto.TimeEntry, error) {
+	if te.ProjectID == "" && te.Project != nil {
+		te.ProjectID = te.Project.ID
+	}
+
+	tagIDs := make([]string, len(te.Tags))
+	for i := range te.Tags {
+		tagIDs[i] = te.Tags[i].ID
+	}
+
+	taskID := ""
+	if te.Task != nil {
+		taskID = te.Task.ID
+	}
+
+	cfs := make([]dto.CustomFieldValue, 0, len(te.CustomFields))
+	for _, cf := range te.CustomFields {
+		if cf.Value != nil {
+			cfs = append(cfs, dto.CustomFieldValue{
+				CustomFieldID: cf.CustomFieldID,
+				Value:         cf.Value,
+			})
+		}
+	}
+
+	end := te.TimeInterval.End
+	first := points[0]
+	restore := api.UpdateTimeEntryParam{
+		Workspace:    w,
+		TimeEntryID:  te.ID,
+		Start:        te.TimeInterval.Start,
+		End:          end,
+		Billable:     te.Billable,
+		Description:  te.Description,
+		ProjectID:    te.ProjectID,
+		TaskID:       taskID,
+		TagIDs:       tagIDs,
+		CustomFields: cfs,
+	}
+
+	p := restore
+	p.End = &first
+	if _, err := c.UpdateTimeEntry(ctx, p); err != nil {
+		return nil, err
+	}
+
+	tes := make([]dto.TimeEntry, 0, len(points)+1)
+	te.TimeInterval.End = &first
+	tes = append(tes, te)
+
+	for i := range points {
+		n := te
+		n.TimeInterval = dto.TimeInterval{Start: points[i], End: end}
+		if i < len(points)-1 {
+			n.TimeInterval.End = &points[i+1]
+		}
+
+		r, err := c.CreateTimeEntry(ctx, api.CreateTimeEntryParam{
+			Workspace:    w,
+			Start:        n.TimeInterval.Start,
+			End:          n.TimeInterval.End,
+			Billable:     &te.Billable,
+			Description:  te.Description,
+			ProjectID:    te.ProjectID,
+			TaskID:       taskID,
+			TagIDs:       tagIDs,
+			CustomFields: cfs,
+		})
+		if err != nil {
+			err = errors.Wrapf(err,
+				"failed to create the time entry starting at %s",
+				points[i].In(time.Local).Format(timehlp.FullTimeFormat))
+			if rerr := rollback(ctx, c, restore, tes[1:]); rerr != nil {
+				return nil, errors.Errorf(
+					"%s, and failed to undo the split: %s", err, rerr)
+			}
+
+			return nil, err
+		}
+
+		n.ID = r.ID
+		tes = append(tes, n)
+	}
+
+	return tes, nil
+}
+
+// rollback restores the time entry split and deletes the copies created
+func rollback(
+	ctx context.Context,
+	c api.Client, restore api.UpdateTimeEntryParam, created []dto.TimeEntry,
+) error {
+	if _, err := c.UpdateTimeEntry(ctx, restore); err != nil {
+		return err
+	}
+
+	for i := range created {
+		if err := c.DeleteTimeEntry(ctx, api.DeleteTimeEntryParam{
+			Workspace:   restore.Workspace,
+			TimeEntryID: created[i].ID,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,307 @@
+package split_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/split"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCmdSplit(t *testing.T) {
+	start := time.Date(2022, 6, 1, 9, 0, 0, 0, time.Local)
+	end := start.Add(3 * time.Hour)
+	first := start.Add(time.Hour + 30*time.Minute)
+	second := start.Add(2 * time.Hour)
+
+	te := dto.TimeEntry{
+		ID:          "te1",
+		Description: "Writing docs",
+		Billable:    true,
+		Project:     &dto.Project{ID: "p1", Name: "CLI"},
+		Task:        &dto.Task{ID: "t1", Name: "Docs"},
+		Tags:        []dto.Tag{{ID: "tg1", Name: "Dev"}},
+		TimeInterval: dto.TimeInterval{
+			Start: start,
+			End:   &end,
+		},
+		CustomFields: []dto.TimeEntryCustomField{
+			{CustomFieldID: "cf1", Name: "Ticket", Value: "CLI-1"},
+			{CustomFieldID: "cf2", Name: "Empty"},
+		},
+	}
+
+	f := mocks.NewMockFactory(t)
+	f.EXPECT().GetWorkspaceID().Return("w", nil)
+	f.EXPECT().GetUserID().Return("u", nil)
+	f.EXPECT().Config().Return(&mocks.SimpleConfig{})
+
+	c := mocks.NewMockClient(t)
+	f.EXPECT().Client().Return(c, nil)
+
+	c.EXPECT().GetTimeEntry(mock.Anything, api.GetTimeEntryParam{
+		Workspace:   "w",
+		TimeEntryID: "te1",
+	}).
+		Return(&dto.TimeEntryImpl{ID: "te1"}, nil)
+
+	c.EXPECT().GetHydratedTimeEntry(mock.Anything, api.GetTimeEntryParam{
+		Workspace:   "w",
+		TimeEntryID: "te1",
+	}).
+		Return(&te, nil)
+
+	cfs := []dto.CustomFieldValue{{CustomFieldID: "cf1", Value: "CLI-1"}}
+	c.EXPECT().UpdateTimeEntry(mock.Anything, api.UpdateTimeEntryParam{
+		Workspace:    "w",
+		TimeEntryID:  "te1",
+		Start:        start,
+		End:          &first,
+		Billable:     true,
+		Description:  "Writing docs",
+		ProjectID:    "p1",
+		TaskID:       "t1",
+		TagIDs:       []string{"tg1"},
+		CustomFields: cfs,
+	}).
+		Return(dto.TimeEntryImpl{ID: "te1"}, nil).
+		Once()
+
+	b := true
+	c.EXPECT().CreateTimeEntry(mock.Anything, api.CreateTimeEntryParam{
+		Workspace:    "w",
+		Start:        first,
+		End:          &second,
+		Billable:     &b,
+		Description:  "Writing docs",
+		ProjectID:    "p1",
+		TaskID:       "t1",
+		TagIDs:       []string{"tg1"},
+		CustomFields: cfs,
+	}).
+		Return(dto.TimeEntryImpl{ID: "te2"}, nil).
+		Once()
+
+	c.EXPECT().CreateTimeEntry(mock.Anything, api.CreateTimeEntryParam{
+		Workspace:    "w",
+		Start:        second,
+		End:          &end,
+		Billable:     &b,
+		Description:  "Writing docs",
+		ProjectID:    "p1",
+		TaskID:       "t1",
+		TagIDs:       []string{"tg1"},
+		CustomFields: cfs,
+	}).
+		Return(dto.TimeEntryImpl{ID: "te3"}, nil).
+		Once()
+
+	cmd := split.NewCmdSplit(f)
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	out := bytes.NewBufferString("")
+	cmd.SetOut(out)
+	cmd.SetErr(out)
+	cmd.SetArgs([]string{"te1", "--at", "11:00", "--at", "10:30",
+		"--format", "{{.ID}} {{.TimeInterval.Start.Format \"15:04\"}}"})
+
+	_, err := cmd.ExecuteC()
+	assert.NoError(t, err)
+	assert.Equal(t, "te1 09:00\nte2 10:30\nte3 11:00\n", out.String())
+}
+
+func TestCmdSplitRestoresWhenCreateFails(t *testing.T) {
+	start := time.Date(2022, 6, 1, 9, 0, 0, 0, time.Local)
+	end := start.Add(3 * time.Hour)
+	first := start.Add(time.Hour + 30*time.Minute)
+	second := start.Add(2 * time.Hour)
+
+	te := dto.TimeEntry{
+		ID:          "te1",
+		Description: "Writing docs",
+		Project:     &dto.Project{ID: "p1", Name: "CLI"},
+		TimeInterval: dto.TimeInterval{
+			Start: start,
+			End:   &end,
+		},
+	}
+
+	f := mocks.NewMockFactory(t)
+	f.EXPECT().GetWorkspaceID().Return("w", nil)
+	f.EXPECT().GetUserID().Return("u", nil)
+
+	c := mocks.NewMockClient(t)
+	f.EXPECT().Client().Return(c, nil)
+
+	c.EXPECT().GetTimeEntry(mock.Anything, api.GetTimeEntryParam{
+		Workspace:   "w",
+		TimeEntryID: "te1",
+	}).
+		Return(&dto.TimeEntryImpl{ID: "te1"}, nil)
+
+	c.EXPECT().GetHydratedTimeEntry(mock.Anything, api.GetTimeEntryParam{
+		Workspace:   "w",
+		TimeEntryID: "te1",
+	}).
+		Return(&te, nil)
+
+	update := api.UpdateTimeEntryParam{
+		Workspace:    "w",
+		TimeEntryID:  "te1",
+		Start:        start,
+		End:          &first,
+		Description:  "Writing docs",
+		ProjectID:    "p1",
+		TagIDs:       []string{},
+		CustomFields: []dto.CustomFieldValue{},
+	}
+	c.EXPECT().UpdateTimeEntry(mock.Anything, update).
+		Return(dto.TimeEntryImpl{ID: "te1"}, nil).
+		Once()
+
+	b := false
+	c.EXPECT().CreateTimeEntry(mock.Anything, api.CreateTimeEntryParam{
+		Workspace:    "w",
+		Start:        first,
+		End:          &second,
+		Billable:     &b,
+		Description:  "Writing docs",
+		ProjectID:    "p1",
+		TagIDs:       []string{},
+		CustomFields: []dto.CustomFieldValue{},
+	}).
+		Return(dto.TimeEntryImpl{ID: "te2"}, nil).
+		Once()
+
+	c.EXPECT().CreateTimeEntry(mock.Anything, api.CreateTimeEntryParam{
+		Workspace:    "w",
+		Start:        second,
+		End:          &end,
+		Billable:     &b,
+		Description:  "Writing docs",
+		ProjectID:    "p1",
+		TagIDs:       []string{},
+		CustomFields: []dto.CustomFieldValue{},
+	}).
+		Return(dto.TimeEntryImpl{}, errors.New("rate limited")).
+		Once()
+
+	update.End = &end
+	c.EXPECT().UpdateTimeEntry(mock.Anything, update).
+		Return(dto.TimeEntryImpl{ID: "te1"}, nil).
+		Once()
+
+	c.EXPECT().DeleteTimeEntry(mock.Anything, api.DeleteTimeEntryParam{
+		Workspace:   "w",
+		TimeEntryID: "te2",
+	}).
+		Return(nil).
+		Once()
+
+	cmd := split.NewCmdSplit(f)
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	out := bytes.NewBufferString("")
+	cmd.SetOut(out)
+	cmd.SetErr(out)
+	cmd.SetArgs([]string{"te1", "--at", "11:00", "--at", "10:30"})
+
+	_, err := cmd.ExecuteC()
+	if assert.Error(t, err) {
+		assert.Regexp(t, "failed to create the time entry starting at .*: "+
+			"rate limited$", err.Error())
+	}
+	assert.Empty(t, out.String())
+}
+
+func TestCmdSplitInvalidPoints(t *testing.T) {
+	start := time.Date(2022, 6, 1, 9, 0, 0, 0, time.Local)
+	end := start.Add(3 * time.Hour)
+
+	tts := []struct {
+		name string
+		args []string
+		err  string
+	}{
+		{
+			name: "no at",
+			args: []string{"te1"},
+			err:  "at least one --at must be set",
+		},
+		{
+			name: "before start",
+			args: []string{"te1", "--at", "08:00"},
+			err: `--at "08:00" must be between the start and end ` +
+				"of the time entry",
+		},
+		{
+			name: "at the end",
+			args: []string{"te1", "--at", "12:00"},
+			err: `--at "12:00" must be between the start and end ` +
+				"of the time entry",
+		},
+		{
+			name: "duplicated",
+			args: []string{"te1", "--at", "10:00", "--at", "10:00:00"},
+			err:  "--at 2022-06-01 10:00:00 is set more than once",
+		},
+		{
+			name: "invalid",
+			args: []string{"te1", "--at", "half past ten"},
+			err:  `invalid --at "half past ten"`,
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			f := mocks.NewMockFactory(t)
+			f.EXPECT().GetWorkspaceID().Return("w", nil).Maybe()
+			f.EXPECT().GetUserID().Return("u", nil).Maybe()
+
+			c := mocks.NewMockClient(t)
+			f.EXPECT().Client().Return(c, nil).Maybe()
+
+			c.EXPECT().GetTimeEntry(mock.Anything, api.GetTimeEntryParam{
+				Workspace:   "w",
+				TimeEntryID: "te1",
+			}).
+				Return(&dto.TimeEntryImpl{ID: "te1"}, nil).
+				Maybe()
+
+			c.EXPECT().GetHydratedTimeEntry(mock.Anything,
+				api.GetTimeEntryParam{
+					Workspace:   "w",
+					TimeEntryID: "te1",
+				}).
+				Return(&dto.TimeEntry{
+					ID: "te1",
+					TimeInterval: dto.TimeInterval{
+						Start: start,
+						End:   &end,
+					},
+				}, nil).
+				Maybe()
+
+			cmd := split.NewCmdSplit(f)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+			cmd.SetOut(bytes.NewBufferString(""))
+			cmd.SetErr(bytes.NewBufferString(""))
+			cmd.SetArgs(tt.args)
+
+			_, err := cmd.ExecuteC()
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package add
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdAdd saves a time entry template on the config
+func NewCmdAdd(f cmdutil.Factory) *cobra.Command {
+	t := util.Template{}
+
+	cmd := &cobra.Command{
+		Use:   "add <name>",
+		Args:  cmdutil.RequiredNamedArgs("name"),
+		Short: "Creates or changes a time entry template",
+		Long: heredoc.Doc(`
+			Saves a template with the project, task, description, tags and
+			billable of a common time entry, which can be started with
+			"template use <name>" or "in --template <name>".
+
+			The values are kept as they were set, so names can be used
+			instead of IDs if "allow-name-for-id" is enabled.
+		`),
+		Example: heredoc.Docf(`
+			$ %[1]s standup -p meetings --task daily -d "daily standup" -T scrum
+			$ %[1]s support -p "Customer X" -d "support" --billable
+		`, "clockify-cli template add"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmdutil.XorFlagSet(
+				cmd.Flags(), "billable", "not-billable"); err != nil {
+				return err
+			}
+
+			t.Name = args[0]
+			if cmd.Flags().Changed("billable") ||
+				cmd.Flags().Changed("not-billable") {
+				b := cmd.Flags().Changed("billable")
+				t.Billable = &b
+			}
+
+			c := f.Config()
+			ts, err := util.LoadTemplates(c)
+			if err != nil {
+				return err
+			}
+
+			if i := util.FindTemplate(ts, t.Name); i != -1 {
+				ts[i] = t
+			} else {
+				ts = append(ts, t)
+			}
+
+			return util.SaveTemplates(c, ts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&t.ProjectID, "project", "p", "",
+		"project of the time entry")
+	_ = cmdcompl.AddSuggestionsToFlag(cmd, "project",
+		cmdcomplutil.NewProjectAutoComplete(f))
+	cmd.Flags().StringVar(&t.TaskID, "task", "", "task of the time entry")
+	_ = cmdcompl.AddSuggestionsToFlag(cmd, "task",
+		cmdcomplutil.NewTaskAutoComplete(f, true))
+	cmd.Flags().StringVarP(&t.Description, "description", "d", "",
+		"description of the time entry")
+	cmd.Flags().StringSliceVarP(&t.TagIDs, "tag", "T", []string{},
+		"tags of the time entry (can be used multiple times)")
+	_ = cmdcompl.AddSuggestionsToFlag(cmd, "tag",
+		cmdcomplutil.NewTagAutoComplete(f))
+	cmd.Flags().BoolP("billable", "b", false, "the time entry is billable")
+	cmd.Flags().BoolP("not-billable", "n", false,
+		"the time entry is not billable")
+
+	return cmd
+}
@@ -0,0 +1,88 @@
+package add_test
+
+import (
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/template/add"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/stretchr/testify/assert"
+)
+
+var standup = map[string]interface{}{
+	"name":        "standup",
+	"project":     "p1",
+	"description": "daily standup",
+}
+
+func TestCmdAdd(t *testing.T) {
+	tts := []struct {
+		name     string
+		args     []string
+		existing interface{}
+		set      []interface{}
+	}{
+		{
+			name: "first one",
+			args: []string{"standup", "-p", "p1", "-d", "daily standup"},
+			set:  []interface{}{standup},
+		},
+		{
+			name: "all values",
+			args: []string{"support", "-p", "p2", "--task", "t2",
+				"-d", "support", "-T", "tg1", "-T", "tg2", "-n"},
+			existing: []interface{}{standup},
+			set: []interface{}{
+				standup,
+				map[string]interface{}{
+					"name":        "support",
+					"project":     "p2",
+					"task":        "t2",
+					"description": "support",
+					"tags":        []interface{}{"tg1", "tg2"},
+					"billable":    false,
+				},
+			},
+		},
+		{
+			name:     "changes existing",
+			args:     []string{"Standup", "-p", "p3", "-b"},
+			existing: []interface{}{standup},
+			set: []interface{}{map[string]interface{}{
+				"name":     "Standup",
+				"project":  "p3",
+				"billable": true,
+			}},
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			f := mocks.NewMockFactory(t)
+			c := mocks.NewMockConfig(t)
+			f.EXPECT().Config().Return(c)
+
+			c.EXPECT().Get(cmdutil.CONF_TIME_ENTRY_TEMPLATES).
+				Return(tt.existing)
+			c.EXPECT().Set(cmdutil.CONF_TIME_ENTRY_TEMPLATES, tt.set).Once()
+			c.EXPECT().Save().Return(nil).Once()
+
+			cmd := add.NewCmdAdd(f)
+			cmd.SetArgs(tt.args)
+
+			_, err := cmd.ExecuteC()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCmdAddBillableAndNotBillable(t *testing.T) {
+	cmd := add.NewCmdAdd(mocks.NewMockFactory(t))
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	cmd.SetArgs([]string{"standup", "-b", "-n"})
+
+	_, err := cmd.ExecuteC()
+	assert.Error(t, err)
+}
@@ -0,0 +1,65 @@
+package list
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	outpututil "github.com/lucassabreu/clockify-cli/pkg/output/util"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdList shows the time entry templates saved on the config
+func NewCmdList(f cmdutil.Factory) *cobra.Command {
+	var asJSON bool
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Short:   "List the time entry templates",
+		Example: heredoc.Doc(`
+			$ clockify-cli template list
+			+---------+----------+-------+---------------+-------+----------+
+			|  NAME   | PROJECT  | TASK  |  DESCRIPTION  | TAGS  | BILLABLE |
+			+---------+----------+-------+---------------+-------+----------+
+			| standup | meetings | daily | daily standup | scrum |          |
+			+---------+----------+-------+---------------+-------+----------+
+		`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ts, err := util.LoadTemplates(f.Config())
+			if err != nil {
+				return err
+			}
+
+			if asJSON {
+				return json.NewEncoder(cmd.OutOrStdout()).Encode(ts)
+			}
+
+			tw := tablewriter.NewWriter(cmd.OutOrStdout())
+			outpututil.SetThemedHeader(tw, []string{"Name", "Project",
+				"Task", "Description", "Tags", "Billable"})
+			for _, t := range ts {
+				b := ""
+				if t.Billable != nil && *t.Billable {
+					b = "yes"
+				} else if t.Billable != nil {
+					b = "no"
+				}
+
+				tw.Append([]string{t.Name, t.ProjectID, t.TaskID,
+					t.Description, strings.Join(t.TagIDs, ", "), b})
+			}
+			tw.Render()
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&asJSON, "json", "j", false, "print as JSON")
+
+	return cmd
+}
@@ -0,0 +1,41 @@
+package remove
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdRemove removes a time entry template from the config
+func NewCmdRemove(f cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove <name>",
+		Aliases: []string{"rm"},
+		Args:    cmdutil.RequiredNamedArgs("name"),
+		ValidArgsFunction: cmdcompl.CombineSuggestionsToArgs(
+			util.NewTemplateAutoComplete(f)),
+		Short: "Removes a time entry template",
+		Example: heredoc.Doc(`
+			$ clockify-cli template remove standup
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c := f.Config()
+			ts, err := util.LoadTemplates(c)
+			if err != nil {
+				return err
+			}
+
+			i := util.FindTemplate(ts, args[0])
+			if i == -1 {
+				return errors.Errorf("template \"%s\" does not exist", args[0])
+			}
+
+			return util.SaveTemplates(c, append(ts[:i], ts[i+1:]...))
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,40 @@
+package template
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/template/add"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/template/list"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/template/remove"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/template/use"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdTemplate represents the template command
+func NewCmdTemplate(f cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "template",
+		Short: "Manages templates of common time entries",
+		Args:  cobra.MaximumNArgs(0),
+		Long: heredoc.Doc(`
+			Manages time entry templates, which keep the project, task,
+			description, tags and billable of routine time entries, so they
+			can be started without setting each of them.
+
+			The templates are saved on the config, and can be started with
+			"template use <name>" or "in --template <name>".
+		`),
+		Example: heredoc.Doc(`
+			$ clockify-cli template add standup -p meetings -d "daily standup"
+			$ clockify-cli template use standup
+			$ clockify-cli in --template standup -s 09:30
+		`),
+	}
+
+	cmd.AddCommand(add.NewCmdAdd(f))
+	cmd.AddCommand(list.NewCmdList(f))
+	cmd.AddCommand(remove.NewCmdRemove(f))
+	cmd.AddCommand(use.NewCmdUse(f))
+
+	return cmd
+}
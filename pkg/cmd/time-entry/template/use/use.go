@@ -0,0 +1,47 @@
+package use
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/in"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdUse starts a time entry using a template, the same as running "in"
+// with --template
+func NewCmdUse(f cmdutil.Factory) *cobra.Command {
+	cmd := in.NewCmdIn(f, nil)
+	run := cmd.RunE
+
+	cmd.Use = "use <name>"
+	cmd.Aliases = nil
+	cmd.Short = "Starts a time entry using a template"
+	cmd.Long = heredoc.Doc(`
+		Starts a time entry with the project, task, description, tags and
+		billable of the template, the same as "clockify-cli in --template".
+
+		The flags set are used instead of the values of the template.
+	`) + "\n" +
+		util.HelpTimeEntryNowIfNotSet + "\n" +
+		util.HelpMoreInfoAboutPrinting
+	cmd.Example = heredoc.Docf(`
+		$ %[1]s standup
+		$ %[1]s standup -d "sprint review" -s 14:00
+	`, "clockify-cli template use")
+	cmd.Args = cmdutil.RequiredNamedArgs("name")
+	cmd.ValidArgsFunction = cmdcompl.CombineSuggestionsToArgs(
+		util.NewTemplateAutoComplete(f))
+
+	_ = cmd.Flags().MarkHidden("template")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if err := cmd.Flags().Set("template", args[0]); err != nil {
+			return err
+		}
+
+		return run(cmd, nil)
+	}
+
+	return cmd
+}
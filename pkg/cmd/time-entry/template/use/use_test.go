@@ -0,0 +1,85 @@
+package use_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/template/use"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCmdUse(t *testing.T) {
+	f := mocks.NewMockFactory(t)
+	f.EXPECT().GetUserID().Return("u", nil)
+	f.EXPECT().GetWorkspaceID().Return("w", nil)
+	f.EXPECT().GetWorkspace().Return(dto.Workspace{ID: "w"}, nil).Maybe()
+
+	conf := mocks.NewMockConfig(t)
+	f.EXPECT().Config().Return(conf)
+	conf.EXPECT().Get(cmdutil.CONF_TIME_ENTRY_TEMPLATES).Return(
+		[]interface{}{map[string]interface{}{
+			"name":        "standup",
+			"project":     "p1",
+			"task":        "t1",
+			"description": "daily standup",
+			"tags":        []interface{}{"tg1"},
+		}})
+	// skips the validation of the project and task
+	conf.EXPECT().GetBool(cmdutil.CONF_ALLOW_INCOMPLETE).Return(true)
+	conf.EXPECT().GetBool(mock.Anything).Return(false).Maybe()
+	conf.EXPECT().IsInteractive().Return(false).Maybe()
+	conf.EXPECT().SetBool(mock.Anything, mock.Anything).Maybe()
+	conf.EXPECT().GetString(mock.Anything).Return("").Maybe()
+
+	c := mocks.NewMockClient(t)
+	f.EXPECT().Client().Return(c, nil)
+
+	c.EXPECT().GetTimeEntryInProgress(mock.Anything,
+		api.GetTimeEntryInProgressParam{
+			Workspace: "w",
+			UserID:    "u",
+		}).
+		Return(nil, nil)
+
+	start := timehlp.Today().Add(9*time.Hour + 30*time.Minute)
+	c.EXPECT().Out(mock.Anything, api.OutParam{
+		Workspace: "w",
+		UserID:    "u",
+		End:       start,
+	}).
+		Return(api.ErrorNotFound)
+
+	c.EXPECT().CreateTimeEntry(mock.Anything, api.CreateTimeEntryParam{
+		Workspace:   "w",
+		Start:       start,
+		ProjectID:   "p1",
+		TaskID:      "t1",
+		Description: "sprint review",
+		TagIDs:      []string{"tg1"},
+	}).
+		Return(dto.TimeEntryImpl{ID: "te1", WorkspaceID: "w"}, nil).
+		Once()
+
+	c.EXPECT().GetHydratedTimeEntry(mock.Anything, api.GetTimeEntryParam{
+		Workspace:   "w",
+		TimeEntryID: "te1",
+	}).
+		Return(&dto.TimeEntry{ID: "te1"}, nil)
+
+	cmd := use.NewCmdUse(f)
+	out := bytes.NewBufferString("")
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{"standup", "-d", "sprint review", "-s", "09:30",
+		"-q"})
+
+	_, err := cmd.ExecuteC()
+	assert.NoError(t, err)
+	assert.Equal(t, "te1\n", out.String())
+}
@@ -1,16 +1,27 @@
 package timeentry
 
 import (
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/backup"
+	breakcmd "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/break"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/clone"
 	del "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/delete"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/doctor"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/edit"
 	em "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/edit-multipple"
+	importcmd "github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/import"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/in"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/invoiced"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/manual"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/merge"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/out"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/recur"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/report"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/restore"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/resume"
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/show"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/split"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/template"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/watch"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 	"github.com/spf13/cobra"
 )
@@ -22,16 +33,28 @@ func NewCmdTimeEntry(f cmdutil.Factory) (cmds []*cobra.Command) {
 		in.NewCmdIn(f, nil),
 		manual.NewCmdManual(f),
 		clone.NewCmdClone(f),
+		resume.NewCmdResume(f),
+		importcmd.NewCmdImport(f),
+		recur.NewCmdRecur(f),
+		template.NewCmdTemplate(f),
 
 		edit.NewCmdEdit(f, nil),
 		em.NewCmdEditMultiple(f),
 
 		out.NewCmdOut(f),
+		breakcmd.NewCmdBreak(f),
+		split.NewCmdSplit(f),
+		merge.NewCmdMerge(f),
+		doctor.NewCmdDoctor(f),
 
 		del.NewCmdDelete(f),
 
 		show.NewCmdShow(f),
+		watch.NewCmdWatch(f),
 		report.NewCmdReport(f),
+
+		backup.NewCmdBackup(f),
+		restore.NewCmdRestore(f),
 	)
 
 	cmds = append(cmds, invoiced.NewCmdInvoiced(f)...)
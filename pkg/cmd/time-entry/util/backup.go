@@ -0,0 +1,51 @@
+package util
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/pkg/errors"
+)
+
+// BackupVersion is the version of the format of the backup files, it
+// changes when the format is not compatible with the previous one
+const BackupVersion = 1
+
+// Backup are the time entries of a user on a workspace, saved by the
+// backup command to be restored later
+type Backup struct {
+	Version     int             `json:"version"`
+	CreatedAt   time.Time       `json:"createdAt"`
+	WorkspaceID string          `json:"workspaceId"`
+	UserID      string          `json:"userId"`
+	Since       time.Time       `json:"since"`
+	Until       time.Time       `json:"until"`
+	TimeEntries []dto.TimeEntry `json:"timeEntries"`
+}
+
+// WriteBackup writes the backup as JSON
+func WriteBackup(w io.Writer, b Backup) error {
+	b.Version = BackupVersion
+
+	e := json.NewEncoder(w)
+	e.SetIndent("", "  ")
+	return errors.Wrap(e.Encode(b), "failed to write the backup")
+}
+
+// ReadBackup reads a backup written by WriteBackup
+func ReadBackup(r io.Reader) (Backup, error) {
+	var b Backup
+	if err := json.NewDecoder(r).Decode(&b); err != nil {
+		return b, errors.Wrap(err, "failed to read the backup")
+	}
+
+	if b.Version != BackupVersion {
+		return b, errors.Errorf(
+			"backup version %d is not supported, only version %d is",
+			b.Version, BackupVersion)
+	}
+
+	return b, nil
+}
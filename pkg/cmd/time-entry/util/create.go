@@ -1,13 +1,15 @@
 package util
 
 import (
+	"context"
+
 	"github.com/lucassabreu/clockify-cli/api"
 )
 
 // CreateTimeEntryFn will create a time entry
-func CreateTimeEntryFn(c api.Client) Step {
+func CreateTimeEntryFn(ctx context.Context, c api.Client) Step {
 	return func(dto TimeEntryDTO) (TimeEntryDTO, error) {
-		te, err := c.CreateTimeEntry(api.CreateTimeEntryParam{
+		te, err := c.CreateTimeEntry(ctx, api.CreateTimeEntryParam{
 			Workspace:   dto.Workspace,
 			Billable:    dto.Billable,
 			Start:       dto.Start,
@@ -16,6 +18,8 @@ func CreateTimeEntryFn(c api.Client) Step {
 			Description: dto.Description,
 			TagIDs:      dto.TagIDs,
 			TaskID:      dto.TaskID,
+
+			CustomFields: dto.CustomFields,
 		})
 
 		if err != nil {
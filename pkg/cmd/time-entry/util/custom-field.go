@@ -0,0 +1,116 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/strhlp"
+)
+
+// LookupCustomFieldsFn will find the custom fields informed by name or id
+// on the workspace and convert their values to the type of each field
+func LookupCustomFieldsFn(ctx context.Context, c api.Client) Step {
+	return func(te TimeEntryDTO) (TimeEntryDTO, error) {
+		if len(te.CustomFields) == 0 {
+			return te, nil
+		}
+
+		fields, err := c.GetCustomFields(ctx, api.GetCustomFieldsParam{
+			Workspace: te.Workspace,
+		})
+		if err != nil {
+			return te, err
+		}
+
+		cfvs := make([]dto.CustomFieldValue, len(te.CustomFields))
+		for i, cfv := range te.CustomFields {
+			f, ok := findCustomField(fields, cfv.CustomFieldID)
+			if !ok {
+				return te, fmt.Errorf(
+					"no custom field with id or name '%s' was found",
+					cfv.CustomFieldID)
+			}
+
+			v, _ := cfv.Value.(string)
+			if cfv.Value, err = customFieldValue(f, v); err != nil {
+				return te, err
+			}
+
+			cfv.CustomFieldID = f.ID
+			cfvs[i] = cfv
+		}
+
+		te.CustomFields = cfvs
+		return te, nil
+	}
+}
+
+func findCustomField(
+	fields []dto.WorkspaceCustomField, s string) (
+	dto.WorkspaceCustomField, bool) {
+	for i := range fields {
+		if fields[i].ID == s {
+			return fields[i], true
+		}
+	}
+
+	for i := range fields {
+		if strings.EqualFold(fields[i].Name, s) {
+			return fields[i], true
+		}
+	}
+
+	return dto.WorkspaceCustomField{}, false
+}
+
+// customFieldValue converts the value informed into the one expected by the
+// type of the custom field
+func customFieldValue(f dto.WorkspaceCustomField, v string) (
+	interface{}, error) {
+	switch f.Type {
+	case dto.CustomFieldTypeNumber:
+		n, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"custom field %s should be a number, not '%s'", f.Name, v)
+		}
+		return n, nil
+	case dto.CustomFieldTypeCheckbox:
+		b, err := strconv.ParseBool(strings.TrimSpace(v))
+		if err != nil {
+			return nil, fmt.Errorf(
+				"custom field %s should be true or false, not '%s'",
+				f.Name, v)
+		}
+		return b, nil
+	case dto.CustomFieldTypeDropdownSingle:
+		return allowedValue(f, v)
+	case dto.CustomFieldTypeDropdownMultiple:
+		vs := strings.Split(v, ",")
+		for i := range vs {
+			var err error
+			if vs[i], err = allowedValue(f, vs[i]); err != nil {
+				return nil, err
+			}
+		}
+		return vs, nil
+	default:
+		return v, nil
+	}
+}
+
+func allowedValue(f dto.WorkspaceCustomField, v string) (string, error) {
+	v = strings.TrimSpace(v)
+	for _, a := range f.AllowedValues {
+		if strings.EqualFold(a, v) {
+			return a, nil
+		}
+	}
+
+	return "", fmt.Errorf("valid options for custom field %s are %s",
+		f.Name, strhlp.ListForHumans(f.AllowedValues))
+}
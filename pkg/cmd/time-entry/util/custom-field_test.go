@@ -0,0 +1,92 @@
+package util
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestLookupCustomFields(t *testing.T) {
+	fields := []dto.WorkspaceCustomField{
+		{ID: "cf1", Name: "Ticket", Type: dto.CustomFieldTypeText},
+		{ID: "cf2", Name: "Hours", Type: dto.CustomFieldTypeNumber},
+		{ID: "cf3", Name: "Remote", Type: dto.CustomFieldTypeCheckbox},
+		{
+			ID: "cf4", Name: "Kind", Type: dto.CustomFieldTypeDropdownMultiple,
+			AllowedValues: []string{"Bug", "Feature"},
+		},
+	}
+
+	tts := []struct {
+		name   string
+		input  []dto.CustomFieldValue
+		output []dto.CustomFieldValue
+		err    string
+	}{
+		{
+			name: "by name and id",
+			input: []dto.CustomFieldValue{
+				{CustomFieldID: "ticket", Value: "ABC-1"},
+				{CustomFieldID: "cf2", Value: "1.5"},
+				{CustomFieldID: "Remote", Value: "true"},
+				{CustomFieldID: "kind", Value: "bug,feature"},
+			},
+			output: []dto.CustomFieldValue{
+				{CustomFieldID: "cf1", Value: "ABC-1"},
+				{CustomFieldID: "cf2", Value: 1.5},
+				{CustomFieldID: "cf3", Value: true},
+				{CustomFieldID: "cf4", Value: []string{"Bug", "Feature"}},
+			},
+		},
+		{
+			name: "not found",
+			input: []dto.CustomFieldValue{
+				{CustomFieldID: "Other", Value: "1"},
+			},
+			err: "no custom field with id or name 'Other' was found",
+		},
+		{
+			name: "not a number",
+			input: []dto.CustomFieldValue{
+				{CustomFieldID: "Hours", Value: "one"},
+			},
+			err: "custom field Hours should be a number, not 'one'",
+		},
+		{
+			name: "not allowed",
+			input: []dto.CustomFieldValue{
+				{CustomFieldID: "Kind", Value: "Chore"},
+			},
+			err: "valid options for custom field Kind are Bug and Feature",
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			c := mocks.NewMockClient(t)
+			c.EXPECT().GetCustomFields(mock.Anything, api.GetCustomFieldsParam{
+				Workspace: "w",
+			}).Return(fields, nil)
+
+			te, err := LookupCustomFieldsFn(context.Background(),
+				c)(TimeEntryDTO{
+				Workspace:    "w",
+				CustomFields: tt.input,
+			})
+
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.output, te.CustomFields)
+		})
+	}
+}
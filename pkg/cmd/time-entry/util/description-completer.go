@@ -1,6 +1,7 @@
 package util
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -23,8 +24,10 @@ type descriptionCompleter struct {
 	descriptions []string
 }
 
-// NewDescriptionCompleter create or not a descriptionCompleter based on params
-func NewDescriptionCompleter(f cmdutil.Factory) DescriptionSuggestFn {
+// NewDescriptionCompleter create or not a descriptionCompleter based on params,
+// the recent time entries are loaded using ctx
+func NewDescriptionCompleter(
+	ctx context.Context, f cmdutil.Factory) DescriptionSuggestFn {
 	if !f.Config().GetBool(cmdutil.CONF_DESCR_AUTOCOMP) {
 		return func(s string) []string { return []string{} }
 	}
@@ -58,17 +61,18 @@ func NewDescriptionCompleter(f cmdutil.Factory) DescriptionSuggestFn {
 		},
 	}
 
-	return d.suggestFn
+	return func(s string) []string { return d.suggestFn(ctx, s) }
 }
 
 // getDescriptions load descriptions from recent time entries and list than
 // unique ones
-func (dc *descriptionCompleter) getDescriptions() []string {
+func (dc *descriptionCompleter) getDescriptions(
+	ctx context.Context) []string {
 	if dc.loaded {
 		return dc.descriptions
 	}
 
-	tes, err := dc.client.GetUserTimeEntries(dc.param)
+	tes, err := dc.client.GetUserTimeEntries(ctx, dc.param)
 
 	dc.loaded = true
 	if err != nil {
@@ -85,10 +89,11 @@ func (dc *descriptionCompleter) getDescriptions() []string {
 }
 
 // suggestFn returns a list of suggested descriptions based on a input string
-func (dc *descriptionCompleter) suggestFn(toComplete string) []string {
+func (dc *descriptionCompleter) suggestFn(
+	ctx context.Context, toComplete string) []string {
 	toComplete = strings.TrimSpace(toComplete)
 	if toComplete == "" {
-		return dc.getDescriptions()
+		return dc.getDescriptions(ctx)
 	}
 
 	toComplete = strhlp.Normalize(toComplete)
@@ -96,19 +101,19 @@ func (dc *descriptionCompleter) suggestFn(toComplete string) []string {
 		func(s string) bool {
 			return strings.Contains(strhlp.Normalize(s), toComplete)
 		},
-		dc.getDescriptions(),
+		dc.getDescriptions(ctx),
 	)
 }
 
 func newDescriptionAutoComplete(f cmdutil.Factory) cmdcompl.SuggestFn {
 	return func(
-		_ *cobra.Command, _ []string, toComplete string,
+		cmd *cobra.Command, _ []string, toComplete string,
 	) (cmdcompl.ValidArgs, error) {
 		if !f.Config().GetBool(cmdutil.CONF_DESCR_AUTOCOMP) {
 			return cmdcompl.EmptyValidArgs(), nil
 		}
 
-		dc := NewDescriptionCompleter(f)
+		dc := NewDescriptionCompleter(cmd.Context(), f)
 		return cmdcompl.ValidArgsSlide(dc(toComplete)), nil
 	}
 }
@@ -1,8 +1,11 @@
 package util
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
+	"github.com/lucassabreu/clockify-cli/api/dto"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
 )
@@ -11,6 +14,7 @@ type flagSet interface {
 	Changed(string) bool
 	GetString(string) (string, error)
 	GetStringSlice(string) ([]string, error)
+	GetStringArray(string) ([]string, error)
 }
 
 // FillTimeEntryWithFlags will read the flags and fill the time entry with they
@@ -47,6 +51,15 @@ func FillTimeEntryWithFlags(flags flagSet) Step {
 			dto.TagIDs, _ = flags.GetStringSlice("tags")
 		}
 
+		if flags.Changed("custom-field") {
+			cfs, _ := flags.GetStringArray("custom-field")
+			cfvs, err := parseCustomFields(cfs)
+			if err != nil {
+				return dto, err
+			}
+			dto.CustomFields = cfvs
+		}
+
 		if flags.Changed("billable") {
 			b := true
 			dto.Billable = &b
@@ -79,3 +92,24 @@ func FillTimeEntryWithFlags(flags flagSet) Step {
 		return dto, nil
 	}
 }
+
+// parseCustomFields reads the values of custom-field, which are like
+// "name=value"
+func parseCustomFields(cfs []string) ([]dto.CustomFieldValue, error) {
+	cfvs := make([]dto.CustomFieldValue, len(cfs))
+	for i := range cfs {
+		p := strings.SplitN(cfs[i], "=", 2)
+		if len(p) != 2 || strings.TrimSpace(p[0]) == "" {
+			return nil, cmdutil.FlagErrorWrap(fmt.Errorf(
+				"custom-field should be like \"name=value\", \"%s\" is not",
+				cfs[i]))
+		}
+
+		cfvs[i] = dto.CustomFieldValue{
+			CustomFieldID: strings.TrimSpace(p[0]),
+			Value:         p[1],
+		}
+	}
+
+	return cfvs, nil
+}
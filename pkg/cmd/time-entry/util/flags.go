@@ -26,6 +26,10 @@ func AddTimeEntryFlags(
 	_ = cmdcompl.AddSuggestionsToFlag(cmd, "tag",
 		cmdcomplutil.NewTagAutoComplete(f))
 
+	cmd.Flags().StringArray("custom-field", []string{},
+		"sets a custom field of the entry, like: \"Ticket=ABC-123\" "+
+			"(can be used multiple times)")
+
 	cmd.Flags().BoolP("allow-incomplete", "A", false,
 		"allow creation of incomplete time entries to be edited later")
 
@@ -0,0 +1,67 @@
+package util
+
+import (
+	"os"
+
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/gitrepo"
+	"github.com/pkg/errors"
+)
+
+// FillTimeEntryWithGit fills the values not set on the time entry using the
+// git repository of the current directory: the project, task, tags and
+// billable come from its gitrepo.ConfigFile and the description is the
+// ticket ID found on the branch name, or the branch name itself
+func FillTimeEntryWithGit(c cmdutil.Config, fromGit bool) Step {
+	if !fromGit {
+		return skip
+	}
+
+	return func(te TimeEntryDTO) (TimeEntryDTO, error) {
+		dir, err := os.Getwd()
+		if err != nil {
+			return te, errors.WithStack(err)
+		}
+
+		r, err := gitrepo.Open(dir)
+		if err != nil {
+			return te, err
+		}
+
+		if te.ProjectID == "" {
+			te.ProjectID = r.Config.Project
+			te.TaskID = r.Config.Task
+		} else if te.TaskID == "" && te.ProjectID == r.Config.Project {
+			te.TaskID = r.Config.Task
+		}
+
+		if len(te.TagIDs) == 0 {
+			te.TagIDs = r.Config.Tags
+		}
+
+		if te.Billable == nil {
+			te.Billable = r.Config.Billable
+		}
+
+		if te.Description != "" {
+			return te, nil
+		}
+
+		p := r.Config.TicketPattern
+		if p == "" {
+			p = c.GetString(cmdutil.CONF_GIT_TICKET_PATTERN)
+		}
+
+		ticket, ok, err := gitrepo.Ticket(r.Branch, p)
+		if err != nil {
+			return te, err
+		}
+
+		te.Description = r.Branch
+		if ok {
+			te.Description = ticket
+		}
+
+		return te, nil
+	}
+}
@@ -0,0 +1,98 @@
+package util
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/github"
+	"github.com/lucassabreu/clockify-cli/pkg/gitrepo"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// GitHubPullRequestOfBranch is the value of --gh to use the pull request of
+// the current branch
+const GitHubPullRequestOfBranch = "pr"
+
+// AddGitHubFlag adds the flag to relate the time entry with an issue or pull
+// request of GitHub
+func AddGitHubFlag(cmd *cobra.Command, v *string) {
+	cmd.Flags().StringVar(v, "gh", "",
+		"reference of a GitHub issue or pull request (owner/repo#123, or "+
+			"#123 for the repository of the remote origin, or \""+
+			GitHubPullRequestOfBranch+"\" for the one of the current "+
+			"branch), its title is used as description (if not set) and "+
+			"the reference is added to it")
+}
+
+// FillTimeEntryWithGitHub uses the title of the GitHub issue or pull request
+// as description, when it is not set, and adds its reference to the
+// description, so it can be found by "report --gh"
+func FillTimeEntryWithGitHub(conf cmdutil.Config, ref string) Step {
+	if ref == "" {
+		return skip
+	}
+
+	return func(te TimeEntryDTO) (TimeEntryDTO, error) {
+		i, err := findGitHubIssue(cmdutil.GitHubClient(conf), ref)
+		if err != nil {
+			return te, err
+		}
+
+		r := i.Ref.String()
+		switch {
+		case te.Description == "":
+			te.Description = i.Title + " (" + r + ")"
+		case !i.Ref.In(te.Description):
+			te.Description = te.Description + " (" + r + ")"
+		}
+
+		return te, nil
+	}
+}
+
+func findGitHubIssue(c *github.Client, ref string) (github.Issue, error) {
+	if r, err := github.ParseRef(ref); err == nil {
+		return c.Issue(r)
+	}
+
+	n := 0
+	if strings.HasPrefix(ref, "#") {
+		n, _ = strconv.Atoi(ref[1:])
+	}
+
+	if n < 1 && ref != GitHubPullRequestOfBranch {
+		return github.Issue{}, cmdutil.FlagErrorWrap(errors.Errorf(
+			"`gh` must be like owner/repo#123, #123 or \"%s\", not \"%s\"",
+			GitHubPullRequestOfBranch, ref))
+	}
+
+	dir, err := os.Getwd()
+	if err != nil {
+		return github.Issue{}, errors.WithStack(err)
+	}
+
+	r, err := gitrepo.Open(dir)
+	if err != nil {
+		return github.Issue{}, err
+	}
+
+	u, err := gitrepo.RemoteURL(r.Root, "origin")
+	if err != nil {
+		return github.Issue{}, err
+	}
+
+	owner, repo, ok := github.ParseRemote(u)
+	if !ok {
+		return github.Issue{}, errors.Errorf(
+			"the remote origin (%s) is not on GitHub", u)
+	}
+
+	if n == 0 {
+		return c.PullRequestOf(owner, repo, r.Branch)
+	}
+
+	return c.Issue(github.Ref{Owner: owner, Repo: repo, Number: n})
+}
@@ -0,0 +1,115 @@
+package util
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFillTimeEntryWithGitHub(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/repos/acme/cli/issues/12":
+				_, _ = w.Write([]byte(`{"number":12,"title":"Fix login"}`))
+			case "/repos/acme/cli/pulls":
+				assert.Equal(t, "acme:docs", r.URL.Query().Get("head"))
+				_, _ = w.Write([]byte(`[{"number":13,"title":"Add docs"}]`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+	defer s.Close()
+
+	t.Setenv("GH_TOKEN", "")
+	t.Setenv("GITHUB_TOKEN", "")
+	conf := mocks.NewMockConfig(t)
+	conf.EXPECT().GetString(cmdutil.CONF_GITHUB_TOKEN).Return("")
+	conf.EXPECT().GetString(cmdutil.CONF_GITHUB_API_URL).Return(s.URL)
+
+	tts := []struct {
+		name string
+		ref  string
+		desc string
+		r    string
+	}{
+		{
+			name: "title as description",
+			ref:  "acme/cli#12",
+			r:    "Fix login (acme/cli#12)",
+		},
+		{
+			name: "with description",
+			ref:  "acme/cli#12",
+			desc: "reviewing",
+			r:    "reviewing (acme/cli#12)",
+		},
+		{
+			name: "already referenced",
+			ref:  "acme/cli#12",
+			desc: "acme/cli#12 review",
+			r:    "acme/cli#12 review",
+		},
+		{
+			name: "number on origin",
+			ref:  "#12",
+			r:    "Fix login (acme/cli#12)",
+		},
+		{
+			name: "pull request of the branch",
+			ref:  GitHubPullRequestOfBranch,
+			r:    "Add docs (acme/cli#13)",
+		},
+	}
+
+	chdirToRepo(t, "git@github.com:acme/cli.git")
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			te, err := FillTimeEntryWithGitHub(conf, tt.ref)(
+				TimeEntryDTO{Description: tt.desc})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.r, te.Description)
+		})
+	}
+
+	_, err := FillTimeEntryWithGitHub(conf, "cli#12")(TimeEntryDTO{})
+	assert.EqualError(t, err,
+		"`gh` must be like owner/repo#123, #123 or \"pr\", not \"cli#12\"")
+}
+
+// chdirToRepo changes into a new git repository on the branch "docs", with
+// the remote as origin
+func chdirToRepo(t *testing.T, remote string) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	dir := t.TempDir()
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"checkout", "-q", "-b", "docs"},
+		{"remote", "add", "origin", remote},
+	} {
+		cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %s", args, out)
+		}
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.Chdir(wd) })
+}
@@ -0,0 +1,98 @@
+package util
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/gitlab"
+	"github.com/lucassabreu/clockify-cli/pkg/gitrepo"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// GitLabMergeRequestOfBranch is the value of --gl to use the merge request
+// of the current branch
+const GitLabMergeRequestOfBranch = "mr"
+
+// AddGitLabFlag adds the flag to relate the time entry with an issue or
+// merge request of GitLab
+func AddGitLabFlag(cmd *cobra.Command, v *string) {
+	cmd.Flags().StringVar(v, "gl", "",
+		"reference of a GitLab merge request or issue (group/project!42 or "+
+			"group/project#42, or !42 and #42 for the project of the "+
+			"remote origin, or \""+GitLabMergeRequestOfBranch+"\" for the "+
+			"one of the current branch), its title is used as description "+
+			"(if not set) and the reference is added to it")
+}
+
+// FillTimeEntryWithGitLab uses the title of the GitLab issue or merge
+// request as description, when it is not set, and adds its reference to the
+// description, so it can be found by "report --gl" and "gitlab spend push"
+func FillTimeEntryWithGitLab(conf cmdutil.Config, ref string) Step {
+	if ref == "" {
+		return skip
+	}
+
+	return func(te TimeEntryDTO) (TimeEntryDTO, error) {
+		i, err := findGitLabIssue(cmdutil.GitLabClient(conf), ref)
+		if err != nil {
+			return te, err
+		}
+
+		r := i.Ref.String()
+		switch {
+		case te.Description == "":
+			te.Description = i.Title + " (" + r + ")"
+		case !i.Ref.In(te.Description):
+			te.Description = te.Description + " (" + r + ")"
+		}
+
+		return te, nil
+	}
+}
+
+func findGitLabIssue(c *gitlab.Client, ref string) (gitlab.Issue, error) {
+	if r, err := gitlab.ParseRef(ref); err == nil {
+		return c.Issue(r)
+	}
+
+	n := 0
+	if len(ref) > 1 && (ref[:1] == gitlab.KindIssue ||
+		ref[:1] == gitlab.KindMergeRequest) {
+		n, _ = strconv.Atoi(ref[1:])
+	}
+
+	if n < 1 && ref != GitLabMergeRequestOfBranch {
+		return gitlab.Issue{}, cmdutil.FlagErrorWrap(errors.Errorf(
+			"`gl` must be like group/project!42, !42, #42 or \"%s\", "+
+				"not \"%s\"", GitLabMergeRequestOfBranch, ref))
+	}
+
+	dir, err := os.Getwd()
+	if err != nil {
+		return gitlab.Issue{}, errors.WithStack(err)
+	}
+
+	r, err := gitrepo.Open(dir)
+	if err != nil {
+		return gitlab.Issue{}, err
+	}
+
+	u, err := gitrepo.RemoteURL(r.Root, "origin")
+	if err != nil {
+		return gitlab.Issue{}, err
+	}
+
+	project, ok := gitlab.ParseRemote(u)
+	if !ok {
+		return gitlab.Issue{}, errors.Errorf(
+			"the remote origin (%s) is not a GitLab project", u)
+	}
+
+	if n == 0 {
+		return c.MergeRequestOf(project, r.Branch)
+	}
+
+	return c.Issue(gitlab.Ref{Project: project, Kind: ref[:1], Number: n})
+}
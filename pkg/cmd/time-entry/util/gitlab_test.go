@@ -0,0 +1,84 @@
+package util
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFillTimeEntryWithGitLab(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/api/v4/projects/acme/cli/issues/7":
+				_, _ = w.Write([]byte(`{"iid":7,"title":"Fix login"}`))
+			case "/api/v4/projects/acme/cli/merge_requests/42":
+				_, _ = w.Write([]byte(`{"iid":42,"title":"Add docs"}`))
+			case "/api/v4/projects/acme/cli/merge_requests":
+				assert.Equal(t, "docs", r.URL.Query().Get("source_branch"))
+				_, _ = w.Write([]byte(`[{"iid":42,"title":"Add docs"}]`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+	defer s.Close()
+
+	t.Setenv("GITLAB_TOKEN", "")
+	conf := mocks.NewMockConfig(t)
+	conf.EXPECT().GetString(cmdutil.CONF_GITLAB_TOKEN).Return("")
+	conf.EXPECT().GetString(cmdutil.CONF_GITLAB_URL).Return(s.URL)
+
+	tts := []struct {
+		name string
+		ref  string
+		desc string
+		r    string
+	}{
+		{
+			name: "title as description",
+			ref:  "acme/cli!42",
+			r:    "Add docs (acme/cli!42)",
+		},
+		{
+			name: "with description",
+			ref:  "acme/cli#7",
+			desc: "reviewing",
+			r:    "reviewing (acme/cli#7)",
+		},
+		{
+			name: "already referenced",
+			ref:  "acme/cli!42",
+			desc: "acme/cli!42 review",
+			r:    "acme/cli!42 review",
+		},
+		{
+			name: "number on origin",
+			ref:  "#7",
+			r:    "Fix login (acme/cli#7)",
+		},
+		{
+			name: "merge request of the branch",
+			ref:  GitLabMergeRequestOfBranch,
+			r:    "Add docs (acme/cli!42)",
+		},
+	}
+
+	chdirToRepo(t, "git@gitlab.com:acme/cli.git")
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			te, err := FillTimeEntryWithGitLab(conf, tt.ref)(
+				TimeEntryDTO{Description: tt.desc})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.r, te.Description)
+		})
+	}
+
+	_, err := FillTimeEntryWithGitLab(conf, "cli!42")(TimeEntryDTO{})
+	assert.EqualError(t, err, "`gl` must be like group/project!42, !42, "+
+		"#42 or \"mr\", not \"cli!42\"")
+}
@@ -26,7 +26,11 @@ const (
 		` - 10mins in the future:              +10m` + "\n" +
 		` - 1min and 30s ago:                  -90s` + "\n" +
 		` - 1hour and 10min ago:               -1:10s` + "\n" +
-		` - 1day, 10min and 30s ago:           -1d10m30s` + "\n"
+		` - 1day, 10min and 30s ago:           -1d10m30s` + "\n" +
+		` - 10mins ago:                        "10 minutes ago"` + "\n" +
+		` - Yesterday at 9am:                  "yesterday 9am"` + "\n" +
+		` - Last Friday at Time:               "last friday 14:00"` + "\n" +
+		` - Today at noon:                     "noon"` + "\n"
 
 	HelpTimeInputOnTimeEntry = "When setting a date/time input " +
 		"(`--when` and `--when-to-close`) you can use any of the following " +
@@ -49,6 +53,13 @@ const (
 		"$ clockify-cli config set allow-incomplete false\n" +
 		"```\n\n"
 
+	HelpOffline = "When the API is unreachable (or `--offline` is set), " +
+		"the time entry is queued on disk instead, with the names and IDs " +
+		"informed, and the rules are only checked when it is sent by:\n" +
+		"```\n" +
+		"$ clockify-cli sync push\n" +
+		"```\n"
+
 	HelpMoreInfoAboutStarting = "Use `clockify-cli in --help` for more " +
 		"information about creating new time entries."
 
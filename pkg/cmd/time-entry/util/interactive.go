@@ -1,6 +1,7 @@
 package util
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -55,6 +56,7 @@ func askTimeEntryDatesInteractive(
 // interactively about the properties of the time entry, only if the parameter
 // cmdutil.CONF_INTERACTIVE is active
 func GetPropsInteractiveFn(
+	ctx context.Context,
 	dc DescriptionSuggestFn,
 	f cmdutil.Factory,
 ) Step {
@@ -69,6 +71,7 @@ func GetPropsInteractiveFn(
 		}
 
 		return askTimeEntryPropsInteractive(
+			ctx,
 			tei,
 			c,
 			f.UI(),
@@ -79,6 +82,7 @@ func GetPropsInteractiveFn(
 }
 
 func askTimeEntryPropsInteractive(
+	ctx context.Context,
 	te TimeEntryDTO,
 	c api.Client,
 	ui ui.UI,
@@ -86,18 +90,18 @@ func askTimeEntryPropsInteractive(
 	allowArchived bool,
 ) (TimeEntryDTO, error) {
 	var err error
-	w, err := c.GetWorkspace(api.GetWorkspace{ID: te.Workspace})
+	w, err := c.GetWorkspace(ctx, api.GetWorkspace{ID: te.Workspace})
 	if err != nil {
 		return te, err
 	}
 
-	te.ProjectID, err = getProjectID(te.ProjectID, w, c, ui)
+	te.ProjectID, err = getProjectID(ctx, te.ProjectID, w, c, ui)
 	if err != nil {
 		return te, err
 	}
 
 	if te.ProjectID != "" {
-		te.TaskID, err = getTaskID(te.TaskID, te.ProjectID, w, c, ui)
+		te.TaskID, err = getTaskID(ctx, te.TaskID, te.ProjectID, w, c, ui)
 		if err != nil {
 			return te, err
 		}
@@ -106,7 +110,7 @@ func askTimeEntryPropsInteractive(
 	te.Description = getDescription(te.Description, dc, ui,
 		w.Settings.ForceDescription)
 
-	te.TagIDs, err = getTagIDs(te.TagIDs, w, c, allowArchived, ui)
+	te.TagIDs, err = getTagIDs(ctx, te.TagIDs, w, c, allowArchived, ui)
 
 	return te, err
 }
@@ -114,10 +118,11 @@ func askTimeEntryPropsInteractive(
 const noProject = "No Project"
 
 func getProjectID(
+	ctx context.Context,
 	projectID string, w dto.Workspace, c api.Client, ui ui.UI,
 ) (string, error) {
 	b := false
-	projects, err := c.GetProjects(api.GetProjectsParam{
+	projects, err := c.GetProjects(ctx, api.GetProjectsParam{
 		Workspace:       w.ID,
 		Archived:        &b,
 		PaginationParam: api.AllPages(),
@@ -184,9 +189,10 @@ func getProjectID(
 const noTask = "No Task"
 
 func getTaskID(
+	ctx context.Context,
 	taskID, projectID string, w dto.Workspace, c api.Client, ui ui.UI,
 ) (string, error) {
-	tasks, err := c.GetTasks(api.GetTasksParam{
+	tasks, err := c.GetTasks(ctx, api.GetTasksParam{
 		Workspace:       w.ID,
 		ProjectID:       projectID,
 		PaginationParam: api.AllPages(),
@@ -269,6 +275,7 @@ func getDescription(
 }
 
 func getTagIDs(
+	ctx context.Context,
 	tagIDs []string, w dto.Workspace, c api.Client, allowArchived bool,
 	ui ui.UI,
 ) ([]string, error) {
@@ -277,7 +284,7 @@ func getTagIDs(
 		f := false
 		archived = &f
 	}
-	tags, err := c.GetTags(api.GetTagsParam{
+	tags, err := c.GetTags(ctx, api.GetTagsParam{
 		Workspace: w.ID,
 		Archived:  archived,
 	})
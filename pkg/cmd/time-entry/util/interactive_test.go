@@ -1,6 +1,7 @@
 package util
 
 import (
+	"context"
 	"testing"
 
 	"github.com/AlecAivazis/survey/v2/terminal"
@@ -18,7 +19,7 @@ func TestGetPropsInteractive_ShouldSkip_WhenDisabled(t *testing.T) {
 	f.EXPECT().Config().Return(&mocks.SimpleConfig{
 		Interactive: false,
 	})
-	s := GetPropsInteractiveFn(nil, f)
+	s := GetPropsInteractiveFn(context.Background(), nil, f)
 
 	te := TimeEntryDTO{}
 	te2, err := s(te)
@@ -32,10 +33,10 @@ func TestGetPropsInteractive_ShouldAskValues(t *testing.T) {
 		func(out consoletest.FileWriter, in consoletest.FileReader) error {
 			c := mocks.NewMockClient(t)
 
-			c.EXPECT().GetWorkspace(mock.Anything).
+			c.EXPECT().GetWorkspace(mock.Anything, mock.Anything).
 				Return(dto.Workspace{ID: "w"}, nil)
 
-			c.EXPECT().GetProjects(mock.Anything).
+			c.EXPECT().GetProjects(mock.Anything, mock.Anything).
 				Return(
 					[]dto.Project{
 						{ID: "1", Name: "First"},
@@ -48,7 +49,7 @@ func TestGetPropsInteractive_ShouldAskValues(t *testing.T) {
 					nil,
 				)
 
-			c.EXPECT().GetTasks(mock.Anything).
+			c.EXPECT().GetTasks(mock.Anything, mock.Anything).
 				Return(
 					[]dto.Task{
 						{ID: "t1", Name: "First"},
@@ -58,7 +59,7 @@ func TestGetPropsInteractive_ShouldAskValues(t *testing.T) {
 					nil,
 				)
 
-			c.EXPECT().GetTags(mock.Anything).
+			c.EXPECT().GetTags(mock.Anything, mock.Anything).
 				Return(
 					[]dto.Tag{
 						{ID: "tag1", Name: "meeting"},
@@ -73,7 +74,7 @@ func TestGetPropsInteractive_ShouldAskValues(t *testing.T) {
 			f.EXPECT().Client().Return(c, nil)
 			f.EXPECT().Config().Return(&mocks.SimpleConfig{Interactive: true})
 
-			te, err := GetPropsInteractiveFn(
+			te, err := GetPropsInteractiveFn(context.Background(),
 				func(string) []string { return []string{} },
 				f,
 			)(TimeEntryDTO{
@@ -138,10 +139,10 @@ func TestGetPropsInteractive_ShouldAllowEmptyValues(t *testing.T) {
 		func(out consoletest.FileWriter, in consoletest.FileReader) error {
 			c := mocks.NewMockClient(t)
 
-			c.EXPECT().GetWorkspace(mock.Anything).
+			c.EXPECT().GetWorkspace(mock.Anything, mock.Anything).
 				Return(dto.Workspace{ID: "w"}, nil)
 
-			c.EXPECT().GetProjects(mock.Anything).
+			c.EXPECT().GetProjects(mock.Anything, mock.Anything).
 				Return(
 					[]dto.Project{
 						{ID: "1", Name: "First"},
@@ -149,7 +150,7 @@ func TestGetPropsInteractive_ShouldAllowEmptyValues(t *testing.T) {
 					nil,
 				)
 
-			c.EXPECT().GetTags(mock.Anything).
+			c.EXPECT().GetTags(mock.Anything, mock.Anything).
 				Return(
 					[]dto.Tag{
 						{ID: "tag1", Name: "meeting"},
@@ -164,7 +165,7 @@ func TestGetPropsInteractive_ShouldAllowEmptyValues(t *testing.T) {
 			f.EXPECT().Client().Return(c, nil)
 			f.EXPECT().Config().Return(&mocks.SimpleConfig{Interactive: true})
 
-			te, err := GetPropsInteractiveFn(
+			te, err := GetPropsInteractiveFn(context.Background(),
 				func(string) []string { return []string{} },
 				f,
 			)(TimeEntryDTO{
@@ -212,10 +213,10 @@ func TestGetPropsInteractive_ShouldUseInputAsSelected(t *testing.T) {
 		func(out consoletest.FileWriter, in consoletest.FileReader) error {
 			c := mocks.NewMockClient(t)
 
-			c.EXPECT().GetWorkspace(mock.Anything).
+			c.EXPECT().GetWorkspace(mock.Anything, mock.Anything).
 				Return(dto.Workspace{ID: "w"}, nil)
 
-			c.EXPECT().GetProjects(mock.Anything).
+			c.EXPECT().GetProjects(mock.Anything, mock.Anything).
 				Return(
 					[]dto.Project{
 						{ID: "1", Name: "First"},
@@ -228,7 +229,7 @@ func TestGetPropsInteractive_ShouldUseInputAsSelected(t *testing.T) {
 					nil,
 				)
 
-			c.EXPECT().GetTasks(mock.Anything).
+			c.EXPECT().GetTasks(mock.Anything, mock.Anything).
 				Return(
 					[]dto.Task{
 						{ID: "t1", Name: "First"},
@@ -238,7 +239,7 @@ func TestGetPropsInteractive_ShouldUseInputAsSelected(t *testing.T) {
 					nil,
 				)
 
-			c.EXPECT().GetTags(mock.Anything).
+			c.EXPECT().GetTags(mock.Anything, mock.Anything).
 				Return(
 					[]dto.Tag{
 						{ID: "tag1", Name: "meeting"},
@@ -261,7 +262,7 @@ func TestGetPropsInteractive_ShouldUseInputAsSelected(t *testing.T) {
 			f.EXPECT().Client().Return(c, nil)
 			f.EXPECT().Config().Return(&mocks.SimpleConfig{Interactive: true})
 
-			output, err := GetPropsInteractiveFn(
+			output, err := GetPropsInteractiveFn(context.Background(),
 				func(string) []string { return []string{} },
 				f,
 			)(input)
@@ -295,7 +296,7 @@ func TestGetPropsInteractive_ShouldForceAnswer_WhenWorkspaceForces(
 		func(out consoletest.FileWriter, in consoletest.FileReader) error {
 			c := mocks.NewMockClient(t)
 
-			c.EXPECT().GetWorkspace(mock.Anything).
+			c.EXPECT().GetWorkspace(mock.Anything, mock.Anything).
 				Return(
 					dto.Workspace{
 						ID: "w",
@@ -309,7 +310,7 @@ func TestGetPropsInteractive_ShouldForceAnswer_WhenWorkspaceForces(
 					nil,
 				)
 
-			c.EXPECT().GetProjects(mock.Anything).
+			c.EXPECT().GetProjects(mock.Anything, mock.Anything).
 				Return(
 					[]dto.Project{
 						{ID: "1", Name: "First"},
@@ -319,7 +320,7 @@ func TestGetPropsInteractive_ShouldForceAnswer_WhenWorkspaceForces(
 					nil,
 				)
 
-			c.EXPECT().GetTasks(mock.Anything).
+			c.EXPECT().GetTasks(mock.Anything, mock.Anything).
 				Return(
 					[]dto.Task{
 						{ID: "t1", Name: "First"},
@@ -328,7 +329,7 @@ func TestGetPropsInteractive_ShouldForceAnswer_WhenWorkspaceForces(
 					nil,
 				)
 
-			c.EXPECT().GetTags(mock.Anything).
+			c.EXPECT().GetTags(mock.Anything, mock.Anything).
 				Return(
 					[]dto.Tag{
 						{ID: "tag1", Name: "meeting"},
@@ -343,7 +344,7 @@ func TestGetPropsInteractive_ShouldForceAnswer_WhenWorkspaceForces(
 			f.EXPECT().Client().Return(c, nil)
 			f.EXPECT().Config().Return(&mocks.SimpleConfig{Interactive: true})
 
-			output, err := GetPropsInteractiveFn(
+			output, err := GetPropsInteractiveFn(context.Background(),
 				func(string) []string { return []string{} },
 				f,
 			)(TimeEntryDTO{Workspace: "w"})
@@ -388,13 +389,13 @@ func TestGetPropsInteractive_ShouldNotAsk_WhenThereAreNoOptions(
 		func(out consoletest.FileWriter, in consoletest.FileReader) error {
 			c := mocks.NewMockClient(t)
 
-			c.EXPECT().GetWorkspace(mock.Anything).
+			c.EXPECT().GetWorkspace(mock.Anything, mock.Anything).
 				Return(dto.Workspace{ID: "w"}, nil)
 
-			c.EXPECT().GetProjects(mock.Anything).
+			c.EXPECT().GetProjects(mock.Anything, mock.Anything).
 				Return([]dto.Project{}, nil)
 
-			c.EXPECT().GetTags(mock.Anything).
+			c.EXPECT().GetTags(mock.Anything, mock.Anything).
 				Return([]dto.Tag{}, nil)
 
 			f := mocks.NewMockFactory(t)
@@ -402,7 +403,7 @@ func TestGetPropsInteractive_ShouldNotAsk_WhenThereAreNoOptions(
 			f.EXPECT().Client().Return(c, nil)
 			f.EXPECT().Config().Return(&mocks.SimpleConfig{Interactive: true})
 
-			output, err := GetPropsInteractiveFn(
+			output, err := GetPropsInteractiveFn(context.Background(),
 				func(string) []string { return []string{} },
 				f,
 			)(TimeEntryDTO{Workspace: "w"})
@@ -0,0 +1,90 @@
+package util
+
+import (
+	"context"
+	"strings"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/jira"
+	"github.com/lucassabreu/clockify-cli/strhlp"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// AddJiraFlag adds the flag to relate the time entry with an issue of Jira
+func AddJiraFlag(cmd *cobra.Command, v *string) {
+	cmd.Flags().StringVar(v, "jira", "",
+		"key of a Jira issue, its summary is used as description (if not "+
+			"set) and the key is added as a tag (created if missing)")
+}
+
+// FillTimeEntryWithJira uses the summary of the Jira issue as description,
+// when it is not set, and tags the time entry with the key of the issue,
+// creating the tag if it does not exist.
+// The tags must already be IDs, so it should run after
+// GetAllowNameForIDsFn
+func FillTimeEntryWithJira(
+	ctx context.Context,
+	conf cmdutil.Config, c api.Client, key string) Step {
+	if key == "" {
+		return skip
+	}
+
+	return func(te TimeEntryDTO) (TimeEntryDTO, error) {
+		if !jira.IsIssueKey(key) {
+			return te, cmdutil.FlagErrorWrap(errors.Errorf(
+				"`jira` must be the key of an issue (like: PROJ-123), "+
+					"not \"%s\"", key))
+		}
+
+		jc, err := cmdutil.JiraClient(conf)
+		if err != nil {
+			return te, err
+		}
+
+		i, err := jc.Issue(key)
+		if err != nil {
+			return te, err
+		}
+
+		if te.Description == "" {
+			te.Description = i.Summary
+		}
+
+		ts, err := c.GetTags(ctx, api.GetTagsParam{
+			Workspace:       te.Workspace,
+			Name:            i.Key,
+			PaginationParam: api.AllPages(),
+		})
+		if err != nil {
+			return te, err
+		}
+
+		id := ""
+		for _, t := range ts {
+			if strings.EqualFold(t.Name, i.Key) {
+				id = t.ID
+				break
+			}
+		}
+
+		if id == "" {
+			t, err := c.AddTag(ctx, api.AddTagParam{
+				Workspace: te.Workspace,
+				Name:      i.Key,
+			})
+			if err != nil {
+				return te, err
+			}
+
+			id = t.ID
+		}
+
+		if !strhlp.InSlice(id, te.TagIDs) {
+			te.TagIDs = append(te.TagIDs, id)
+		}
+
+		return te, nil
+	}
+}
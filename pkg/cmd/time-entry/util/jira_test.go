@@ -0,0 +1,98 @@
+package util
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFillTimeEntryWithJira(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/rest/api/2/issue/PROJ-1", r.URL.Path)
+			_, _ = w.Write([]byte(
+				`{"key":"PROJ-1","fields":{"summary":"Write docs"}}`))
+		}))
+	defer s.Close()
+
+	conf := mocks.NewMockConfig(t)
+	conf.EXPECT().GetString(cmdutil.CONF_JIRA_URL).Return(s.URL)
+	conf.EXPECT().GetString(cmdutil.CONF_JIRA_USER).Return("me@example.com")
+	conf.EXPECT().GetString(cmdutil.CONF_JIRA_TOKEN).Return("a-token")
+
+	tags := api.GetTagsParam{
+		Workspace:       "w",
+		Name:            "PROJ-1",
+		PaginationParam: api.AllPages(),
+	}
+
+	tts := []struct {
+		name  string
+		te    TimeEntryDTO
+		calls func(*mocks.MockClient)
+		r     TimeEntryDTO
+	}{
+		{
+			name: "tag exists",
+			te:   TimeEntryDTO{Workspace: "w", TagIDs: []string{"tg1"}},
+			calls: func(c *mocks.MockClient) {
+				c.EXPECT().GetTags(mock.Anything, tags).Return([]dto.Tag{
+					{ID: "tg2", Name: "PROJ-12"},
+					{ID: "tg3", Name: "proj-1"},
+				}, nil)
+			},
+			r: TimeEntryDTO{
+				Workspace:   "w",
+				Description: "Write docs",
+				TagIDs:      []string{"tg1", "tg3"},
+			},
+		},
+		{
+			name: "tag is created",
+			te:   TimeEntryDTO{Workspace: "w", Description: "review"},
+			calls: func(c *mocks.MockClient) {
+				c.EXPECT().GetTags(mock.Anything, tags).Return([]dto.Tag{}, nil)
+				c.EXPECT().AddTag(mock.Anything, api.AddTagParam{
+					Workspace: "w",
+					Name:      "PROJ-1",
+				}).Return(dto.Tag{ID: "tg4", Name: "PROJ-1"}, nil)
+			},
+			r: TimeEntryDTO{
+				Workspace:   "w",
+				Description: "review",
+				TagIDs:      []string{"tg4"},
+			},
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			c := mocks.NewMockClient(t)
+			tt.calls(c)
+
+			te, err := FillTimeEntryWithJira(context.Background(), conf, c,
+				"PROJ-1")(tt.te)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.r, te)
+		})
+	}
+
+	_, err := FillTimeEntryWithJira(context.Background(), conf, nil,
+		"proj 1")(TimeEntryDTO{})
+	assert.EqualError(t, err, "`jira` must be the key of an issue "+
+		"(like: PROJ-123), not \"proj 1\"")
+
+	te, err := FillTimeEntryWithJira(context.Background(), conf, nil,
+		"")(TimeEntryDTO{})
+	assert.NoError(t, err)
+	assert.Equal(t, TimeEntryDTO{}, te)
+}
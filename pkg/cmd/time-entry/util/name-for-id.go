@@ -1,6 +1,8 @@
 package util
 
 import (
+	"context"
+
 	"github.com/lucassabreu/clockify-cli/api"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 	"github.com/lucassabreu/clockify-cli/pkg/search"
@@ -8,15 +10,16 @@ import (
 
 // GetAllowNameForIDsFn will try to find project/task/tags by their names if
 // the value provided was not a ID
-func GetAllowNameForIDsFn(config cmdutil.Config, c api.Client) Step {
+func GetAllowNameForIDsFn(
+	ctx context.Context, config cmdutil.Config, c api.Client) Step {
 	if !config.GetBool(cmdutil.CONF_ALLOW_NAME_FOR_ID) {
 		return skip
 	}
 
 	cbs := []Step{
-		lookupProject(c),
-		lookupTask(c),
-		lookupTags(c),
+		lookupProject(ctx, c),
+		lookupTask(ctx, c),
+		lookupTags(ctx, c),
 	}
 
 	if config.IsInteractive() {
@@ -26,7 +29,7 @@ func GetAllowNameForIDsFn(config cmdutil.Config, c api.Client) Step {
 	return compose(cbs...)
 }
 
-func lookupProject(c api.Client) Step {
+func lookupProject(ctx context.Context, c api.Client) Step {
 	return func(te TimeEntryDTO) (TimeEntryDTO, error) {
 		if te.ProjectID == "" {
 			return te, nil
@@ -34,13 +37,14 @@ func lookupProject(c api.Client) Step {
 
 		var err error
 		te.ProjectID, err = search.GetProjectByName(
+			ctx,
 			c, te.Workspace, te.ProjectID)
 		return te, err
 	}
 
 }
 
-func lookupTask(c api.Client) Step {
+func lookupTask(ctx context.Context, c api.Client) Step {
 	return func(te TimeEntryDTO) (TimeEntryDTO, error) {
 		if te.TaskID == "" {
 			return te, nil
@@ -48,6 +52,7 @@ func lookupTask(c api.Client) Step {
 
 		var err error
 		te.TaskID, err = search.GetTaskByName(
+			ctx,
 			c,
 			api.GetTasksParam{
 				Workspace: te.Workspace,
@@ -59,14 +64,14 @@ func lookupTask(c api.Client) Step {
 	}
 }
 
-func lookupTags(c api.Client) Step {
+func lookupTags(ctx context.Context, c api.Client) Step {
 	return func(te TimeEntryDTO) (TimeEntryDTO, error) {
 		if len(te.TagIDs) == 0 {
 			return te, nil
 		}
 
 		var err error
-		te.TagIDs, err = search.GetTagsByName(c, te.Workspace, te.TagIDs)
+		te.TagIDs, err = search.GetTagsByName(ctx, c, te.Workspace, te.TagIDs)
 		return te, err
 	}
 
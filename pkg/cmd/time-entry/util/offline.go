@@ -0,0 +1,79 @@
+package util
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/offline"
+	"github.com/spf13/cobra"
+)
+
+// AddOfflineFlag adds the flag to queue the operation instead of calling the
+// API
+func AddOfflineFlag(cmd *cobra.Command, v *bool) {
+	cmd.Flags().BoolVar(v, "offline", false,
+		"queues it to be sent later by \"clockify-cli sync push\", "+
+			"instead of calling the API (done automatically when the API "+
+			"is unreachable)")
+}
+
+// QueueOffline adds the time entry to the offline queue when forced or when
+// err is because the API was unreachable, otherwise returns err as is
+func QueueOffline(
+	kind string, te TimeEntryDTO, forced bool, err error, w io.Writer,
+) error {
+	if !forced && !api.IsUnreachable(err) {
+		return err
+	}
+
+	if err != nil {
+		fmt.Fprintf(w, "the API is unreachable: %s\n", err.Error())
+	}
+
+	q, qerr := cmdutil.OfflineQueue()
+	if qerr != nil {
+		return qerr
+	}
+
+	if qerr = q.Add(TimeEntryDTOToOperation(kind, te)); qerr != nil {
+		return qerr
+	}
+
+	fmt.Fprintln(w, "queued, use \"clockify-cli sync push\" to send it")
+	return nil
+}
+
+// TimeEntryDTOToOperation converts a TimeEntryDTO into an offline.Operation
+func TimeEntryDTOToOperation(kind string, te TimeEntryDTO) offline.Operation {
+	return offline.Operation{
+		Kind:         kind,
+		Workspace:    te.Workspace,
+		UserID:       te.UserID,
+		ProjectID:    te.ProjectID,
+		TaskID:       te.TaskID,
+		Description:  te.Description,
+		Start:        te.Start,
+		End:          te.End,
+		TagIDs:       te.TagIDs,
+		Billable:     te.Billable,
+		CustomFields: te.CustomFields,
+	}
+}
+
+// OperationToTimeEntryDTO converts an offline.Operation into a TimeEntryDTO
+func OperationToTimeEntryDTO(op offline.Operation) TimeEntryDTO {
+	return TimeEntryDTO{
+		Workspace:    op.Workspace,
+		UserID:       op.UserID,
+		ProjectID:    op.ProjectID,
+		TaskID:       op.TaskID,
+		Description:  op.Description,
+		Start:        op.Start,
+		End:          op.End,
+		TagIDs:       op.TagIDs,
+		Billable:     op.Billable,
+		CustomFields: op.CustomFields,
+	}
+}
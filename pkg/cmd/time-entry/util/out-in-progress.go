@@ -1,6 +1,7 @@
 package util
 
 import (
+	"context"
 	"errors"
 	"time"
 
@@ -9,14 +10,14 @@ import (
 )
 
 // OutInProgressFn will stop the in progress time entry, if it exists
-func OutInProgressFn(c api.Client) Step {
+func OutInProgressFn(ctx context.Context, c api.Client) Step {
 	return func(tei TimeEntryDTO) (TimeEntryDTO, error) {
-		return tei, out(c, tei.Workspace, tei.UserID, tei.Start)
+		return tei, out(ctx, c, tei.Workspace, tei.UserID, tei.Start)
 	}
 }
 
-func out(c api.Client, w, u string, end time.Time) error {
-	if err := c.Out(api.OutParam{
+func out(ctx context.Context, c api.Client, w, u string, end time.Time) error {
+	if err := c.Out(ctx, api.OutParam{
 		Workspace: w,
 		UserID:    u,
 		End:       end,
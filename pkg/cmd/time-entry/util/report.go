@@ -1,12 +1,22 @@
 package util
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/lucassabreu/clockify-cli/api"
 	"github.com/lucassabreu/clockify-cli/api/dto"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 	output "github.com/lucassabreu/clockify-cli/pkg/output/time-entry"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/lucassabreu/clockify-cli/strhlp"
 	"github.com/spf13/cobra"
 )
 
@@ -14,25 +24,385 @@ import (
 type OutputFlags struct {
 	Format            string
 	CSV               bool
+	CSVDelimiter      string
+	CSVNoHeader       bool
+	TSV               bool
+	Plain             bool
+	ShowAmounts       bool
+	NoTruncate        bool
+	TableWidth        int
+	Round             time.Duration
+	RoundUp           bool
+	RoundNearest      bool
+	ColumnMaxWidth    map[string]int
 	JSON              bool
+	JSONLines         bool
+	YAML              bool
 	Quiet             bool
 	Markdown          bool
+	MarkdownTable     bool
 	DurationFormatted bool
 	DurationFloat     bool
+	Prometheus        bool
+	DailyBillable     bool
+	CalDAVBatch       bool
+	Encoded           bool
+	Taskwarrior       bool
+	Org               bool
+	Counts            bool
+	InvoiceHTML       bool
+	XLSX              string
+	ICS               bool
+	HTML              bool
+	Timeline          bool
+	TimelineHours     string
+	OutputFile        string
+	Chart             bool
+	ChartTarget       time.Duration
+	Matrix            bool
+
+	GroupBy      string
+	GroupSummary bool
+	TagSplit     bool
+
+	FormatHeader    string
+	FormatFooter    string
+	FormatSeparator *string
+
+	ColumnAlignment map[string]string
+	Columns         []string
+	Sort            string
+	HoursPerDay     float64
+	DurationFormat  string
+	TimeZone        string
+	Locale          string
+	ShiftEnd        string
 
 	TimeFormat string
 }
 
+// outputFileFormats are the outputs used for each extension of the
+// `output-file` when no output is set
+var outputFileFormats = map[string]func(*OutputFlags){
+	".csv":   func(of *OutputFlags) { of.CSV = true },
+	".tsv":   func(of *OutputFlags) { of.TSV = true },
+	".json":  func(of *OutputFlags) { of.JSON = true },
+	".jsonl": func(of *OutputFlags) { of.JSONLines = true },
+	".yaml":  func(of *OutputFlags) { of.YAML = true },
+	".yml":   func(of *OutputFlags) { of.YAML = true },
+	".md":    func(of *OutputFlags) { of.MarkdownTable = true },
+	".html":  func(of *OutputFlags) { of.HTML = true },
+	".ics":   func(of *OutputFlags) { of.ICS = true },
+	".org":   func(of *OutputFlags) { of.Org = true },
+	".xlsx":  func(of *OutputFlags) { of.XLSX = of.OutputFile },
+}
+
+// withOutputFile sets the output from the extension of the `output-file`,
+// when no output was set
+func (of OutputFlags) withOutputFile() (OutputFlags, error) {
+	if of.OutputFile == "" {
+		return of, nil
+	}
+
+	if of.XLSX != "" {
+		return of, cmdutil.FlagErrorWrap(errors.New(
+			"`output-file` can't be used with `xlsx`"))
+	}
+
+	for _, set := range of.Outputs() {
+		if set {
+			return of, nil
+		}
+	}
+
+	if of.Format != "" {
+		return of, nil
+	}
+
+	ext := strings.ToLower(filepath.Ext(of.OutputFile))
+	f, ok := outputFileFormats[ext]
+	if !ok {
+		exts := make([]string, 0, len(outputFileFormats))
+		for e := range outputFileFormats {
+			exts = append(exts, e)
+		}
+		sort.Strings(exts)
+
+		return of, cmdutil.FlagErrorWrap(fmt.Errorf(
+			"can't infer the output of `output-file` from \"%s\", set "+
+				"one of the output flags or use one of the extensions: %s",
+			ext, strhlp.ListForHumans(exts)))
+	}
+
+	f(&of)
+	return of, nil
+}
+
 func (of OutputFlags) Check() error {
-	return cmdutil.XorFlag(map[string]bool{
+	of, err := of.withOutputFile()
+	if err != nil {
+		return err
+	}
+
+	if of.Format == "" && (of.FormatHeader != "" ||
+		of.FormatFooter != "" || of.FormatSeparator != nil) {
+		return cmdutil.FlagErrorWrap(errors.New(
+			"`format-header`, `format-footer` and `format-separator` can " +
+				"only be used with `format`"))
+	}
+
+	if of.ShiftEnd != "" {
+		if _, err := parseShiftEnd(of.ShiftEnd); err != nil {
+			return err
+		}
+	}
+
+	if of.ChartTarget != 0 && !of.Chart {
+		return cmdutil.FlagErrorWrap(errors.New(
+			"`chart-target` can only be used with `chart`"))
+	}
+
+	if of.TimelineHours != "" {
+		if !of.Timeline {
+			return cmdutil.FlagErrorWrap(errors.New(
+				"`timeline-hours` can only be used with `timeline`"))
+		}
+
+		if _, _, err := parseTimelineHours(of.TimelineHours); err != nil {
+			return err
+		}
+	}
+
+	if _, err := output.DurationFormatter(of.DurationFormat); err != nil {
+		return cmdutil.FlagErrorWrap(err)
+	}
+
+	if _, err := time.LoadLocation(of.TimeZone); err != nil {
+		return cmdutil.FlagErrorWrap(errors.New(
+			"`time-zone` must be a IANA time zone name, like: " +
+				"America/Sao_Paulo or UTC"))
+	}
+
+	if _, err := output.GetLocale(of.Locale); err != nil {
+		return cmdutil.FlagErrorWrap(err)
+	}
+
+	outputs := of.Outputs()
+	if err := cmdutil.XorFlag(outputs); err != nil {
+		return err
+	}
+
+	if of.ShowAmounts {
+		for n, set := range outputs {
+			if set && n != "csv" && n != "tsv" && n != "plain" &&
+				n != "json" {
+				return cmdutil.FlagErrorWrap(errors.New(
+					"`show-amounts` can only be used with the table, " +
+						"`plain`, `csv`, `tsv` or `json` outputs"))
+			}
+		}
+
+		if of.GroupBy != "" {
+			return cmdutil.FlagErrorWrap(errors.New(
+				"`show-amounts` can't be used with `group-by`"))
+		}
+	}
+
+	if err := of.checkRound(outputs); err != nil {
+		return err
+	}
+
+	if of.NoTruncate || of.TableWidth != 0 || len(of.ColumnMaxWidth) > 0 {
+		for _, set := range outputs {
+			if set {
+				return cmdutil.FlagErrorWrap(errors.New(
+					"`no-truncate`, `table-width` and `max-width` can only " +
+						"be used with the table output"))
+			}
+		}
+	}
+
+	if of.HoursPerDay != 0 || of.ShiftEnd != "" {
+		for n, set := range outputs {
+			if set && n != "plain" {
+				return cmdutil.FlagErrorWrap(errors.New(
+					"`days-as-unit` and `shift-end` can only be used with " +
+						"the table or `plain` outputs"))
+			}
+		}
+
+		if of.GroupSummary {
+			return cmdutil.FlagErrorWrap(errors.New(
+				"`days-as-unit` and `shift-end` can't be used with " +
+					"`group-summary`"))
+		}
+	}
+
+	if (of.CSVDelimiter != "" && !of.CSV) ||
+		(of.CSVNoHeader && !of.CSV && !of.TSV) {
+		return cmdutil.FlagErrorWrap(errors.New(
+			"`csv-delimiter` and `csv-no-header` can only be used with " +
+				"`csv` (and `csv-no-header` with `tsv`)"))
+	}
+
+	if _, err := of.csvDelimiter(); err != nil {
+		return err
+	}
+
+	if of.GroupBy == "" {
+		if of.GroupSummary || of.TagSplit {
+			return cmdutil.FlagErrorWrap(errors.New(
+				"`group-summary` and `tag-split` can only be used with " +
+					"`group-by`"))
+		}
+
+		return nil
+	}
+
+	if of.TagSplit && (of.GroupBy != "tag" ||
+		!(of.GroupSummary || of.CSV || of.JSON)) {
+		return cmdutil.FlagErrorWrap(errors.New(
+			"`tag-split` can only be used with `group-by tag` and " +
+				"`group-summary`, `csv` or `json`"))
+	}
+
+	if !strhlp.InSlice(of.GroupBy, output.TimeEntryGroupFields) {
+		return cmdutil.FlagErrorWrap(errors.New(
+			"`group-by` must be one of: " +
+				strhlp.ListForHumans(output.TimeEntryGroupFields)))
+	}
+
+	for n, set := range outputs {
+		if set && n != "csv" && n != "json" && n != "html" {
+			return cmdutil.FlagErrorWrap(errors.New(
+				"`group-by` can only be used with the table, " +
+					"`csv`, `json` or `html` outputs"))
+		}
+
+		if set && of.GroupSummary {
+			return cmdutil.FlagErrorWrap(errors.New(
+				"`group-summary` can only be used with the table output"))
+		}
+	}
+
+	return nil
+}
+
+// checkRound assures that the rounding flags are valid and only used with
+// the outputs that print the rounded durations
+func (of OutputFlags) checkRound(outputs map[string]bool) error {
+	if of.Round < 0 {
+		return cmdutil.FlagErrorWrap(errors.New(
+			"`round` must be a positive duration, like: 15m"))
+	}
+
+	if of.Round == 0 {
+		if of.RoundUp || of.RoundNearest {
+			return cmdutil.FlagErrorWrap(errors.New(
+				"`round-up` and `round-nearest` can only be used with " +
+					"`round`"))
+		}
+
+		return nil
+	}
+
+	if err := cmdutil.XorFlag(map[string]bool{
+		"round-up":      of.RoundUp,
+		"round-nearest": of.RoundNearest,
+	}); err != nil {
+		return err
+	}
+
+	for n, set := range outputs {
+		if set && n != "csv" && n != "tsv" && n != "plain" &&
+			n != "duration-float" && n != "duration-formatted" {
+			return cmdutil.FlagErrorWrap(errors.New(
+				"`round` can only be used with the table, `plain`, `csv`, " +
+					"`tsv`, `duration-formatted` or `duration-float` outputs"))
+		}
+	}
+
+	if of.GroupBy != "" {
+		return cmdutil.FlagErrorWrap(errors.New(
+			"`round` can't be used with `group-by`"))
+	}
+
+	return nil
+}
+
+// csvDelimiter returns the character set as delimiter, accepting "tab" or
+// "\t" for tabs
+func (of OutputFlags) csvDelimiter() (rune, error) {
+	d := of.CSVDelimiter
+	switch d {
+	case "":
+		return ',', nil
+	case "tab", "\\t":
+		return '\t', nil
+	}
+
+	r := []rune(d)
+	if len(r) != 1 || r[0] == '"' || r[0] == '\r' || r[0] == '\n' {
+		return 0, cmdutil.FlagErrorWrap(errors.New(
+			"`csv-delimiter` must be a single character (or \"tab\"), " +
+				"like: ; or |"))
+	}
+
+	return r[0], nil
+}
+
+// Outputs returns which output formats were set
+func (of OutputFlags) Outputs() map[string]bool {
+	return map[string]bool{
 		"format":             of.Format != "",
 		"json":               of.JSON,
+		"jsonl":              of.JSONLines,
+		"yaml":               of.YAML,
 		"csv":                of.CSV,
+		"tsv":                of.TSV,
+		"plain":              of.Plain,
 		"quiet":              of.Quiet,
 		"md":                 of.Markdown,
+		"md-table":           of.MarkdownTable,
 		"duration-float":     of.DurationFloat,
 		"duration-formatted": of.DurationFormatted,
-	})
+		"prometheus":         of.Prometheus,
+		"daily-billable":     of.DailyBillable,
+		"caldav-batch":       of.CalDAVBatch,
+		"encoded":            of.Encoded,
+		"taskwarrior":        of.Taskwarrior,
+		"org":                of.Org,
+		"counts":             of.Counts,
+		"invoice-html":       of.InvoiceHTML,
+		"xlsx":               of.XLSX != "",
+		"ics":                of.ICS,
+		"html":               of.HTML,
+		"timeline":           of.Timeline,
+		"chart":              of.Chart,
+		"matrix":             of.Matrix,
+	}
+}
+
+func parseTimelineHours(s string) (int, int, error) {
+	var start, end int
+	if _, err := fmt.Sscanf(s, "%d-%d", &start, &end); err != nil ||
+		start < 0 || end > 24 || start >= end {
+		return 0, 0, cmdutil.FlagErrorWrap(errors.New(
+			"`timeline-hours` must be a range of hours between 0 and 24, " +
+				"like: 8-18"))
+	}
+
+	return start, end, nil
+}
+
+func parseShiftEnd(s string) (time.Time, error) {
+	t, err := time.Parse(timehlp.SimplerOnlyTimeFormat, s)
+	if err != nil {
+		return t, cmdutil.FlagErrorWrap(errors.New(
+			"`shift-end` must be a time of the day, like: 17:00"))
+	}
+
+	return t, nil
 }
 
 // AddPrintMultipleTimeEntriesFlags add flags to print multiple time entries
@@ -45,18 +415,161 @@ func AddPrintMultipleTimeEntriesFlags(cmd *cobra.Command) {
 func AddPrintTimeEntriesFlags(cmd *cobra.Command, of *OutputFlags) {
 	cmd.Flags().StringVarP(&of.Format, "format", "f", "",
 		"golang text/template format to be applied on each time entry")
+	cmdutil.AddTemplateSourceFlags(cmd.Flags())
+	cmd.Flags().StringVar(&of.FormatHeader, "format-header", "",
+		"golang text/template format to be printed before the time "+
+			"entries (can use .Count and .Total)")
+	cmdutil.StringPointerVar(cmd.Flags(), &of.FormatSeparator,
+		"format-separator",
+		"string to be printed between the time entries when using "+
+			"--format, instead of a line break after each one")
+	cmd.Flags().StringVar(&of.FormatFooter, "format-footer", "",
+		"golang text/template format to be printed after the time "+
+			"entries (can use .Count and .Total)")
+	cmd.Flags().StringToStringVar(&of.ColumnAlignment, "column-alignment",
+		map[string]string{},
+		"sets the alignment (left, right or center) of the table columns, "+
+			"like: dur=right,description=left")
+	cmd.Flags().StringSliceVar(&of.Columns, "columns", []string{},
+		"sets which columns and in which order the table or CSV will "+
+			"have, like: id,start,dur,project,description (custom fields "+
+			"are set as cf:<name>, and customFields.* adds all of them to "+
+			"the CSV)")
+	cmd.Flags().BoolVar(&of.NoTruncate, "no-truncate", false,
+		"does not limit the width of the table columns, showing long "+
+			"values in a single line")
+	cmd.Flags().IntVar(&of.TableWidth, "table-width", 0,
+		"width used to limit the table columns, when not set the "+
+			"terminal width (or $COLUMNS) is used")
+	cmd.Flags().StringToIntVar(&of.ColumnMaxWidth, "max-width",
+		map[string]int{},
+		"sets the max width of table columns, wrapping their values, "+
+			"like: description=40,project=20")
+	cmd.Flags().DurationVar(&of.Round, "round", 0,
+		"rounds the duration of each time entry to this increment (like: "+
+			"15m) on the table, CSV, --duration-formatted and "+
+			"--duration-float, totals are the sum of the rounded durations")
+	cmd.Flags().BoolVar(&of.RoundUp, "round-up", false,
+		"rounds the durations up to the next increment of --round")
+	cmd.Flags().BoolVar(&of.RoundNearest, "round-nearest", false,
+		"rounds the durations to the nearest increment of --round "+
+			"(default)")
+	cmd.Flags().StringVar(&of.Sort, "sort", "",
+		"sorts the time entries by a field (id, start, end, duration, "+
+			"project, task or description), like: duration:desc")
+	cmd.Flags().StringVar(&of.DurationFormat, "duration-format", "",
+		"sets how durations are shown on the table, CSV and "+
+			"--duration-formatted, can be: "+
+			strhlp.ListForHumans(output.DurationFormats)+
+			" (default is hms, like 1:30:00)")
+	cmd.Flags().StringVar(&of.TimeZone, "time-zone", "",
+		"shows the start and end of the time entries in this time zone "+
+			"on the table, CSV and --format (like: America/Sao_Paulo or "+
+			"UTC), default is the local time zone")
+	cmd.Flags().StringVar(&of.Locale, "locale", "",
+		"shows dates, weekday names and decimal numbers on the table, "+
+			"CSV and --duration-float as used in this locale, can be: "+
+			strhlp.ListForHumans(output.LocaleNames)+
+			" (default are ISO-8601 dates and dot as decimal separator)")
+	cmd.Flags().Float64Var(&of.HoursPerDay, "days-as-unit", 0,
+		"shows the durations on the table as decimal days, considering "+
+			"that a day has this many hours (like: 8)")
+	cmd.Flags().StringVar(&of.ShiftEnd, "shift-end", "",
+		"highlights the time entries that cross this time of the day on "+
+			"the table, showing how much time was after it (like: 17:00)")
+	cmd.Flags().StringVar(&of.OutputFile, "output-file", "",
+		"writes the output into this file instead of the stdout, the "+
+			"output is chosen by its extension (.csv, .tsv, .json, .jsonl, "+
+			".yaml, .md, .html, .ics, .org or .xlsx) when not set")
 	cmd.Flags().BoolVarP(&of.JSON, "json", "j", false, "print as JSON")
+	cmd.Flags().BoolVar(&of.JSONLines, "jsonl", false,
+		"print each time entry as JSON in its own line (JSON Lines)")
+	cmd.Flags().BoolVar(&of.YAML, "yaml", false, "print as YAML")
 	cmd.Flags().BoolVarP(&of.CSV, "csv", "v", false, "print as CSV")
+	cmd.Flags().BoolVar(&of.ShowAmounts, "show-amounts", false,
+		"shows the hourly rate and amount of each time entry, and the "+
+			"total amount, on the table, CSV and JSON outputs")
+	cmd.Flags().StringVar(&of.CSVDelimiter, "csv-delimiter", "",
+		"character used to separate the fields of the CSV, like: ; "+
+			"or tab (default is ,)")
+	cmd.Flags().BoolVar(&of.CSVNoHeader, "csv-no-header", false,
+		"do not print the header row of the CSV or TSV")
+	cmd.Flags().BoolVar(&of.TSV, "tsv", false,
+		"print as tab-separated values, without quoting them (tabs and "+
+			"line breaks on the values are replaced by spaces)")
+	cmd.Flags().BoolVar(&of.Plain, "plain", false,
+		"print as a table without borders, with the columns aligned and "+
+			"each time entry in a single line")
 	cmd.Flags().BoolVarP(&of.Quiet, "quiet", "q", false, "print only ID")
 	cmd.Flags().BoolVarP(&of.Markdown, "md", "m", false, "print as Markdown")
+	cmd.Flags().BoolVar(&of.MarkdownTable, "md-table", false,
+		"print as a Markdown table, with a totals row")
 	cmd.Flags().BoolVarP(&of.DurationFormatted, "duration-formatted", "D", false,
 		"prints only the sum of duration formatted")
 	cmd.Flags().BoolVarP(&of.DurationFloat, "duration-float", "F", false,
 		`prints only the sum of duration as a "float hour"`)
+	cmd.Flags().BoolVar(&of.Prometheus, "prometheus", false,
+		"prints the sum of durations per project as metrics for the "+
+			"node_exporter's textfile collector")
+	cmd.Flags().BoolVar(&of.DailyBillable, "daily-billable", false,
+		"prints the billable and non-billable durations of each day, "+
+			"and the percentage that was billable")
+	cmd.Flags().BoolVar(&of.CalDAVBatch, "caldav-batch", false,
+		"prints a JSON with a iCalendar object for each time entry and a "+
+			"manifest of their paths, to be sent to a CalDAV server")
+	cmd.Flags().BoolVar(&of.Encoded, "encoded", false,
+		"prints the time entries as a single line of gzipped JSON "+
+			"encoded with base64, to be shared")
+	cmd.Flags().BoolVar(&of.Taskwarrior, "taskwarrior", false,
+		"prints the time entries as JSON to be imported by Taskwarrior "+
+			"(task import)")
+	cmd.Flags().BoolVar(&of.Org, "org", false,
+		"prints the time entries as org-mode headings for each project "+
+			"and description, with their CLOCK lines")
+	cmd.Flags().BoolVar(&of.Counts, "counts", false,
+		"prints how many time entries there are and how many distinct "+
+			"projects, tasks, tags and days they touched")
+	cmd.Flags().BoolVar(&of.InvoiceHTML, "invoice-html", false,
+		"prints a invoice as HTML ready to be printed, with the billable "+
+			"time entries and their amounts")
+	cmd.Flags().StringVar(&of.XLSX, "xlsx", "",
+		"writes the time entries into a spreadsheet file (.xlsx), "+
+			"with one sheet for each week")
+	cmd.Flags().BoolVar(&of.ICS, "ics", false,
+		"prints the time entries as iCalendar events, to be imported "+
+			"into calendar applications")
+	cmd.Flags().BoolVar(&of.HTML, "html", false,
+		"prints the time entries as a standalone HTML page")
+	cmd.Flags().BoolVar(&of.Timeline, "timeline", false,
+		"prints a bar for each day showing when the time entries "+
+			"happened, with # for busy and ! for overlapping time entries")
+	cmd.Flags().StringVar(&of.TimelineHours, "timeline-hours", "",
+		"limits the timeline to these hours of the day, like: 8-18 "+
+			"(default is the whole day)")
+	cmd.Flags().BoolVar(&of.Chart, "chart", false,
+		"prints a bar chart with the duration of each day, scaled to "+
+			"the terminal width")
+	cmd.Flags().DurationVar(&of.ChartTarget, "chart-target", 0,
+		"shows a line on the chart at the expected duration of each day "+
+			"(like: 8h)")
+	cmd.Flags().BoolVar(&of.Matrix, "matrix", false,
+		"prints a table with the projects as rows, the days as columns, "+
+			"the durations on the cells and the totals of each one")
+	cmd.Flags().StringVar(&of.GroupBy, "group-by", "",
+		"splits the time entries in sections with their subtotals by "+
+			"client, day, project, tag or task (only for the table, "+
+			"CSV, JSON and HTML outputs)")
+	cmd.Flags().BoolVar(&of.GroupSummary, "group-summary", false,
+		"prints only a table with the count of time entries and "+
+			"subtotal of each group of --group-by")
+	cmd.Flags().BoolVar(&of.TagSplit, "tag-split", false,
+		"when using --group-by tag, divides the duration of a time entry "+
+			"between its tags, instead of counting it fully for each one")
 }
 
 // PrintTimeEntryImpl will print out a time entries using parameters and flags
 func PrintTimeEntryImpl(
+	ctx context.Context,
 	tei dto.TimeEntryImpl,
 	f cmdutil.Factory,
 	out io.Writer,
@@ -67,7 +580,7 @@ func PrintTimeEntryImpl(
 		return err
 	}
 
-	fte, err := c.GetHydratedTimeEntry(api.GetTimeEntryParam{
+	fte, err := c.GetHydratedTimeEntry(ctx, api.GetTimeEntryParam{
 		Workspace:   tei.WorkspaceID,
 		TimeEntryID: tei.ID,
 	})
@@ -103,24 +616,200 @@ func PrintTimeEntry(
 func PrintTimeEntries(
 	tes []dto.TimeEntry, out io.Writer, config cmdutil.Config, of OutputFlags,
 ) error {
+	if of.OutputFile != "" {
+		return printTimeEntriesToFile(tes, config, of)
+	}
+
+	if of.Sort != "" {
+		if err := output.SortTimeEntries(tes, of.Sort); err != nil {
+			return err
+		}
+	}
+
+	df, err := output.DurationFormatter(of.DurationFormat)
+	if err != nil {
+		return err
+	}
+
+	lc, err := output.GetLocale(of.Locale)
+	if err != nil {
+		return err
+	}
+
+	r := output.DurationRounder(of.Round, of.RoundUp)
+
+	loc := time.Local
+	if of.TimeZone != "" {
+		if loc, err = time.LoadLocation(of.TimeZone); err != nil {
+			return err
+		}
+	}
+
 	switch {
 	case of.Markdown:
 		return output.TimeEntriesMarkdownPrint(tes, out)
+	case of.MarkdownTable:
+		return output.TimeEntriesMarkdownTablePrint(tes, out)
+	case of.JSON && of.GroupBy != "":
+		return output.TimeEntriesGroupedJSONPrint(of.GroupBy, of.TagSplit)(tes, out)
+	case of.JSON && of.ShowAmounts:
+		return output.TimeEntriesWithAmountsJSONPrint(tes, out)
 	case of.JSON:
 		return output.TimeEntriesJSONPrint(tes, out)
-	case of.CSV:
-		return output.TimeEntriesCSVPrint(tes, out)
+	case of.JSONLines:
+		return output.TimeEntriesJSONLinesPrint(tes, out)
+	case of.YAML:
+		return output.TimeEntriesYAMLPrint(tes, out)
+	case of.CSV && of.GroupBy != "":
+		return output.TimeEntriesGroupedCSVPrint(of.GroupBy, of.TagSplit)(tes, out)
+	case of.CSV || of.TSV:
+		opts := []output.CSVOpt{
+			output.WithCSVDurationFormatter(df),
+			output.WithCSVDurationRounder(r),
+			output.WithCSVTimeZone(loc),
+			output.WithCSVLocale(lc),
+		}
+
+		d, err := of.csvDelimiter()
+		if err != nil {
+			return err
+		}
+		opts = append(opts, output.WithCSVDelimiter(d))
+
+		if of.TSV {
+			opts = append(opts, output.WithCSVAsTSV())
+		}
+
+		if of.CSVNoHeader {
+			opts = append(opts, output.WithCSVNoHeader())
+		}
+
+		if of.ShowAmounts {
+			opts = append(opts, output.WithCSVAmounts())
+		}
+		if len(of.Columns) > 0 {
+			opts = append(opts, output.WithCSVColumns(of.Columns))
+		}
+
+		return output.TimeEntriesCSVPrintWithOptions(opts...)(tes, out)
 	case of.Format != "":
-		return output.TimeEntriesPrintWithTemplate(of.Format)(tes, out)
+		opts := []output.TemplateOpt{
+			output.WithTemplateHeader(of.FormatHeader),
+			output.WithTemplateFooter(of.FormatFooter),
+		}
+
+		if of.TimeZone != "" {
+			opts = append(opts, output.WithTemplateTimeZone(loc))
+		}
+
+		if of.FormatSeparator != nil {
+			opts = append(opts,
+				output.WithTemplateSeparator(*of.FormatSeparator))
+		}
+
+		return output.TimeEntriesPrintWithTemplate(of.Format, opts...)(
+			tes, out)
 	case of.Quiet:
 		return output.TimeEntriesPrintQuietly(tes, out)
 	case of.DurationFloat:
-		return output.TimeEntriesTotalDurationOnlyAsFloat(tes, out)
+		return output.TimeEntriesRoundedTotalDurationOnly(
+			lc.FormatDecimals(output.DurationAsFloat), r)(tes, out)
 	case of.DurationFormatted:
-		return output.TimeEntriesTotalDurationOnlyFormatted(tes, out)
+		return output.TimeEntriesRoundedTotalDurationOnly(
+			lc.FormatDecimals(df), r)(tes, out)
+	case of.Prometheus:
+		return output.TimeEntriesPrometheusPrint(tes, out)
+	case of.DailyBillable:
+		return output.TimeEntriesDailyBillableSplit(tes, out)
+	case of.CalDAVBatch:
+		return output.TimeEntriesCalDAVBatch(tes, out)
+	case of.Encoded:
+		return output.TimeEntriesEncodedPrint(tes, out)
+	case of.Taskwarrior:
+		return output.TimeEntriesTaskwarriorPrint(tes, out)
+	case of.Org:
+		return output.TimeEntriesOrgPrint(tes, out)
+	case of.Counts:
+		return output.TimeEntriesCountsPrint(tes, out)
+	case of.InvoiceHTML:
+		return output.TimeEntriesInvoiceHTMLPrint(tes, out)
+	case of.XLSX != "":
+		return printTimeEntriesXLSX(tes, of.XLSX)
+	case of.ICS:
+		return output.TimeEntriesICSPrint(tes, out)
+	case of.Timeline:
+		opts := []output.TimelineOpt{output.WithTimelineTimeZone(loc)}
+		if of.TimelineHours != "" {
+			start, end, err := parseTimelineHours(of.TimelineHours)
+			if err != nil {
+				return err
+			}
+			opts = append(opts, output.WithTimelineHours(start, end))
+		}
+
+		return output.TimeEntriesTimelinePrint(opts...)(tes, out)
+	case of.Chart:
+		return output.TimeEntriesChartPrint(
+			output.WithChartTarget(of.ChartTarget))(tes, out)
+	case of.Matrix:
+		return output.TimeEntriesMatrixPrint(
+			output.WithMatrixDurationFormatter(df),
+			output.WithMatrixTimeZone(loc),
+			output.WithMatrixLocale(lc),
+		)(tes, out)
+	case of.HTML:
+		opts := []output.HTMLOpt{}
+		if of.GroupBy != "" {
+			opts = append(opts, output.WithHTMLGroupBy(of.GroupBy))
+		}
+
+		return output.TimeEntriesHTMLPrint(opts...)(tes, out)
 	default:
 		opts := []output.TimeEntryOutputOpt{
-			output.WithTimeFormat(of.TimeFormat)}
+			output.WithTimeFormat(of.TimeFormat),
+			output.WithDurationFormatter(df),
+			output.WithDurationRounder(r),
+			output.WithTimeZone(loc),
+			output.WithLocale(lc),
+		}
+
+		if of.HoursPerDay != 0 {
+			opts = append(opts, output.WithDaysAsUnit(of.HoursPerDay))
+		}
+
+		if of.ShiftEnd != "" {
+			t, err := parseShiftEnd(of.ShiftEnd)
+			if err != nil {
+				return err
+			}
+			opts = append(opts, output.WithShiftBoundary(t))
+		}
+
+		if len(of.Columns) > 0 {
+			opts = append(opts, output.WithColumns(of.Columns))
+		}
+
+		if len(of.ColumnAlignment) > 0 {
+			opts = append(opts,
+				output.WithColumnAlignment(of.ColumnAlignment))
+		}
+
+		if of.NoTruncate {
+			opts = append(opts, output.WithNoTruncate())
+		}
+
+		if of.Plain {
+			opts = append(opts, output.WithPlain())
+		}
+
+		if of.TableWidth != 0 {
+			opts = append(opts, output.WithTableWidth(of.TableWidth))
+		}
+
+		if len(of.ColumnMaxWidth) > 0 {
+			opts = append(opts,
+				output.WithColumnMaxWidth(of.ColumnMaxWidth))
+		}
 
 		if config.GetBool(cmdutil.CONF_SHOW_TASKS) {
 			opts = append(opts, output.WithShowTasks())
@@ -130,6 +819,76 @@ func PrintTimeEntries(
 			opts = append(opts, output.WithTotalDuration())
 		}
 
+		if of.ShowAmounts {
+			opts = append(opts, output.WithAmounts())
+		}
+
+		if of.GroupSummary {
+			return output.TimeEntriesGroupSummaryPrint(
+				of.GroupBy, of.TagSplit)(tes, out)
+		}
+
+		if of.GroupBy != "" {
+			return output.TimeEntriesGroupedPrint(of.GroupBy, opts...)(
+				tes, out)
+		}
+
 		return output.TimeEntriesPrint(opts...)(tes, out)
 	}
 }
+
+// printTimeEntriesToFile writes the time entries into the `output-file`
+func printTimeEntriesToFile(
+	tes []dto.TimeEntry, config cmdutil.Config, of OutputFlags,
+) error {
+	of, err := of.withOutputFile()
+	if err != nil {
+		return err
+	}
+
+	filename := of.OutputFile
+	of.OutputFile = ""
+
+	return WriteFile(filename, func(w io.Writer) error {
+		if of.XLSX != "" {
+			return output.TimeEntriesXLSXPrint(tes, w)
+		}
+
+		return PrintTimeEntries(tes, w, config, of)
+	})
+}
+
+// WriteFile writes into a temporary file, replacing the file with it only
+// when everything was written
+func WriteFile(filename string, write func(io.Writer) error) error {
+	f, err := os.CreateTemp(
+		filepath.Dir(filename), "."+filepath.Base(filename)+".*")
+	if err != nil {
+		return err
+	}
+
+	err = write(f)
+	if cErr := f.Close(); err == nil {
+		err = cErr
+	}
+
+	if err == nil {
+		err = os.Chmod(f.Name(), 0o644)
+	}
+
+	if err == nil {
+		err = os.Rename(f.Name(), filename)
+	}
+
+	if err != nil {
+		_ = os.Remove(f.Name())
+	}
+
+	return err
+}
+
+func printTimeEntriesXLSX(tes []dto.TimeEntry, filename string) error {
+	return WriteFile(filename, func(w io.Writer) error {
+		return output.TimeEntriesXLSXPrint(tes, w)
+	})
+}
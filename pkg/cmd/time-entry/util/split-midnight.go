@@ -0,0 +1,96 @@
+package util
+
+import (
+	"context"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// AddSplitMidnightFlag adds the flag to split time entries crossing midnight
+func AddSplitMidnightFlag(cmd *cobra.Command, v *bool) {
+	cmd.Flags().BoolVar(v, "split-midnight", false,
+		"splits the time entry into one for each day, if it ends on "+
+			"another day (default is the config "+
+			cmdutil.CONF_SPLIT_MIDNIGHT+")")
+}
+
+// SplitAtMidnight ends the time entry at the first midnight (local time)
+// after it starts, and creates a copy of it for each of the next days it
+// crosses, returning all of them; running time entries are not split
+func SplitAtMidnight(
+	ctx context.Context, c api.Client, w, id string) ([]dto.TimeEntry, error) {
+	te, err := c.GetHydratedTimeEntry(ctx, api.GetTimeEntryParam{
+		Workspace:   w,
+		TimeEntryID: id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if te.TimeInterval.End == nil {
+		return []dto.TimeEntry{*te}, nil
+	}
+
+	end := *te.TimeInterval.End
+	points := make([]time.Time, 0)
+	s := te.TimeInterval.Start.In(time.Local)
+	m := time.Date(s.Year(), s.Month(), s.Day()+1, 0, 0, 0, 0, time.Local)
+	for ; m.Before(end); m = m.AddDate(0, 0, 1) {
+		points = append(points, m)
+	}
+
+	if len(points) == 0 {
+		return []dto.TimeEntry{*te}, nil
+	}
+
+	p := CopyTimeEntryParam(w, *te)
+
+	first := points[0]
+	if _, err := c.UpdateTimeEntry(ctx, api.UpdateTimeEntryParam{
+		Workspace:    w,
+		TimeEntryID:  te.ID,
+		Start:        te.TimeInterval.Start,
+		End:          &first,
+		Billable:     te.Billable,
+		Description:  p.Description,
+		ProjectID:    p.ProjectID,
+		TaskID:       p.TaskID,
+		TagIDs:       p.TagIDs,
+		CustomFields: p.CustomFields,
+	}); err != nil {
+		return nil, err
+	}
+
+	tes := make([]dto.TimeEntry, 0, len(points)+1)
+	t := *te
+	t.TimeInterval = dto.NewTimeInterval(te.TimeInterval.Start, &first)
+	tes = append(tes, t)
+
+	for i := range points {
+		e := end
+		if i < len(points)-1 {
+			e = points[i+1]
+		}
+
+		p.Start = points[i]
+		p.End = &e
+		n, err := c.CreateTimeEntry(ctx, p)
+		if err != nil {
+			return tes, errors.Wrapf(err,
+				"failed to create the time entry for %s",
+				points[i].Format("2006-01-02"))
+		}
+
+		t := *te
+		t.ID = n.ID
+		t.TimeInterval = dto.NewTimeInterval(points[i], &e)
+		tes = append(tes, t)
+	}
+
+	return tes, nil
+}
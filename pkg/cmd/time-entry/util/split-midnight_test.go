@@ -0,0 +1,107 @@
+package util
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSplitAtMidnight(t *testing.T) {
+	start := time.Date(2022, 6, 1, 22, 0, 0, 0, time.Local)
+	day2 := time.Date(2022, 6, 2, 0, 0, 0, 0, time.Local)
+	day3 := time.Date(2022, 6, 3, 0, 0, 0, 0, time.Local)
+	end := time.Date(2022, 6, 3, 2, 0, 0, 0, time.Local)
+
+	c := mocks.NewMockClient(t)
+	p := api.GetTimeEntryParam{Workspace: "w", TimeEntryID: "te1"}
+	c.EXPECT().GetHydratedTimeEntry(mock.Anything, p).Return(&dto.TimeEntry{
+		ID:          "te1",
+		Description: "deploy",
+		Project:     &dto.Project{ID: "p1"},
+		Task:        &dto.Task{ID: "t1"},
+		Tags:        []dto.Tag{{ID: "tg1"}},
+		TimeInterval: dto.TimeInterval{
+			Start: start,
+			End:   &end,
+		},
+		CustomFields: []dto.TimeEntryCustomField{
+			{CustomFieldID: "cf1", Value: "OPS-1"},
+		},
+	}, nil)
+
+	cfs := []dto.CustomFieldValue{{CustomFieldID: "cf1", Value: "OPS-1"}}
+	c.EXPECT().UpdateTimeEntry(mock.Anything, api.UpdateTimeEntryParam{
+		Workspace:    "w",
+		TimeEntryID:  "te1",
+		Start:        start,
+		End:          &day2,
+		Description:  "deploy",
+		ProjectID:    "p1",
+		TaskID:       "t1",
+		TagIDs:       []string{"tg1"},
+		CustomFields: cfs,
+	}).
+		Return(dto.TimeEntryImpl{ID: "te1"}, nil).
+		Once()
+
+	for _, r := range []struct {
+		id         string
+		start, end time.Time
+	}{
+		{id: "te2", start: day2, end: day3},
+		{id: "te3", start: day3, end: end},
+	} {
+		e := r.end
+		c.EXPECT().CreateTimeEntry(mock.Anything, api.CreateTimeEntryParam{
+			Workspace:    "w",
+			Start:        r.start,
+			End:          &e,
+			Billable:     &bFalse,
+			Description:  "deploy",
+			ProjectID:    "p1",
+			TaskID:       "t1",
+			TagIDs:       []string{"tg1"},
+			CustomFields: cfs,
+		}).
+			Return(dto.TimeEntryImpl{ID: r.id}, nil).
+			Once()
+	}
+
+	tes, err := SplitAtMidnight(context.Background(), c, "w", "te1")
+	if !assert.NoError(t, err) || !assert.Len(t, tes, 3) {
+		return
+	}
+
+	for i, id := range []string{"te1", "te2", "te3"} {
+		assert.Equal(t, id, tes[i].ID)
+	}
+	assert.Equal(t, day2, tes[1].TimeInterval.Start.In(time.Local))
+	assert.Equal(t, end, tes[2].TimeInterval.End.In(time.Local))
+}
+
+func TestSplitAtMidnightSameDay(t *testing.T) {
+	start := time.Date(2022, 6, 1, 22, 0, 0, 0, time.Local)
+	midnight := time.Date(2022, 6, 2, 0, 0, 0, 0, time.Local)
+
+	for _, end := range []*time.Time{nil, &midnight} {
+		c := mocks.NewMockClient(t)
+		c.EXPECT().GetHydratedTimeEntry(mock.Anything, api.GetTimeEntryParam{
+			Workspace:   "w",
+			TimeEntryID: "te1",
+		}).
+			Return(&dto.TimeEntry{
+				ID:           "te1",
+				TimeInterval: dto.TimeInterval{Start: start, End: end},
+			}, nil)
+
+		tes, err := SplitAtMidnight(context.Background(), c, "w", "te1")
+		assert.NoError(t, err)
+		assert.Len(t, tes, 1)
+	}
+}
@@ -0,0 +1,111 @@
+package util
+
+import (
+	"strings"
+
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// Template keeps the values of a common time entry, to start it without
+// setting each of them
+type Template struct {
+	Name        string   `yaml:"name" json:"name"`
+	ProjectID   string   `yaml:"project,omitempty" json:"project,omitempty"`
+	TaskID      string   `yaml:"task,omitempty" json:"task,omitempty"`
+	Description string   `yaml:"description,omitempty" json:"description,omitempty"`
+	TagIDs      []string `yaml:"tags,omitempty" json:"tags,omitempty"`
+	Billable    *bool    `yaml:"billable,omitempty" json:"billable,omitempty"`
+}
+
+// LoadTemplates reads the time entry templates from the config
+func LoadTemplates(c cmdutil.Config) ([]Template, error) {
+	ts := make([]Template, 0)
+	err := cmdutil.UnmarshalConfig(c, cmdutil.CONF_TIME_ENTRY_TEMPLATES, &ts)
+	return ts, err
+}
+
+// SaveTemplates writes the time entry templates into the config
+func SaveTemplates(c cmdutil.Config, ts []Template) error {
+	if err := cmdutil.MarshalConfig(
+		c, cmdutil.CONF_TIME_ENTRY_TEMPLATES, ts); err != nil {
+		return err
+	}
+
+	return c.Save()
+}
+
+// FindTemplate returns the position of the template with the name, or -1
+func FindTemplate(ts []Template, name string) int {
+	for i := range ts {
+		if strings.EqualFold(ts[i].Name, name) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// FillTimeEntryWithTemplate will fill the time entry with the values of the
+// template, keeping the ones already set
+func FillTimeEntryWithTemplate(c cmdutil.Config, name string) Step {
+	if name == "" {
+		return skip
+	}
+
+	return func(te TimeEntryDTO) (TimeEntryDTO, error) {
+		ts, err := LoadTemplates(c)
+		if err != nil {
+			return te, err
+		}
+
+		i := FindTemplate(ts, name)
+		if i == -1 {
+			return te, cmdutil.FlagErrorWrap(errors.Errorf(
+				"template \"%s\" does not exist", name))
+		}
+
+		t := ts[i]
+		if te.ProjectID == "" {
+			te.ProjectID = t.ProjectID
+			te.TaskID = t.TaskID
+		} else if te.TaskID == "" && te.ProjectID == t.ProjectID {
+			te.TaskID = t.TaskID
+		}
+
+		if te.Description == "" {
+			te.Description = t.Description
+		}
+
+		if len(te.TagIDs) == 0 {
+			te.TagIDs = t.TagIDs
+		}
+
+		if te.Billable == nil {
+			te.Billable = t.Billable
+		}
+
+		return te, nil
+	}
+}
+
+// NewTemplateAutoComplete suggests the names of the time entry templates
+func NewTemplateAutoComplete(f cmdutil.Factory) cmdcompl.SuggestFn {
+	return func(
+		_ *cobra.Command, _ []string, _ string,
+	) (cmdcompl.ValidArgs, error) {
+		ts, err := LoadTemplates(f.Config())
+		if err != nil {
+			return cmdcompl.EmptyValidArgs(), err
+		}
+
+		va := make(cmdcompl.ValidArgsMap, len(ts))
+		for _, t := range ts {
+			va.Set(t.Name, t.Description)
+		}
+
+		return va, nil
+	}
+}
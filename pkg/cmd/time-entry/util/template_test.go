@@ -0,0 +1,91 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFillTimeEntryWithTemplate(t *testing.T) {
+	tts := []struct {
+		name string
+		te   TimeEntryDTO
+		r    TimeEntryDTO
+	}{
+		{
+			name: "empty",
+			r: TimeEntryDTO{
+				ProjectID:   "p1",
+				TaskID:      "t1",
+				Description: "daily standup",
+				TagIDs:      []string{"tg1"},
+				Billable:    &bFalse,
+			},
+		},
+		{
+			name: "other project",
+			te: TimeEntryDTO{
+				ProjectID: "p2",
+				Billable:  &bTrue,
+			},
+			r: TimeEntryDTO{
+				ProjectID:   "p2",
+				Description: "daily standup",
+				TagIDs:      []string{"tg1"},
+				Billable:    &bTrue,
+			},
+		},
+		{
+			name: "same project",
+			te: TimeEntryDTO{
+				ProjectID:   "p1",
+				Description: "planning",
+				TagIDs:      []string{"tg2"},
+			},
+			r: TimeEntryDTO{
+				ProjectID:   "p1",
+				TaskID:      "t1",
+				Description: "planning",
+				TagIDs:      []string{"tg2"},
+				Billable:    &bFalse,
+			},
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			c := mocks.NewMockConfig(t)
+			c.EXPECT().Get(cmdutil.CONF_TIME_ENTRY_TEMPLATES).Return(
+				[]interface{}{
+					map[string]interface{}{"name": "other"},
+					map[string]interface{}{
+						"name":        "standup",
+						"project":     "p1",
+						"task":        "t1",
+						"description": "daily standup",
+						"tags":        []interface{}{"tg1"},
+						"billable":    false,
+					},
+				})
+
+			te, err := FillTimeEntryWithTemplate(c, "Standup")(tt.te)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.r, te)
+		})
+	}
+}
+
+func TestFillTimeEntryWithTemplate_ShouldFail_WhenNotFound(t *testing.T) {
+	c := mocks.NewMockConfig(t)
+	c.EXPECT().Get(cmdutil.CONF_TIME_ENTRY_TEMPLATES).Return(nil)
+
+	_, err := FillTimeEntryWithTemplate(c, "standup")(TimeEntryDTO{})
+	assert.EqualError(t, err, `template "standup" does not exist`)
+
+	te, err := FillTimeEntryWithTemplate(nil, "")(TimeEntryDTO{})
+	assert.NoError(t, err)
+	assert.Equal(t, TimeEntryDTO{}, te)
+}
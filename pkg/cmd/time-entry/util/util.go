@@ -5,6 +5,7 @@ package util
 import (
 	"time"
 
+	"github.com/lucassabreu/clockify-cli/api"
 	"github.com/lucassabreu/clockify-cli/api/dto"
 )
 
@@ -22,6 +23,11 @@ type TimeEntryDTO struct {
 	TagIDs      []string
 	Billable    *bool
 	Locked      *bool
+
+	// CustomFields to be set on the time entry, before LookupCustomFieldsFn
+	// the CustomFieldID may be the name of the field and the value is the
+	// one informed by the user
+	CustomFields []dto.CustomFieldValue
 }
 
 // Step is used to stack multiple actions to be executed over a TimeEntryDTO
@@ -94,3 +100,75 @@ func TimeEntryDTOToImpl(t TimeEntryDTO) dto.TimeEntryImpl {
 		IsLocked:     *t.Locked,
 	}
 }
+
+// TimeEntryDTOToNamed converts the time entry to be printed, using the names
+// informed on named for the project, task and tags (as they were before
+// being replaced by their IDs)
+func TimeEntryDTOToNamed(tei, named TimeEntryDTO) dto.TimeEntry {
+	end := *tei.End
+	te := dto.TimeEntry{
+		WorkspaceID:  tei.Workspace,
+		ProjectID:    tei.ProjectID,
+		Description:  tei.Description,
+		TimeInterval: dto.NewTimeInterval(tei.Start, &end),
+	}
+
+	if tei.Billable != nil {
+		te.Billable = *tei.Billable
+	}
+
+	if tei.ProjectID != "" {
+		te.Project = &dto.Project{ID: tei.ProjectID, Name: named.ProjectID}
+	}
+
+	if tei.TaskID != "" {
+		te.Task = &dto.Task{ID: tei.TaskID, Name: named.TaskID}
+	}
+
+	for i, id := range tei.TagIDs {
+		n := id
+		if i < len(named.TagIDs) {
+			n = named.TagIDs[i]
+		}
+
+		te.Tags = append(te.Tags, dto.Tag{ID: id, Name: n})
+	}
+
+	return te
+}
+
+// CopyTimeEntryParam returns the params to create a time entry with the same
+// project, task, tags, description, billable and custom fields of the time
+// entry; the interval is not set
+func CopyTimeEntryParam(w string, te dto.TimeEntry) api.CreateTimeEntryParam {
+	p := api.CreateTimeEntryParam{
+		Workspace:   w,
+		Billable:    &te.Billable,
+		Description: te.Description,
+		ProjectID:   te.ProjectID,
+		TagIDs:      make([]string, len(te.Tags)),
+	}
+
+	if p.ProjectID == "" && te.Project != nil {
+		p.ProjectID = te.Project.ID
+	}
+
+	if te.Task != nil {
+		p.TaskID = te.Task.ID
+	}
+
+	for i := range te.Tags {
+		p.TagIDs[i] = te.Tags[i].ID
+	}
+
+	for _, cf := range te.CustomFields {
+		if cf.Value != nil {
+			p.CustomFields = append(p.CustomFields, dto.CustomFieldValue{
+				CustomFieldID: cf.CustomFieldID,
+				Value:         cf.Value,
+			})
+		}
+	}
+
+	return p
+}
@@ -1,6 +1,7 @@
 package util
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -136,6 +137,10 @@ func (f *flagSetMock) GetStringSlice(k string) ([]string, error) {
 	return []string{}, nil
 }
 
+func (f *flagSetMock) GetStringArray(k string) ([]string, error) {
+	return f.GetStringSlice(k)
+}
+
 func TestFillTimeEntryWithFlags_ShouldNotSetProperties_WhenNotChanged(
 	t *testing.T) {
 	tm := MustParseTime(timehlp.SimplerTimeFormat, "2022-11-07 11:00").Local()
@@ -211,6 +216,25 @@ func TestFillTimeEntryWithFlags_ShouldNotSetProperties_WhenNotChanged(
 				),
 			},
 		},
+		{
+			name: "custom fields",
+			flags: &flagSetMock{flags: map[string]interface{}{
+				"custom-field": []string{"Ticket=ABC-1", "note=a=b"},
+			}},
+			output: TimeEntryDTO{
+				CustomFields: []dto.CustomFieldValue{
+					{CustomFieldID: "Ticket", Value: "ABC-1"},
+					{CustomFieldID: "note", Value: "a=b"},
+				},
+			},
+		},
+		{
+			name: "custom fields should have name and value",
+			flags: &flagSetMock{flags: map[string]interface{}{
+				"custom-field": []string{"Ticket"},
+			}},
+			err: `custom-field should be like "name=value"`,
+		},
 		{
 			name: "should validate time-strings",
 			flags: &flagSetMock{flags: map[string]interface{}{
@@ -368,7 +392,7 @@ func TestGetValidateTimeEntry_ShouldValidate_UsingSettingsAndConfigs(
 			c := mocks.NewMockClient(t)
 			f.EXPECT().Client().Return(c, nil)
 
-			c.EXPECT().GetProject(mock.Anything).Return(p, err)
+			c.EXPECT().GetProject(mock.Anything, mock.Anything).Return(p, err)
 
 			return f
 		}
@@ -558,7 +582,8 @@ func TestGetValidateTimeEntry_ShouldValidate_UsingSettingsAndConfigs(
 	for i := range tts {
 		tt := &tts[i]
 		t.Run(tt.name, func(t *testing.T) {
-			_, err := GetValidateTimeEntryFn(tt.factory(t))(tt.input)
+			_, err := GetValidateTimeEntryFn(context.Background(),
+				tt.factory(t))(tt.input)
 			if tt.err != "" {
 				if !assert.Error(t, err) {
 					return
@@ -583,13 +608,13 @@ func TestGetAllowNameForIDsFn_ShouldLookupEntityIDs_WhenFilled(t *testing.T) {
 	cf := &mocks.SimpleConfig{AllowNameForID: true}
 	c := mocks.NewMockClient(t)
 
-	c.EXPECT().GetProjects(api.GetProjectsParam{
+	c.EXPECT().GetProjects(mock.Anything, api.GetProjectsParam{
 		Workspace:       te.Workspace,
 		PaginationParam: api.AllPages(),
 	}).
 		Return([]dto.Project{{ID: "pj_id", Name: "project"}}, nil)
 
-	c.EXPECT().GetTasks(api.GetTasksParam{
+	c.EXPECT().GetTasks(mock.Anything, api.GetTasksParam{
 		Workspace:       te.Workspace,
 		ProjectID:       "pj_id",
 		Active:          true,
@@ -597,7 +622,7 @@ func TestGetAllowNameForIDsFn_ShouldLookupEntityIDs_WhenFilled(t *testing.T) {
 	}).
 		Return([]dto.Task{{ID: "tk_id", Name: "task"}}, nil)
 
-	c.EXPECT().GetTags(api.GetTagsParam{
+	c.EXPECT().GetTags(mock.Anything, api.GetTagsParam{
 		Workspace:       te.Workspace,
 		PaginationParam: api.AllPages(),
 	}).
@@ -606,7 +631,7 @@ func TestGetAllowNameForIDsFn_ShouldLookupEntityIDs_WhenFilled(t *testing.T) {
 			{ID: "tg_id_2", Name: "t2"},
 		}, nil)
 
-	te, err := GetAllowNameForIDsFn(cf, c)(te)
+	te, err := GetAllowNameForIDsFn(context.Background(), cf, c)(te)
 	if !assert.NoError(t, err) {
 		return
 	}
@@ -625,7 +650,7 @@ func TestGetAllowNameForIDsFn_ShouldNotLookupEntityIDs_WhenEmpty(
 		TagIDs:    []string{},
 	}
 
-	te2, err := GetAllowNameForIDsFn(
+	te2, err := GetAllowNameForIDsFn(context.Background(),
 		&mocks.SimpleConfig{AllowNameForID: true}, mocks.NewMockClient(t))(te)
 	if !assert.NoError(t, err) {
 		return
@@ -642,7 +667,7 @@ func TestGetAllowNameForIDsFn_ShouldNotLookup_WhenDisabled(t *testing.T) {
 		TagIDs:    []string{"t1", "t2"},
 	}
 
-	te2, err := GetAllowNameForIDsFn(
+	te2, err := GetAllowNameForIDsFn(context.Background(),
 		&mocks.SimpleConfig{AllowNameForID: false}, mocks.NewMockClient(t))(te)
 	if !assert.NoError(t, err) {
 		return
@@ -662,29 +687,32 @@ func TestGetAllowNameForIDsFn_ShouldFail_WhenEntitiesNotFound(t *testing.T) {
 	cf := &mocks.SimpleConfig{AllowNameForID: true}
 	c := mocks.NewMockClient(t)
 
-	c.EXPECT().GetProjects(mock.Anything).Return([]dto.Project{}, nil).Once()
-	c.EXPECT().GetTasks(mock.Anything).Return([]dto.Task{}, nil).Once()
-	c.EXPECT().GetTags(mock.Anything).Return([]dto.Tag{}, nil).Once()
+	c.EXPECT().GetProjects(mock.Anything,
+		mock.Anything).Return([]dto.Project{}, nil).Once()
+	c.EXPECT().GetTasks(mock.Anything,
+		mock.Anything).Return([]dto.Task{}, nil).Once()
+	c.EXPECT().GetTags(mock.Anything,
+		mock.Anything).Return([]dto.Tag{}, nil).Once()
 
-	_, err := GetAllowNameForIDsFn(cf, c)(te)
+	_, err := GetAllowNameForIDsFn(context.Background(), cf, c)(te)
 	if !assert.Error(t, err) {
 		return
 	}
 	assert.Regexp(t, "No project with id or name .*", err.Error())
 
-	c.EXPECT().GetProjects(api.GetProjectsParam{
+	c.EXPECT().GetProjects(mock.Anything, api.GetProjectsParam{
 		Workspace:       te.Workspace,
 		PaginationParam: api.AllPages(),
 	}).
 		Return([]dto.Project{{ID: "pj_id", Name: "project"}}, nil)
 
-	_, err = GetAllowNameForIDsFn(cf, c)(te)
+	_, err = GetAllowNameForIDsFn(context.Background(), cf, c)(te)
 	if !assert.Error(t, err) {
 		return
 	}
 	assert.Regexp(t, "No task with id or name .*", err.Error())
 
-	c.EXPECT().GetTasks(api.GetTasksParam{
+	c.EXPECT().GetTasks(mock.Anything, api.GetTasksParam{
 		Workspace:       te.Workspace,
 		ProjectID:       "pj_id",
 		Active:          true,
@@ -692,19 +720,19 @@ func TestGetAllowNameForIDsFn_ShouldFail_WhenEntitiesNotFound(t *testing.T) {
 	}).
 		Return([]dto.Task{{ID: "tk_id", Name: "task"}}, nil)
 
-	_, err = GetAllowNameForIDsFn(cf, c)(te)
+	_, err = GetAllowNameForIDsFn(context.Background(), cf, c)(te)
 	if !assert.Error(t, err) {
 		return
 	}
 	assert.Regexp(t, "No tag with id or name .*", err.Error())
 
-	c.EXPECT().GetTags(api.GetTagsParam{
+	c.EXPECT().GetTags(mock.Anything, api.GetTagsParam{
 		Workspace:       te.Workspace,
 		PaginationParam: api.AllPages(),
 	}).
 		Return([]dto.Tag{{ID: "tg_id_1", Name: "t1"}}, nil)
 
-	_, err = GetAllowNameForIDsFn(cf, c)(te)
+	_, err = GetAllowNameForIDsFn(context.Background(), cf, c)(te)
 	if !assert.NoError(t, err) {
 		return
 	}
@@ -724,23 +752,27 @@ func TestGetAllowNameForIDsFn_ShouldBeQuiet_WhenInteractive(t *testing.T) {
 	}
 	c := mocks.NewMockClient(t)
 
-	c.EXPECT().GetProjects(mock.Anything).Return([]dto.Project{}, nil).Once()
-	c.EXPECT().GetTags(mock.Anything).Return([]dto.Tag{}, nil).Once()
+	c.EXPECT().GetProjects(mock.Anything,
+		mock.Anything).Return([]dto.Project{}, nil).Once()
+	c.EXPECT().GetTags(mock.Anything,
+		mock.Anything).Return([]dto.Tag{}, nil).Once()
 
-	_, err := GetAllowNameForIDsFn(cf, c)(te)
+	_, err := GetAllowNameForIDsFn(context.Background(), cf, c)(te)
 	if !assert.NoError(t, err) {
 		return
 	}
 
 	te.TaskID = "t"
 
-	c.EXPECT().GetProjects(mock.Anything).
+	c.EXPECT().GetProjects(mock.Anything, mock.Anything).
 		Return([]dto.Project{{ID: "pj_id", Name: "project"}}, nil).Once()
 
-	c.EXPECT().GetTasks(mock.Anything).Return([]dto.Task{}, nil).Once()
-	c.EXPECT().GetTags(mock.Anything).Return([]dto.Tag{}, nil).Once()
+	c.EXPECT().GetTasks(mock.Anything,
+		mock.Anything).Return([]dto.Task{}, nil).Once()
+	c.EXPECT().GetTags(mock.Anything,
+		mock.Anything).Return([]dto.Tag{}, nil).Once()
 
-	_, err = GetAllowNameForIDsFn(cf, c)(te)
+	_, err = GetAllowNameForIDsFn(context.Background(), cf, c)(te)
 	if !assert.NoError(t, err) {
 		return
 	}
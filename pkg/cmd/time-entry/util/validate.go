@@ -1,6 +1,7 @@
 package util
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -11,17 +12,18 @@ import (
 
 // GetValidateTimeEntryFn will check if the time entry is valid given the
 // workspace parameters
-func GetValidateTimeEntryFn(f cmdutil.Factory) Step {
+func GetValidateTimeEntryFn(ctx context.Context, f cmdutil.Factory) Step {
 	if f.Config().GetBool(cmdutil.CONF_ALLOW_INCOMPLETE) {
 		return skip
 	}
 
 	return func(tei TimeEntryDTO) (TimeEntryDTO, error) {
-		return tei, validateTimeEntry(tei, f)
+		return tei, validateTimeEntry(ctx, tei, f)
 	}
 }
 
-func validateTimeEntry(te TimeEntryDTO, f cmdutil.Factory) error {
+func validateTimeEntry(
+	ctx context.Context, te TimeEntryDTO, f cmdutil.Factory) error {
 	w, err := f.GetWorkspace()
 	if err != nil {
 		return err
@@ -52,7 +54,7 @@ func validateTimeEntry(te TimeEntryDTO, f cmdutil.Factory) error {
 		return err
 	}
 
-	p, err := c.GetProject(api.GetProjectParam{
+	p, err := c.GetProject(ctx, api.GetProjectParam{
 		Workspace: te.Workspace,
 		ProjectID: te.ProjectID,
 	})
@@ -0,0 +1,167 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	output "github.com/lucassabreu/clockify-cli/pkg/output/time-entry"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// NewCmdWatch represents the watch command
+func NewCmdWatch(f cmdutil.Factory) *cobra.Command {
+	interval := 30 * time.Second
+	cmd := &cobra.Command{
+		Use:  "watch",
+		Args: cobra.NoArgs,
+		Short: "Shows the time entry in progress with its duration " +
+			"updating until it is stopped",
+		Long: heredoc.Doc(`
+			Shows the time entry in progress with its duration updating every
+			second, and its project and description.
+
+			The time entry is fetched again on each interval, so changes done
+			elsewhere are shown, and when it is stopped the command ends.
+			Press Ctrl+C to stop watching, without stopping the time entry.
+		`),
+		Example: heredoc.Doc(`
+			$ clockify-cli watch
+			0:25:13  Clockify CLI: Watch Command  Writing docs
+
+			# check if it was stopped every 5 seconds
+			$ clockify-cli watch --interval 5s
+		`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+
+			if interval <= 0 {
+				return cmdutil.FlagErrorWrap(
+					errors.New("`interval` must be a positive duration"))
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			u, err := f.GetUserID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			p := api.GetTimeEntryInProgressParam{Workspace: w, UserID: u}
+			te, err := c.GetHydratedTimeEntryInProgress(ctx, p)
+			if err != nil {
+				return err
+			}
+
+			if te == nil {
+				return errors.New("there is no time entry in progress")
+			}
+
+			return watch(ctx, te, interval, cmd.OutOrStdout(),
+				func() (*dto.TimeEntry, error) {
+					return c.GetHydratedTimeEntryInProgress(ctx, p)
+				})
+		},
+	}
+
+	cmd.Flags().DurationVar(&interval, "interval", interval,
+		"how often the time entry is fetched again, to know if it was "+
+			"changed or stopped")
+
+	return cmd
+}
+
+// watch redraws the line of the time entry every second, on terminals, and
+// fetches it again every interval until it is stopped or the context is
+// done
+func watch(
+	ctx context.Context, te *dto.TimeEntry, interval time.Duration,
+	out io.Writer, fetch func() (*dto.TimeEntry, error),
+) error {
+	live := false
+	if f, ok := out.(*os.File); ok {
+		live = term.IsTerminal(int(f.Fd()))
+	}
+
+	draw := func() {
+		if live {
+			fmt.Fprint(out, "\r\033[K"+line(*te))
+			return
+		}
+
+		fmt.Fprintln(out, line(*te))
+	}
+
+	draw()
+
+	redraw := time.NewTicker(time.Second)
+	defer redraw.Stop()
+	poll := time.NewTicker(interval)
+	defer poll.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if live {
+				fmt.Fprintln(out)
+			}
+			return nil
+		case <-redraw.C:
+			if live {
+				draw()
+			}
+		case <-poll.C:
+			n, err := fetch()
+			if err != nil {
+				return err
+			}
+
+			if n == nil || n.ID != te.ID {
+				if live {
+					fmt.Fprintln(out)
+				}
+				fmt.Fprintln(out, "time entry was stopped")
+				return nil
+			}
+
+			te = n
+			draw()
+		}
+	}
+}
+
+var formatDuration, _ = output.DurationFormatter("hms")
+
+func line(te dto.TimeEntry) string {
+	s := formatDuration(timehlp.Now().Sub(te.TimeInterval.Start))
+
+	if te.Project != nil {
+		s += "  " + te.Project.Name
+		if te.Task != nil {
+			s += ": " + te.Task.Name
+		}
+	}
+
+	if te.Description != "" {
+		s += "  " + te.Description
+	}
+
+	return s
+}
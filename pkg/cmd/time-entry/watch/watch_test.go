@@ -0,0 +1,93 @@
+package watch_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-entry/watch"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCmdWatch(t *testing.T) {
+	te := &dto.TimeEntry{
+		ID:          "te1",
+		Description: "Writing docs",
+		Project:     &dto.Project{Name: "Clockify CLI"},
+		TimeInterval: dto.TimeInterval{
+			Start: time.Now().Add(-time.Hour),
+		},
+	}
+	changed := *te
+	changed.Description = "Writing tests"
+
+	tts := []struct {
+		name     string
+		fetches  []*dto.TimeEntry
+		expected string
+		err      string
+	}{
+		{
+			name:    "nothing running",
+			fetches: []*dto.TimeEntry{nil},
+			err:     "there is no time entry in progress",
+		},
+		{
+			name:    "until stopped",
+			fetches: []*dto.TimeEntry{te, te, &changed, nil},
+			expected: `^\d:\d\d:\d\d  Clockify CLI  Writing docs\n` +
+				`\d:\d\d:\d\d  Clockify CLI  Writing docs\n` +
+				`\d:\d\d:\d\d  Clockify CLI  Writing tests\n` +
+				`time entry was stopped\n$`,
+		},
+		{
+			name:    "other started",
+			fetches: []*dto.TimeEntry{te, {ID: "te2"}},
+			expected: `^\d:\d\d:\d\d  Clockify CLI  Writing docs\n` +
+				`time entry was stopped\n$`,
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			f := mocks.NewMockFactory(t)
+			f.EXPECT().GetWorkspaceID().Return("w", nil)
+			f.EXPECT().GetUserID().Return("u", nil)
+
+			c := mocks.NewMockClient(t)
+			f.EXPECT().Client().Return(c, nil)
+
+			for _, r := range tt.fetches {
+				c.EXPECT().GetHydratedTimeEntryInProgress(mock.Anything,
+					api.GetTimeEntryInProgressParam{
+						Workspace: "w",
+						UserID:    "u",
+					}).
+					Return(r, nil).Once()
+			}
+
+			cmd := watch.NewCmdWatch(f)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			out := bytes.NewBufferString("")
+			cmd.SetOut(out)
+			cmd.SetErr(out)
+			cmd.SetArgs([]string{"--interval=5ms"})
+
+			_, err := cmd.ExecuteC()
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Regexp(t, tt.expected, out.String())
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package balance
+
+import (
+	"io"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-off/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdBalance represents the balance command
+func NewCmdBalance(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, []dto.TimeOffBalance) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	cmd := &cobra.Command{
+		Use:   "balance",
+		Args:  cobra.ExactArgs(0),
+		Short: "Shows how much time off the user has left on each policy",
+		Example: heredoc.Docf(`
+			$ %[1]s
+			+------------+---------+------+-------+
+			|   POLICY   | BALANCE | USED | TOTAL |
+			+------------+---------+------+-------+
+			| Vacation   | 12.5    | 7.5  | 20    |
+			| Sick Leave | 5       | 0    | 5     |
+			+------------+---------+------+-------+
+
+			$ %[1]s --format '{{.PolicyName}}: {{.Balance}}'
+			Vacation: 12.5
+			Sick Leave: 5
+		`, "clockify-cli time-off balance"),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			p := api.GetTimeOffBalancesParam{PaginationParam: api.AllPages()}
+
+			var err error
+			if p.Workspace, err = f.GetWorkspaceID(); err != nil {
+				return err
+			}
+
+			if p.UserID, err = f.GetUserID(); err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			bs, err := c.GetTimeOffBalances(cmd.Context(), p)
+			if err != nil {
+				return err
+			}
+
+			if report != nil {
+				return report(cmd.OutOrStdout(), &of, bs)
+			}
+
+			return util.ReportBalances(bs, cmd.OutOrStdout(), of)
+		},
+	}
+
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
@@ -0,0 +1,133 @@
+package list
+
+import (
+	"io"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-off/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+var statuses = map[string]dto.TimeOffStatus{
+	"pending":  dto.TimeOffStatusPending,
+	"approved": dto.TimeOffStatusApproved,
+	"rejected": dto.TimeOffStatusRejected,
+	"all":      "",
+}
+
+// NewCmdList represents the list command
+func NewCmdList(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, []dto.TimeOffRequest) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	var all bool
+	var status, start, end string
+	pagination := api.AllPages()
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Args:    cobra.ExactArgs(0),
+		Short:   "List time off requests of the user",
+		Example: heredoc.Docf(`
+			$ %[1]s --start 2023-08-01 --end 2023-08-31
+			+--------------------------+----------+----------+------------+------------+----------+
+			|            ID            |   USER   |  POLICY  |   START    |    END     |  STATUS  |
+			+--------------------------+----------+----------+------------+------------+----------+
+			| 64c3d9a2b4e5f6a7b8c9d0e1 | John Due | Vacation | 2023-08-07 | 2023-08-11 | APPROVED |
+			+--------------------------+----------+----------+------------+------------+----------+
+
+			$ %[1]s --all --status pending -q
+			64c3d9a2b4e5f6a7b8c9d0e2
+		`, "clockify-cli time-off list"),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			s, ok := statuses[strings.ToLower(status)]
+			if !ok {
+				return cmdutil.FlagErrorWrap(&api.InvalidOptionError{
+					Field:   "status",
+					Options: statusArgs(),
+				})
+			}
+
+			p := api.GetTimeOffRequestsParam{PaginationParam: pagination}
+			if s != "" {
+				p.Statuses = []dto.TimeOffStatus{s}
+			}
+
+			if start != "" {
+				d, err := util.ParseDate("start", start)
+				if err != nil {
+					return cmdutil.FlagErrorWrap(err)
+				}
+				p.Start = &d
+			}
+
+			if end != "" {
+				d, err := util.ParseDate("end", end)
+				if err != nil {
+					return cmdutil.FlagErrorWrap(err)
+				}
+				d = d.AddDate(0, 0, 1).Add(-1)
+				p.End = &d
+			}
+
+			var err error
+			if p.Workspace, err = f.GetWorkspaceID(); err != nil {
+				return err
+			}
+
+			if !all {
+				u, err := f.GetUserID()
+				if err != nil {
+					return err
+				}
+				p.Users = []string{u}
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			rs, err := c.GetTimeOffRequests(cmd.Context(), p)
+			if err != nil {
+				return err
+			}
+
+			if report != nil {
+				return report(cmd.OutOrStdout(), &of, rs)
+			}
+
+			return util.Report(rs, cmd.OutOrStdout(), of)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&all, "all", "a", false,
+		"list the requests of all users of the workspace")
+	cmd.Flags().StringVarP(&status, "status", "s", "all",
+		"which requests to list: pending, approved, rejected or all")
+	_ = cmdcompl.AddFixedSuggestionsToFlag(cmd, "status",
+		cmdcompl.ValidArgsSlide(statusArgs()))
+	cmd.Flags().StringVar(&start, "start", "",
+		"only requests from this day on (format 2006-01-02)")
+	cmd.Flags().StringVar(&end, "end", "",
+		"only requests until this day (format 2006-01-02)")
+
+	util.AddReportFlags(cmd, &of)
+	cmdutil.AddPaginationFlags(cmd.Flags(), &pagination)
+
+	return cmd
+}
+
+func statusArgs() []string {
+	return []string{"pending", "approved", "rejected", "all"}
+}
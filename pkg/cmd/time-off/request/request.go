@@ -0,0 +1,121 @@
+package request
+
+import (
+	"errors"
+	"io"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-off/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcomplutil"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/search"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdRequest represents the request command
+func NewCmdRequest(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, dto.TimeOffRequest) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	p := api.AddTimeOffRequestParam{}
+	cmd := &cobra.Command{
+		Use:     "request <start> [<end>]",
+		Aliases: []string{"add", "new"},
+		Args:    cobra.RangeArgs(1, 2),
+		Short:   "Requests time off on a policy of the workspace",
+		Long: heredoc.Doc(`
+			Requests time off on a policy of the workspace
+
+			The dates must be in the format 2006-01-02, and both are
+			included on the time off. When no end is informed only the start
+			day is requested.
+		`),
+		Example: heredoc.Docf(`
+			$ %[1]s --policy vacation 2023-08-07 2023-08-11 -q
+			64c3d9a2b4e5f6a7b8c9d0e1
+
+			$ %[1]s --policy "sick leave" --half-day 2023-08-14 -n "dentist"
+			+--------------------------+----------+------------+------------+------------+---------+
+			|            ID            |   USER   |   POLICY   |   START    |    END     | STATUS  |
+			+--------------------------+----------+------------+------------+------------+---------+
+			| 64c3d9a2b4e5f6a7b8c9d0e2 | John Due | Sick Leave | 2023-08-14 | 2023-08-14 | PENDING |
+			+--------------------------+----------+------------+------------+------------+---------+
+		`, "clockify-cli time-off request"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			var err error
+			if p.Start, err = util.ParseDate("start", args[0]); err != nil {
+				return err
+			}
+
+			end := p.Start
+			if len(args) > 1 {
+				if end, err = util.ParseDate("end", args[1]); err != nil {
+					return err
+				}
+			}
+
+			if end.Before(p.Start) {
+				return errors.New("end must not be before start")
+			}
+
+			if p.HalfDay && !end.Equal(p.Start) {
+				return cmdutil.FlagErrorWrap(errors.New(
+					"half-day can only be used for a single day"))
+			}
+
+			p.End = end.AddDate(0, 0, 1).Add(-1)
+
+			if p.Workspace, err = f.GetWorkspaceID(); err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			if f.Config().IsAllowNameForID() {
+				if p.PolicyID, err = search.GetTimeOffPolicyByName(
+					ctx,
+					c, p.Workspace, p.PolicyID); err != nil {
+					return err
+				}
+			}
+
+			r, err := c.AddTimeOffRequest(ctx, p)
+			if err != nil {
+				return err
+			}
+
+			if report != nil {
+				return report(cmd.OutOrStdout(), &of, r)
+			}
+
+			return util.ReportOne(r, cmd.OutOrStdout(), of)
+		},
+	}
+
+	cmd.Flags().StringVarP(&p.PolicyID, "policy", "p", "",
+		"the name/id of the time off policy")
+	_ = cmd.MarkFlagRequired("policy")
+	_ = cmdcompl.AddSuggestionsToFlag(cmd, "policy",
+		cmdcomplutil.NewTimeOffPolicyAutoComplete(f))
+	cmd.Flags().BoolVar(&p.HalfDay, "half-day", false,
+		"request only half of the day")
+	cmd.Flags().StringVarP(&p.Note, "note", "n", "",
+		"a note to who will approve the request")
+
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
@@ -0,0 +1,126 @@
+package request_test
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-off/request"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-off/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCmdRequest(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2023, 8, d, 0, 0, 0, 0, time.Local)
+	}
+
+	tts := []struct {
+		name    string
+		args    []string
+		factory func(*testing.T) cmdutil.Factory
+		param   api.AddTimeOffRequestParam
+		err     string
+	}{
+		{
+			name: "policy required",
+			args: []string{"2023-08-07"},
+			err:  `"policy" not set`,
+			factory: func(t *testing.T) cmdutil.Factory {
+				return mocks.NewMockFactory(t)
+			},
+		},
+		{
+			name: "invalid date",
+			args: []string{"-p=p1", "07/08/2023"},
+			err:  "start must be in the format 2006-01-02",
+			factory: func(t *testing.T) cmdutil.Factory {
+				return mocks.NewMockFactory(t)
+			},
+		},
+		{
+			name: "end before start",
+			args: []string{"-p=p1", "2023-08-07", "2023-08-06"},
+			err:  "end must not be before start",
+			factory: func(t *testing.T) cmdutil.Factory {
+				return mocks.NewMockFactory(t)
+			},
+		},
+		{
+			name: "half day of many days",
+			args: []string{"-p=p1", "--half-day", "2023-08-07", "2023-08-08"},
+			err:  "half-day can only be used for a single day",
+			factory: func(t *testing.T) cmdutil.Factory {
+				return mocks.NewMockFactory(t)
+			},
+		},
+		{
+			name: "single day",
+			args: []string{"-p=p1", "--half-day", "2023-08-07", "-n=dentist"},
+			param: api.AddTimeOffRequestParam{
+				Workspace: "w",
+				PolicyID:  "p1",
+				Start:     day(7),
+				End:       day(8).Add(-1),
+				HalfDay:   true,
+				Note:      "dentist",
+			},
+		},
+		{
+			name: "a week",
+			args: []string{"-p=p1", "2023-08-07", "2023-08-11"},
+			param: api.AddTimeOffRequestParam{
+				Workspace: "w",
+				PolicyID:  "p1",
+				Start:     day(7),
+				End:       day(12).Add(-1),
+			},
+		},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.name, func(t *testing.T) {
+			var f cmdutil.Factory
+			called := false
+			if tt.factory != nil {
+				f = tt.factory(t)
+			} else {
+				mf := mocks.NewMockFactory(t)
+				c := mocks.NewMockClient(t)
+				cf := mocks.NewMockConfig(t)
+				mf.On("GetWorkspaceID").Return("w", nil)
+				mf.On("Client").Return(c, nil)
+				mf.On("Config").Return(cf)
+				cf.On("IsAllowNameForID").Return(false)
+				c.On("AddTimeOffRequest", mock.Anything, tt.param).
+					Return(dto.TimeOffRequest{ID: "r1"}, nil)
+				f = mf
+
+				t.Cleanup(func() { assert.True(t, called) })
+			}
+
+			cmd := request.NewCmdRequest(f,
+				func(_ io.Writer, _ *util.OutputFlags, r dto.TimeOffRequest) error {
+					called = true
+					assert.Equal(t, "r1", r.ID)
+					return nil
+				})
+			cmd.SilenceUsage = true
+			cmd.SetArgs(tt.args)
+
+			_, err := cmd.ExecuteC()
+			if tt.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.Error(t, err)
+			assert.Regexp(t, tt.err, err.Error())
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package timeoff
+
+import (
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-off/balance"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-off/list"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/time-off/request"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdTimeOff represents the time-off command
+func NewCmdTimeOff(f cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "time-off",
+		Aliases: []string{"pto"},
+		Short:   "Request time off and check balances on Clockify",
+	}
+
+	cmd.AddCommand(request.NewCmdRequest(f, nil))
+	cmd.AddCommand(list.NewCmdList(f, nil))
+	cmd.AddCommand(balance.NewCmdBalance(f, nil))
+
+	return cmd
+}
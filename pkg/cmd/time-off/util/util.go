@@ -0,0 +1,91 @@
+package util
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	output "github.com/lucassabreu/clockify-cli/pkg/output/time-off"
+	"github.com/spf13/cobra"
+)
+
+// OutputFlags sets how to print out a list of time off requests or balances
+type OutputFlags struct {
+	Format string
+	JSON   bool
+	YAML   bool
+	Quiet  bool
+}
+
+func (of OutputFlags) Check() error {
+	return cmdutil.XorFlag(map[string]bool{
+		"format": of.Format != "",
+		"json":   of.JSON,
+		"yaml":   of.YAML,
+		"quiet":  of.Quiet,
+	})
+}
+
+// AddReportFlags adds the default output flags for time off
+func AddReportFlags(cmd *cobra.Command, of *OutputFlags) {
+	cmd.Flags().StringVarP(&of.Format, "format", "f", "",
+		"golang text/template format to be applied on each item")
+	cmdutil.AddTemplateSourceFlags(cmd.Flags())
+	cmd.Flags().BoolVarP(&of.JSON, "json", "j", false, "print as JSON")
+	cmd.Flags().BoolVar(&of.YAML, "yaml", false, "print as YAML")
+	cmd.Flags().BoolVarP(&of.Quiet, "quiet", "q", false, "only display ids")
+}
+
+// Report prints out the time off requests
+func Report(rs []dto.TimeOffRequest, out io.Writer, of OutputFlags) error {
+	switch {
+	case of.JSON:
+		return output.TimeOffRequestsJSONPrint(rs, out)
+	case of.YAML:
+		return output.TimeOffRequestsYAMLPrint(rs, out)
+	case of.Format != "":
+		return output.TimeOffRequestPrintWithTemplate(of.Format)(rs, out)
+	case of.Quiet:
+		return output.TimeOffRequestPrintQuietly(rs, out)
+	default:
+		return output.TimeOffRequestPrint(rs, out)
+	}
+}
+
+// ReportOne prints out a single time off request
+func ReportOne(r dto.TimeOffRequest, out io.Writer, of OutputFlags) error {
+	if of.JSON {
+		return output.TimeOffRequestJSONPrint(r, out)
+	}
+
+	return Report([]dto.TimeOffRequest{r}, out, of)
+}
+
+// ReportBalances prints out the balances of the user
+func ReportBalances(
+	bs []dto.TimeOffBalance, out io.Writer, of OutputFlags) error {
+	switch {
+	case of.JSON:
+		return output.TimeOffBalancesJSONPrint(bs, out)
+	case of.YAML:
+		return output.TimeOffBalancesYAMLPrint(bs, out)
+	case of.Format != "":
+		return output.TimeOffBalancePrintWithTemplate(of.Format)(bs, out)
+	case of.Quiet:
+		return output.TimeOffBalancePrintQuietly(bs, out)
+	default:
+		return output.TimeOffBalancePrint(bs, out)
+	}
+}
+
+// ParseDate reads a day in the format 2006-01-02 as local time
+func ParseDate(name, value string) (time.Time, error) {
+	d, err := time.ParseInLocation("2006-01-02", value, time.Local)
+	if err != nil {
+		return d, fmt.Errorf("%s must be in the format 2006-01-02", name)
+	}
+
+	return d, nil
+}
@@ -0,0 +1,100 @@
+package undo
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/lucassabreu/clockify-cli/pkg/journal"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdUndo represents the undo command
+func NewCmdUndo(f cmdutil.Factory) *cobra.Command {
+	var list bool
+
+	cmd := &cobra.Command{
+		Use:   "undo",
+		Short: "Reverts the changes to time entries of the last execution",
+		Long: heredoc.Docf(`
+			Reverts the time entries created, edited, ended and deleted by
+			the last execution of the CLI that changed them.
+
+			Time entries created are deleted, the edited and ended ones go
+			back to how they were before and the deleted ones are created
+			again (with a new ID). Running it again reverts the execution
+			before that one.
+
+			The changes are kept on a local journal with the last %[1]s
+			executions (10 by default), to keep more or disable it use:
+			clockify-cli config set %[1]s <n>
+		`, cmdutil.CONF_JOURNAL_SIZE),
+		Example: heredoc.Doc(`
+			# deleted the wrong time entry
+			$ clockify-cli delete 62ae4b304ebb4f143c931d50
+			$ clockify-cli undo
+			created 62ae4b304ebb4f143c931d51 "coding"
+
+			# what can be reverted, the newest first
+			$ clockify-cli undo --list
+			2022-06-01 15:20:10
+			  edited 62ae4b304ebb4f143c931d52 "meeting"
+			2022-06-01 09:00:00
+			  edited 62ae4b304ebb4f143c931d50 "coding"
+			  created 62ae4b304ebb4f143c931d52 "meeting"
+		`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			j, err := cmdutil.Journal(f)
+			if err != nil {
+				return err
+			}
+
+			if j.Size() <= 0 {
+				return errors.Errorf(
+					"the journal is disabled, set \"%s\" to enable it",
+					cmdutil.CONF_JOURNAL_SIZE)
+			}
+
+			out := cmd.OutOrStdout()
+			if list {
+				es, err := j.Entries()
+				if err != nil {
+					return err
+				}
+
+				for i := len(es) - 1; i >= 0; i-- {
+					fmt.Fprintln(out,
+						es[i].At.Local().Format("2006-01-02 15:04:05"))
+					printChanges(out, "  ", es[i].Changes)
+				}
+
+				return nil
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			cs, err := j.Undo(cmd.Context(), c)
+			printChanges(out, "", cs)
+			return err
+		},
+	}
+
+	cmd.Flags().BoolVarP(&list, "list", "l", false,
+		"shows the changes that can be reverted, instead of reverting them")
+
+	return cmd
+}
+
+func printChanges(out io.Writer, prefix string, cs []journal.Change) {
+	for _, c := range cs {
+		te := c.TimeEntry()
+		fmt.Fprintf(out, "%s%s %s %q\n",
+			prefix, c.Kind(), te.ID, te.Description)
+	}
+}
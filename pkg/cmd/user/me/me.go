@@ -49,7 +49,7 @@ func NewCmdMe(
 				return err
 			}
 
-			u, err := c.GetMe()
+			u, err := c.GetMe(cmd.Context())
 			if err != nil {
 				return err
 			}
@@ -11,6 +11,7 @@ import (
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/user/util"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 type report func(io.Writer, *util.OutputFlags, dto.User) error
@@ -52,7 +53,7 @@ func TestCmdMe(t *testing.T) {
 				f := mocks.NewMockFactory(t)
 				c := mocks.NewMockClient(t)
 				f.On("Client").Return(c, nil)
-				c.On("GetMe").
+				c.On("GetMe", mock.Anything).
 					Return(dto.User{}, errors.New("http error"))
 				return f, defReport(t)
 			},
@@ -66,7 +67,7 @@ func TestCmdMe(t *testing.T) {
 				f.On("Client").Return(c, nil)
 
 				r := dto.User{Email: "john@due.com"}
-				c.On("GetMe").Return(r, nil)
+				c.On("GetMe", mock.Anything).Return(r, nil)
 
 				called := false
 				t.Cleanup(func() { assert.True(t, called, "was not called") })
@@ -88,7 +89,7 @@ func TestCmdMe(t *testing.T) {
 				f.On("Client").Return(c, nil)
 
 				r := dto.User{Email: "john@due.com"}
-				c.On("GetMe").Return(r, nil)
+				c.On("GetMe", mock.Anything).Return(r, nil)
 
 				called := false
 				t.Cleanup(func() { assert.True(t, called, "was not called") })
@@ -110,7 +111,7 @@ func TestCmdMe(t *testing.T) {
 				f.On("Client").Return(c, nil)
 
 				r := dto.User{Email: "john@due.com"}
-				c.On("GetMe").Return(r, nil)
+				c.On("GetMe", mock.Anything).Return(r, nil)
 
 				called := false
 				t.Cleanup(func() { assert.True(t, called, "was not called") })
@@ -18,6 +18,7 @@ func NewCmdUser(
 	report func(io.Writer, *util.OutputFlags, []dto.User) error,
 ) *cobra.Command {
 	of := util.OutputFlags{}
+	pagination := api.AllPages()
 	cmd := &cobra.Command{
 		Use:     "user",
 		Aliases: []string{"users"},
@@ -61,11 +62,12 @@ func NewCmdUser(
 				return err
 			}
 
-			users, err := c.WorkspaceUsers(api.WorkspaceUsersParam{
-				Workspace:       w,
-				Email:           email,
-				PaginationParam: api.AllPages(),
-			})
+			users, err := c.WorkspaceUsers(cmd.Context(),
+				api.WorkspaceUsersParam{
+					Workspace:       w,
+					Email:           email,
+					PaginationParam: pagination,
+				})
 			if err != nil {
 				return err
 			}
@@ -82,6 +84,7 @@ func NewCmdUser(
 		"will be used to filter the workspaces by email")
 
 	util.AddReportFlags(cmd, &of)
+	cmdutil.AddPaginationFlags(cmd.Flags(), &pagination)
 
 	_ = cmd.MarkFlagRequired("workspace")
 
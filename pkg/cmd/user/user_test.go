@@ -12,6 +12,7 @@ import (
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/user/util"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 type report func(io.Writer, *util.OutputFlags, []dto.User) error
@@ -66,7 +67,7 @@ func TestCmdUser(t *testing.T) {
 				f.On("Client").Return(c, nil)
 				f.On("GetWorkspaceID").
 					Return("w", nil)
-				c.On("WorkspaceUsers", api.WorkspaceUsersParam{
+				c.On("WorkspaceUsers", mock.Anything, api.WorkspaceUsersParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).
@@ -85,7 +86,7 @@ func TestCmdUser(t *testing.T) {
 					Return("w", nil)
 
 				list := []dto.User{{Email: "john@due.com"}}
-				c.On("WorkspaceUsers", api.WorkspaceUsersParam{
+				c.On("WorkspaceUsers", mock.Anything, api.WorkspaceUsersParam{
 					Workspace:       "w",
 					Email:           "john@due.com",
 					PaginationParam: api.AllPages(),
@@ -114,7 +115,7 @@ func TestCmdUser(t *testing.T) {
 					Return("w", nil)
 
 				list := []dto.User{{Email: "john@due.com"}}
-				c.On("WorkspaceUsers", api.WorkspaceUsersParam{
+				c.On("WorkspaceUsers", mock.Anything, api.WorkspaceUsersParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).
@@ -142,7 +143,7 @@ func TestCmdUser(t *testing.T) {
 					Return("w", nil)
 
 				list := []dto.User{{Email: "john@due.com"}}
-				c.On("WorkspaceUsers", api.WorkspaceUsersParam{
+				c.On("WorkspaceUsers", mock.Anything, api.WorkspaceUsersParam{
 					Workspace:       "w",
 					PaginationParam: api.AllPages(),
 				}).
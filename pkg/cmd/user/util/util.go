@@ -28,6 +28,7 @@ func (of OutputFlags) Check() error {
 func AddReportFlags(cmd *cobra.Command, of *OutputFlags) {
 	cmd.Flags().StringVarP(&of.Format, "format", "f", "",
 		"golang text/template format to be applied on each workspace")
+	cmdutil.AddTemplateSourceFlags(cmd.Flags())
 	cmd.Flags().BoolVarP(&of.Quiet, "quiet", "q", false, "only display ids")
 	cmd.Flags().BoolVarP(&of.JSON, "json", "j", false, "print as json")
 }
@@ -0,0 +1,144 @@
+package add
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/webhook/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+var sourceTypes = map[string]dto.WebhookTriggerSourceType{
+	"workspace": dto.WebhookTriggerSourceWorkspace,
+	"project":   dto.WebhookTriggerSourceProject,
+	"user":      dto.WebhookTriggerSourceUser,
+	"tag":       dto.WebhookTriggerSourceTag,
+	"task":      dto.WebhookTriggerSourceTask,
+}
+
+// NewCmdAdd represents the add command
+func NewCmdAdd(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, dto.Webhook) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	var sourceType string
+	var sources []string
+	cmd := &cobra.Command{
+		Use:     "add",
+		Aliases: []string{"new", "create"},
+		Args:    cobra.ExactArgs(0),
+		Short:   "Registers a new webhook on the Clockify workspace",
+		Long: heredoc.Doc(`
+			Registers a new webhook on the Clockify workspace
+
+			By default every change on the workspace triggers the webhook, use
+			--source-type and --source to limit it to some projects, users,
+			tags or tasks.
+		`),
+		Example: heredoc.Docf(`
+			$ %[1]s --name Billing --event NEW_TIME_ENTRY --url https://example.com/hook -q
+			64b7d5a3c2c3a5112e1b3a8c
+
+			$ %[1]s -n Special --event TIMER_STOPPED --url https://example.com/stop \
+				--source-type project --source 621948458cb9606d934ebb1c
+		`, "clockify-cli webhook add"),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			p := api.AddWebhookParam{}
+			p.Name, _ = cmd.Flags().GetString("name")
+			p.URL, _ = cmd.Flags().GetString("url")
+
+			event, _ := cmd.Flags().GetString("event")
+			p.Event = dto.WebhookEvent(strings.ToUpper(event))
+
+			if sourceType != "" {
+				st, ok := sourceTypes[strings.ToLower(sourceType)]
+				if !ok {
+					return cmdutil.FlagErrorWrap(&api.InvalidOptionError{
+						Field:   "source-type",
+						Options: sourceTypeArgs().OnlyArgs(),
+					})
+				}
+
+				if st != dto.WebhookTriggerSourceWorkspace && len(sources) == 0 {
+					return cmdutil.FlagErrorWrap(errors.New(
+						"source is required when source-type is not workspace"))
+				}
+
+				p.TriggerSourceType = st
+				p.TriggerSource = sources
+			}
+
+			var err error
+			if p.Workspace, err = f.GetWorkspaceID(); err != nil {
+				return err
+			}
+
+			if p.TriggerSourceType == dto.WebhookTriggerSourceWorkspace &&
+				len(p.TriggerSource) == 0 {
+				p.TriggerSource = []string{p.Workspace}
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			h, err := c.AddWebhook(cmd.Context(), p)
+			if err != nil {
+				return err
+			}
+
+			if report != nil {
+				return report(cmd.OutOrStdout(), &of, h)
+			}
+
+			return util.ReportOne(h, cmd.OutOrStdout(), of)
+		},
+	}
+
+	cmd.Flags().StringP("name", "n", "", "the name of the new webhook")
+	_ = cmd.MarkFlagRequired("name")
+	cmd.Flags().String("url", "", "where the events will be posted to")
+	_ = cmd.MarkFlagRequired("url")
+
+	events := make(cmdcompl.ValidArgsSlide, len(dto.WebhookEvents))
+	for i := range dto.WebhookEvents {
+		events[i] = string(dto.WebhookEvents[i])
+	}
+	cmd.Flags().StringP("event", "e", "",
+		"which event triggers the webhook (like: NEW_TIME_ENTRY)")
+	_ = cmd.MarkFlagRequired("event")
+	_ = cmdcompl.AddFixedSuggestionsToFlag(cmd, "event", events)
+
+	cmd.Flags().StringVar(&sourceType, "source-type", "",
+		"kind of entity that limits which changes trigger the webhook: "+
+			"workspace, project, user, tag or task")
+	_ = cmdcompl.AddFixedSuggestionsToFlag(cmd, "source-type",
+		sourceTypeArgs())
+	cmd.Flags().StringSliceVar(&sources, "source", []string{},
+		"ids of the entities that trigger the webhook")
+
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
+
+func sourceTypeArgs() cmdcompl.ValidArgsMap {
+	m := make(cmdcompl.ValidArgsMap, len(sourceTypes))
+	for k, v := range sourceTypes {
+		m[k] = string(v)
+	}
+
+	return m
+}
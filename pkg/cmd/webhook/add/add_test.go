@@ -0,0 +1,114 @@
+package add_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/webhook/add"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/webhook/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCmdAdd(t *testing.T) {
+	required := []string{"-n=hook", "--url=https://example.com"}
+	tts := []struct {
+		name    string
+		args    []string
+		factory func(*testing.T) cmdutil.Factory
+		param   api.AddWebhookParam
+		err     string
+	}{
+		{
+			name: "event required",
+			args: required,
+			err:  `"event" not set`,
+			factory: func(t *testing.T) cmdutil.Factory {
+				return mocks.NewMockFactory(t)
+			},
+		},
+		{
+			name: "invalid source type",
+			args: append([]string{"-e=NEW_TAG", "--source-type=client"},
+				required...),
+			err: "valid options for source-type are",
+			factory: func(t *testing.T) cmdutil.Factory {
+				return mocks.NewMockFactory(t)
+			},
+		},
+		{
+			name: "source required",
+			args: append([]string{"-e=NEW_TAG", "--source-type=project"},
+				required...),
+			err: "source is required",
+			factory: func(t *testing.T) cmdutil.Factory {
+				return mocks.NewMockFactory(t)
+			},
+		},
+		{
+			name: "whole workspace",
+			args: append([]string{"-e=new_time_entry"}, required...),
+			param: api.AddWebhookParam{
+				Workspace: "w",
+				Name:      "hook",
+				URL:       "https://example.com",
+				Event:     dto.WebhookEventNewTimeEntry,
+			},
+		},
+		{
+			name: "some projects",
+			args: append([]string{"-e=TIMER_STOPPED",
+				"--source-type=project", "--source=p1,p2"}, required...),
+			param: api.AddWebhookParam{
+				Workspace:         "w",
+				Name:              "hook",
+				URL:               "https://example.com",
+				Event:             dto.WebhookEventTimerStopped,
+				TriggerSourceType: dto.WebhookTriggerSourceProject,
+				TriggerSource:     []string{"p1", "p2"},
+			},
+		},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.name, func(t *testing.T) {
+			var f cmdutil.Factory
+			called := false
+			if tt.factory != nil {
+				f = tt.factory(t)
+			} else {
+				mf := mocks.NewMockFactory(t)
+				c := mocks.NewMockClient(t)
+				mf.On("GetWorkspaceID").Return("w", nil)
+				mf.On("Client").Return(c, nil)
+				c.On("AddWebhook", mock.Anything, tt.param).
+					Return(dto.Webhook{ID: "h1"}, nil)
+				f = mf
+
+				t.Cleanup(func() { assert.True(t, called) })
+			}
+
+			cmd := add.NewCmdAdd(f,
+				func(_ io.Writer, _ *util.OutputFlags, h dto.Webhook) error {
+					called = true
+					assert.Equal(t, "h1", h.ID)
+					return nil
+				})
+			cmd.SilenceUsage = true
+			cmd.SetArgs(tt.args)
+
+			_, err := cmd.ExecuteC()
+			if tt.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.Error(t, err)
+			assert.Regexp(t, tt.err, err.Error())
+		})
+	}
+}
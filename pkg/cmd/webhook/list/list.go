@@ -0,0 +1,68 @@
+package list
+
+import (
+	"io"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/webhook/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdList represents the list command
+func NewCmdList(
+	f cmdutil.Factory,
+	report func(io.Writer, *util.OutputFlags, []dto.Webhook) error,
+) *cobra.Command {
+	of := util.OutputFlags{}
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Args:    cobra.ExactArgs(0),
+		Short:   "List webhooks of a Clockify workspace",
+		Example: heredoc.Docf(`
+			$ %[1]s
+			+--------------------------+-----------+----------------+---------------------------+---------+
+			|            ID            |   NAME    |     EVENT      |            URL            | ENABLED |
+			+--------------------------+-----------+----------------+---------------------------+---------+
+			| 64b7d5a3c2c3a5112e1b3a8c | Billing   | NEW_TIME_ENTRY | https://example.com/hook  | YES     |
+			+--------------------------+-----------+----------------+---------------------------+---------+
+
+			$ %[1]s --quiet
+			64b7d5a3c2c3a5112e1b3a8c
+		`, "clockify-cli webhook list"),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := of.Check(); err != nil {
+				return err
+			}
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			ws, err := c.GetWebhooks(cmd.Context(),
+				api.GetWebhooksParam{Workspace: w})
+			if err != nil {
+				return err
+			}
+
+			if report != nil {
+				return report(cmd.OutOrStdout(), &of, ws)
+			}
+
+			return util.Report(ws, cmd.OutOrStdout(), of)
+		},
+	}
+
+	util.AddReportFlags(cmd, &of)
+
+	return cmd
+}
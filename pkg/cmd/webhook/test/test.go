@@ -0,0 +1,98 @@
+package test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdTest represents the test command
+func NewCmdTest(f cmdutil.Factory, hc *http.Client) *cobra.Command {
+	if hc == nil {
+		hc = http.DefaultClient
+	}
+
+	cmd := &cobra.Command{
+		Use:   "test <webhook>",
+		Args:  cmdutil.RequiredNamedArgs("webhook"),
+		Short: "Sends a sample event to the url of a webhook",
+		Long: heredoc.Doc(`
+			Sends a sample event to the url of a webhook
+
+			The request is sent by the CLI (not by Clockify) with the same
+			headers Clockify uses, so the receiver can be checked without
+			changing the workspace.
+		`),
+		Example: heredoc.Docf(`
+			$ %[1]s 64b7d5a3c2c3a5112e1b3a8c
+			200 OK
+		`, "clockify-cli webhook test"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			h, err := c.GetWebhook(ctx, api.GetWebhookParam{
+				Workspace: w,
+				WebhookID: strings.TrimSpace(args[0]),
+			})
+			if err != nil {
+				return err
+			}
+
+			b, err := json.Marshal(map[string]string{
+				"id":          "000000000000000000000000",
+				"workspaceId": w,
+				"description": "clockify-cli webhook test",
+			})
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			req, err := http.NewRequestWithContext(
+				ctx, "POST", h.URL, bytes.NewReader(b))
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Clockify-Signature", h.AuthToken)
+			req.Header.Set("Clockify-Webhook-Event-Type",
+				string(h.WebhookEvent))
+
+			res, err := hc.Do(req)
+			if err != nil {
+				return errors.Wrap(err, "test webhook")
+			}
+			defer res.Body.Close()
+			_, _ = io.Copy(io.Discard, res.Body)
+
+			if res.StatusCode < 200 || res.StatusCode > 299 {
+				return errors.Errorf(
+					"test webhook: %s answered %s", h.URL, res.Status)
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), res.Status)
+			return err
+		},
+	}
+
+	return cmd
+}
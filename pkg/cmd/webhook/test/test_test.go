@@ -0,0 +1,80 @@
+package test_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/webhook/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCmdTest(t *testing.T) {
+	tts := []struct {
+		name   string
+		status int
+		out    string
+		err    string
+	}{
+		{name: "accepted", status: http.StatusOK, out: "200 OK\n"},
+		{
+			name:   "rejected",
+			status: http.StatusUnauthorized,
+			err:    "answered 401 Unauthorized",
+		},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.name, func(t *testing.T) {
+			s := httptest.NewServer(http.HandlerFunc(
+				func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, "token",
+						r.Header.Get("Clockify-Signature"))
+					assert.Equal(t, "NEW_TAG",
+						r.Header.Get("Clockify-Webhook-Event-Type"))
+
+					b, _ := io.ReadAll(r.Body)
+					assert.Contains(t, string(b), `"workspaceId":"w"`)
+
+					w.WriteHeader(tt.status)
+				}))
+			defer s.Close()
+
+			f := mocks.NewMockFactory(t)
+			c := mocks.NewMockClient(t)
+			f.On("GetWorkspaceID").Return("w", nil)
+			f.On("Client").Return(c, nil)
+			c.On("GetWebhook", mock.Anything, api.GetWebhookParam{
+				Workspace: "w",
+				WebhookID: "h1",
+			}).Return(dto.Webhook{
+				ID:           "h1",
+				URL:          s.URL,
+				AuthToken:    "token",
+				WebhookEvent: dto.WebhookEventNewTag,
+			}, nil)
+
+			out := &bytes.Buffer{}
+			cmd := test.NewCmdTest(f, s.Client())
+			cmd.SilenceUsage = true
+			cmd.SetOut(out)
+			cmd.SetArgs([]string{"h1"})
+
+			_, err := cmd.ExecuteC()
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.Regexp(t, tt.err, err.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.out, out.String())
+		})
+	}
+}
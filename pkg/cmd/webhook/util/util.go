@@ -0,0 +1,62 @@
+package util
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	output "github.com/lucassabreu/clockify-cli/pkg/output/webhook"
+	"github.com/spf13/cobra"
+)
+
+// OutputFlags sets how to print out a list of webhooks
+type OutputFlags struct {
+	Format string
+	JSON   bool
+	YAML   bool
+	Quiet  bool
+}
+
+func (of OutputFlags) Check() error {
+	return cmdutil.XorFlag(map[string]bool{
+		"format": of.Format != "",
+		"json":   of.JSON,
+		"yaml":   of.YAML,
+		"quiet":  of.Quiet,
+	})
+}
+
+// AddReportFlags adds the default output flags for webhooks
+func AddReportFlags(cmd *cobra.Command, of *OutputFlags) {
+	cmd.Flags().StringVarP(&of.Format, "format", "f", "",
+		"golang text/template format to be applied on each Webhook")
+	cmdutil.AddTemplateSourceFlags(cmd.Flags())
+	cmd.Flags().BoolVarP(&of.JSON, "json", "j", false, "print as JSON")
+	cmd.Flags().BoolVar(&of.YAML, "yaml", false, "print as YAML")
+	cmd.Flags().BoolVarP(&of.Quiet, "quiet", "q", false, "only display ids")
+}
+
+// Report prints out the webhooks
+func Report(ws []dto.Webhook, out io.Writer, of OutputFlags) error {
+	switch {
+	case of.JSON:
+		return output.WebhooksJSONPrint(ws, out)
+	case of.YAML:
+		return output.WebhooksYAMLPrint(ws, out)
+	case of.Format != "":
+		return output.WebhookPrintWithTemplate(of.Format)(ws, out)
+	case of.Quiet:
+		return output.WebhookPrintQuietly(ws, out)
+	default:
+		return output.WebhookPrint(ws, out)
+	}
+}
+
+// ReportOne prints out a single webhook
+func ReportOne(w dto.Webhook, out io.Writer, of OutputFlags) error {
+	if of.JSON {
+		return output.WebhookJSONPrint(w, out)
+	}
+
+	return Report([]dto.Webhook{w}, out, of)
+}
@@ -0,0 +1,26 @@
+package webhook
+
+import (
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/webhook/add"
+	del "github.com/lucassabreu/clockify-cli/pkg/cmd/webhook/delete"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/webhook/list"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/webhook/test"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdWebhook represents the webhook command
+func NewCmdWebhook(f cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "webhook",
+		Aliases: []string{"webhooks"},
+		Short:   "Work with Clockify webhooks",
+	}
+
+	cmd.AddCommand(list.NewCmdList(f, nil))
+	cmd.AddCommand(add.NewCmdAdd(f, nil))
+	cmd.AddCommand(del.NewCmdDelete(f, nil))
+	cmd.AddCommand(test.NewCmdTest(f, nil))
+
+	return cmd
+}
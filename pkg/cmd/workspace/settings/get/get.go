@@ -0,0 +1,47 @@
+package get
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	cutil "github.com/lucassabreu/clockify-cli/pkg/cmd/config/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/workspace/settings/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdGet represents the workspace settings get command
+func NewCmdGet(f cmdutil.Factory) *cobra.Command {
+	var format string
+	cmd := &cobra.Command{
+		Use:   "get <setting>",
+		Short: "Retrieves one setting of the workspace",
+		Example: heredoc.Docf(`
+			$ %[1]s forceDescription
+			true
+
+			$ %[1]s round --format json
+			{"minutes":"15","round":"Round to nearest"}
+		`, "clockify-cli workspace settings get"),
+		Args: cobra.MatchAll(
+			cmdutil.RequiredNamedArgs("setting"),
+			cobra.ExactArgs(1),
+		),
+		ValidArgs: util.Keys(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			s, err := util.GetSettings(cmd.Context(), f)
+			if err != nil {
+				return err
+			}
+
+			v, err := util.Get(s, args[0])
+			if err != nil {
+				return err
+			}
+
+			return cutil.Report(cmd.OutOrStdout(), format, v)
+		},
+	}
+
+	_ = cutil.AddReportFlags(cmd, &format)
+
+	return cmd
+}
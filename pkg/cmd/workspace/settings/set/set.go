@@ -0,0 +1,77 @@
+package set
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/api"
+	cutil "github.com/lucassabreu/clockify-cli/pkg/cmd/config/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/workspace/settings/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdSet represents the workspace settings set command
+func NewCmdSet(f cmdutil.Factory) *cobra.Command {
+	var format string
+	cmd := &cobra.Command{
+		Use:   "set <setting> <value>",
+		Short: "Changes one setting of the workspace",
+		Long: heredoc.Doc(`
+			Changes one setting of the workspace, the value is converted to
+			the type of the setting, lists must be separated by commas.
+
+			Nested settings are set using a "." between the names.
+		`),
+		Example: heredoc.Docf(`
+			$ %[1]s forceDescription true
+			$ %[1]s round.minutes 15
+			$ %[1]s automaticLock.type WEEKLY
+		`, "clockify-cli workspace settings set"),
+		Args: cobra.MatchAll(
+			cmdutil.RequiredNamedArgs("setting", "value"),
+			cobra.ExactArgs(2),
+		),
+		ValidArgs: util.Keys(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			w, err := f.GetWorkspaceID()
+			if err != nil {
+				return err
+			}
+
+			s, err := util.GetSettings(ctx, f)
+			if err != nil {
+				return err
+			}
+
+			if s, err = util.Set(s, args[0], args[1]); err != nil {
+				return err
+			}
+
+			c, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			if s, err = c.UpdateWorkspaceSettings(
+				ctx,
+				api.UpdateWorkspaceSettingsParam{
+					Workspace: w,
+					Settings:  s,
+				}); err != nil {
+				return err
+			}
+
+			v, err := util.Get(s, args[0])
+			if err != nil {
+				return err
+			}
+
+			return cutil.Report(cmd.OutOrStdout(), format, v)
+		},
+	}
+
+	_ = cutil.AddReportFlags(cmd, &format)
+
+	return cmd
+}
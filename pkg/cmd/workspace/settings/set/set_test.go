@@ -0,0 +1,114 @@
+package set_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/workspace/settings/set"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCmdSet(t *testing.T) {
+	tts := []struct {
+		name     string
+		args     []string
+		expected func(*dto.WorkspaceSettings)
+		out      string
+		err      string
+	}{
+		{
+			name: "bool",
+			args: []string{"forceDescription", "true"},
+			expected: func(s *dto.WorkspaceSettings) {
+				s.ForceDescription = true
+			},
+			out: "true",
+		},
+		{
+			name: "nested",
+			args: []string{"round.minutes", "15"},
+			expected: func(s *dto.WorkspaceSettings) {
+				s.Round.Minutes = "15"
+			},
+			out: `"15"`,
+		},
+		{
+			name: "number",
+			args: []string{"automaticLock.dayOfMonth", "5"},
+			expected: func(s *dto.WorkspaceSettings) {
+				s.AutomaticLock.DayOfMonth = 5
+			},
+			out: "5",
+		},
+		{
+			name: "list",
+			args: []string{"adminOnlyPages", "PROJECT, TEAM"},
+			expected: func(s *dto.WorkspaceSettings) {
+				s.AdminOnlyPages = []string{"PROJECT", "TEAM"}
+			},
+			out: `["PROJECT","TEAM"]`,
+		},
+		{
+			name: "unknown",
+			args: []string{"round.hours", "1"},
+			err:  "unknown workspace setting: round.hours",
+		},
+		{
+			name: "invalid bool",
+			args: []string{"forceTags", "maybe"},
+			err:  "forceTags must be true or false",
+		},
+		{
+			name: "invalid number",
+			args: []string{"automaticLock.dayOfMonth", "first"},
+			err:  "automaticLock.dayOfMonth must be a number",
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			f := mocks.NewMockFactory(t)
+			c := mocks.NewMockClient(t)
+			f.On("GetWorkspaceID").Return("w", nil)
+			f.On("Client").Return(c, nil)
+
+			current := dto.WorkspaceSettings{
+				ForceProjects: true,
+				Round:         dto.Round{Round: "Round to nearest", Minutes: "0"},
+			}
+			c.On("GetWorkspace", mock.Anything, api.GetWorkspace{ID: "w"}).
+				Return(dto.Workspace{ID: "w", Settings: current}, nil)
+
+			if tt.expected != nil {
+				s := current
+				tt.expected(&s)
+				c.On("UpdateWorkspaceSettings", mock.Anything,
+					api.UpdateWorkspaceSettingsParam{
+						Workspace: "w",
+						Settings:  s,
+					}).Return(s, nil)
+			}
+
+			cmd := set.NewCmdSet(f)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+			out := bytes.NewBufferString("")
+			cmd.SetOut(out)
+			cmd.SetArgs(append(tt.args, "--format", "json"))
+
+			_, err := cmd.ExecuteC()
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.out, out.String())
+		})
+	}
+}
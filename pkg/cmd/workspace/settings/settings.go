@@ -0,0 +1,53 @@
+package settings
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	cutil "github.com/lucassabreu/clockify-cli/pkg/cmd/config/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/workspace/settings/get"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/workspace/settings/set"
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/workspace/settings/util"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdSettings represents the workspace settings command
+func NewCmdSettings(f cmdutil.Factory) *cobra.Command {
+	var format string
+	cmd := &cobra.Command{
+		Use:   "settings",
+		Short: "Shows or changes the settings of the workspace",
+		Long: heredoc.Doc(`
+			Shows or changes the settings of the workspace, like rounding,
+			locking of time entries, required fields and who can see rates.
+
+			Only admins of the workspace can change its settings.
+		`),
+		Example: heredoc.Docf(`
+			$ %[1]s
+			$ %[1]s --format json
+			$ %[1]s get forceDescription
+			$ %[1]s set round.minutes 15
+		`, "clockify-cli workspace settings"),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			s, err := util.GetSettings(cmd.Context(), f)
+			if err != nil {
+				return err
+			}
+
+			m, err := util.ToMap(s)
+			if err != nil {
+				return err
+			}
+
+			return cutil.Report(cmd.OutOrStdout(), format, m)
+		},
+	}
+
+	_ = cutil.AddReportFlags(cmd, &format)
+
+	cmd.AddCommand(get.NewCmdGet(f))
+	cmd.AddCommand(set.NewCmdSet(f))
+
+	return cmd
+}
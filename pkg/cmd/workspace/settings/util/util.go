@@ -0,0 +1,155 @@
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+)
+
+// ErrUnknownSetting is returned when the key does not exist on the
+// workspace settings
+var ErrUnknownSetting = errors.New("unknown workspace setting")
+
+// GetSettings loads the settings of the current workspace
+func GetSettings(
+	ctx context.Context, f cmdutil.Factory) (dto.WorkspaceSettings, error) {
+	w, err := f.GetWorkspaceID()
+	if err != nil {
+		return dto.WorkspaceSettings{}, err
+	}
+
+	c, err := f.Client()
+	if err != nil {
+		return dto.WorkspaceSettings{}, err
+	}
+
+	ws, err := c.GetWorkspace(ctx, api.GetWorkspace{ID: w})
+	return ws.Settings, err
+}
+
+// ToMap converts the settings into a map using the same keys as the API,
+// nested settings (like round) are also maps
+func ToMap(s dto.WorkspaceSettings) (map[string]interface{}, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+
+	m := map[string]interface{}{}
+	return m, json.Unmarshal(b, &m)
+}
+
+func lookup(m map[string]interface{}, key string) (
+	map[string]interface{}, string, error) {
+	path := strings.Split(key, ".")
+	for _, p := range path[:len(path)-1] {
+		n, ok := m[p].(map[string]interface{})
+		if !ok {
+			return nil, "", fmt.Errorf("%w: %s", ErrUnknownSetting, key)
+		}
+		m = n
+	}
+
+	last := path[len(path)-1]
+	if _, ok := m[last]; !ok {
+		return nil, "", fmt.Errorf("%w: %s", ErrUnknownSetting, key)
+	}
+
+	return m, last, nil
+}
+
+// Get returns the value of the setting, nested settings can be read using
+// "." (like: round.minutes)
+func Get(s dto.WorkspaceSettings, key string) (interface{}, error) {
+	m, err := ToMap(s)
+	if err != nil {
+		return nil, err
+	}
+
+	p, k, err := lookup(m, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return p[k], nil
+}
+
+// Set changes the setting to the value, which is converted to the same type
+// the setting has (lists are separated by commas)
+func Set(s dto.WorkspaceSettings, key, value string) (
+	dto.WorkspaceSettings, error) {
+	m, err := ToMap(s)
+	if err != nil {
+		return s, err
+	}
+
+	p, k, err := lookup(m, key)
+	if err != nil {
+		return s, err
+	}
+
+	switch p[k].(type) {
+	case bool:
+		if p[k], err = strconv.ParseBool(value); err != nil {
+			return s, fmt.Errorf("%s must be true or false", key)
+		}
+	case float64:
+		if p[k], err = strconv.ParseFloat(value, 64); err != nil {
+			return s, fmt.Errorf("%s must be a number", key)
+		}
+	case map[string]interface{}:
+		return s, fmt.Errorf(
+			"%s has nested settings, set them using %s.<name>", key, key)
+	case []interface{}, nil:
+		l := []interface{}{}
+		for _, v := range strings.Split(value, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				l = append(l, v)
+			}
+		}
+		p[k] = l
+	default:
+		p[k] = value
+	}
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		return s, err
+	}
+
+	n := dto.WorkspaceSettings{}
+	if err := json.Unmarshal(b, &n); err != nil {
+		return s, fmt.Errorf("invalid value for %s: %w", key, err)
+	}
+
+	return n, nil
+}
+
+// Keys lists the settings that can be read or changed
+func Keys() cmdcompl.ValidArgsSlide {
+	m, _ := ToMap(dto.WorkspaceSettings{})
+	return keys("", m)
+}
+
+func keys(prefix string, m map[string]interface{}) []string {
+	ks := make([]string, 0, len(m))
+	for k, v := range m {
+		if n, ok := v.(map[string]interface{}); ok {
+			ks = append(ks, keys(prefix+k+".", n)...)
+			continue
+		}
+		ks = append(ks, prefix+k)
+	}
+
+	sort.Strings(ks)
+	return ks
+}
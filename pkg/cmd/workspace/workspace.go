@@ -1,6 +1,7 @@
 package workspace
 
 import (
+	"github.com/lucassabreu/clockify-cli/pkg/cmd/workspace/settings"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 	output "github.com/lucassabreu/clockify-cli/pkg/output/workspace"
 
@@ -32,7 +33,7 @@ func NewCmdWorkspace(f cmdutil.Factory) *cobra.Command {
 				return err
 			}
 
-			list, err := c.GetWorkspaces(api.GetWorkspaces{
+			list, err := c.GetWorkspaces(cmd.Context(), api.GetWorkspaces{
 				Name: fl.name,
 			})
 			if err != nil {
@@ -57,7 +58,10 @@ func NewCmdWorkspace(f cmdutil.Factory) *cobra.Command {
 		"will be used to filter the workspaces by name")
 	cmd.Flags().StringVarP(&fl.format, "format", "f", "",
 		"golang text/template format to be applied on each workspace")
+	cmdutil.AddTemplateSourceFlags(cmd.Flags())
 	cmd.Flags().BoolVarP(&fl.quiet, "quiet", "q", false, "only display ids")
 
+	cmd.AddCommand(settings.NewCmdSettings(f))
+
 	return cmd
 }
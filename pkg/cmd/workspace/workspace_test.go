@@ -12,6 +12,7 @@ import (
 	"github.com/lucassabreu/clockify-cli/pkg/cmd/workspace"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestCmdWorkspaces(t *testing.T) {
@@ -48,7 +49,8 @@ func TestCmdWorkspaces(t *testing.T) {
 				c := mocks.NewMockClient(t)
 				f.On("Client").Return(c, nil)
 
-				c.On("GetWorkspaces", api.GetWorkspaces{}).Return(
+				c.On("GetWorkspaces", mock.Anything,
+					api.GetWorkspaces{}).Return(
 					[]dto.Workspace{
 						{ID: "w1"},
 						{ID: "w2"},
@@ -70,7 +72,7 @@ func TestCmdWorkspaces(t *testing.T) {
 				c := mocks.NewMockClient(t)
 				f.On("Client").Return(c, nil)
 
-				c.On("GetWorkspaces", api.GetWorkspaces{}).
+				c.On("GetWorkspaces", mock.Anything, api.GetWorkspaces{}).
 					Return(nil, errors.New("failed querying"))
 
 				return f
@@ -85,7 +87,8 @@ func TestCmdWorkspaces(t *testing.T) {
 				c := mocks.NewMockClient(t)
 				f.On("Client").Return(c, nil)
 
-				c.On("GetWorkspaces", api.GetWorkspaces{}).Return(
+				c.On("GetWorkspaces", mock.Anything,
+					api.GetWorkspaces{}).Return(
 					[]dto.Workspace{
 						{ID: "w1", Name: "first"},
 						{ID: "w2", Name: "last"},
@@ -108,7 +111,7 @@ func TestCmdWorkspaces(t *testing.T) {
 				c := mocks.NewMockClient(t)
 				f.On("Client").Return(c, nil)
 
-				c.On("GetWorkspaces", api.GetWorkspaces{
+				c.On("GetWorkspaces", mock.Anything, api.GetWorkspaces{
 					Name: "second",
 				}).Return(
 					[]dto.Workspace{
@@ -0,0 +1,49 @@
+package cmdcomplutil
+
+import (
+	"strings"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/spf13/cobra"
+)
+
+// NewExpenseCategoryAutoComplete will provide auto-completion to flags or
+// args
+func NewExpenseCategoryAutoComplete(f factory) cmdcompl.SuggestFn {
+	return func(
+		cmd *cobra.Command, args []string, toComplete string,
+	) (cmdcompl.ValidArgs, error) {
+		w, err := f.GetWorkspaceID()
+		if err != nil {
+			return cmdcompl.EmptyValidArgs(), err
+		}
+
+		c, err := f.Client()
+		if err != nil {
+			return cmdcompl.EmptyValidArgs(), err
+		}
+
+		b := false
+		cs, err := c.GetExpenseCategories(cmd.Context(),
+			api.GetExpenseCategoriesParam{
+				Workspace:       w,
+				Archived:        &b,
+				PaginationParam: api.AllPages(),
+			})
+		if err != nil {
+			return cmdcompl.EmptyValidArgs(), err
+		}
+
+		va := make(cmdcompl.ValidArgsMap)
+		toComplete = strings.ToLower(toComplete)
+		for i := range cs {
+			if toComplete != "" && !strings.Contains(cs[i].ID, toComplete) {
+				continue
+			}
+			va.Set(cs[i].ID, cs[i].Name)
+		}
+
+		return va, nil
+	}
+}
@@ -24,7 +24,7 @@ func NewClientAutoComplete(f factory) cmdcompl.SuggestFn {
 		}
 
 		b := false
-		clients, err := c.GetClients(api.GetClientsParam{
+		clients, err := c.GetClients(cmd.Context(), api.GetClientsParam{
 			Workspace:       w,
 			Archived:        &b,
 			PaginationParam: api.AllPages(),
@@ -0,0 +1,46 @@
+package cmdcomplutil
+
+import (
+	"strings"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdcompl"
+	"github.com/spf13/cobra"
+)
+
+// NewUserGroupAutoComplete will provide auto-completion to flags or
+// args
+func NewUserGroupAutoComplete(f factory) cmdcompl.SuggestFn {
+	return func(
+		cmd *cobra.Command, args []string, toComplete string,
+	) (cmdcompl.ValidArgs, error) {
+		w, err := f.GetWorkspaceID()
+		if err != nil {
+			return cmdcompl.EmptyValidArgs(), err
+		}
+
+		c, err := f.Client()
+		if err != nil {
+			return cmdcompl.EmptyValidArgs(), err
+		}
+
+		gs, err := c.GetUserGroups(cmd.Context(), api.GetUserGroupsParam{
+			Workspace:       w,
+			PaginationParam: api.AllPages(),
+		})
+		if err != nil {
+			return cmdcompl.EmptyValidArgs(), err
+		}
+
+		va := make(cmdcompl.ValidArgsMap)
+		toComplete = strings.ToLower(toComplete)
+		for i := range gs {
+			if toComplete != "" && !strings.Contains(gs[i].ID, toComplete) {
+				continue
+			}
+			va.Set(gs[i].ID, gs[i].Name)
+		}
+
+		return va, nil
+	}
+}
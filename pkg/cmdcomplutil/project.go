@@ -24,7 +24,7 @@ func NewProjectAutoComplete(f factory) cmdcompl.SuggestFn {
 		}
 
 		b := false
-		ps, err := c.GetProjects(api.GetProjectsParam{
+		ps, err := c.GetProjects(cmd.Context(), api.GetProjectsParam{
 			Workspace:       w,
 			Archived:        &b,
 			PaginationParam: api.AllPages(),
@@ -24,7 +24,7 @@ func NewTagAutoComplete(f factory) cmdcompl.SuggestFn {
 		}
 
 		b := false
-		projects, err := c.GetTags(api.GetTagsParam{
+		projects, err := c.GetTags(cmd.Context(), api.GetTagsParam{
 			Workspace:       w,
 			Archived:        &b,
 			PaginationParam: api.AllPages(),
@@ -28,7 +28,7 @@ func NewTaskAutoComplete(f factory, onlyActive bool) cmdcompl.SuggestFn {
 			return cmdcompl.EmptyValidArgs(), err
 		}
 
-		ts, err := c.GetTasks(api.GetTasksParam{
+		ts, err := c.GetTasks(cmd.Context(), api.GetTasksParam{
 			Workspace: w,
 			ProjectID: project,
 			Active:    onlyActive,
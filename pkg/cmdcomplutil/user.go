@@ -24,7 +24,7 @@ func NewUserAutoComplete(f factory) cmdcompl.SuggestFn {
 			return cmdcompl.EmptyValidArgs(), err
 		}
 
-		us, err := c.WorkspaceUsers(api.WorkspaceUsersParam{
+		us, err := c.WorkspaceUsers(cmd.Context(), api.WorkspaceUsersParam{
 			Workspace:       w,
 			PaginationParam: api.AllPages(),
 		})
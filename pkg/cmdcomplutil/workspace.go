@@ -18,7 +18,7 @@ func NewWorspaceAutoComplete(f factory) cmdcompl.SuggestFn {
 			return cmdcompl.EmptyValidArgs(), err
 		}
 
-		ws, err := c.GetWorkspaces(api.GetWorkspaces{})
+		ws, err := c.GetWorkspaces(cmd.Context(), api.GetWorkspaces{})
 
 		if err != nil {
 			return cmdcompl.EmptyValidArgs(), err
@@ -1,13 +1,17 @@
 package cmdutil
 
 import (
+	"os"
 	"path"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/lucassabreu/clockify-cli/strhlp"
 	"github.com/mitchellh/go-homedir"
+	"github.com/pkg/errors"
 	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
 )
 
 const (
@@ -25,6 +29,44 @@ const (
 	CONF_LOG_LEVEL             = "log-level"
 	CONF_ALLOW_ARCHIVED_TAGS   = "allow-archived-tags"
 	CONF_INTERACTIVE_PAGE_SIZE = "interactive-page-size"
+	CONF_DURATION_FORMAT       = "duration-format"
+	CONF_TIME_ZONE             = "time-zone"
+	CONF_LOCALE                = "locale"
+	CONF_TEMPLATES             = "templates"
+	CONF_NO_COLOR              = "no-color"
+	CONF_COLOR_HEADER          = "color.header"
+	CONF_COLOR_TOTAL           = "color.total"
+	CONF_COLOR_PROJECT         = "color.project"
+	CONF_MAX_RETRIES           = "max-retries"
+	CONF_HTTP_CACHE            = "http-cache"
+	CONF_CACHE_TTL             = "cache-ttl"
+	CONF_API_URL               = "api-url"
+	CONF_REPORTS_API_URL       = "reports-api-url"
+	CONF_DEBUG_HTTP            = "debug-http"
+	CONF_HTTP_TIMEOUT          = "http-timeout"
+	CONF_RETRY_COUNT           = "retry-count"
+	CONF_RETRY_WAIT            = "retry-wait"
+	CONF_PROXY                 = "proxy"
+	CONF_CA_BUNDLE             = "ca-bundle"
+	CONF_INSECURE_SKIP_VERIFY  = "insecure-skip-verify"
+	CONF_RECORD                = "record"
+	CONF_REPLAY                = "replay"
+	CONF_PROFILE               = "profile"
+	CONF_PROFILES              = "profiles"
+	CONF_JSON_ERRORS           = "json-errors"
+	CONF_SPLIT_MIDNIGHT        = "split-midnight"
+	CONF_RECURRING             = "recurring"
+	CONF_TIME_ENTRY_TEMPLATES  = "time-entry-templates"
+	CONF_JOURNAL_SIZE          = "journal-size"
+	CONF_GIT_TICKET_PATTERN    = "git-ticket-pattern"
+	CONF_JIRA_URL              = "jira.url"
+	CONF_JIRA_USER             = "jira.user"
+	CONF_JIRA_TOKEN            = "jira.token"
+	CONF_GITHUB_TOKEN          = "github.token"
+	CONF_GITHUB_API_URL        = "github.api-url"
+	CONF_GITLAB_URL            = "gitlab.url"
+	CONF_GITLAB_TOKEN          = "gitlab.token"
+	CONF_BREAK_PROJECT         = "break.project"
 )
 
 const (
@@ -69,6 +111,8 @@ type Config interface {
 
 	// Get retrieves a config by its name
 	Get(string) interface{}
+	// Set changes a config by its name
+	Set(string, interface{})
 	// All retrieves all the configurations of the CLI as a map
 	All() map[string]interface{}
 
@@ -104,6 +148,7 @@ func (*config) GetBool(param string) bool {
 }
 
 func (*config) SetBool(p string, b bool) {
+	changed[p] = true
 	viper.Set(p, b)
 }
 
@@ -112,6 +157,7 @@ func (*config) GetString(param string) string {
 }
 
 func (*config) SetString(p, s string) {
+	changed[p] = true
 	viper.Set(p, s)
 }
 
@@ -120,6 +166,7 @@ func (*config) GetInt(param string) int {
 }
 
 func (*config) SetInt(p string, i int) {
+	changed[p] = true
 	viper.Set(p, i)
 }
 
@@ -128,6 +175,7 @@ func (*config) GetStringSlice(param string) []string {
 }
 
 func (*config) SetStringSlice(p string, ss []string) {
+	changed[p] = true
 	viper.Set(p, ss)
 }
 
@@ -151,6 +199,11 @@ func (*config) Get(p string) interface{} {
 	return viper.Get(p)
 }
 
+func (*config) Set(p string, v interface{}) {
+	changed[p] = true
+	viper.Set(p, v)
+}
+
 func (*config) All() map[string]interface{} {
 	return viper.AllSettings()
 }
@@ -166,7 +219,117 @@ func (*config) Save() error {
 		filename = path.Join(home, ".clockify-cli.yaml")
 	}
 
-	return viper.WriteConfigAs(filename)
+	if activeProfile == "" {
+		return writeConfig(viper.GetViper(), filename)
+	}
+
+	// the configs of the profile were merged into the root ones when it was
+	// loaded, so only the ones changed are written, into the profile
+	v := viper.New()
+	v.SetConfigFile(filename)
+	if err := v.ReadInConfig(); err != nil &&
+		!errors.As(err, &viper.ConfigFileNotFoundError{}) {
+		return err
+	}
+
+	for k := range changed {
+		name := k
+		if k != CONF_PROFILE && !strings.HasPrefix(k, CONF_PROFILES+".") {
+			name = ProfileKey(activeProfile, k)
+		}
+		v.Set(name, viper.Get(k))
+	}
+
+	return writeConfig(v, filename)
+}
+
+// writeConfig saves the configs readable only by the user, as it has the
+// tokens of the user
+func writeConfig(v *viper.Viper, filename string) error {
+	if err := v.WriteConfigAs(filename); err != nil {
+		return err
+	}
+
+	return errors.WithStack(os.Chmod(filename, 0600))
+}
+
+// changed keeps the configs set during the execution, to be written into
+// the current profile
+var changed = map[string]bool{}
+
+// activeProfile is the name of the profile loaded by UseProfile
+var activeProfile string
+
+// ProfileKey returns the name of a config inside a profile
+func ProfileKey(profile, name string) string {
+	return CONF_PROFILES + "." + profile + "." + name
+}
+
+// ProfileNames returns the names of the profiles on the config, sorted
+func ProfileNames(c Config) []string {
+	ps, _ := c.Get(CONF_PROFILES).(map[string]interface{})
+	names := make([]string, 0, len(ps))
+	for n := range ps {
+		names = append(names, n)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// UnmarshalConfig reads a config with a structured value (like a list of
+// maps) into v, using its yaml tags
+func UnmarshalConfig(c Config, name string, v interface{}) error {
+	cv := c.Get(name)
+	if cv == nil {
+		return nil
+	}
+
+	b, err := yaml.Marshal(cv)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return errors.Wrapf(yaml.Unmarshal(b, v), "invalid config %s", name)
+}
+
+// MarshalConfig changes a config to the value of v, as it would be read
+// from the config file
+func MarshalConfig(c Config, name string, v interface{}) error {
+	b, err := yaml.Marshal(v)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	var cv interface{}
+	if err := yaml.Unmarshal(b, &cv); err != nil {
+		return errors.WithStack(err)
+	}
+
+	c.Set(name, cv)
+	return nil
+}
+
+// UseProfile merges the configs of the profile set on CONF_PROFILE over the
+// ones on the root of the config file, flags and environment variables
+// still have precedence over them
+func UseProfile() error {
+	p := viper.GetString(CONF_PROFILE)
+	if p == "" {
+		return nil
+	}
+
+	key := CONF_PROFILES + "." + p
+	if !viper.IsSet(key) {
+		return errors.Errorf("profile %s does not exist", p)
+	}
+
+	if err := viper.MergeConfigMap(viper.GetStringMap(key)); err != nil {
+		return err
+	}
+
+	activeProfile = p
+	return nil
 }
 
 func configFunc() func() (c Config) {
@@ -1,5 +1,14 @@
 package cmdutil
 
+import (
+	"context"
+	"errors"
+
+	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
 // FlagError happens when a non-cobra validation fails
 type FlagError struct {
 	err error
@@ -16,3 +25,75 @@ func (fe *FlagError) Unwrap() error {
 func FlagErrorWrap(err error) *FlagError {
 	return &FlagError{err: err}
 }
+
+// Exit codes of the CLI, the ones for API errors are only used with
+// --json-errors, and will not change, so wrappers can rely on them
+const (
+	ExitOK           = 0
+	ExitError        = 1
+	ExitCancel       = 2
+	ExitUsage        = 3
+	ExitUnauthorized = 4
+	ExitForbidden    = 5
+	ExitNotFound     = 6
+	ExitInvalid      = 7
+	ExitArchived     = 8
+	ExitRateLimited  = 9
+	ExitServer       = 10
+)
+
+var exitCodes = map[api.ErrorKind]int{
+	api.ErrorKindUnauthorized: ExitUnauthorized,
+	api.ErrorKindForbidden:    ExitForbidden,
+	api.ErrorKindNotFound:     ExitNotFound,
+	api.ErrorKindInvalid:      ExitInvalid,
+	api.ErrorKindArchived:     ExitArchived,
+	api.ErrorKindRateLimited:  ExitRateLimited,
+	api.ErrorKindServer:       ExitServer,
+}
+
+// JSONError is how errors are printed on stderr with --json-errors
+type JSONError struct {
+	// Kind is one of the api.ErrorKind, or "usage", "canceled" and "error"
+	// for errors not returned by the API
+	Kind     string `json:"kind"`
+	Message  string `json:"message"`
+	Code     int    `json:"code,omitempty"`
+	Field    string `json:"field,omitempty"`
+	Status   int    `json:"status,omitempty"`
+	ExitCode int    `json:"exitCode"`
+}
+
+// NewJSONError describes the error and the exit code it should cause
+func NewJSONError(err error) JSONError {
+	je := JSONError{Kind: "error", Message: err.Error(), ExitCode: ExitError}
+
+	var flagError *FlagError
+	if errors.As(err, &flagError) {
+		je.Kind = "usage"
+		je.ExitCode = ExitUsage
+		return je
+	}
+
+	if errors.Is(err, terminal.InterruptErr) ||
+		errors.Is(err, context.Canceled) {
+		je.Kind = "canceled"
+		je.ExitCode = ExitCancel
+		return je
+	}
+
+	kind := api.ErrorKindOf(err)
+	if kind == api.ErrorKindUnknown {
+		return je
+	}
+
+	var e dto.Error
+	errors.As(err, &e)
+
+	je.Kind = string(kind)
+	je.Code = e.Code
+	je.Field = e.Field
+	je.Status = e.Status
+	je.ExitCode = exitCodes[kind]
+	return je
+}
@@ -0,0 +1,95 @@
+package cmdutil_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewJSONError(t *testing.T) {
+	tts := []struct {
+		name     string
+		err      error
+		expected cmdutil.JSONError
+	}{
+		{
+			name: "generic",
+			err:  errors.New("failed"),
+			expected: cmdutil.JSONError{
+				Kind: "error", Message: "failed", ExitCode: cmdutil.ExitError},
+		},
+		{
+			name: "flag",
+			err:  cmdutil.FlagErrorWrap(errors.New("invalid flag")),
+			expected: cmdutil.JSONError{
+				Kind:     "usage",
+				Message:  "invalid flag",
+				ExitCode: cmdutil.ExitUsage,
+			},
+		},
+		{
+			name: "canceled",
+			err:  pkgerrors.Wrap(context.Canceled, "get me"),
+			expected: cmdutil.JSONError{
+				Kind:     "canceled",
+				Message:  "get me: context canceled",
+				ExitCode: cmdutil.ExitCancel,
+			},
+		},
+		{
+			name: "archived",
+			err: pkgerrors.Wrap(pkgerrors.WithStack(dto.Error{
+				Message: "Project is archived", Code: 501, Status: 400,
+			}), "add time entry"),
+			expected: cmdutil.JSONError{
+				Kind:     "archived",
+				Message:  "add time entry: Project is archived (code: 501)",
+				Code:     501,
+				Status:   400,
+				ExitCode: cmdutil.ExitArchived,
+			},
+		},
+		{
+			name: "unauthorized",
+			err: pkgerrors.WithStack(dto.Error{
+				Message: "Unauthorized", Code: 401, Status: 401,
+			}),
+			expected: cmdutil.JSONError{
+				Kind:     "unauthorized",
+				Message:  "Unauthorized (code: 401)",
+				Code:     401,
+				Status:   401,
+				ExitCode: cmdutil.ExitUnauthorized,
+			},
+		},
+		{
+			name: "invalid field",
+			err: dto.Error{
+				Message: "must not be blank",
+				Code:    501,
+				Field:   "name",
+				Status:  400,
+			},
+			expected: cmdutil.JSONError{
+				Kind:     "invalid",
+				Message:  "must not be blank (code: 501)",
+				Code:     501,
+				Field:    "name",
+				Status:   400,
+				ExitCode: cmdutil.ExitInvalid,
+			},
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, cmdutil.NewJSONError(tt.err))
+		})
+	}
+}
@@ -1,12 +1,26 @@
 package cmdutil
 
 import (
+	"context"
 	"log"
+	"net/http"
 	"os"
+	"path/filepath"
+	"time"
 
 	"github.com/lucassabreu/clockify-cli/api"
 	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/api/reports"
+	"github.com/lucassabreu/clockify-cli/pkg/breaks"
+	"github.com/lucassabreu/clockify-cli/pkg/cache"
+	"github.com/lucassabreu/clockify-cli/pkg/github"
+	"github.com/lucassabreu/clockify-cli/pkg/gitlab"
+	"github.com/lucassabreu/clockify-cli/pkg/idle"
+	"github.com/lucassabreu/clockify-cli/pkg/jira"
+	"github.com/lucassabreu/clockify-cli/pkg/journal"
+	"github.com/lucassabreu/clockify-cli/pkg/offline"
 	"github.com/lucassabreu/clockify-cli/pkg/ui"
+	"github.com/pkg/errors"
 )
 
 // Factory is a container/factory builder for the commands and its helpers
@@ -18,6 +32,8 @@ type Factory interface {
 	Config() Config
 	// Client builds a client for Clockify's API
 	Client() (api.Client, error)
+	// ReportsClient builds a client for Clockify's Reports API
+	ReportsClient() (reports.Client, error)
 	// UI builds a control to prompt information from the user
 	UI() ui.UI
 
@@ -32,9 +48,10 @@ type Factory interface {
 type factory struct {
 	version func() Version
 
-	config func() Config
-	client func() (api.Client, error)
-	ui     func() ui.UI
+	config        func() Config
+	client        func() (api.Client, error)
+	reportsClient func() (reports.Client, error)
+	ui            func() ui.UI
 
 	getUserID      func() (string, error)
 	getWorkspaceID func() (string, error)
@@ -53,6 +70,10 @@ func (f *factory) Client() (api.Client, error) {
 	return f.client()
 }
 
+func (f *factory) ReportsClient() (reports.Client, error) {
+	return f.reportsClient()
+}
+
 func (f *factory) UI() ui.UI {
 	return f.ui()
 }
@@ -69,7 +90,9 @@ func (f *factory) GetWorkspace() (dto.Workspace, error) {
 	return f.getWorkspace()
 }
 
-func NewFactory(v Version) Factory {
+// NewFactory creates a Factory, the user and workspace it looks up are
+// fetched using the context informed
+func NewFactory(ctx context.Context, v Version) Factory {
 	f := &factory{
 		version: func() Version { return v },
 		config:  configFunc(),
@@ -78,16 +101,17 @@ func NewFactory(v Version) Factory {
 	f.ui = getUi(f)
 
 	f.client = clientFunc(f)
+	f.reportsClient = reportsClientFunc(f)
 
-	f.getUserID = getUserIDFunc(f)
+	f.getUserID = getUserIDFunc(ctx, f)
 
-	f.getWorkspace = getWorkspaceFunc(f)
-	f.getWorkspaceID = getWorkspaceIDFunc(f)
+	f.getWorkspace = getWorkspaceFunc(ctx, f)
+	f.getWorkspaceID = getWorkspaceIDFunc(ctx, f)
 
 	return f
 }
 
-func getUserIDFunc(f Factory) func() (string, error) {
+func getUserIDFunc(ctx context.Context, f Factory) func() (string, error) {
 	var userID string
 	var err error
 	return func() (string, error) {
@@ -105,7 +129,7 @@ func getUserIDFunc(f Factory) func() (string, error) {
 			return userID, err
 		}
 
-		u, err := client.GetMe()
+		u, err := client.GetMe(ctx)
 		if err != nil {
 			return userID, err
 		}
@@ -115,7 +139,8 @@ func getUserIDFunc(f Factory) func() (string, error) {
 	}
 }
 
-func getWorkspaceFunc(f Factory) func() (dto.Workspace, error) {
+func getWorkspaceFunc(
+	ctx context.Context, f Factory) func() (dto.Workspace, error) {
 	var w *dto.Workspace
 	var err error
 	return func() (dto.Workspace, error) {
@@ -137,7 +162,7 @@ func getWorkspaceFunc(f Factory) func() (dto.Workspace, error) {
 			return dto.Workspace{}, err
 		}
 
-		oW, err := client.GetWorkspace(api.GetWorkspace{ID: id})
+		oW, err := client.GetWorkspace(ctx, api.GetWorkspace{ID: id})
 		if err != nil {
 			return dto.Workspace{}, err
 		}
@@ -147,7 +172,7 @@ func getWorkspaceFunc(f Factory) func() (dto.Workspace, error) {
 	}
 }
 
-func getWorkspaceIDFunc(f Factory) func() (string, error) {
+func getWorkspaceIDFunc(ctx context.Context, f Factory) func() (string, error) {
 	var w string
 	var err error
 	return func() (string, error) {
@@ -165,7 +190,7 @@ func getWorkspaceIDFunc(f Factory) func() (string, error) {
 			return w, err
 		}
 
-		u, err := client.GetMe()
+		u, err := client.GetMe(ctx)
 		w = u.DefaultWorkspace
 
 		return w, err
@@ -181,11 +206,277 @@ func clientFunc(f Factory) func() (api.Client, error) {
 			return c, err
 		}
 
-		c, err = api.NewClient(f.Config().GetString(CONF_TOKEN))
+		if u := f.Config().GetString(CONF_API_URL); u != "" {
+			c, err = api.NewClientFromUrlAndKey(
+				f.Config().GetString(CONF_TOKEN), u)
+		} else {
+			c, err = api.NewClient(f.Config().GetString(CONF_TOKEN))
+		}
 		if err != nil {
 			return c, err
 		}
 
+		c.SetMaxRetries(f.Config().GetInt(CONF_MAX_RETRIES))
+
+		var t http.RoundTripper
+		if t, err = transport(f); err != nil {
+			return c, err
+		}
+		if t != nil {
+			c.SetTransport(t)
+		}
+
+		var d time.Duration
+		if d, err = configDuration(f, CONF_RETRY_WAIT); err != nil {
+			return c, err
+		}
+		c.SetRetryWait(d)
+
+		if d, err = configDuration(f, CONF_HTTP_TIMEOUT); err != nil {
+			return c, err
+		}
+		c.SetTimeout(d)
+
+		if f.Config().GetBool(CONF_DEBUG_HTTP) {
+			c.SetHTTPTrace(os.Stderr)
+		}
+
+		if f.Config().GetBool(CONF_HTTP_CACHE) {
+			if dir, err := cache.DefaultDir(); err == nil {
+				c.SetCacheDir(filepath.Join(dir, "http"))
+			}
+		}
+
+		var lc *cache.Cache
+		if lc, err = LocalCache(f); err != nil {
+			return c, err
+		}
+
+		if lc.TTL() > 0 {
+			c = cache.NewClient(c, lc)
+		}
+
+		var j *journal.Journal
+		if j, err = Journal(f); err != nil {
+			return c, err
+		}
+
+		if j.Size() > 0 {
+			c = journal.NewClient(c, j)
+		}
+
+		ll := f.Config().LogLevel()
+		if ll == LOG_LEVEL_NONE {
+			return c, err
+		}
+
+		c.SetInfoLogger(
+			log.New(os.Stdout, "INFO  ", log.LstdFlags),
+		)
+
+		if ll == LOG_LEVEL_INFO {
+			return c, err
+		}
+
+		c.SetDebugLogger(
+			log.New(os.Stdout, "DEBUG ", log.LstdFlags),
+		)
+
+		return c, err
+	}
+}
+
+// transport returns the http.RoundTripper to be used by the clients, or nil
+// if the default one can be used
+func transport(f Factory) (http.RoundTripper, error) {
+	record := f.Config().GetString(CONF_RECORD)
+	replay := f.Config().GetString(CONF_REPLAY)
+	if record != "" && replay != "" {
+		return nil, errors.New("record and replay can't be used together")
+	}
+
+	if replay != "" {
+		return api.NewReplayTransport(replay), nil
+	}
+
+	var t http.RoundTripper
+	p := api.TransportParam{
+		Proxy:              f.Config().GetString(CONF_PROXY),
+		CABundle:           f.Config().GetString(CONF_CA_BUNDLE),
+		InsecureSkipVerify: f.Config().GetBool(CONF_INSECURE_SKIP_VERIFY),
+	}
+
+	if p != (api.TransportParam{}) {
+		var err error
+		if t, err = api.NewTransport(p); err != nil {
+			return nil, err
+		}
+	}
+
+	if record != "" {
+		t = api.NewRecordTransport(t, record)
+	}
+
+	return t, nil
+}
+
+// configDuration reads a config as a time.Duration, empty is zero
+func configDuration(f Factory, name string) (time.Duration, error) {
+	v := f.Config().GetString(name)
+	if v == "" {
+		return 0, nil
+	}
+
+	d, err := time.ParseDuration(v)
+	return d, errors.Wrapf(err, "%s is not a valid duration", name)
+}
+
+// LocalCache returns the cache of projects, clients, tags and tasks using the
+// ttl set by the user
+func LocalCache(f Factory) (*cache.Cache, error) {
+	ttl, err := configDuration(f, CONF_CACHE_TTL)
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := cache.DefaultDir()
+	if err != nil {
+		return nil, err
+	}
+
+	return cache.New(dir, ttl), nil
+}
+
+// Journal returns the journal of changes to time entries, keeping as many
+// executions as set by the user
+func Journal(f Factory) (*journal.Journal, error) {
+	dir, err := cache.DefaultDir()
+	if err != nil {
+		return nil, err
+	}
+
+	return journal.New(filepath.Join(dir, "journal.json"),
+		f.Config().GetInt(CONF_JOURNAL_SIZE)), nil
+}
+
+// OfflineQueue returns the queue of time entries started, created and ended
+// while the API was not reachable
+func OfflineQueue() (*offline.Queue, error) {
+	dir, err := cache.DefaultDir()
+	if err != nil {
+		return nil, err
+	}
+
+	return offline.New(filepath.Join(dir, "queue.json")), nil
+}
+
+// BreakStore returns where the break being taken is kept, between "break
+// start" and "break end"
+func BreakStore() (*breaks.Store, error) {
+	dir, err := cache.DefaultDir()
+	if err != nil {
+		return nil, err
+	}
+
+	return breaks.NewStore(filepath.Join(dir, "break.json")), nil
+}
+
+// IdleLog returns the log of the periods the user was away from the
+// computer, kept by "idle daemon"
+func IdleLog() (*idle.Log, error) {
+	dir, err := cache.DefaultDir()
+	if err != nil {
+		return nil, err
+	}
+
+	return idle.NewLog(filepath.Join(dir, "idle.json")), nil
+}
+
+// JiraClient returns a client for the Jira set on the config
+func JiraClient(c Config) (*jira.Client, error) {
+	u := c.GetString(CONF_JIRA_URL)
+	t := c.GetString(CONF_JIRA_TOKEN)
+	if u == "" || t == "" {
+		return nil, errors.Errorf(
+			"jira is not configured, set \"%s\" and \"%s\" (and \"%s\" "+
+				"for Jira Cloud) with \"clockify-cli config set\"",
+			CONF_JIRA_URL, CONF_JIRA_TOKEN, CONF_JIRA_USER)
+	}
+
+	return jira.NewClient(u, c.GetString(CONF_JIRA_USER), t), nil
+}
+
+// GitHubClient returns a client for the API of GitHub, with the token set on
+// the config or on the environment variables used by the gh CLI
+func GitHubClient(c Config) *github.Client {
+	t := c.GetString(CONF_GITHUB_TOKEN)
+	for _, e := range []string{"GH_TOKEN", "GITHUB_TOKEN"} {
+		if t == "" {
+			t = os.Getenv(e)
+		}
+	}
+
+	u := c.GetString(CONF_GITHUB_API_URL)
+	if u == "" {
+		u = github.DefaultURL
+	}
+
+	return github.NewClientFromURL(t, u)
+}
+
+// GitLabClient returns a client for the GitLab set on the config, with the
+// token set on it or on the environment variable GITLAB_TOKEN
+func GitLabClient(c Config) *gitlab.Client {
+	t := c.GetString(CONF_GITLAB_TOKEN)
+	if t == "" {
+		t = os.Getenv("GITLAB_TOKEN")
+	}
+
+	u := c.GetString(CONF_GITLAB_URL)
+	if u == "" {
+		u = gitlab.DefaultURL
+	}
+
+	return gitlab.NewClient(u, t)
+}
+
+func reportsClientFunc(f Factory) func() (reports.Client, error) {
+	var c reports.Client
+	var err error
+
+	return func() (reports.Client, error) {
+		if c != nil || err != nil {
+			return c, err
+		}
+
+		if u := f.Config().GetString(CONF_REPORTS_API_URL); u != "" {
+			c, err = reports.NewClientFromUrlAndKey(
+				f.Config().GetString(CONF_TOKEN), u)
+		} else {
+			c, err = reports.NewClient(f.Config().GetString(CONF_TOKEN))
+		}
+		if err != nil {
+			return c, err
+		}
+
+		if f.Config().GetBool(CONF_DEBUG_HTTP) {
+			c.SetHTTPTrace(os.Stderr)
+		}
+
+		var t http.RoundTripper
+		if t, err = transport(f); err != nil {
+			return c, err
+		}
+		if t != nil {
+			c.SetTransport(t)
+		}
+
+		var d time.Duration
+		if d, err = configDuration(f, CONF_HTTP_TIMEOUT); err != nil {
+			return c, err
+		}
+		c.SetTimeout(d)
+
 		ll := f.Config().LogLevel()
 		if ll == LOG_LEVEL_NONE {
 			return c, err
@@ -1,8 +1,12 @@
 package cmdutil
 
 import (
+	"io"
+	"os"
 	"sort"
+	"strings"
 
+	"github.com/lucassabreu/clockify-cli/api"
 	"github.com/lucassabreu/clockify-cli/strhlp"
 	"github.com/pkg/errors"
 	"github.com/spf13/pflag"
@@ -39,3 +43,116 @@ func XorFlagSet(f *pflag.FlagSet, exclusiveFlags ...string) error {
 
 	return XorFlag(fs)
 }
+
+type stringPointerValue struct {
+	p **string
+}
+
+func (s stringPointerValue) String() string {
+	if *s.p == nil {
+		return ""
+	}
+	return **s.p
+}
+
+func (s stringPointerValue) Set(v string) error {
+	*s.p = &v
+	return nil
+}
+
+func (stringPointerValue) Type() string {
+	return "string"
+}
+
+// StringPointerVar defines a string flag that will only set its pointer if
+// the flag is used, allowing empty strings to be differentiated from unset
+func StringPointerVar(f *pflag.FlagSet, p **string, name, usage string) {
+	f.Var(stringPointerValue{p: p}, name, usage)
+}
+
+// AddTemplateSourceFlags adds the flags `format-name` and `format-file`,
+// which allow the use of a template stored on the config (as
+// templates.<name>) or on a file instead of `format`
+func AddTemplateSourceFlags(f *pflag.FlagSet) {
+	f.String("format-name", "",
+		"name of a template stored on the config (as templates.<name>) "+
+			"to be used as --format")
+	f.String("format-file", "",
+		"path of a file with the template to be used as --format "+
+			"(use - to read from stdin)")
+}
+
+// ApplyTemplateSource sets the flag `format` with the template stored on the
+// config with the name informed on `format-name`, or with the contents of
+// the file informed on `format-file`
+func ApplyTemplateSource(f *pflag.FlagSet, c Config, stdin io.Reader) error {
+	if !f.Changed("format-name") && !f.Changed("format-file") {
+		return nil
+	}
+
+	if err := XorFlagSet(
+		f, "format", "format-name", "format-file"); err != nil {
+		return err
+	}
+
+	var t string
+	var err error
+	if f.Changed("format-name") {
+		t, err = templateFromConfig(f, c)
+	} else {
+		t, err = templateFromFile(f, stdin)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return f.Set("format", t)
+}
+
+func templateFromConfig(f *pflag.FlagSet, c Config) (string, error) {
+	name, err := f.GetString("format-name")
+	if err != nil {
+		return "", err
+	}
+
+	t := c.GetString(CONF_TEMPLATES + "." + name)
+	if t == "" {
+		return "", FlagErrorWrap(errors.Errorf(
+			"there is no template named \"%s\" on the config, "+
+				"you can add it with: config set %s.%s <template>",
+			name, CONF_TEMPLATES, name))
+	}
+
+	return t, nil
+}
+
+func templateFromFile(f *pflag.FlagSet, stdin io.Reader) (string, error) {
+	file, err := f.GetString("format-file")
+	if err != nil {
+		return "", err
+	}
+
+	var b []byte
+	if file == "-" {
+		b, err = io.ReadAll(stdin)
+	} else {
+		b, err = os.ReadFile(file)
+	}
+
+	if err != nil {
+		return "", errors.Wrap(err, "reading format file")
+	}
+
+	t := strings.TrimSuffix(string(b), "\n")
+	return strings.TrimSuffix(t, "\r"), nil
+}
+
+// AddPaginationFlags adds the flags `limit` and `page-size` to a command
+// that lists all pages, setting them on the api.PaginationParam
+func AddPaginationFlags(f *pflag.FlagSet, p *api.PaginationParam) {
+	f.IntVar(&p.Limit, "limit", 0,
+		"maximum of items to list (0 lists all)")
+	f.IntVar(&p.PageSize, "page-size", 0,
+		"how many items are fetched on each request (default 50)")
+}
@@ -2,8 +2,12 @@ package cmdutil_test
 
 import (
 	"errors"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
 	"github.com/lucassabreu/clockify-cli/pkg/cmdutil"
 	"github.com/spf13/pflag"
 	"github.com/stretchr/testify/assert"
@@ -110,3 +114,106 @@ func TestXorFlagSet(t *testing.T) {
 		})
 	}
 }
+
+func TestApplyTemplateSource(t *testing.T) {
+	tts := []struct {
+		name   string
+		args   []string
+		config func(*testing.T) cmdutil.Config
+		file   *string
+		stdin  string
+		format string
+		err    string
+	}{
+		{
+			name:   "not set",
+			args:   []string{"--format", "{{ .ID }}"},
+			config: func(t *testing.T) cmdutil.Config { return nil },
+			format: "{{ .ID }}",
+		},
+		{
+			name: "template exists",
+			args: []string{"--format-name", "short"},
+			config: func(t *testing.T) cmdutil.Config {
+				c := mocks.NewMockConfig(t)
+				c.On("GetString", "templates.short").
+					Return("{{ .ID }} - {{ .Name }}")
+				return c
+			},
+			format: "{{ .ID }} - {{ .Name }}",
+		},
+		{
+			name: "template does not exist",
+			args: []string{"--format-name", "invoice"},
+			config: func(t *testing.T) cmdutil.Config {
+				c := mocks.NewMockConfig(t)
+				c.On("GetString", "templates.invoice").Return("")
+				return c
+			},
+			err: "there is no template named \"invoice\" on the config, " +
+				"you can add it with: config set templates.invoice <template>",
+		},
+		{
+			name:   "both format flags",
+			args:   []string{"--format-name", "short", "--format", "{{.ID}}"},
+			config: func(t *testing.T) cmdutil.Config { return nil },
+			err: "the following flags can't be used together: " +
+				"`format` and `format-name`",
+		},
+		{
+			name:   "template from file",
+			file:   &[]string{"{{ .ID }}\n{{ .Name }}\n"}[0],
+			config: func(t *testing.T) cmdutil.Config { return nil },
+			format: "{{ .ID }}\n{{ .Name }}",
+		},
+		{
+			name:   "template from stdin",
+			args:   []string{"--format-file", "-"},
+			stdin:  "{{ .Name }}\r\n",
+			config: func(t *testing.T) cmdutil.Config { return nil },
+			format: "{{ .Name }}",
+		},
+		{
+			name:   "file does not exist",
+			args:   []string{"--format-file", "/not/a/real/file.gotmpl"},
+			config: func(t *testing.T) cmdutil.Config { return nil },
+			err: "reading format file: open /not/a/real/file.gotmpl: " +
+				"no such file or directory",
+		},
+		{
+			name:   "format name and file",
+			args:   []string{"--format-name", "short"},
+			file:   &[]string{"{{ .ID }}"}[0],
+			config: func(t *testing.T) cmdutil.Config { return nil },
+			err: "the following flags can't be used together: " +
+				"`format-file` and `format-name`",
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			format := fs.String("format", "", "format")
+			cmdutil.AddTemplateSourceFlags(fs)
+
+			args := tt.args
+			if tt.file != nil {
+				p := filepath.Join(t.TempDir(), "format.gotmpl")
+				assert.NoError(t, os.WriteFile(p, []byte(*tt.file), 0644))
+				args = append(args, "--format-file", p)
+			}
+			_ = fs.Parse(args)
+
+			err := cmdutil.ApplyTemplateSource(
+				fs, tt.config(t), strings.NewReader(tt.stdin))
+			if tt.err == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.format, *format)
+				return
+			}
+
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,159 @@
+// Package github reads the issues and pull requests of GitHub, to use them
+// on the descriptions of time entries
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/pkg/jsonapi"
+	"github.com/pkg/errors"
+)
+
+// Ref is a reference to an issue or pull request (like: owner/repo#123)
+type Ref struct {
+	Owner  string
+	Repo   string
+	Number int
+}
+
+var refRE = regexp.MustCompile(`^([\w.-]+)/([\w.-]+)#([1-9][0-9]*)$`)
+
+// ParseRef reads a reference like owner/repo#123
+func ParseRef(s string) (Ref, error) {
+	m := refRE.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return Ref{}, errors.Errorf(
+			"\"%s\" is not a reference like owner/repo#123", s)
+	}
+
+	n, _ := strconv.Atoi(m[3])
+	return Ref{Owner: m[1], Repo: m[2], Number: n}, nil
+}
+
+func (r Ref) String() string {
+	return fmt.Sprintf("%s/%s#%d", r.Owner, r.Repo, r.Number)
+}
+
+// In returns true when the text has the reference (ignoring the case of the
+// owner and repository), "#12" is not found on "#123"
+func (r Ref) In(text string) bool {
+	return r.Matcher()(text)
+}
+
+// Matcher returns a function working as In, but compiling the expression
+// only once, to be used on many texts
+func (r Ref) Matcher() func(string) bool {
+	return regexp.MustCompile(`(?i)(^|[^\w./-])` +
+		regexp.QuoteMeta(r.String()) + `([^0-9]|$)`).MatchString
+}
+
+// Issue is an issue or pull request of GitHub
+type Issue struct {
+	Ref
+	Title       string
+	URL         string
+	PullRequest bool
+}
+
+var remoteRE = regexp.MustCompile(
+	`github\.com[:/]([\w.-]+)/([\w.-]+?)(\.git)?/?$`)
+
+// ParseRemote returns the owner and repository of the URL of a git remote
+// on GitHub, false if it is not on GitHub
+func ParseRemote(u string) (string, string, bool) {
+	m := remoteRE.FindStringSubmatch(strings.TrimSpace(u))
+	if m == nil {
+		return "", "", false
+	}
+
+	return m[1], m[2], true
+}
+
+// DefaultURL is the base URL of the API of GitHub
+const DefaultURL = "https://api.github.com"
+
+// Client reads the issues and pull requests from the API of GitHub
+type Client struct {
+	api jsonapi.Client
+}
+
+// NewClient creates a Client with the token, without one only public
+// repositories can be read
+func NewClient(token string) *Client {
+	return NewClientFromURL(token, DefaultURL)
+}
+
+// NewClientFromURL creates a Client using other base URL for the API (like
+// the one of a GitHub Enterprise Server)
+func NewClientFromURL(token, baseURL string) *Client {
+	return &Client{api: jsonapi.Client{
+		Service: "GitHub",
+		BaseURL: strings.TrimSuffix(baseURL, "/"),
+		HTTP:    &http.Client{Timeout: time.Minute},
+		Prepare: func(r *http.Request) {
+			if token != "" {
+				r.Header.Set("Authorization", "Bearer "+token)
+			}
+			r.Header.Set("Accept", "application/vnd.github+json")
+		},
+	}}
+}
+
+type apiIssue struct {
+	Number      int         `json:"number"`
+	Title       string      `json:"title"`
+	HTMLURL     string      `json:"html_url"`
+	PullRequest interface{} `json:"pull_request"`
+}
+
+func (i apiIssue) toIssue(owner, repo string) Issue {
+	return Issue{
+		Ref:         Ref{Owner: owner, Repo: repo, Number: i.Number},
+		Title:       i.Title,
+		URL:         i.HTMLURL,
+		PullRequest: i.PullRequest != nil,
+	}
+}
+
+// Issue returns the issue or pull request of the reference
+func (c *Client) Issue(r Ref) (Issue, error) {
+	var i apiIssue
+	if err := c.api.Do("GET", fmt.Sprintf("repos/%s/%s/issues/%d",
+		url.PathEscape(r.Owner), url.PathEscape(r.Repo), r.Number),
+		nil, &i); err != nil {
+		return Issue{}, err
+	}
+
+	return i.toIssue(r.Owner, r.Repo), nil
+}
+
+// PullRequestOf returns the open pull request of the branch on the
+// repository
+func (c *Client) PullRequestOf(owner, repo, branch string) (Issue, error) {
+	q := url.Values{}
+	q.Set("head", owner+":"+branch)
+	q.Set("state", "open")
+
+	var ps []apiIssue
+	if err := c.api.Do("GET", fmt.Sprintf("repos/%s/%s/pulls?%s",
+		url.PathEscape(owner), url.PathEscape(repo), q.Encode()),
+		nil, &ps); err != nil {
+		return Issue{}, err
+	}
+
+	if len(ps) == 0 {
+		return Issue{}, errors.Errorf(
+			"no open pull request was found for %s on %s/%s",
+			branch, owner, repo)
+	}
+
+	i := ps[0].toIssue(owner, repo)
+	i.PullRequest = true
+	return i, nil
+}
@@ -0,0 +1,125 @@
+package github_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lucassabreu/clockify-cli/pkg/github"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRef(t *testing.T) {
+	r, err := github.ParseRef("lucassabreu/clockify-cli#42")
+	assert.NoError(t, err)
+	assert.Equal(t, github.Ref{
+		Owner: "lucassabreu", Repo: "clockify-cli", Number: 42}, r)
+	assert.Equal(t, "lucassabreu/clockify-cli#42", r.String())
+
+	for _, s := range []string{"#42", "clockify-cli#42", "a/b#0", "a/b"} {
+		_, err = github.ParseRef(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestRefIn(t *testing.T) {
+	r := github.Ref{Owner: "acme", Repo: "cli", Number: 12}
+
+	assert.True(t, r.In("acme/cli#12"))
+	assert.True(t, r.In("Fix login (Acme/CLI#12)"))
+	assert.True(t, r.In("acme/cli#12: fix login"))
+	assert.False(t, r.In("acme/cli#123"))
+	assert.False(t, r.In("other-acme/cli#12"))
+	assert.False(t, r.In("fix login"))
+
+	in := r.Matcher()
+	assert.True(t, in("Fix login (Acme/CLI#12)"))
+	assert.False(t, in("acme/cli#123"))
+}
+
+func TestParseRemote(t *testing.T) {
+	for _, u := range []string{
+		"git@github.com:acme/cli.git",
+		"https://github.com/acme/cli.git",
+		"https://github.com/acme/cli",
+		"ssh://git@github.com/acme/cli.git",
+	} {
+		o, r, ok := github.ParseRemote(u)
+		assert.True(t, ok, u)
+		assert.Equal(t, "acme", o, u)
+		assert.Equal(t, "cli", r, u)
+	}
+
+	_, _, ok := github.ParseRemote("git@gitlab.com:acme/cli.git")
+	assert.False(t, ok)
+}
+
+func TestClient(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer a-token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			switch r.URL.Path {
+			case "/repos/acme/cli/issues/12":
+				_, _ = w.Write([]byte(`{"number":12,"title":"Fix login",` +
+					`"html_url":"https://github.com/acme/cli/issues/12"}`))
+			case "/repos/acme/cli/issues/13":
+				_, _ = w.Write([]byte(`{"number":13,"title":"Add docs",` +
+					`"html_url":"https://github.com/acme/cli/pull/13",` +
+					`"pull_request":{}}`))
+			case "/repos/acme/cli/pulls":
+				assert.Equal(t, "open", r.URL.Query().Get("state"))
+				if r.URL.Query().Get("head") != "acme:docs" {
+					_, _ = w.Write([]byte(`[]`))
+					return
+				}
+				_, _ = w.Write([]byte(`[{"number":13,"title":"Add docs",` +
+					`"html_url":"https://github.com/acme/cli/pull/13"}]`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+	defer s.Close()
+
+	c := github.NewClientFromURL("a-token", s.URL)
+	ref := func(n int) github.Ref {
+		return github.Ref{Owner: "acme", Repo: "cli", Number: n}
+	}
+
+	i, err := c.Issue(ref(12))
+	assert.NoError(t, err)
+	assert.Equal(t, github.Issue{
+		Ref:   ref(12),
+		Title: "Fix login",
+		URL:   "https://github.com/acme/cli/issues/12",
+	}, i)
+
+	pr := github.Issue{
+		Ref:         ref(13),
+		Title:       "Add docs",
+		URL:         "https://github.com/acme/cli/pull/13",
+		PullRequest: true,
+	}
+
+	i, err = c.Issue(ref(13))
+	assert.NoError(t, err)
+	assert.Equal(t, pr, i)
+
+	i, err = c.PullRequestOf("acme", "cli", "docs")
+	assert.NoError(t, err)
+	assert.Equal(t, pr, i)
+
+	_, err = c.PullRequestOf("acme", "cli", "main")
+	assert.EqualError(t, err,
+		"no open pull request was found for main on acme/cli")
+
+	_, err = c.Issue(ref(14))
+	assert.EqualError(t, err, "github API did not find repos/acme/cli/issues/14")
+
+	_, err = github.NewClientFromURL("", s.URL).Issue(ref(12))
+	assert.EqualError(t, err,
+		"github API answered 401 Unauthorized for repos/acme/cli/issues/12")
+}
@@ -0,0 +1,230 @@
+// Package gitlab reads the issues and merge requests of GitLab, to use them
+// on the descriptions of time entries, and adds the time spent on them
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/pkg/jsonapi"
+	"github.com/pkg/errors"
+)
+
+const (
+	// KindIssue marks a reference to an issue (like: group/project#42)
+	KindIssue = "#"
+	// KindMergeRequest marks a reference to a merge request (like:
+	// group/project!42)
+	KindMergeRequest = "!"
+)
+
+// Ref is a reference to an issue or merge request of a project, which can
+// be on subgroups
+type Ref struct {
+	Project string
+	Kind    string
+	Number  int
+}
+
+var refRE = regexp.MustCompile(
+	`^([\w.-]+(?:/[\w.-]+)+)([#!])([1-9][0-9]*)$`)
+
+// ParseRef reads a reference like group/project!42 or group/project#42
+func ParseRef(s string) (Ref, error) {
+	m := refRE.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return Ref{}, errors.Errorf(
+			"\"%s\" is not a reference like group/project!42", s)
+	}
+
+	n, _ := strconv.Atoi(m[3])
+	return Ref{Project: m[1], Kind: m[2], Number: n}, nil
+}
+
+func (r Ref) String() string {
+	return r.Project + r.Kind + strconv.Itoa(r.Number)
+}
+
+// In returns true when the text has the reference (ignoring the case of the
+// project), "!4" is not found on "!42"
+func (r Ref) In(text string) bool {
+	return r.Matcher()(text)
+}
+
+// Matcher returns a function working as In, but compiling the expression
+// only once, to be used on many texts
+func (r Ref) Matcher() func(string) bool {
+	return regexp.MustCompile(`(?i)(^|[^\w./-])` +
+		regexp.QuoteMeta(r.String()) + `([^0-9]|$)`).MatchString
+}
+
+func (r Ref) path() string {
+	k := "issues"
+	if r.Kind == KindMergeRequest {
+		k = "merge_requests"
+	}
+
+	return fmt.Sprintf("projects/%s/%s/%d",
+		url.PathEscape(r.Project), k, r.Number)
+}
+
+var mergeRequestTextRE = regexp.MustCompile(
+	`(?:^|[^\w./-])([\w.-]+(?:/[\w.-]+)+![1-9][0-9]*)(?:[^0-9]|$)`)
+
+// FindMergeRequest returns the first reference to a merge request on the
+// text; issues are not looked for, as their references are the same as the
+// ones of GitHub
+func FindMergeRequest(text string) (Ref, bool) {
+	m := mergeRequestTextRE.FindStringSubmatch(text)
+	if m == nil {
+		return Ref{}, false
+	}
+
+	r, err := ParseRef(m[1])
+	return r, err == nil
+}
+
+// Issue is an issue or merge request of GitLab
+type Issue struct {
+	Ref
+	Title string
+	URL   string
+}
+
+var remoteRE = regexp.MustCompile(
+	`^(?:[\w.+-]+://)?(?:[^@/]+@)?[^:/]+(?::[0-9]+)?[:/]` +
+		`([\w.-]+(?:/[\w.-]+)+)/?$`)
+
+// ParseRemote returns the path of the project of the URL of a git remote,
+// false if it does not look like one
+func ParseRemote(u string) (string, bool) {
+	m := remoteRE.FindStringSubmatch(strings.TrimSpace(u))
+	if m == nil {
+		return "", false
+	}
+
+	return strings.TrimSuffix(m[1], ".git"), true
+}
+
+// DefaultURL is the URL of GitLab.com
+const DefaultURL = "https://gitlab.com"
+
+// Client reads the issues and merge requests from the API of GitLab
+type Client struct {
+	api jsonapi.Client
+}
+
+// NewClient creates a Client for the GitLab instance on baseURL with a
+// personal access token, without one only public projects can be read
+func NewClient(baseURL, token string) *Client {
+	return &Client{api: jsonapi.Client{
+		Service: "GitLab",
+		BaseURL: strings.TrimSuffix(baseURL, "/") + "/api/v4",
+		HTTP:    &http.Client{Timeout: time.Minute},
+		Prepare: func(r *http.Request) {
+			if token != "" {
+				r.Header.Set("PRIVATE-TOKEN", token)
+			}
+		},
+	}}
+}
+
+type apiIssue struct {
+	IID    int    `json:"iid"`
+	Title  string `json:"title"`
+	WebURL string `json:"web_url"`
+}
+
+// Issue returns the issue or merge request of the reference
+func (c *Client) Issue(r Ref) (Issue, error) {
+	var i apiIssue
+	if err := c.api.Do("GET", r.path(), nil, &i); err != nil {
+		return Issue{}, err
+	}
+
+	return Issue{Ref: r, Title: i.Title, URL: i.WebURL}, nil
+}
+
+// MergeRequestOf returns the open merge request of the branch on the
+// project
+func (c *Client) MergeRequestOf(project, branch string) (Issue, error) {
+	q := url.Values{}
+	q.Set("source_branch", branch)
+	q.Set("state", "opened")
+
+	var ms []apiIssue
+	if err := c.api.Do("GET", "projects/"+url.PathEscape(project)+
+		"/merge_requests?"+q.Encode(), nil, &ms); err != nil {
+		return Issue{}, err
+	}
+
+	if len(ms) == 0 {
+		return Issue{}, errors.Errorf(
+			"no open merge request was found for %s on %s",
+			branch, project)
+	}
+
+	return Issue{
+		Ref: Ref{
+			Project: project,
+			Kind:    KindMergeRequest,
+			Number:  ms[0].IID,
+		},
+		Title: ms[0].Title,
+		URL:   ms[0].WebURL,
+	}, nil
+}
+
+// Notes returns the body of all the notes (comments) of the issue or merge
+// request
+func (c *Client) Notes(r Ref) ([]string, error) {
+	ns := []string{}
+	for p := 1; ; p++ {
+		var page []struct {
+			Body string `json:"body"`
+		}
+
+		if err := c.api.Do("GET", r.path()+"/notes?per_page=100&page="+
+			strconv.Itoa(p), nil, &page); err != nil {
+			return nil, err
+		}
+
+		for _, n := range page {
+			ns = append(ns, n.Body)
+		}
+
+		if len(page) < 100 {
+			return ns, nil
+		}
+	}
+}
+
+// Spend adds a note to the issue or merge request with the text and a
+// "/spend" quick action, adding the duration to the time spent on it on
+// the date
+func (c *Client) Spend(
+	r Ref, text string, d time.Duration, date time.Time) error {
+	return c.api.Do("POST", r.path()+"/notes", map[string]string{
+		"body": text + "\n\n/spend " + FormatDuration(d) + " " +
+			date.Format("2006-01-02"),
+	}, &struct{}{})
+}
+
+// FormatDuration formats the duration as GitLab reads it on time tracking
+// (like: 1h30m), rounded to minutes
+func FormatDuration(d time.Duration) string {
+	m := int(d.Round(time.Minute) / time.Minute)
+	switch {
+	case m%60 == 0:
+		return fmt.Sprintf("%dh", m/60)
+	case m < 60:
+		return fmt.Sprintf("%dm", m)
+	default:
+		return fmt.Sprintf("%dh%dm", m/60, m%60)
+	}
+}
@@ -0,0 +1,149 @@
+package gitlab_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/pkg/gitlab"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRef(t *testing.T) {
+	r, err := gitlab.ParseRef("acme/tools/cli!42")
+	assert.NoError(t, err)
+	assert.Equal(t, gitlab.Ref{Project: "acme/tools/cli",
+		Kind: gitlab.KindMergeRequest, Number: 42}, r)
+	assert.Equal(t, "acme/tools/cli!42", r.String())
+
+	r, err = gitlab.ParseRef("acme/cli#7")
+	assert.NoError(t, err)
+	assert.Equal(t, gitlab.KindIssue, r.Kind)
+
+	for _, s := range []string{"!42", "cli!42", "acme/cli!0", "acme/cli"} {
+		_, err = gitlab.ParseRef(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestRefIn(t *testing.T) {
+	r := gitlab.Ref{Project: "acme/cli", Kind: "!", Number: 4}
+
+	assert.True(t, r.In("Fix login (Acme/CLI!4)"))
+	assert.False(t, r.In("acme/cli!42"))
+	assert.False(t, r.In("acme/cli#4"))
+	assert.False(t, r.In("other/acme/cli!4"))
+
+	in := r.Matcher()
+	assert.True(t, in("Fix login (Acme/CLI!4)"))
+	assert.False(t, in("acme/cli!42"))
+}
+
+func TestFindMergeRequest(t *testing.T) {
+	r, ok := gitlab.FindMergeRequest("Fix login (acme/cli#3, acme/cli!42)")
+	assert.True(t, ok)
+	assert.Equal(t, "acme/cli!42", r.String())
+
+	_, ok = gitlab.FindMergeRequest("Fix login (acme/cli#3)")
+	assert.False(t, ok)
+}
+
+func TestParseRemote(t *testing.T) {
+	for _, u := range []string{
+		"git@gitlab.com:acme/tools/cli.git",
+		"https://gitlab.acme.com/acme/tools/cli.git",
+		"https://gitlab.acme.com/acme/tools/cli",
+		"ssh://git@gitlab.acme.com:2222/acme/tools/cli.git",
+	} {
+		p, ok := gitlab.ParseRemote(u)
+		assert.True(t, ok, u)
+		assert.Equal(t, "acme/tools/cli", p, u)
+	}
+
+	_, ok := gitlab.ParseRemote("/home/me/cli")
+	assert.False(t, ok)
+}
+
+func TestFormatDuration(t *testing.T) {
+	assert.Equal(t, "25m", gitlab.FormatDuration(25*time.Minute))
+	assert.Equal(t, "2h", gitlab.FormatDuration(2*time.Hour))
+	assert.Equal(t, "1h30m",
+		gitlab.FormatDuration(90*time.Minute+20*time.Second))
+}
+
+func TestClient(t *testing.T) {
+	var spent map[string]string
+	s := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("PRIVATE-TOKEN") != "a-token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			p := "/api/v4/projects/acme/cli/"
+			switch r.Method + " " + r.URL.Path {
+			case "GET " + p + "merge_requests/42":
+				_, _ = w.Write([]byte(`{"iid":42,"title":"Add docs",` +
+					`"web_url":"https://gitlab.com/acme/cli/-/merge_requests/42"}`))
+			case "GET " + p + "issues/7":
+				_, _ = w.Write([]byte(`{"iid":7,"title":"Fix login"}`))
+			case "GET " + p + "merge_requests":
+				assert.Equal(t, "docs", r.URL.Query().Get("source_branch"))
+				assert.Equal(t, "opened", r.URL.Query().Get("state"))
+				_, _ = w.Write([]byte(`[{"iid":42,"title":"Add docs"}]`))
+			case "GET " + p + "merge_requests/42/notes":
+				if r.URL.Query().Get("page") == "1" {
+					ns := make([]map[string]string, 100)
+					for i := range ns {
+						ns[i] = map[string]string{"body": strconv.Itoa(i)}
+					}
+					_ = json.NewEncoder(w).Encode(ns)
+					return
+				}
+				_, _ = w.Write([]byte(`[{"body":"last"}]`))
+			case "POST " + p + "merge_requests/42/notes":
+				_ = json.NewDecoder(r.Body).Decode(&spent)
+				_, _ = w.Write([]byte(`{"id":1}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+	defer s.Close()
+
+	c := gitlab.NewClient(s.URL+"/", "a-token")
+	mr := gitlab.Ref{Project: "acme/cli", Kind: "!", Number: 42}
+
+	i, err := c.Issue(mr)
+	assert.NoError(t, err)
+	assert.Equal(t, gitlab.Issue{Ref: mr, Title: "Add docs",
+		URL: "https://gitlab.com/acme/cli/-/merge_requests/42"}, i)
+
+	i, err = c.Issue(gitlab.Ref{Project: "acme/cli", Kind: "#", Number: 7})
+	assert.NoError(t, err)
+	assert.Equal(t, "Fix login", i.Title)
+
+	i, err = c.MergeRequestOf("acme/cli", "docs")
+	assert.NoError(t, err)
+	assert.Equal(t, gitlab.Issue{Ref: mr, Title: "Add docs"}, i)
+
+	ns, err := c.Notes(mr)
+	assert.NoError(t, err)
+	assert.Len(t, ns, 101)
+	assert.Equal(t, "last", ns[100])
+
+	assert.NoError(t, c.Spend(mr, "docs", 90*time.Minute,
+		time.Date(2022, 6, 1, 9, 0, 0, 0, time.UTC)))
+	assert.Equal(t, map[string]string{
+		"body": "docs\n\n/spend 1h30m 2022-06-01"}, spent)
+
+	_, err = c.Issue(gitlab.Ref{Project: "acme/cli", Kind: "#", Number: 8})
+	assert.EqualError(t, err,
+		"gitlab API did not find projects/acme/cli/issues/8")
+
+	_, err = gitlab.NewClient(s.URL, "").Issue(mr)
+	assert.EqualError(t, err, "gitlab API answered 401 Unauthorized for "+
+		"projects/acme/cli/merge_requests/42")
+}
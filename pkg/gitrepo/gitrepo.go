@@ -0,0 +1,108 @@
+package gitrepo
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigFile is the name of the file, on the root of the repository, with
+// the values used for the time entries started on it
+const ConfigFile = ".clockify.yaml"
+
+// Config are the values used for the time entries started on a repository
+type Config struct {
+	Project  string   `yaml:"project"`
+	Task     string   `yaml:"task"`
+	Tags     []string `yaml:"tags"`
+	Billable *bool    `yaml:"billable"`
+	// TicketPattern is a regular expression to find the ticket ID on the
+	// branch name, replacing the one set on "git-ticket-pattern"
+	TicketPattern string `yaml:"ticket-pattern"`
+}
+
+// Repo is the git repository of a directory
+type Repo struct {
+	Root   string
+	Branch string
+	Config Config
+}
+
+// Open finds the git repository of the directory, its current branch and
+// the Config on its root (if there is one)
+func Open(dir string) (Repo, error) {
+	r := Repo{}
+	var err error
+	if r.Root, err = git(dir, "rev-parse", "--show-toplevel"); err != nil {
+		return r, errors.Wrapf(err, "%s is not on a git repository", dir)
+	}
+
+	if r.Branch, err = git(dir, "symbolic-ref", "--short", "HEAD"); err != nil {
+		return r, errors.New("the git repository is not on a branch")
+	}
+
+	b, err := os.ReadFile(filepath.Join(r.Root, ConfigFile))
+	if os.IsNotExist(err) {
+		return r, nil
+	}
+
+	if err != nil {
+		return r, errors.WithStack(err)
+	}
+
+	return r, errors.Wrapf(yaml.Unmarshal(b, &r.Config),
+		"failed to read %s", ConfigFile)
+}
+
+// RemoteURL returns the URL of the remote of the repository
+func RemoteURL(dir, remote string) (string, error) {
+	return git(dir, "remote", "get-url", remote)
+}
+
+func git(dir string, args ...string) (string, error) {
+	cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		if s := strings.TrimSpace(stderr.String()); s != "" {
+			return "", errors.New(s)
+		}
+
+		return "", errors.WithStack(err)
+	}
+
+	return strings.TrimSpace(string(out)), nil
+}
+
+// Ticket returns the ticket ID found on the branch name using the pattern,
+// which is the first group of it or the whole match when it has no groups;
+// returns false when it is not found
+func Ticket(branch, pattern string) (string, bool, error) {
+	if pattern == "" {
+		return "", false, nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return "", false, errors.Wrapf(err,
+			"ticket pattern \"%s\" is not valid", pattern)
+	}
+
+	m := re.FindStringSubmatch(branch)
+	switch {
+	case m == nil:
+		return "", false, nil
+	case len(m) > 1:
+		return m[1], m[1] != "", nil
+	default:
+		return m[0], true, nil
+	}
+}
@@ -0,0 +1,167 @@
+package gitrepo_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/pkg/gitrepo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTicket(t *testing.T) {
+	tts := []struct {
+		branch  string
+		pattern string
+		ticket  string
+		found   bool
+	}{
+		{branch: "feature/CLI-42-docs", pattern: ""},
+		{branch: "feature/CLI-42-docs", pattern: `[A-Z]+-\d+`,
+			ticket: "CLI-42", found: true},
+		{branch: "fix/123-login", pattern: `/(\d+)-`,
+			ticket: "123", found: true},
+		{branch: "main", pattern: `[A-Z]+-\d+`},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.branch+" "+tt.pattern, func(t *testing.T) {
+			ticket, found, err := gitrepo.Ticket(tt.branch, tt.pattern)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.ticket, ticket)
+			assert.Equal(t, tt.found, found)
+		})
+	}
+
+	_, _, err := gitrepo.Ticket("main", "[")
+	assert.Error(t, err)
+}
+
+func TestOpen(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	dir := t.TempDir()
+	_, err := gitrepo.Open(dir)
+	assert.Error(t, err, "should fail outside a repository")
+
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"checkout", "-q", "-b", "feature/CLI-42-docs"},
+	} {
+		if out, err := exec.Command("git", append(
+			[]string{"-C", dir}, args...)...).CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %s", args, out)
+		}
+	}
+
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, gitrepo.ConfigFile),
+		[]byte("project: p1\ntags: [t1, t2]\nbillable: true\n"+
+			"ticket-pattern: '[A-Z]+-[0-9]+'\n"), 0600))
+
+	sub := filepath.Join(dir, "docs")
+	assert.NoError(t, os.Mkdir(sub, 0700))
+
+	r, err := gitrepo.Open(sub)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	b := true
+	assert.Equal(t, "feature/CLI-42-docs", r.Branch)
+	assert.Equal(t, gitrepo.Config{
+		Project:       "p1",
+		Tags:          []string{"t1", "t2"},
+		Billable:      &b,
+		TicketPattern: "[A-Z]+-[0-9]+",
+	}, r.Config)
+
+	root, _ := filepath.EvalSymlinks(dir)
+	rroot, _ := filepath.EvalSymlinks(r.Root)
+	assert.Equal(t, root, rroot)
+}
+
+func TestBlocks(t *testing.T) {
+	at := func(h, m int) time.Time {
+		return time.Date(2022, 6, 1, h, m, 0, 0, time.UTC)
+	}
+
+	cs := []gitrepo.Commit{
+		{Subject: "start docs", At: at(9, 0)},
+		{Subject: "fix typo", At: at(9, 40)},
+		{Subject: "fix typo", At: at(10, 30)},
+		{Subject: "add login", At: at(11, 50)},
+		{Subject: "after lunch", At: at(14, 0)},
+	}
+
+	bs := gitrepo.Blocks(cs, time.Hour, 30*time.Minute)
+	if !assert.Len(t, bs, 3) {
+		return
+	}
+
+	assert.Equal(t, at(8, 30), bs[0].Start)
+	assert.Equal(t, at(10, 30), bs[0].End)
+	assert.Equal(t, "start docs; fix typo", bs[0].Description())
+
+	assert.Equal(t, at(11, 20), bs[1].Start)
+	assert.Equal(t, at(11, 50), bs[1].End)
+	assert.Equal(t, "add login", bs[1].Description())
+
+	assert.Equal(t, at(13, 30), bs[2].Start)
+
+	bs = gitrepo.Blocks(cs[3:], time.Hour, 3*time.Hour)
+	assert.Equal(t, at(11, 50), bs[1].Start,
+		"should not start before the previous one ends")
+}
+
+func TestLog(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	dir := t.TempDir()
+	run := func(env []string, args ...string) {
+		cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+		cmd.Env = append(os.Environ(), env...)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %s", args, out)
+		}
+	}
+
+	run(nil, "init", "-q")
+	run(nil, "config", "user.email", "me@example.com")
+	run(nil, "config", "user.name", "Me")
+
+	commit := func(date, email, subject string) {
+		run([]string{
+			"GIT_AUTHOR_DATE=" + date,
+			"GIT_COMMITTER_DATE=" + date,
+			"GIT_AUTHOR_EMAIL=" + email,
+		}, "commit", "-q", "--allow-empty", "-m", subject)
+	}
+
+	commit("2022-05-31T18:00:00Z", "me@example.com", "yesterday")
+	commit("2022-06-01T09:00:00Z", "me@example.com", "first")
+	commit("2022-06-01T09:30:00Z", "other@example.com", "someone else")
+	commit("2022-06-01T10:00:00Z", "me@example.com", "second")
+
+	email, err := gitrepo.UserEmail(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, "me@example.com", email)
+
+	cs, err := gitrepo.Log(dir,
+		time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2022, 6, 2, 0, 0, 0, 0, time.UTC),
+		email)
+	assert.NoError(t, err)
+	if assert.Len(t, cs, 2) {
+		assert.Equal(t, "first", cs[0].Subject)
+		assert.True(t, cs[0].At.Equal(
+			time.Date(2022, 6, 1, 9, 0, 0, 0, time.UTC)))
+		assert.Equal(t, "second", cs[1].Subject)
+		assert.Len(t, cs[1].Hash, 40)
+	}
+}
@@ -0,0 +1,120 @@
+package gitrepo
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Commit is a commit done on the repository
+type Commit struct {
+	Hash    string
+	At      time.Time
+	Subject string
+}
+
+// UserEmail returns the e-mail configured for the user of the repository
+func UserEmail(dir string) (string, error) {
+	return git(dir, "config", "user.email")
+}
+
+// Log returns the commits of all branches authored between since and until
+// by the author (any one, if empty), the oldest first; merges are ignored
+func Log(dir string, since, until time.Time, author string) (
+	[]Commit, error) {
+	args := []string{
+		"log", "--all", "--no-merges", "--reverse",
+		"--since=" + since.Format(time.RFC3339),
+		"--until=" + until.Format(time.RFC3339),
+		"--format=%H%x1f%aI%x1f%s",
+	}
+	if author != "" {
+		args = append(args, "--author="+author)
+	}
+
+	out, err := git(dir, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	cs := []Commit{}
+	for _, l := range strings.Split(out, "\n") {
+		if l == "" {
+			continue
+		}
+
+		p := strings.SplitN(l, "\x1f", 3)
+		if len(p) != 3 {
+			return nil, errors.Errorf("unexpected line on git log: %s", l)
+		}
+
+		at, err := time.Parse(time.RFC3339, p[1])
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		// author dates can be before since when they were rebased
+		if at.Before(since) || at.After(until) {
+			continue
+		}
+
+		cs = append(cs, Commit{Hash: p[0], At: at.Local(), Subject: p[2]})
+	}
+
+	sort.SliceStable(cs, func(i, j int) bool {
+		return cs[i].At.Before(cs[j].At)
+	})
+
+	return cs, nil
+}
+
+// Block is a period of work, with the commits done on it
+type Block struct {
+	Start   time.Time
+	End     time.Time
+	Commits []Commit
+}
+
+// Description joins the subjects of the commits of the block, without
+// repeating them
+func (b Block) Description() string {
+	seen := map[string]bool{}
+	ss := make([]string, 0, len(b.Commits))
+	for _, c := range b.Commits {
+		if seen[c.Subject] {
+			continue
+		}
+
+		seen[c.Subject] = true
+		ss = append(ss, c.Subject)
+	}
+
+	return strings.Join(ss, "; ")
+}
+
+// Blocks groups the commits (sorted by when they were done) into blocks of
+// work, starting a new one when a commit is done more than gap after the
+// previous one. Each block starts lead before its first commit (but not
+// before the previous block ends) and ends on its last commit
+func Blocks(cs []Commit, gap, lead time.Duration) []Block {
+	bs := []Block{}
+	for _, c := range cs {
+		l := len(bs) - 1
+		if l >= 0 && c.At.Sub(bs[l].End) <= gap {
+			bs[l].End = c.At
+			bs[l].Commits = append(bs[l].Commits, c)
+			continue
+		}
+
+		s := c.At.Add(-lead)
+		if l >= 0 && s.Before(bs[l].End) {
+			s = bs[l].End
+		}
+
+		bs = append(bs, Block{Start: s, End: c.At, Commits: []Commit{c}})
+	}
+
+	return bs
+}
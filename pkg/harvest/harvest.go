@@ -0,0 +1,123 @@
+// Package harvest reads the time entries exported by Harvest and the rules
+// to map its projects and tasks into the ones of Clockify
+package harvest
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Entry is a time entry of Harvest, it has only the date and how long it
+// took, not when it started
+type Entry struct {
+	Line     int
+	Date     time.Time
+	Client   string
+	Project  string
+	Task     string
+	Notes    string
+	Duration time.Duration
+	Billable bool
+}
+
+const dateFormat = "2006-01-02"
+
+// ReadCSV reads the time entries of a "Detailed time" report exported as CSV
+// by Harvest, its dates are read on the location
+func ReadCSV(r io.Reader, loc *time.Location) ([]Entry, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	h, err := cr.Read()
+	if err == io.EOF {
+		return nil, errors.New("the CSV file is empty")
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read the CSV file")
+	}
+
+	cols := map[string]int{}
+	for i := range h {
+		n := strings.ToLower(strings.TrimSpace(
+			strings.TrimPrefix(h[i], "\ufeff")))
+		cols[n] = i
+	}
+
+	for _, n := range []string{"date", "project", "task", "hours"} {
+		if _, ok := cols[n]; !ok {
+			return nil, errors.Errorf(
+				`the column "%s" is missing, is it a detailed time report?`,
+				n)
+		}
+	}
+
+	es := make([]Entry, 0)
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read the CSV file")
+		}
+
+		get := func(n string) string {
+			i, ok := cols[n]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		e := Entry{
+			Client:   get("client"),
+			Project:  get("project"),
+			Task:     get("task"),
+			Notes:    get("notes"),
+			Billable: strings.EqualFold(get("billable?"), "yes"),
+		}
+		e.Line, _ = cr.FieldPos(0)
+
+		if e.Date, err = time.ParseInLocation(
+			dateFormat, get("date"), loc); err != nil {
+			return nil, errors.Errorf(
+				"line %d: invalid date, it should be like 2022-06-19",
+				e.Line)
+		}
+
+		if e.Duration, err = parseHours(get("hours")); err != nil {
+			return nil, errors.Errorf("line %d: %s", e.Line, err)
+		}
+
+		es = append(es, e)
+	}
+
+	return es, nil
+}
+
+// parseHours reads hours as decimal (1.5) or time (1:30)
+func parseHours(s string) (time.Duration, error) {
+	err := errors.Errorf(`invalid hours "%s"`, s)
+	if ps := strings.SplitN(s, ":", 2); len(ps) == 2 {
+		hs, herr := strconv.Atoi(ps[0])
+		ms, merr := strconv.Atoi(ps[1])
+		if herr != nil || merr != nil || hs < 0 || ms < 0 || ms > 59 {
+			return 0, err
+		}
+
+		return time.Duration(hs)*time.Hour +
+			time.Duration(ms)*time.Minute, nil
+	}
+
+	h, perr := strconv.ParseFloat(s, 64)
+	if perr != nil || h < 0 {
+		return 0, err
+	}
+
+	return time.Duration(h * float64(time.Hour)).Round(time.Second), nil
+}
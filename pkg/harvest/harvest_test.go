@@ -0,0 +1,131 @@
+package harvest_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/harvest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadCSV(t *testing.T) {
+	es, err := harvest.ReadCSV(strings.NewReader(heredoc.Doc(`
+		Date,Client,Project,Project Code,Task,Notes,Hours,Hours Rounded,Billable?,Invoiced?,First Name,Last Name
+		2022-06-19,Acme,Website,WEB,Design,Writing docs,1.5,1.5,Yes,No,John,Doe
+		2022-06-19,,Internal,,Meetings,,0:45,0.75,No,No,John,Doe
+	`)), time.UTC)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []harvest.Entry{
+		{
+			Line:     2,
+			Date:     time.Date(2022, 6, 19, 0, 0, 0, 0, time.UTC),
+			Client:   "Acme",
+			Project:  "Website",
+			Task:     "Design",
+			Notes:    "Writing docs",
+			Duration: 90 * time.Minute,
+			Billable: true,
+		},
+		{
+			Line:     3,
+			Date:     time.Date(2022, 6, 19, 0, 0, 0, 0, time.UTC),
+			Project:  "Internal",
+			Task:     "Meetings",
+			Duration: 45 * time.Minute,
+		},
+	}, es)
+}
+
+func TestReadCSVErrors(t *testing.T) {
+	tts := []struct {
+		name string
+		csv  string
+		err  string
+	}{
+		{name: "empty", err: "the CSV file is empty"},
+		{
+			name: "missing column",
+			csv:  "Date,Project,Task\n",
+			err: `the column "hours" is missing, ` +
+				`is it a detailed time report?`,
+		},
+		{
+			name: "invalid date",
+			csv:  "Date,Project,Task,Hours\n06/19/2022,P,T,1\n",
+			err:  "line 2: invalid date, it should be like 2022-06-19",
+		},
+		{
+			name: "invalid hours",
+			csv:  "Date,Project,Task,Hours\n2022-06-19,P,T,1h\n",
+			err:  `line 2: invalid hours "1h"`,
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := harvest.ReadCSV(strings.NewReader(tt.csv), time.UTC)
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestMapping(t *testing.T) {
+	m, err := harvest.LoadMapping(strings.NewReader(heredoc.Doc(`
+		projects:
+		  - harvest: Website
+		    client: Acme
+		    clockify: Acme Website
+		  - harvest: Website
+		    clockify: Other Website
+		  - harvest: Internal
+		    clockify: ""
+		tasks:
+		  - harvest: Design
+		    project: Website
+		    clockify: UI
+		  - harvest: Meetings
+		    clockify: ""
+		    tags: [Meeting]
+		  - harvest: Support
+		    tags: [Support]
+	`)))
+	assert.NoError(t, err)
+
+	assert.Equal(t, "Acme Website", m.Project("acme", "website"))
+	assert.Equal(t, "Other Website", m.Project("Other", "Website"))
+	assert.Equal(t, "", m.Project("", "Internal"))
+	assert.Equal(t, "Unmapped", m.Project("Acme", "Unmapped"))
+
+	task, tags := m.Task("Website", "Design")
+	assert.Equal(t, "UI", task)
+	assert.Empty(t, tags)
+
+	task, tags = m.Task("Internal", "Design")
+	assert.Equal(t, "Design", task)
+	assert.Empty(t, tags)
+
+	task, tags = m.Task("Internal", "Meetings")
+	assert.Equal(t, "", task)
+	assert.Equal(t, []string{"Meeting"}, tags)
+
+	task, tags = m.Task("Internal", "Support")
+	assert.Equal(t, "Support", task)
+	assert.Equal(t, []string{"Support"}, tags)
+}
+
+func TestLoadMappingErrors(t *testing.T) {
+	_, err := harvest.LoadMapping(strings.NewReader("project: []\n"))
+	assert.Error(t, err)
+
+	_, err = harvest.LoadMapping(strings.NewReader(
+		"tasks:\n  - clockify: UI\n"))
+	assert.EqualError(t, err, "task rule 1 must set the harvest name")
+
+	m, err := harvest.LoadMapping(strings.NewReader(""))
+	assert.NoError(t, err)
+	assert.Equal(t, harvest.Mapping{}, m)
+}
@@ -0,0 +1,110 @@
+package harvest
+
+import (
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// Mapping are the rules to find which project and task of Clockify should be
+// used for the ones of Harvest, when there is no rule for them the same
+// names are used
+type Mapping struct {
+	Projects []ProjectRule `yaml:"projects"`
+	Tasks    []TaskRule    `yaml:"tasks"`
+}
+
+// ProjectRule maps a project of Harvest into one of Clockify
+type ProjectRule struct {
+	// Harvest is the name of the project on Harvest
+	Harvest string `yaml:"harvest"`
+	// Client limits the rule to the project of this client on Harvest
+	Client string `yaml:"client"`
+	// Clockify is the name or ID of the project on Clockify, when empty the
+	// time entries will have no project
+	Clockify *string `yaml:"clockify"`
+}
+
+// TaskRule maps a task of Harvest into one of Clockify and tags
+type TaskRule struct {
+	// Harvest is the name of the task on Harvest
+	Harvest string `yaml:"harvest"`
+	// Project limits the rule to the task of this project on Harvest
+	Project string `yaml:"project"`
+	// Clockify is the name or ID of the task on Clockify, when empty the
+	// time entries will have no task
+	Clockify *string `yaml:"clockify"`
+	// Tags are names or IDs of tags on Clockify to add to the time entries
+	Tags []string `yaml:"tags"`
+}
+
+// LoadMapping reads the rules from a YAML file
+func LoadMapping(r io.Reader) (Mapping, error) {
+	var m Mapping
+
+	d := yaml.NewDecoder(r)
+	d.KnownFields(true)
+	if err := d.Decode(&m); err != nil && err != io.EOF {
+		return m, errors.Wrap(err, "failed to read the mapping file")
+	}
+
+	for i := range m.Projects {
+		if m.Projects[i].Harvest == "" {
+			return m, errors.Errorf(
+				"project rule %d must set the harvest name", i+1)
+		}
+	}
+
+	for i := range m.Tasks {
+		if m.Tasks[i].Harvest == "" {
+			return m, errors.Errorf(
+				"task rule %d must set the harvest name", i+1)
+		}
+	}
+
+	return m, nil
+}
+
+func same(rule, value string) bool {
+	return strings.EqualFold(strings.TrimSpace(rule), value)
+}
+
+// Project returns the name or ID of the project on Clockify to be used for
+// the project of Harvest, the first rule matching is used
+func (m Mapping) Project(client, project string) string {
+	for _, r := range m.Projects {
+		if !same(r.Harvest, project) ||
+			(r.Client != "" && !same(r.Client, client)) {
+			continue
+		}
+
+		if r.Clockify == nil {
+			return project
+		}
+
+		return *r.Clockify
+	}
+
+	return project
+}
+
+// Task returns the name or ID of the task on Clockify to be used for the
+// task of Harvest and the tags to add, the first rule matching is used
+func (m Mapping) Task(project, task string) (string, []string) {
+	for _, r := range m.Tasks {
+		if !same(r.Harvest, task) ||
+			(r.Project != "" && !same(r.Project, project)) {
+			continue
+		}
+
+		if r.Clockify == nil {
+			return task, r.Tags
+		}
+
+		return *r.Clockify, r.Tags
+	}
+
+	return task, nil
+}
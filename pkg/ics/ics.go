@@ -0,0 +1,461 @@
+// Package ics reads the events of iCalendar files (.ics), to be imported as
+// time entries, and the rules to map them into projects and tags
+package ics
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Event is an event of the calendar, a recurring one is expanded into its
+// occurrences by Occurrences
+type Event struct {
+	UID       string
+	Summary   string
+	Start     time.Time
+	End       time.Time
+	AllDay    bool
+	Cancelled bool
+
+	rrule        string
+	exdates      []time.Time
+	recurrenceID *time.Time
+}
+
+const (
+	dateFormat     = "20060102"
+	dateTimeFormat = "20060102T150405"
+)
+
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// Read reads the events of an iCalendar file, date-times without time zone
+// are read on the location
+func Read(r io.Reader, loc *time.Location) ([]Event, error) {
+	ps, err := readProperties(r)
+	if err != nil {
+		return nil, err
+	}
+
+	es := []Event{}
+	var e *Event
+	var duration string
+	depth := 0
+	for _, p := range ps {
+		switch {
+		case p.name == "BEGIN" && p.value == "VEVENT":
+			e = &Event{}
+			duration = ""
+			depth = 0
+		case e == nil:
+			continue
+		case p.name == "BEGIN":
+			depth++
+		case p.name == "END" && depth > 0:
+			depth--
+		case p.name == "END" && p.value == "VEVENT":
+			if err := finish(e, duration); err != nil {
+				return nil, err
+			}
+			es = append(es, *e)
+			e = nil
+		case depth > 0:
+			// properties of alarms and other nested components
+		default:
+			if err := set(e, p, loc, &duration); err != nil {
+				return nil, errors.Wrapf(err, "event \"%s\"", e.Summary)
+			}
+		}
+	}
+
+	return es, nil
+}
+
+func set(e *Event, p property, loc *time.Location, duration *string) error {
+	var err error
+	switch p.name {
+	case "UID":
+		e.UID = p.value
+	case "SUMMARY":
+		e.Summary = unescape(p.value)
+	case "STATUS":
+		e.Cancelled = strings.EqualFold(p.value, "CANCELLED")
+	case "DTSTART":
+		e.Start, e.AllDay, err = parseTime(p, loc)
+	case "DTEND":
+		e.End, _, err = parseTime(p, loc)
+	case "DURATION":
+		*duration = p.value
+	case "RRULE":
+		e.rrule = p.value
+	case "EXDATE":
+		for _, v := range strings.Split(p.value, ",") {
+			p.value = v
+			t, _, err := parseTime(p, loc)
+			if err != nil {
+				return err
+			}
+			e.exdates = append(e.exdates, t)
+		}
+	case "RECURRENCE-ID":
+		var t time.Time
+		t, _, err = parseTime(p, loc)
+		e.recurrenceID = &t
+	}
+
+	return err
+}
+
+func finish(e *Event, duration string) error {
+	if e.Start.IsZero() {
+		return errors.Errorf("event \"%s\" has no start", e.Summary)
+	}
+
+	if !e.End.IsZero() {
+		return nil
+	}
+
+	switch {
+	case duration != "":
+		d, err := parseDuration(duration)
+		if err != nil {
+			return errors.Wrapf(err, "event \"%s\"", e.Summary)
+		}
+		e.End = e.Start.Add(d)
+	case e.AllDay:
+		e.End = e.Start.AddDate(0, 0, 1)
+	default:
+		e.End = e.Start
+	}
+
+	return nil
+}
+
+func readProperties(r io.Reader) ([]property, error) {
+	lines := []string{}
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 64*1024), 1024*1024)
+	for s.Scan() {
+		l := strings.TrimRight(s.Text(), "\r")
+		if l == "" {
+			continue
+		}
+
+		// long lines are folded, continuing with a space or tab
+		if (l[0] == ' ' || l[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += l[1:]
+			continue
+		}
+
+		lines = append(lines, l)
+	}
+
+	if err := s.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to read the calendar")
+	}
+
+	if len(lines) == 0 || !strings.EqualFold(lines[0], "BEGIN:VCALENDAR") {
+		return nil, errors.New("the file is not an iCalendar (.ics)")
+	}
+
+	ps := make([]property, 0, len(lines))
+	for i, l := range lines {
+		p, err := parseProperty(l)
+		if err != nil {
+			return nil, errors.Wrapf(err, "line %d", i+1)
+		}
+		ps = append(ps, p)
+	}
+
+	return ps, nil
+}
+
+func parseProperty(l string) (property, error) {
+	quoted := false
+	i := strings.IndexFunc(l, func(r rune) bool {
+		if r == '"' {
+			quoted = !quoted
+		}
+		return r == ':' && !quoted
+	})
+	if i == -1 {
+		return property{}, errors.Errorf("invalid line: %s", l)
+	}
+
+	parts := strings.Split(l[:i], ";")
+	p := property{
+		name:   strings.ToUpper(parts[0]),
+		params: map[string]string{},
+		value:  l[i+1:],
+	}
+
+	for _, param := range parts[1:] {
+		kv := strings.SplitN(param, "=", 2)
+		if len(kv) == 2 {
+			p.params[strings.ToUpper(kv[0])] = strings.Trim(kv[1], `"`)
+		}
+	}
+
+	return p, nil
+}
+
+func unescape(s string) string {
+	return strings.NewReplacer(
+		`\n`, "\n", `\N`, "\n", `\,`, ",", `\;`, ";", `\\`, `\`,
+	).Replace(s)
+}
+
+// parseTime reads a date or date-time, informing if it was only a date
+func parseTime(p property, loc *time.Location) (time.Time, bool, error) {
+	v := strings.TrimSpace(p.value)
+	if strings.EqualFold(p.params["VALUE"], "DATE") || len(v) == 8 {
+		t, err := time.ParseInLocation(dateFormat, v, loc)
+		return t, true, errors.Wrapf(err, "invalid date %s", v)
+	}
+
+	if strings.HasSuffix(v, "Z") {
+		t, err := time.Parse(dateTimeFormat, strings.TrimSuffix(v, "Z"))
+		return t.In(loc), false,
+			errors.Wrapf(err, "invalid date-time %s", v)
+	}
+
+	l := loc
+	if tz, ok := p.params["TZID"]; ok {
+		// zones not known by name (like the ones of Windows) are read on
+		// the location
+		if z, err := time.LoadLocation(tz); err == nil {
+			l = z
+		}
+	}
+
+	t, err := time.ParseInLocation(dateTimeFormat, v, l)
+	return t.In(loc), false, errors.Wrapf(err, "invalid date-time %s", v)
+}
+
+var durationRE = regexp.MustCompile(
+	`^([+-])?P(?:([0-9]+)W)?(?:([0-9]+)D)?` +
+		`(?:T(?:([0-9]+)H)?(?:([0-9]+)M)?(?:([0-9]+)S)?)?$`)
+
+func parseDuration(s string) (time.Duration, error) {
+	m := durationRE.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil || s == "P" || s == "PT" {
+		return 0, errors.Errorf("invalid duration %s", s)
+	}
+
+	var d time.Duration
+	for i, u := range []time.Duration{
+		7 * 24 * time.Hour, 24 * time.Hour,
+		time.Hour, time.Minute, time.Second,
+	} {
+		n, _ := strconv.Atoi(m[i+2])
+		d += time.Duration(n) * u
+	}
+
+	if m[1] == "-" {
+		d = -d
+	}
+
+	return d, nil
+}
+
+// Occurrences returns the events, and the occurrences of the recurring ones,
+// that started between since and until, the first one first. Recurrence
+// rules not supported are returned as errors, with their events being
+// ignored
+func Occurrences(es []Event, since, until time.Time) ([]Event, []error) {
+	overrides := map[string][]time.Time{}
+	for _, e := range es {
+		if e.recurrenceID != nil {
+			overrides[e.UID] = append(overrides[e.UID], *e.recurrenceID)
+		}
+	}
+
+	occ := []Event{}
+	errs := []error{}
+	in := func(e Event) bool {
+		return !e.Start.Before(since) && !e.Start.After(until)
+	}
+
+	for _, e := range es {
+		if e.rrule == "" || e.recurrenceID != nil {
+			if in(e) {
+				occ = append(occ, e)
+			}
+			continue
+		}
+
+		starts, err := expand(e, until)
+		if err != nil {
+			errs = append(errs,
+				errors.Wrapf(err, "event \"%s\"", e.Summary))
+			continue
+		}
+
+		skip := append(append([]time.Time{}, e.exdates...),
+			overrides[e.UID]...)
+		d := e.End.Sub(e.Start)
+		for _, s := range starts {
+			if contains(skip, s) {
+				continue
+			}
+
+			o := e
+			o.Start = s
+			o.End = s.Add(d)
+			if in(o) {
+				occ = append(occ, o)
+			}
+		}
+	}
+
+	sort.SliceStable(occ, func(i, j int) bool {
+		return occ[i].Start.Before(occ[j].Start)
+	})
+
+	return occ, errs
+}
+
+func contains(ts []time.Time, t time.Time) bool {
+	for i := range ts {
+		if ts[i].Equal(t) {
+			return true
+		}
+	}
+
+	return false
+}
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday, "MO": time.Monday, "TU": time.Tuesday,
+	"WE": time.Wednesday, "TH": time.Thursday, "FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// expand returns the starts of the occurrences of the event until the
+// time, only the rules with FREQ (DAILY, WEEKLY, MONTHLY or YEARLY),
+// INTERVAL, COUNT, UNTIL and BYDAY (on WEEKLY) are supported
+func expand(e Event, until time.Time) ([]time.Time, error) {
+	freq, interval, count := "", 1, -1
+	var days []time.Weekday
+	for _, part := range strings.Split(e.rrule, ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			return nil, errors.Errorf("invalid recurrence rule %s", e.rrule)
+		}
+
+		var err error
+		switch v := kv[1]; strings.ToUpper(kv[0]) {
+		case "FREQ":
+			freq = strings.ToUpper(v)
+		case "INTERVAL":
+			interval, err = strconv.Atoi(v)
+		case "COUNT":
+			count, err = strconv.Atoi(v)
+		case "UNTIL":
+			var u time.Time
+			u, _, err = parseTime(property{value: v}, e.Start.Location())
+			if err == nil && u.Before(until) {
+				until = u
+			}
+		case "WKST":
+		case "BYDAY":
+			for _, d := range strings.Split(v, ",") {
+				wd, ok := weekdays[strings.ToUpper(d)]
+				if !ok {
+					return nil, errors.Errorf(
+						"recurrence rule %s is not supported", e.rrule)
+				}
+				days = append(days, wd)
+			}
+		default:
+			return nil, errors.Errorf(
+				"recurrence rule %s is not supported", e.rrule)
+		}
+
+		if err != nil {
+			return nil, errors.Errorf("invalid recurrence rule %s", e.rrule)
+		}
+	}
+
+	if interval < 1 || (len(days) > 0 && freq != "WEEKLY") {
+		return nil, errors.Errorf(
+			"recurrence rule %s is not supported", e.rrule)
+	}
+
+	s := e.Start
+	at := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, s.Hour(), s.Minute(), s.Second(), 0,
+			s.Location())
+	}
+
+	var next func(n int) []time.Time
+	switch freq {
+	case "DAILY":
+		next = func(n int) []time.Time {
+			return []time.Time{at(s.Year(), s.Month(), s.Day()+n*interval)}
+		}
+	case "WEEKLY":
+		if len(days) == 0 {
+			days = []time.Weekday{s.Weekday()}
+		}
+		sort.Slice(days, func(i, j int) bool {
+			return (days[i]+6)%7 < (days[j]+6)%7
+		})
+
+		// weeks start on monday
+		monday := s.Day() - int((s.Weekday()+6)%7)
+		next = func(n int) []time.Time {
+			ts := make([]time.Time, len(days))
+			for i, d := range days {
+				ts[i] = at(s.Year(), s.Month(),
+					monday+n*7*interval+int((d+6)%7))
+			}
+			return ts
+		}
+	case "MONTHLY":
+		next = func(n int) []time.Time {
+			t := at(s.Year(), s.Month()+time.Month(n*interval), s.Day())
+			if t.Day() != s.Day() {
+				return nil
+			}
+			return []time.Time{t}
+		}
+	case "YEARLY":
+		next = func(n int) []time.Time {
+			t := at(s.Year()+n*interval, s.Month(), s.Day())
+			if t.Day() != s.Day() {
+				return nil
+			}
+			return []time.Time{t}
+		}
+	default:
+		return nil, errors.Errorf(
+			"recurrence rule %s is not supported", e.rrule)
+	}
+
+	ts := []time.Time{}
+	for n := 0; ; n++ {
+		for _, t := range next(n) {
+			if t.Before(s) {
+				continue
+			}
+
+			if t.After(until) || (count >= 0 && len(ts) >= count) {
+				return ts, nil
+			}
+
+			ts = append(ts, t)
+		}
+	}
+}
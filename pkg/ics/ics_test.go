@@ -0,0 +1,337 @@
+package ics_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/ics"
+	"github.com/stretchr/testify/assert"
+)
+
+type occurrence struct {
+	summary    string
+	start, end string
+	allDay     bool
+	cancelled  bool
+}
+
+func occurrences(es []ics.Event) []occurrence {
+	os := make([]occurrence, len(es))
+	for i, e := range es {
+		os[i] = occurrence{
+			summary:   e.Summary,
+			start:     e.Start.Format("2006-01-02 15:04"),
+			end:       e.End.Format("2006-01-02 15:04"),
+			allDay:    e.AllDay,
+			cancelled: e.Cancelled,
+		}
+	}
+
+	return os
+}
+
+func TestReadAndOccurrences(t *testing.T) {
+	es, err := ics.Read(strings.NewReader(strings.ReplaceAll(heredoc.Doc(`
+		BEGIN:VCALENDAR
+		VERSION:2.0
+		BEGIN:VEVENT
+		UID:1
+		SUMMARY:Planning\, sprint 3
+		DTSTART:20220620T130000Z
+		DTEND:20220620T140000Z
+		BEGIN:VALARM
+		SUMMARY:Reminder
+		TRIGGER:-PT10M
+		END:VALARM
+		END:VEVENT
+		BEGIN:VEVENT
+		UID:2
+		SUMMARY:Daily stand-up with a long title that was folded by the
+		  calendar
+		DTSTART;TZID=America/Sao_Paulo:20220620T090000
+		DURATION:PT15M
+		RRULE:FREQ=DAILY;COUNT=5
+		EXDATE;TZID=America/Sao_Paulo:20220622T090000
+		END:VEVENT
+		BEGIN:VEVENT
+		UID:2
+		RECURRENCE-ID;TZID=America/Sao_Paulo:20220623T090000
+		SUMMARY:Daily stand-up (moved)
+		DTSTART;TZID=America/Sao_Paulo:20220623T110000
+		DTEND;TZID=America/Sao_Paulo:20220623T113000
+		END:VEVENT
+		BEGIN:VEVENT
+		UID:3
+		SUMMARY:Holiday
+		DTSTART;VALUE=DATE:20220621
+		END:VEVENT
+		BEGIN:VEVENT
+		UID:4
+		SUMMARY:Cancelled review
+		STATUS:CANCELLED
+		DTSTART:20220621T150000
+		DTEND:20220621T160000
+		END:VEVENT
+		BEGIN:VEVENT
+		UID:5
+		SUMMARY:Old meeting
+		DTSTART:20220601T150000
+		DTEND:20220601T160000
+		END:VEVENT
+		END:VCALENDAR
+	`), "\n", "\r\n")), time.UTC)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	os, errs := ics.Occurrences(es,
+		time.Date(2022, 6, 20, 0, 0, 0, 0, time.UTC),
+		time.Date(2022, 6, 24, 0, 0, 0, 0, time.UTC),
+	)
+
+	assert.Empty(t, errs)
+	assert.Equal(t, []occurrence{
+		{
+			summary: "Daily stand-up with a long title that was folded " +
+				"by the calendar",
+			start: "2022-06-20 12:00", end: "2022-06-20 12:15",
+		},
+		{
+			summary: "Planning, sprint 3",
+			start:   "2022-06-20 13:00", end: "2022-06-20 14:00",
+		},
+		{
+			summary: "Holiday",
+			start:   "2022-06-21 00:00", end: "2022-06-22 00:00",
+			allDay: true,
+		},
+		{
+			summary: "Daily stand-up with a long title that was folded " +
+				"by the calendar",
+			start: "2022-06-21 12:00", end: "2022-06-21 12:15",
+		},
+		{
+			summary: "Cancelled review",
+			start:   "2022-06-21 15:00", end: "2022-06-21 16:00",
+			cancelled: true,
+		},
+		{
+			summary: "Daily stand-up (moved)",
+			start:   "2022-06-23 14:00", end: "2022-06-23 14:30",
+		},
+	}, occurrences(os))
+}
+
+func TestOccurrencesOfRecurrenceRules(t *testing.T) {
+	tts := []struct {
+		name   string
+		rrule  string
+		starts []string
+		err    string
+	}{
+		{
+			name:  "weekly by day",
+			rrule: "FREQ=WEEKLY;BYDAY=MO,WE,FR;WKST=SU",
+			starts: []string{
+				"2022-06-01 10:00", "2022-06-03 10:00",
+				"2022-06-06 10:00", "2022-06-08 10:00",
+				"2022-06-10 10:00",
+			},
+		},
+		{
+			name:  "every 3 days until",
+			rrule: "FREQ=DAILY;INTERVAL=3;UNTIL=20220607T000000Z",
+			starts: []string{
+				"2022-06-01 10:00", "2022-06-04 10:00",
+			},
+		},
+		{
+			name:   "monthly",
+			rrule:  "FREQ=MONTHLY;COUNT=3",
+			starts: []string{"2022-06-01 10:00"},
+		},
+		{
+			name:  "not supported",
+			rrule: "FREQ=MONTHLY;BYDAY=1MO",
+			err: `event "Sync": ` +
+				"recurrence rule FREQ=MONTHLY;BYDAY=1MO is not supported",
+		},
+		{
+			name:  "invalid",
+			rrule: "FREQ=DAILY;COUNT=many",
+			err: `event "Sync": ` +
+				"invalid recurrence rule FREQ=DAILY;COUNT=many",
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			es, err := ics.Read(strings.NewReader(heredoc.Doc(`
+				BEGIN:VCALENDAR
+				BEGIN:VEVENT
+				SUMMARY:Sync
+				DTSTART:20220601T100000
+				DTEND:20220601T103000
+				RRULE:`+tt.rrule+`
+				END:VEVENT
+				END:VCALENDAR
+			`)), time.UTC)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			os, errs := ics.Occurrences(es,
+				time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2022, 6, 11, 0, 0, 0, 0, time.UTC),
+			)
+
+			if tt.err != "" {
+				assert.Empty(t, os)
+				if assert.Len(t, errs, 1) {
+					assert.EqualError(t, errs[0], tt.err)
+				}
+				return
+			}
+
+			assert.Empty(t, errs)
+			starts := make([]string, len(os))
+			for i := range os {
+				starts[i] = os[i].Start.Format("2006-01-02 15:04")
+			}
+			assert.Equal(t, tt.starts, starts)
+		})
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	tts := []struct {
+		name string
+		ics  string
+		err  string
+	}{
+		{name: "empty", err: "the file is not an iCalendar (.ics)"},
+		{
+			name: "not a calendar",
+			ics:  "Date,Project\n",
+			err:  "the file is not an iCalendar (.ics)",
+		},
+		{
+			name: "invalid line",
+			ics:  "BEGIN:VCALENDAR\nBEGIN:VEVENT\nSUMMARY\n",
+			err:  "line 3: invalid line: SUMMARY",
+		},
+		{
+			name: "without start",
+			ics: "BEGIN:VCALENDAR\nBEGIN:VEVENT\nSUMMARY:Sync\n" +
+				"END:VEVENT\n",
+			err: `event "Sync" has no start`,
+		},
+		{
+			name: "invalid date-time",
+			ics: "BEGIN:VCALENDAR\nBEGIN:VEVENT\nSUMMARY:Sync\n" +
+				"DTSTART:2022-06-01T10:00\n",
+			err: `event "Sync": invalid date-time 2022-06-01T10:00: ` +
+				`parsing time "2022-06-01T10:00" as "20060102T150405": ` +
+				`cannot parse "-06-01T10:00" as "01"`,
+		},
+		{
+			name: "invalid duration",
+			ics: "BEGIN:VCALENDAR\nBEGIN:VEVENT\nSUMMARY:Sync\n" +
+				"DTSTART:20220601T100000\nDURATION:1h\nEND:VEVENT\n",
+			err: `event "Sync": invalid duration 1h`,
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ics.Read(strings.NewReader(tt.ics), time.UTC)
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestMapping(t *testing.T) {
+	m, err := ics.LoadMapping(strings.NewReader(heredoc.Doc(`
+		rules:
+		  - title: (?i)^lunch
+		    skip: true
+		  - title: ^\[(\w+)\] (.*)
+		    project: Clients
+		    task: Meetings
+		    tags: [Meeting]
+		    billable: true
+		    description: "$1: $2"
+		  - title: (?i)stand-?up
+		    project: Internal
+	`)))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	r, d, ok := m.Match("Lunch with the team")
+	assert.True(t, ok)
+	assert.True(t, r.Skip)
+	assert.Equal(t, "Lunch with the team", d)
+
+	r, d, ok = m.Match("[Acme] Review of the website")
+	assert.True(t, ok)
+	assert.Equal(t, "Clients", r.Project)
+	assert.Equal(t, "Meetings", r.Task)
+	assert.Equal(t, []string{"Meeting"}, r.Tags)
+	if assert.NotNil(t, r.Billable) {
+		assert.True(t, *r.Billable)
+	}
+	assert.Equal(t, "Acme: Review of the website", d)
+
+	r, d, ok = m.Match("Daily Standup")
+	assert.True(t, ok)
+	assert.Equal(t, "Internal", r.Project)
+	assert.Equal(t, "Daily Standup", d)
+
+	_, d, ok = m.Match("1:1")
+	assert.False(t, ok)
+	assert.Equal(t, "1:1", d)
+}
+
+func TestLoadMappingErrors(t *testing.T) {
+	tts := []struct {
+		name string
+		yaml string
+		err  string
+	}{
+		{
+			name: "without title",
+			yaml: "rules:\n  - project: Internal\n",
+			err:  "rule 1 must set the title",
+		},
+		{
+			name: "invalid title",
+			yaml: "rules:\n  - title: \"(\"\n",
+			err: "rule 1 has an invalid title: error parsing regexp: " +
+				"missing closing ): `(`",
+		},
+		{
+			name: "task without project",
+			yaml: "rules:\n  - title: x\n    task: Meetings\n",
+			err:  "rule 1 can't set the task without the project",
+		},
+		{
+			name: "unknown field",
+			yaml: "rules:\n  - title: x\n    client: Acme\n",
+			err: "failed to read the mapping file: yaml: unmarshal " +
+				"errors:\n  line 3: field client not found in type ics.Rule",
+		},
+	}
+
+	for i := range tts {
+		tt := &tts[i]
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ics.LoadMapping(strings.NewReader(tt.yaml))
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
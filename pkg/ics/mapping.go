@@ -0,0 +1,88 @@
+package ics
+
+import (
+	"io"
+	"regexp"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// Mapping are the rules to find which project, task and tags of Clockify
+// should be used for the events
+type Mapping struct {
+	Rules []Rule `yaml:"rules"`
+}
+
+// Rule maps the events with a title matching it
+type Rule struct {
+	// Title is a regular expression to match the title of the events
+	Title string `yaml:"title"`
+	// Skip ignores the events matching the rule
+	Skip bool `yaml:"skip"`
+	// Description of the time entries, can use the groups of Title (like:
+	// $1), when empty the title of the event is used
+	Description string `yaml:"description"`
+	// Project is the name or ID of the project on Clockify
+	Project string `yaml:"project"`
+	// Task is the name or ID of the task on Clockify
+	Task string `yaml:"task"`
+	// Tags are names or IDs of tags on Clockify
+	Tags []string `yaml:"tags"`
+	// Billable sets if the time entries are billable
+	Billable *bool `yaml:"billable"`
+
+	re *regexp.Regexp
+}
+
+// LoadMapping reads the rules from a YAML file
+func LoadMapping(r io.Reader) (Mapping, error) {
+	var m Mapping
+
+	d := yaml.NewDecoder(r)
+	d.KnownFields(true)
+	if err := d.Decode(&m); err != nil && err != io.EOF {
+		return m, errors.Wrap(err, "failed to read the mapping file")
+	}
+
+	for i := range m.Rules {
+		if m.Rules[i].Title == "" {
+			return m, errors.Errorf("rule %d must set the title", i+1)
+		}
+
+		re, err := regexp.Compile(m.Rules[i].Title)
+		if err != nil {
+			return m, errors.Wrapf(err,
+				"rule %d has an invalid title", i+1)
+		}
+
+		if m.Rules[i].Task != "" && m.Rules[i].Project == "" {
+			return m, errors.Errorf(
+				"rule %d can't set the task without the project", i+1)
+		}
+
+		m.Rules[i].re = re
+	}
+
+	return m, nil
+}
+
+// Match returns the first rule matching the title and the description to
+// be used, false when none matches
+func (m Mapping) Match(title string) (Rule, string, bool) {
+	for _, r := range m.Rules {
+		sm := r.re.FindStringSubmatchIndex(title)
+		if sm == nil {
+			continue
+		}
+
+		if r.Description == "" {
+			return r, title, true
+		}
+
+		return r, string(r.re.ExpandString(
+			nil, r.Description, title, sm)), true
+	}
+
+	return Rule{}, title, false
+}
@@ -0,0 +1,93 @@
+// Package idle finds for how long the user is away from the computer, and
+// keeps the periods they were away, so they can be removed from time entries
+package idle
+
+import (
+	"os/exec"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrNotSupported is returned when there is no way known to find the idle
+// time on the system
+var ErrNotSupported = errors.New("idle time is not supported on this system")
+
+// Current returns for how long the keyboard and mouse were not used, using
+// ioreg on macOS, and xprintidle (X11) or the idle monitor of GNOME on Linux
+// and BSDs
+func Current() (time.Duration, error) {
+	switch runtime.GOOS {
+	case "darwin":
+		out, err := exec.Command("ioreg", "-c", "IOHIDSystem").Output()
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to run ioreg")
+		}
+
+		return parseIOReg(string(out))
+	case "linux", "freebsd", "openbsd", "netbsd":
+		if _, err := exec.LookPath("xprintidle"); err == nil {
+			out, err := exec.Command("xprintidle").Output()
+			if err != nil {
+				return 0, errors.Wrap(err, "failed to run xprintidle")
+			}
+
+			return parseMilliseconds(string(out))
+		}
+
+		if _, err := exec.LookPath("gdbus"); err != nil {
+			return 0, ErrNotSupported
+		}
+
+		out, err := exec.Command("gdbus", "call", "--session",
+			"--dest", "org.gnome.Mutter.IdleMonitor",
+			"--object-path", "/org/gnome/Mutter/IdleMonitor/Core",
+			"--method", "org.gnome.Mutter.IdleMonitor.GetIdletime",
+		).Output()
+		if err != nil {
+			return 0, ErrNotSupported
+		}
+
+		return parseGDBus(string(out))
+	default:
+		return 0, ErrNotSupported
+	}
+}
+
+var ioregRE = regexp.MustCompile(`"HIDIdleTime"\s*=\s*([0-9]+)`)
+
+// parseIOReg reads the idle time on the output of ioreg, in nanoseconds
+func parseIOReg(out string) (time.Duration, error) {
+	m := ioregRE.FindStringSubmatch(out)
+	if m == nil {
+		return 0, errors.New("ioreg did not inform the HIDIdleTime")
+	}
+
+	n, err := strconv.ParseInt(m[1], 10, 64)
+	return time.Duration(n), errors.Wrap(err, "invalid HIDIdleTime")
+}
+
+func parseMilliseconds(out string) (time.Duration, error) {
+	n, err := strconv.ParseInt(strings.TrimSpace(out), 10, 64)
+	if err != nil {
+		return 0, errors.Errorf("invalid idle time: %s", out)
+	}
+
+	return time.Duration(n) * time.Millisecond, nil
+}
+
+var gdbusRE = regexp.MustCompile(`^\(uint64 ([0-9]+),\)$`)
+
+// parseGDBus reads the idle time answered by GNOME, like: (uint64 1500,)
+func parseGDBus(out string) (time.Duration, error) {
+	m := gdbusRE.FindStringSubmatch(strings.TrimSpace(out))
+	if m == nil {
+		return 0, errors.Errorf("invalid idle time: %s", out)
+	}
+
+	return parseMilliseconds(m[1])
+}
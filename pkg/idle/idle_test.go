@@ -0,0 +1,106 @@
+package idle
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseIdleTime(t *testing.T) {
+	d, err := parseIOReg(`    | |   "HIDIdleTime" = 1500000000
+    | |   "HIDParameters" = {}`)
+	assert.NoError(t, err)
+	assert.Equal(t, 1500*time.Millisecond, d)
+
+	d, err = parseMilliseconds("1500\n")
+	assert.NoError(t, err)
+	assert.Equal(t, 1500*time.Millisecond, d)
+
+	d, err = parseGDBus("(uint64 1500,)\n")
+	assert.NoError(t, err)
+	assert.Equal(t, 1500*time.Millisecond, d)
+
+	_, err = parseIOReg("")
+	assert.EqualError(t, err, "ioreg did not inform the HIDIdleTime")
+
+	_, err = parseGDBus("Error: GDBus.Error")
+	assert.EqualError(t, err, "invalid idle time: Error: GDBus.Error")
+}
+
+func at(h, m int) time.Time {
+	return time.Date(2022, 6, 19, h, m, 0, 0, time.UTC)
+}
+
+func TestWatcher(t *testing.T) {
+	w := Watcher{Min: 5 * time.Minute}
+	for _, o := range []struct {
+		now  time.Time
+		idle time.Duration
+		back *Period
+	}{
+		{now: at(12, 0), idle: time.Minute},
+		// idle since 11:59
+		{now: at(12, 4), idle: 5 * time.Minute},
+		{now: at(12, 10), idle: 11 * time.Minute},
+		// used at 12:19 and idle again for long before the next check
+		{
+			now: at(12, 25), idle: 6 * time.Minute,
+			back: &Period{Start: at(11, 59), End: at(12, 10)},
+		},
+		{
+			now: at(12, 30), idle: 0,
+			back: &Period{Start: at(12, 19), End: at(12, 25)},
+		},
+		{now: at(12, 35), idle: 4 * time.Minute},
+		{now: at(12, 40), idle: 0},
+	} {
+		p, ok := w.Observe(o.now, o.idle)
+		if o.back == nil {
+			assert.False(t, ok, "at %s", o.now)
+			continue
+		}
+
+		assert.True(t, ok, "at %s", o.now)
+		assert.Equal(t, *o.back, p)
+	}
+}
+
+func TestBetween(t *testing.T) {
+	ps := []Period{
+		{Start: at(13, 0), End: at(13, 30)},
+		{Start: at(9, 0), End: at(10, 15)},
+		{Start: at(11, 0), End: at(11, 3)},
+		{Start: at(13, 20), End: at(13, 40)},
+		{Start: at(15, 0), End: at(16, 0)},
+	}
+
+	assert.Equal(t, []Period{
+		{Start: at(10, 0), End: at(10, 15)},
+		{Start: at(13, 0), End: at(13, 40)},
+	}, Between(ps, at(10, 0), at(15, 0), 5*time.Minute))
+}
+
+func TestLog(t *testing.T) {
+	l := NewLog(filepath.Join(t.TempDir(), "dir", "idle.json"))
+
+	ps, err := l.Periods()
+	assert.NoError(t, err)
+	assert.Empty(t, ps)
+
+	daysAgo := func(d int) Period {
+		return Period{
+			Start: at(9, 0).AddDate(0, 0, -d),
+			End:   at(10, 0).AddDate(0, 0, -d),
+		}
+	}
+	old, week, now := daysAgo(8), daysAgo(6), daysAgo(0)
+	for _, p := range []Period{old, week, now} {
+		assert.NoError(t, l.Add(p))
+	}
+
+	ps, err = l.Periods()
+	assert.NoError(t, err)
+	assert.Equal(t, []Period{week, now}, ps)
+}
@@ -0,0 +1,156 @@
+package idle
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Period is when the user was away from the computer
+type Period struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// Duration is how long the period is
+func (p Period) Duration() time.Duration {
+	return p.End.Sub(p.Start)
+}
+
+// MaxAge is how long the periods are kept on the Log
+const MaxAge = 7 * 24 * time.Hour
+
+// Log keeps on disk the periods the user was away, as found by a Watcher
+type Log struct {
+	path string
+}
+
+// NewLog creates a Log stored on the file
+func NewLog(path string) *Log {
+	return &Log{path: path}
+}
+
+// Periods returns the periods on the log, the oldest first
+func (l *Log) Periods() ([]Period, error) {
+	b, err := os.ReadFile(l.path)
+	if os.IsNotExist(err) {
+		return []Period{}, nil
+	}
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var ps []Period
+	if err := json.Unmarshal(b, &ps); err != nil {
+		return nil, errors.Wrapf(err, "idle log %s is not valid", l.path)
+	}
+
+	return ps, nil
+}
+
+// Add appends the period to the log, removing the ones older than MaxAge
+func (l *Log) Add(p Period) error {
+	ps, err := l.Periods()
+	if err != nil {
+		return err
+	}
+
+	keep := make([]Period, 0, len(ps)+1)
+	for _, o := range ps {
+		if p.End.Sub(o.End) <= MaxAge {
+			keep = append(keep, o)
+		}
+	}
+	keep = append(keep, Period{Start: p.Start.UTC(), End: p.End.UTC()})
+
+	b, err := json.Marshal(keep)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(l.path), 0700); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(os.WriteFile(l.path, b, 0600))
+}
+
+// Between returns the parts of the periods between start and end that are
+// at least as long as min, merging the ones overlapping, the first one first
+func Between(ps []Period, start, end time.Time, min time.Duration) []Period {
+	cs := make([]Period, 0, len(ps))
+	for _, p := range ps {
+		if p.Start.Before(start) {
+			p.Start = start
+		}
+
+		if p.End.After(end) {
+			p.End = end
+		}
+
+		if p.End.After(p.Start) {
+			cs = append(cs, p)
+		}
+	}
+
+	sort.Slice(cs, func(i, j int) bool { return cs[i].Start.Before(cs[j].Start) })
+
+	merged := make([]Period, 0, len(cs))
+	for _, p := range cs {
+		if l := len(merged) - 1; l >= 0 && !p.Start.After(merged[l].End) {
+			if p.End.After(merged[l].End) {
+				merged[l].End = p.End
+			}
+			continue
+		}
+
+		merged = append(merged, p)
+	}
+
+	r := make([]Period, 0, len(merged))
+	for _, p := range merged {
+		if p.Duration() >= min {
+			r = append(r, p)
+		}
+	}
+
+	return r
+}
+
+// Watcher finds the periods the user was away, from the idle time observed
+// from time to time
+type Watcher struct {
+	// Min is how long the user must be idle to be taken as away
+	Min time.Duration
+
+	start *time.Time
+	last  time.Time
+}
+
+// Observe informs for how long the user is idle now, returning the period
+// they were away when they are back; the period ends when they were last
+// observed idle
+func (w *Watcher) Observe(now time.Time, idle time.Duration) (Period, bool) {
+	since := now.Add(-idle)
+
+	var p Period
+	back := false
+	if w.start != nil && since.After(w.last) {
+		p, back = Period{Start: *w.start, End: w.last}, true
+		w.start = nil
+	}
+
+	if idle >= w.Min {
+		if w.start == nil {
+			w.start = &since
+		}
+		w.last = now
+	}
+
+	return p, back
+}
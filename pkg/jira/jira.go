@@ -0,0 +1,157 @@
+// Package jira reads the issues of a Jira instance and logs work on them,
+// to relate the time entries of Clockify with the issues they are about
+package jira
+
+import (
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/pkg/jsonapi"
+	"github.com/pkg/errors"
+)
+
+// Issue is an issue of Jira, with only the fields used by the CLI
+type Issue struct {
+	Key     string
+	Summary string
+}
+
+// Worklog is work logged on an issue
+type Worklog struct {
+	ID        string
+	Started   time.Time
+	TimeSpent time.Duration
+	Comment   string
+}
+
+var (
+	issueKeyRE     = regexp.MustCompile(`^[A-Z][A-Z0-9_]*-[1-9][0-9]*$`)
+	issueKeyTextRE = regexp.MustCompile(`\b[A-Z][A-Z0-9_]*-[1-9][0-9]*\b`)
+)
+
+// IsIssueKey returns true when s is a key of an issue (like: PROJ-123)
+func IsIssueKey(s string) bool {
+	return issueKeyRE.MatchString(s)
+}
+
+// FindIssueKey returns the first key of an issue on the text
+func FindIssueKey(s string) (string, bool) {
+	k := issueKeyTextRE.FindString(s)
+	return k, k != ""
+}
+
+// Client calls the REST API (version 2) of a Jira instance
+type Client struct {
+	api jsonapi.Client
+}
+
+// NewClient creates a Client for the Jira on baseURL. With an user (the
+// e-mail on Jira Cloud) the token is its API token, without one it is used
+// as a personal access token (Jira Server and Data Center)
+func NewClient(baseURL, user, token string) *Client {
+	return &Client{api: jsonapi.Client{
+		Service: "Jira",
+		BaseURL: strings.TrimSuffix(baseURL, "/") + "/rest/api/2",
+		HTTP:    &http.Client{Timeout: time.Minute},
+		Prepare: func(r *http.Request) {
+			if user != "" {
+				r.SetBasicAuth(user, token)
+				return
+			}
+
+			r.Header.Set("Authorization", "Bearer "+token)
+		},
+	}}
+}
+
+// Issue returns the issue with the key
+func (c *Client) Issue(key string) (Issue, error) {
+	var i struct {
+		Key    string `json:"key"`
+		Fields struct {
+			Summary string `json:"summary"`
+		} `json:"fields"`
+	}
+
+	if err := c.api.Do("GET", "issue/"+url.PathEscape(key)+"?fields=summary",
+		nil, &i); err != nil {
+		return Issue{}, err
+	}
+
+	return Issue{Key: i.Key, Summary: i.Fields.Summary}, nil
+}
+
+// started is the format Jira uses for the start of worklogs
+const started = "2006-01-02T15:04:05.000-0700"
+
+type apiWorklog struct {
+	ID               string `json:"id,omitempty"`
+	Started          string `json:"started"`
+	TimeSpentSeconds int64  `json:"timeSpentSeconds"`
+	Comment          string `json:"comment,omitempty"`
+}
+
+func (w apiWorklog) toWorklog() (Worklog, error) {
+	s, err := time.Parse(started, w.Started)
+	if err != nil {
+		return Worklog{}, errors.Wrapf(err,
+			"worklog %s has an invalid start", w.ID)
+	}
+
+	return Worklog{
+		ID:        w.ID,
+		Started:   s,
+		TimeSpent: time.Duration(w.TimeSpentSeconds) * time.Second,
+		Comment:   w.Comment,
+	}, nil
+}
+
+// Worklogs returns all the work logged on the issue
+func (c *Client) Worklogs(key string) ([]Worklog, error) {
+	ws := []Worklog{}
+	for {
+		var page struct {
+			StartAt  int          `json:"startAt"`
+			Total    int          `json:"total"`
+			Worklogs []apiWorklog `json:"worklogs"`
+		}
+
+		if err := c.api.Do("GET", "issue/"+url.PathEscape(key)+
+			"/worklog?startAt="+strconv.Itoa(len(ws)), nil, &page); err != nil {
+			return nil, err
+		}
+
+		for _, aw := range page.Worklogs {
+			w, err := aw.toWorklog()
+			if err != nil {
+				return nil, err
+			}
+
+			ws = append(ws, w)
+		}
+
+		if len(page.Worklogs) == 0 || len(ws) >= page.Total {
+			return ws, nil
+		}
+	}
+}
+
+// AddWorklog logs work on the issue, Jira requires it to be at least one
+// minute long
+func (c *Client) AddWorklog(key string, w Worklog) (Worklog, error) {
+	var r apiWorklog
+	if err := c.api.Do("POST", "issue/"+url.PathEscape(key)+"/worklog",
+		apiWorklog{
+			Started:          w.Started.Format(started),
+			TimeSpentSeconds: int64(w.TimeSpent / time.Second),
+			Comment:          w.Comment,
+		}, &r); err != nil {
+		return Worklog{}, err
+	}
+
+	return r.toWorklog()
+}
@@ -0,0 +1,127 @@
+package jira_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/pkg/jira"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueKeys(t *testing.T) {
+	assert.True(t, jira.IsIssueKey("PROJ-123"))
+	assert.True(t, jira.IsIssueKey("P2_X-1"))
+	assert.False(t, jira.IsIssueKey("proj-123"))
+	assert.False(t, jira.IsIssueKey("PROJ-0"))
+	assert.False(t, jira.IsIssueKey("PROJ-123 docs"))
+
+	k, ok := jira.FindIssueKey("fixing PROJ-42: docs of CLI-7")
+	assert.True(t, ok)
+	assert.Equal(t, "PROJ-42", k)
+
+	_, ok = jira.FindIssueKey("writing docs")
+	assert.False(t, ok)
+}
+
+func TestClient(t *testing.T) {
+	var added map[string]interface{}
+	s := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			u, p, _ := r.BasicAuth()
+			if u != "me@example.com" || p != "a-token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			switch r.Method + " " + r.URL.Path {
+			case "GET /rest/api/2/issue/PROJ-1":
+				_, _ = w.Write([]byte(
+					`{"key":"PROJ-1","fields":{"summary":"Write docs"}}`))
+			case "GET /rest/api/2/issue/PROJ-1/worklog":
+				if r.URL.Query().Get("startAt") == "0" {
+					_, _ = w.Write([]byte(`{"startAt":0,"total":2,"worklogs":[
+						{"id":"1","started":"2022-06-01T09:00:00.000+0000",
+						 "timeSpentSeconds":3600,"comment":"first"}]}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"startAt":1,"total":2,"worklogs":[
+					{"id":"2","started":"2022-06-02T09:00:00.000+0000",
+					 "timeSpentSeconds":60}]}`))
+			case "POST /rest/api/2/issue/PROJ-1/worklog":
+				_ = json.NewDecoder(r.Body).Decode(&added)
+				_, _ = w.Write([]byte(`{"id":"3",` +
+					`"started":"2022-06-03T09:00:00.000+0000",` +
+					`"timeSpentSeconds":1800,"comment":"docs"}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+	defer s.Close()
+
+	c := jira.NewClient(s.URL+"/", "me@example.com", "a-token")
+
+	i, err := c.Issue("PROJ-1")
+	assert.NoError(t, err)
+	assert.Equal(t, jira.Issue{Key: "PROJ-1", Summary: "Write docs"}, i)
+
+	_, err = c.Issue("PROJ-2")
+	assert.EqualError(t, err, "jira API did not find issue/PROJ-2")
+
+	ws, err := c.Worklogs("PROJ-1")
+	assert.NoError(t, err)
+	assert.Equal(t, []jira.Worklog{
+		{
+			ID:        "1",
+			Started:   time.Date(2022, 6, 1, 9, 0, 0, 0, time.UTC),
+			TimeSpent: time.Hour,
+			Comment:   "first",
+		},
+		{
+			ID:        "2",
+			Started:   time.Date(2022, 6, 2, 9, 0, 0, 0, time.UTC),
+			TimeSpent: time.Minute,
+		},
+	}, toUTC(ws))
+
+	w, err := c.AddWorklog("PROJ-1", jira.Worklog{
+		Started:   time.Date(2022, 6, 3, 9, 0, 0, 0, time.UTC),
+		TimeSpent: 30 * time.Minute,
+		Comment:   "docs",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "3", w.ID)
+	assert.Equal(t, map[string]interface{}{
+		"started":          "2022-06-03T09:00:00.000+0000",
+		"timeSpentSeconds": float64(1800),
+		"comment":          "docs",
+	}, added)
+
+	_, err = jira.NewClient(s.URL, "me@example.com", "other").
+		Issue("PROJ-1")
+	assert.EqualError(t, err,
+		"jira API answered 401 Unauthorized for issue/PROJ-1")
+}
+
+func TestClientWithPersonalAccessToken(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "Bearer a-pat", r.Header.Get("Authorization"))
+			_, _ = w.Write([]byte(
+				`{"key":"PROJ-1","fields":{"summary":"Write docs"}}`))
+		}))
+	defer s.Close()
+
+	i, err := jira.NewClient(s.URL, "", "a-pat").Issue("PROJ-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "Write docs", i.Summary)
+}
+
+func toUTC(ws []jira.Worklog) []jira.Worklog {
+	for i := range ws {
+		ws[i].Started = ws[i].Started.UTC()
+	}
+	return ws
+}
@@ -0,0 +1,238 @@
+package journal
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+type client struct {
+	api.Client
+	journal *Journal
+	session string
+	// known are the time entries as they were last read or changed through
+	// the client, so their state before a change does not need to be
+	// fetched again
+	known map[string]dto.TimeEntryImpl
+}
+
+// NewClient decorates the api.Client to record on the Journal the time
+// entries created, edited, ended and deleted through it, grouping all the
+// changes done by it on the same entry.
+//
+// Failing to record a change does not fail it, as it was already done.
+func NewClient(c api.Client, j *Journal) api.Client {
+	return &client{
+		Client:  c,
+		journal: j,
+		session: strconv.FormatInt(j.now().UnixNano(), 36),
+		known:   map[string]dto.TimeEntryImpl{},
+	}
+}
+
+// Unwrap returns the api.Client decorated by NewClient, so changes done
+// with it are not recorded
+func Unwrap(c api.Client) api.Client {
+	if jc, ok := c.(*client); ok {
+		return jc.Client
+	}
+
+	return c
+}
+
+func (c *client) SetDebugLogger(logger api.Logger) api.Client {
+	c.Client.SetDebugLogger(logger)
+	return c
+}
+
+func (c *client) SetInfoLogger(logger api.Logger) api.Client {
+	c.Client.SetInfoLogger(logger)
+	return c
+}
+
+func (c *client) SetMaxRetries(n int) api.Client {
+	c.Client.SetMaxRetries(n)
+	return c
+}
+
+func (c *client) SetRetryWait(d time.Duration) api.Client {
+	c.Client.SetRetryWait(d)
+	return c
+}
+
+func (c *client) SetTimeout(d time.Duration) api.Client {
+	c.Client.SetTimeout(d)
+	return c
+}
+
+func (c *client) SetCacheDir(dir string) api.Client {
+	c.Client.SetCacheDir(dir)
+	return c
+}
+
+func (c *client) SetHTTPTrace(out io.Writer) api.Client {
+	c.Client.SetHTTPTrace(out)
+	return c
+}
+
+func (c *client) SetTransport(t http.RoundTripper) api.Client {
+	c.Client.SetTransport(t)
+	return c
+}
+
+func (c *client) record(before, after *dto.TimeEntryImpl) {
+	if after != nil {
+		c.remember(*after)
+	} else {
+		delete(c.known, before.WorkspaceID+"/"+before.ID)
+	}
+
+	_ = c.journal.Record(c.session, Change{Before: before, After: after})
+}
+
+func (c *client) remember(tes ...dto.TimeEntryImpl) {
+	for i := range tes {
+		c.known[tes[i].WorkspaceID+"/"+tes[i].ID] = tes[i]
+	}
+}
+
+// get returns the time entry before changing it, fetching it only if it
+// was not read through the client already, or nil if it can't
+func (c *client) get(
+	ctx context.Context, workspace, id string) *dto.TimeEntryImpl {
+	if te, ok := c.known[workspace+"/"+id]; ok {
+		return &te
+	}
+
+	te, err := c.Client.GetTimeEntry(ctx, api.GetTimeEntryParam{
+		Workspace:   workspace,
+		TimeEntryID: id,
+	})
+	if err != nil {
+		return nil
+	}
+
+	return te
+}
+
+func (c *client) GetTimeEntry(ctx context.Context, p api.GetTimeEntryParam) (
+	*dto.TimeEntryImpl, error) {
+	te, err := c.Client.GetTimeEntry(ctx, p)
+	if err == nil && te != nil {
+		c.remember(*te)
+	}
+
+	return te, err
+}
+
+func (c *client) GetTimeEntryInProgress(
+	ctx context.Context, p api.GetTimeEntryInProgressParam,
+) (*dto.TimeEntryImpl, error) {
+	te, err := c.Client.GetTimeEntryInProgress(ctx, p)
+	if err == nil && te != nil {
+		c.remember(*te)
+	}
+
+	return te, err
+}
+
+func (c *client) GetUserTimeEntries(
+	ctx context.Context, p api.GetUserTimeEntriesParam,
+) ([]dto.TimeEntryImpl, error) {
+	tes, err := c.Client.GetUserTimeEntries(ctx, p)
+	if err == nil {
+		c.remember(tes...)
+	}
+
+	return tes, err
+}
+
+func (c *client) CreateTimeEntry(
+	ctx context.Context, p api.CreateTimeEntryParam,
+) (dto.TimeEntryImpl, error) {
+	te, err := c.Client.CreateTimeEntry(ctx, p)
+	if err == nil {
+		c.record(nil, &te)
+	}
+
+	return te, err
+}
+
+func (c *client) UpdateTimeEntry(
+	ctx context.Context, p api.UpdateTimeEntryParam,
+) (dto.TimeEntryImpl, error) {
+	b := c.get(ctx, p.Workspace, p.TimeEntryID)
+	te, err := c.Client.UpdateTimeEntry(ctx, p)
+	if err == nil && b != nil {
+		c.record(b, &te)
+	}
+
+	return te, err
+}
+
+// UpdateTimeEntries records the changes using the time entries as they
+// were read through the client (like edit-multiple does), the ones not read
+// before are fetched
+func (c *client) UpdateTimeEntries(
+	ctx context.Context, p api.UpdateTimeEntriesParam,
+) ([]dto.TimeEntryImpl, error) {
+	bs := make(map[string]*dto.TimeEntryImpl, len(p.TimeEntries))
+	for _, u := range p.TimeEntries {
+		bs[u.TimeEntryID] = c.get(ctx, p.Workspace, u.TimeEntryID)
+	}
+
+	tes, err := c.Client.UpdateTimeEntries(ctx, p)
+	for i := range tes {
+		if b := bs[tes[i].ID]; b != nil {
+			c.record(b, &tes[i])
+		}
+	}
+
+	return tes, err
+}
+
+func (c *client) Out(ctx context.Context, p api.OutParam) error {
+	b, _ := c.GetTimeEntryInProgress(ctx, api.GetTimeEntryInProgressParam{
+		Workspace: p.Workspace,
+		UserID:    p.UserID,
+	})
+
+	err := c.Client.Out(ctx, p)
+	if err == nil && b != nil {
+		a := *b
+		end := p.End
+		a.TimeInterval = dto.NewTimeInterval(b.TimeInterval.Start, &end)
+		c.record(b, &a)
+	}
+
+	return err
+}
+
+func (c *client) DeleteTimeEntry(
+	ctx context.Context, p api.DeleteTimeEntryParam) error {
+	b := c.get(ctx, p.Workspace, p.TimeEntryID)
+	err := c.Client.DeleteTimeEntry(ctx, p)
+	if err == nil && b != nil {
+		c.record(b, nil)
+	}
+
+	return err
+}
+
+// DeleteTimeEntries records the time entries as returned by the API, so
+// they are not fetched before being deleted
+func (c *client) DeleteTimeEntries(
+	ctx context.Context, p api.DeleteTimeEntriesParam,
+) ([]dto.TimeEntryImpl, error) {
+	tes, err := c.Client.DeleteTimeEntries(ctx, p)
+	for i := range tes {
+		c.record(&tes[i], nil)
+	}
+
+	return tes, err
+}
@@ -0,0 +1,138 @@
+package journal
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/pkg/errors"
+)
+
+// Change is a time entry before and after being changed, a created one has
+// no Before and a deleted one has no After
+type Change struct {
+	Before *dto.TimeEntryImpl `json:"before,omitempty"`
+	After  *dto.TimeEntryImpl `json:"after,omitempty"`
+}
+
+const (
+	KindCreated = "created"
+	KindEdited  = "edited"
+	KindDeleted = "deleted"
+)
+
+// Kind returns if the time entry was created, edited or deleted
+func (c Change) Kind() string {
+	switch {
+	case c.Before == nil:
+		return KindCreated
+	case c.After == nil:
+		return KindDeleted
+	default:
+		return KindEdited
+	}
+}
+
+// TimeEntry returns the time entry as it is after the change, or before it
+// if it was deleted
+func (c Change) TimeEntry() dto.TimeEntryImpl {
+	if c.After != nil {
+		return *c.After
+	}
+
+	return *c.Before
+}
+
+// Entry are the changes done by one execution of the CLI
+type Entry struct {
+	Session string    `json:"session"`
+	At      time.Time `json:"at"`
+	Changes []Change  `json:"changes"`
+}
+
+// Journal keeps on disk the changes done to time entries by the last
+// executions of the CLI, so they can be undone
+type Journal struct {
+	path string
+	size int
+	now  func() time.Time
+}
+
+// New creates a Journal stored on the file, keeping the changes of the last
+// size executions
+func New(path string, size int) *Journal {
+	return &Journal{path: path, size: size, now: time.Now}
+}
+
+// Size is how many executions are kept
+func (j *Journal) Size() int {
+	return j.size
+}
+
+// Entries returns the entries of the journal, the oldest first
+func (j *Journal) Entries() ([]Entry, error) {
+	b, err := os.ReadFile(j.path)
+	if os.IsNotExist(err) {
+		return []Entry{}, nil
+	}
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var es []Entry
+	if err := json.Unmarshal(b, &es); err != nil {
+		return nil, errors.Wrapf(err, "journal %s is not valid", j.path)
+	}
+
+	return es, nil
+}
+
+// Record adds the change to the entry of the session, creating a new entry
+// if the last one is from another session
+func (j *Journal) Record(session string, c Change) error {
+	es, err := j.Entries()
+	if err != nil {
+		return err
+	}
+
+	if l := len(es) - 1; l >= 0 && es[l].Session == session {
+		es[l].Changes = append(es[l].Changes, c)
+		return j.save(es)
+	}
+
+	return j.save(append(es, Entry{
+		Session: session,
+		At:      j.now().UTC(),
+		Changes: []Change{c},
+	}))
+}
+
+// Clear removes all the entries
+func (j *Journal) Clear() error {
+	err := os.Remove(j.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	return errors.WithStack(err)
+}
+
+func (j *Journal) save(es []Entry) error {
+	if len(es) > j.size {
+		es = es[len(es)-j.size:]
+	}
+
+	b, err := json.Marshal(es)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(j.path), 0700); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(os.WriteFile(j.path, b, 0600))
+}
@@ -0,0 +1,277 @@
+package journal_test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/internal/mocks"
+	"github.com/lucassabreu/clockify-cli/pkg/journal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTE(id, description string) dto.TimeEntryImpl {
+	end := time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC)
+	return dto.TimeEntryImpl{
+		ID:          id,
+		WorkspaceID: "w",
+		Description: description,
+		TimeInterval: dto.NewTimeInterval(
+			end.Add(-time.Hour), &end),
+	}
+}
+
+func TestClientRecordsAndUndo(t *testing.T) {
+	j := journal.New(filepath.Join(t.TempDir(), "journal.json"), 2)
+	m := mocks.NewMockClient(t)
+	c := journal.NewClient(m, j)
+
+	created := newTE("te1", "coding")
+	m.EXPECT().CreateTimeEntry(mock.Anything, api.CreateTimeEntryParam{
+		Workspace:   "w",
+		Description: "coding",
+	}).Return(created, nil).Once()
+
+	_, err := c.CreateTimeEntry(context.Background(), api.CreateTimeEntryParam{
+		Workspace:   "w",
+		Description: "coding",
+	})
+	assert.NoError(t, err)
+
+	before := newTE("te2", "meeting")
+	after := newTE("te2", "planning")
+	m.EXPECT().GetTimeEntry(mock.Anything, api.GetTimeEntryParam{
+		Workspace:   "w",
+		TimeEntryID: "te2",
+	}).Return(&before, nil).Once()
+	m.EXPECT().UpdateTimeEntry(mock.Anything, api.UpdateTimeEntryParam{
+		Workspace:   "w",
+		TimeEntryID: "te2",
+		Description: "planning",
+	}).Return(after, nil).Once()
+
+	_, err = c.UpdateTimeEntry(context.Background(), api.UpdateTimeEntryParam{
+		Workspace:   "w",
+		TimeEntryID: "te2",
+		Description: "planning",
+	})
+	assert.NoError(t, err)
+
+	deleted := newTE("te3", "lunch")
+	deleted.Billable = true
+	m.EXPECT().GetTimeEntry(mock.Anything, api.GetTimeEntryParam{
+		Workspace:   "w",
+		TimeEntryID: "te3",
+	}).Return(&deleted, nil).Once()
+	m.EXPECT().DeleteTimeEntry(mock.Anything, api.DeleteTimeEntryParam{
+		Workspace:   "w",
+		TimeEntryID: "te3",
+	}).Return(nil).Once()
+
+	assert.NoError(t, c.DeleteTimeEntry(context.Background(),
+		api.DeleteTimeEntryParam{
+			Workspace:   "w",
+			TimeEntryID: "te3",
+		}))
+
+	es, err := j.Entries()
+	assert.NoError(t, err)
+	if assert.Len(t, es, 1, "changes should be on the same entry") {
+		assert.Equal(t, []journal.Change{
+			{After: &created},
+			{Before: &before, After: &after},
+			{Before: &deleted},
+		}, es[0].Changes)
+	}
+
+	recreated := newTE("te4", "lunch")
+	bTrue := true
+	m.EXPECT().CreateTimeEntry(mock.Anything, api.CreateTimeEntryParam{
+		Workspace:   "w",
+		Start:       deleted.TimeInterval.Start,
+		End:         deleted.TimeInterval.End,
+		Billable:    &bTrue,
+		Description: "lunch",
+	}).Return(recreated, nil).Once()
+	m.EXPECT().UpdateTimeEntry(mock.Anything, api.UpdateTimeEntryParam{
+		Workspace:   "w",
+		TimeEntryID: "te2",
+		Start:       before.TimeInterval.Start,
+		End:         before.TimeInterval.End,
+		Description: "meeting",
+	}).Return(before, nil).Once()
+	m.EXPECT().DeleteTimeEntry(mock.Anything, api.DeleteTimeEntryParam{
+		Workspace:   "w",
+		TimeEntryID: "te1",
+	}).Return(nil).Once()
+
+	cs, err := j.Undo(context.Background(), c)
+	assert.NoError(t, err)
+	assert.Equal(t, []journal.Change{
+		{After: &recreated},
+		{Before: &after, After: &before},
+		{Before: &created},
+	}, cs)
+
+	es, err = j.Entries()
+	assert.NoError(t, err)
+	assert.Len(t, es, 0)
+
+	_, err = j.Undo(context.Background(), c)
+	assert.Equal(t, journal.ErrNothingToUndo, err)
+}
+
+func TestJournalKeepsTheLastExecutions(t *testing.T) {
+	j := journal.New(filepath.Join(t.TempDir(), "journal.json"), 2)
+	for _, s := range []string{"a", "b", "c"} {
+		te := newTE("te-"+s, s)
+		assert.NoError(t, j.Record(s, journal.Change{After: &te}))
+	}
+
+	es, err := j.Entries()
+	assert.NoError(t, err)
+	if assert.Len(t, es, 2) {
+		assert.Equal(t, "b", es[0].Session)
+		assert.Equal(t, "c", es[1].Session)
+	}
+}
+
+func TestUndoReplacesIDsOfRecreatedTimeEntries(t *testing.T) {
+	j := journal.New(filepath.Join(t.TempDir(), "journal.json"), 10)
+
+	b := newTE("te1", "coding")
+	a := newTE("te1", "design")
+	assert.NoError(t, j.Record("a", journal.Change{Before: &b, After: &a}))
+	assert.NoError(t, j.Record("b", journal.Change{Before: &a}))
+
+	other := newTE("te2", "other")
+	assert.NoError(t, j.Record("c", journal.Change{After: &other}))
+	assert.NoError(t, j.Record("c", journal.Change{Before: &a}))
+
+	m := mocks.NewMockClient(t)
+	m.EXPECT().CreateTimeEntry(mock.Anything, api.CreateTimeEntryParam{
+		Workspace:   "w",
+		Start:       a.TimeInterval.Start,
+		End:         a.TimeInterval.End,
+		Billable:    &a.Billable,
+		Description: "design",
+	}).Return(newTE("te3", "design"), nil).Once()
+	m.EXPECT().DeleteTimeEntry(mock.Anything, api.DeleteTimeEntryParam{
+		Workspace:   "w",
+		TimeEntryID: "te2",
+	}).Return(errors.New("locked")).Once()
+
+	_, err := j.Undo(context.Background(), m)
+	assert.EqualError(t, err, "locked")
+
+	es, err := j.Entries()
+	assert.NoError(t, err)
+	if assert.Len(t, es, 3) {
+		assert.Equal(t, "te3", es[0].Changes[0].Before.ID)
+		assert.Equal(t, "te3", es[1].Changes[0].Before.ID)
+		assert.Len(t, es[2].Changes, 1,
+			"only the change not reverted should be kept")
+		assert.Equal(t, "te2", es[2].Changes[0].TimeEntry().ID)
+	}
+}
+
+func TestBulkChangesDoNotFetchTheTimeEntries(t *testing.T) {
+	j := journal.New(filepath.Join(t.TempDir(), "journal.json"), 2)
+	m := mocks.NewMockClient(t)
+	c := journal.NewClient(m, j)
+
+	before := newTE("te1", "meeting")
+	after := newTE("te1", "planning")
+	m.EXPECT().GetTimeEntry(mock.Anything, api.GetTimeEntryParam{
+		Workspace:   "w",
+		TimeEntryID: "te1",
+	}).Return(&before, nil).Once()
+
+	_, err := c.GetTimeEntry(context.Background(), api.GetTimeEntryParam{
+		Workspace:   "w",
+		TimeEntryID: "te1",
+	})
+	assert.NoError(t, err)
+
+	p := api.UpdateTimeEntriesParam{
+		Workspace: "w",
+		UserID:    "u",
+		TimeEntries: []api.UpdateTimeEntryParam{
+			{Workspace: "w", TimeEntryID: "te1", Description: "planning"},
+		},
+	}
+	m.EXPECT().UpdateTimeEntries(mock.Anything, p).
+		Return([]dto.TimeEntryImpl{after}, nil).Once()
+
+	_, err = c.UpdateTimeEntries(context.Background(), p)
+	assert.NoError(t, err)
+
+	deleted := []dto.TimeEntryImpl{after, newTE("te2", "lunch")}
+	m.EXPECT().DeleteTimeEntries(mock.Anything, api.DeleteTimeEntriesParam{
+		Workspace:    "w",
+		UserID:       "u",
+		TimeEntryIDs: []string{"te1", "te2"},
+	}).Return(deleted, nil).Once()
+
+	_, err = c.DeleteTimeEntries(context.Background(),
+		api.DeleteTimeEntriesParam{
+			Workspace:    "w",
+			UserID:       "u",
+			TimeEntryIDs: []string{"te1", "te2"},
+		})
+	assert.NoError(t, err)
+
+	es, err := j.Entries()
+	assert.NoError(t, err)
+	if assert.Len(t, es, 1) {
+		assert.Equal(t, []journal.Change{
+			{Before: &before, After: &after},
+			{Before: &deleted[0]},
+			{Before: &deleted[1]},
+		}, es[0].Changes)
+	}
+}
+
+func TestUndoTimeEntryChangedMoreThanOnce(t *testing.T) {
+	j := journal.New(filepath.Join(t.TempDir(), "journal.json"), 10)
+
+	b := newTE("te1", "coding")
+	a := newTE("te1", "design")
+	a.CustomFields = []dto.TimeEntryCustomField{
+		{CustomFieldID: "cf1", Value: "JIRA-12"},
+		{CustomFieldID: "cf2"},
+	}
+	assert.NoError(t, j.Record("a", journal.Change{Before: &b, After: &a}))
+	assert.NoError(t, j.Record("a", journal.Change{Before: &a}))
+
+	m := mocks.NewMockClient(t)
+	m.EXPECT().CreateTimeEntry(mock.Anything, api.CreateTimeEntryParam{
+		Workspace:   "w",
+		Start:       a.TimeInterval.Start,
+		End:         a.TimeInterval.End,
+		Billable:    &a.Billable,
+		Description: "design",
+		CustomFields: []dto.CustomFieldValue{
+			{CustomFieldID: "cf1", Value: "JIRA-12"},
+		},
+	}).Return(newTE("te3", "design"), nil).Once()
+	m.EXPECT().UpdateTimeEntry(mock.Anything, api.UpdateTimeEntryParam{
+		Workspace:   "w",
+		TimeEntryID: "te3",
+		Start:       b.TimeInterval.Start,
+		End:         b.TimeInterval.End,
+		Description: "coding",
+	}).Return(newTE("te3", "coding"), nil).Once()
+
+	_, err := j.Undo(context.Background(), m)
+	assert.NoError(t, err)
+
+	es, err := j.Entries()
+	assert.NoError(t, err)
+	assert.Len(t, es, 0)
+}
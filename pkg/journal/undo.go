@@ -0,0 +1,150 @@
+package journal
+
+import (
+	"context"
+	"errors"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// ErrNothingToUndo is returned when the journal is empty
+var ErrNothingToUndo = errors.New("there is nothing to undo")
+
+// Undo reverts the changes of the last entry of the journal, from the last
+// change to the first, and removes it from the journal. It returns the
+// changes done to revert them.
+//
+// Time entries deleted are created again with a new ID, which is replaced on
+// the changes not reverted yet and on the other entries of the journal.
+//
+// If a change fails to be reverted, the entry is kept only with the changes
+// not reverted yet.
+func (j *Journal) Undo(ctx context.Context, c api.Client) ([]Change, error) {
+	es, err := j.Entries()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(es) == 0 {
+		return nil, ErrNothingToUndo
+	}
+
+	c = Unwrap(c)
+	l := len(es) - 1
+	ids := map[string]string{}
+	undone := make([]Change, 0, len(es[l].Changes))
+	for i := len(es[l].Changes) - 1; i >= 0; i-- {
+		// the same time entry may have been changed more than once
+		ch := es[l].Changes[i]
+		replaceID(ch.Before, ids)
+		replaceID(ch.After, ids)
+
+		var u Change
+		if u, err = revert(ctx, c, ch); err != nil {
+			es[l].Changes = es[l].Changes[:i+1]
+			break
+		}
+
+		undone = append(undone, u)
+		if u.Kind() == KindCreated {
+			old := ch.Before.ID
+			for k, id := range ids {
+				if id == old {
+					ids[k] = u.After.ID
+				}
+			}
+			ids[u.After.WorkspaceID+"/"+old] = u.After.ID
+		}
+	}
+
+	if err == nil {
+		es = es[:l]
+	}
+
+	for i := range es {
+		for k := range es[i].Changes {
+			replaceID(es[i].Changes[k].Before, ids)
+			replaceID(es[i].Changes[k].After, ids)
+		}
+	}
+
+	if serr := j.save(es); err == nil {
+		err = serr
+	}
+
+	return undone, err
+}
+
+func replaceID(te *dto.TimeEntryImpl, ids map[string]string) {
+	if te == nil {
+		return
+	}
+
+	if id, ok := ids[te.WorkspaceID+"/"+te.ID]; ok {
+		te.ID = id
+	}
+}
+
+// revert does the opposite of the change, returning what was done
+func revert(ctx context.Context, c api.Client, ch Change) (Change, error) {
+	switch ch.Kind() {
+	case KindCreated:
+		return Change{Before: ch.After}, c.DeleteTimeEntry(
+			ctx,
+			api.DeleteTimeEntryParam{
+				Workspace:   ch.After.WorkspaceID,
+				TimeEntryID: ch.After.ID,
+			})
+	case KindDeleted:
+		b := ch.Before.Billable
+		te, err := c.CreateTimeEntry(ctx, api.CreateTimeEntryParam{
+			Workspace:   ch.Before.WorkspaceID,
+			Start:       ch.Before.TimeInterval.Start,
+			End:         ch.Before.TimeInterval.End,
+			Billable:    &b,
+			Description: ch.Before.Description,
+			ProjectID:   ch.Before.ProjectID,
+			TaskID:      ch.Before.TaskID,
+			TagIDs:      ch.Before.TagIDs,
+
+			CustomFields: customFields(ch.Before),
+		})
+		return Change{After: &te}, err
+	default:
+		te, err := c.UpdateTimeEntry(ctx, api.UpdateTimeEntryParam{
+			Workspace:   ch.Before.WorkspaceID,
+			TimeEntryID: ch.After.ID,
+			Start:       ch.Before.TimeInterval.Start,
+			End:         ch.Before.TimeInterval.End,
+			Billable:    ch.Before.Billable,
+			Description: ch.Before.Description,
+			ProjectID:   ch.Before.ProjectID,
+			TaskID:      ch.Before.TaskID,
+			TagIDs:      ch.Before.TagIDs,
+
+			CustomFields: customFields(ch.Before),
+		})
+		return Change{Before: ch.After, After: &te}, err
+	}
+}
+
+// customFields returns the values of the custom fields of the time entry to
+// set them again
+func customFields(te *dto.TimeEntryImpl) []dto.CustomFieldValue {
+	if len(te.CustomFields) == 0 {
+		return nil
+	}
+
+	cfs := make([]dto.CustomFieldValue, 0, len(te.CustomFields))
+	for _, cf := range te.CustomFields {
+		if cf.Value != nil {
+			cfs = append(cfs, dto.CustomFieldValue{
+				CustomFieldID: cf.CustomFieldID,
+				Value:         cf.Value,
+			})
+		}
+	}
+
+	return cfs
+}
@@ -0,0 +1,78 @@
+// Package jsonapi calls the JSON APIs of the services integrated with the
+// CLI (like GitHub, GitLab and Jira), reporting their failures the same way
+package jsonapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Client sends requests to the API of a service
+type Client struct {
+	// Service is the name of the service, used on the error messages
+	Service string
+	// BaseURL is prefixed to the paths of the requests
+	BaseURL string
+	// HTTP is the client used to send the requests
+	HTTP *http.Client
+	// Prepare is called before sending each request, to set its credentials
+	// and headers (if any)
+	Prepare func(*http.Request)
+}
+
+// Do sends the body (if any) encoded as JSON to the path, and decodes the
+// response into v
+func (c Client) Do(method, path string, body, v interface{}) error {
+	var b io.Reader
+	if body != nil {
+		buf := new(bytes.Buffer)
+		if err := json.NewEncoder(buf).Encode(body); err != nil {
+			return errors.WithStack(err)
+		}
+		b = buf
+	}
+
+	req, err := http.NewRequest(method, c.BaseURL+"/"+path, b)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if c.Prepare != nil {
+		c.Prepare(req)
+	}
+
+	res, err := c.HTTP.Do(req)
+	if err != nil {
+		// not wrapped, so it is not taken as Clockify being unreachable
+		return errors.Errorf("failed to call the %s API: %s", c.Service, err)
+	}
+	defer res.Body.Close()
+
+	p := strings.SplitN(path, "?", 2)[0]
+	if u, err := url.PathUnescape(p); err == nil {
+		p = u
+	}
+
+	name := strings.ToLower(c.Service)
+	switch {
+	case res.StatusCode == http.StatusNotFound:
+		return errors.Errorf("%s API did not find %s", name, p)
+	case res.StatusCode < 200 || res.StatusCode > 299:
+		return errors.Errorf("%s API answered %s for %s",
+			name, res.Status, p)
+	}
+
+	return errors.Wrapf(json.NewDecoder(res.Body).Decode(v),
+		"failed to read the %s API response", c.Service)
+}
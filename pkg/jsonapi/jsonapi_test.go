@@ -0,0 +1,71 @@
+package jsonapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/pkg/jsonapi"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDo(t *testing.T) {
+	var sent map[string]string
+	s := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("X-Token") != "a-token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			switch r.Method + " " + r.URL.Path {
+			case "POST /v1/notes":
+				assert.Equal(t, "application/json",
+					r.Header.Get("Content-Type"))
+				_ = json.NewDecoder(r.Body).Decode(&sent)
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(`{"id":"n1"}`))
+			case "GET /v1/notes/n1":
+				_, _ = w.Write([]byte(`{"id":"n1"}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+	defer s.Close()
+
+	c := jsonapi.Client{
+		Service: "Notes",
+		BaseURL: s.URL + "/v1",
+		HTTP:    &http.Client{Timeout: time.Minute},
+		Prepare: func(r *http.Request) { r.Header.Set("X-Token", "a-token") },
+	}
+
+	var n struct {
+		ID string `json:"id"`
+	}
+	assert.NoError(t, c.Do("POST", "notes", map[string]string{"body": "hi"},
+		&n))
+	assert.Equal(t, map[string]string{"body": "hi"}, sent)
+	assert.Equal(t, "n1", n.ID)
+
+	n.ID = ""
+	assert.NoError(t, c.Do("GET", "notes/n1?fields=id", nil, &n))
+	assert.Equal(t, "n1", n.ID)
+
+	err := c.Do("GET", "notes/n%2F2?fields=id", nil, &n)
+	assert.EqualError(t, err, "notes API did not find notes/n/2")
+
+	c.Prepare = nil
+	err = c.Do("GET", "notes/n1", nil, &n)
+	assert.EqualError(t, err,
+		"notes API answered 401 Unauthorized for notes/n1")
+
+	s.Close()
+	err = c.Do("GET", "notes/n1", nil, &n)
+	assert.Regexp(t, "^failed to call the Notes API: ", err.Error())
+	assert.False(t, api.IsUnreachable(err),
+		"the failure of other services is not Clockify being unreachable")
+}
@@ -0,0 +1,31 @@
+package notify
+
+import (
+	"errors"
+	"os/exec"
+	"runtime"
+	"strconv"
+)
+
+// ErrNotSupported is returned when there is no way known to show desktop
+// notifications on the system
+var ErrNotSupported = errors.New("desktop notifications are not supported")
+
+// Desktop shows a notification on the desktop, using notify-send on Linux
+// and BSDs, and osascript on macOS
+func Desktop(title, message string) error {
+	switch runtime.GOOS {
+	case "darwin":
+		return exec.Command("osascript", "-e",
+			"display notification "+strconv.Quote(message)+
+				" with title "+strconv.Quote(title)).Run()
+	case "linux", "freebsd", "openbsd", "netbsd":
+		if _, err := exec.LookPath("notify-send"); err != nil {
+			return ErrNotSupported
+		}
+
+		return exec.Command("notify-send", title, message).Run()
+	default:
+		return ErrNotSupported
+	}
+}
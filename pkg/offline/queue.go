@@ -0,0 +1,100 @@
+package offline
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/pkg/errors"
+)
+
+const (
+	KindIn     = "in"
+	KindManual = "manual"
+	KindOut    = "out"
+)
+
+// Operation is an "in", "manual" or "out" done while the API was not
+// reachable, with the values informed by the user (names are not resolved
+// to IDs yet)
+type Operation struct {
+	Kind         string                 `json:"kind"`
+	QueuedAt     time.Time              `json:"queuedAt"`
+	Workspace    string                 `json:"workspace"`
+	UserID       string                 `json:"userId"`
+	ProjectID    string                 `json:"project,omitempty"`
+	TaskID       string                 `json:"task,omitempty"`
+	Description  string                 `json:"description,omitempty"`
+	Start        time.Time              `json:"start"`
+	End          *time.Time             `json:"end,omitempty"`
+	TagIDs       []string               `json:"tags,omitempty"`
+	Billable     *bool                  `json:"billable,omitempty"`
+	CustomFields []dto.CustomFieldValue `json:"customFields,omitempty"`
+}
+
+// Queue keeps on disk the operations done offline, so they can be sent to
+// the API later, in the same order
+type Queue struct {
+	path string
+	now  func() time.Time
+}
+
+// New creates a Queue stored on the file
+func New(path string) *Queue {
+	return &Queue{path: path, now: time.Now}
+}
+
+// Operations returns the operations queued, the oldest first
+func (q *Queue) Operations() ([]Operation, error) {
+	b, err := os.ReadFile(q.path)
+	if os.IsNotExist(err) {
+		return []Operation{}, nil
+	}
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var ops []Operation
+	if err := json.Unmarshal(b, &ops); err != nil {
+		return nil, errors.Wrapf(err, "queue %s is not valid", q.path)
+	}
+
+	return ops, nil
+}
+
+// Add appends the operation to the end of the queue
+func (q *Queue) Add(op Operation) error {
+	ops, err := q.Operations()
+	if err != nil {
+		return err
+	}
+
+	op.QueuedAt = q.now().UTC()
+	return q.Save(append(ops, op))
+}
+
+// Save replaces the operations on the queue, removing it when there are none
+func (q *Queue) Save(ops []Operation) error {
+	if len(ops) == 0 {
+		err := os.Remove(q.path)
+		if os.IsNotExist(err) {
+			return nil
+		}
+
+		return errors.WithStack(err)
+	}
+
+	b, err := json.Marshal(ops)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(q.path), 0700); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(os.WriteFile(q.path, b, 0600))
+}
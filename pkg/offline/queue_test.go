@@ -0,0 +1,58 @@
+package offline_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/pkg/offline"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueue(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "queue.json")
+	q := offline.New(path)
+
+	ops, err := q.Operations()
+	assert.NoError(t, err)
+	assert.Len(t, ops, 0)
+
+	start := time.Date(2022, 6, 1, 9, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	assert.NoError(t, q.Add(offline.Operation{
+		Kind:        offline.KindIn,
+		Workspace:   "w",
+		UserID:      "u",
+		ProjectID:   "Clockify CLI",
+		Description: "docs",
+		Start:       start,
+	}))
+	assert.NoError(t, q.Add(offline.Operation{
+		Kind:      offline.KindOut,
+		Workspace: "w",
+		UserID:    "u",
+		End:       &end,
+	}))
+
+	ops, err = q.Operations()
+	assert.NoError(t, err)
+	if assert.Len(t, ops, 2) {
+		assert.Equal(t, offline.KindIn, ops[0].Kind)
+		assert.Equal(t, "Clockify CLI", ops[0].ProjectID)
+		assert.Equal(t, start, ops[0].Start)
+		assert.False(t, ops[0].QueuedAt.IsZero())
+
+		assert.Equal(t, offline.KindOut, ops[1].Kind)
+		assert.Equal(t, end, *ops[1].End)
+	}
+
+	assert.NoError(t, q.Save(ops[1:]))
+	ops, err = q.Operations()
+	assert.NoError(t, err)
+	assert.Len(t, ops, 1)
+
+	assert.NoError(t, q.Save(nil))
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err), "the file should be removed")
+}
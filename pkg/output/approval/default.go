@@ -0,0 +1,41 @@
+package approval
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+	"github.com/olekukonko/tablewriter"
+)
+
+// ApprovalPrint will print more details
+func ApprovalPrint(as []dto.ApprovalRequest, w io.Writer) error {
+	tw := tablewriter.NewWriter(w)
+	util.SetThemedHeader(tw, []string{"ID", "User", "Start", "End", "State"})
+
+	lines := make([][]string, len(as))
+	for i := 0; i < len(as); i++ {
+		a := as[i]
+
+		owner := a.Owner.UserName
+		if owner == "" {
+			owner = a.Owner.UserID
+		}
+
+		lines[i] = []string{
+			a.ID,
+			owner,
+			a.DateRange.Start.UTC().Format("2006-01-02"),
+			a.DateRange.End.UTC().Format("2006-01-02"),
+			string(a.Status.State),
+		}
+	}
+
+	if width, ok := util.TerminalWidth(); ok {
+		tw.SetColWidth(width / 5)
+	}
+	tw.AppendBulk(lines)
+	tw.Render()
+
+	return nil
+}
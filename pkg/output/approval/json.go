@@ -0,0 +1,18 @@
+package approval
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// ApprovalJSONPrint will print as JSON
+func ApprovalJSONPrint(a dto.ApprovalRequest, w io.Writer) error {
+	return json.NewEncoder(w).Encode(a)
+}
+
+// ApprovalsJSONPrint will print as JSON
+func ApprovalsJSONPrint(as []dto.ApprovalRequest, w io.Writer) error {
+	return json.NewEncoder(w).Encode(as)
+}
@@ -0,0 +1,17 @@
+package approval
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// ApprovalPrintQuietly will only print the IDs
+func ApprovalPrintQuietly(as []dto.ApprovalRequest, w io.Writer) error {
+	for i := 0; i < len(as); i++ {
+		fmt.Fprintln(w, as[i].ID)
+	}
+
+	return nil
+}
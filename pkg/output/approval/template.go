@@ -0,0 +1,27 @@
+package approval
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+)
+
+// ApprovalPrintWithTemplate will print each approval request using the
+// format string
+func ApprovalPrintWithTemplate(
+	format string) func([]dto.ApprovalRequest, io.Writer) error {
+	return func(as []dto.ApprovalRequest, w io.Writer) error {
+		t, err := util.NewTemplate(format)
+		if err != nil {
+			return err
+		}
+
+		for i := 0; i < len(as); i++ {
+			if err := t.Execute(w, as[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
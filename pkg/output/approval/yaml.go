@@ -0,0 +1,13 @@
+package approval
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+)
+
+// ApprovalsYAMLPrint will print as YAML
+func ApprovalsYAMLPrint(as []dto.ApprovalRequest, w io.Writer) error {
+	return util.YAMLPrint(as, w)
+}
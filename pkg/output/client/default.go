@@ -2,17 +2,16 @@ package client
 
 import (
 	"io"
-	"os"
 
 	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
 	"github.com/olekukonko/tablewriter"
-	"golang.org/x/term"
 )
 
 // ClientPrint will print more details
 func ClientPrint(cs []dto.Client, w io.Writer) error {
 	tw := tablewriter.NewWriter(w)
-	tw.SetHeader([]string{"ID", "Name", "Archived"})
+	util.SetThemedHeader(tw, []string{"ID", "Name", "Archived"})
 
 	yesNo := map[bool]string{
 		true:  "YES",
@@ -29,7 +28,7 @@ func ClientPrint(cs []dto.Client, w io.Writer) error {
 		}
 	}
 
-	if width, _, err := term.GetSize(int(os.Stdout.Fd())); err == nil {
+	if width, ok := util.TerminalWidth(); ok {
 		tw.SetColWidth(width / 3)
 	}
 	tw.AppendBulk(lines)
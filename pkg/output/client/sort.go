@@ -0,0 +1,19 @@
+package client
+
+import (
+	"strings"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+)
+
+// SortClients sorts the clients by a spec like "name" or "name:desc", valid
+// fields are: id and name
+func SortClients(cs []dto.Client, spec string) error {
+	return util.SortSlice(cs, spec, util.SortFields{
+		"id": func(i, j int) bool { return cs[i].ID < cs[j].ID },
+		"name": func(i, j int) bool {
+			return strings.ToLower(cs[i].Name) < strings.ToLower(cs[j].Name)
+		},
+	})
+}
@@ -0,0 +1,13 @@
+package client
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+)
+
+// ClientsYAMLPrint will print as YAML
+func ClientsYAMLPrint(t []dto.Client, w io.Writer) error {
+	return util.YAMLPrint(t, w)
+}
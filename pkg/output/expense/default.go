@@ -0,0 +1,60 @@
+package expense
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+	"github.com/olekukonko/tablewriter"
+)
+
+// ExpensePrint will print more details
+func ExpensePrint(es []dto.Expense, w io.Writer) error {
+	tw := tablewriter.NewWriter(w)
+	util.SetThemedHeader(tw, []string{
+		"ID", "Date", "Project", "Category", "Amount", "Notes"})
+
+	lines := make([][]string, len(es))
+	for i := 0; i < len(es); i++ {
+		e := es[i]
+
+		project := e.ProjectID
+		if e.Project != nil {
+			project = e.Project.Name
+		}
+
+		category := e.CategoryID
+		if e.Category != nil {
+			category = e.Category.Name
+		}
+
+		lines[i] = []string{
+			e.ID,
+			e.Date.Format("2006-01-02"),
+			project,
+			category,
+			FormatAmount(e.Total),
+			e.Notes,
+		}
+	}
+
+	if width, ok := util.TerminalWidth(); ok {
+		tw.SetColWidth(width / 6)
+	}
+	tw.AppendBulk(lines)
+	tw.Render()
+
+	return nil
+}
+
+// FormatAmount shows a amount in cents with two decimal places
+func FormatAmount(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
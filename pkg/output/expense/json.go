@@ -0,0 +1,18 @@
+package expense
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// ExpenseJSONPrint will print as JSON
+func ExpenseJSONPrint(e dto.Expense, w io.Writer) error {
+	return json.NewEncoder(w).Encode(e)
+}
+
+// ExpensesJSONPrint will print as JSON
+func ExpensesJSONPrint(es []dto.Expense, w io.Writer) error {
+	return json.NewEncoder(w).Encode(es)
+}
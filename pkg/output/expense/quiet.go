@@ -0,0 +1,17 @@
+package expense
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// ExpensePrintQuietly will only print the IDs
+func ExpensePrintQuietly(es []dto.Expense, w io.Writer) error {
+	for i := 0; i < len(es); i++ {
+		fmt.Fprintln(w, es[i].ID)
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package expense
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+)
+
+// ExpensePrintWithTemplate will print each expense using the format string
+func ExpensePrintWithTemplate(
+	format string) func([]dto.Expense, io.Writer) error {
+	return func(es []dto.Expense, w io.Writer) error {
+		t, err := util.NewTemplate(format)
+		if err != nil {
+			return err
+		}
+
+		for i := 0; i < len(es); i++ {
+			if err := t.Execute(w, es[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
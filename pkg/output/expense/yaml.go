@@ -0,0 +1,13 @@
+package expense
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+)
+
+// ExpensesYAMLPrint will print as YAML
+func ExpensesYAMLPrint(es []dto.Expense, w io.Writer) error {
+	return util.YAMLPrint(es, w)
+}
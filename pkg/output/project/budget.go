@@ -0,0 +1,76 @@
+package project
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+	"github.com/olekukonko/tablewriter"
+)
+
+// ProjectPrintWithBudget will print the projects with their estimates, how
+// much was already tracked and what remains of it. When estimated by budget,
+// the amount used is the tracked hours using the project's hourly rate
+func ProjectPrintWithBudget(ps []dto.Project, w io.Writer) error {
+	tw := tablewriter.NewWriter(w)
+	util.SetThemedHeader(tw, []string{
+		"ID", "Name", "Estimate", "Tracked", "Remaining"})
+
+	colors := make([]tablewriter.Colors, 5)
+	for i := 0; i < len(ps); i++ {
+		p := ps[i]
+		colors[1] = []int{}
+		if p.Color != "" {
+			colors[1] = util.ColorToTermColor(p.Color)
+		}
+
+		tracked := time.Duration(0)
+		if p.Duration != nil {
+			tracked = p.Duration.Duration
+		}
+
+		line := []string{p.ID, p.Name, "", formatHours(tracked), ""}
+		switch {
+		case p.BudgetEstimate.Active:
+			e := int64(p.BudgetEstimate.Estimate)
+			used := int64(math.Round(
+				tracked.Hours() * float64(p.HourlyRate.Amount)))
+			line[2] = formatAmount(e)
+			line[3] = formatAmount(used)
+			line[4] = formatAmount(e - used)
+		case p.TimeEstimate.Active:
+			e := p.TimeEstimate.Estimate.Duration
+			line[2] = formatHours(e)
+			line[4] = formatHours(e - tracked)
+		}
+
+		tw.Rich(line, colors)
+	}
+
+	tw.Render()
+
+	return nil
+}
+
+func formatHours(d time.Duration) string {
+	s := ""
+	if d < 0 {
+		s = "-"
+		d = -d
+	}
+
+	return fmt.Sprintf("%s%d:%02d", s, int64(d.Hours()), int64(d.Minutes())%60)
+}
+
+func formatAmount(cents int64) string {
+	s := ""
+	if cents < 0 {
+		s = "-"
+		cents = -cents
+	}
+
+	return fmt.Sprintf("%s%d.%02d", s, cents/100, cents%100)
+}
@@ -3,20 +3,18 @@ package project
 import (
 	"fmt"
 	"io"
-	"os"
 
 	"github.com/lucassabreu/clockify-cli/api/dto"
 	"github.com/lucassabreu/clockify-cli/pkg/output/util"
 	"github.com/olekukonko/tablewriter"
-	"golang.org/x/term"
 )
 
 // ProjectPrint will print more details
 func ProjectPrint(ps []dto.Project, w io.Writer) error {
 	tw := tablewriter.NewWriter(w)
-	tw.SetHeader([]string{"ID", "Name", "Client"})
+	util.SetThemedHeader(tw, []string{"ID", "Name", "Client"})
 
-	if width, _, err := term.GetSize(int(os.Stdout.Fd())); err == nil {
+	if width, ok := util.TerminalWidth(); ok {
 		tw.SetColWidth(width / 3)
 	}
 
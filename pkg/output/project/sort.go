@@ -0,0 +1,23 @@
+package project
+
+import (
+	"strings"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+)
+
+// SortProjects sorts the projects by a spec like "name" or "client:desc",
+// valid fields are: id, name and client
+func SortProjects(ps []dto.Project, spec string) error {
+	return util.SortSlice(ps, spec, util.SortFields{
+		"id": func(i, j int) bool { return ps[i].ID < ps[j].ID },
+		"name": func(i, j int) bool {
+			return strings.ToLower(ps[i].Name) < strings.ToLower(ps[j].Name)
+		},
+		"client": func(i, j int) bool {
+			return strings.ToLower(ps[i].ClientName) <
+				strings.ToLower(ps[j].ClientName)
+		},
+	})
+}
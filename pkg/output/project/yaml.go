@@ -0,0 +1,18 @@
+package project
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+)
+
+// ProjectsYAMLPrint will print as YAML
+func ProjectsYAMLPrint(t []dto.Project, w io.Writer) error {
+	return util.YAMLPrint(t, w)
+}
+
+// ProjectYAMLPrint will print as YAML
+func ProjectYAMLPrint(t dto.Project, w io.Writer) error {
+	return util.YAMLPrint(t, w)
+}
@@ -0,0 +1,241 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/reports"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/olekukonko/tablewriter"
+)
+
+func formatAmount(v float64) string {
+	return fmt.Sprintf("%.2f", v/100)
+}
+
+func hasAmounts(ts []reports.Totals) bool {
+	for _, t := range ts {
+		if t.TotalAmount != 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sumTotals(ts []reports.Totals) (time.Duration, float64) {
+	d := time.Duration(0)
+	a := 0.0
+	for _, t := range ts {
+		d = d + t.TotalTime.Duration()
+		a = a + t.TotalAmount
+	}
+
+	return d, a
+}
+
+func totalRow(tw *tablewriter.Table, line []string) {
+	colors := make([]tablewriter.Colors, len(line))
+	for i := range colors {
+		colors[i] = util.TotalColor()
+	}
+	tw.Rich(line, colors)
+}
+
+// SummaryPrint will print a table with the groups of the summary report,
+// the sub groups are indented below their parents
+func SummaryPrint(
+	df func(time.Duration) string,
+) func(reports.SummaryReport, io.Writer) error {
+	return func(r reports.SummaryReport, w io.Writer) error {
+		amounts := hasAmounts(r.Totals)
+
+		tw := tablewriter.NewWriter(w)
+		tw.SetAutoWrapText(false)
+		header := []string{"Group", "Duration"}
+		if amounts {
+			header = append(header, "Amount")
+		}
+		util.SetThemedHeader(tw, header)
+		tw.SetColumnAlignment([]int{tablewriter.ALIGN_LEFT,
+			tablewriter.ALIGN_RIGHT, tablewriter.ALIGN_RIGHT})
+
+		var add func(gs []reports.SummaryGroup, level int)
+		add = func(gs []reports.SummaryGroup, level int) {
+			for _, g := range gs {
+				name := g.Name
+				if name == "" {
+					name = "(without)"
+				}
+
+				line := []string{
+					strings.Repeat("  ", level) + name,
+					df(g.Duration.Duration()),
+				}
+				if amounts {
+					line = append(line, formatAmount(g.Amount))
+				}
+				tw.Append(line)
+
+				add(g.Children, level+1)
+			}
+		}
+		add(r.GroupOne, 0)
+
+		d, a := sumTotals(r.Totals)
+		line := []string{"TOTAL", df(d)}
+		if amounts {
+			line = append(line, formatAmount(a))
+		}
+		totalRow(tw, line)
+
+		tw.Render()
+		return nil
+	}
+}
+
+// DetailedPrint will print a table with the time entries of the detailed
+// report
+func DetailedPrint(
+	df func(time.Duration) string,
+) func(reports.DetailedReport, io.Writer) error {
+	return func(r reports.DetailedReport, w io.Writer) error {
+		amounts := hasAmounts(r.Totals)
+
+		tw := tablewriter.NewWriter(w)
+		tw.SetAutoWrapText(false)
+		header := []string{
+			"ID", "Start", "End", "Dur", "Project", "Description", "Tags"}
+		if amounts {
+			header = append(header, "Amount")
+		}
+		util.SetThemedHeader(tw, header)
+
+		for _, t := range r.TimeEntries {
+			end := ""
+			if t.TimeInterval.End != nil {
+				end = t.TimeInterval.End.In(time.Local).
+					Format(timehlp.FullTimeFormat)
+			}
+
+			tags := make([]string, len(t.Tags))
+			for i := range t.Tags {
+				tags[i] = t.Tags[i].Name
+			}
+
+			project := t.ProjectName
+			if t.TaskName != "" {
+				project = project + ": " + t.TaskName
+			}
+
+			line := []string{
+				t.ID,
+				t.TimeInterval.Start.In(time.Local).
+					Format(timehlp.FullTimeFormat),
+				end,
+				df(t.TimeInterval.Duration.Duration()),
+				project,
+				t.Description,
+				strings.Join(tags, ", "),
+			}
+			if amounts {
+				line = append(line, formatAmount(t.Amount))
+			}
+			tw.Append(line)
+		}
+
+		d, a := sumTotals(r.Totals)
+		line := []string{"TOTAL", "", "", df(d), "", "", ""}
+		if amounts {
+			line = append(line, formatAmount(a))
+		}
+		totalRow(tw, line)
+
+		tw.Render()
+		return nil
+	}
+}
+
+func weeklyDay(t time.Time) string {
+	return t.Format("2006-01-02")
+}
+
+// WeeklyPrint will print a table with the groups as rows, the days as
+// columns and the durations on the cells
+func WeeklyPrint(
+	df func(time.Duration) string,
+) func(reports.WeeklyReport, io.Writer) error {
+	return func(r reports.WeeklyReport, w io.Writer) error {
+		days := make([]time.Time, 0, len(r.TotalsByDay))
+		for _, d := range r.TotalsByDay {
+			days = append(days, d.Date)
+		}
+		sort.Slice(days, func(i, j int) bool {
+			return days[i].Before(days[j])
+		})
+
+		tw := tablewriter.NewWriter(w)
+		tw.SetAutoWrapText(false)
+		header := make([]string, 0, len(days)+2)
+		header = append(header, "Group")
+		for _, d := range days {
+			header = append(header, d.Format("Mon 2006-01-02"))
+		}
+		util.SetThemedHeader(tw, append(header, "Total"))
+
+		al := make([]int, len(header)+1)
+		for i := range al {
+			al[i] = tablewriter.ALIGN_RIGHT
+		}
+		al[0] = tablewriter.ALIGN_LEFT
+		tw.SetColumnAlignment(al)
+
+		line := func(
+			name string, ds []reports.DayTotal, total time.Duration,
+		) []string {
+			byDay := make(map[string]time.Duration, len(ds))
+			for _, d := range ds {
+				byDay[weeklyDay(d.Date)] = d.Duration.Duration()
+			}
+
+			l := make([]string, 0, len(days)+2)
+			l = append(l, name)
+			for _, d := range days {
+				v, ok := byDay[weeklyDay(d)]
+				if !ok || v == 0 {
+					l = append(l, "")
+					continue
+				}
+
+				l = append(l, df(v))
+			}
+
+			return append(l, df(total))
+		}
+
+		for _, g := range r.GroupOne {
+			name := g.Name
+			if name == "" {
+				name = "(without)"
+			}
+
+			tw.Append(line(name, g.TotalsByDay, g.Duration.Duration()))
+		}
+
+		d, _ := sumTotals(r.Totals)
+		total := line("TOTAL", r.TotalsByDay, d)
+		for i := 1; i < len(total)-1; i++ {
+			if total[i] == "" {
+				total[i] = df(0)
+			}
+		}
+		totalRow(tw, total)
+
+		tw.Render()
+		return nil
+	}
+}
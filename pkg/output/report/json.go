@@ -0,0 +1,11 @@
+package report
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// JSONPrint will print the report as JSON, as it was returned by the API
+func JSONPrint(r interface{}, w io.Writer) error {
+	return json.NewEncoder(w).Encode(r)
+}
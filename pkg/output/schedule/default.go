@@ -0,0 +1,70 @@
+package schedule
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+	"github.com/olekukonko/tablewriter"
+)
+
+func assignmentLine(a dto.Assignment) []string {
+	return []string{
+		a.ID,
+		a.UserID,
+		a.ProjectID,
+		a.Period.Start.UTC().Format("2006-01-02"),
+		a.Period.End.UTC().Format("2006-01-02"),
+		strconv.FormatFloat(a.HoursPerDay, 'f', -1, 64),
+	}
+}
+
+var assignmentHeader = []string{
+	"ID", "User", "Project", "Start", "End", "Hours/Day"}
+
+// AssignmentPrint will print more details
+func AssignmentPrint(as []dto.Assignment, w io.Writer) error {
+	tw := tablewriter.NewWriter(w)
+	util.SetThemedHeader(tw, assignmentHeader)
+
+	lines := make([][]string, len(as))
+	for i := 0; i < len(as); i++ {
+		lines[i] = assignmentLine(as[i])
+	}
+
+	if width, ok := util.TerminalWidth(); ok {
+		tw.SetColWidth(width / 6)
+	}
+	tw.AppendBulk(lines)
+	tw.Render()
+
+	return nil
+}
+
+// AssignmentHoursPrint will print more details, with the hours planned and
+// tracked for each assignment
+func AssignmentHoursPrint(as []AssignmentHours, w io.Writer) error {
+	tw := tablewriter.NewWriter(w)
+	util.SetThemedHeader(tw, append(assignmentHeader, "Planned", "Tracked"))
+
+	lines := make([][]string, len(as))
+	for i := 0; i < len(as); i++ {
+		lines[i] = append(assignmentLine(as[i].Assignment),
+			durationToHours(as[i].Planned),
+			durationToHours(as[i].Tracked),
+		)
+	}
+
+	if width, ok := util.TerminalWidth(); ok {
+		tw.SetColWidth(width / 8)
+	}
+	tw.AppendBulk(lines)
+	tw.Render()
+
+	return nil
+}
+
+func durationToHours(d dto.Duration) string {
+	return strconv.FormatFloat(d.Hours(), 'f', 2, 64)
+}
@@ -0,0 +1,58 @@
+package schedule
+
+import (
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// AssignmentHours is an assignment with how many hours were planned for it and
+// how many the user already tracked on its project during its period
+type AssignmentHours struct {
+	dto.Assignment
+	Planned dto.Duration `json:"planned"`
+	Tracked dto.Duration `json:"tracked"`
+}
+
+// PlannedHours is how long the user is expected to work on the assignment,
+// non working days (saturday and sunday) are only counted when the
+// assignment includes them
+func PlannedHours(a dto.Assignment) time.Duration {
+	days := 0
+	start := a.Period.Start.UTC()
+	end := a.Period.End.UTC()
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		if !a.IncludeNonWorkingDays &&
+			(d.Weekday() == time.Saturday || d.Weekday() == time.Sunday) {
+			continue
+		}
+		days++
+	}
+
+	return time.Duration(float64(days) * a.HoursPerDay * float64(time.Hour))
+}
+
+// TrackedHours sums the time entries on the project of the assignment that
+// started during its period, tes must be the time entries of its user
+func TrackedHours(a dto.Assignment, tes []dto.TimeEntryImpl) time.Duration {
+	start := a.Period.Start
+	end := a.Period.End.AddDate(0, 0, 1)
+
+	var t time.Duration
+	for i := range tes {
+		te := tes[i]
+		if te.ProjectID != a.ProjectID ||
+			te.TimeInterval.Start.Before(start) ||
+			!te.TimeInterval.Start.Before(end) {
+			continue
+		}
+
+		e := time.Now()
+		if te.TimeInterval.End != nil {
+			e = *te.TimeInterval.End
+		}
+		t = t + e.Sub(te.TimeInterval.Start)
+	}
+
+	return t
+}
@@ -0,0 +1,18 @@
+package schedule
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// AssignmentsJSONPrint will print as JSON
+func AssignmentsJSONPrint(as []dto.Assignment, w io.Writer) error {
+	return json.NewEncoder(w).Encode(as)
+}
+
+// AssignmentHoursJSONPrint will print as JSON
+func AssignmentHoursJSONPrint(as []AssignmentHours, w io.Writer) error {
+	return json.NewEncoder(w).Encode(as)
+}
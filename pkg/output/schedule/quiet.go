@@ -0,0 +1,17 @@
+package schedule
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// AssignmentPrintQuietly will only print the IDs
+func AssignmentPrintQuietly(as []dto.Assignment, w io.Writer) error {
+	for i := 0; i < len(as); i++ {
+		fmt.Fprintln(w, as[i].ID)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package schedule
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+)
+
+// AssignmentPrintWithTemplate will print each assignment using the format
+// string
+func AssignmentPrintWithTemplate(
+	format string) func([]dto.Assignment, io.Writer) error {
+	return func(as []dto.Assignment, w io.Writer) error {
+		t, err := util.NewTemplate(format)
+		if err != nil {
+			return err
+		}
+
+		for i := 0; i < len(as); i++ {
+			if err := t.Execute(w, as[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// AssignmentHoursPrintWithTemplate will print each assignment with its
+// hours using the format string
+func AssignmentHoursPrintWithTemplate(
+	format string) func([]AssignmentHours, io.Writer) error {
+	return func(as []AssignmentHours, w io.Writer) error {
+		t, err := util.NewTemplate(format)
+		if err != nil {
+			return err
+		}
+
+		for i := 0; i < len(as); i++ {
+			if err := t.Execute(w, as[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,18 @@
+package schedule
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+)
+
+// AssignmentsYAMLPrint will print as YAML
+func AssignmentsYAMLPrint(as []dto.Assignment, w io.Writer) error {
+	return util.YAMLPrint(as, w)
+}
+
+// AssignmentHoursYAMLPrint will print as YAML
+func AssignmentHoursYAMLPrint(as []AssignmentHours, w io.Writer) error {
+	return util.YAMLPrint(as, w)
+}
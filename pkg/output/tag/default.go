@@ -4,13 +4,14 @@ import (
 	"io"
 
 	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
 	"github.com/olekukonko/tablewriter"
 )
 
 // TagPrint will print more details
 func TagPrint(ts []dto.Tag, w io.Writer) error {
 	tw := tablewriter.NewWriter(w)
-	tw.SetHeader([]string{"ID", "Name"})
+	util.SetThemedHeader(tw, []string{"ID", "Name"})
 
 	lines := make([][]string, len(ts))
 	for i := 0; i < len(ts); i++ {
@@ -0,0 +1,13 @@
+package tag
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+)
+
+// TagsYAMLPrint will print as YAML
+func TagsYAMLPrint(t []dto.Tag, w io.Writer) error {
+	return util.YAMLPrint(t, w)
+}
@@ -2,17 +2,16 @@ package task
 
 import (
 	"io"
-	"os"
 
 	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
 	"github.com/olekukonko/tablewriter"
-	"golang.org/x/term"
 )
 
 // TaskPrint will print more details
 func TaskPrint(ts []dto.Task, w io.Writer) error {
 	tw := tablewriter.NewWriter(w)
-	tw.SetHeader([]string{"ID", "Name", "Status"})
+	util.SetThemedHeader(tw, []string{"ID", "Name", "Status"})
 
 	lines := make([][]string, len(ts))
 	for i := 0; i < len(ts); i++ {
@@ -23,7 +22,7 @@ func TaskPrint(ts []dto.Task, w io.Writer) error {
 		}
 	}
 
-	if width, _, err := term.GetSize(int(os.Stdout.Fd())); err == nil {
+	if width, ok := util.TerminalWidth(); ok {
 		tw.SetColWidth(width / 3)
 	}
 	tw.AppendBulk(lines)
@@ -0,0 +1,20 @@
+package task
+
+import (
+	"strings"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+)
+
+// SortTasks sorts the tasks by a spec like "name" or "status:desc", valid
+// fields are: id, name and status
+func SortTasks(ts []dto.Task, spec string) error {
+	return util.SortSlice(ts, spec, util.SortFields{
+		"id": func(i, j int) bool { return ts[i].ID < ts[j].ID },
+		"name": func(i, j int) bool {
+			return strings.ToLower(ts[i].Name) < strings.ToLower(ts[j].Name)
+		},
+		"status": func(i, j int) bool { return ts[i].Status < ts[j].Status },
+	})
+}
@@ -0,0 +1,13 @@
+package task
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+)
+
+// TasksYAMLPrint will print as YAML
+func TasksYAMLPrint(t []dto.Task, w io.Writer) error {
+	return util.YAMLPrint(t, w)
+}
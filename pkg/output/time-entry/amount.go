@@ -0,0 +1,89 @@
+package timeentry
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+)
+
+// timeEntryAmount returns the hourly rate and the amount (in cents) of the
+// time entry, non-billable time entries have no amount
+func timeEntryAmount(t dto.TimeEntry) (dto.Rate, dto.Rate) {
+	r := resolveHourlyRate(t)
+	if !t.Billable {
+		return r, dto.Rate{Currency: r.Currency}
+	}
+
+	end := timehlp.Now()
+	if t.TimeInterval.End != nil {
+		end = *t.TimeInterval.End
+	}
+
+	return r, dto.Rate{
+		Amount:   int64(float64(r.Amount) * end.Sub(t.TimeInterval.Start).Hours()),
+		Currency: r.Currency,
+	}
+}
+
+// sumTimeEntriesAmounts returns the sum of the amounts of the time entries
+// for each currency, ordered by currency
+func sumTimeEntriesAmounts(timeEntries []dto.TimeEntry) []dto.Rate {
+	sums := make(map[string]int64)
+	for i := range timeEntries {
+		_, a := timeEntryAmount(timeEntries[i])
+		sums[a.Currency] = sums[a.Currency] + a.Amount
+	}
+
+	amounts := make([]dto.Rate, 0, len(sums))
+	for c, a := range sums {
+		amounts = append(amounts, dto.Rate{Amount: a, Currency: c})
+	}
+
+	sort.Slice(amounts, func(i, j int) bool {
+		return amounts[i].Currency < amounts[j].Currency
+	})
+
+	return amounts
+}
+
+func formatRate(r dto.Rate) string {
+	return formatCurrency(r.Amount, r.Currency)
+}
+
+func formatAmounts(amounts []dto.Rate) string {
+	s := make([]string, len(amounts))
+	for i := range amounts {
+		s[i] = formatRate(amounts[i])
+	}
+
+	return strings.Join(s, "\n")
+}
+
+// TimeEntryWithAmount is a time entry with the amount of its duration
+type TimeEntryWithAmount struct {
+	dto.TimeEntry
+	Amount dto.Rate `json:"amount"`
+}
+
+// TimeEntriesWithAmountsJSONPrint will print the time entries as JSON with
+// their amounts, and the total of the amounts for each currency
+func TimeEntriesWithAmountsJSONPrint(
+	timeEntries []dto.TimeEntry, w io.Writer) error {
+	tes := make([]TimeEntryWithAmount, len(timeEntries))
+	for i := range timeEntries {
+		_, a := timeEntryAmount(timeEntries[i])
+		tes[i] = TimeEntryWithAmount{TimeEntry: timeEntries[i], Amount: a}
+	}
+
+	return json.NewEncoder(w).Encode(struct {
+		TimeEntries []TimeEntryWithAmount `json:"timeEntries"`
+		Amounts     []dto.Rate            `json:"amounts"`
+	}{
+		TimeEntries: tes,
+		Amounts:     sumTimeEntriesAmounts(timeEntries),
+	})
+}
@@ -0,0 +1,64 @@
+package timeentry
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+	"github.com/olekukonko/tablewriter"
+)
+
+type billableSplit struct {
+	billable    time.Duration
+	nonBillable time.Duration
+}
+
+// TimeEntriesDailyBillableSplit will print a table with how much time was
+// billable or not for each day, and the percentage of it that was billable
+func TimeEntriesDailyBillableSplit(
+	timeEntries []dto.TimeEntry, w io.Writer) error {
+	days := make(map[string]billableSplit)
+	for i := 0; i < len(timeEntries); i++ {
+		t := timeEntries[i]
+		day := t.TimeInterval.Start.In(time.Local).Format("2006-01-02")
+		d := sumTimeEntriesDuration(timeEntries[i : i+1])
+
+		s := days[day]
+		if t.Billable {
+			s.billable = s.billable + d
+		} else {
+			s.nonBillable = s.nonBillable + d
+		}
+		days[day] = s
+	}
+
+	dates := make([]string, 0, len(days))
+	for d := range days {
+		dates = append(dates, d)
+	}
+	sort.Strings(dates)
+
+	tw := tablewriter.NewWriter(w)
+	util.SetThemedHeader(tw,
+		[]string{"Date", "Billable", "Non-Billable", "Billable %"})
+	for _, d := range dates {
+		s := days[d]
+		p := float64(0)
+		if total := s.billable + s.nonBillable; total > 0 {
+			p = float64(s.billable) / float64(total) * 100
+		}
+
+		tw.Append([]string{
+			d,
+			durationToString(s.billable),
+			durationToString(s.nonBillable),
+			fmt.Sprintf("%.2f%%", p),
+		})
+	}
+
+	tw.Render()
+	return nil
+}
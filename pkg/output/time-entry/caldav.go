@@ -0,0 +1,156 @@
+package timeentry
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+)
+
+const icalTimeFormat = "20060102T150405Z"
+
+var icalTextEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+)
+
+// icalFold breaks content lines longer than 75 octets as required by the
+// RFC 5545, without splitting multi-byte characters
+func icalFold(line string) string {
+	const limit = 75
+
+	b := strings.Builder{}
+	size := 0
+	for _, r := range line {
+		l := len(string(r))
+		if size+l > limit {
+			b.WriteString("\r\n ")
+			size = 1
+		}
+		b.WriteRune(r)
+		size = size + l
+	}
+	b.WriteString("\r\n")
+
+	return b.String()
+}
+
+func icalUID(t dto.TimeEntry) string {
+	return t.ID + "@clockify.me"
+}
+
+// icalEvent returns the VEVENT lines for a time entry, running time entries
+// are marked as tentative and have no end
+func icalEvent(t dto.TimeEntry, stamp time.Time) []string {
+	lines := []string{
+		"BEGIN:VEVENT",
+		"UID:" + icalUID(t),
+		"DTSTAMP:" + stamp.UTC().Format(icalTimeFormat),
+		"DTSTART:" + t.TimeInterval.Start.UTC().Format(icalTimeFormat),
+	}
+
+	if t.TimeInterval.End != nil {
+		lines = append(lines,
+			"DTEND:"+t.TimeInterval.End.UTC().Format(icalTimeFormat),
+			"STATUS:CONFIRMED")
+	} else {
+		lines = append(lines, "STATUS:TENTATIVE")
+	}
+
+	lines = append(lines,
+		"SUMMARY:"+icalTextEscaper.Replace(t.Description))
+
+	if t.Project != nil {
+		d := "Project: " + t.Project.Name
+		if t.Task != nil {
+			d = d + "\nTask: " + t.Task.Name
+		}
+		lines = append(lines, "DESCRIPTION:"+icalTextEscaper.Replace(d))
+	}
+
+	categories := make([]string, 0, len(t.Tags)+1)
+	if t.Project != nil {
+		categories = append(categories,
+			icalTextEscaper.Replace(t.Project.Name))
+	}
+
+	for i := range t.Tags {
+		categories = append(categories,
+			icalTextEscaper.Replace(t.Tags[i].Name))
+	}
+
+	if len(categories) > 0 {
+		lines = append(lines, "CATEGORIES:"+strings.Join(categories, ","))
+	}
+
+	return append(lines, "END:VEVENT")
+}
+
+func icalCalendar(events ...[]string) string {
+	b := strings.Builder{}
+	for _, l := range []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//Clockify CLI//EN",
+	} {
+		b.WriteString(icalFold(l))
+	}
+
+	for _, e := range events {
+		for _, l := range e {
+			b.WriteString(icalFold(l))
+		}
+	}
+
+	b.WriteString(icalFold("END:VCALENDAR"))
+	return b.String()
+}
+
+// CalDAVResource is a single iCalendar object to be sent with a PUT to the
+// CalDAV collection at Path
+type CalDAVResource struct {
+	UID  string `json:"uid"`
+	Path string `json:"path"`
+	Data string `json:"data"`
+}
+
+// CalDAVBatch holds the iCalendar objects of the time entries and a manifest
+// mapping its UIDs to the relative paths they should be stored
+type CalDAVBatch struct {
+	Manifest  map[string]string `json:"manifest"`
+	Resources []CalDAVResource  `json:"resources"`
+}
+
+// TimeEntriesCalDAVBatch will print the time entries as a JSON with one
+// iCalendar object for each one, ready to be sent to a CalDAV server
+func TimeEntriesCalDAVBatch(timeEntries []dto.TimeEntry, w io.Writer) error {
+	stamp := timehlp.Now()
+	b := CalDAVBatch{
+		Manifest:  make(map[string]string, len(timeEntries)),
+		Resources: make([]CalDAVResource, 0, len(timeEntries)),
+	}
+
+	for i := range timeEntries {
+		t := timeEntries[i]
+		if t.ID == "" {
+			continue
+		}
+
+		r := CalDAVResource{
+			UID:  icalUID(t),
+			Path: t.ID + ".ics",
+			Data: icalCalendar(icalEvent(t, stamp)),
+		}
+
+		b.Manifest[r.UID] = r.Path
+		b.Resources = append(b.Resources, r)
+	}
+
+	return json.NewEncoder(w).Encode(b)
+}
@@ -0,0 +1,129 @@
+package timeentry
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+)
+
+const (
+	chartBar    = '#'
+	chartTarget = '|'
+)
+
+// ChartOptions sets how the chart of hours per day will be drawn
+type ChartOptions struct {
+	Target time.Duration
+	Width  int
+}
+
+// ChartOpt allows the setting of ChartOptions values
+type ChartOpt func(*ChartOptions) error
+
+// WithChartTarget shows a line on the chart at the expected duration of
+// each day
+func WithChartTarget(d time.Duration) ChartOpt {
+	return func(co *ChartOptions) error {
+		co.Target = d
+		return nil
+	}
+}
+
+// WithChartWidth sets the width of the chart, instead of using the terminal
+// width
+func WithChartWidth(w int) ChartOpt {
+	return func(co *ChartOptions) error {
+		co.Width = w
+		return nil
+	}
+}
+
+// TimeEntriesChartPrint will print a bar for each day with its duration,
+// scaled to the width of the terminal, and a "|" on the target duration
+// when set
+func TimeEntriesChartPrint(
+	opts ...ChartOpt) func([]dto.TimeEntry, io.Writer) error {
+	options := ChartOptions{}
+	for _, o := range opts {
+		if err := o(&options); err != nil {
+			return func(_ []dto.TimeEntry, _ io.Writer) error { return err }
+		}
+	}
+
+	if options.Width <= 0 {
+		options.Width = defaultWidth
+		if w, ok := util.TerminalWidth(); ok {
+			options.Width = w
+		}
+	}
+
+	return func(tes []dto.TimeEntry, w io.Writer) error {
+		groups, err := GroupTimeEntries(tes, "day")
+		if err != nil {
+			return err
+		}
+
+		sort.Slice(groups, func(i, j int) bool {
+			return groups[i].Name < groups[j].Name
+		})
+
+		max := options.Target
+		for _, g := range groups {
+			if g.Duration.Duration > max {
+				max = g.Duration.Duration
+			}
+		}
+
+		size := len(durationToString(max))
+		cols := options.Width - len(dayLabelFormat) - size - 4
+		if cols < 1 {
+			cols = 1
+		}
+
+		scale := func(d time.Duration) int {
+			if max == 0 {
+				return 0
+			}
+
+			return int(math.Round(float64(d) / float64(max) * float64(cols)))
+		}
+
+		target := -1
+		if options.Target > 0 {
+			target = scale(options.Target)
+		}
+
+		for _, g := range groups {
+			day, err := time.ParseInLocation("2006-01-02", g.Name, time.Local)
+			if err != nil {
+				return err
+			}
+
+			bar := []rune(strings.Repeat(string(chartBar),
+				scale(g.Duration.Duration)) +
+				strings.Repeat(" ", cols-scale(g.Duration.Duration)))
+			if target >= 0 {
+				bar = append(bar[:target],
+					append([]rune{chartTarget}, bar[target:]...)...)
+			} else {
+				bar = append(bar, ' ')
+			}
+
+			if _, err := fmt.Fprintf(w, "%s %s %*s\n",
+				day.Format(dayLabelFormat),
+				string(bar),
+				size, durationToString(g.Duration.Duration),
+			); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
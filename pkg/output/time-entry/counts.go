@@ -0,0 +1,51 @@
+package timeentry
+
+import (
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+	"github.com/olekukonko/tablewriter"
+)
+
+// TimeEntriesCountsPrint will print a table with how many time entries there
+// are and how many distinct projects, tasks, tags and days they touched
+func TimeEntriesCountsPrint(timeEntries []dto.TimeEntry, w io.Writer) error {
+	projects := make(map[string]bool)
+	tasks := make(map[string]bool)
+	tags := make(map[string]bool)
+	days := make(map[string]bool)
+
+	for i := range timeEntries {
+		t := timeEntries[i]
+		days[t.TimeInterval.Start.In(time.Local).Format("2006-01-02")] = true
+
+		if t.Project != nil {
+			projects[t.Project.ID] = true
+		}
+
+		if t.Task != nil {
+			tasks[t.Task.ID] = true
+		}
+
+		for _, tag := range t.Tags {
+			tags[tag.ID] = true
+		}
+	}
+
+	tw := tablewriter.NewWriter(w)
+	util.SetThemedHeader(tw,
+		[]string{"Entries", "Projects", "Tasks", "Tags", "Days"})
+	tw.Append([]string{
+		strconv.Itoa(len(timeEntries)),
+		strconv.Itoa(len(projects)),
+		strconv.Itoa(len(tasks)),
+		strconv.Itoa(len(tags)),
+		strconv.Itoa(len(days)),
+	})
+
+	tw.Render()
+	return nil
+}
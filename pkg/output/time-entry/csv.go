@@ -3,39 +3,298 @@ package timeentry
 import (
 	"encoding/csv"
 	"io"
+	"strings"
 	"time"
 
 	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/strhlp"
+	"github.com/pkg/errors"
 )
 
+// csvColumns are the columns of the CSV format, in the default order
+var csvColumns = []string{
+	"id",
+	"description",
+	"project.id",
+	"project.name",
+	"task.id",
+	"task.name",
+	"start",
+	"end",
+	"duration",
+	"user.id",
+	"user.email",
+	"user.name",
+	"tags...",
+}
+
+// csvAmountColumns are the columns with the hourly rate and amount, which
+// are only shown by default when the amounts are asked for
+var csvAmountColumns = []string{"rate", "amount"}
+
+// csvExtraColumns are the columns only shown when asked for
+var csvExtraColumns = []string{"status"}
+
+// csvColumnAliases allows the use of the table columns names for the CSV
+var csvColumnAliases = map[string]string{
+	"dur":     "duration",
+	"project": "project.name",
+	"task":    "task.name",
+	"tags":    "tags...",
+}
+
+// CSVOptions sets how the CSV format should print the time entries
+type CSVOptions struct {
+	Columns           []string
+	DurationFormatter func(time.Duration) string
+	DurationRounder   func(time.Duration) time.Duration
+	Location          *time.Location
+	Locale            Locale
+	Delimiter         rune
+	NoHeader          bool
+	ShowAmounts       bool
+	TSV               bool
+}
+
+// CSVOpt allows the setting of CSVOptions values
+type CSVOpt func(*CSVOptions) error
+
+// WithCSVColumns sets which columns the CSV will have and in which order,
+// the columns "dur", "project", "task" and "tags" can be used as aliases of
+// the CSV columns. Custom fields are set as "customFields.<name>" (or
+// "cf:<name>"), and "customFields.*" adds all the custom fields found
+func WithCSVColumns(columns []string) CSVOpt {
+	return func(co *CSVOptions) error {
+		co.Columns = make([]string, len(columns))
+		for i, c := range columns {
+			c = strings.TrimSpace(c)
+			if n, ok := isCustomFieldColumn(c, csvCustomFieldPrefix); ok {
+				co.Columns[i] = csvCustomFieldPrefix + n
+				continue
+			}
+
+			if n, ok := isCustomFieldColumn(c, customFieldColumnPrefix); ok {
+				co.Columns[i] = csvCustomFieldPrefix + n
+				continue
+			}
+
+			c = strings.ToLower(c)
+			if a, ok := csvColumnAliases[c]; ok {
+				c = a
+			}
+
+			valid := append(append(
+				append([]string{}, csvColumns...), csvAmountColumns...),
+				csvExtraColumns...)
+			if !strhlp.InSlice(c, valid) {
+				return errors.Errorf(
+					"column \"%s\" does not exist, valid columns are: %s "+
+						"(or customFields.<name> for custom fields)",
+					c, strhlp.ListForHumans(valid))
+			}
+
+			if c == "tags..." && i != len(columns)-1 {
+				return errors.New("column \"tags\" must be the last one")
+			}
+
+			co.Columns[i] = c
+		}
+
+		return nil
+	}
+}
+
+// WithCSVDurationFormatter sets how the duration column will be formatted
+func WithCSVDurationFormatter(f func(time.Duration) string) CSVOpt {
+	return func(co *CSVOptions) error {
+		co.DurationFormatter = f
+		return nil
+	}
+}
+
+// WithCSVDurationRounder sets how the duration column will be rounded
+func WithCSVDurationRounder(r func(time.Duration) time.Duration) CSVOpt {
+	return func(co *CSVOptions) error {
+		co.DurationRounder = r
+		return nil
+	}
+}
+
+// WithCSVTimeZone sets in which time zone the start and end columns will be
+func WithCSVTimeZone(loc *time.Location) CSVOpt {
+	return func(co *CSVOptions) error {
+		co.Location = loc
+		return nil
+	}
+}
+
+// WithCSVLocale sets the locale used to show the start, end and duration
+// columns
+func WithCSVLocale(l Locale) CSVOpt {
+	return func(co *CSVOptions) error {
+		co.Locale = l
+		return nil
+	}
+}
+
+// WithCSVDelimiter sets which character will separate the fields
+func WithCSVDelimiter(d rune) CSVOpt {
+	return func(co *CSVOptions) error {
+		if d == '"' || d == '\r' || d == '\n' || d == 0xFFFD {
+			return errors.Errorf("delimiter %q is not valid", d)
+		}
+
+		co.Delimiter = d
+		return nil
+	}
+}
+
+// WithCSVAsTSV prints the values separated by tabs without quoting them,
+// tabs and line breaks on the values are replaced by spaces
+func WithCSVAsTSV() CSVOpt {
+	return func(co *CSVOptions) error {
+		co.TSV = true
+		return nil
+	}
+}
+
+// WithCSVNoHeader removes the header row from the CSV
+func WithCSVNoHeader() CSVOpt {
+	return func(co *CSVOptions) error {
+		co.NoHeader = true
+		return nil
+	}
+}
+
+// WithCSVAmounts adds the columns "rate" and "amount" (if not set already)
+// and rows with the total amount for each currency at the end
+func WithCSVAmounts() CSVOpt {
+	return func(co *CSVOptions) error {
+		co.ShowAmounts = true
+		return nil
+	}
+}
+
 // TimeEntriesCSVPrint will print each time entry using the format string
 func TimeEntriesCSVPrint(timeEntries []dto.TimeEntry, out io.Writer) error {
-	w := csv.NewWriter(out)
-
-	if err := w.Write([]string{
-		"id",
-		"description",
-		"project.id",
-		"project.name",
-		"task.id",
-		"task.name",
-		"start",
-		"end",
-		"duration",
-		"user.id",
-		"user.email",
-		"user.name",
-		"tags...",
-	}); err != nil {
-		return err
+	return TimeEntriesCSVPrintWithOptions()(timeEntries, out)
+}
+
+// TimeEntriesCSVPrintWithOptions will print each time entry as CSV, as set
+// by the options
+func TimeEntriesCSVPrintWithOptions(
+	opts ...CSVOpt,
+) func([]dto.TimeEntry, io.Writer) error {
+	options := &CSVOptions{
+		Columns:           csvColumns,
+		DurationFormatter: durationToString,
+		DurationRounder:   DurationRounder(0, false),
+		Location:          time.Local,
+		Locale:            DefaultLocale,
+		Delimiter:         ',',
+	}
+
+	for _, o := range opts {
+		if err := o(options); err != nil {
+			return func(_ []dto.TimeEntry, _ io.Writer) error { return err }
+		}
+	}
+
+	return func(timeEntries []dto.TimeEntry, out io.Writer) error {
+		return timeEntriesCSVPrint(options, timeEntries, out)
+	}
+}
+
+// rowWriter writes the rows of the CSV (or TSV)
+type rowWriter interface {
+	Write([]string) error
+	Flush()
+	Error() error
+}
+
+var tsvEscaper = strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ",
+	"\t", " ")
+
+// tsvWriter writes the values separated by tabs, without quoting them
+type tsvWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (t *tsvWriter) Write(values []string) error {
+	if t.err != nil {
+		return t.err
+	}
+
+	escaped := make([]string, len(values))
+	for i := range values {
+		escaped[i] = tsvEscaper.Replace(values[i])
+	}
+
+	_, t.err = io.WriteString(t.w, strings.Join(escaped, "\t")+"\n")
+	return t.err
+}
+
+func (*tsvWriter) Flush() {}
+
+func (t *tsvWriter) Error() error {
+	return t.err
+}
+
+func timeEntriesCSVPrint(
+	options *CSVOptions, timeEntries []dto.TimeEntry, out io.Writer) error {
+	var w rowWriter = &tsvWriter{w: out}
+	if !options.TSV {
+		cw := csv.NewWriter(out)
+		cw.Comma = options.Delimiter
+		w = cw
+	}
+
+	columns := options.Columns
+	if options.ShowAmounts && !strhlp.InSlice("amount", columns) {
+		columns = make([]string, 0, len(options.Columns)+2)
+		for _, c := range options.Columns {
+			if c == "tags..." {
+				columns = append(columns, csvAmountColumns...)
+			}
+			columns = append(columns, c)
+		}
+
+		if !strhlp.InSlice("amount", columns) {
+			columns = append(columns, csvAmountColumns...)
+		}
+	}
+
+	if strhlp.InSlice(csvCustomFieldPrefix+"*", columns) {
+		names := customFieldNames(timeEntries)
+		expanded := make([]string, 0, len(columns)+len(names))
+		for _, c := range columns {
+			if c != csvCustomFieldPrefix+"*" {
+				expanded = append(expanded, c)
+				continue
+			}
+
+			for _, n := range names {
+				expanded = append(expanded, csvCustomFieldPrefix+n)
+			}
+		}
+		columns = expanded
+	}
+
+	if !options.NoHeader {
+		if err := w.Write(columns); err != nil {
+			return err
+		}
 	}
 
 	format := func(t *time.Time) string {
 		if t == nil {
 			return ""
 		}
-		return t.In(time.Local).Format(TimeFormatFull)
+		return options.Locale.FormatTime(
+			t.In(options.Location), TimeFormatFull)
 	}
+	df := options.Locale.FormatDecimals(options.DurationFormatter)
 
 	for i := 0; i < len(timeEntries); i++ {
 		te := timeEntries[i]
@@ -59,27 +318,63 @@ func TimeEntriesCSVPrint(timeEntries []dto.TimeEntry, out io.Writer) error {
 			te.Task = &t
 		}
 
-		arr := []string{
-			te.ID,
-			te.Description,
-			p.ID,
-			p.Name,
-			te.Task.ID,
-			te.Task.Name,
-			format(&te.TimeInterval.Start),
-			format(te.TimeInterval.End),
-			durationToString(end.Sub(te.TimeInterval.Start)),
-			te.User.ID,
-			te.User.Email,
-			te.User.Name,
+		values := map[string]string{
+			"id":           te.ID,
+			"description":  te.Description,
+			"project.id":   p.ID,
+			"project.name": p.Name,
+			"task.id":      te.Task.ID,
+			"task.name":    te.Task.Name,
+			"start":        format(&te.TimeInterval.Start),
+			"end":          format(te.TimeInterval.End),
+			"duration": df(options.DurationRounder(
+				end.Sub(te.TimeInterval.Start))),
+			"user.id":    te.User.ID,
+			"user.email": te.User.Email,
+			"user.name":  te.User.Name,
+			"status":     te.ApprovalStatus(),
 		}
 
-		if err := w.Write(append(
-			arr, tagsToStringSlice(te.Tags)...)); err != nil {
+		r, a := timeEntryAmount(te)
+		values["rate"] = formatRate(r)
+		values["amount"] = formatRate(a)
+
+		arr := make([]string, 0, len(columns))
+		for _, c := range columns {
+			if c == "tags..." {
+				arr = append(arr, tagsToStringSlice(te.Tags)...)
+				continue
+			}
+
+			if n, ok := isCustomFieldColumn(c, csvCustomFieldPrefix); ok {
+				arr = append(arr, customFieldValue(te, n))
+				continue
+			}
+
+			arr = append(arr, values[c])
+		}
+
+		if err := w.Write(arr); err != nil {
 			return err
 		}
 	}
 
+	if options.ShowAmounts {
+		for _, a := range sumTimeEntriesAmounts(timeEntries) {
+			arr := make([]string, len(columns))
+			arr[0] = "TOTAL"
+			for i, c := range columns {
+				if c == "amount" {
+					arr[i] = formatRate(a)
+				}
+			}
+
+			if err := w.Write(arr); err != nil {
+				return err
+			}
+		}
+	}
+
 	w.Flush()
 	return w.Error()
 }
@@ -0,0 +1,81 @@
+package timeentry
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+const (
+	// customFieldColumnPrefix is used on the table columns to show the
+	// value of a custom field, like: cf:Ticket
+	customFieldColumnPrefix = "cf:"
+	// csvCustomFieldPrefix is used on the CSV columns to show the value of
+	// a custom field, like: customFields.Ticket, or all of them with
+	// customFields.*
+	csvCustomFieldPrefix = "customFields."
+)
+
+// isCustomFieldColumn reports if the column references a custom field,
+// returning the name of the field
+func isCustomFieldColumn(c, prefix string) (string, bool) {
+	if len(c) <= len(prefix) ||
+		!strings.EqualFold(c[:len(prefix)], prefix) {
+		return "", false
+	}
+
+	return strings.TrimSpace(c[len(prefix):]), true
+}
+
+// customFieldValue returns the value of the custom field with the name
+// (ignoring case) on the time entry formatted as text
+func customFieldValue(t dto.TimeEntry, name string) string {
+	for _, cf := range t.CustomFields {
+		if strings.EqualFold(cf.Name, name) {
+			return formatCustomFieldValue(cf.Value)
+		}
+	}
+
+	return ""
+}
+
+func formatCustomFieldValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []interface{}:
+		s := make([]string, len(v))
+		for i := range v {
+			s[i] = formatCustomFieldValue(v[i])
+		}
+		return strings.Join(s, ", ")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// customFieldNames returns the names of the custom fields of the time
+// entries, in the order they first appear
+func customFieldNames(timeEntries []dto.TimeEntry) []string {
+	names := make([]string, 0)
+	found := map[string]bool{}
+	for _, t := range timeEntries {
+		for _, cf := range t.CustomFields {
+			k := strings.ToLower(cf.Name)
+			if found[k] {
+				continue
+			}
+
+			found[k] = true
+			names = append(names, cf.Name)
+		}
+	}
+
+	return names
+}
@@ -1,16 +1,17 @@
 package timeentry
 
 import (
+	"bytes"
 	"fmt"
 	"io"
-	"os"
 	"strings"
 	"time"
 
 	"github.com/lucassabreu/clockify-cli/api/dto"
 	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+	"github.com/lucassabreu/clockify-cli/strhlp"
 	"github.com/olekukonko/tablewriter"
-	"golang.org/x/term"
+	"github.com/pkg/errors"
 )
 
 func sumTimeEntriesDuration(ts []dto.TimeEntry) time.Duration {
@@ -37,7 +38,231 @@ const (
 type TimeEntryOutputOptions struct {
 	ShowTasks         bool
 	ShowTotalDuration bool
+	ShowAmounts       bool
+	NoTruncate        bool
+	Plain             bool
+	TableWidth        int
+	ColumnMaxWidth    map[string]int
 	TimeFormat        string
+	ColumnAlignment   map[string]int
+	DurationFormatter func(time.Duration) string
+	DurationRounder   func(time.Duration) time.Duration
+	ShiftBoundary     *time.Time
+	Columns           []string
+	Location          *time.Location
+	Locale            Locale
+}
+
+// tableColumns are the columns that the "table" format can show, by the
+// lowercase name of its header
+var tableColumns = []string{"id", "start", "end", "dur",
+	"project", "task", "description", "tags", "rate", "amount", "status"}
+
+var tableHeaders = map[string]string{
+	"id":          "ID",
+	"start":       "Start",
+	"end":         "End",
+	"dur":         "Dur",
+	"project":     "Project",
+	"task":        "Task",
+	"description": "Description",
+	"tags":        "Tags",
+	"rate":        "Rate",
+	"amount":      "Amount",
+	"status":      "Status",
+}
+
+// tableColumn validates and normalizes the name of a table column, custom
+// fields are shown using their names after "cf:"
+func tableColumn(c string) (string, error) {
+	c = strings.TrimSpace(c)
+	if n, ok := isCustomFieldColumn(c, customFieldColumnPrefix); ok {
+		return customFieldColumnPrefix + n, nil
+	}
+
+	c = strings.ToLower(c)
+	if !strhlp.InSlice(c, tableColumns) {
+		return c, errors.Errorf(
+			"column \"%s\" does not exist, valid columns are: %s "+
+				"(or cf:<name> for custom fields)",
+			c, strhlp.ListForHumans(tableColumns))
+	}
+
+	return c, nil
+}
+
+// WithColumns sets which columns the table will have and in which order,
+// valid columns are: id, start, end, dur, project, task, description, tags,
+// rate, amount, status and cf:<name> for custom fields
+func WithColumns(columns []string) TimeEntryOutputOpt {
+	return func(teoo *TimeEntryOutputOptions) error {
+		teoo.Columns = make([]string, len(columns))
+		for i, c := range columns {
+			c, err := tableColumn(c)
+			if err != nil {
+				return err
+			}
+
+			teoo.Columns[i] = c
+		}
+
+		return nil
+	}
+}
+
+// WithNoTruncate will not limit the width of the columns, so long values are
+// shown in a single line
+func WithNoTruncate() TimeEntryOutputOpt {
+	return func(teoo *TimeEntryOutputOptions) error {
+		teoo.NoTruncate = true
+		return nil
+	}
+}
+
+// flatten replaces line breaks, tabs and repeated spaces with a single space,
+// so the value is printed in one line
+func flatten(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// WithPlain prints the table without borders and lines between the rows,
+// with the values of each column aligned and in a single line, to be easier
+// to parse with tools like awk and cut
+func WithPlain() TimeEntryOutputOpt {
+	return func(teoo *TimeEntryOutputOptions) error {
+		teoo.Plain = true
+		teoo.NoTruncate = true
+		return nil
+	}
+}
+
+// WithTableWidth sets the width used to limit the columns, instead of the
+// width of the terminal
+func WithTableWidth(width int) TimeEntryOutputOpt {
+	return func(teoo *TimeEntryOutputOptions) error {
+		if width <= 0 {
+			return errors.New("table width must be greater than zero")
+		}
+
+		teoo.TableWidth = width
+		return nil
+	}
+}
+
+// WithColumnMaxWidth sets the max width of each column (by its header), the
+// values are wrapped to fit in it
+func WithColumnMaxWidth(m map[string]int) TimeEntryOutputOpt {
+	return func(teoo *TimeEntryOutputOptions) error {
+		teoo.ColumnMaxWidth = make(map[string]int, len(m))
+		for c, w := range m {
+			c, err := tableColumn(c)
+			if err != nil {
+				return err
+			}
+
+			if w <= 0 {
+				return errors.Errorf(
+					"max width of column \"%s\" must be greater than zero",
+					c)
+			}
+
+			teoo.ColumnMaxWidth[c] = w
+		}
+
+		return nil
+	}
+}
+
+// WithShiftBoundary highlights the time entries that cross the time of the
+// day informed, showing how much time was after it
+func WithShiftBoundary(t time.Time) TimeEntryOutputOpt {
+	return func(teoo *TimeEntryOutputOptions) error {
+		teoo.ShiftBoundary = &t
+		return nil
+	}
+}
+
+// WithTimeZone sets in which time zone the start and end of the time entries
+// will be shown
+func WithTimeZone(loc *time.Location) TimeEntryOutputOpt {
+	return func(teoo *TimeEntryOutputOptions) error {
+		teoo.Location = loc
+		return nil
+	}
+}
+
+// WithLocale sets the locale used to show the dates, weekday names and
+// decimal numbers on the table
+func WithLocale(l Locale) TimeEntryOutputOpt {
+	return func(teoo *TimeEntryOutputOptions) error {
+		teoo.Locale = l
+		return nil
+	}
+}
+
+// WithDurationFormatter sets how the durations will be shown on the table
+func WithDurationFormatter(f func(time.Duration) string) TimeEntryOutputOpt {
+	return func(teoo *TimeEntryOutputOptions) error {
+		teoo.DurationFormatter = f
+		return nil
+	}
+}
+
+// WithDurationRounder sets how the durations of the time entries will be
+// rounded before being shown on the table, the total is the sum of the
+// rounded durations
+func WithDurationRounder(
+	r func(time.Duration) time.Duration) TimeEntryOutputOpt {
+	return func(teoo *TimeEntryOutputOptions) error {
+		teoo.DurationRounder = r
+		return nil
+	}
+}
+
+// WithDaysAsUnit shows the durations as decimal days, considering that a day
+// has the informed number of hours
+func WithDaysAsUnit(hoursPerDay float64) TimeEntryOutputOpt {
+	return func(teoo *TimeEntryOutputOptions) error {
+		if hoursPerDay <= 0 {
+			return errors.New("hours per day must be greater than zero")
+		}
+
+		teoo.DurationFormatter = func(d time.Duration) string {
+			return fmt.Sprintf("%.2f d", d.Hours()/hoursPerDay)
+		}
+		return nil
+	}
+}
+
+var alignments = map[string]int{
+	"left":   tablewriter.ALIGN_LEFT,
+	"right":  tablewriter.ALIGN_RIGHT,
+	"center": tablewriter.ALIGN_CENTER,
+}
+
+// WithColumnAlignment sets how each column (by its header) should be
+// aligned, valid alignments are: left, right and center
+func WithColumnAlignment(a map[string]string) TimeEntryOutputOpt {
+	return func(teoo *TimeEntryOutputOptions) error {
+		teoo.ColumnAlignment = make(map[string]int, len(a))
+		for c, v := range a {
+			c, err := tableColumn(c)
+			if err != nil {
+				return err
+			}
+
+			al, ok := alignments[strings.ToLower(strings.TrimSpace(v))]
+			if !ok {
+				return errors.Errorf(
+					"alignment \"%s\" for column \"%s\" is not valid, "+
+						"use left, right or center", v, c)
+			}
+
+			teoo.ColumnAlignment[c] = al
+		}
+
+		return nil
+	}
 }
 
 // WithTimeFormat sets the date-time output format
@@ -65,6 +290,15 @@ func WithTotalDuration() TimeEntryOutputOpt {
 	}
 }
 
+// WithAmounts shows the columns "rate" and "amount" with the hourly rate and
+// the amount of each time entry, and a footer with the total amounts
+func WithAmounts() TimeEntryOutputOpt {
+	return func(teoo *TimeEntryOutputOptions) error {
+		teoo.ShowAmounts = true
+		return nil
+	}
+}
+
 // TimeEntryOutputOpt allows the setting of TimeEntryOutputOptions values
 type TimeEntryOutputOpt func(*TimeEntryOutputOptions) error
 
@@ -74,6 +308,10 @@ func TimeEntriesPrint(opts ...TimeEntryOutputOpt) func([]dto.TimeEntry, io.Write
 		TimeFormat:        TimeFormatSimple,
 		ShowTasks:         false,
 		ShowTotalDuration: false,
+		DurationFormatter: durationToString,
+		DurationRounder:   DurationRounder(0, false),
+		Location:          time.Local,
+		Locale:            DefaultLocale,
 	}
 
 	for _, o := range opts {
@@ -83,27 +321,73 @@ func TimeEntriesPrint(opts ...TimeEntryOutputOpt) func([]dto.TimeEntry, io.Write
 		}
 	}
 
+	df := options.Locale.FormatDecimals(options.DurationFormatter)
+	format := func(t time.Time) string {
+		return options.Locale.FormatTime(
+			t.In(options.Location), options.TimeFormat)
+	}
+
 	return func(timeEntries []dto.TimeEntry, w io.Writer) error {
-		tw := tablewriter.NewWriter(w)
-		taskColumn := 6
-		projectColumn := 4
-		header := []string{"ID", "Start", "End", "Dur",
-			"Project", "Description", "Tags"}
-		if options.ShowTasks {
-			header = append(
-				header[:taskColumn],
-				header[taskColumn-1:]...,
-			)
-			header[taskColumn] = "Task"
-		}
-
-		tw.SetHeader(header)
+		columns := options.Columns
+		if len(columns) == 0 {
+			columns = []string{"id", "start", "end", "dur",
+				"project", "description", "tags"}
+			if options.ShowTasks {
+				columns = []string{"id", "start", "end", "dur",
+					"project", "description", "task", "tags"}
+			}
+		}
+
+		if options.ShowAmounts && !strhlp.InSlice("amount", columns) {
+			columns = append(columns, "rate", "amount")
+		}
+
+		out := w
+		if options.Plain {
+			out = &trimRightWriter{w: w}
+		}
+
+		tw := tablewriter.NewWriter(out)
+		header := make([]string, len(columns))
+		for i, c := range columns {
+			header[i] = tableHeaders[c]
+			if n, ok := isCustomFieldColumn(c, customFieldColumnPrefix); ok {
+				header[i] = n
+			}
+		}
+
+		util.SetThemedHeader(tw, header)
 		tw.SetRowLine(true)
-		if width, _, err := term.GetSize(int(os.Stdout.Fd())); err == nil {
-			tw.SetColWidth(width / 3)
+		tagsSep, lineSep := "\n", "\n"
+		if options.Plain {
+			tw.SetRowLine(false)
+			tw.SetBorder(false)
+			tw.SetHeaderLine(false)
+			tw.SetNoWhiteSpace(true)
+			tw.SetTablePadding("  ")
+			tw.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
+			tw.SetAlignment(tablewriter.ALIGN_LEFT)
+			tagsSep, lineSep = ", ", " "
+		}
+		if len(options.ColumnAlignment) > 0 {
+			al := make([]int, len(columns))
+			for i, c := range columns {
+				al[i] = options.ColumnAlignment[c]
+			}
+			tw.SetColumnAlignment(al)
+		}
+		switch {
+		case options.NoTruncate:
+			tw.SetAutoWrapText(false)
+		case options.TableWidth > 0:
+			tw.SetColWidth(options.TableWidth / 3)
+		default:
+			if width, ok := util.TerminalWidth(); ok {
+				tw.SetColWidth(width / 3)
+			}
 		}
 
-		colors := make([]tablewriter.Colors, len(header))
+		overtime := time.Duration(0)
 		for i := 0; i < len(timeEntries); i++ {
 			t := timeEntries[i]
 			end := time.Now()
@@ -111,47 +395,150 @@ func TimeEntriesPrint(opts ...TimeEntryOutputOpt) func([]dto.TimeEntry, io.Write
 				end = *t.TimeInterval.End
 			}
 
-			projectName := ""
-			colors[projectColumn] = []int{}
+			values := map[string]string{
+				"id":    t.ID,
+				"start": format(t.TimeInterval.Start),
+				"end":   format(end),
+				"dur": df(options.DurationRounder(
+					end.Sub(t.TimeInterval.Start))),
+				"description": t.Description,
+				"tags":        strings.Join(tagsToStringSlice(t.Tags), tagsSep),
+				"status":      t.ApprovalStatus(),
+			}
+
+			r, a := timeEntryAmount(t)
+			values["rate"] = formatRate(r)
+			values["amount"] = formatRate(a)
+			colors := map[string][]int{}
+
+			for _, c := range columns {
+				if n, ok := isCustomFieldColumn(
+					c, customFieldColumnPrefix); ok {
+					values[c] = customFieldValue(t, n)
+				}
+			}
+
 			if t.Project != nil {
-				colors[projectColumn] = util.ColorToTermColor(t.Project.Color)
-				projectName = t.Project.Name
+				colors["project"] = util.ColorToTermColor(t.Project.Color)
+				values["project"] = t.Project.Name
 			}
 
-			line := []string{
-				t.ID,
-				t.TimeInterval.Start.In(time.Local).Format(options.TimeFormat),
-				end.In(time.Local).Format(options.TimeFormat),
-				durationToString(end.Sub(t.TimeInterval.Start)),
-				projectName,
-				t.Description,
-				strings.Join(tagsToStringSlice(t.Tags), "\n"),
+			if t.Task != nil {
+				values["task"] = fmt.Sprintf("%s (%s)", t.Task.Name, t.Task.ID)
 			}
 
-			if options.ShowTasks {
-				line = append(line[:taskColumn], line[taskColumn-1:]...)
-				line[taskColumn] = ""
-				if t.Task != nil {
-					line[taskColumn] = fmt.Sprintf("%s (%s)", t.Task.Name, t.Task.ID)
+			if options.ShiftBoundary != nil {
+				if o := timeAfterShiftBoundary(
+					t, *options.ShiftBoundary); o > 0 {
+					overtime = overtime + o
+					values["dur"] = values["dur"] + lineSep + "(+" +
+						df(o) + ")"
+					colors["dur"] = util.TermColor(
+						tablewriter.Bold, tablewriter.FgRedColor)
 				}
 			}
 
-			tw.Rich(line, colors)
+			line := make([]string, len(columns))
+			lineColors := make([]tablewriter.Colors, len(columns))
+			for i, c := range columns {
+				line[i] = values[c]
+				if mw := options.ColumnMaxWidth[c]; mw > 0 {
+					line[i] = wrapText(line[i], mw)
+				}
+				if options.Plain {
+					line[i] = flatten(line[i])
+				}
+				lineColors[i] = colors[c]
+				if lineColors[i] == nil {
+					lineColors[i] = []int{}
+				}
+			}
+
+			tw.Rich(line, lineColors)
 		}
 
-		if options.ShowTotalDuration {
-			line := make([]string, len(header))
+		if options.ShowTotalDuration || options.ShowAmounts {
+			line := make([]string, len(columns))
 			line[0] = "TOTAL"
-			line[3] = durationToString(sumTimeEntriesDuration(timeEntries))
-			tw.Append(line)
+			for i, c := range columns {
+				switch c {
+				case "dur":
+					line[i] = df(sumRoundedDuration(
+						timeEntries, options.DurationRounder))
+					if overtime > 0 {
+						line[i] = line[i] + lineSep +
+							"(+" + df(overtime) + ")"
+					}
+				case "amount":
+					line[i] = formatAmounts(sumTimeEntriesAmounts(timeEntries))
+				}
+			}
+
+			colors := make([]tablewriter.Colors, len(columns))
+			for i := range colors {
+				colors[i] = util.TotalColor()
+			}
+			tw.Rich(line, colors)
 		}
 
 		tw.Render()
 
+		if t, ok := out.(*trimRightWriter); ok {
+			return t.Flush()
+		}
+
 		return nil
 	}
 }
 
+// trimRightWriter removes the spaces at the end of each line written
+type trimRightWriter struct {
+	w   io.Writer
+	buf bytes.Buffer
+}
+
+func (t *trimRightWriter) Write(p []byte) (int, error) {
+	return t.buf.Write(p)
+}
+
+// Flush writes the buffered lines without the spaces at their ends
+func (t *trimRightWriter) Flush() error {
+	lines := strings.SplitAfter(t.buf.String(), "\n")
+	for _, l := range lines {
+		nl := ""
+		if strings.HasSuffix(l, "\n") {
+			nl = "\n"
+		}
+
+		if _, err := io.WriteString(
+			t.w, strings.TrimRight(l, " \n")+nl); err != nil {
+			return err
+		}
+	}
+
+	t.buf.Reset()
+	return nil
+}
+
+// wrapText breaks the text in lines with at most width characters, breaking
+// between words when possible
+func wrapText(s string, width int) string {
+	lines := make([]string, 0)
+	for _, l := range strings.Split(s, "\n") {
+		ws, _ := tablewriter.WrapString(l, width)
+		for _, w := range ws {
+			r := []rune(w)
+			for len(r) > width {
+				lines = append(lines, string(r[:width]))
+				r = r[width:]
+			}
+			lines = append(lines, string(r))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
 func tagsToStringSlice(tags []dto.Tag) []string {
 	s := make([]string, len(tags))
 
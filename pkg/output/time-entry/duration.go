@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/strhlp"
+	"github.com/pkg/errors"
 )
 
 func timeEntriesTotalDurationOnly(
@@ -17,11 +19,16 @@ func timeEntriesTotalDurationOnly(
 	return err
 }
 
+// DurationAsFloat formats the duration as hours in a float number
+func DurationAsFloat(d time.Duration) string {
+	return fmt.Sprintf("%f", d.Hours())
+}
+
 // TimeEntriesTotalDurationOnlyAsFloat will only print the total duration as
 // float
 func TimeEntriesTotalDurationOnlyAsFloat(timeEntries []dto.TimeEntry, w io.Writer) error {
 	return timeEntriesTotalDurationOnly(
-		func(d time.Duration) string { return fmt.Sprintf("%f", d.Hours()) },
+		DurationAsFloat,
 		timeEntries,
 		w,
 	)
@@ -37,3 +44,128 @@ func TimeEntriesTotalDurationOnlyFormatted(
 		w,
 	)
 }
+
+// DurationFormats are the names of the formats accepted by DurationFormatter
+var DurationFormats = []string{"hms", "decimal", "minutes", "hm", "iso"}
+
+// DurationFormatter returns a function to format durations by the name of
+// the format, which can be:
+//   - hms: hours, minutes and seconds (1:30:00)
+//   - decimal: hours as a decimal number (1.50)
+//   - minutes: whole minutes (90)
+//   - hm: hours and minutes (01h30m)
+//   - iso: ISO-8601 duration (PT1H30M)
+func DurationFormatter(name string) (func(time.Duration) string, error) {
+	switch name {
+	case "", "hms":
+		return durationToString, nil
+	case "decimal":
+		return func(d time.Duration) string {
+			return fmt.Sprintf("%.2f", d.Hours())
+		}, nil
+	case "minutes":
+		return func(d time.Duration) string {
+			return fmt.Sprintf("%d", int64(d.Minutes()))
+		}, nil
+	case "hm":
+		return signedDuration(func(d time.Duration) string {
+			return fmt.Sprintf("%02dh%02dm",
+				int64(d.Hours()), int64(d.Minutes())%60)
+		}), nil
+	case "iso":
+		return signedDuration(durationToISO), nil
+	default:
+		return nil, errors.Errorf(
+			"duration format \"%s\" is not valid, use one of: %s",
+			name, strhlp.ListForHumans(DurationFormats))
+	}
+}
+
+func signedDuration(f func(time.Duration) string) func(time.Duration) string {
+	return func(d time.Duration) string {
+		if d < 0 {
+			return "-" + f(-d)
+		}
+
+		return f(d)
+	}
+}
+
+func durationToISO(d time.Duration) string {
+	d = d.Truncate(time.Second)
+	if d == 0 {
+		return "PT0S"
+	}
+
+	s := "PT"
+	if h := int64(d.Hours()); h > 0 {
+		s = s + fmt.Sprintf("%dH", h)
+	}
+
+	if m := int64(d.Minutes()) % 60; m > 0 {
+		s = s + fmt.Sprintf("%dM", m)
+	}
+
+	if sec := int64(d.Seconds()) % 60; sec > 0 {
+		s = s + fmt.Sprintf("%dS", sec)
+	}
+
+	return s
+}
+
+// TimeEntriesTotalDurationOnly will only print the total duration, using the
+// formatter informed
+func TimeEntriesTotalDurationOnly(
+	f func(time.Duration) string,
+) func([]dto.TimeEntry, io.Writer) error {
+	return func(timeEntries []dto.TimeEntry, w io.Writer) error {
+		return timeEntriesTotalDurationOnly(f, timeEntries, w)
+	}
+}
+
+// TimeEntriesRoundedTotalDurationOnly will only print the total duration,
+// rounding the duration of each time entry before summing them
+func TimeEntriesRoundedTotalDurationOnly(
+	f func(time.Duration) string, r func(time.Duration) time.Duration,
+) func([]dto.TimeEntry, io.Writer) error {
+	return func(timeEntries []dto.TimeEntry, w io.Writer) error {
+		_, err := fmt.Fprintln(w, f(sumRoundedDuration(timeEntries, r)))
+		return err
+	}
+}
+
+// DurationRounder returns a function that rounds durations to a multiple of
+// the increment, to the nearest one or always up
+func DurationRounder(
+	increment time.Duration, up bool) func(time.Duration) time.Duration {
+	if increment <= 0 {
+		return func(d time.Duration) time.Duration { return d }
+	}
+
+	if !up {
+		return func(d time.Duration) time.Duration {
+			return d.Round(increment)
+		}
+	}
+
+	return func(d time.Duration) time.Duration {
+		if t := d.Truncate(increment); t != d {
+			return t + increment
+		}
+
+		return d
+	}
+}
+
+// sumRoundedDuration returns the sum of the durations of the time entries,
+// after rounding each one of them
+func sumRoundedDuration(
+	timeEntries []dto.TimeEntry, r func(time.Duration) time.Duration,
+) time.Duration {
+	s := time.Duration(0)
+	for i := range timeEntries {
+		s = s + r(sumTimeEntriesDuration(timeEntries[i:i+1]))
+	}
+
+	return s
+}
@@ -0,0 +1,56 @@
+package timeentry
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// TimeEntriesEncodedPrint will print the time entries as a single line
+// payload, to be easily shared on text-only channels.
+//
+// The payload is the JSON array of the time entries (same as
+// TimeEntriesJSONPrint), compressed with gzip and encoded with the standard
+// base64 encoding (RFC 4648, with padding)
+func TimeEntriesEncodedPrint(timeEntries []dto.TimeEntry, w io.Writer) error {
+	b := new(bytes.Buffer)
+	gz := gzip.NewWriter(b)
+	if err := json.NewEncoder(gz).Encode(timeEntries); err != nil {
+		return err
+	}
+
+	if err := gz.Close(); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(
+		w, base64.StdEncoding.EncodeToString(b.Bytes())+"\n")
+	return err
+}
+
+// DecodeTimeEntries reads time entries from a payload created by
+// TimeEntriesEncodedPrint
+func DecodeTimeEntries(payload string) ([]dto.TimeEntry, error) {
+	b, err := base64.StdEncoding.DecodeString(strings.TrimSpace(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	gz, err := gzip.NewReader(bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	var tes []dto.TimeEntry
+	if err := json.NewDecoder(gz).Decode(&tes); err != nil {
+		return nil, err
+	}
+
+	return tes, nil
+}
@@ -0,0 +1,278 @@
+package timeentry
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+	"github.com/lucassabreu/clockify-cli/strhlp"
+	"github.com/olekukonko/tablewriter"
+	"github.com/pkg/errors"
+)
+
+// TimeEntryGroupFields are the fields the time entries can be grouped by
+var TimeEntryGroupFields = []string{"client", "day", "project", "tag", "task"}
+
+var timeEntryGroupKeys = map[string]func(dto.TimeEntry) []string{
+	"client": func(t dto.TimeEntry) []string {
+		if t.Project == nil || t.Project.ClientName == "" {
+			return []string{"No Client"}
+		}
+
+		return []string{t.Project.ClientName}
+	},
+	"day": func(t dto.TimeEntry) []string {
+		return []string{
+			t.TimeInterval.Start.In(time.Local).Format("2006-01-02")}
+	},
+	"project": func(t dto.TimeEntry) []string {
+		if t.Project == nil {
+			return []string{"No Project"}
+		}
+
+		return []string{t.Project.Name}
+	},
+	"tag": func(t dto.TimeEntry) []string {
+		if len(t.Tags) == 0 {
+			return []string{"No Tag"}
+		}
+
+		tags := make([]string, len(t.Tags))
+		for i := range t.Tags {
+			tags[i] = t.Tags[i].Name
+		}
+
+		return tags
+	},
+	"task": func(t dto.TimeEntry) []string {
+		if t.Task == nil {
+			return []string{"No Task"}
+		}
+
+		return []string{t.Task.Name}
+	},
+}
+
+// TimeEntryGroup is a set of time entries with the same value on the field
+// they were grouped by
+type TimeEntryGroup struct {
+	Name        string          `json:"name"`
+	Duration    dto.Duration    `json:"duration"`
+	TimeEntries []dto.TimeEntry `json:"timeEntries"`
+}
+
+// GroupTimeEntries splits the time entries by one of the
+// TimeEntryGroupFields, the groups are in the order they first appear.
+//
+// When grouped by tag, a time entry will be in the group of each one of its
+// tags
+func GroupTimeEntries(
+	timeEntries []dto.TimeEntry, by string) ([]TimeEntryGroup, error) {
+	key, ok := timeEntryGroupKeys[by]
+	if !ok {
+		return nil, errors.Errorf(
+			"can't group by \"%s\", valid fields are: %s",
+			by, strhlp.ListForHumans(TimeEntryGroupFields))
+	}
+
+	groups := make([]TimeEntryGroup, 0)
+	index := make(map[string]int)
+	for i := range timeEntries {
+		for _, k := range key(timeEntries[i]) {
+			g, ok := index[k]
+			if !ok {
+				g = len(groups)
+				index[k] = g
+				groups = append(groups, TimeEntryGroup{Name: k})
+			}
+
+			groups[g].TimeEntries = append(
+				groups[g].TimeEntries, timeEntries[i])
+		}
+	}
+
+	for i := range groups {
+		groups[i].Duration = dto.Duration{
+			Duration: sumTimeEntriesDuration(groups[i].TimeEntries)}
+	}
+
+	return groups, nil
+}
+
+// SplitTagsDuration recalculates the durations of groups made by tag,
+// dividing the duration of each time entry equally between its tags, so the
+// sum of the groups is the same as the sum of the time entries
+func SplitTagsDuration(groups []TimeEntryGroup) {
+	for i := range groups {
+		d := time.Duration(0)
+		for _, t := range groups[i].TimeEntries {
+			n := len(t.Tags)
+			if n == 0 {
+				n = 1
+			}
+
+			d = d + sumTimeEntriesDuration([]dto.TimeEntry{t})/
+				time.Duration(n)
+		}
+
+		groups[i].Duration = dto.Duration{Duration: d}
+	}
+}
+
+func groupTimeEntries(
+	timeEntries []dto.TimeEntry, by string, splitTags bool,
+) ([]TimeEntryGroup, error) {
+	groups, err := GroupTimeEntries(timeEntries, by)
+	if err != nil {
+		return nil, err
+	}
+
+	if splitTags && by == "tag" {
+		SplitTagsDuration(groups)
+	}
+
+	return groups, nil
+}
+
+// TimeEntriesGroupSummaryPrint will print a table with the subtotal and
+// count of time entries of each group, and the overall total at the end.
+//
+// When grouped by tag and splitTags is true, the duration of a time entry
+// is divided between its tags, instead of being counted for each one
+func TimeEntriesGroupSummaryPrint(
+	by string, splitTags bool,
+) func([]dto.TimeEntry, io.Writer) error {
+	return func(timeEntries []dto.TimeEntry, w io.Writer) error {
+		groups, err := groupTimeEntries(timeEntries, by, splitTags)
+		if err != nil {
+			return err
+		}
+
+		tw := tablewriter.NewWriter(w)
+		util.SetThemedHeader(tw, []string{
+			strings.ToUpper(by[:1]) + by[1:], "Entries", "Duration"})
+		tw.SetColumnAlignment([]int{tablewriter.ALIGN_LEFT,
+			tablewriter.ALIGN_RIGHT, tablewriter.ALIGN_RIGHT})
+		for _, g := range groups {
+			tw.Append([]string{
+				g.Name,
+				strconv.Itoa(len(g.TimeEntries)),
+				durationToString(g.Duration.Duration),
+			})
+		}
+
+		colors := make([]tablewriter.Colors, 3)
+		for i := range colors {
+			colors[i] = util.TotalColor()
+		}
+		tw.Rich([]string{
+			"TOTAL",
+			strconv.Itoa(len(timeEntries)),
+			durationToString(sumTimeEntriesDuration(timeEntries)),
+		}, colors)
+
+		tw.Render()
+		return nil
+	}
+}
+
+// TimeEntriesGroupedPrint will print a table for each group of time entries,
+// with its name and subtotal before it, and the overall total at the end
+func TimeEntriesGroupedPrint(
+	by string, opts ...TimeEntryOutputOpt,
+) func([]dto.TimeEntry, io.Writer) error {
+	return func(timeEntries []dto.TimeEntry, w io.Writer) error {
+		groups, err := GroupTimeEntries(timeEntries, by)
+		if err != nil {
+			return err
+		}
+
+		for _, g := range groups {
+			if _, err := fmt.Fprintf(w, "%s - %s\n",
+				g.Name, durationToString(g.Duration.Duration)); err != nil {
+				return err
+			}
+
+			if err := TimeEntriesPrint(opts...)(g.TimeEntries, w); err != nil {
+				return err
+			}
+
+			if _, err := fmt.Fprintln(w); err != nil {
+				return err
+			}
+		}
+
+		_, err = fmt.Fprintf(w, "TOTAL - %s\n",
+			durationToString(sumTimeEntriesDuration(timeEntries)))
+		return err
+	}
+}
+
+// TimeEntriesGroupedCSVPrint will print a CSV with the subtotal and count of
+// time entries of each group, and a last line with the overall total (see
+// TimeEntriesGroupSummaryPrint about splitTags)
+func TimeEntriesGroupedCSVPrint(
+	by string, splitTags bool,
+) func([]dto.TimeEntry, io.Writer) error {
+	return func(timeEntries []dto.TimeEntry, out io.Writer) error {
+		groups, err := groupTimeEntries(timeEntries, by, splitTags)
+		if err != nil {
+			return err
+		}
+
+		w := csv.NewWriter(out)
+		if err := w.Write([]string{by, "count", "duration"}); err != nil {
+			return err
+		}
+
+		for _, g := range groups {
+			if err := w.Write([]string{
+				g.Name,
+				strconv.Itoa(len(g.TimeEntries)),
+				durationToString(g.Duration.Duration),
+			}); err != nil {
+				return err
+			}
+		}
+
+		if err := w.Write([]string{
+			"TOTAL",
+			strconv.Itoa(len(timeEntries)),
+			durationToString(sumTimeEntriesDuration(timeEntries)),
+		}); err != nil {
+			return err
+		}
+
+		w.Flush()
+		return w.Error()
+	}
+}
+
+// TimeEntriesGroupedJSONPrint will print the groups of time entries as JSON,
+// with their subtotals and the overall total (see
+// TimeEntriesGroupSummaryPrint about splitTags)
+func TimeEntriesGroupedJSONPrint(
+	by string, splitTags bool,
+) func([]dto.TimeEntry, io.Writer) error {
+	return func(timeEntries []dto.TimeEntry, w io.Writer) error {
+		groups, err := groupTimeEntries(timeEntries, by, splitTags)
+		if err != nil {
+			return err
+		}
+
+		return json.NewEncoder(w).Encode(struct {
+			Groups   []TimeEntryGroup `json:"groups"`
+			Duration dto.Duration     `json:"duration"`
+		}{
+			Groups: groups,
+			Duration: dto.Duration{
+				Duration: sumTimeEntriesDuration(timeEntries)},
+		})
+	}
+}
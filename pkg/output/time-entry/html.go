@@ -0,0 +1,128 @@
+package timeentry
+
+import (
+	_ "embed"
+	"html/template"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+)
+
+//go:embed html.gotmpl.html
+var htmlTemplate string
+
+const htmlTimeFormat = timehlp.FullTimeFormat
+
+// HTMLRow is a time entry as a row of the HTML report
+type HTMLRow struct {
+	ID           string
+	Start        string
+	End          string
+	Duration     string
+	Project      string
+	ProjectColor string
+	Description  string
+	Tags         string
+}
+
+// HTMLSection is a table of the HTML report, with its total duration
+type HTMLSection struct {
+	Title string
+	Rows  []HTMLRow
+	Total string
+}
+
+// HTMLOptions sets how the HTML report should be sectioned
+type HTMLOptions struct {
+	GroupBy string
+}
+
+// HTMLOpt allows the setting of HTMLOptions values
+type HTMLOpt func(*HTMLOptions) error
+
+// WithHTMLGroupBy will split the time entries into a table for each group,
+// valid groups are the TimeEntryGroupFields
+func WithHTMLGroupBy(groupBy string) HTMLOpt {
+	return func(ho *HTMLOptions) error {
+		ho.GroupBy = groupBy
+		return nil
+	}
+}
+
+func htmlRow(t dto.TimeEntry) HTMLRow {
+	end := timehlp.Now()
+	r := HTMLRow{
+		ID:          t.ID,
+		Start:       t.TimeInterval.Start.In(time.Local).Format(htmlTimeFormat),
+		End:         "now",
+		Description: t.Description,
+	}
+
+	if t.TimeInterval.End != nil {
+		end = *t.TimeInterval.End
+		r.End = end.In(time.Local).Format(htmlTimeFormat)
+	}
+	r.Duration = durationToString(end.Sub(t.TimeInterval.Start))
+
+	if t.Project != nil {
+		r.Project = t.Project.Name
+		r.ProjectColor = t.Project.Color
+	}
+
+	tags := make([]string, len(t.Tags))
+	for i := range t.Tags {
+		tags[i] = t.Tags[i].Name
+	}
+	r.Tags = strings.Join(tags, ", ")
+
+	return r
+}
+
+// TimeEntriesHTMLPrint will print the time entries as a standalone HTML page
+// with a table of them and its total duration
+func TimeEntriesHTMLPrint(
+	opts ...HTMLOpt,
+) func([]dto.TimeEntry, io.Writer) error {
+	options := &HTMLOptions{}
+	for _, o := range opts {
+		if err := o(options); err != nil {
+			return func(_ []dto.TimeEntry, _ io.Writer) error { return err }
+		}
+	}
+
+	return func(timeEntries []dto.TimeEntry, w io.Writer) error {
+		t, err := template.New("html").Parse(htmlTemplate)
+		if err != nil {
+			return err
+		}
+
+		groups := []TimeEntryGroup{{TimeEntries: timeEntries}}
+		if options.GroupBy != "" {
+			if groups, err = GroupTimeEntries(
+				timeEntries, options.GroupBy); err != nil {
+				return err
+			}
+		}
+
+		sections := make([]HTMLSection, len(groups))
+		for i, g := range groups {
+			tes := g.TimeEntries
+			s := HTMLSection{
+				Title: g.Name,
+				Rows:  make([]HTMLRow, len(tes)),
+				Total: durationToString(sumTimeEntriesDuration(tes)),
+			}
+
+			for j := range tes {
+				s.Rows[j] = htmlRow(tes[j])
+			}
+
+			sections[i] = s
+		}
+
+		return t.Execute(w, struct{ Sections []HTMLSection }{sections})
+	}
+}
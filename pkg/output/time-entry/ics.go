@@ -0,0 +1,25 @@
+package timeentry
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+)
+
+// TimeEntriesICSPrint will print the time entries as a iCalendar (.ics) with
+// a event for each one, to be imported into calendar applications
+func TimeEntriesICSPrint(timeEntries []dto.TimeEntry, w io.Writer) error {
+	stamp := timehlp.Now()
+	events := make([][]string, 0, len(timeEntries))
+	for i := range timeEntries {
+		if timeEntries[i].ID == "" {
+			continue
+		}
+
+		events = append(events, icalEvent(timeEntries[i], stamp))
+	}
+
+	_, err := io.WriteString(w, icalCalendar(events...))
+	return err
+}
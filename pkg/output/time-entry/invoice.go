@@ -0,0 +1,159 @@
+package timeentry
+
+import (
+	_ "embed"
+	"fmt"
+	"html/template"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+)
+
+//go:embed invoice.gotmpl.html
+var invoiceTemplate string
+
+// InvoiceLine is a billable time entry as a line of the invoice
+type InvoiceLine struct {
+	Date        string
+	Description string
+	Project     string
+	Hours       string
+	Rate        string
+	Amount      string
+}
+
+// InvoiceTotal is the sum of hours and amounts of a currency in the invoice
+type InvoiceTotal struct {
+	Hours  string
+	Amount string
+}
+
+// Invoice is the data used to render the invoice HTML
+type Invoice struct {
+	From    string
+	To      string
+	Clients []string
+	Lines   []InvoiceLine
+	Totals  []InvoiceTotal
+}
+
+// resolveHourlyRate returns the hourly rate of the time entry, or of its
+// project when the time entry has none
+func resolveHourlyRate(t dto.TimeEntry) dto.Rate {
+	if t.HourlyRate.Amount == 0 && t.Project != nil {
+		return t.Project.HourlyRate
+	}
+
+	return t.HourlyRate
+}
+
+// formatCurrency formats a amount in cents with the currency code
+func formatCurrency(amount int64, currency string) string {
+	s := fmt.Sprintf("%.2f", float64(amount)/100)
+	if currency == "" {
+		return s
+	}
+
+	return s + " " + currency
+}
+
+func formatHours(d time.Duration) string {
+	return fmt.Sprintf("%.2f", d.Hours())
+}
+
+// TimeEntriesInvoiceHTMLPrint will print a invoice as a HTML ready to be
+// printed, with a line for each billable time entry and its amount based on
+// the hourly rate of the time entry or its project
+func TimeEntriesInvoiceHTMLPrint(
+	timeEntries []dto.TimeEntry, w io.Writer) error {
+	t, err := template.New("invoice").
+		Funcs(template.FuncMap{"join": strings.Join}).
+		Parse(invoiceTemplate)
+	if err != nil {
+		return err
+	}
+
+	var from, to time.Time
+	clients := make(map[string]bool)
+	hours := make(map[string]time.Duration)
+	amounts := make(map[string]int64)
+	lines := make([]InvoiceLine, 0, len(timeEntries))
+	for i := range timeEntries {
+		te := timeEntries[i]
+		if !te.Billable {
+			continue
+		}
+
+		start := te.TimeInterval.Start.In(time.Local)
+		end := timehlp.Now().In(time.Local)
+		if te.TimeInterval.End != nil {
+			end = te.TimeInterval.End.In(time.Local)
+		}
+
+		if from.IsZero() || start.Before(from) {
+			from = start
+		}
+		if end.After(to) {
+			to = end
+		}
+
+		l := InvoiceLine{
+			Date:        start.Format("2006-01-02"),
+			Description: te.Description,
+		}
+
+		if te.Project != nil {
+			l.Project = te.Project.Name
+			if te.Project.ClientName != "" {
+				clients[te.Project.ClientName] = true
+			}
+		}
+
+		d := end.Sub(start)
+		r := resolveHourlyRate(te)
+		amount := int64(float64(r.Amount) * d.Hours())
+
+		l.Hours = formatHours(d)
+		l.Rate = formatCurrency(r.Amount, r.Currency)
+		l.Amount = formatCurrency(amount, r.Currency)
+		lines = append(lines, l)
+
+		hours[r.Currency] = hours[r.Currency] + d
+		amounts[r.Currency] = amounts[r.Currency] + amount
+	}
+
+	currencies := make([]string, 0, len(hours))
+	for c := range hours {
+		currencies = append(currencies, c)
+	}
+	sort.Strings(currencies)
+
+	inv := Invoice{
+		Lines:   lines,
+		Clients: make([]string, 0, len(clients)),
+		Totals:  make([]InvoiceTotal, len(currencies)),
+	}
+
+	if len(lines) > 0 {
+		inv.From = from.Format("2006-01-02")
+		inv.To = to.Format("2006-01-02")
+	}
+
+	for c := range clients {
+		inv.Clients = append(inv.Clients, c)
+	}
+	sort.Strings(inv.Clients)
+
+	for i, c := range currencies {
+		inv.Totals[i] = InvoiceTotal{
+			Hours:  formatHours(hours[c]),
+			Amount: formatCurrency(amounts[c], c),
+		}
+	}
+
+	return t.Execute(w, inv)
+}
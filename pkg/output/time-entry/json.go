@@ -16,3 +16,16 @@ func TimeEntryJSONPrint(t dto.TimeEntry, w io.Writer) error {
 func TimeEntriesJSONPrint(t []dto.TimeEntry, w io.Writer) error {
 	return json.NewEncoder(w).Encode(t)
 }
+
+// TimeEntriesJSONLinesPrint will print each time entry as a JSON in its own
+// line (JSON Lines), writing them one at a time
+func TimeEntriesJSONLinesPrint(t []dto.TimeEntry, w io.Writer) error {
+	e := json.NewEncoder(w)
+	for i := range t {
+		if err := e.Encode(t[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
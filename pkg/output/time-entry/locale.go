@@ -0,0 +1,167 @@
+package timeentry
+
+import (
+	"strings"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/strhlp"
+	"github.com/pkg/errors"
+	"golang.org/x/text/language"
+)
+
+// Locale sets how dates, weekday names and decimal numbers are shown
+type Locale struct {
+	Name             string
+	DateFormat       string
+	DecimalSeparator string
+	Weekdays         [7]string
+	ShortWeekdays    [7]string
+}
+
+// DefaultLocale keeps dates as ISO-8601 and decimals with a dot, as they are
+// shown when no locale is set
+var DefaultLocale = Locale{
+	DateFormat:       "2006-01-02",
+	DecimalSeparator: ".",
+}
+
+var locales = []Locale{
+	{
+		Name:             "en-US",
+		DateFormat:       "01/02/2006",
+		DecimalSeparator: ".",
+	},
+	{
+		Name:             "en-GB",
+		DateFormat:       "02/01/2006",
+		DecimalSeparator: ".",
+	},
+	{
+		Name:             "pt-BR",
+		DateFormat:       "02/01/2006",
+		DecimalSeparator: ",",
+		Weekdays: [7]string{"domingo", "segunda-feira", "terça-feira",
+			"quarta-feira", "quinta-feira", "sexta-feira", "sábado"},
+		ShortWeekdays: [7]string{
+			"dom", "seg", "ter", "qua", "qui", "sex", "sáb"},
+	},
+	{
+		Name:             "de-DE",
+		DateFormat:       "02.01.2006",
+		DecimalSeparator: ",",
+		Weekdays: [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch",
+			"Donnerstag", "Freitag", "Samstag"},
+		ShortWeekdays: [7]string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"},
+	},
+	{
+		Name:             "fr-FR",
+		DateFormat:       "02/01/2006",
+		DecimalSeparator: ",",
+		Weekdays: [7]string{"dimanche", "lundi", "mardi", "mercredi",
+			"jeudi", "vendredi", "samedi"},
+		ShortWeekdays: [7]string{
+			"dim", "lun", "mar", "mer", "jeu", "ven", "sam"},
+	},
+	{
+		Name:             "es-ES",
+		DateFormat:       "02/01/2006",
+		DecimalSeparator: ",",
+		Weekdays: [7]string{"domingo", "lunes", "martes", "miércoles",
+			"jueves", "viernes", "sábado"},
+		ShortWeekdays: [7]string{
+			"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+	},
+	{
+		Name:             "it-IT",
+		DateFormat:       "02/01/2006",
+		DecimalSeparator: ",",
+		Weekdays: [7]string{"domenica", "lunedì", "martedì", "mercoledì",
+			"giovedì", "venerdì", "sabato"},
+		ShortWeekdays: [7]string{
+			"dom", "lun", "mar", "mer", "gio", "ven", "sab"},
+	},
+	{
+		Name:             "nl-NL",
+		DateFormat:       "02-01-2006",
+		DecimalSeparator: ",",
+		Weekdays: [7]string{"zondag", "maandag", "dinsdag", "woensdag",
+			"donderdag", "vrijdag", "zaterdag"},
+		ShortWeekdays: [7]string{"zo", "ma", "di", "wo", "do", "vr", "za"},
+	},
+}
+
+// LocaleNames are the locales accepted by GetLocale, other variants of
+// these languages (like "pt" or "de-AT") will use the closest one
+var LocaleNames = func() []string {
+	n := make([]string, len(locales))
+	for i := range locales {
+		n[i] = locales[i].Name
+	}
+	return n
+}()
+
+var localeMatcher = func() language.Matcher {
+	tags := make([]language.Tag, len(locales))
+	for i := range locales {
+		tags[i] = language.MustParse(locales[i].Name)
+	}
+	return language.NewMatcher(tags)
+}()
+
+// GetLocale returns the supported locale closest to the name informed (like
+// "de-DE" or "pt_BR"), an empty name returns the DefaultLocale
+func GetLocale(name string) (Locale, error) {
+	if name == "" {
+		return DefaultLocale, nil
+	}
+
+	err := errors.Errorf(
+		"locale \"%s\" is not supported, use one of: %s",
+		name, strhlp.ListForHumans(LocaleNames))
+
+	t, perr := language.Parse(strings.ReplaceAll(name, "_", "-"))
+	if perr != nil {
+		return DefaultLocale, err
+	}
+
+	_, i, c := localeMatcher.Match(t)
+	if c < language.High {
+		return DefaultLocale, err
+	}
+
+	return locales[i], nil
+}
+
+const (
+	localeWeekdayMark      = "\x00W\x00"
+	localeShortWeekdayMark = "\x00w\x00"
+)
+
+// FormatTime formats the time using the layout, replacing ISO-8601 dates
+// with the locale's date format and translating the weekday names
+func (l Locale) FormatTime(t time.Time, layout string) string {
+	layout = strings.ReplaceAll(layout, "2006-01-02", l.DateFormat)
+	if l.Weekdays[0] == "" {
+		return t.Format(layout)
+	}
+
+	layout = strings.ReplaceAll(layout, "Monday", localeWeekdayMark)
+	layout = strings.ReplaceAll(layout, "Mon", localeShortWeekdayMark)
+
+	s := t.Format(layout)
+	s = strings.ReplaceAll(s, localeWeekdayMark, l.Weekdays[t.Weekday()])
+	return strings.ReplaceAll(
+		s, localeShortWeekdayMark, l.ShortWeekdays[t.Weekday()])
+}
+
+// FormatDecimals changes the formatter to use the locale's decimal separator
+func (l Locale) FormatDecimals(
+	f func(time.Duration) string) func(time.Duration) string {
+	if l.DecimalSeparator == "." {
+		return f
+	}
+
+	return func(d time.Duration) string {
+		return strings.ReplaceAll(f(d), ".", l.DecimalSeparator)
+	}
+}
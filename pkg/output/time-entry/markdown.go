@@ -2,9 +2,13 @@ package timeentry
 
 import (
 	_ "embed"
+	"fmt"
 	"io"
+	"strings"
+	"time"
 
 	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
 )
 
 //go:embed template.gotmpl.md
@@ -14,3 +18,66 @@ var mdTemplate string
 func TimeEntriesMarkdownPrint(tes []dto.TimeEntry, w io.Writer) error {
 	return TimeEntriesPrintWithTemplate(mdTemplate)(tes, w)
 }
+
+var mdCellEscaper = strings.NewReplacer(
+	"|", `\|`,
+	"\r\n", "<br>",
+	"\n", "<br>",
+)
+
+func mdTableRow(w io.Writer, cells ...string) error {
+	for i := range cells {
+		cells[i] = mdCellEscaper.Replace(cells[i])
+	}
+
+	_, err := fmt.Fprintf(w, "| %s |\n", strings.Join(cells, " | "))
+	return err
+}
+
+// TimeEntriesMarkdownTablePrint will print time entries as a GitHub
+// flavored markdown table, with a totals row at the end
+func TimeEntriesMarkdownTablePrint(tes []dto.TimeEntry, w io.Writer) error {
+	if err := mdTableRow(w, "ID", "Start", "End", "Dur", "Project",
+		"Description", "Tags"); err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintln(w,
+		"|---|---|---|--:|---|---|---|"); err != nil {
+		return err
+	}
+
+	for _, t := range tes {
+		end := timehlp.Now()
+		endStr := ""
+		if t.TimeInterval.End != nil {
+			end = *t.TimeInterval.End
+			endStr = end.In(time.Local).Format(timehlp.FullTimeFormat)
+		}
+
+		project := ""
+		if t.Project != nil {
+			project = t.Project.Name
+		}
+
+		tags := make([]string, len(t.Tags))
+		for i := range t.Tags {
+			tags[i] = t.Tags[i].Name
+		}
+
+		if err := mdTableRow(w,
+			t.ID,
+			t.TimeInterval.Start.In(time.Local).Format(timehlp.FullTimeFormat),
+			endStr,
+			durationToString(end.Sub(t.TimeInterval.Start)),
+			project,
+			t.Description,
+			strings.Join(tags, ", "),
+		); err != nil {
+			return err
+		}
+	}
+
+	return mdTableRow(w, "**TOTAL**", "", "",
+		"**"+durationToString(sumTimeEntriesDuration(tes))+"**", "", "", "")
+}
@@ -0,0 +1,157 @@
+package timeentry
+
+import (
+	"io"
+	"sort"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+	"github.com/olekukonko/tablewriter"
+)
+
+// MatrixOptions sets how the matrix of projects and days will be printed
+type MatrixOptions struct {
+	DurationFormatter func(time.Duration) string
+	Location          *time.Location
+	Locale            Locale
+}
+
+// MatrixOpt allows the setting of MatrixOptions values
+type MatrixOpt func(*MatrixOptions) error
+
+// WithMatrixDurationFormatter sets how the durations on the cells will be
+// shown
+func WithMatrixDurationFormatter(f func(time.Duration) string) MatrixOpt {
+	return func(mo *MatrixOptions) error {
+		mo.DurationFormatter = f
+		return nil
+	}
+}
+
+// WithMatrixTimeZone sets in which time zone the days are split
+func WithMatrixTimeZone(l *time.Location) MatrixOpt {
+	return func(mo *MatrixOptions) error {
+		mo.Location = l
+		return nil
+	}
+}
+
+// WithMatrixLocale sets how the days and numbers will be shown
+func WithMatrixLocale(l Locale) MatrixOpt {
+	return func(mo *MatrixOptions) error {
+		mo.Locale = l
+		return nil
+	}
+}
+
+// TimeEntriesMatrixPrint will print a table with the projects as rows, the
+// days as columns and the durations on the cells, with the totals of each
+// project and day.
+//
+// Time entries without ID (like the ones used to fill missing dates) only
+// add their days as columns
+func TimeEntriesMatrixPrint(
+	opts ...MatrixOpt) func([]dto.TimeEntry, io.Writer) error {
+	options := MatrixOptions{
+		DurationFormatter: durationToString,
+		Location:          time.Local,
+		Locale:            DefaultLocale,
+	}
+
+	for _, o := range opts {
+		if err := o(&options); err != nil {
+			return func(_ []dto.TimeEntry, _ io.Writer) error { return err }
+		}
+	}
+
+	df := options.Locale.FormatDecimals(options.DurationFormatter)
+	return func(tes []dto.TimeEntry, w io.Writer) error {
+		days := make([]time.Time, 0)
+		dayIndex := map[time.Time]bool{}
+		projects := make([]string, 0)
+		cells := map[string]map[time.Time]time.Duration{}
+		for _, t := range tes {
+			s := t.TimeInterval.Start.In(options.Location)
+			day := time.Date(s.Year(), s.Month(), s.Day(), 0, 0, 0, 0,
+				options.Location)
+			if !dayIndex[day] {
+				dayIndex[day] = true
+				days = append(days, day)
+			}
+
+			if t.ID == "" {
+				continue
+			}
+
+			p := timeEntryGroupKeys["project"](t)[0]
+			if _, ok := cells[p]; !ok {
+				projects = append(projects, p)
+				cells[p] = map[time.Time]time.Duration{}
+			}
+
+			cells[p][day] = cells[p][day] +
+				sumTimeEntriesDuration([]dto.TimeEntry{t})
+		}
+
+		sort.Slice(days, func(i, j int) bool {
+			return days[i].Before(days[j])
+		})
+
+		tw := tablewriter.NewWriter(w)
+		tw.SetAutoWrapText(false)
+		tw.SetRowLine(true)
+		header := make([]string, 0, len(days)+2)
+		header = append(header, "Project")
+		for _, d := range days {
+			header = append(header,
+				options.Locale.FormatTime(d, "Mon 2006-01-02"))
+		}
+		util.SetThemedHeader(tw, append(header, "Total"))
+
+		al := make([]int, len(header)+1)
+		for i := range al {
+			al[i] = tablewriter.ALIGN_RIGHT
+		}
+		al[0] = tablewriter.ALIGN_LEFT
+		tw.SetColumnAlignment(al)
+
+		totals := make([]time.Duration, len(days)+1)
+		for _, p := range projects {
+			line := make([]string, 0, len(days)+2)
+			line = append(line, p)
+
+			sum := time.Duration(0)
+			for i, d := range days {
+				v := cells[p][d]
+				sum = sum + v
+				totals[i] = totals[i] + v
+
+				if v == 0 {
+					line = append(line, "")
+					continue
+				}
+
+				line = append(line, df(v))
+			}
+
+			totals[len(days)] = totals[len(days)] + sum
+			tw.Append(append(line, df(sum)))
+		}
+
+		line := make([]string, 0, len(days)+2)
+		line = append(line, "TOTAL")
+		for _, d := range totals {
+			line = append(line, df(d))
+		}
+
+		colors := make([]tablewriter.Colors, len(line))
+		for i := range colors {
+			colors[i] = util.TotalColor()
+		}
+		tw.Rich(line, colors)
+
+		tw.Render()
+		return nil
+	}
+}
@@ -0,0 +1,126 @@
+package timeentry
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// orgTimeFormat is the format of the timestamps used on org-mode CLOCK lines
+const orgTimeFormat = "2006-01-02 Mon 15:04"
+
+// orgTagInvalidChars are the chars not allowed on org-mode tags
+var orgTagInvalidChars = regexp.MustCompile(`[^\pL\pN_@#%]+`)
+
+type orgHeading struct {
+	title       string
+	tags        []string
+	timeEntries []dto.TimeEntry
+}
+
+func orgHeadingTitle(t dto.TimeEntry) string {
+	title := strings.Join(strings.Fields(t.Description), " ")
+	if title == "" && t.Task != nil {
+		title = t.Task.Name
+	}
+
+	if title == "" {
+		return "No Description"
+	}
+
+	return title
+}
+
+// orgHeadings splits the time entries of a project by description, merging
+// the tags of all the time entries under the heading
+func orgHeadings(timeEntries []dto.TimeEntry) []*orgHeading {
+	hs := make([]*orgHeading, 0)
+	idx := map[string]*orgHeading{}
+	for _, t := range timeEntries {
+		title := orgHeadingTitle(t)
+		h, ok := idx[title]
+		if !ok {
+			h = &orgHeading{title: title}
+			idx[title] = h
+			hs = append(hs, h)
+		}
+
+		h.timeEntries = append(h.timeEntries, t)
+		for _, tag := range t.Tags {
+			n := strings.Trim(
+				orgTagInvalidChars.ReplaceAllString(tag.Name, "_"), "_")
+			if n == "" {
+				continue
+			}
+
+			found := false
+			for _, e := range h.tags {
+				if e == n {
+					found = true
+					break
+				}
+			}
+
+			if !found {
+				h.tags = append(h.tags, n)
+			}
+		}
+	}
+
+	return hs
+}
+
+// orgClockLine formats the time entry as a org-mode CLOCK line, running
+// time entries have no end
+func orgClockLine(t dto.TimeEntry) string {
+	start := t.TimeInterval.Start.In(time.Local)
+	if t.TimeInterval.End == nil {
+		return fmt.Sprintf("CLOCK: [%s]", start.Format(orgTimeFormat))
+	}
+
+	end := t.TimeInterval.End.In(time.Local)
+	d := end.Sub(start).Round(time.Minute)
+	return fmt.Sprintf("CLOCK: [%s]--[%s] => %2d:%02d",
+		start.Format(orgTimeFormat), end.Format(orgTimeFormat),
+		int(d.Hours()), int(d.Minutes())%60)
+}
+
+// TimeEntriesOrgPrint will print the time entries as org-mode headings, one
+// for each project with the descriptions as sub-headings, and the time
+// entries as CLOCK lines on their LOGBOOK drawers
+func TimeEntriesOrgPrint(timeEntries []dto.TimeEntry, w io.Writer) error {
+	groups, err := GroupTimeEntries(timeEntries, "project")
+	if err != nil {
+		return err
+	}
+
+	for _, g := range groups {
+		if _, err := fmt.Fprintf(w, "* %s\n", g.Name); err != nil {
+			return err
+		}
+
+		for _, h := range orgHeadings(g.TimeEntries) {
+			title := "** " + h.title
+			if len(h.tags) > 0 {
+				title = title + " :" + strings.Join(h.tags, ":") + ":"
+			}
+
+			lines := []string{title, "   :LOGBOOK:"}
+			for _, t := range h.timeEntries {
+				lines = append(lines, "   "+orgClockLine(t))
+			}
+			lines = append(lines, "   :END:")
+
+			if _, err := fmt.Fprintln(
+				w, strings.Join(lines, "\n")); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
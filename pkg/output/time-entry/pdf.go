@@ -0,0 +1,197 @@
+package timeentry
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+)
+
+const (
+	pdfPageWidth  = 595
+	pdfPageHeight = 842
+	pdfMargin     = 40
+	pdfFontSize   = 9
+	pdfLeading    = 12
+	pdfLineWidth  = 93
+)
+
+// TimesheetHeader is the information printed at the top of the timesheet
+type TimesheetHeader struct {
+	User  string
+	Start time.Time
+	End   time.Time
+}
+
+var pdfTextEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"(", `\(`,
+	")", `\)`,
+)
+
+// pdfText encodes the text to be used with the standard fonts, which only
+// support latin characters
+func pdfText(s string) string {
+	b := make([]byte, 0, len(s))
+	for _, r := range s {
+		switch {
+		case r == '\n' || r == '\r' || r == '\t':
+			b = append(b, ' ')
+		case r < 256:
+			b = append(b, byte(r))
+		default:
+			b = append(b, '?')
+		}
+	}
+
+	return pdfTextEscaper.Replace(string(b))
+}
+
+func pdfCell(s string, size int) string {
+	r := []rune(s)
+	if len(r) > size {
+		r = append(r[:size-1], '~')
+	}
+
+	return string(r) + strings.Repeat(" ", size-len(r))
+}
+
+func timesheetLines(
+	h TimesheetHeader, timeEntries []dto.TimeEntry) ([]string, []string) {
+	header := []string{
+		"TIMESHEET",
+		"",
+		"User:   " + h.User,
+		"Period: " + h.Start.Format("2006-01-02") + " - " +
+			h.End.Format("2006-01-02"),
+		"",
+	}
+
+	columns := pdfCell("Date", 11) + pdfCell("Start", 6) +
+		pdfCell("End", 6) + pdfCell("Dur", 9) + pdfCell("Project", 21) +
+		"Description"
+	sep := strings.Repeat("-", pdfLineWidth)
+
+	lines := []string{columns, sep}
+	for _, t := range timeEntries {
+		start := t.TimeInterval.Start.In(time.Local)
+		end := "now"
+		e := timehlp.Now()
+		if t.TimeInterval.End != nil {
+			e = *t.TimeInterval.End
+			end = e.In(time.Local).Format(timehlp.SimplerOnlyTimeFormat)
+		}
+
+		project := ""
+		if t.Project != nil {
+			project = t.Project.Name
+		}
+
+		lines = append(lines, pdfCell(start.Format("2006-01-02"), 11)+
+			pdfCell(start.Format(timehlp.SimplerOnlyTimeFormat), 6)+
+			pdfCell(end, 6)+
+			pdfCell(durationToString(e.Sub(t.TimeInterval.Start)), 9)+
+			pdfCell(project, 21)+
+			pdfCell(t.Description, pdfLineWidth-53))
+	}
+
+	lines = append(lines,
+		sep,
+		pdfCell("Total", 23)+
+			durationToString(sumTimeEntriesDuration(timeEntries)),
+		"",
+		"",
+		"",
+		"_______________________________      "+
+			"_______________________________",
+		pdfCell(h.User, 37)+"Approved by",
+	)
+
+	return header, lines
+}
+
+// TimeEntriesTimesheetPDFPrint will print the time entries as a PDF
+// timesheet, with the user and period on the top, a table of the time entries
+// and lines for signatures at the end
+func TimeEntriesTimesheetPDFPrint(
+	h TimesheetHeader,
+) func([]dto.TimeEntry, io.Writer) error {
+	return func(timeEntries []dto.TimeEntry, w io.Writer) error {
+		header, lines := timesheetLines(h, timeEntries)
+
+		perPage := (pdfPageHeight - 2*pdfMargin) / pdfLeading
+		pages := make([][]string, 0)
+		page := header
+		for _, l := range lines {
+			if len(page) == perPage {
+				pages = append(pages, page)
+				page = []string{lines[0], lines[1]}
+			}
+			page = append(page, l)
+		}
+		pages = append(pages, page)
+
+		return writePDF(w, pages)
+	}
+}
+
+// writePDF writes a document with a page for each set of lines, using a
+// monospaced standard font
+func writePDF(w io.Writer, pages [][]string) error {
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Courier " +
+			"/Encoding /WinAnsiEncoding >>",
+	}
+
+	kids := make([]string, len(pages))
+	for i, lines := range pages {
+		c := bytes.Buffer{}
+		fmt.Fprintf(&c, "BT\n/F1 %d Tf\n%d TL\n%d %d Td\n",
+			pdfFontSize, pdfLeading,
+			pdfMargin, pdfPageHeight-pdfMargin-pdfFontSize)
+		for _, l := range lines {
+			fmt.Fprintf(&c, "(%s) Tj T*\n", pdfText(l))
+		}
+		c.WriteString("ET")
+
+		pageID := len(objects) + 1
+		kids[i] = fmt.Sprintf("%d 0 R", pageID)
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R "+
+				"/MediaBox [0 0 %d %d] "+
+				"/Resources << /Font << /F1 3 0 R >> >> "+
+				"/Contents %d 0 R >>",
+				pdfPageWidth, pdfPageHeight, pageID+1),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream",
+				c.Len(), c.String()),
+		)
+	}
+
+	objects[1] = fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>",
+		strings.Join(kids, " "), len(pages))
+
+	b := bytes.Buffer{}
+	b.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, o := range objects {
+		offsets[i] = b.Len()
+		fmt.Fprintf(&b, "%d 0 obj\n%s\nendobj\n", i+1, o)
+	}
+
+	xref := b.Len()
+	fmt.Fprintf(&b, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, o := range offsets {
+		fmt.Fprintf(&b, "%010d 00000 n \n", o)
+	}
+	fmt.Fprintf(&b, "trailer\n<< /Size %d /Root 1 0 R >>\n", len(objects)+1)
+	fmt.Fprintf(&b, "startxref\n%d\n%%%%EOF\n", xref)
+
+	_, err := b.WriteTo(w)
+	return err
+}
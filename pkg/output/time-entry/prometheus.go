@@ -0,0 +1,76 @@
+package timeentry
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+const (
+	promTrackedByProject = "clockify_tracked_seconds_total"
+	promTrackedTotal     = "clockify_tracked_seconds"
+)
+
+var promLabelEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`"`, `\"`,
+	"\n", `\n`,
+)
+
+func sumTimeEntriesDurationByProject(
+	ts []dto.TimeEntry) map[string]time.Duration {
+	s := make(map[string]time.Duration)
+	for i := 0; i < len(ts); i++ {
+		name := ""
+		if ts[i].Project != nil {
+			name = ts[i].Project.Name
+		}
+
+		s[name] = s[name] + sumTimeEntriesDuration(ts[i:i+1])
+	}
+
+	return s
+}
+
+// TimeEntriesPrometheusPrint will print the total durations per project and
+// overall as metrics for the node_exporter's textfile collector
+func TimeEntriesPrometheusPrint(
+	timeEntries []dto.TimeEntry, w io.Writer) error {
+	totals := sumTimeEntriesDurationByProject(timeEntries)
+	projects := make([]string, 0, len(totals))
+	for p := range totals {
+		projects = append(projects, p)
+	}
+	sort.Strings(projects)
+
+	if _, err := fmt.Fprintf(w,
+		"# HELP %[1]s Time tracked on Clockify per project, in seconds.\n"+
+			"# TYPE %[1]s counter\n",
+		promTrackedByProject,
+	); err != nil {
+		return err
+	}
+
+	for _, p := range projects {
+		if _, err := fmt.Fprintf(w, "%s{project=\"%s\"} %d\n",
+			promTrackedByProject,
+			promLabelEscaper.Replace(p),
+			int64(totals[p].Seconds()),
+		); err != nil {
+			return err
+		}
+	}
+
+	_, err := fmt.Fprintf(w,
+		"# HELP %[1]s Time tracked on Clockify, in seconds.\n"+
+			"# TYPE %[1]s gauge\n"+
+			"%[1]s %[2]d\n",
+		promTrackedTotal,
+		int64(sumTimeEntriesDuration(timeEntries).Seconds()),
+	)
+	return err
+}
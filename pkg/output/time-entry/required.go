@@ -0,0 +1,36 @@
+package timeentry
+
+import (
+	"strings"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// RequiredFields are the fields that can be checked by MissingFields
+var RequiredFields = []string{"project", "task", "description", "tags"}
+
+var hasField = map[string]func(dto.TimeEntry) bool{
+	"project": func(t dto.TimeEntry) bool {
+		return t.ProjectID != "" || t.Project != nil
+	},
+	"task": func(t dto.TimeEntry) bool {
+		return t.Task != nil && t.Task.ID != ""
+	},
+	"description": func(t dto.TimeEntry) bool {
+		return strings.TrimSpace(t.Description) != ""
+	},
+	"tags": func(t dto.TimeEntry) bool { return len(t.Tags) > 0 },
+}
+
+// MissingFields returns which of the fields are not set on the time entry,
+// in the same order they were asked; unknown fields are ignored
+func MissingFields(t dto.TimeEntry, fields []string) []string {
+	missing := make([]string, 0, len(fields))
+	for _, f := range fields {
+		if has, ok := hasField[f]; ok && !has(t) {
+			missing = append(missing, f)
+		}
+	}
+
+	return missing
+}
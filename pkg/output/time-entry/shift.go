@@ -0,0 +1,28 @@
+package timeentry
+
+import (
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// timeAfterShiftBoundary returns how much of the time entry was after the
+// boundary (only its time of day is used) on the day the time entry started,
+// if the time entry does not cross the boundary, zero is returned
+func timeAfterShiftBoundary(t dto.TimeEntry, boundary time.Time) time.Duration {
+	start := t.TimeInterval.Start.In(time.Local)
+	end := time.Now()
+	if t.TimeInterval.End != nil {
+		end = *t.TimeInterval.End
+	}
+
+	b := time.Date(start.Year(), start.Month(), start.Day(),
+		boundary.Hour(), boundary.Minute(), boundary.Second(), 0,
+		time.Local)
+
+	if !start.Before(b) || !end.After(b) {
+		return 0
+	}
+
+	return end.Sub(b)
+}
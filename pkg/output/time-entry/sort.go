@@ -0,0 +1,57 @@
+package timeentry
+
+import (
+	"strings"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+)
+
+// SortTimeEntries sorts the time entries by a spec like "start" or
+// "duration:desc", valid fields are: id, start, end, duration, project, task
+// and description
+func SortTimeEntries(tes []dto.TimeEntry, spec string) error {
+	end := func(i int) time.Time {
+		if tes[i].TimeInterval.End == nil {
+			return time.Now()
+		}
+
+		return *tes[i].TimeInterval.End
+	}
+
+	duration := func(i int) time.Duration {
+		return end(i).Sub(tes[i].TimeInterval.Start)
+	}
+
+	project := func(i int) string {
+		if tes[i].Project == nil {
+			return ""
+		}
+
+		return strings.ToLower(tes[i].Project.Name)
+	}
+
+	task := func(i int) string {
+		if tes[i].Task == nil {
+			return ""
+		}
+
+		return strings.ToLower(tes[i].Task.Name)
+	}
+
+	return util.SortSlice(tes, spec, util.SortFields{
+		"id": func(i, j int) bool { return tes[i].ID < tes[j].ID },
+		"start": func(i, j int) bool {
+			return tes[i].TimeInterval.Start.Before(tes[j].TimeInterval.Start)
+		},
+		"end":      func(i, j int) bool { return end(i).Before(end(j)) },
+		"duration": func(i, j int) bool { return duration(i) < duration(j) },
+		"project":  func(i, j int) bool { return project(i) < project(j) },
+		"task":     func(i, j int) bool { return task(i) < task(j) },
+		"description": func(i, j int) bool {
+			return strings.ToLower(tes[i].Description) <
+				strings.ToLower(tes[j].Description)
+		},
+	})
+}
@@ -0,0 +1,25 @@
+package timeentry
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// TimeEntriesStreamPrint prints the time entries of each page of the
+// iterator as soon as it is fetched, so only one page is kept in memory.
+// print is called once per page, so it should not write headers or footers
+func TimeEntriesStreamPrint(
+	it *api.TimeEntryIterator,
+	print func([]dto.TimeEntry, io.Writer) error,
+	w io.Writer,
+) error {
+	for it.Next() {
+		if err := print(it.Entries(), w); err != nil {
+			return err
+		}
+	}
+
+	return it.Err()
+}
@@ -0,0 +1,89 @@
+package timeentry
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// taskwarriorTimeFormat is the UTC date format used by Taskwarrior's JSON
+const taskwarriorTimeFormat = "20060102T150405Z"
+
+// taskwarriorNamespace is used to create stable UUIDs from time entries IDs
+var taskwarriorNamespace = []byte("clockify-cli/time-entry/")
+
+type taskwarriorTask struct {
+	UUID        string   `json:"uuid"`
+	Description string   `json:"description"`
+	Status      string   `json:"status"`
+	Entry       string   `json:"entry"`
+	Start       string   `json:"start,omitempty"`
+	End         string   `json:"end,omitempty"`
+	Project     string   `json:"project,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+}
+
+// taskwarriorUUID creates a version 5 like UUID from the time entry ID, so
+// importing the same time entry again updates the task instead of adding one
+func taskwarriorUUID(id string) string {
+	h := sha1.Sum(append(taskwarriorNamespace, id...))
+	h[6] = (h[6] & 0x0f) | 0x50
+	h[8] = (h[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", h[0:4], h[4:6], h[6:8], h[8:10],
+		h[10:16])
+}
+
+// TimeEntriesTaskwarriorPrint will print the time entries as a JSON array to
+// be imported by Taskwarrior (`task import`), ended time entries are
+// completed tasks and running ones are pending and started
+func TimeEntriesTaskwarriorPrint(
+	timeEntries []dto.TimeEntry, w io.Writer) error {
+	tasks := make([]taskwarriorTask, 0, len(timeEntries))
+	for i := range timeEntries {
+		t := timeEntries[i]
+		if t.ID == "" {
+			continue
+		}
+
+		start := t.TimeInterval.Start.UTC().Format(taskwarriorTimeFormat)
+		task := taskwarriorTask{
+			UUID:        taskwarriorUUID(t.ID),
+			Description: t.Description,
+			Status:      "pending",
+			Entry:       start,
+			Start:       start,
+		}
+
+		if t.TimeInterval.End != nil {
+			task.Status = "completed"
+			task.Start = ""
+			task.End = t.TimeInterval.End.UTC().Format(taskwarriorTimeFormat)
+		}
+
+		if task.Description == "" && t.Task != nil {
+			task.Description = t.Task.Name
+		}
+
+		if task.Description == "" {
+			task.Description = t.ID
+		}
+
+		if t.Project != nil {
+			task.Project = t.Project.Name
+		}
+
+		for _, tag := range t.Tags {
+			task.Tags = append(task.Tags,
+				strings.Join(strings.Fields(tag.Name), "_"))
+		}
+
+		tasks = append(tasks, task)
+	}
+
+	return json.NewEncoder(w).Encode(tasks)
+}
@@ -2,36 +2,153 @@ package timeentry
 
 import (
 	"io"
+	"text/template"
+	"time"
 
 	"github.com/lucassabreu/clockify-cli/api/dto"
 	"github.com/lucassabreu/clockify-cli/pkg/output/util"
 )
 
+// TemplateOptions sets how the "template" format should wrap the time entries
+type TemplateOptions struct {
+	Header    string
+	Footer    string
+	Separator *string
+	Location  *time.Location
+}
+
+// TemplateOpt allows the setting of TemplateOptions values
+type TemplateOpt func(*TemplateOptions) error
+
+// WithTemplateHeader sets a template to be printed once before the time
+// entries
+func WithTemplateHeader(header string) TemplateOpt {
+	return func(to *TemplateOptions) error {
+		to.Header = header
+		return nil
+	}
+}
+
+// WithTemplateFooter sets a template to be printed once after the time
+// entries
+func WithTemplateFooter(footer string) TemplateOpt {
+	return func(to *TemplateOptions) error {
+		to.Footer = footer
+		return nil
+	}
+}
+
+// WithTemplateSeparator sets a string to be printed between the time entries,
+// instead of a line break after each one; nothing is printed after the last
+// time entry
+func WithTemplateSeparator(sep string) TemplateOpt {
+	return func(to *TemplateOptions) error {
+		sep = util.ReplaceEscapes(sep)
+		to.Separator = &sep
+		return nil
+	}
+}
+
+// WithTemplateTimeZone sets the time zone of the start and end of the time
+// entries, before the template is applied
+func WithTemplateTimeZone(loc *time.Location) TemplateOpt {
+	return func(to *TemplateOptions) error {
+		to.Location = loc
+		return nil
+	}
+}
+
+// TemplateSummary is the data available to the header and footer templates
+type TemplateSummary struct {
+	Count int
+	Total dto.Duration
+}
+
 // TimeEntriesPrintWithTemplate will print each time entry using the format
 // string
 func TimeEntriesPrintWithTemplate(
-	format string,
+	format string, opts ...TemplateOpt,
 ) func([]dto.TimeEntry, io.Writer) error {
+	options := &TemplateOptions{}
+	for _, o := range opts {
+		if err := o(options); err != nil {
+			return func(_ []dto.TimeEntry, _ io.Writer) error { return err }
+		}
+	}
+
 	return func(timeEntries []dto.TimeEntry, w io.Writer) error {
-		t, err := util.NewTemplate(format)
+		newTemplate := util.NewTemplate
+		if options.Separator != nil {
+			newTemplate = util.NewTemplateWithoutLineBreak
+		}
+
+		t, err := newTemplate(format)
 		if err != nil {
 			return err
 		}
 
+		var header, footer *template.Template
+		if options.Header != "" {
+			if header, err = util.NewTemplate(options.Header); err != nil {
+				return err
+			}
+		}
+
+		if options.Footer != "" {
+			if footer, err = util.NewTemplate(options.Footer); err != nil {
+				return err
+			}
+		}
+
 		l := len(timeEntries)
+		summary := TemplateSummary{
+			Count: l,
+			Total: dto.Duration{Duration: sumTimeEntriesDuration(timeEntries)},
+		}
+
+		if header != nil {
+			if err := header.Execute(w, summary); err != nil {
+				return err
+			}
+		}
+
 		for i := 0; i < l; i++ {
+			te := timeEntries[i]
+			if options.Location != nil {
+				te.TimeInterval = dto.TimeInterval{
+					Start:    te.TimeInterval.Start.In(options.Location),
+					Duration: te.TimeInterval.Duration,
+				}
+
+				if e := timeEntries[i].TimeInterval.End; e != nil {
+					end := e.In(options.Location)
+					te.TimeInterval.End = &end
+				}
+			}
+
+			if i > 0 && options.Separator != nil {
+				if _, err := io.WriteString(w, *options.Separator); err != nil {
+					return err
+				}
+			}
+
 			if err := t.Execute(w, struct {
 				dto.TimeEntry
 				First bool
 				Last  bool
 			}{
-				TimeEntry: timeEntries[i],
+				TimeEntry: te,
 				First:     i == 0,
 				Last:      i == (l - 1),
 			}); err != nil {
 				return err
 			}
 		}
+
+		if footer != nil {
+			return footer.Execute(w, summary)
+		}
+
 		return nil
 	}
 }
@@ -0,0 +1,226 @@
+package timeentry
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+	"github.com/pkg/errors"
+)
+
+const (
+	// dayLabelFormat is used to name the days on the timeline and chart
+	dayLabelFormat = "2006-01-02 Mon"
+	// defaultWidth is used when the terminal width is unknown
+	defaultWidth = 80
+
+	timelineEmpty   = '.'
+	timelineBusy    = '#'
+	timelineOverlap = '!'
+)
+
+// TimelineOptions sets how the timeline will be drawn
+type TimelineOptions struct {
+	StartHour int
+	EndHour   int
+	Width     int
+	Location  *time.Location
+}
+
+// TimelineOpt allows the setting of TimelineOptions values
+type TimelineOpt func(*TimelineOptions) error
+
+// WithTimelineHours limits the axis of the timeline to these hours of the
+// day, like the working hours (8 to 18)
+func WithTimelineHours(start, end int) TimelineOpt {
+	return func(to *TimelineOptions) error {
+		if start < 0 || end > 24 || start >= end {
+			return errors.Errorf(
+				"timeline hours must be between 0 and 24, and the start "+
+					"must be before the end, got %d-%d", start, end)
+		}
+
+		to.StartHour = start
+		to.EndHour = end
+		return nil
+	}
+}
+
+// WithTimelineWidth sets the width of the timeline, instead of using the
+// terminal width
+func WithTimelineWidth(w int) TimelineOpt {
+	return func(to *TimelineOptions) error {
+		to.Width = w
+		return nil
+	}
+}
+
+// WithTimelineTimeZone sets in which time zone the days are drawn
+func WithTimelineTimeZone(l *time.Location) TimelineOpt {
+	return func(to *TimelineOptions) error {
+		to.Location = l
+		return nil
+	}
+}
+
+// TimeEntriesTimelinePrint will print a line for each day with a bar showing
+// when the time entries happened, "#" is a busy slot and "!" a slot with
+// overlapping time entries, followed by the total of the day
+func TimeEntriesTimelinePrint(
+	opts ...TimelineOpt) func([]dto.TimeEntry, io.Writer) error {
+	options := TimelineOptions{
+		StartHour: 0,
+		EndHour:   24,
+		Location:  time.Local,
+	}
+
+	for _, o := range opts {
+		if err := o(&options); err != nil {
+			return func(_ []dto.TimeEntry, _ io.Writer) error { return err }
+		}
+	}
+
+	if options.Width <= 0 {
+		options.Width = defaultWidth
+		if w, ok := util.TerminalWidth(); ok {
+			options.Width = w
+		}
+	}
+
+	return func(tes []dto.TimeEntry, w io.Writer) error {
+		return printTimeline(tes, w, options)
+	}
+}
+
+type timelineInterval struct {
+	start time.Time
+	end   time.Time
+}
+
+func printTimeline(
+	tes []dto.TimeEntry, w io.Writer, options TimelineOptions) error {
+	if len(tes) == 0 {
+		return nil
+	}
+
+	now := timehlp.Now()
+	is := make([]timelineInterval, len(tes))
+	first, last := now, time.Time{}
+	for i, t := range tes {
+		is[i] = timelineInterval{start: t.TimeInterval.Start, end: now}
+		if t.TimeInterval.End != nil {
+			is[i].end = *t.TimeInterval.End
+		}
+
+		if is[i].start.Before(first) {
+			first = is[i].start
+		}
+
+		if is[i].end.After(last) {
+			last = is[i].end
+		}
+	}
+
+	total := durationToString(0)
+	cols := options.Width - len(dayLabelFormat) - len(total) - 5
+	if cols < options.EndHour-options.StartHour {
+		cols = options.EndHour - options.StartHour
+	}
+
+	if _, err := fmt.Fprintln(w, strings.Repeat(" ", len(dayLabelFormat)+2)+
+		timelineAxis(options.StartHour, options.EndHour, cols)); err != nil {
+		return err
+	}
+
+	first = first.In(options.Location)
+	day := time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0,
+		options.Location)
+	for ; day.Before(last); day = day.AddDate(0, 0, 1) {
+		if _, err := fmt.Fprintf(w, "%s |%s| %s\n",
+			day.Format(dayLabelFormat),
+			timelineBar(is, day, options, cols),
+			durationToString(timelineDayDuration(is, day)),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// timelineAxis prints the hours over the columns of the bar, skipping the
+// ones that would not fit
+func timelineAxis(start, end, cols int) string {
+	axis := []rune(strings.Repeat(" ", cols+2))
+	next := 0
+	for h := start; h <= end; h++ {
+		c := (h - start) * cols / (end - start)
+		if c < next || c+2 > len(axis) {
+			continue
+		}
+
+		copy(axis[c:], []rune(fmt.Sprintf("%02d", h)))
+		next = c + 3
+	}
+
+	return strings.TrimRight(string(axis), " ")
+}
+
+// timelineBar draws which parts of the day had time entries, each column is
+// a slice of the hours of the day being shown
+func timelineBar(
+	is []timelineInterval, day time.Time, options TimelineOptions, cols int,
+) string {
+	start := day.Add(time.Duration(options.StartHour) * time.Hour)
+	size := time.Duration(options.EndHour-options.StartHour) * time.Hour
+
+	bar := make([]rune, cols)
+	for c := range bar {
+		cs := start.Add(size * time.Duration(c) / time.Duration(cols))
+		ce := start.Add(size * time.Duration(c+1) / time.Duration(cols))
+
+		count := 0
+		for _, i := range is {
+			if i.start.Before(ce) && i.end.After(cs) {
+				count++
+			}
+		}
+
+		switch {
+		case count == 0:
+			bar[c] = timelineEmpty
+		case count == 1:
+			bar[c] = timelineBusy
+		default:
+			bar[c] = timelineOverlap
+		}
+	}
+
+	return string(bar)
+}
+
+// timelineDayDuration sums how much of the time entries happened on the day
+func timelineDayDuration(is []timelineInterval, day time.Time) time.Duration {
+	end := day.AddDate(0, 0, 1)
+	d := time.Duration(0)
+	for _, i := range is {
+		s, e := i.start, i.end
+		if s.Before(day) {
+			s = day
+		}
+
+		if e.After(end) {
+			e = end
+		}
+
+		if e.After(s) {
+			d = d + e.Sub(s)
+		}
+	}
+
+	return d
+}
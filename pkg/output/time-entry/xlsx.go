@@ -0,0 +1,283 @@
+package timeentry
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/timehlp"
+)
+
+const (
+	xlsxStyleDate     = 1
+	xlsxStyleTime     = 2
+	xlsxStyleDuration = 3
+)
+
+var xlsxStaticParts = map[string]string{
+	"_rels/.rels": xml.Header +
+		`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" ` +
+		`Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" ` +
+		`Target="xl/workbook.xml"/>` +
+		`</Relationships>`,
+	"xl/styles.xml": xml.Header +
+		`<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+		`<numFmts count="3">` +
+		`<numFmt numFmtId="164" formatCode="yyyy\-mm\-dd"/>` +
+		`<numFmt numFmtId="165" formatCode="hh:mm:ss"/>` +
+		`<numFmt numFmtId="166" formatCode="[h]:mm:ss"/>` +
+		`</numFmts>` +
+		`<fonts count="1"><font><sz val="11"/><name val="Calibri"/></font></fonts>` +
+		`<fills count="2"><fill><patternFill patternType="none"/></fill>` +
+		`<fill><patternFill patternType="gray125"/></fill></fills>` +
+		`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+		`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+		`<cellXfs count="4">` +
+		`<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+		`<xf numFmtId="164" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+		`<xf numFmtId="165" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+		`<xf numFmtId="166" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+		`</cellXfs>` +
+		`</styleSheet>`,
+}
+
+// xlsxCell is a value of a spreadsheet row, numbers are written as they are
+// and strings are written inline
+type xlsxCell struct {
+	str     string
+	num     *float64
+	formula string
+	style   int
+}
+
+func xlsxString(s string) xlsxCell {
+	return xlsxCell{str: s}
+}
+
+func xlsxNumber(n float64, style int) xlsxCell {
+	return xlsxCell{num: &n, style: style}
+}
+
+// xlsxSerial converts a time into the days since 1899-12-30, as used by
+// spreadsheets to represent dates, using the local wall clock
+func xlsxSerial(t time.Time) float64 {
+	t = t.In(time.Local)
+	w := time.Date(t.Year(), t.Month(), t.Day(),
+		t.Hour(), t.Minute(), t.Second(), 0, time.UTC)
+	return w.Sub(time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)).Hours() / 24
+}
+
+func xlsxColumn(i int) string {
+	c := ""
+	for i++; i > 0; i = (i - 1) / 26 {
+		c = string(rune('A'+(i-1)%26)) + c
+	}
+	return c
+}
+
+func xlsxEscape(s string) string {
+	b := strings.Builder{}
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+func xlsxSheet(rows [][]xlsxCell) string {
+	b := strings.Builder{}
+	b.WriteString(xml.Header)
+	b.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">`)
+	b.WriteString(`<sheetData>`)
+	for r, row := range rows {
+		fmt.Fprintf(&b, `<row r="%d">`, r+1)
+		for c, cell := range row {
+			ref := fmt.Sprintf("%s%d", xlsxColumn(c), r+1)
+			style := ""
+			if cell.style != 0 {
+				style = fmt.Sprintf(` s="%d"`, cell.style)
+			}
+
+			switch {
+			case cell.formula != "":
+				fmt.Fprintf(&b, `<c r="%s"%s><f>%s</f><v>%s</v></c>`,
+					ref, style, xlsxEscape(cell.formula),
+					fmt.Sprint(*cell.num))
+			case cell.num != nil:
+				fmt.Fprintf(&b, `<c r="%s"%s><v>%s</v></c>`,
+					ref, style, fmt.Sprint(*cell.num))
+			default:
+				fmt.Fprintf(&b,
+					`<c r="%s"%s t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`,
+					ref, style, xlsxEscape(cell.str))
+			}
+		}
+		b.WriteString(`</row>`)
+	}
+	b.WriteString(`</sheetData></worksheet>`)
+
+	return b.String()
+}
+
+// timeEntriesByWeek groups the time entries by the ISO week they started
+func timeEntriesByWeek(
+	timeEntries []dto.TimeEntry) ([]string, map[string][]dto.TimeEntry) {
+	weeks := make(map[string][]dto.TimeEntry)
+	for i := range timeEntries {
+		y, w := timeEntries[i].TimeInterval.Start.In(time.Local).ISOWeek()
+		k := fmt.Sprintf("%d-W%02d", y, w)
+		weeks[k] = append(weeks[k], timeEntries[i])
+	}
+
+	names := make([]string, 0, len(weeks))
+	for k := range weeks {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	return names, weeks
+}
+
+func xlsxWeekRows(timeEntries []dto.TimeEntry) [][]xlsxCell {
+	const durationColumn = 4
+
+	rows := [][]xlsxCell{{
+		xlsxString("ID"),
+		xlsxString("Date"),
+		xlsxString("Start"),
+		xlsxString("End"),
+		xlsxString("Duration"),
+		xlsxString("Project"),
+		xlsxString("Task"),
+		xlsxString("Description"),
+		xlsxString("Tags"),
+	}}
+
+	total := float64(0)
+	for _, t := range timeEntries {
+		end := timehlp.Now()
+		if t.TimeInterval.End != nil {
+			end = *t.TimeInterval.End
+		}
+
+		start := xlsxSerial(t.TimeInterval.Start)
+		d := end.Sub(t.TimeInterval.Start).Hours() / 24
+		total = total + d
+
+		project := ""
+		if t.Project != nil {
+			project = t.Project.Name
+		}
+
+		task := ""
+		if t.Task != nil {
+			task = t.Task.Name
+		}
+
+		tags := make([]string, len(t.Tags))
+		for i := range t.Tags {
+			tags[i] = t.Tags[i].Name
+		}
+
+		rows = append(rows, []xlsxCell{
+			xlsxString(t.ID),
+			xlsxNumber(float64(int64(start)), xlsxStyleDate),
+			xlsxNumber(start, xlsxStyleTime),
+			xlsxNumber(xlsxSerial(end), xlsxStyleTime),
+			xlsxNumber(d, xlsxStyleDuration),
+			xlsxString(project),
+			xlsxString(task),
+			xlsxString(t.Description),
+			xlsxString(strings.Join(tags, ", ")),
+		})
+	}
+
+	c := xlsxColumn(durationColumn)
+	sum := xlsxNumber(total, xlsxStyleDuration)
+	sum.formula = fmt.Sprintf("SUM(%s2:%s%d)", c, c, len(rows))
+
+	return append(rows, []xlsxCell{
+		xlsxString("Total"), {}, {}, {}, sum,
+	})
+}
+
+// TimeEntriesXLSXPrint will write the time entries as a spreadsheet (.xlsx),
+// with one sheet for each week and a total row at the end of each one
+func TimeEntriesXLSXPrint(timeEntries []dto.TimeEntry, w io.Writer) error {
+	names, weeks := timeEntriesByWeek(timeEntries)
+	if len(names) == 0 {
+		names = []string{"Time Entries"}
+	}
+
+	parts := make(map[string]string, len(xlsxStaticParts)+len(names)+3)
+	for k, v := range xlsxStaticParts {
+		parts[k] = v
+	}
+
+	ct := strings.Builder{}
+	ct.WriteString(xml.Header)
+	ct.WriteString(`<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>`)
+
+	wb := strings.Builder{}
+	wb.WriteString(xml.Header)
+	wb.WriteString(`<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" ` +
+		`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets>`)
+
+	rels := strings.Builder{}
+	rels.WriteString(xml.Header)
+	rels.WriteString(`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">`)
+
+	for i, n := range names {
+		id := i + 1
+		fmt.Fprintf(&ct, `<Override PartName="/xl/worksheets/sheet%d.xml" `+
+			`ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`,
+			id)
+		fmt.Fprintf(&wb, `<sheet name="%s" sheetId="%d" r:id="rId%d"/>`,
+			xlsxEscape(n), id, id)
+		fmt.Fprintf(&rels, `<Relationship Id="rId%d" `+
+			`Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" `+
+			`Target="worksheets/sheet%d.xml"/>`, id, id)
+
+		parts[fmt.Sprintf("xl/worksheets/sheet%d.xml", id)] =
+			xlsxSheet(xlsxWeekRows(weeks[n]))
+	}
+
+	fmt.Fprintf(&rels, `<Relationship Id="rId%d" `+
+		`Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" `+
+		`Target="styles.xml"/>`, len(names)+1)
+
+	ct.WriteString(`</Types>`)
+	wb.WriteString(`</sheets></workbook>`)
+	rels.WriteString(`</Relationships>`)
+
+	parts["[Content_Types].xml"] = ct.String()
+	parts["xl/workbook.xml"] = wb.String()
+	parts["xl/_rels/workbook.xml.rels"] = rels.String()
+
+	files := make([]string, 0, len(parts))
+	for k := range parts {
+		files = append(files, k)
+	}
+	sort.Strings(files)
+
+	z := zip.NewWriter(w)
+	for _, f := range files {
+		fw, err := z.Create(f)
+		if err != nil {
+			return err
+		}
+
+		if _, err := io.WriteString(fw, parts[f]); err != nil {
+			return err
+		}
+	}
+
+	return z.Close()
+}
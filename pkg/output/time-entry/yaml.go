@@ -0,0 +1,13 @@
+package timeentry
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+)
+
+// TimeEntriesYAMLPrint will print as YAML
+func TimeEntriesYAMLPrint(t []dto.TimeEntry, w io.Writer) error {
+	return util.YAMLPrint(t, w)
+}
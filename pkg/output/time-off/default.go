@@ -0,0 +1,59 @@
+package timeoff
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+	"github.com/olekukonko/tablewriter"
+)
+
+// TimeOffRequestPrint will print more details
+func TimeOffRequestPrint(rs []dto.TimeOffRequest, w io.Writer) error {
+	tw := tablewriter.NewWriter(w)
+	util.SetThemedHeader(tw, []string{
+		"ID", "User", "Policy", "Start", "End", "Status"})
+
+	lines := make([][]string, len(rs))
+	for i := 0; i < len(rs); i++ {
+		r := rs[i]
+		lines[i] = []string{
+			r.ID,
+			r.UserName,
+			r.PolicyName,
+			r.TimeOffPeriod.Period.Start.UTC().Format("2006-01-02"),
+			r.TimeOffPeriod.Period.End.UTC().Format("2006-01-02"),
+			string(r.Status.StatusType),
+		}
+	}
+
+	if width, ok := util.TerminalWidth(); ok {
+		tw.SetColWidth(width / 6)
+	}
+	tw.AppendBulk(lines)
+	tw.Render()
+
+	return nil
+}
+
+// TimeOffBalancePrint will print more details
+func TimeOffBalancePrint(bs []dto.TimeOffBalance, w io.Writer) error {
+	tw := tablewriter.NewWriter(w)
+	util.SetThemedHeader(tw, []string{"Policy", "Balance", "Used", "Total"})
+
+	f := func(v float64) string {
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+
+	lines := make([][]string, len(bs))
+	for i := 0; i < len(bs); i++ {
+		b := bs[i]
+		lines[i] = []string{b.PolicyName, f(b.Balance), f(b.Used), f(b.Total)}
+	}
+
+	tw.AppendBulk(lines)
+	tw.Render()
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package timeoff
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// TimeOffRequestJSONPrint will print as JSON
+func TimeOffRequestJSONPrint(r dto.TimeOffRequest, w io.Writer) error {
+	return json.NewEncoder(w).Encode(r)
+}
+
+// TimeOffRequestsJSONPrint will print as JSON
+func TimeOffRequestsJSONPrint(rs []dto.TimeOffRequest, w io.Writer) error {
+	return json.NewEncoder(w).Encode(rs)
+}
+
+// TimeOffBalancesJSONPrint will print as JSON
+func TimeOffBalancesJSONPrint(bs []dto.TimeOffBalance, w io.Writer) error {
+	return json.NewEncoder(w).Encode(bs)
+}
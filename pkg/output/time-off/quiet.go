@@ -0,0 +1,26 @@
+package timeoff
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// TimeOffRequestPrintQuietly will only print the IDs
+func TimeOffRequestPrintQuietly(rs []dto.TimeOffRequest, w io.Writer) error {
+	for i := 0; i < len(rs); i++ {
+		fmt.Fprintln(w, rs[i].ID)
+	}
+
+	return nil
+}
+
+// TimeOffBalancePrintQuietly will only print the IDs of the policies
+func TimeOffBalancePrintQuietly(bs []dto.TimeOffBalance, w io.Writer) error {
+	for i := 0; i < len(bs); i++ {
+		fmt.Fprintln(w, bs[i].PolicyID)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package timeoff
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+)
+
+// TimeOffRequestPrintWithTemplate will print each time off request using
+// the format string
+func TimeOffRequestPrintWithTemplate(
+	format string) func([]dto.TimeOffRequest, io.Writer) error {
+	return func(rs []dto.TimeOffRequest, w io.Writer) error {
+		t, err := util.NewTemplate(format)
+		if err != nil {
+			return err
+		}
+
+		for i := 0; i < len(rs); i++ {
+			if err := t.Execute(w, rs[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// TimeOffBalancePrintWithTemplate will print each balance using the format
+// string
+func TimeOffBalancePrintWithTemplate(
+	format string) func([]dto.TimeOffBalance, io.Writer) error {
+	return func(bs []dto.TimeOffBalance, w io.Writer) error {
+		t, err := util.NewTemplate(format)
+		if err != nil {
+			return err
+		}
+
+		for i := 0; i < len(bs); i++ {
+			if err := t.Execute(w, bs[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
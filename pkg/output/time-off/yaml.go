@@ -0,0 +1,18 @@
+package timeoff
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+)
+
+// TimeOffRequestsYAMLPrint will print as YAML
+func TimeOffRequestsYAMLPrint(rs []dto.TimeOffRequest, w io.Writer) error {
+	return util.YAMLPrint(rs, w)
+}
+
+// TimeOffBalancesYAMLPrint will print as YAML
+func TimeOffBalancesYAMLPrint(bs []dto.TimeOffBalance, w io.Writer) error {
+	return util.YAMLPrint(bs, w)
+}
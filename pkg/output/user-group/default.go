@@ -0,0 +1,33 @@
+package usergroup
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+	"github.com/olekukonko/tablewriter"
+)
+
+// UserGroupPrint will print more details
+func UserGroupPrint(gs []dto.UserGroup, w io.Writer) error {
+	tw := tablewriter.NewWriter(w)
+	util.SetThemedHeader(tw, []string{"ID", "Name", "Members"})
+
+	lines := make([][]string, len(gs))
+	for i := 0; i < len(gs); i++ {
+		lines[i] = []string{
+			gs[i].ID,
+			gs[i].Name,
+			strconv.Itoa(len(gs[i].UserIDs)),
+		}
+	}
+
+	if width, ok := util.TerminalWidth(); ok {
+		tw.SetColWidth(width / 3)
+	}
+	tw.AppendBulk(lines)
+	tw.Render()
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package usergroup
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// UserGroupJSONPrint will print as JSON
+func UserGroupJSONPrint(g dto.UserGroup, w io.Writer) error {
+	return json.NewEncoder(w).Encode(g)
+}
+
+// UserGroupsJSONPrint will print as JSON
+func UserGroupsJSONPrint(gs []dto.UserGroup, w io.Writer) error {
+	return json.NewEncoder(w).Encode(gs)
+}
@@ -0,0 +1,27 @@
+package usergroup
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+)
+
+// UserGroupPrintWithTemplate will print each user group using the format
+// string
+func UserGroupPrintWithTemplate(
+	format string) func([]dto.UserGroup, io.Writer) error {
+	return func(gs []dto.UserGroup, w io.Writer) error {
+		t, err := util.NewTemplate(format)
+		if err != nil {
+			return err
+		}
+
+		for i := 0; i < len(gs); i++ {
+			if err := t.Execute(w, gs[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
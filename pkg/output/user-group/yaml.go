@@ -0,0 +1,13 @@
+package usergroup
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+)
+
+// UserGroupsYAMLPrint will print as YAML
+func UserGroupsYAMLPrint(gs []dto.UserGroup, w io.Writer) error {
+	return util.YAMLPrint(gs, w)
+}
@@ -4,13 +4,15 @@ import (
 	"io"
 
 	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
 	"github.com/olekukonko/tablewriter"
 )
 
 // UserPrint will print more details
 func UserPrint(users []dto.User, w io.Writer) error {
 	tw := tablewriter.NewWriter(w)
-	tw.SetHeader([]string{"ID", "Name", "Email", "Status", "TimeZone"})
+	util.SetThemedHeader(tw,
+		[]string{"ID", "Name", "Email", "Status", "TimeZone"})
 
 	lines := make([][]string, len(users))
 	for i := 0; i < len(users); i++ {
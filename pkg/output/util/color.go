@@ -2,27 +2,124 @@ package util
 
 import (
 	"os"
+	"strings"
 
 	"github.com/lucassabreu/clockify-cli/pkg/ui"
+	"github.com/olekukonko/tablewriter"
+	"github.com/pkg/errors"
 )
 
-// ColorToTermColor coverts HEX color to term colors
-func ColorToTermColor(hex string) []int {
-	if hex == "" {
-		return []int{}
+// Theme sets which colors will be used on the table outputs
+type Theme struct {
+	// Header are the colors of the header row
+	Header []int
+	// Total are the colors of the totals row
+	Total []int
+	// ProjectColors sets if the color of the projects should be used
+	ProjectColors bool
+}
+
+var (
+	noColor = false
+	theme   = Theme{ProjectColors: true}
+)
+
+// SetNoColor disables (or enables) all colors on the outputs, colors are
+// always disabled when the env NO_COLOR is set
+func SetNoColor(b bool) {
+	noColor = b
+}
+
+// SetTheme changes the colors used on the table outputs
+func SetTheme(t Theme) {
+	theme = t
+}
+
+var colorNames = map[string]int{
+	"bold":      tablewriter.Bold,
+	"underline": tablewriter.UnderlineSingle,
+	"black":     tablewriter.FgBlackColor,
+	"red":       tablewriter.FgRedColor,
+	"green":     tablewriter.FgGreenColor,
+	"yellow":    tablewriter.FgYellowColor,
+	"blue":      tablewriter.FgBlueColor,
+	"magenta":   tablewriter.FgMagentaColor,
+	"cyan":      tablewriter.FgCyanColor,
+	"white":     tablewriter.FgWhiteColor,
+}
+
+// ParseColor converts a list of color names (like: bold,cyan) into term
+// color codes
+func ParseColor(s string) ([]int, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return []int{}, nil
 	}
 
-	fi, _ := os.Stdout.Stat()
-	if fi.Mode()&os.ModeCharDevice == 0 {
+	cs := strings.Split(s, ",")
+	codes := make([]int, len(cs))
+	for i, c := range cs {
+		code, ok := colorNames[strings.ToLower(strings.TrimSpace(c))]
+		if !ok {
+			return nil, errors.Errorf(
+				"color \"%s\" is not valid, use: bold, underline, black, "+
+					"red, green, yellow, blue, magenta, cyan or white", c)
+		}
+
+		codes[i] = code
+	}
+
+	return codes, nil
+}
+
+// SetThemedHeader sets the header of the table using the colors of the theme
+func SetThemedHeader(tw *tablewriter.Table, header []string) {
+	tw.SetHeader(header)
+
+	c := TermColor(theme.Header...)
+	if len(c) == 0 {
+		return
+	}
+
+	colors := make([]tablewriter.Colors, len(header))
+	for i := range colors {
+		colors[i] = c
+	}
+	tw.SetHeaderColor(colors...)
+}
+
+// TotalColor returns the color codes of the totals row
+func TotalColor() []int {
+	return TermColor(theme.Total...)
+}
+
+// ColorToTermColor coverts HEX color to term colors
+func ColorToTermColor(hex string) []int {
+	if hex == "" || !theme.ProjectColors {
 		return []int{}
 	}
 
 	if c, err := ui.HEX(hex[1:]); err == nil {
-		return append(
+		return TermColor(append(
 			[]int{38, 2},
 			c.Values()...,
-		)
+		)...)
 	}
 
 	return []int{}
 }
+
+// TermColor returns the color codes only if the output is a terminal and
+// colors are not disabled
+func TermColor(c ...int) []int {
+	if len(c) == 0 || noColor || os.Getenv("NO_COLOR") != "" {
+		return []int{}
+	}
+
+	fi, _ := os.Stdout.Stat()
+	if fi.Mode()&os.ModeCharDevice == 0 {
+		return []int{}
+	}
+
+	return c
+}
@@ -0,0 +1,60 @@
+package util
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/lucassabreu/clockify-cli/strhlp"
+	"github.com/pkg/errors"
+)
+
+// SortFields are the fields a list can be sorted by, with a function
+// telling if the item at i should come before the item at j
+type SortFields map[string]func(i, j int) bool
+
+// SortSlice sorts the list using a spec like "field" or "field:desc", the
+// order of the items with equal values is kept
+func SortSlice(list interface{}, spec string, fields SortFields) error {
+	field, desc, err := ParseSort(spec, fields)
+	if err != nil {
+		return err
+	}
+
+	less := fields[field]
+	if desc {
+		less = func(i, j int) bool { return fields[field](j, i) }
+	}
+
+	sort.SliceStable(list, less)
+	return nil
+}
+
+// ParseSort reads a spec like "field", "field:asc" or "field:desc" and
+// checks if the field exists
+func ParseSort(spec string, fields SortFields) (string, bool, error) {
+	parts := strings.SplitN(strings.ToLower(strings.TrimSpace(spec)), ":", 2)
+	field := parts[0]
+
+	if _, ok := fields[field]; !ok {
+		names := make([]string, 0, len(fields))
+		for n := range fields {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+
+		return "", false, errors.Errorf(
+			"can't sort by \"%s\", valid fields are: %s",
+			field, strhlp.ListForHumans(names))
+	}
+
+	if len(parts) == 1 || parts[1] == "asc" {
+		return field, false, nil
+	}
+
+	if parts[1] == "desc" {
+		return field, true, nil
+	}
+
+	return "", false, errors.Errorf(
+		"sort order \"%s\" is not valid, use asc or desc", parts[1])
+}
@@ -70,8 +70,20 @@ func diff(s, e time.Time) dto.Duration {
 	return dto.Duration{Duration: e.Sub(s)}
 }
 
+// ReplaceEscapes changes the escaped line breaks and tabs from the string
+// into real ones
+func ReplaceEscapes(s string) string {
+	s = strings.ReplaceAll(s, "\\n", "\n")
+	return strings.ReplaceAll(s, "\\t", "\t")
+}
+
 func NewTemplate(format string) (*template.Template, error) {
-	format = strings.ReplaceAll(format, "\\n", "\n")
-	format = strings.ReplaceAll(format, "\\t", "\t")
-	return template.New("tmpl").Funcs(funcMap).Parse(format + "\n")
+	return NewTemplateWithoutLineBreak(format + "\n")
+}
+
+// NewTemplateWithoutLineBreak works as NewTemplate, but will not add a line
+// break at the end of the template
+func NewTemplateWithoutLineBreak(format string) (*template.Template, error) {
+	return template.New("tmpl").Funcs(funcMap).Funcs(helperFuncMap).
+		Parse(ReplaceEscapes(format))
 }
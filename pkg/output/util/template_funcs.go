@@ -0,0 +1,255 @@
+package util
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/pkg/errors"
+	"github.com/spf13/cast"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+// helperFuncMap are functions to manipulate strings, numbers, durations and
+// dates on the templates, following the names and order of arguments used
+// by the sprig library, so the last argument can be piped
+var helperFuncMap = template.FuncMap{
+	// strings
+	"upper":      strings.ToUpper,
+	"lower":      strings.ToLower,
+	"title":      cases.Title(language.Und).String,
+	"trim":       strings.TrimSpace,
+	"trimPrefix": func(p, s string) string { return strings.TrimPrefix(s, p) },
+	"trimSuffix": func(p, s string) string { return strings.TrimSuffix(s, p) },
+	"replace": func(o, n, s string) string {
+		return strings.ReplaceAll(s, o, n)
+	},
+	"contains":  func(sub, s string) bool { return strings.Contains(s, sub) },
+	"hasPrefix": func(p, s string) bool { return strings.HasPrefix(s, p) },
+	"hasSuffix": func(p, s string) bool { return strings.HasSuffix(s, p) },
+	"repeat":    func(c int, s string) string { return strings.Repeat(s, c) },
+	"trunc": func(l int, s string) string {
+		if r := []rune(s); len(r) > l {
+			return string(r[:l])
+		}
+		return s
+	},
+	"quote": strconv.Quote,
+	"split": func(sep, s string) []string { return strings.Split(s, sep) },
+	"join": func(sep string, l interface{}) string {
+		return strings.Join(cast.ToStringSlice(l), sep)
+	},
+	"list": func(v ...interface{}) []interface{} { return v },
+
+	// default values
+	"default": func(d interface{}, v ...interface{}) interface{} {
+		if len(v) == 0 || empty(v[0]) {
+			return d
+		}
+		return v[0]
+	},
+	"empty": empty,
+	"coalesce": func(v ...interface{}) interface{} {
+		for _, i := range v {
+			if !empty(i) {
+				return i
+			}
+		}
+		return nil
+	},
+	"ternary": func(t, f interface{}, c bool) interface{} {
+		if c {
+			return t
+		}
+		return f
+	},
+
+	// arithmetic
+	"add": func(a interface{}, v ...interface{}) int64 {
+		s := cast.ToInt64(a)
+		for _, i := range v {
+			s = s + cast.ToInt64(i)
+		}
+		return s
+	},
+	"sub": func(a, b interface{}) int64 {
+		return cast.ToInt64(a) - cast.ToInt64(b)
+	},
+	"mul": func(a interface{}, v ...interface{}) int64 {
+		s := cast.ToInt64(a)
+		for _, i := range v {
+			s = s * cast.ToInt64(i)
+		}
+		return s
+	},
+	"div": func(a, b interface{}) (int64, error) {
+		if cast.ToInt64(b) == 0 {
+			return 0, errors.New("division by zero")
+		}
+		return cast.ToInt64(a) / cast.ToInt64(b), nil
+	},
+	"mod": func(a, b interface{}) (int64, error) {
+		if cast.ToInt64(b) == 0 {
+			return 0, errors.New("division by zero")
+		}
+		return cast.ToInt64(a) % cast.ToInt64(b), nil
+	},
+	"max": func(a interface{}, v ...interface{}) int64 {
+		m := cast.ToInt64(a)
+		for _, i := range v {
+			if n := cast.ToInt64(i); n > m {
+				m = n
+			}
+		}
+		return m
+	},
+	"min": func(a interface{}, v ...interface{}) int64 {
+		m := cast.ToInt64(a)
+		for _, i := range v {
+			if n := cast.ToInt64(i); n < m {
+				m = n
+			}
+		}
+		return m
+	},
+	"addf": func(a interface{}, v ...interface{}) float64 {
+		s := cast.ToFloat64(a)
+		for _, i := range v {
+			s = s + cast.ToFloat64(i)
+		}
+		return s
+	},
+	"subf": func(a, b interface{}) float64 {
+		return cast.ToFloat64(a) - cast.ToFloat64(b)
+	},
+	"mulf": func(a interface{}, v ...interface{}) float64 {
+		s := cast.ToFloat64(a)
+		for _, i := range v {
+			s = s * cast.ToFloat64(i)
+		}
+		return s
+	},
+	"divf": func(a, b interface{}) (float64, error) {
+		if cast.ToFloat64(b) == 0 {
+			return 0, errors.New("division by zero")
+		}
+		return cast.ToFloat64(a) / cast.ToFloat64(b), nil
+	},
+	"round": func(v interface{}, p int) float64 {
+		m := math.Pow10(p)
+		return math.Round(cast.ToFloat64(v)*m) / m
+	},
+
+	// durations
+	"duration": toDuration,
+	"addDuration": func(a interface{}, v ...interface{}) (dto.Duration, error) {
+		s, err := toDuration(a)
+		for _, i := range v {
+			if err != nil {
+				break
+			}
+
+			var d dto.Duration
+			d, err = toDuration(i)
+			s.Duration = s.Duration + d.Duration
+		}
+		return s, err
+	},
+	"subDuration": func(a, b interface{}) (dto.Duration, error) {
+		da, err := toDuration(a)
+		if err != nil {
+			return da, err
+		}
+
+		db, err := toDuration(b)
+		return dto.Duration{Duration: da.Duration - db.Duration}, err
+	},
+	"mulDuration": func(n, v interface{}) (dto.Duration, error) {
+		d, err := toDuration(v)
+		return dto.Duration{Duration: time.Duration(
+			float64(d.Duration) * cast.ToFloat64(n))}, err
+	},
+	"hours": func(v interface{}) (float64, error) {
+		d, err := toDuration(v)
+		return d.Hours(), err
+	},
+	"minutes": func(v interface{}) (float64, error) {
+		d, err := toDuration(v)
+		return d.Minutes(), err
+	},
+	"seconds": func(v interface{}) (float64, error) {
+		d, err := toDuration(v)
+		return d.Seconds(), err
+	},
+
+	// dates
+	"date": func(layout string, t time.Time) string {
+		return t.Format(layout)
+	},
+	"dateModify": func(v interface{}, t time.Time) (time.Time, error) {
+		d, err := toDuration(v)
+		return t.Add(d.Duration), err
+	},
+	"addDate": func(y, m, d int, t time.Time) time.Time {
+		return t.AddDate(y, m, d)
+	},
+	"startOfDay": func(t time.Time) time.Time {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0,
+			t.Location())
+	},
+}
+
+// empty reports if the value is the zero value of its type, or a empty
+// slice or map
+func empty(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+
+	r := reflect.ValueOf(v)
+	switch r.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return r.Len() == 0
+	case reflect.Ptr, reflect.Interface:
+		return r.IsNil()
+	default:
+		return r.IsZero()
+	}
+}
+
+// toDuration converts durations, numbers of seconds and strings like "1h30m"
+// or "PT1H30M" into a dto.Duration
+func toDuration(v interface{}) (dto.Duration, error) {
+	switch d := v.(type) {
+	case dto.Duration:
+		return d, nil
+	case *dto.Duration:
+		if d == nil {
+			return dto.Duration{}, nil
+		}
+		return *d, nil
+	case time.Duration:
+		return dto.Duration{Duration: d}, nil
+	case string:
+		if strings.HasPrefix(strings.ToUpper(d), "P") {
+			var r dto.Duration
+			b, _ := json.Marshal(strings.ToUpper(d))
+			err := r.UnmarshalJSON(b)
+			return r, err
+		}
+
+		r, err := time.ParseDuration(d)
+		return dto.Duration{Duration: r}, errors.Wrapf(
+			err, "duration \"%s\" is not valid", d)
+	default:
+		s, err := cast.ToFloat64E(v)
+		return dto.Duration{Duration: time.Duration(s * float64(time.Second))},
+			err
+	}
+}
@@ -0,0 +1,23 @@
+package util
+
+import (
+	"os"
+	"strconv"
+
+	"golang.org/x/term"
+)
+
+// TerminalWidth returns the width of the terminal, or of the env COLUMNS when
+// the output is not a terminal (like when piped to a pager)
+func TerminalWidth() (int, bool) {
+	if width, _, err := term.GetSize(int(os.Stdout.Fd())); err == nil {
+		return width, true
+	}
+
+	if width, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil &&
+		width > 0 {
+		return width, true
+	}
+
+	return 0, false
+}
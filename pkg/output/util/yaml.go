@@ -0,0 +1,40 @@
+package util
+
+import (
+	"encoding/json"
+	"io"
+
+	"gopkg.in/yaml.v3"
+)
+
+// YAMLPrint will print the value as YAML, using the same keys and order that
+// it would have as JSON
+func YAMLPrint(v interface{}, w io.Writer) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	var n yaml.Node
+	if err := yaml.Unmarshal(b, &n); err != nil {
+		return err
+	}
+	resetYAMLStyle(&n)
+
+	e := yaml.NewEncoder(w)
+	e.SetIndent(2)
+	if err := e.Encode(&n); err != nil {
+		return err
+	}
+
+	return e.Close()
+}
+
+// resetYAMLStyle removes the flow style that the JSON input leaves on the
+// nodes, keeping quotes only where they are needed
+func resetYAMLStyle(n *yaml.Node) {
+	n.Style = 0
+	for _, c := range n.Content {
+		resetYAMLStyle(c)
+	}
+}
@@ -0,0 +1,40 @@
+package webhook
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+	"github.com/olekukonko/tablewriter"
+)
+
+// WebhookPrint will print more details
+func WebhookPrint(ws []dto.Webhook, w io.Writer) error {
+	tw := tablewriter.NewWriter(w)
+	util.SetThemedHeader(tw, []string{"ID", "Name", "Event", "URL", "Enabled"})
+
+	yesNo := map[bool]string{
+		true:  "YES",
+		false: "NO",
+	}
+
+	lines := make([][]string, len(ws))
+	for i := 0; i < len(ws); i++ {
+		h := ws[i]
+		lines[i] = []string{
+			h.ID,
+			h.Name,
+			string(h.WebhookEvent),
+			h.URL,
+			yesNo[h.Enabled],
+		}
+	}
+
+	if width, ok := util.TerminalWidth(); ok {
+		tw.SetColWidth(width / 5)
+	}
+	tw.AppendBulk(lines)
+	tw.Render()
+
+	return nil
+}
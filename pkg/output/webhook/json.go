@@ -0,0 +1,18 @@
+package webhook
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// WebhookJSONPrint will print as JSON
+func WebhookJSONPrint(h dto.Webhook, w io.Writer) error {
+	return json.NewEncoder(w).Encode(h)
+}
+
+// WebhooksJSONPrint will print as JSON
+func WebhooksJSONPrint(ws []dto.Webhook, w io.Writer) error {
+	return json.NewEncoder(w).Encode(ws)
+}
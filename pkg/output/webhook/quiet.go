@@ -0,0 +1,17 @@
+package webhook
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+)
+
+// WebhookPrintQuietly will only print the IDs
+func WebhookPrintQuietly(ws []dto.Webhook, w io.Writer) error {
+	for i := 0; i < len(ws); i++ {
+		fmt.Fprintln(w, ws[i].ID)
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package webhook
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+)
+
+// WebhookPrintWithTemplate will print each webhook using the format string
+func WebhookPrintWithTemplate(
+	format string) func([]dto.Webhook, io.Writer) error {
+	return func(ws []dto.Webhook, w io.Writer) error {
+		t, err := util.NewTemplate(format)
+		if err != nil {
+			return err
+		}
+
+		for i := 0; i < len(ws); i++ {
+			if err := t.Execute(w, ws[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
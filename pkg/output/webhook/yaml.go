@@ -0,0 +1,13 @@
+package webhook
+
+import (
+	"io"
+
+	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
+)
+
+// WebhooksYAMLPrint will print as YAML
+func WebhooksYAMLPrint(ws []dto.Webhook, w io.Writer) error {
+	return util.YAMLPrint(ws, w)
+}
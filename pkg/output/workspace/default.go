@@ -4,6 +4,7 @@ import (
 	"io"
 
 	"github.com/lucassabreu/clockify-cli/api/dto"
+	"github.com/lucassabreu/clockify-cli/pkg/output/util"
 	"github.com/olekukonko/tablewriter"
 )
 
@@ -12,7 +13,7 @@ func WorkspacePrint(
 	wDefault string) func(ws []dto.Workspace, w io.Writer) error {
 	return func(ws []dto.Workspace, w io.Writer) error {
 		tw := tablewriter.NewWriter(w)
-		tw.SetHeader([]string{"ID", "Name", "Image"})
+		util.SetThemedHeader(tw, []string{"ID", "Name", "Image"})
 
 		lines := make([][]string, len(ws))
 		for i := 0; i < len(ws); i++ {
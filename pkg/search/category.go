@@ -0,0 +1,42 @@
+package search
+
+import (
+	"context"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/pkg/errors"
+)
+
+// GetExpenseCategoryByName will try to find the first expense category
+// containing the string on its name or id that matches the value
+func GetExpenseCategoryByName(
+	ctx context.Context,
+	c api.Client,
+	workspace,
+	category string,
+) (string, error) {
+	id, err := findByName(category, "category", func() ([]named, error) {
+		cs, err := c.GetExpenseCategories(ctx, api.GetExpenseCategoriesParam{
+			Workspace:       workspace,
+			PaginationParam: api.AllPages(),
+		})
+		if err != nil {
+			return []named{}, err
+		}
+
+		ns := make([]named, len(cs))
+		for i := 0; i < len(ns); i++ {
+			ns[i] = cs[i]
+		}
+
+		return ns, nil
+	})
+
+	if errors.Is(err, ErrEmptyReference) {
+		return id, errors.New(
+			"no category with id or name containing \"" +
+				category + "\" was not found")
+	}
+
+	return id, err
+}
@@ -1,6 +1,8 @@
 package search
 
 import (
+	"context"
+
 	"github.com/lucassabreu/clockify-cli/api"
 	"golang.org/x/sync/errgroup"
 )
@@ -8,6 +10,7 @@ import (
 // GetClientsByName receives a list of id or names of clients and returns their
 // ids
 func GetClientsByName(
+	ctx context.Context,
 	c api.Client,
 	workspace string,
 	clients []string,
@@ -16,7 +19,7 @@ func GetClientsByName(
 		return clients, nil
 	}
 
-	cs, err := c.GetClients(api.GetClientsParam{
+	cs, err := c.GetClients(ctx, api.GetClientsParam{
 		Workspace:       workspace,
 		PaginationParam: api.AllPages(),
 	})
@@ -52,6 +55,7 @@ func GetClientsByName(
 // GetClientByName will look for a client that the id or name Contains the
 // string on client parameter
 func GetClientByName(
+	ctx context.Context,
 	c api.Client,
 	workspace string,
 	client string,
@@ -60,7 +64,7 @@ func GetClientByName(
 		client,
 		"client", func() ([]named, error) {
 
-			cs, err := c.GetClients(api.GetClientsParam{
+			cs, err := c.GetClients(ctx, api.GetClientsParam{
 				Workspace:       workspace,
 				PaginationParam: api.AllPages(),
 			})
@@ -0,0 +1,42 @@
+package search
+
+import (
+	"context"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/pkg/errors"
+)
+
+// GetUserGroupByName will try to find the first user group
+// containing the string on its name or id that matches the value
+func GetUserGroupByName(
+	ctx context.Context,
+	c api.Client,
+	workspace,
+	group string,
+) (string, error) {
+	id, err := findByName(group, "group", func() ([]named, error) {
+		gs, err := c.GetUserGroups(ctx, api.GetUserGroupsParam{
+			Workspace:       workspace,
+			PaginationParam: api.AllPages(),
+		})
+		if err != nil {
+			return []named{}, err
+		}
+
+		ns := make([]named, len(gs))
+		for i := 0; i < len(ns); i++ {
+			ns[i] = gs[i]
+		}
+
+		return ns, nil
+	})
+
+	if errors.Is(err, ErrEmptyReference) {
+		return id, errors.New(
+			"no group with id or name containing \"" +
+				group + "\" was not found")
+	}
+
+	return id, err
+}
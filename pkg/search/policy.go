@@ -0,0 +1,42 @@
+package search
+
+import (
+	"context"
+
+	"github.com/lucassabreu/clockify-cli/api"
+	"github.com/pkg/errors"
+)
+
+// GetTimeOffPolicyByName will try to find the first time off policy
+// containing the string on its name or id that matches the value
+func GetTimeOffPolicyByName(
+	ctx context.Context,
+	c api.Client,
+	workspace,
+	policy string,
+) (string, error) {
+	id, err := findByName(policy, "policy", func() ([]named, error) {
+		ps, err := c.GetTimeOffPolicies(ctx, api.GetTimeOffPoliciesParam{
+			Workspace:       workspace,
+			PaginationParam: api.AllPages(),
+		})
+		if err != nil {
+			return []named{}, err
+		}
+
+		ns := make([]named, len(ps))
+		for i := 0; i < len(ns); i++ {
+			ns[i] = ps[i]
+		}
+
+		return ns, nil
+	})
+
+	if errors.Is(err, ErrEmptyReference) {
+		return id, errors.New(
+			"no policy with id or name containing \"" +
+				policy + "\" was not found")
+	}
+
+	return id, err
+}
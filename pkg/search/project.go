@@ -1,18 +1,21 @@
 package search
 
 import (
+	"context"
+
 	"github.com/lucassabreu/clockify-cli/api"
 	"github.com/pkg/errors"
 	"golang.org/x/sync/errgroup"
 )
 
 func GetProjectByName(
+	ctx context.Context,
 	c api.Client,
 	workspace,
 	project string,
 ) (string, error) {
 	id, err := findByName(project, "project", func() ([]named, error) {
-		ps, err := c.GetProjects(api.GetProjectsParam{
+		ps, err := c.GetProjects(ctx, api.GetProjectsParam{
 			Workspace:       workspace,
 			PaginationParam: api.AllPages(),
 		})
@@ -40,6 +43,7 @@ func GetProjectByName(
 // GetProjectsByName will try to find projects containing the string on its
 // name or id that matches the value
 func GetProjectsByName(
+	ctx context.Context,
 	c api.Client,
 	workspace string,
 	projects []string,
@@ -48,7 +52,7 @@ func GetProjectsByName(
 		return projects, nil
 	}
 
-	ts, err := c.GetProjects(api.GetProjectsParam{
+	ts, err := c.GetProjects(ctx, api.GetProjectsParam{
 		Workspace:       workspace,
 		PaginationParam: api.AllPages(),
 	})
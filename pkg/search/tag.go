@@ -1,12 +1,15 @@
 package search
 
 import (
+	"context"
+
 	"github.com/lucassabreu/clockify-cli/api"
 	"golang.org/x/sync/errgroup"
 )
 
 // GetTagsByName receives a list of id or names of tags and returns their ids
 func GetTagsByName(
+	ctx context.Context,
 	c api.Client,
 	workspace string,
 	tags []string,
@@ -15,7 +18,7 @@ func GetTagsByName(
 		return tags, nil
 	}
 
-	ts, err := c.GetTags(api.GetTagsParam{
+	ts, err := c.GetTags(ctx, api.GetTagsParam{
 		Workspace:       workspace,
 		PaginationParam: api.AllPages(),
 	})
@@ -1,6 +1,8 @@
 package search
 
 import (
+	"context"
+
 	"github.com/lucassabreu/clockify-cli/api"
 	"golang.org/x/sync/errgroup"
 )
@@ -8,13 +10,14 @@ import (
 // GetTaskByName will try to find the first task containing the string on its
 // name or id that matches the value
 func GetTaskByName(
+	ctx context.Context,
 	c api.Client,
 	f api.GetTasksParam,
 	task string,
 ) (string, error) {
 	return findByName(task, "task", func() ([]named, error) {
 		f.PaginationParam = api.AllPages()
-		ts, err := c.GetTasks(f)
+		ts, err := c.GetTasks(ctx, f)
 		if err != nil {
 			return []named{}, err
 		}
@@ -31,6 +34,7 @@ func GetTaskByName(
 // GetTasksByName will try to find tasks containing the string on its name or
 // id that matches the value
 func GetTasksByName(
+	ctx context.Context,
 	c api.Client,
 	f api.GetTasksParam,
 	tasks []string,
@@ -40,7 +44,7 @@ func GetTasksByName(
 	}
 
 	f.PaginationParam = api.AllPages()
-	ts, err := c.GetTasks(f)
+	ts, err := c.GetTasks(ctx, f)
 	if err != nil {
 		return tasks, err
 	}
@@ -1,6 +1,8 @@
 package search
 
 import (
+	"context"
+
 	"github.com/lucassabreu/clockify-cli/api"
 	"golang.org/x/sync/errgroup"
 )
@@ -8,6 +10,7 @@ import (
 // GetUsersByName receives a list of id or names of clients and returns their
 // ids
 func GetUsersByName(
+	ctx context.Context,
 	c api.Client,
 	workspace string,
 	users []string,
@@ -16,7 +19,7 @@ func GetUsersByName(
 		return users, nil
 	}
 
-	us, err := c.WorkspaceUsers(api.WorkspaceUsersParam{
+	us, err := c.WorkspaceUsers(ctx, api.WorkspaceUsersParam{
 		Workspace:       workspace,
 		PaginationParam: api.AllPages(),
 	})
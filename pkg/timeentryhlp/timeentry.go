@@ -1,6 +1,7 @@
 package timeentryhlp
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -18,8 +19,9 @@ const (
 
 // GetLatestEntryEntry will return the last time entry of a user, if it exists
 func GetLatestEntryEntry(
+	ctx context.Context,
 	c api.Client, workspace, userID string) (dto.TimeEntryImpl, error) {
-	return GetTimeEntry(c, workspace, userID, AliasLatest)
+	return GetTimeEntry(ctx, c, workspace, userID, AliasLatest)
 }
 
 var ErrNoTimeEntry = errors.New("time entry was not found")
@@ -40,6 +42,7 @@ func mayNotFound(tei *dto.TimeEntryImpl, err error) (
 // GetTimeEntry will look for the time entry of a user for the id or alias
 // provided
 func GetTimeEntry(
+	ctx context.Context,
 	c api.Client,
 	workspace,
 	userID,
@@ -51,6 +54,7 @@ func GetTimeEntry(
 	switch id {
 	case "^0", AliasCurrent:
 		tei, err := mayNotFound(c.GetTimeEntryInProgress(
+			ctx,
 			api.GetTimeEntryInProgressParam{
 				Workspace: workspace,
 				UserID:    userID,
@@ -70,7 +74,7 @@ func GetTimeEntry(
 	}
 
 	if id != AliasLast && id != AliasLatest && !strings.HasPrefix(id, "^") {
-		return mayNotFound(c.GetTimeEntry(api.GetTimeEntryParam{
+		return mayNotFound(c.GetTimeEntry(ctx, api.GetTimeEntryParam{
 			Workspace:   workspace,
 			TimeEntryID: id,
 		}))
@@ -87,7 +91,7 @@ func GetTimeEntry(
 		}
 	}
 
-	list, err := c.GetUserTimeEntries(api.GetUserTimeEntriesParam{
+	list, err := c.GetUserTimeEntries(ctx, api.GetUserTimeEntriesParam{
 		Workspace:      workspace,
 		UserID:         userID,
 		OnlyInProgress: onlyInProgress,
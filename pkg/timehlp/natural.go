@@ -0,0 +1,202 @@
+package timehlp
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var naturalUnits = map[string]time.Duration{
+	"s": time.Second, "sec": time.Second, "secs": time.Second,
+	"second": time.Second, "seconds": time.Second,
+	"m": time.Minute, "min": time.Minute, "mins": time.Minute,
+	"minute": time.Minute, "minutes": time.Minute,
+	"h": time.Hour, "hr": time.Hour, "hrs": time.Hour,
+	"hour": time.Hour, "hours": time.Hour,
+}
+
+var naturalDayUnits = map[string]int{
+	"day": 1, "days": 1, "week": 7, "weeks": 7,
+}
+
+// ParseWeekday reads a weekday by its name or first three letters
+func ParseWeekday(s string) (time.Weekday, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		n := strings.ToLower(d.String())
+		if s == n || (len(s) == 3 && strings.HasPrefix(n, s)) {
+			return d, nil
+		}
+	}
+
+	return time.Sunday, errors.Errorf("\"%s\" is not a weekday", s)
+}
+
+// naturalToTime reads expressions like "10 minutes ago", "5m ago", "2 days
+// ago", "yesterday 9am", "last friday at 14:00" or "noon", relative to now;
+// days without a time are at 0:00
+func naturalToTime(s string, now time.Time) (time.Time, bool) {
+	fs := strings.Fields(s)
+	if len(fs) == 2 && fs[1] == "ago" && fs[0] != "" &&
+		fs[0][0] >= '0' && fs[0][0] <= '9' {
+		d, err := relativeUnitDescriptiveTimeToDuration(fs[0])
+		return now.Add(-d), err == nil
+	}
+
+	if len(fs) == 3 && fs[2] == "ago" {
+		n, ok := naturalAmount(fs[0])
+		if !ok {
+			return now, false
+		}
+
+		if u, ok := naturalUnits[fs[1]]; ok {
+			return now.Add(-time.Duration(n) * u), true
+		}
+
+		if u, ok := naturalDayUnits[fs[1]]; ok {
+			return now.AddDate(0, 0, -n*u), true
+		}
+	}
+
+	day, fs, hasDay := naturalToDay(fs, now)
+	if len(fs) > 0 && fs[0] == "at" {
+		fs = fs[1:]
+	}
+
+	if len(fs) == 0 {
+		return day, hasDay
+	}
+
+	h, m, sec, ok := naturalClock(strings.Join(fs, ""))
+	if !ok {
+		return now, false
+	}
+
+	return time.Date(day.Year(), day.Month(), day.Day(), h, m, sec, 0,
+		day.Location()), true
+}
+
+// naturalToDay reads the day at the start of the expression, like "today",
+// "yesterday", "tomorrow", "friday" (the last one, including today), "last
+// friday", "next friday" or "3 days ago", returning it at 0:00 and the rest
+// of the expression; when there is no day, today is returned
+func naturalToDay(fs []string, now time.Time) (time.Time, []string, bool) {
+	today := TruncateDateWithTimezone(now, now.Location())
+	if len(fs) == 0 {
+		return today, fs, false
+	}
+
+	switch fs[0] {
+	case "today":
+		return today, fs[1:], true
+	case "yesterday":
+		return today.AddDate(0, 0, -1), fs[1:], true
+	case "tomorrow":
+		return today.AddDate(0, 0, 1), fs[1:], true
+	}
+
+	if d, err := ParseWeekday(fs[0]); err == nil {
+		diff := int(today.Weekday()-d+7) % 7
+		return today.AddDate(0, 0, -diff), fs[1:], true
+	}
+
+	if len(fs) > 1 && (fs[0] == "last" || fs[0] == "next") {
+		if d, err := ParseWeekday(fs[1]); err == nil {
+			if fs[0] == "last" {
+				diff := int(today.Weekday()-d+6)%7 + 1
+				return today.AddDate(0, 0, -diff), fs[2:], true
+			}
+
+			diff := int(d-today.Weekday()+6)%7 + 1
+			return today.AddDate(0, 0, diff), fs[2:], true
+		}
+	}
+
+	if len(fs) > 2 && fs[2] == "ago" {
+		n, ok := naturalAmount(fs[0])
+		u, uok := naturalDayUnits[fs[1]]
+		if ok && uok {
+			return today.AddDate(0, 0, -n*u), fs[3:], true
+		}
+	}
+
+	return today, fs, false
+}
+
+// naturalClock reads a time of the day like "9am", "9:30 pm", "14:00",
+// "14:00:05", "noon" or "midnight" into its hour, minute and second
+func naturalClock(s string) (h, m, sec int, ok bool) {
+	switch s {
+	case "noon":
+		return 12, 0, 0, true
+	case "midnight":
+		return 0, 0, 0, true
+	}
+
+	suffix := ""
+	if strings.HasSuffix(s, "am") || strings.HasSuffix(s, "pm") {
+		suffix = s[len(s)-2:]
+		s = s[:len(s)-2]
+	}
+
+	parts := strings.Split(s, ":")
+	if len(parts) > 3 || (suffix == "" && len(parts) == 1) {
+		return 0, 0, 0, false
+	}
+
+	vs := []*int{&h, &m, &sec}
+	for i, p := range parts {
+		v, err := strconv.Atoi(p)
+		if err != nil || v < 0 || (i > 0 && (len(p) != 2 || v > 59)) {
+			return 0, 0, 0, false
+		}
+		*vs[i] = v
+	}
+
+	switch {
+	case suffix == "" && h > 23:
+		return 0, 0, 0, false
+	case suffix != "" && (h < 1 || h > 12):
+		return 0, 0, 0, false
+	case suffix == "am" && h == 12:
+		h = 0
+	case suffix == "pm" && h != 12:
+		h += 12
+	}
+
+	return h, m, sec, true
+}
+
+// naturalAmount reads a positive number, or "a", "an" and "one" as 1
+func naturalAmount(s string) (int, bool) {
+	switch s {
+	case "a", "an", "one":
+		return 1, true
+	}
+
+	n, err := strconv.Atoi(s)
+	return n, err == nil && n > 0
+}
+
+// ConvertToDate reads a date like 2006-01-02, or expressions like "today",
+// "yesterday", "last friday" or "3 days ago", returning it at 0:00 (UTC)
+func ConvertToDate(s string) (time.Time, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	t, err := time.Parse("2006-01-02", s)
+	if err == nil {
+		return t, nil
+	}
+
+	if s == NowTimeFormat {
+		s = "today"
+	}
+
+	d, rest, ok := naturalToDay(strings.Fields(s), Now())
+	if !ok || len(rest) > 0 {
+		return t, err
+	}
+
+	return TruncateDate(d), nil
+}
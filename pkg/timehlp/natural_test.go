@@ -0,0 +1,60 @@
+package timehlp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNaturalToTime(t *testing.T) {
+	// a wednesday
+	now := time.Date(2022, 6, 1, 15, 20, 10, 0, time.Local)
+	at := func(day, h, m int) time.Time {
+		return time.Date(2022, 6, day, h, m, 0, 0, time.Local)
+	}
+
+	tts := []struct {
+		in  string
+		out time.Time
+	}{
+		{in: "10 minutes ago", out: now.Add(-10 * time.Minute)},
+		{in: "an hour ago", out: now.Add(-time.Hour)},
+		{in: "5m ago", out: now.Add(-5 * time.Minute)},
+		{in: "1h30m ago", out: now.Add(-90 * time.Minute)},
+		{in: "2 days ago", out: now.AddDate(0, 0, -2)},
+		{in: "noon", out: at(1, 12, 0)},
+		{in: "9am", out: at(1, 9, 0)},
+		{in: "12am", out: at(1, 0, 0)},
+		{in: "yesterday 9:30 pm", out: at(0, 21, 30)},
+		{in: "yesterday", out: at(0, 0, 0)},
+		{in: "tomorrow at midnight", out: at(2, 0, 0)},
+		{in: "wednesday 8am", out: at(1, 8, 0)},
+		{in: "mon 8am", out: at(-1, 8, 0)},
+		{in: "last friday 14:00", out: at(-4, 14, 0)},
+		{in: "last wednesday 14:00", out: at(-6, 14, 0)},
+		{in: "next wed 14:00", out: at(8, 14, 0)},
+		{in: "next thursday 14:00", out: at(2, 14, 0)},
+		{in: "3 days ago at 10:15", out: at(-2, 10, 15)},
+		{in: "1 week ago 9am", out: at(-6, 9, 0)},
+	}
+
+	for _, tt := range tts {
+		t.Run(tt.in, func(t *testing.T) {
+			r, ok := naturalToTime(tt.in, now)
+			assert.True(t, ok)
+			assert.Equal(t, tt.out, r)
+		})
+	}
+
+	for _, in := range []string{
+		"", "someday", "9", "13pm", "0am", "yesterday 25:00", "9:5am",
+		"ten minutes ago", "-1 days ago", "last 9am", "friday never",
+		"5x ago", "m5 ago",
+	} {
+		t.Run(in, func(t *testing.T) {
+			_, ok := naturalToTime(in, now)
+			assert.False(t, ok)
+		})
+	}
+}
@@ -23,6 +23,8 @@ const (
 // it.
 // If the string is "now" than `time.Now()` in the local timezone will be
 // returned.
+// If none of the formats fits, expressions like "10 minutes ago",
+// "yesterday 9am" or "last friday 14:00" are tried.
 func ConvertToTime(timeString string) (t time.Time, err error) {
 	timeString = strings.ToLower(strings.TrimSpace(timeString))
 
@@ -35,6 +37,18 @@ func ConvertToTime(timeString string) (t time.Time, err error) {
 		return relativeToTime(timeString)
 	}
 
+	if t, err = convertFormatToTime(timeString); err == nil {
+		return t, nil
+	}
+
+	if nt, ok := naturalToTime(timeString, Now()); ok {
+		return nt, nil
+	}
+
+	return t, err
+}
+
+func convertFormatToTime(timeString string) (t time.Time, err error) {
 	if strings.HasPrefix(timeString, "yesterday ") {
 		timeString = Today().
 			Add(-1).Format("2006-01-02") + " " + timeString[10:]
@@ -51,6 +65,7 @@ func ConvertToTime(timeString string) (t time.Time, err error) {
 				[]string{
 					FullTimeFormat, SimplerTimeFormat, OnlyTimeFormat,
 					SimplerOnlyTimeFormat, NowTimeFormat,
+					"10 minutes ago", "yesterday 9am", "last friday 14:00",
 				},
 				", ",
 			),
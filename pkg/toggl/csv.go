@@ -0,0 +1,95 @@
+package toggl
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	csvDateFormat = "2006-01-02"
+	csvTimeFormat = "15:04:05"
+)
+
+// ReadCSV reads the time entries of a "Detailed" report exported as CSV by
+// Toggl Track, its dates and times are read on the location
+func ReadCSV(r io.Reader, loc *time.Location) ([]Entry, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	h, err := cr.Read()
+	if err == io.EOF {
+		return nil, errors.New("the CSV file is empty")
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read the CSV file")
+	}
+
+	cols := map[string]int{}
+	for i := range h {
+		n := strings.ToLower(strings.TrimSpace(
+			strings.TrimPrefix(h[i], "\ufeff")))
+		cols[n] = i
+	}
+
+	for _, n := range []string{
+		"start date", "start time", "end date", "end time"} {
+		if _, ok := cols[n]; !ok {
+			return nil, errors.Errorf(
+				`the column "%s" is missing, is it a detailed report?`, n)
+		}
+	}
+
+	es := make([]Entry, 0)
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read the CSV file")
+		}
+
+		get := func(n string) string {
+			i, ok := cols[n]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		line, _ := cr.FieldPos(0)
+		e := Entry{
+			Description: get("description"),
+			Client:      get("client"),
+			Project:     get("project"),
+			Task:        get("task"),
+			Billable:    strings.EqualFold(get("billable"), "yes"),
+		}
+
+		if e.Start, err = time.ParseInLocation(
+			csvDateFormat+" "+csvTimeFormat,
+			get("start date")+" "+get("start time"), loc); err != nil {
+			return nil, errors.Errorf("line %d: invalid start", line)
+		}
+
+		if e.End, err = time.ParseInLocation(
+			csvDateFormat+" "+csvTimeFormat,
+			get("end date")+" "+get("end time"), loc); err != nil {
+			return nil, errors.Errorf("line %d: invalid end", line)
+		}
+
+		for _, t := range strings.Split(get("tags"), ",") {
+			if t = strings.TrimSpace(t); t != "" {
+				e.Tags = append(e.Tags, t)
+			}
+		}
+
+		es = append(es, e)
+	}
+
+	return es, nil
+}
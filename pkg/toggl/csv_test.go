@@ -0,0 +1,55 @@
+package toggl_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/lucassabreu/clockify-cli/pkg/toggl"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadCSV(t *testing.T) {
+	es, err := toggl.ReadCSV(strings.NewReader("\ufeff"+heredoc.Doc(`
+		User,Email,Client,Project,Task,Description,Billable,Start date,Start time,End date,End time,Duration,Tags,Amount (USD)
+		John,john@example.com,Acme,Website,Design,Writing docs,Yes,2022-06-19,09:00:00,2022-06-19,10:30:00,01:30:00,"Docs, Meeting",
+		John,john@example.com,,,,Lunch,No,2022-06-19,23:30:00,2022-06-20,00:15:00,00:45:00,,
+	`)), time.UTC)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []toggl.Entry{
+		{
+			Description: "Writing docs",
+			Client:      "Acme",
+			Project:     "Website",
+			Task:        "Design",
+			Tags:        []string{"Docs", "Meeting"},
+			Billable:    true,
+			Start:       time.Date(2022, 6, 19, 9, 0, 0, 0, time.UTC),
+			End:         time.Date(2022, 6, 19, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			Description: "Lunch",
+			Start:       time.Date(2022, 6, 19, 23, 30, 0, 0, time.UTC),
+			End:         time.Date(2022, 6, 20, 0, 15, 0, 0, time.UTC),
+		},
+	}, es)
+}
+
+func TestReadCSVErrors(t *testing.T) {
+	_, err := toggl.ReadCSV(strings.NewReader(""), time.UTC)
+	assert.EqualError(t, err, "the CSV file is empty")
+
+	_, err = toggl.ReadCSV(strings.NewReader(
+		"Project,Start date,Start time\n"), time.UTC)
+	assert.EqualError(t, err,
+		`the column "end date" is missing, is it a detailed report?`)
+
+	_, err = toggl.ReadCSV(strings.NewReader(heredoc.Doc(`
+		Start date,Start time,End date,End time
+		2022-06-19,09:00:00,2022-06-19,10:00:00
+		2022-06-19,9h,2022-06-19,10:00:00
+	`)), time.UTC)
+	assert.EqualError(t, err, "line 3: invalid start")
+}
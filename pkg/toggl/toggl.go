@@ -0,0 +1,170 @@
+// Package toggl reads the time entries of a Toggl Track account, from its
+// CSV export or from its API, to be imported into Clockify
+package toggl
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Entry is a time entry of Toggl Track, with the names of its client,
+// project, task and tags
+type Entry struct {
+	Description string
+	Client      string
+	Project     string
+	Task        string
+	Tags        []string
+	Billable    bool
+	Start       time.Time
+	End         time.Time
+}
+
+// DefaultURL is the base URL of the API of Toggl Track
+const DefaultURL = "https://api.track.toggl.com/api/v9"
+
+// Client reads the time entries of the user of the token from the API of
+// Toggl Track
+type Client struct {
+	baseURL string
+	token   string
+	http    *http.Client
+}
+
+// NewClient creates a Client for the API token of the user (found on the
+// profile page of Toggl Track)
+func NewClient(token string) *Client {
+	return NewClientFromURL(token, DefaultURL)
+}
+
+// NewClientFromURL creates a Client using other base URL for the API
+func NewClientFromURL(token, baseURL string) *Client {
+	return &Client{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		token:   token,
+		http:    &http.Client{Timeout: time.Minute},
+	}
+}
+
+type apiEntry struct {
+	Description string     `json:"description"`
+	ProjectID   *int64     `json:"project_id"`
+	TaskID      *int64     `json:"task_id"`
+	Billable    bool       `json:"billable"`
+	Start       time.Time  `json:"start"`
+	Stop        *time.Time `json:"stop"`
+	Tags        []string   `json:"tags"`
+}
+
+type apiProject struct {
+	ID       int64  `json:"id"`
+	Name     string `json:"name"`
+	ClientID *int64 `json:"client_id"`
+}
+
+type apiNamed struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+// TimeEntries returns the time entries started between since and until,
+// the one running is ignored
+func (c *Client) TimeEntries(since, until time.Time) ([]Entry, error) {
+	q := url.Values{}
+	q.Set("start_date", since.UTC().Format(time.RFC3339))
+	q.Set("end_date", until.UTC().Format(time.RFC3339))
+
+	var tes []apiEntry
+	if err := c.get("me/time_entries?"+q.Encode(), &tes); err != nil {
+		return nil, err
+	}
+
+	var ps []apiProject
+	if err := c.get("me/projects", &ps); err != nil {
+		return nil, err
+	}
+
+	var cs, ts []apiNamed
+	if err := c.get("me/clients", &cs); err != nil {
+		return nil, err
+	}
+
+	if err := c.get("me/tasks", &ts); err != nil {
+		return nil, err
+	}
+
+	clients := map[int64]string{}
+	for _, c := range cs {
+		clients[c.ID] = c.Name
+	}
+
+	projects := map[int64]apiProject{}
+	for _, p := range ps {
+		projects[p.ID] = p
+	}
+
+	tasks := map[int64]string{}
+	for _, t := range ts {
+		tasks[t.ID] = t.Name
+	}
+
+	es := make([]Entry, 0, len(tes))
+	for _, te := range tes {
+		if te.Stop == nil {
+			continue
+		}
+
+		e := Entry{
+			Description: te.Description,
+			Tags:        te.Tags,
+			Billable:    te.Billable,
+			Start:       te.Start,
+			End:         *te.Stop,
+		}
+
+		if te.ProjectID != nil {
+			p := projects[*te.ProjectID]
+			e.Project = p.Name
+			if p.ClientID != nil {
+				e.Client = clients[*p.ClientID]
+			}
+		}
+
+		if te.TaskID != nil {
+			e.Task = tasks[*te.TaskID]
+		}
+
+		es = append(es, e)
+	}
+
+	return es, nil
+}
+
+func (c *Client) get(path string, v interface{}) error {
+	req, err := http.NewRequest("GET", c.baseURL+"/"+path, nil)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	req.SetBasicAuth(c.token, "api_token")
+	req.Header.Set("Accept", "application/json")
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to call the Toggl API")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return errors.Errorf("toggl API answered %s for %s",
+			res.Status, strings.SplitN(path, "?", 2)[0])
+	}
+
+	return errors.Wrap(json.NewDecoder(res.Body).Decode(v),
+		"failed to read the Toggl API response")
+}
@@ -0,0 +1,83 @@
+package toggl_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/lucassabreu/clockify-cli/pkg/toggl"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientTimeEntries(t *testing.T) {
+	responses := map[string]string{
+		"/me/time_entries": `[
+			{"description":"Writing docs","project_id":1,"task_id":3,
+			 "billable":true,"start":"2022-06-19T09:00:00Z",
+			 "stop":"2022-06-19T10:30:00Z","tags":["Docs"]},
+			{"description":"Lunch","start":"2022-06-19T12:00:00Z",
+			 "stop":"2022-06-19T13:00:00Z","tags":[]},
+			{"description":"Running","start":"2022-06-19T14:00:00Z",
+			 "stop":null}
+		]`,
+		"/me/projects": `[{"id":1,"name":"Website","client_id":2}]`,
+		"/me/clients":  `[{"id":2,"name":"Acme"}]`,
+		"/me/tasks":    `[{"id":3,"name":"Design"}]`,
+	}
+
+	s := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			u, p, _ := r.BasicAuth()
+			if u != "a-token" || p != "api_token" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+
+			if r.URL.Path == "/me/time_entries" {
+				assert.Equal(t, "2022-06-01T00:00:00Z",
+					r.URL.Query().Get("start_date"))
+				assert.Equal(t, "2022-07-01T00:00:00Z",
+					r.URL.Query().Get("end_date"))
+			}
+
+			b, ok := responses[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			_, _ = w.Write([]byte(b))
+		}))
+	defer s.Close()
+
+	since := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2022, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	es, err := toggl.NewClientFromURL("a-token", s.URL).
+		TimeEntries(since, until)
+	assert.NoError(t, err)
+	assert.Equal(t, []toggl.Entry{
+		{
+			Description: "Writing docs",
+			Client:      "Acme",
+			Project:     "Website",
+			Task:        "Design",
+			Tags:        []string{"Docs"},
+			Billable:    true,
+			Start:       time.Date(2022, 6, 19, 9, 0, 0, 0, time.UTC),
+			End:         time.Date(2022, 6, 19, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			Description: "Lunch",
+			Tags:        []string{},
+			Start:       time.Date(2022, 6, 19, 12, 0, 0, 0, time.UTC),
+			End:         time.Date(2022, 6, 19, 13, 0, 0, 0, time.UTC),
+		},
+	}, es)
+
+	_, err = toggl.NewClientFromURL("other", s.URL).
+		TimeEntries(since, until)
+	assert.EqualError(t, err,
+		"toggl API answered 403 Forbidden for me/time_entries")
+}